@@ -41,7 +41,14 @@ var DefaultTLSConfig = config.TLS{
 }
 
 // DefaultClusterConfig is the default cluster configuration.
-var DefaultClusterConfig = config.Cluster{}
+var DefaultClusterConfig = config.Cluster{
+	Claims: config.Claims{
+		LeaseTTL: 30 * time.Second,
+	},
+	DNS: config.ClusterDNS{
+		Interval: 30 * time.Second,
+	},
+}
 
 // DefaultHTTPConfig is the default HTTP config.
 var DefaultHTTPConfig = config.HTTP{
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	"go.thethings.network/lorawan-stack/pkg/auth/argon2"
 	"go.thethings.network/lorawan-stack/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/pkg/oauth"
 	"go.thethings.network/lorawan-stack/pkg/webui"
@@ -58,4 +59,14 @@ func init() {
 	DefaultIdentityServerConfig.ProfilePicture.Bucket = "profile_pictures"
 	DefaultIdentityServerConfig.ProfilePicture.BucketURL = path.Join(shared.DefaultAssetsBaseURL, "blob", "profile_pictures")
 	DefaultIdentityServerConfig.ProfilePicture.UseGravatar = true
+	DefaultIdentityServerConfig.OAuth.OIDC.IDTokenTTL = time.Hour
+	DefaultIdentityServerConfig.Delete.Retention = 30 * 24 * time.Hour
+	DefaultIdentityServerConfig.Delete.PurgeInterval = time.Hour
+	DefaultIdentityServerConfig.APIKeys.LastUsedFlushInterval = time.Minute
+	DefaultIdentityServerConfig.APIKeys.ExpiryReminder = 7 * 24 * time.Hour
+	DefaultIdentityServerConfig.APIKeys.ExpiryReminderInterval = time.Hour
+	DefaultIdentityServerConfig.PasswordHashing.Algorithm = "ARGON2ID"
+	DefaultIdentityServerConfig.PasswordHashing.Argon2ID.Time = argon2.Default().Time
+	DefaultIdentityServerConfig.PasswordHashing.Argon2ID.Memory = argon2.Default().Memory
+	DefaultIdentityServerConfig.PasswordHashing.Argon2ID.Threads = argon2.Default().Threads
 }
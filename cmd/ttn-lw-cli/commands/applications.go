@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
 )
 
 var (
@@ -76,6 +77,7 @@ var (
 			limit, page, opt, getTotal := withPagination(cmd.Flags())
 			res, err := ttnpb.NewApplicationRegistryClient(is).List(ctx, &ttnpb.ListApplicationsRequest{
 				Collaborator: getCollaborator(cmd.Flags()),
+				Deleted:      getDeleted(cmd.Flags()),
 				FieldMask:    types.FieldMask{Paths: paths},
 				Limit:        limit,
 				Page:         page,
@@ -218,6 +220,12 @@ var (
 	applicationsDeleteCommand = &cobra.Command{
 		Use:   "delete [application-id]",
 		Short: "Delete an application",
+		Long: `Delete an application
+
+Deleting an application also deletes its end devices from the Identity Server,
+Network Server, Application Server and Join Server, and its webhooks, pub/subs
+and link from the Application Server. Restoring the application does not
+restore those. Use the --dry-run flag to see what would be deleted.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			appID := getApplicationID(cmd.Flags(), args)
 			if appID == nil {
@@ -228,6 +236,9 @@ var (
 			if err != nil {
 				return err
 			}
+			if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+				return printApplicationDeletion(is, *appID)
+			}
 			_, err = ttnpb.NewApplicationRegistryClient(is).Delete(ctx, appID)
 			if err != nil {
 				return err
@@ -236,6 +247,55 @@ var (
 			return nil
 		},
 	}
+	applicationsRestoreCommand = &cobra.Command{
+		Use:   "restore [application-id]",
+		Short: "Restore a deleted application",
+		Long: `Restore a deleted application
+
+Deleted applications can be restored until they are purged.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationRegistryClient(is).Restore(ctx, appID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	applicationsPurgeCommand = &cobra.Command{
+		Use:   "purge [application-id]",
+		Short: "Purge a deleted application",
+		Long: `Purge a deleted application
+
+Purging permanently removes the application and the data related to it. This can
+not be undone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationRegistryClient(is).Purge(ctx, appID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	applicationsContactInfoCommand = contactInfoCommands("application", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		appID := getApplicationID(cmd.Flags(), args)
 		if appID == nil {
@@ -245,10 +305,71 @@ var (
 	})
 )
 
+// printApplicationDeletion prints the end devices, integrations and link that
+// are deleted when the application is deleted.
+func printApplicationDeletion(is *grpc.ClientConn, appID ttnpb.ApplicationIdentifiers) error {
+	idsMask := types.FieldMask{Paths: []string{"ids"}}
+	if _, err := ttnpb.NewApplicationRegistryClient(is).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIdentifiers: appID,
+		FieldMask:              idsMask,
+	}); err != nil {
+		return err
+	}
+	logger := logger.WithField("application_id", appID.ApplicationID)
+	logger.Info("Would delete application")
+	devs, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+		ApplicationIdentifiers: appID,
+		FieldMask:              idsMask,
+	})
+	if err != nil {
+		return err
+	}
+	for _, dev := range devs.EndDevices {
+		logger.WithField("device_id", dev.DeviceID).Info("Would delete end device")
+	}
+
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		logger.WithError(err).Warn("Could not connect to Application Server, integrations and link not listed")
+		return nil
+	}
+	webhooks, err := ttnpb.NewApplicationWebhookRegistryClient(as).List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+		ApplicationIdentifiers: appID,
+		FieldMask:              idsMask,
+	})
+	if err != nil {
+		return err
+	}
+	for _, webhook := range webhooks.Webhooks {
+		logger.WithField("webhook_id", webhook.WebhookID).Info("Would delete webhook")
+	}
+	pubsubs, err := ttnpb.NewApplicationPubSubRegistryClient(as).List(ctx, &ttnpb.ListApplicationPubSubsRequest{
+		ApplicationIdentifiers: appID,
+		FieldMask:              idsMask,
+	})
+	if err != nil {
+		return err
+	}
+	for _, pubsub := range pubsubs.Pubsubs {
+		logger.WithField("pub_sub_id", pubsub.PubSubID).Info("Would delete pub/sub")
+	}
+	_, err = ttnpb.NewAsClient(as).GetLink(ctx, &ttnpb.GetApplicationLinkRequest{
+		ApplicationIdentifiers: appID,
+	})
+	switch {
+	case err == nil:
+		logger.Info("Would delete link")
+	case !errors.IsNotFound(err):
+		return err
+	}
+	return nil
+}
+
 func init() {
 	applicationsListCommand.Flags().AddFlagSet(collaboratorFlags())
 	applicationsListCommand.Flags().AddFlagSet(selectApplicationFlags)
 	applicationsListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsListCommand.Flags().AddFlagSet(deletedFlags())
 	applicationsCommand.AddCommand(applicationsListCommand)
 	applicationsSearchCommand.Flags().AddFlagSet(searchFlags())
 	applicationsSearchCommand.Flags().AddFlagSet(selectApplicationFlags)
@@ -266,7 +387,12 @@ func init() {
 	applicationsUpdateCommand.Flags().AddFlagSet(attributesFlags())
 	applicationsCommand.AddCommand(applicationsUpdateCommand)
 	applicationsDeleteCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsDeleteCommand.Flags().Bool("dry-run", false, "only print what would be deleted")
 	applicationsCommand.AddCommand(applicationsDeleteCommand)
+	applicationsRestoreCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsCommand.AddCommand(applicationsRestoreCommand)
+	applicationsPurgeCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsCommand.AddCommand(applicationsPurgeCommand)
 	applicationsContactInfoCommand.PersistentFlags().AddFlagSet(applicationIDFlags())
 	applicationsCommand.AddCommand(applicationsContactInfoCommand)
 	Root.AddCommand(applicationsCommand)
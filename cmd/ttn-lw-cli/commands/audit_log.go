@@ -0,0 +1,70 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	auditLogCommand = &cobra.Command{
+		Use:     "audit-log",
+		Aliases: []string{"audit"},
+		Short:   "View the audit log of the Identity Server",
+	}
+	auditLogListCommand = &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List audit log entries",
+		Long: `List audit log entries
+
+The entries of a single entity are listed, newest first. Admins can leave out
+the entity to list the entries of all entities.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &ttnpb.ListAuditLogEntriesRequest{}
+			if ids := getCombinedIdentifiers(cmd.Flags()).GetEntityIdentifiers(); len(ids) > 0 {
+				if len(ids) > 1 {
+					logger.Warn("multiple entities found in flags, considering only the first")
+				}
+				req.EntityIDs = ids[0]
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			req.Limit, req.Page = limit, page
+			res, err := ttnpb.NewAuditLogClient(is).List(ctx, req, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Entries)
+		},
+	}
+)
+
+func init() {
+	auditLogListCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
+	auditLogListCommand.Flags().AddFlagSet(paginationFlags())
+	auditLogCommand.AddCommand(auditLogListCommand)
+	Root.AddCommand(auditLogCommand)
+}
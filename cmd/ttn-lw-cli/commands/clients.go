@@ -76,6 +76,7 @@ var (
 			limit, page, opt, getTotal := withPagination(cmd.Flags())
 			res, err := ttnpb.NewClientRegistryClient(is).List(ctx, &ttnpb.ListClientsRequest{
 				Collaborator: getCollaborator(cmd.Flags()),
+				Deleted:      getDeleted(cmd.Flags()),
 				FieldMask:    types.FieldMask{Paths: paths},
 				Limit:        limit,
 				Page:         page,
@@ -244,6 +245,55 @@ var (
 			return nil
 		},
 	}
+	clientsRestoreCommand = &cobra.Command{
+		Use:   "restore [client-id]",
+		Short: "Restore a deleted client",
+		Long: `Restore a deleted client
+
+Deleted clients can be restored until they are purged.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliID := getClientID(cmd.Flags(), args)
+			if cliID == nil {
+				return errNoClientID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewClientRegistryClient(is).Restore(ctx, cliID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	clientsPurgeCommand = &cobra.Command{
+		Use:   "purge [client-id]",
+		Short: "Purge a deleted client",
+		Long: `Purge a deleted client
+
+Purging permanently removes the client and the data related to it. This can
+not be undone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliID := getClientID(cmd.Flags(), args)
+			if cliID == nil {
+				return errNoClientID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewClientRegistryClient(is).Purge(ctx, cliID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	clientsContactInfoCommand = contactInfoCommands("client", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		cliID := getClientID(cmd.Flags(), args)
 		if cliID == nil {
@@ -257,6 +307,7 @@ func init() {
 	clientsListCommand.Flags().AddFlagSet(collaboratorFlags())
 	clientsListCommand.Flags().AddFlagSet(selectClientFlags)
 	clientsListCommand.Flags().AddFlagSet(paginationFlags())
+	clientsListCommand.Flags().AddFlagSet(deletedFlags())
 	clientsCommand.AddCommand(clientsListCommand)
 	clientsSearchCommand.Flags().AddFlagSet(searchFlags())
 	clientsSearchCommand.Flags().AddFlagSet(selectClientFlags)
@@ -277,6 +328,10 @@ func init() {
 	clientsCommand.AddCommand(clientsUpdateCommand)
 	clientsDeleteCommand.Flags().AddFlagSet(clientIDFlags())
 	clientsCommand.AddCommand(clientsDeleteCommand)
+	clientsRestoreCommand.Flags().AddFlagSet(clientIDFlags())
+	clientsCommand.AddCommand(clientsRestoreCommand)
+	clientsPurgeCommand.Flags().AddFlagSet(clientIDFlags())
+	clientsCommand.AddCommand(clientsPurgeCommand)
 	clientsContactInfoCommand.PersistentFlags().AddFlagSet(clientIDFlags())
 	clientsCommand.AddCommand(clientsContactInfoCommand)
 	Root.AddCommand(clientsCommand)
@@ -0,0 +1,87 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type macCommandPayload interface {
+	MACCommand() *ttnpb.MACCommand
+}
+
+// newEndDevicesQueueMACCommandCommand returns a command that queues a MAC command with identifier cid in the Network Server.
+// If pld is not nil, the payload of the MAC command is set from the flags.
+func newEndDevicesQueueMACCommandCommand(use, short string, cid ttnpb.MACCommandIdentifier, pld macCommandPayload) *cobra.Command {
+	var pldFlags *pflag.FlagSet
+	if pld != nil {
+		pldFlags = util.FieldFlags(pld)
+	}
+	cmd := &cobra.Command{
+		Use:   use + " [application-id] [device-id]",
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			macCommand := cid.MACCommand()
+			if pld != nil {
+				if err = util.SetFields(pld, pldFlags); err != nil {
+					return err
+				}
+				macCommand = pld.MACCommand()
+			}
+
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewNsEndDeviceRegistryClient(ns).QueueMACCommands(ctx, &ttnpb.QueueMACCommandsRequest{
+				EndDeviceIdentifiers: *devID,
+				MACCommands:          []*ttnpb.MACCommand{macCommand},
+			})
+			return err
+		},
+	}
+	cmd.Flags().AddFlagSet(endDeviceIDFlags())
+	if pldFlags != nil {
+		cmd.Flags().AddFlagSet(pldFlags)
+	}
+	return cmd
+}
+
+var endDevicesMACCommandsCommand = &cobra.Command{
+	Use:     "mac-commands",
+	Aliases: []string{"mac"},
+	Short:   "Queue MAC commands for end devices (NS only)",
+}
+
+func init() {
+	endDevicesMACCommandsCommand.AddCommand(
+		newEndDevicesQueueMACCommandCommand("dev-status-req", "Queue DevStatusReq to request the device status", ttnpb.CID_DEV_STATUS, nil),
+		newEndDevicesQueueMACCommandCommand("link-check-ans", "Queue LinkCheckAns with the given link margin and gateway count", ttnpb.CID_LINK_CHECK, &ttnpb.MACCommand_LinkCheckAns{}),
+		newEndDevicesQueueMACCommandCommand("rx-param-setup-req", "Queue RxParamSetupReq to change the Rx parameters", ttnpb.CID_RX_PARAM_SETUP, &ttnpb.MACCommand_RxParamSetupReq{}),
+		newEndDevicesQueueMACCommandCommand("new-channel-req", "Queue NewChannelReq to create or modify a channel", ttnpb.CID_NEW_CHANNEL, &ttnpb.MACCommand_NewChannelReq{}),
+		newEndDevicesQueueMACCommandCommand("device-time-ans", "Queue DeviceTimeAns with the given time", ttnpb.CID_DEVICE_TIME, &ttnpb.MACCommand_DeviceTimeAns{}),
+		newEndDevicesQueueMACCommandCommand("force-rejoin-req", "Queue ForceRejoinReq to make the device rejoin", ttnpb.CID_FORCE_REJOIN, &ttnpb.MACCommand_ForceRejoinReq{}),
+	)
+	endDevicesCommand.AddCommand(endDevicesMACCommandsCommand)
+}
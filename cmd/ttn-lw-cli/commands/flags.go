@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -32,6 +33,17 @@ func firstArgs(i int, args ...string) []string {
 	return args[:i]
 }
 
+func deletedFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("deleted", false, "list recently deleted entities")
+	return flagSet
+}
+
+func getDeleted(flagSet *pflag.FlagSet) bool {
+	deleted, _ := flagSet.GetBool("deleted")
+	return deleted
+}
+
 func collaboratorFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.String("user-id", "", "")
@@ -128,6 +140,48 @@ func getAPIKeyID(flagSet *pflag.FlagSet, args []string, i int) string {
 	return apiKeyID
 }
 
+var errInvalidAPIKeyExpiresAt = errors.DefineInvalidArgument("api_key_expires_at", "invalid API key expiry time `{expires_at}`")
+
+func apiKeyRestrictionFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("expires-at", "", "time (RFC3339) or duration from now after which the API key expires")
+	flagSet.StringSlice("allowed-cidrs", nil, "source IP address ranges (CIDR) from which the API key can be used")
+	return flagSet
+}
+
+func getAPIKeyRestrictions(flagSet *pflag.FlagSet) (expiresAt *time.Time, allowedCIDRs []string, err error) {
+	allowedCIDRs, _ = flagSet.GetStringSlice("allowed-cidrs")
+	s, _ := flagSet.GetString("expires-at")
+	if s == "" {
+		return nil, allowedCIDRs, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		t := time.Now().Add(d)
+		return &t, allowedCIDRs, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil, nil, errInvalidAPIKeyExpiresAt.WithAttributes("expires_at", s).WithCause(err)
+	}
+	return &t, allowedCIDRs, nil
+}
+
+// getAPIKeyUpdatePaths returns the API key field mask paths for an update.
+// The rights are always updated; the name and restrictions only if their flags are set.
+func getAPIKeyUpdatePaths(flagSet *pflag.FlagSet) []string {
+	paths := []string{"rights"}
+	if flagSet.Changed("name") {
+		paths = append(paths, "name")
+	}
+	if flagSet.Changed("expires-at") {
+		paths = append(paths, "expires_at")
+	}
+	if flagSet.Changed("allowed-cidrs") {
+		paths = append(paths, "allowed_cidrs")
+	}
+	return paths
+}
+
 func searchFlags() *pflag.FlagSet {
 	flagSet := &pflag.FlagSet{}
 	flagSet.String("id-contains", "", "")
@@ -112,6 +112,7 @@ var (
 			limit, page, opt, getTotal := withPagination(cmd.Flags())
 			res, err := ttnpb.NewGatewayRegistryClient(is).List(ctx, &ttnpb.ListGatewaysRequest{
 				Collaborator: getCollaborator(cmd.Flags()),
+				Deleted:      getDeleted(cmd.Flags()),
 				FieldMask:    types.FieldMask{Paths: paths},
 				Limit:        limit,
 				Page:         page,
@@ -349,6 +350,55 @@ var (
 			return nil
 		},
 	}
+	gatewaysRestoreCommand = &cobra.Command{
+		Use:   "restore [gateway-id]",
+		Short: "Restore a deleted gateway",
+		Long: `Restore a deleted gateway
+
+Deleted gateways can be restored until they are purged.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayRegistryClient(is).Restore(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	gatewaysPurgeCommand = &cobra.Command{
+		Use:   "purge [gateway-id]",
+		Short: "Purge a deleted gateway",
+		Long: `Purge a deleted gateway
+
+Purging permanently removes the gateway and the data related to it. This can
+not be undone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGatewayRegistryClient(is).Purge(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	gatewaysConnectionStats = &cobra.Command{
 		Use:   "connection-stats [gateway-id]",
 		Short: "Get connection stats for a gateway",
@@ -403,6 +453,7 @@ func init() {
 	gatewaysListCommand.Flags().AddFlagSet(collaboratorFlags())
 	gatewaysListCommand.Flags().AddFlagSet(selectGatewayFlags)
 	gatewaysListCommand.Flags().AddFlagSet(paginationFlags())
+	gatewaysListCommand.Flags().AddFlagSet(deletedFlags())
 	gatewaysCommand.AddCommand(gatewaysListCommand)
 	gatewaysSearchCommand.Flags().AddFlagSet(searchFlags())
 	gatewaysSearchCommand.Flags().AddFlagSet(selectGatewayFlags)
@@ -427,6 +478,10 @@ func init() {
 	gatewaysCommand.AddCommand(gatewaysUpdateCommand)
 	gatewaysDeleteCommand.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysDeleteCommand)
+	gatewaysRestoreCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysRestoreCommand)
+	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysPurgeCommand)
 	gatewaysConnectionStats.Flags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
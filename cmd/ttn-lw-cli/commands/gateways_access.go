@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -190,6 +191,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -199,6 +204,8 @@ var (
 				GatewayIdentifiers: *gtwID,
 				Name:               name,
 				Rights:             rights,
+				ExpiresAt:          expiresAt,
+				AllowedCIDRs:       allowedCIDRs,
 			})
 			if err != nil {
 				return err
@@ -231,6 +238,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -239,10 +250,13 @@ var (
 			_, err = ttnpb.NewGatewayAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
 				GatewayIdentifiers: *gtwID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiresAt,
+					AllowedCIDRs: allowedCIDRs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -275,6 +289,7 @@ var (
 					ID:     id,
 					Rights: nil,
 				},
+				FieldMask: types.FieldMask{Paths: []string{"rights"}},
 			})
 			if err != nil {
 				return err
@@ -306,10 +321,12 @@ func init() {
 	gatewayAPIKeysList.Flags().AddFlagSet(paginationFlags())
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysList)
 	gatewayAPIKeysCreate.Flags().String("name", "", "")
+	gatewayAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	gatewayAPIKeysCreate.Flags().AddFlagSet(gatewayRightsFlags)
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysCreate)
 	gatewayAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	gatewayAPIKeysUpdate.Flags().String("name", "", "")
+	gatewayAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	gatewayAPIKeysUpdate.Flags().AddFlagSet(gatewayRightsFlags)
 	gatewayAPIKeys.AddCommand(gatewayAPIKeysUpdate)
 	gatewayAPIKeysDelete.Flags().String("api-key-id", "", "")
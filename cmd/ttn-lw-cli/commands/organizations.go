@@ -76,6 +76,7 @@ var (
 			limit, page, opt, getTotal := withPagination(cmd.Flags())
 			res, err := ttnpb.NewOrganizationRegistryClient(is).List(ctx, &ttnpb.ListOrganizationsRequest{
 				Collaborator: getUserID(cmd.Flags(), nil).GetOrganizationOrUserIdentifiers(),
+				Deleted:      getDeleted(cmd.Flags()),
 				FieldMask:    types.FieldMask{Paths: paths},
 				Limit:        limit,
 				Page:         page,
@@ -236,6 +237,55 @@ var (
 			return nil
 		},
 	}
+	organizationsRestoreCommand = &cobra.Command{
+		Use:   "restore [organization-id]",
+		Short: "Restore a deleted organization",
+		Long: `Restore a deleted organization
+
+Deleted organizations can be restored until they are purged.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Restore(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	organizationsPurgeCommand = &cobra.Command{
+		Use:   "purge [organization-id]",
+		Short: "Purge a deleted organization",
+		Long: `Purge a deleted organization
+
+Purging permanently removes the organization and the data related to it. This can
+not be undone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orgID := getOrganizationID(cmd.Flags(), args)
+			if orgID == nil {
+				return errNoOrganizationID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewOrganizationRegistryClient(is).Purge(ctx, orgID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	organizationsContactInfoCommand = contactInfoCommands("organization", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		orgID := getOrganizationID(cmd.Flags(), args)
 		if orgID == nil {
@@ -249,6 +299,7 @@ func init() {
 	organizationsListCommand.Flags().AddFlagSet(collaboratorFlags())
 	organizationsListCommand.Flags().AddFlagSet(selectOrganizationFlags)
 	organizationsListCommand.Flags().AddFlagSet(paginationFlags())
+	organizationsListCommand.Flags().AddFlagSet(deletedFlags())
 	organizationsCommand.AddCommand(organizationsListCommand)
 	organizationsSearchCommand.Flags().AddFlagSet(searchFlags())
 	organizationsSearchCommand.Flags().AddFlagSet(selectOrganizationFlags)
@@ -267,6 +318,10 @@ func init() {
 	organizationsCommand.AddCommand(organizationsUpdateCommand)
 	organizationsDeleteCommand.Flags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationsDeleteCommand)
+	organizationsRestoreCommand.Flags().AddFlagSet(organizationIDFlags())
+	organizationsCommand.AddCommand(organizationsRestoreCommand)
+	organizationsPurgeCommand.Flags().AddFlagSet(organizationIDFlags())
+	organizationsCommand.AddCommand(organizationsPurgeCommand)
 	organizationsContactInfoCommand.PersistentFlags().AddFlagSet(organizationIDFlags())
 	organizationsCommand.AddCommand(organizationsContactInfoCommand)
 	Root.AddCommand(organizationsCommand)
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -190,6 +191,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -199,6 +204,8 @@ var (
 				OrganizationIdentifiers: *orgID,
 				Name:                    name,
 				Rights:                  rights,
+				ExpiresAt:               expiresAt,
+				AllowedCIDRs:            allowedCIDRs,
 			})
 			if err != nil {
 				return err
@@ -231,6 +238,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -239,10 +250,13 @@ var (
 			_, err = ttnpb.NewOrganizationAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateOrganizationAPIKeyRequest{
 				OrganizationIdentifiers: *orgID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiresAt,
+					AllowedCIDRs: allowedCIDRs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -275,6 +289,7 @@ var (
 					ID:     id,
 					Rights: nil,
 				},
+				FieldMask: types.FieldMask{Paths: []string{"rights"}},
 			})
 			if err != nil {
 				return err
@@ -311,10 +326,12 @@ func init() {
 	organizationAPIKeysList.Flags().AddFlagSet(paginationFlags())
 	organizationAPIKeys.AddCommand(organizationAPIKeysList)
 	organizationAPIKeysCreate.Flags().String("name", "", "")
+	organizationAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	organizationAPIKeysCreate.Flags().AddFlagSet(organizationRightsFlags)
 	organizationAPIKeys.AddCommand(organizationAPIKeysCreate)
 	organizationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	organizationAPIKeysUpdate.Flags().String("name", "", "")
+	organizationAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	organizationAPIKeysUpdate.Flags().AddFlagSet(organizationRightsFlags)
 	organizationAPIKeys.AddCommand(organizationAPIKeysUpdate)
 	organizationAPIKeysDelete.Flags().String("api-key-id", "", "")
@@ -0,0 +1,98 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var setQuotaFlags = util.FieldFlags(&ttnpb.QuotaOverride{})
+
+var errNoQuotaEntity = errors.DefineInvalidArgument("no_quota_entity", "no entity set")
+
+var (
+	quotaCommand = &cobra.Command{
+		Use:   "quota",
+		Short: "Quota commands",
+	}
+	quotaGetCommand = &cobra.Command{
+		Use:   "get",
+		Short: "Get the quota and usage of an entity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids := getCombinedIdentifiers(cmd.Flags()).GetEntityIdentifiers()
+			if len(ids) == 0 {
+				return errNoQuotaEntity
+			}
+			if len(ids) > 1 {
+				logger.Warn("multiple entities found in flags, considering only the first")
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewQuotaRegistryClient(is).Get(ctx, &ttnpb.GetQuotaRequest{
+				EntityIDs: *ids[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	quotaSetCommand = &cobra.Command{
+		Use:   "set",
+		Short: "Set the quota of a user or organization (admin only)",
+		Long: `Set the quota of a user or organization (admin only)
+
+Quotas that are not set use the default of the Identity Server. Quotas that are
+set to 0 are unlimited.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			collaborator := getCollaborator(cmd.Flags())
+			if collaborator == nil {
+				return errNoCollaborator
+			}
+			var quota ttnpb.QuotaOverride
+			if err := util.SetFields(&quota, setQuotaFlags); err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewQuotaRegistryClient(is).Set(ctx, &ttnpb.SetQuotaRequest{
+				OrganizationOrUserIdentifiers: *collaborator,
+				Quota:                         quota,
+			})
+			return err
+		},
+	}
+)
+
+func init() {
+	quotaGetCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
+	quotaCommand.AddCommand(quotaGetCommand)
+	quotaSetCommand.Flags().AddFlagSet(collaboratorFlags())
+	quotaSetCommand.Flags().AddFlagSet(setQuotaFlags)
+	quotaCommand.AddCommand(quotaSetCommand)
+	Root.AddCommand(quotaCommand)
+}
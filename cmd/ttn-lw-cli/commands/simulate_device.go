@@ -0,0 +1,300 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	simulation "go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/simulate"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errInvalidGatewayProtocol = errors.DefineInvalidArgument("gateway_protocol", "invalid gateway protocol `{protocol}`")
+	errNoGatewayEUI           = errors.DefineInvalidArgument("no_gateway_eui", "no gateway EUI set")
+)
+
+type simulateDeviceParams struct {
+	LoRaWANVersion    ttnpb.MACVersion `protobuf:"varint,1,opt,name=lorawan_version,proto3,enum=ttn.lorawan.v3.MACVersion" json:"lorawan_version"`
+	LoRaWANPHYVersion ttnpb.PHYVersion `protobuf:"varint,2,opt,name=lorawan_phy_version,proto3,enum=ttn.lorawan.v3.PHYVersion" json:"lorawan_phy_version"`
+	BandID            string           `protobuf:"bytes,3,opt,name=band_id,proto3" json:"band_id,omitempty"`
+	JoinEUI           types.EUI64      `protobuf:"bytes,4,opt,name=join_eui,proto3" json:"join_eui"`
+	DevEUI            types.EUI64      `protobuf:"bytes,5,opt,name=dev_eui,proto3" json:"dev_eui"`
+	DevNonce          types.DevNonce   `protobuf:"bytes,6,opt,name=dev_nonce,proto3" json:"dev_nonce"`
+	AppKey            types.AES128Key  `protobuf:"bytes,7,opt,name=app_key,proto3" json:"app_key"`
+	NwkKey            types.AES128Key  `protobuf:"bytes,8,opt,name=nwk_key,proto3" json:"nwk_key"`
+	DevAddr           types.DevAddr    `protobuf:"bytes,9,opt,name=dev_addr,proto3" json:"dev_addr"`
+	FNwkSIntKey       types.AES128Key  `protobuf:"bytes,10,opt,name=f_nwk_s_int_key,proto3" json:"f_nwk_s_int_key"`
+	SNwkSIntKey       types.AES128Key  `protobuf:"bytes,11,opt,name=s_nwk_s_int_key,proto3" json:"s_nwk_s_int_key"`
+	NwkSEncKey        types.AES128Key  `protobuf:"bytes,12,opt,name=nwk_s_enc_key,proto3" json:"nwk_s_enc_key"`
+	AppSKey           types.AES128Key  `protobuf:"bytes,13,opt,name=app_s_key,proto3" json:"app_s_key"`
+	FCnt              uint32           `protobuf:"varint,14,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	DataRateIndex     uint32           `protobuf:"varint,15,opt,name=data_rate_index,proto3" json:"data_rate_index,omitempty"`
+	ADR               bool             `protobuf:"varint,16,opt,name=adr,proto3" json:"adr,omitempty"`
+	RSSI              float32          `protobuf:"fixed32,17,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	SNR               float32          `protobuf:"fixed32,18,opt,name=snr,proto3" json:"snr,omitempty"`
+	Battery           uint32           `protobuf:"varint,19,opt,name=battery,proto3" json:"battery,omitempty"`
+	Confirmed         bool             `protobuf:"varint,20,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	FPort             uint32           `protobuf:"varint,21,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FRMPayload        []byte           `protobuf:"bytes,22,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+}
+
+var simulateDeviceFlags = util.FieldFlags(&simulateDeviceParams{})
+
+func simulateTrafficFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("protocol", "grpc", "gateway protocol (grpc|udp|basicstation)")
+	flagSet.String("udp-address", clusterHost+":1700", "Gateway Server Semtech UDP address")
+	flagSet.String("basic-station-address", "wss://"+clusterHost+":8887", "Gateway Server LoRa Basics Station LNS address")
+	flagSet.String("basic-station-auth", "", "authorization header for the LoRa Basics Station traffic endpoint")
+	flagSet.Int("join-attempts", 3, "maximum number of join-requests per device")
+	flagSet.Int("uplinks", 10, "number of data uplinks per device (0 is unlimited)")
+	flagSet.Duration("interval", 10*time.Second, "time between uplinks of a device")
+	flagSet.Duration("receive-timeout", 10*time.Second, "how long to wait for a downlink after an uplink")
+	return flagSet
+}
+
+func simulateFleetFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Int("devices", 10, "number of devices")
+	flagSet.Float64("rate", 0, "total uplinks per second of the fleet (overrides interval)")
+	return flagSet
+}
+
+func getSimulateDeviceParams() (*simulateDeviceParams, error) {
+	var params simulateDeviceParams
+	if err := util.SetFields(&params, simulateDeviceFlags); err != nil {
+		return nil, err
+	}
+	if params.BandID == "" {
+		params.BandID = band.EU_863_870
+	}
+	if params.LoRaWANVersion == ttnpb.MAC_UNKNOWN {
+		params.LoRaWANVersion = ttnpb.MAC_V1_0_2
+	}
+	if params.LoRaWANPHYVersion == ttnpb.PHY_UNKNOWN {
+		params.LoRaWANPHYVersion = ttnpb.PHY_V1_0_2_REV_B
+	}
+	if params.FPort == 0 {
+		params.FPort = 1
+	}
+	return &params, nil
+}
+
+// deviceConfig returns the configuration of the i-th device. The DevEUI and DevAddr are incremented by i.
+func (p *simulateDeviceParams) deviceConfig(i int, abp bool) simulation.DeviceConfig {
+	devEUI := p.DevEUI
+	devEUI.UnmarshalNumber(devEUI.MarshalNumber() + uint64(i))
+	conf := simulation.DeviceConfig{
+		LoRaWANVersion:    p.LoRaWANVersion,
+		LoRaWANPHYVersion: p.LoRaWANPHYVersion,
+		BandID:            p.BandID,
+		JoinEUI:           p.JoinEUI,
+		DevEUI:            devEUI,
+		DevNonce:          p.DevNonce,
+		AppKey:            p.AppKey,
+		NwkKey:            p.NwkKey,
+		DataRateIndex:     ttnpb.DataRateIndex(p.DataRateIndex),
+		ADR:               p.ADR,
+		RSSI:              p.RSSI,
+		SNR:               p.SNR,
+		Battery:           p.Battery,
+	}
+	if abp {
+		devAddr := p.DevAddr
+		devAddr.UnmarshalNumber(devAddr.MarshalNumber() + uint32(i))
+		conf.Session = &simulation.SessionConfig{
+			DevAddr:     devAddr,
+			FNwkSIntKey: p.FNwkSIntKey,
+			SNwkSIntKey: p.SNwkSIntKey,
+			NwkSEncKey:  p.NwkSEncKey,
+			AppSKey:     p.AppSKey,
+			FCntUp:      p.FCnt,
+		}
+	}
+	return conf
+}
+
+func getSimulateTrafficConfig(flagSet *pflag.FlagSet, params *simulateDeviceParams) simulation.TrafficConfig {
+	joinAttempts, _ := flagSet.GetInt("join-attempts")
+	uplinks, _ := flagSet.GetInt("uplinks")
+	interval, _ := flagSet.GetDuration("interval")
+	receiveTimeout, _ := flagSet.GetDuration("receive-timeout")
+	return simulation.TrafficConfig{
+		JoinAttempts:   joinAttempts,
+		Uplinks:        uplinks,
+		Interval:       interval,
+		ReceiveTimeout: receiveTimeout,
+		FPort:          params.FPort,
+		FRMPayload:     params.FRMPayload,
+		Confirmed:      params.Confirmed,
+	}
+}
+
+func newSimulatedGateway(ctx context.Context, flagSet *pflag.FlagSet, bandID string) (simulation.Gateway, error) {
+	protocol, _ := flagSet.GetString("protocol")
+	switch protocol {
+	case "grpc":
+		gtwID, err := getGatewayID(flagSet, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		return simulation.NewGRPCGateway(ctx, gs, *gtwID, logger)
+	case "udp", "basicstation":
+		gtwID, err := getGatewayID(flagSet, nil, false)
+		if err != nil {
+			return nil, err
+		}
+		if gtwID.EUI == nil {
+			return nil, errNoGatewayEUI
+		}
+		if protocol == "udp" {
+			address, _ := flagSet.GetString("udp-address")
+			return simulation.NewUDPGateway(ctx, address, *gtwID.EUI, logger)
+		}
+		address, _ := flagSet.GetString("basic-station-address")
+		auth, _ := flagSet.GetString("basic-station-auth")
+		return simulation.NewBasicStationGateway(ctx, address, *gtwID.EUI, bandID, auth, api.GetTLSConfig(), logger)
+	default:
+		return nil, errInvalidGatewayProtocol.WithAttributes("protocol", protocol)
+	}
+}
+
+func logSimulatedDownlink(dev *simulation.Device, down *simulation.Downlink) {
+	logger := logger.WithFields(log.Fields(
+		"dev_eui", dev.DevEUI(),
+		"dev_addr", down.DevAddr,
+		"m_type", down.MType,
+	))
+	if down.MType == ttnpb.MType_JOIN_ACCEPT {
+		logger.Info("Received join-accept")
+		return
+	}
+	logger = logger.WithFields(log.Fields(
+		"f_cnt", down.FCnt,
+		"ack", down.Ack,
+		"f_pending", down.FPending,
+	))
+	if down.FPort != 0 {
+		logger = logger.WithFields(log.Fields(
+			"f_port", down.FPort,
+			"frm_payload", down.FRMPayload,
+		))
+	}
+	for _, cmd := range down.MACCommands {
+		logger.WithField("cid", cmd.CID).WithField("payload", cmd.GetPayload()).Info("Received MAC command")
+	}
+	logger.Info("Received downlink")
+}
+
+func runSimulation(cmd *cobra.Command, params *simulateDeviceParams, devices int, run func(*simulation.Network, []*simulation.Device, *simulation.Stats)) error {
+	abp := cmd.Flags().Changed("dev-addr")
+	devs := make([]*simulation.Device, 0, devices)
+	for i := 0; i < devices; i++ {
+		dev, err := simulation.NewDevice(params.deviceConfig(i, abp), logger)
+		if err != nil {
+			return err
+		}
+		devs = append(devs, dev)
+	}
+
+	gtw, err := newSimulatedGateway(ctx, cmd.Flags(), params.BandID)
+	if err != nil {
+		return err
+	}
+	defer gtw.Close()
+
+	stats := &simulation.Stats{}
+	run(simulation.NewNetwork(gtw, logger), devs, stats)
+	return io.Write(os.Stdout, config.OutputFormat, stats.Summary())
+}
+
+var (
+	simulateDeviceCommand = &cobra.Command{
+		Use:   "device",
+		Short: "Simulate a stateful end device (EXPERIMENTAL)",
+		Long: `Simulate a stateful end device (EXPERIMENTAL)
+
+The device joins (unless the dev-addr and session keys are set), keeps track of
+frame counters, answers MAC commands and decrypts downlinks. It is connected to
+the Gateway Server through a simulated gateway that uses the gRPC, Semtech UDP
+or LoRa Basics Station protocol.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := getSimulateDeviceParams()
+			if err != nil {
+				return err
+			}
+			conf := getSimulateTrafficConfig(cmd.Flags(), params)
+			conf.HandleDownlink = logSimulatedDownlink
+			return runSimulation(cmd, params, 1, func(network *simulation.Network, devs []*simulation.Device, stats *simulation.Stats) {
+				if err := network.Run(ctx, devs[0], conf, stats); err != nil && ctx.Err() == nil {
+					logger.WithError(err).Error("Device simulation failed")
+				}
+			})
+		},
+	}
+	simulateFleetCommand = &cobra.Command{
+		Use:   "fleet",
+		Short: "Simulate a fleet of end devices (EXPERIMENTAL)",
+		Long: `Simulate a fleet of end devices (EXPERIMENTAL)
+
+The fleet consists of stateful simulated end devices that share the same keys.
+The DevEUI (and DevAddr for devices activated by personalization) of each device
+is incremented by its index in the fleet. When all devices are done, the join
+and downlink latency and the join-accept and acknowledgment loss are reported.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params, err := getSimulateDeviceParams()
+			if err != nil {
+				return err
+			}
+			conf := getSimulateTrafficConfig(cmd.Flags(), params)
+			devices, _ := cmd.Flags().GetInt("devices")
+			if rate, _ := cmd.Flags().GetFloat64("rate"); rate > 0 {
+				conf.Interval = time.Duration(float64(devices) / rate * float64(time.Second))
+			}
+			return runSimulation(cmd, params, devices, func(network *simulation.Network, devs []*simulation.Device, stats *simulation.Stats) {
+				network.RunFleet(ctx, devs, conf, stats)
+			})
+		},
+	}
+)
+
+func init() {
+	simulateDeviceCommand.Flags().AddFlagSet(gatewayIDFlags())
+	simulateDeviceCommand.Flags().AddFlagSet(simulateDeviceFlags)
+	simulateDeviceCommand.Flags().AddFlagSet(simulateTrafficFlags())
+	simulateCommand.AddCommand(simulateDeviceCommand)
+
+	simulateFleetCommand.Flags().AddFlagSet(gatewayIDFlags())
+	simulateFleetCommand.Flags().AddFlagSet(simulateDeviceFlags)
+	simulateFleetCommand.Flags().AddFlagSet(simulateTrafficFlags())
+	simulateFleetCommand.Flags().AddFlagSet(simulateFleetFlags())
+	simulateCommand.AddCommand(simulateFleetCommand)
+}
@@ -313,6 +313,55 @@ var (
 			return nil
 		},
 	}
+	usersRestoreCommand = &cobra.Command{
+		Use:   "restore [user-id]",
+		Short: "Restore a deleted user",
+		Long: `Restore a deleted user
+
+Deleted users can be restored until they are purged.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserRegistryClient(is).Restore(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	usersPurgeCommand = &cobra.Command{
+		Use:   "purge [user-id]",
+		Short: "Purge a deleted user",
+		Long: `Purge a deleted user
+
+Purging permanently removes the user and the data related to it. This can
+not be undone.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			usrID := getUserID(cmd.Flags(), args)
+			if usrID == nil {
+				return errNoUserID
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewUserRegistryClient(is).Purge(ctx, usrID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
 	usersContactInfoCommand = contactInfoCommands("user", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		usrID := getUserID(cmd.Flags(), args)
 		if usrID == nil {
@@ -352,6 +401,10 @@ func init() {
 	usersCommand.AddCommand(usersUpdatePasswordCommand)
 	usersDeleteCommand.Flags().AddFlagSet(userIDFlags())
 	usersCommand.AddCommand(usersDeleteCommand)
+	usersRestoreCommand.Flags().AddFlagSet(userIDFlags())
+	usersCommand.AddCommand(usersRestoreCommand)
+	usersPurgeCommand.Flags().AddFlagSet(userIDFlags())
+	usersCommand.AddCommand(usersPurgeCommand)
 	usersContactInfoCommand.PersistentFlags().AddFlagSet(userIDFlags())
 	usersCommand.AddCommand(usersContactInfoCommand)
 	Root.AddCommand(usersCommand)
@@ -18,6 +18,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
@@ -92,6 +93,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -101,6 +106,8 @@ var (
 				UserIdentifiers: *usrID,
 				Name:            name,
 				Rights:          rights,
+				ExpiresAt:       expiresAt,
+				AllowedCIDRs:    allowedCIDRs,
 			})
 			if err != nil {
 				return err
@@ -133,6 +140,10 @@ var (
 			if len(rights) == 0 {
 				return errNoAPIKeyRights
 			}
+			expiresAt, allowedCIDRs, err := getAPIKeyRestrictions(cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
 			if err != nil {
@@ -141,10 +152,13 @@ var (
 			_, err = ttnpb.NewUserAccessClient(is).UpdateAPIKey(ctx, &ttnpb.UpdateUserAPIKeyRequest{
 				UserIdentifiers: *usrID,
 				APIKey: ttnpb.APIKey{
-					ID:     id,
-					Name:   name,
-					Rights: rights,
+					ID:           id,
+					Name:         name,
+					Rights:       rights,
+					ExpiresAt:    expiresAt,
+					AllowedCIDRs: allowedCIDRs,
 				},
+				FieldMask: types.FieldMask{Paths: getAPIKeyUpdatePaths(cmd.Flags())},
 			})
 			if err != nil {
 				return err
@@ -177,6 +191,7 @@ var (
 					ID:     id,
 					Rights: nil,
 				},
+				FieldMask: types.FieldMask{Paths: []string{"rights"}},
 			})
 			if err != nil {
 				return err
@@ -203,10 +218,12 @@ func init() {
 	userAPIKeysList.Flags().AddFlagSet(paginationFlags())
 	userAPIKeys.AddCommand(userAPIKeysList)
 	userAPIKeysCreate.Flags().String("name", "", "")
+	userAPIKeysCreate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	userAPIKeysCreate.Flags().AddFlagSet(userRightsFlags)
 	userAPIKeys.AddCommand(userAPIKeysCreate)
 	userAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	userAPIKeysUpdate.Flags().String("name", "", "")
+	userAPIKeysUpdate.Flags().AddFlagSet(apiKeyRestrictionFlags())
 	userAPIKeysUpdate.Flags().AddFlagSet(userRightsFlags)
 	userAPIKeys.AddCommand(userAPIKeysUpdate)
 	userAPIKeysDelete.Flags().String("api-key-id", "", "")
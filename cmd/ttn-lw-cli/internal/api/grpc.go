@@ -61,6 +61,11 @@ func AddCA(pemBytes []byte) (err error) {
 	return nil
 }
 
+// GetTLSConfig returns the TLS configuration for non-gRPC connections.
+func GetTLSConfig() *tls.Config {
+	return tlsConfig
+}
+
 // SetAuth sets the authentication information.
 func SetAuth(authType, authValue string) {
 	auth = &rpcmetadata.MD{
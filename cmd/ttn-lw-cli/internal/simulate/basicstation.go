@@ -0,0 +1,211 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/pkg/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	gsio "go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstationlns/messages"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var errDiscover = errors.DefineUnavailable("discover", "failed to discover traffic endpoint: {message}")
+
+type basicStationGateway struct {
+	eui       types.EUI64
+	ids       ttnpb.GatewayIdentifiers
+	bandID    string
+	clock     concentratorClock
+	cancel    context.CancelFunc
+	downlinks chan *ttnpb.DownlinkMessage
+
+	writeMu sync.Mutex
+	ws      *websocket.Conn
+}
+
+// NewBasicStationGateway connects a simulated gateway to the Gateway Server using the LoRa Basics Station LNS protocol.
+// The address is the websocket URL of the LNS endpoint, for example wss://localhost:8887.
+// If auth is set, it is used as the Authorization header of the traffic endpoint.
+func NewBasicStationGateway(ctx context.Context, address string, eui types.EUI64, bandID, auth string, tlsConfig *tls.Config, logger log.Interface) (Gateway, error) {
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:  tlsConfig,
+	}
+
+	discover, _, err := dialer.DialContext(ctx, strings.TrimSuffix(address, "/")+"/router-info", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer discover.Close()
+	if err := discover.WriteJSON(messages.DiscoverQuery{EUI: basicstation.EUI{EUI64: eui}}); err != nil {
+		return nil, err
+	}
+	var res messages.DiscoverResponse
+	if err := discover.ReadJSON(&res); err != nil {
+		return nil, err
+	}
+	if res.Error != "" {
+		return nil, errDiscover.WithAttributes("message", res.Error)
+	}
+
+	header := http.Header{}
+	if auth != "" {
+		header.Set("Authorization", auth)
+	}
+	ws, _, err := dialer.DialContext(ctx, res.URI, header)
+	if err != nil {
+		return nil, err
+	}
+	version, err := messages.Version{
+		Station:  "ttn-lw-cli",
+		Firmware: "simulated",
+		Package:  "simulated",
+		Model:    "simulated",
+		Protocol: 2,
+	}.MarshalJSON()
+	if err != nil {
+		ws.Close()
+		return nil, err
+	}
+	if err := ws.WriteMessage(websocket.TextMessage, version); err != nil {
+		ws.Close()
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	gtw := &basicStationGateway{
+		eui:       eui,
+		ids:       ttnpb.GatewayIdentifiers{EUI: &eui},
+		bandID:    bandID,
+		clock:     concentratorClock(time.Now()),
+		cancel:    cancel,
+		downlinks: make(chan *ttnpb.DownlinkMessage, 16),
+		ws:        ws,
+	}
+	go func() {
+		defer close(gtw.downlinks)
+		for {
+			_, data, err := ws.ReadMessage()
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.WithError(err).Warn("Failed to read message")
+				}
+				return
+			}
+			typ, err := messages.Type(data)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to parse message type")
+				continue
+			}
+			if typ != messages.TypeDownstreamDownlinkMessage {
+				logger.WithField("message_type", typ).Debug("Received message")
+				continue
+			}
+			var dnmsg messages.DownlinkMessage
+			if err := json.Unmarshal(data, &dnmsg); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal downlink message")
+				continue
+			}
+			down, err := gtw.toDownlinkMessage(dnmsg)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to convert downlink message")
+				continue
+			}
+			if err := gtw.write(messages.TxConfirmation{
+				Diid:   dnmsg.Diid,
+				RCtx:   dnmsg.RCtx,
+				XTime:  dnmsg.XTime,
+				TxTime: float64(time.Now().UnixNano()) / float64(time.Second),
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to send Tx confirmation")
+			}
+			select {
+			case gtw.downlinks <- down:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return gtw, nil
+}
+
+func (g *basicStationGateway) toDownlinkMessage(dnmsg messages.DownlinkMessage) (*ttnpb.DownlinkMessage, error) {
+	rawPayload, err := hex.DecodeString(dnmsg.Pdu)
+	if err != nil {
+		return nil, err
+	}
+	down := dnmsg.ToDownlinkMessage()
+	down.RawPayload = rawPayload
+	// The lower 48 bits of xtime are the concentrator time in microseconds of the transmission minus RxDelay.
+	down.GetScheduled().Timestamp = uint32(dnmsg.XTime&(1<<48-1) + int64(dnmsg.RxDelay)*int64(time.Second/time.Microsecond))
+	return &down, nil
+}
+
+func (g *basicStationGateway) write(msg json.Marshaler) error {
+	data, err := msg.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	g.writeMu.Lock()
+	defer g.writeMu.Unlock()
+	return g.ws.WriteMessage(websocket.TextMessage, data)
+}
+
+// SendUplink implements Gateway.
+func (g *basicStationGateway) SendUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	setMetadata(up, g.ids, g.clock, time.Now())
+	for _, md := range up.RxMetadata {
+		md.UplinkToken = gsio.MustUplinkToken(ttnpb.GatewayAntennaIdentifiers{GatewayIdentifiers: g.ids}, md.Timestamp)
+	}
+	switch up.Payload.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		var jreq messages.JoinRequest
+		if err := jreq.FromUplinkMessage(up, g.bandID); err != nil {
+			return err
+		}
+		return g.write(jreq)
+	default:
+		var updf messages.UplinkDataFrame
+		if err := updf.FromUplinkMessage(up, g.bandID); err != nil {
+			return err
+		}
+		return g.write(updf)
+	}
+}
+
+// Downlinks implements Gateway.
+func (g *basicStationGateway) Downlinks() <-chan *ttnpb.DownlinkMessage {
+	return g.downlinks
+}
+
+// Close implements Gateway.
+func (g *basicStationGateway) Close() error {
+	g.cancel()
+	return g.ws.Close()
+}
@@ -0,0 +1,666 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"bytes"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errInvalidMACVersion = errors.DefineInvalidArgument("mac_version", "invalid LoRaWAN version")
+	errInvalidPHYVersion = errors.DefineInvalidArgument("phy_version", "invalid LoRaWAN PHY version")
+	errNoSession         = errors.DefineFailedPrecondition("no_session", "device has no session")
+	errPersonalized      = errors.DefineFailedPrecondition("personalized", "device is activated by personalization")
+	errNoChannel         = errors.DefineFailedPrecondition("no_channel", "no enabled channel for data rate `{data_rate_index}`")
+	errDownlinkMIC       = errors.DefineInvalidArgument("downlink_mic", "invalid downlink MIC")
+)
+
+// maxFOptsLength is the maximum length of the FOpts field.
+const maxFOptsLength = 15
+
+// SessionConfig is the configuration of a session of a device activated by personalization.
+type SessionConfig struct {
+	DevAddr     types.DevAddr
+	FNwkSIntKey types.AES128Key
+	SNwkSIntKey types.AES128Key
+	NwkSEncKey  types.AES128Key
+	AppSKey     types.AES128Key
+	FCntUp      uint32
+}
+
+// DeviceConfig is the configuration of a simulated end device.
+type DeviceConfig struct {
+	LoRaWANVersion    ttnpb.MACVersion
+	LoRaWANPHYVersion ttnpb.PHYVersion
+	BandID            string
+
+	JoinEUI  types.EUI64
+	DevEUI   types.EUI64
+	DevNonce types.DevNonce
+	AppKey   types.AES128Key
+	NwkKey   types.AES128Key
+
+	// Session activates the device by personalization, if set.
+	Session *SessionConfig
+
+	DataRateIndex ttnpb.DataRateIndex
+	ADR           bool
+
+	// RSSI and SNR are reported in the metadata of the uplink messages.
+	RSSI float32
+	SNR  float32
+	// Battery is reported in DevStatusAns.
+	Battery uint32
+}
+
+type session struct {
+	macVersion  ttnpb.MACVersion
+	devAddr     types.DevAddr
+	fNwkSIntKey types.AES128Key
+	sNwkSIntKey types.AES128Key
+	nwkSEncKey  types.AES128Key
+	appSKey     types.AES128Key
+
+	fCntUp    uint32
+	nFCntDown uint32
+	aFCntDown uint32
+}
+
+type channel struct {
+	uplinkFrequency   uint64
+	downlinkFrequency uint64
+	minDataRateIndex  ttnpb.DataRateIndex
+	maxDataRateIndex  ttnpb.DataRateIndex
+	enableUplink      bool
+}
+
+// Downlink is a downlink message processed by a Device.
+type Downlink struct {
+	MType ttnpb.MType
+	// DevAddr is the address assigned in the join-accept.
+	DevAddr     types.DevAddr
+	FCnt        uint32
+	FPort       uint32
+	FRMPayload  []byte
+	Ack         bool
+	FPending    bool
+	MACCommands []*ttnpb.MACCommand
+}
+
+// Device is a stateful simulated LoRaWAN end device.
+// It keeps track of the session, frame counters and MAC state, and answers MAC commands sent by the Network Server.
+// Device is safe for concurrent use.
+type Device struct {
+	conf   DeviceConfig
+	phy    band.Band
+	logger log.Interface
+
+	mu sync.Mutex
+
+	devNonce    types.DevNonce
+	pendingJoin *ttnpb.JoinRequestPayload
+	session     *session
+
+	channels          []*channel
+	nextChannel       int
+	dataRateIndex     ttnpb.DataRateIndex
+	txPowerIndex      uint32
+	nbTrans           uint32
+	rx1DataRateOffset uint32
+	rx1Delay          ttnpb.RxDelay
+	rx2DataRateIndex  ttnpb.DataRateIndex
+	rx2Frequency      uint64
+	adrAckCnt         uint32
+	adrAckLimit       uint32
+	adrAckDelay       uint32
+
+	lastFCntUp        uint32
+	awaitingAck       bool
+	pendingAck        bool
+	lastConfFCntDown  uint32
+	pendingMACAnswers []*ttnpb.MACCommand
+	rekeyIndPending   bool
+	resetIndPending   bool
+}
+
+// NewDevice returns a new simulated end device.
+func NewDevice(conf DeviceConfig, logger log.Interface) (*Device, error) {
+	if err := conf.LoRaWANVersion.Validate(); err != nil {
+		return nil, errInvalidMACVersion.WithCause(err)
+	}
+	if err := conf.LoRaWANPHYVersion.Validate(); err != nil {
+		return nil, errInvalidPHYVersion.WithCause(err)
+	}
+	phy, err := band.GetByID(conf.BandID)
+	if err != nil {
+		return nil, err
+	}
+	phy, err = phy.Version(conf.LoRaWANPHYVersion)
+	if err != nil {
+		return nil, err
+	}
+	d := &Device{
+		conf:     conf,
+		phy:      phy,
+		logger:   logger,
+		devNonce: conf.DevNonce,
+	}
+	d.resetMACState()
+	if s := conf.Session; s != nil {
+		d.session = &session{
+			macVersion:  conf.LoRaWANVersion,
+			devAddr:     s.DevAddr,
+			fNwkSIntKey: s.FNwkSIntKey,
+			sNwkSIntKey: s.SNwkSIntKey,
+			nwkSEncKey:  s.NwkSEncKey,
+			appSKey:     s.AppSKey,
+			fCntUp:      s.FCntUp,
+		}
+		if conf.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
+			// LoRaWAN 1.0.x uses a single NwkSKey.
+			d.session.sNwkSIntKey, d.session.nwkSEncKey = s.FNwkSIntKey, s.FNwkSIntKey
+		} else {
+			d.resetIndPending = true
+		}
+	}
+	return d, nil
+}
+
+// DevEUI returns the DevEUI of the device.
+func (d *Device) DevEUI() types.EUI64 {
+	return d.conf.DevEUI
+}
+
+// DevAddr returns the DevAddr of the current session of the device, if any.
+func (d *Device) DevAddr() (types.DevAddr, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.session == nil {
+		return types.DevAddr{}, false
+	}
+	return d.session.devAddr, true
+}
+
+// Activated returns whether the device has a session.
+func (d *Device) Activated() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.session != nil
+}
+
+func (d *Device) resetMACState() {
+	d.channels = make([]*channel, 0, len(d.phy.UplinkChannels))
+	for i, ch := range d.phy.UplinkChannels {
+		dlFreq := ch.Frequency
+		if rx1Ch, err := d.phy.Rx1Channel(uint8(i)); err == nil && int(rx1Ch) < len(d.phy.DownlinkChannels) {
+			dlFreq = d.phy.DownlinkChannels[rx1Ch].Frequency
+		}
+		d.channels = append(d.channels, &channel{
+			uplinkFrequency:   ch.Frequency,
+			downlinkFrequency: dlFreq,
+			minDataRateIndex:  ch.MinDataRate,
+			maxDataRateIndex:  ch.MaxDataRate,
+			enableUplink:      true,
+		})
+	}
+	d.nextChannel = 0
+	d.dataRateIndex = d.conf.DataRateIndex
+	d.txPowerIndex = 0
+	d.nbTrans = 1
+	d.rx1DataRateOffset = 0
+	d.rx1Delay = ttnpb.RX_DELAY_1
+	d.rx2DataRateIndex = d.phy.DefaultRx2Parameters.DataRateIndex
+	d.rx2Frequency = d.phy.DefaultRx2Parameters.Frequency
+	d.adrAckCnt = 0
+	d.adrAckLimit = uint32(d.phy.ADRAckLimit)
+	d.adrAckDelay = uint32(d.phy.ADRAckDelay)
+	d.awaitingAck = false
+	d.pendingAck = false
+	d.pendingMACAnswers = nil
+}
+
+// uplinkChannel selects the next enabled channel supporting the data rate, round-robin.
+func (d *Device) uplinkChannel(drIdx ttnpb.DataRateIndex) (int, *channel, error) {
+	for i := 0; i < len(d.channels); i++ {
+		idx := (d.nextChannel + i) % len(d.channels)
+		ch := d.channels[idx]
+		if ch == nil || !ch.enableUplink || drIdx < ch.minDataRateIndex || drIdx > ch.maxDataRateIndex {
+			continue
+		}
+		d.nextChannel = idx + 1
+		return idx, ch, nil
+	}
+	return 0, nil, errNoChannel.WithAttributes("data_rate_index", drIdx)
+}
+
+func (d *Device) newUplink(msg *ttnpb.Message, rawPayload []byte, drIdx ttnpb.DataRateIndex, ch *channel) *ttnpb.UplinkMessage {
+	settings := ttnpb.TxSettings{
+		DataRate:      d.phy.DataRates[drIdx].Rate,
+		DataRateIndex: drIdx,
+		Frequency:     ch.uplinkFrequency,
+	}
+	if settings.DataRate.GetLoRa() != nil {
+		settings.CodingRate = d.phy.LoRaCodingRate
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: rawPayload,
+		Payload:    msg,
+		Settings:   settings,
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				RSSI: d.conf.RSSI,
+				SNR:  d.conf.SNR,
+			},
+		},
+	}
+}
+
+// JoinRequest returns a new join-request message.
+// The device awaits the join-accept until the next call to JoinRequest.
+func (d *Device) JoinRequest() (*ttnpb.UplinkMessage, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.conf.Session != nil {
+		return nil, errPersonalized
+	}
+	d.resetMACState()
+	_, ch, err := d.uplinkChannel(d.dataRateIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	pld := &ttnpb.JoinRequestPayload{
+		JoinEUI:  d.conf.JoinEUI,
+		DevEUI:   d.conf.DevEUI,
+		DevNonce: d.devNonce,
+	}
+	msg := &ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: pld,
+		},
+	}
+	b, err := lorawan.MarshalMessage(*msg)
+	if err != nil {
+		return nil, err
+	}
+	key := d.conf.AppKey
+	if d.conf.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		key = d.conf.NwkKey
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(key, b)
+	if err != nil {
+		return nil, err
+	}
+	msg.MIC = mic[:]
+
+	d.pendingJoin = pld
+	n := uint16(d.devNonce[0])<<8 | uint16(d.devNonce[1])
+	n++
+	d.devNonce = types.DevNonce{byte(n >> 8), byte(n)}
+
+	return d.newUplink(msg, append(b, mic[:]...), d.dataRateIndex, ch), nil
+}
+
+// DataUplink returns a new data uplink message.
+// Pending MAC command answers are included in FOpts, as far as they fit.
+func (d *Device) DataUplink(fPort uint32, frmPayload []byte, confirmed bool) (*ttnpb.UplinkMessage, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	s := d.session
+	if s == nil {
+		return nil, errNoSession
+	}
+	chIdx, ch, err := d.uplinkChannel(d.dataRateIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	var indications []*ttnpb.MACCommand
+	if d.rekeyIndPending {
+		indications = append(indications, (&ttnpb.MACCommand_RekeyInd{MinorVersion: ttnpb.MINOR_1}).MACCommand())
+	}
+	if d.resetIndPending {
+		indications = append(indications, (&ttnpb.MACCommand_ResetInd{MinorVersion: ttnpb.MINOR_1}).MACCommand())
+	}
+	var fOpts []byte
+	var n int
+	for _, cmd := range append(indications, d.pendingMACAnswers...) {
+		b, err := lorawan.DefaultMACCommands.AppendUplink(d.phy, fOpts, *cmd)
+		if err != nil {
+			return nil, err
+		}
+		if len(b) > maxFOptsLength {
+			break
+		}
+		fOpts = b
+		n++
+	}
+	if n > len(indications) {
+		d.pendingMACAnswers = d.pendingMACAnswers[n-len(indications):]
+	}
+
+	fCnt := s.fCntUp
+	if len(fOpts) > 0 && s.macVersion.EncryptFOpts() {
+		if fOpts, err = crypto.EncryptUplink(s.nwkSEncKey, s.devAddr, fCnt, fOpts); err != nil {
+			return nil, err
+		}
+	}
+	key := s.appSKey
+	if fPort == 0 {
+		key = s.nwkSEncKey
+	}
+	if frmPayload, err = crypto.EncryptUplink(key, s.devAddr, fCnt, frmPayload); err != nil {
+		return nil, err
+	}
+
+	msg := &ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_UP,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: s.devAddr,
+					FCtrl: ttnpb.FCtrl{
+						ADR:       d.conf.ADR,
+						ADRAckReq: d.conf.ADR && d.adrAckCnt >= d.adrAckLimit,
+						Ack:       d.pendingAck,
+					},
+					FCnt:  fCnt,
+					FOpts: fOpts,
+				},
+				FPort:      fPort,
+				FRMPayload: frmPayload,
+			},
+		},
+	}
+	if confirmed {
+		msg.MType = ttnpb.MType_CONFIRMED_UP
+	}
+	b, err := lorawan.MarshalMessage(*msg)
+	if err != nil {
+		return nil, err
+	}
+	var mic [4]byte
+	if s.macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		var confFCnt uint32
+		if d.pendingAck {
+			confFCnt = d.lastConfFCntDown
+		}
+		mic, err = crypto.ComputeUplinkMIC(s.sNwkSIntKey, s.fNwkSIntKey, confFCnt, uint8(d.dataRateIndex), uint8(chIdx), s.devAddr, fCnt, b)
+	} else {
+		mic, err = crypto.ComputeLegacyUplinkMIC(s.fNwkSIntKey, s.devAddr, fCnt, b)
+	}
+	if err != nil {
+		return nil, err
+	}
+	msg.MIC = mic[:]
+	up := d.newUplink(msg, append(b, mic[:]...), d.dataRateIndex, ch)
+
+	s.fCntUp++
+	d.lastFCntUp = fCnt
+	d.awaitingAck = confirmed
+	d.pendingAck = false
+	if d.conf.ADR {
+		d.adrAckCnt++
+		if d.adrAckCnt >= d.adrAckLimit+d.adrAckDelay && d.adrAckDelay > 0 && (d.adrAckCnt-d.adrAckLimit)%d.adrAckDelay == 0 {
+			// Fall back to a lower data rate when the network does not respond to ADRAckReq.
+			d.txPowerIndex = 0
+			if d.dataRateIndex > 0 {
+				d.dataRateIndex--
+			}
+		}
+	}
+	return up, nil
+}
+
+// HandleDownlink processes the downlink message.
+// HandleDownlink returns nil without an error if the downlink is not destined for the device.
+func (d *Device) HandleDownlink(down *ttnpb.DownlinkMessage) (*Downlink, error) {
+	var msg ttnpb.Message
+	if err := lorawan.UnmarshalMessage(down.RawPayload, &msg); err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	switch msg.MType {
+	case ttnpb.MType_JOIN_ACCEPT:
+		return d.handleJoinAccept(down.RawPayload, &msg)
+	case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+		return d.handleDataDownlink(down.RawPayload, &msg)
+	}
+	return nil, nil
+}
+
+func (d *Device) handleJoinAccept(rawPayload []byte, msg *ttnpb.Message) (*Downlink, error) {
+	req := d.pendingJoin
+	if req == nil {
+		return nil, nil
+	}
+	is1_1 := d.conf.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) >= 0
+	key := d.conf.AppKey
+	if is1_1 {
+		key = d.conf.NwkKey
+	}
+	pld := msg.GetJoinAcceptPayload()
+	b, err := crypto.DecryptJoinAccept(key, pld.Encrypted)
+	if err != nil {
+		return nil, err
+	}
+	joinAcceptBytes, micBytes := b[:len(b)-4], b[len(b)-4:]
+	if err := lorawan.UnmarshalJoinAcceptPayload(joinAcceptBytes, pld); err != nil {
+		// The join-accept is encrypted with another key, so it is not for this device.
+		return nil, nil
+	}
+	var expectedMIC [4]byte
+	if is1_1 && pld.OptNeg {
+		expectedMIC, err = crypto.ComputeJoinAcceptMIC(
+			crypto.DeriveJSIntKey(key, req.DevEUI),
+			0xff,
+			req.JoinEUI,
+			req.DevNonce,
+			append([]byte{rawPayload[0]}, joinAcceptBytes...),
+		)
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyJoinAcceptMIC(key, append([]byte{rawPayload[0]}, joinAcceptBytes...))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(micBytes, expectedMIC[:]) {
+		return nil, nil
+	}
+
+	s := &session{
+		devAddr: pld.DevAddr,
+	}
+	if is1_1 && pld.OptNeg {
+		s.macVersion = ttnpb.MAC_V1_1
+		s.appSKey = crypto.DeriveAppSKey(d.conf.AppKey, pld.JoinNonce, req.JoinEUI, req.DevNonce)
+		s.fNwkSIntKey = crypto.DeriveFNwkSIntKey(d.conf.NwkKey, pld.JoinNonce, req.JoinEUI, req.DevNonce)
+		s.sNwkSIntKey = crypto.DeriveSNwkSIntKey(d.conf.NwkKey, pld.JoinNonce, req.JoinEUI, req.DevNonce)
+		s.nwkSEncKey = crypto.DeriveNwkSEncKey(d.conf.NwkKey, pld.JoinNonce, req.JoinEUI, req.DevNonce)
+	} else {
+		s.macVersion = d.conf.LoRaWANVersion
+		if is1_1 {
+			// The Join Server does not support LoRaWAN 1.1; fall back to LoRaWAN 1.0.3.
+			s.macVersion = ttnpb.MAC_V1_0_3
+		}
+		nwkSKey := crypto.DeriveLegacyNwkSKey(key, pld.JoinNonce, pld.NetID, req.DevNonce)
+		s.appSKey = crypto.DeriveLegacyAppSKey(key, pld.JoinNonce, pld.NetID, req.DevNonce)
+		s.fNwkSIntKey, s.sNwkSIntKey, s.nwkSEncKey = nwkSKey, nwkSKey, nwkSKey
+	}
+	d.session = s
+	d.pendingJoin = nil
+	d.rekeyIndPending = s.macVersion.Compare(ttnpb.MAC_V1_1) >= 0
+
+	d.rx1DataRateOffset = pld.DLSettings.Rx1DROffset
+	d.rx2DataRateIndex = pld.DLSettings.Rx2DR
+	if pld.RxDelay != 0 {
+		d.rx1Delay = pld.RxDelay
+	}
+	if cfList := pld.CFList; cfList != nil {
+		switch cfList.Type {
+		case ttnpb.CFListType_FREQUENCIES:
+			for _, freq := range cfList.Freq {
+				if freq == 0 {
+					continue
+				}
+				d.channels = append(d.channels, &channel{
+					uplinkFrequency:   uint64(freq) * 100,
+					downlinkFrequency: uint64(freq) * 100,
+					maxDataRateIndex:  ttnpb.DataRateIndex(d.phy.MaxADRDataRateIndex),
+					enableUplink:      true,
+				})
+			}
+		case ttnpb.CFListType_CHANNEL_MASKS:
+			for i, ch := range d.channels {
+				if i < len(cfList.ChMasks) {
+					ch.enableUplink = cfList.ChMasks[i]
+				}
+			}
+		}
+	}
+
+	d.logger.WithFields(log.Fields(
+		"dev_eui", d.conf.DevEUI,
+		"dev_addr", s.devAddr,
+		"mac_version", s.macVersion,
+	)).Debug("Joined")
+
+	return &Downlink{
+		MType:   ttnpb.MType_JOIN_ACCEPT,
+		DevAddr: s.devAddr,
+	}, nil
+}
+
+// fullFCnt returns the 32-bit frame counter closest to last that matches the 16 least significant bits in fCnt.
+func fullFCnt(fCnt, last uint32) uint32 {
+	full := last&^0xffff | fCnt&0xffff
+	if full < last {
+		full += 0x10000
+	}
+	return full
+}
+
+func (d *Device) handleDataDownlink(rawPayload []byte, msg *ttnpb.Message) (*Downlink, error) {
+	s := d.session
+	pld := msg.GetMACPayload()
+	if s == nil || pld.DevAddr != s.devAddr {
+		return nil, nil
+	}
+
+	isAppDown := pld.FPort != 0 && s.macVersion.Compare(ttnpb.MAC_V1_1) >= 0
+	lastFCnt := s.nFCntDown
+	if isAppDown {
+		lastFCnt = s.aFCntDown
+	}
+	fCnt := fullFCnt(pld.FCnt, lastFCnt)
+
+	var expectedMIC [4]byte
+	var err error
+	if s.macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		var confFCnt uint32
+		if pld.Ack {
+			confFCnt = d.lastFCntUp
+		}
+		expectedMIC, err = crypto.ComputeDownlinkMIC(s.sNwkSIntKey, s.devAddr, confFCnt, fCnt, rawPayload[:len(rawPayload)-4])
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyDownlinkMIC(s.fNwkSIntKey, s.devAddr, fCnt, rawPayload[:len(rawPayload)-4])
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(msg.MIC, expectedMIC[:]) {
+		return nil, errDownlinkMIC
+	}
+
+	if isAppDown {
+		s.aFCntDown = fCnt
+	} else {
+		s.nFCntDown = fCnt
+	}
+	d.adrAckCnt = 0
+
+	res := &Downlink{
+		MType:    msg.MType,
+		DevAddr:  s.devAddr,
+		FCnt:     fCnt,
+		FPort:    pld.FPort,
+		Ack:      pld.Ack && d.awaitingAck,
+		FPending: pld.FPending,
+	}
+	if res.Ack {
+		d.awaitingAck = false
+	}
+	if msg.MType == ttnpb.MType_CONFIRMED_DOWN {
+		d.pendingAck = true
+		d.lastConfFCntDown = fCnt
+	}
+
+	macBuf := pld.FOpts
+	if len(macBuf) > 0 && s.macVersion.EncryptFOpts() {
+		if macBuf, err = crypto.DecryptDownlink(s.nwkSEncKey, s.devAddr, s.nFCntDown, macBuf); err != nil {
+			return nil, err
+		}
+	}
+	if len(pld.FRMPayload) > 0 {
+		key := s.appSKey
+		if pld.FPort == 0 {
+			key = s.nwkSEncKey
+		}
+		frmPayload, err := crypto.DecryptDownlink(key, s.devAddr, fCnt, pld.FRMPayload)
+		if err != nil {
+			return nil, err
+		}
+		if pld.FPort == 0 {
+			macBuf = frmPayload
+		} else {
+			res.FRMPayload = frmPayload
+		}
+	}
+
+	for r := bytes.NewReader(macBuf); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		if err := lorawan.DefaultMACCommands.ReadDownlink(d.phy, r, cmd); err != nil {
+			d.logger.WithFields(log.Fields(
+				"bytes_left", r.Len(),
+				"mac_count", len(res.MACCommands),
+			)).WithError(err).Warn("Failed to unmarshal MAC command")
+			break
+		}
+		res.MACCommands = append(res.MACCommands, cmd)
+	}
+	d.handleMACCommands(res.MACCommands)
+	return res, nil
+}
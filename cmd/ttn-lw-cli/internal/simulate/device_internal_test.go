@@ -0,0 +1,318 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var (
+	testKey      = types.AES128Key{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	testOtherKey = types.AES128Key{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2}
+	testDevAddr  = types.DevAddr{1, 2, 3, 4}
+	testJoinEUI  = types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	testDevEUI   = types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	testDevNonce = types.DevNonce{0x00, 0x01}
+)
+
+func TestFullFCnt(t *testing.T) {
+	for _, tc := range []struct {
+		FCnt,
+		Last,
+		Expected uint32
+	}{
+		{FCnt: 0, Last: 0, Expected: 0},
+		{FCnt: 5, Last: 3, Expected: 5},
+		{FCnt: 3, Last: 5, Expected: 0x10003},
+		{FCnt: 0x0002, Last: 0xffff, Expected: 0x10002},
+		{FCnt: 0x0010, Last: 0x10000, Expected: 0x10010},
+		{FCnt: 0xfffe, Last: 0x1fffe, Expected: 0x1fffe},
+		{FCnt: 0x0001, Last: 0x1fffe, Expected: 0x20001},
+	} {
+		t.Run(fmt.Sprintf("%d/%d", tc.FCnt, tc.Last), func(t *testing.T) {
+			assertions.New(t).So(fullFCnt(tc.FCnt, tc.Last), should.Equal, tc.Expected)
+		})
+	}
+}
+
+func newJoinAccept(t *testing.T, key types.AES128Key, pld ttnpb.JoinAcceptPayload) []byte {
+	b, err := lorawan.MarshalJoinAcceptPayload(pld)
+	if err != nil {
+		t.Fatalf("Failed to marshal join-accept payload: %s", err)
+	}
+	mic, err := crypto.ComputeJoinAcceptMIC(crypto.DeriveJSIntKey(key, testDevEUI), 0xff, testJoinEUI, testDevNonce, append([]byte{0x20}, b...))
+	if err != nil {
+		t.Fatalf("Failed to compute join-accept MIC: %s", err)
+	}
+	enc, err := crypto.EncryptJoinAccept(key, append(b, mic[:]...))
+	if err != nil {
+		t.Fatalf("Failed to encrypt join-accept: %s", err)
+	}
+	return append([]byte{0x20}, enc...)
+}
+
+func TestHandleJoinAccept(t *testing.T) {
+	// Join-accept from the pkg/crypto test vectors, encrypted with testKey.
+	legacyJoinAccept := []byte{
+		0x20, // JoinAccept
+		0xC9, 0xFB, 0xB2, 0x59, 0xE1, 0x16, 0x49, 0x09, 0x6A, 0x56, 0x8A, 0x9E, 0x3B, 0x71, 0x17, 0xC3,
+	}
+	legacyNwkSKey := crypto.DeriveLegacyNwkSKey(testKey, types.JoinNonce{1, 2, 3}, types.NetID{1, 2, 3}, testDevNonce)
+	legacyAppSKey := crypto.DeriveLegacyAppSKey(testKey, types.JoinNonce{1, 2, 3}, types.NetID{1, 2, 3}, testDevNonce)
+
+	for _, tc := range []struct {
+		Name             string
+		MACVersion       ttnpb.MACVersion
+		AppKey           types.AES128Key
+		NwkKey           types.AES128Key
+		NoJoinRequest    bool
+		JoinAccept       func(*testing.T) []byte
+		ExpectedDownlink *Downlink
+		ExpectedSession  *session
+		ExpectedRx1Delay ttnpb.RxDelay
+	}{
+		{
+			Name:       "1.0.3/Vector",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			AppKey:     testKey,
+			JoinAccept: func(*testing.T) []byte { return legacyJoinAccept },
+			ExpectedDownlink: &Downlink{
+				MType:   ttnpb.MType_JOIN_ACCEPT,
+				DevAddr: testDevAddr,
+			},
+			ExpectedSession: &session{
+				macVersion:  ttnpb.MAC_V1_0_3,
+				devAddr:     testDevAddr,
+				fNwkSIntKey: legacyNwkSKey,
+				sNwkSIntKey: legacyNwkSKey,
+				nwkSEncKey:  legacyNwkSKey,
+				appSKey:     legacyAppSKey,
+			},
+			ExpectedRx1Delay: ttnpb.RX_DELAY_1,
+		},
+		{
+			Name:             "1.0.3/Other key",
+			MACVersion:       ttnpb.MAC_V1_0_3,
+			AppKey:           testOtherKey,
+			JoinAccept:       func(*testing.T) []byte { return legacyJoinAccept },
+			ExpectedRx1Delay: ttnpb.RX_DELAY_1,
+		},
+		{
+			Name:             "1.0.3/No join-request",
+			MACVersion:       ttnpb.MAC_V1_0_3,
+			AppKey:           testKey,
+			NoJoinRequest:    true,
+			JoinAccept:       func(*testing.T) []byte { return legacyJoinAccept },
+			ExpectedRx1Delay: ttnpb.RX_DELAY_1,
+		},
+		{
+			Name:       "1.1/Vector without OptNeg",
+			MACVersion: ttnpb.MAC_V1_1,
+			AppKey:     testOtherKey,
+			NwkKey:     testKey,
+			JoinAccept: func(*testing.T) []byte { return legacyJoinAccept },
+			ExpectedDownlink: &Downlink{
+				MType:   ttnpb.MType_JOIN_ACCEPT,
+				DevAddr: testDevAddr,
+			},
+			ExpectedSession: &session{
+				macVersion:  ttnpb.MAC_V1_0_3,
+				devAddr:     testDevAddr,
+				fNwkSIntKey: legacyNwkSKey,
+				sNwkSIntKey: legacyNwkSKey,
+				nwkSEncKey:  legacyNwkSKey,
+				appSKey:     legacyAppSKey,
+			},
+			ExpectedRx1Delay: ttnpb.RX_DELAY_1,
+		},
+		{
+			Name:       "1.1/OptNeg",
+			MACVersion: ttnpb.MAC_V1_1,
+			AppKey:     testOtherKey,
+			NwkKey:     testKey,
+			JoinAccept: func(t *testing.T) []byte {
+				return newJoinAccept(t, testKey, ttnpb.JoinAcceptPayload{
+					JoinNonce: types.JoinNonce{1, 2, 3},
+					NetID:     types.NetID{1, 2, 3},
+					DevAddr:   testDevAddr,
+					DLSettings: ttnpb.DLSettings{
+						OptNeg: true,
+					},
+					RxDelay: ttnpb.RX_DELAY_5,
+				})
+			},
+			ExpectedDownlink: &Downlink{
+				MType:   ttnpb.MType_JOIN_ACCEPT,
+				DevAddr: testDevAddr,
+			},
+			ExpectedSession: &session{
+				macVersion:  ttnpb.MAC_V1_1,
+				devAddr:     testDevAddr,
+				fNwkSIntKey: crypto.DeriveFNwkSIntKey(testKey, types.JoinNonce{1, 2, 3}, testJoinEUI, testDevNonce),
+				sNwkSIntKey: crypto.DeriveSNwkSIntKey(testKey, types.JoinNonce{1, 2, 3}, testJoinEUI, testDevNonce),
+				nwkSEncKey:  crypto.DeriveNwkSEncKey(testKey, types.JoinNonce{1, 2, 3}, testJoinEUI, testDevNonce),
+				appSKey:     crypto.DeriveAppSKey(testOtherKey, types.JoinNonce{1, 2, 3}, testJoinEUI, testDevNonce),
+			},
+			ExpectedRx1Delay: ttnpb.RX_DELAY_5,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			d, err := NewDevice(DeviceConfig{
+				LoRaWANVersion:    tc.MACVersion,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				BandID:            band.EU_863_870,
+				JoinEUI:           testJoinEUI,
+				DevEUI:            testDevEUI,
+				DevNonce:          testDevNonce,
+				AppKey:            tc.AppKey,
+				NwkKey:            tc.NwkKey,
+			}, test.GetLogger(t))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if !tc.NoJoinRequest {
+				if _, err := d.JoinRequest(); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+			}
+
+			down, err := d.HandleDownlink(&ttnpb.DownlinkMessage{
+				RawPayload: tc.JoinAccept(t),
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(down, should.Resemble, tc.ExpectedDownlink)
+			a.So(d.session, should.Resemble, tc.ExpectedSession)
+			a.So(d.rx1Delay, should.Equal, tc.ExpectedRx1Delay)
+			if tc.ExpectedSession != nil {
+				a.So(d.pendingJoin, should.BeNil)
+				a.So(d.rekeyIndPending, should.Equal, tc.ExpectedSession.macVersion == ttnpb.MAC_V1_1)
+			}
+		})
+	}
+}
+
+func TestDataUplink(t *testing.T) {
+	// Uplink from the pkg/crypto test vectors, without MIC.
+	payloadWithoutMIC := []byte{
+		0x40,                   // Unconfirmed Uplink
+		0x04, 0x03, 0x02, 0x01, // DevAddr 01020304
+		0x00,       // Empty FCtrl
+		0x01, 0x00, // FCnt 1
+		0x01,                   // FPort 1
+		0x01, 0x02, 0x03, 0x04, // Encrypted FRMPayload
+	}
+
+	for _, tc := range []struct {
+		Name               string
+		MACVersion         ttnpb.MACVersion
+		FPort              uint32
+		FRMPayload         []byte
+		ExpectedFRMPayload []byte
+		ExpectedRawPayload []byte
+	}{
+		{
+			Name:               "1.0.3/Encryption",
+			MACVersion:         ttnpb.MAC_V1_0_3,
+			FPort:              1,
+			FRMPayload:         []byte{0x01, 0x02, 0x03, 0x04},
+			ExpectedFRMPayload: []byte{0xCF, 0xF3, 0x0B, 0x4E},
+		},
+		{
+			Name:               "1.0.3/Encryption with FPort 0",
+			MACVersion:         ttnpb.MAC_V1_0_3,
+			FRMPayload:         []byte{0x01, 0x02, 0x03, 0x04},
+			ExpectedFRMPayload: []byte{0xCF, 0xF3, 0x0B, 0x4E},
+		},
+		{
+			Name:               "1.0.3/MIC",
+			MACVersion:         ttnpb.MAC_V1_0_3,
+			FPort:              1,
+			FRMPayload:         []byte{0xCF, 0xF3, 0x0B, 0x4E},
+			ExpectedFRMPayload: []byte{0x01, 0x02, 0x03, 0x04},
+			ExpectedRawPayload: append(append([]byte{}, payloadWithoutMIC...), 0x3B, 0x07, 0x31, 0x82),
+		},
+		{
+			Name:               "1.1/Encryption",
+			MACVersion:         ttnpb.MAC_V1_1,
+			FPort:              1,
+			FRMPayload:         []byte{0x01, 0x02, 0x03, 0x04},
+			ExpectedFRMPayload: []byte{0xCF, 0xF3, 0x0B, 0x4E},
+		},
+		{
+			Name:               "1.1/MIC",
+			MACVersion:         ttnpb.MAC_V1_1,
+			FPort:              1,
+			FRMPayload:         []byte{0xCF, 0xF3, 0x0B, 0x4E},
+			ExpectedFRMPayload: []byte{0x01, 0x02, 0x03, 0x04},
+			ExpectedRawPayload: append(append([]byte{}, payloadWithoutMIC...), 0x3B, 0x07, 0x3B, 0x07),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			d, err := NewDevice(DeviceConfig{
+				LoRaWANVersion:    tc.MACVersion,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				BandID:            band.EU_863_870,
+				DevEUI:            testDevEUI,
+				Session: &SessionConfig{
+					DevAddr:     testDevAddr,
+					FNwkSIntKey: testKey,
+					SNwkSIntKey: testKey,
+					NwkSEncKey:  testKey,
+					AppSKey:     testKey,
+					FCntUp:      1,
+				},
+			}, test.GetLogger(t))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			// Omit ResetInd, so that FOpts is empty as in the test vectors.
+			d.resetIndPending = false
+
+			up, err := d.DataUplink(tc.FPort, tc.FRMPayload, false)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			pld := up.Payload.GetMACPayload()
+			if !a.So(pld, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(pld.FCnt, should.Equal, 1)
+			a.So(pld.FPort, should.Equal, tc.FPort)
+			a.So(pld.FRMPayload, should.Resemble, tc.ExpectedFRMPayload)
+			if tc.ExpectedRawPayload != nil {
+				a.So(up.RawPayload, should.Resemble, tc.ExpectedRawPayload)
+				a.So(up.Payload.MIC, should.Resemble, tc.ExpectedRawPayload[len(tc.ExpectedRawPayload)-4:])
+			}
+			a.So(up.Settings.DataRateIndex, should.Equal, ttnpb.DATA_RATE_0)
+			a.So(d.session.fCntUp, should.Equal, 2)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// Gateway is a simulated gateway that forwards uplink messages to, and receives downlink messages from, the Gateway Server.
+type Gateway interface {
+	// SendUplink sends the uplink message. The gateway fills the gateway specific metadata.
+	SendUplink(ctx context.Context, up *ttnpb.UplinkMessage) error
+	// Downlinks returns the channel of downlink messages. The channel is closed when the connection is closed.
+	Downlinks() <-chan *ttnpb.DownlinkMessage
+	// Close closes the connection to the Gateway Server.
+	Close() error
+}
+
+// concentratorClock is the internal clock of a simulated gateway concentrator.
+type concentratorClock time.Time
+
+// Timestamp returns the concentrator timestamp in microseconds at t.
+func (c concentratorClock) Timestamp(t time.Time) uint32 {
+	return uint32(t.Sub(time.Time(c)) / time.Microsecond)
+}
+
+// setMetadata sets the gateway metadata in the uplink message received at t.
+func setMetadata(up *ttnpb.UplinkMessage, ids ttnpb.GatewayIdentifiers, clock concentratorClock, t time.Time) {
+	timestamp := clock.Timestamp(t)
+	up.Settings.Timestamp = timestamp
+	up.Settings.Time = &t
+	if len(up.RxMetadata) == 0 {
+		up.RxMetadata = []*ttnpb.RxMetadata{{}}
+	}
+	for _, md := range up.RxMetadata {
+		md.GatewayIdentifiers = ids
+		md.Timestamp = timestamp
+		md.Time = &t
+	}
+}
+
+type grpcGateway struct {
+	ids       ttnpb.GatewayIdentifiers
+	clock     concentratorClock
+	cancel    context.CancelFunc
+	downlinks chan *ttnpb.DownlinkMessage
+
+	sendMu sync.Mutex
+	link   ttnpb.GtwGs_LinkGatewayClient
+}
+
+// NewGRPCGateway connects a simulated gateway to the Gateway Server using the gRPC link.
+func NewGRPCGateway(ctx context.Context, cc *grpc.ClientConn, ids ttnpb.GatewayIdentifiers, logger log.Interface) (Gateway, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	link, err := ttnpb.NewGtwGsClient(cc).LinkGateway(rpcmetadata.MD{ID: ids.GatewayID}.ToOutgoingContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// Send an empty message to start the stream.
+	if err := link.Send(&ttnpb.GatewayUp{}); err != nil {
+		cancel()
+		return nil, err
+	}
+	gtw := &grpcGateway{
+		ids:       ids,
+		clock:     concentratorClock(time.Now()),
+		link:      link,
+		cancel:    cancel,
+		downlinks: make(chan *ttnpb.DownlinkMessage, 16),
+	}
+	go func() {
+		defer close(gtw.downlinks)
+		for {
+			msg, err := link.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.WithError(err).Warn("Failed to receive downlink")
+				}
+				return
+			}
+			if msg.DownlinkMessage == nil {
+				continue
+			}
+			select {
+			case gtw.downlinks <- msg.DownlinkMessage:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return gtw, nil
+}
+
+// SendUplink implements Gateway.
+func (g *grpcGateway) SendUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	setMetadata(up, g.ids, g.clock, time.Now())
+	g.sendMu.Lock()
+	defer g.sendMu.Unlock()
+	return g.link.Send(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{up},
+	})
+}
+
+// Downlinks implements Gateway.
+func (g *grpcGateway) Downlinks() <-chan *ttnpb.DownlinkMessage {
+	return g.downlinks
+}
+
+// Close implements Gateway.
+func (g *grpcGateway) Close() error {
+	g.cancel()
+	return nil
+}
@@ -0,0 +1,227 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// handleMACCommands applies the MAC commands received in a downlink and queues the answers for the next uplink.
+func (d *Device) handleMACCommands(cmds []*ttnpb.MACCommand) {
+	for i := 0; i < len(cmds); i++ {
+		cmd := cmds[i]
+		logger := d.logger.WithFields(log.Fields(
+			"dev_eui", d.conf.DevEUI,
+			"cid", cmd.CID,
+		))
+		switch cmd.CID {
+		case ttnpb.CID_LINK_ADR:
+			// Contiguous LinkADRReq commands are handled as a single block.
+			j := i + 1
+			for j < len(cmds) && cmds[j].CID == ttnpb.CID_LINK_ADR {
+				j++
+			}
+			d.handleLinkADRReqs(cmds[i:j])
+			i = j - 1
+
+		case ttnpb.CID_DUTY_CYCLE:
+			d.queueMACAnswer(&ttnpb.MACCommand{CID: ttnpb.CID_DUTY_CYCLE})
+
+		case ttnpb.CID_RX_PARAM_SETUP:
+			req := cmd.GetRxParamSetupReq()
+			ans := &ttnpb.MACCommand_RxParamSetupAns{
+				Rx2DataRateIndexAck:  d.phy.DataRates[req.Rx2DataRateIndex].Rate.Modulation != nil,
+				Rx1DataRateOffsetAck: req.Rx1DataRateOffset <= 7,
+				Rx2FrequencyAck:      req.Rx2Frequency != 0,
+			}
+			if ans.Rx2DataRateIndexAck && ans.Rx1DataRateOffsetAck && ans.Rx2FrequencyAck {
+				d.rx1DataRateOffset = req.Rx1DataRateOffset
+				d.rx2DataRateIndex = req.Rx2DataRateIndex
+				d.rx2Frequency = req.Rx2Frequency
+			}
+			d.queueMACAnswer(ans.MACCommand())
+
+		case ttnpb.CID_DEV_STATUS:
+			margin := int32(d.conf.SNR)
+			if margin < -32 {
+				margin = -32
+			} else if margin > 31 {
+				margin = 31
+			}
+			d.queueMACAnswer((&ttnpb.MACCommand_DevStatusAns{
+				Battery: d.conf.Battery,
+				Margin:  margin,
+			}).MACCommand())
+
+		case ttnpb.CID_NEW_CHANNEL:
+			req := cmd.GetNewChannelReq()
+			ans := &ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: req.ChannelIndex < uint32(d.phy.MaxUplinkChannels),
+				DataRateAck:  req.MinDataRateIndex <= req.MaxDataRateIndex && req.MaxDataRateIndex <= ttnpb.DataRateIndex(d.phy.MaxADRDataRateIndex),
+			}
+			if req.Frequency == 0 {
+				// A zero frequency disables the channel.
+				ans.DataRateAck = true
+			}
+			if ans.FrequencyAck && ans.DataRateAck {
+				for uint32(len(d.channels)) <= req.ChannelIndex {
+					d.channels = append(d.channels, nil)
+				}
+				if req.Frequency == 0 {
+					d.channels[req.ChannelIndex] = nil
+				} else {
+					d.channels[req.ChannelIndex] = &channel{
+						uplinkFrequency:   req.Frequency,
+						downlinkFrequency: req.Frequency,
+						minDataRateIndex:  req.MinDataRateIndex,
+						maxDataRateIndex:  req.MaxDataRateIndex,
+						enableUplink:      true,
+					}
+				}
+			}
+			d.queueMACAnswer(ans.MACCommand())
+
+		case ttnpb.CID_RX_TIMING_SETUP:
+			d.rx1Delay = cmd.GetRxTimingSetupReq().Delay
+			d.queueMACAnswer(&ttnpb.MACCommand{CID: ttnpb.CID_RX_TIMING_SETUP})
+
+		case ttnpb.CID_TX_PARAM_SETUP:
+			if !d.phy.TxParamSetupReqSupport {
+				logger.Debug("Ignore TxParamSetupReq in band without support")
+				continue
+			}
+			d.queueMACAnswer(&ttnpb.MACCommand{CID: ttnpb.CID_TX_PARAM_SETUP})
+
+		case ttnpb.CID_DL_CHANNEL:
+			req := cmd.GetDLChannelReq()
+			ans := &ttnpb.MACCommand_DLChannelAns{
+				ChannelIndexAck: int(req.ChannelIndex) < len(d.channels) && d.channels[req.ChannelIndex] != nil,
+				FrequencyAck:    req.Frequency != 0,
+			}
+			if ans.ChannelIndexAck && ans.FrequencyAck {
+				d.channels[req.ChannelIndex].downlinkFrequency = req.Frequency
+			}
+			d.queueMACAnswer(ans.MACCommand())
+
+		case ttnpb.CID_REKEY:
+			d.rekeyIndPending = false
+
+		case ttnpb.CID_RESET:
+			d.resetIndPending = false
+
+		case ttnpb.CID_ADR_PARAM_SETUP:
+			req := cmd.GetADRParamSetupReq()
+			d.adrAckLimit = 1 << uint32(req.ADRAckLimitExponent)
+			d.adrAckDelay = 1 << uint32(req.ADRAckDelayExponent)
+			d.queueMACAnswer(&ttnpb.MACCommand{CID: ttnpb.CID_ADR_PARAM_SETUP})
+
+		case ttnpb.CID_REJOIN_PARAM_SETUP:
+			d.queueMACAnswer((&ttnpb.MACCommand_RejoinParamSetupAns{}).MACCommand())
+
+		case ttnpb.CID_LINK_CHECK, ttnpb.CID_DEVICE_TIME:
+			logger.WithField("payload", cmd.GetPayload()).Info("Received MAC command answer")
+
+		default:
+			logger.Warn("Unsupported MAC command")
+		}
+	}
+}
+
+func (d *Device) queueMACAnswer(cmd *ttnpb.MACCommand) {
+	d.pendingMACAnswers = append(d.pendingMACAnswers, cmd)
+}
+
+// handleLinkADRReqs handles a contiguous block of LinkADRReq commands.
+// The block is applied atomically: either all changes are accepted or none are.
+func (d *Device) handleLinkADRReqs(cmds []*ttnpb.MACCommand) {
+	enabled := make([]bool, len(d.channels))
+	for i, ch := range d.channels {
+		enabled[i] = ch != nil && ch.enableUplink
+	}
+
+	ans := &ttnpb.MACCommand_LinkADRAns{
+		ChannelMaskAck: true,
+	}
+	var req *ttnpb.MACCommand_LinkADRReq
+	for _, cmd := range cmds {
+		req = cmd.GetLinkADRReq()
+		var mask [16]bool
+		copy(mask[:], req.ChannelMask)
+		m, err := d.phy.ParseChMask(mask, uint8(req.ChannelMaskControl))
+		if err != nil {
+			ans.ChannelMaskAck = false
+			continue
+		}
+		for i, on := range m {
+			if int(i) >= len(enabled) {
+				if on {
+					ans.ChannelMaskAck = false
+				}
+				continue
+			}
+			if on && d.channels[i] == nil {
+				ans.ChannelMaskAck = false
+				continue
+			}
+			enabled[i] = on
+		}
+	}
+
+	dataRateIndex := req.DataRateIndex
+	if dataRateIndex == 15 {
+		dataRateIndex = d.dataRateIndex
+	}
+	txPowerIndex := req.TxPowerIndex
+	if txPowerIndex == 15 {
+		txPowerIndex = d.txPowerIndex
+	}
+
+	var anyEnabled bool
+	for i, on := range enabled {
+		if !on {
+			continue
+		}
+		anyEnabled = true
+		if ch := d.channels[i]; dataRateIndex >= ch.minDataRateIndex && dataRateIndex <= ch.maxDataRateIndex {
+			ans.DataRateIndexAck = true
+		}
+	}
+	ans.ChannelMaskAck = ans.ChannelMaskAck && anyEnabled
+	ans.DataRateIndexAck = ans.DataRateIndexAck && d.phy.DataRates[dataRateIndex].Rate.Modulation != nil
+	ans.TxPowerIndexAck = txPowerIndex <= uint32(d.phy.MaxTxPowerIndex)
+
+	if ans.ChannelMaskAck && ans.DataRateIndexAck && ans.TxPowerIndexAck {
+		for i, on := range enabled {
+			if ch := d.channels[i]; ch != nil {
+				ch.enableUplink = on
+			}
+		}
+		d.dataRateIndex = dataRateIndex
+		d.txPowerIndex = txPowerIndex
+		if req.NbTrans > 0 {
+			d.nbTrans = req.NbTrans
+		}
+	}
+
+	// LoRaWAN 1.0.x devices answer each request in the block, LoRaWAN 1.1 devices answer the block once.
+	n := len(cmds)
+	if d.session.macVersion.Compare(ttnpb.MAC_V1_1) >= 0 {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		d.queueMACAnswer(ans.MACCommand())
+	}
+}
@@ -0,0 +1,304 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestHandleMACCommands(t *testing.T) {
+	linkADRReq := (&ttnpb.MACCommand_LinkADRReq{
+		DataRateIndex: ttnpb.DATA_RATE_5,
+		TxPowerIndex:  1,
+		ChannelMask:   []bool{true, true, true},
+		NbTrans:       1,
+	}).MACCommand()
+
+	for _, tc := range []struct {
+		Name       string
+		MACVersion ttnpb.MACVersion
+		SNR        float32
+		Battery    uint32
+		Commands   []*ttnpb.MACCommand
+		Expected   []*ttnpb.MACCommand
+	}{
+		{
+			Name:       "DutyCycleReq",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DutyCycleReq{MaxDutyCycle: ttnpb.DUTY_CYCLE_2}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				{CID: ttnpb.CID_DUTY_CYCLE},
+			},
+		},
+		{
+			Name:       "RxParamSetupReq/Valid",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupReq{
+					Rx2DataRateIndex:  ttnpb.DATA_RATE_3,
+					Rx1DataRateOffset: 2,
+					Rx2Frequency:      869525000,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupAns{
+					Rx2DataRateIndexAck:  true,
+					Rx1DataRateOffsetAck: true,
+					Rx2FrequencyAck:      true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "RxParamSetupReq/Invalid data rate",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupReq{
+					Rx2DataRateIndex:  ttnpb.DATA_RATE_15,
+					Rx1DataRateOffset: 2,
+					Rx2Frequency:      869525000,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupAns{
+					Rx1DataRateOffsetAck: true,
+					Rx2FrequencyAck:      true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "DevStatusReq",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			SNR:        7.5,
+			Battery:    200,
+			Commands: []*ttnpb.MACCommand{
+				ttnpb.CID_DEV_STATUS.MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DevStatusAns{
+					Battery: 200,
+					Margin:  7,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "DevStatusReq/Margin out of range",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			SNR:        42,
+			Commands: []*ttnpb.MACCommand{
+				ttnpb.CID_DEV_STATUS.MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DevStatusAns{
+					Margin: 31,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "NewChannelReq/Valid",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelReq{
+					ChannelIndex:     3,
+					Frequency:        867100000,
+					MaxDataRateIndex: ttnpb.DATA_RATE_5,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelAns{
+					FrequencyAck: true,
+					DataRateAck:  true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "NewChannelReq/Invalid channel index",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelReq{
+					ChannelIndex:     16,
+					Frequency:        867100000,
+					MaxDataRateIndex: ttnpb.DATA_RATE_5,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelAns{
+					DataRateAck: true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "RxTimingSetupReq",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxTimingSetupReq{Delay: ttnpb.RX_DELAY_5}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				{CID: ttnpb.CID_RX_TIMING_SETUP},
+			},
+		},
+		{
+			Name:       "TxParamSetupReq/Not supported in band",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_TxParamSetupReq{}).MACCommand(),
+			},
+		},
+		{
+			Name:       "DLChannelReq/Valid",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DLChannelReq{
+					ChannelIndex: 0,
+					Frequency:    868500000,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DLChannelAns{
+					ChannelIndexAck: true,
+					FrequencyAck:    true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "DLChannelReq/Unknown channel",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DLChannelReq{
+					ChannelIndex: 10,
+					Frequency:    868500000,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DLChannelAns{
+					FrequencyAck: true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "LinkADRReq/1.0.3/Block",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands:   []*ttnpb.MACCommand{linkADRReq, linkADRReq},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkADRAns{
+					ChannelMaskAck:   true,
+					DataRateIndexAck: true,
+					TxPowerIndexAck:  true,
+				}).MACCommand(),
+				(&ttnpb.MACCommand_LinkADRAns{
+					ChannelMaskAck:   true,
+					DataRateIndexAck: true,
+					TxPowerIndexAck:  true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "LinkADRReq/1.1/Block",
+			MACVersion: ttnpb.MAC_V1_1,
+			Commands:   []*ttnpb.MACCommand{linkADRReq, linkADRReq},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkADRAns{
+					ChannelMaskAck:   true,
+					DataRateIndexAck: true,
+					TxPowerIndexAck:  true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "LinkADRReq/Unknown channel",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkADRReq{
+					DataRateIndex: ttnpb.DATA_RATE_5,
+					TxPowerIndex:  1,
+					ChannelMask:   []bool{true, true, true, false, false, true},
+					NbTrans:       1,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkADRAns{
+					DataRateIndexAck: true,
+					TxPowerIndexAck:  true,
+				}).MACCommand(),
+			},
+		},
+		{
+			Name:       "ADRParamSetupReq",
+			MACVersion: ttnpb.MAC_V1_1,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_ADRParamSetupReq{
+					ADRAckLimitExponent: ttnpb.ADR_ACK_LIMIT_8,
+					ADRAckDelayExponent: ttnpb.ADR_ACK_DELAY_4,
+				}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				{CID: ttnpb.CID_ADR_PARAM_SETUP},
+			},
+		},
+		{
+			Name:       "RejoinParamSetupReq",
+			MACVersion: ttnpb.MAC_V1_1,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RejoinParamSetupReq{}).MACCommand(),
+			},
+			Expected: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RejoinParamSetupAns{}).MACCommand(),
+			},
+		},
+		{
+			Name:       "LinkCheckAns",
+			MACVersion: ttnpb.MAC_V1_0_3,
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkCheckAns{
+					Margin:       10,
+					GatewayCount: 2,
+				}).MACCommand(),
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			d, err := NewDevice(DeviceConfig{
+				LoRaWANVersion:    tc.MACVersion,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				BandID:            band.EU_863_870,
+				DevEUI:            testDevEUI,
+				Session: &SessionConfig{
+					DevAddr:     testDevAddr,
+					FNwkSIntKey: testKey,
+					SNwkSIntKey: testKey,
+					NwkSEncKey:  testKey,
+					AppSKey:     testKey,
+				},
+				SNR:     tc.SNR,
+				Battery: tc.Battery,
+			}, test.GetLogger(t))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			d.handleMACCommands(tc.Commands)
+			a.So(d.pendingMACAnswers, should.Resemble, tc.Expected)
+		})
+	}
+}
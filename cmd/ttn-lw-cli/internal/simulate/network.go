@@ -0,0 +1,255 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulate implements simulated LoRaWAN end devices and gateways.
+package simulate
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errGatewayClosed = errors.DefineUnavailable("gateway_closed", "gateway connection closed")
+	errJoin          = errors.DefineAborted("join", "no join-accept received after `{attempts}` attempts")
+)
+
+// TrafficConfig configures the traffic of a simulated device.
+type TrafficConfig struct {
+	// JoinAttempts is the maximum number of join-requests sent by devices that are not activated.
+	JoinAttempts int
+	// Uplinks is the number of data uplinks to send. If zero, uplinks are sent until the context is done.
+	Uplinks int
+	// Interval is the time between uplinks.
+	Interval time.Duration
+	// ReceiveTimeout is the time to wait for a downlink after an uplink.
+	ReceiveTimeout time.Duration
+
+	FPort      uint32
+	FRMPayload []byte
+	Confirmed  bool
+
+	// HandleDownlink is called for each downlink received by a device, if set.
+	HandleDownlink func(*Device, *Downlink)
+}
+
+// Network connects simulated devices to a simulated gateway.
+// It dispatches the downlink messages received by the gateway to the devices they are destined for.
+type Network struct {
+	gateway Gateway
+	logger  log.Interface
+	done    chan struct{}
+
+	mu      sync.RWMutex
+	devices map[*Device]chan *Downlink
+}
+
+// NewNetwork returns a new network that dispatches the downlinks received by the gateway.
+func NewNetwork(gtw Gateway, logger log.Interface) *Network {
+	n := &Network{
+		gateway: gtw,
+		logger:  logger,
+		done:    make(chan struct{}),
+		devices: make(map[*Device]chan *Downlink),
+	}
+	go n.dispatch()
+	return n
+}
+
+func (n *Network) dispatch() {
+	defer close(n.done)
+	for down := range n.gateway.Downlinks() {
+		n.mu.RLock()
+		var handled bool
+		for dev, ch := range n.devices {
+			res, err := dev.HandleDownlink(down)
+			if err != nil {
+				n.logger.WithField("dev_eui", dev.DevEUI()).WithError(err).Warn("Failed to handle downlink")
+				handled = true
+				break
+			}
+			if res == nil {
+				continue
+			}
+			select {
+			case ch <- res:
+			default:
+				n.logger.WithField("dev_eui", dev.DevEUI()).Warn("Drop downlink")
+			}
+			handled = true
+			break
+		}
+		n.mu.RUnlock()
+		if !handled {
+			n.logger.Debug("Received downlink for unknown device")
+		}
+	}
+}
+
+func (n *Network) add(dev *Device) chan *Downlink {
+	ch := make(chan *Downlink, 4)
+	n.mu.Lock()
+	n.devices[dev] = ch
+	n.mu.Unlock()
+	return ch
+}
+
+func (n *Network) remove(dev *Device) {
+	n.mu.Lock()
+	delete(n.devices, dev)
+	n.mu.Unlock()
+}
+
+// receive waits for a downlink until the timeout.
+// receive returns nil without an error if no downlink is received in time.
+func (n *Network) receive(ctx context.Context, downlinks <-chan *Downlink, timeout time.Duration) (*Downlink, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case down := <-downlinks:
+		return down, nil
+	case <-timer.C:
+		return nil, nil
+	case <-n.done:
+		return nil, errGatewayClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (n *Network) join(ctx context.Context, dev *Device, downlinks <-chan *Downlink, conf TrafficConfig, stats *Stats) error {
+	for attempt := 0; attempt < conf.JoinAttempts; attempt++ {
+		if attempt > 0 {
+			if err := sleep(ctx, conf.Interval); err != nil {
+				return err
+			}
+		}
+		up, err := dev.JoinRequest()
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		if err := n.gateway.SendUplink(ctx, up); err != nil {
+			return err
+		}
+		stats.recordJoinRequest()
+		n.logger.WithField("dev_eui", dev.DevEUI()).Debug("Sent join-request")
+
+		down, err := n.receive(ctx, downlinks, conf.ReceiveTimeout)
+		if err != nil {
+			return err
+		}
+		if down == nil || down.MType != ttnpb.MType_JOIN_ACCEPT {
+			continue
+		}
+		stats.recordJoinAccept(time.Since(start))
+		if conf.HandleDownlink != nil {
+			conf.HandleDownlink(dev, down)
+		}
+		return nil
+	}
+	return errJoin.WithAttributes("attempts", conf.JoinAttempts)
+}
+
+// Run runs the device on the network. Devices that are not activated join first.
+// Run returns when the configured number of uplinks is sent, or when the context is done.
+func (n *Network) Run(ctx context.Context, dev *Device, conf TrafficConfig, stats *Stats) error {
+	downlinks := n.add(dev)
+	defer n.remove(dev)
+
+	if !dev.Activated() {
+		if err := n.join(ctx, dev, downlinks, conf, stats); err != nil {
+			return err
+		}
+	}
+	for i := 0; conf.Uplinks == 0 || i < conf.Uplinks; i++ {
+		if i > 0 {
+			if err := sleep(ctx, conf.Interval); err != nil {
+				return err
+			}
+		}
+		// Downlinks received after the receive timeout of the previous uplink are late.
+		for drained := false; !drained; {
+			select {
+			case <-downlinks:
+				stats.recordLateDownlink()
+			default:
+				drained = true
+			}
+		}
+
+		up, err := dev.DataUplink(conf.FPort, conf.FRMPayload, conf.Confirmed)
+		if err != nil {
+			return err
+		}
+		start := time.Now()
+		if err := n.gateway.SendUplink(ctx, up); err != nil {
+			return err
+		}
+		stats.recordUplink(conf.Confirmed)
+		n.logger.WithFields(log.Fields(
+			"dev_eui", dev.DevEUI(),
+			"f_cnt", up.Payload.GetMACPayload().FCnt,
+		)).Debug("Sent uplink")
+
+		down, err := n.receive(ctx, downlinks, conf.ReceiveTimeout)
+		if err != nil {
+			return err
+		}
+		if down == nil {
+			continue
+		}
+		stats.recordDownlink(time.Since(start), down.Ack)
+		if conf.HandleDownlink != nil {
+			conf.HandleDownlink(dev, down)
+		}
+	}
+	return nil
+}
+
+// RunFleet runs the devices concurrently on the network.
+// The first uplinks of the devices are spread evenly over the traffic interval.
+// Errors of individual devices are logged and do not stop the other devices.
+func (n *Network) RunFleet(ctx context.Context, devs []*Device, conf TrafficConfig, stats *Stats) {
+	var wg sync.WaitGroup
+	for i, dev := range devs {
+		wg.Add(1)
+		go func(i int, dev *Device) {
+			defer wg.Done()
+			if err := sleep(ctx, conf.Interval*time.Duration(i)/time.Duration(len(devs))); err != nil {
+				return
+			}
+			if err := n.Run(ctx, dev, conf, stats); err != nil && ctx.Err() == nil {
+				n.logger.WithField("dev_eui", dev.DevEUI()).WithError(err).Warn("Device failed")
+			}
+		}(i, dev)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,182 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Stats collects traffic statistics of simulated devices.
+// Stats is safe for concurrent use.
+type Stats struct {
+	mu sync.Mutex
+
+	joinRequests     uint64
+	joinAccepts      uint64
+	uplinks          uint64
+	confirmedUplinks uint64
+	acknowledgments  uint64
+	downlinks        uint64
+	lateDownlinks    uint64
+
+	joinLatencies     []time.Duration
+	downlinkLatencies []time.Duration
+}
+
+func (s *Stats) recordJoinRequest() {
+	s.mu.Lock()
+	s.joinRequests++
+	s.mu.Unlock()
+}
+
+func (s *Stats) recordJoinAccept(latency time.Duration) {
+	s.mu.Lock()
+	s.joinAccepts++
+	s.joinLatencies = append(s.joinLatencies, latency)
+	s.mu.Unlock()
+}
+
+func (s *Stats) recordUplink(confirmed bool) {
+	s.mu.Lock()
+	s.uplinks++
+	if confirmed {
+		s.confirmedUplinks++
+	}
+	s.mu.Unlock()
+}
+
+func (s *Stats) recordDownlink(latency time.Duration, ack bool) {
+	s.mu.Lock()
+	s.downlinks++
+	s.downlinkLatencies = append(s.downlinkLatencies, latency)
+	if ack {
+		s.acknowledgments++
+	}
+	s.mu.Unlock()
+}
+
+func (s *Stats) recordLateDownlink() {
+	s.mu.Lock()
+	s.lateDownlinks++
+	s.mu.Unlock()
+}
+
+// LatencySummary summarizes a latency distribution.
+type LatencySummary struct {
+	Count int
+	Min   time.Duration
+	Mean  time.Duration
+	P50   time.Duration
+	P95   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s LatencySummary) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Count int    `json:"count"`
+		Min   string `json:"min"`
+		Mean  string `json:"mean"`
+		P50   string `json:"p50"`
+		P95   string `json:"p95"`
+		P99   string `json:"p99"`
+		Max   string `json:"max"`
+	}{
+		Count: s.Count,
+		Min:   s.Min.String(),
+		Mean:  s.Mean.String(),
+		P50:   s.P50.String(),
+		P95:   s.P95.String(),
+		P99:   s.P99.String(),
+		Max:   s.Max.String(),
+	})
+}
+
+func summarizeLatencies(latencies []time.Duration) LatencySummary {
+	n := len(latencies)
+	if n == 0 {
+		return LatencySummary{}
+	}
+	sorted := make([]time.Duration, n)
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total time.Duration
+	for _, l := range sorted {
+		total += l
+	}
+	// percentile returns the nearest-rank percentile.
+	percentile := func(p int) time.Duration {
+		i := (p*n+99)/100 - 1
+		if i < 0 {
+			i = 0
+		}
+		return sorted[i]
+	}
+	return LatencySummary{
+		Count: n,
+		Min:   sorted[0],
+		Mean:  total / time.Duration(n),
+		P50:   percentile(50),
+		P95:   percentile(95),
+		P99:   percentile(99),
+		Max:   sorted[n-1],
+	}
+}
+
+// StatsSummary summarizes the traffic statistics.
+// The loss ratios are the fraction of join-requests without join-accept, and the fraction of confirmed uplinks without acknowledgment.
+type StatsSummary struct {
+	JoinRequests       uint64         `json:"join_requests"`
+	JoinAccepts        uint64         `json:"join_accepts"`
+	JoinLoss           float64        `json:"join_loss"`
+	JoinLatency        LatencySummary `json:"join_latency"`
+	Uplinks            uint64         `json:"uplinks"`
+	ConfirmedUplinks   uint64         `json:"confirmed_uplinks"`
+	Acknowledgments    uint64         `json:"acknowledgments"`
+	AcknowledgmentLoss float64        `json:"acknowledgment_loss"`
+	Downlinks          uint64         `json:"downlinks"`
+	LateDownlinks      uint64         `json:"late_downlinks"`
+	DownlinkLatency    LatencySummary `json:"downlink_latency"`
+}
+
+func lossRatio(sent, received uint64) float64 {
+	if sent == 0 || received >= sent {
+		return 0
+	}
+	return float64(sent-received) / float64(sent)
+}
+
+// Summary returns the summary of the statistics collected so far.
+func (s *Stats) Summary() StatsSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return StatsSummary{
+		JoinRequests:       s.joinRequests,
+		JoinAccepts:        s.joinAccepts,
+		JoinLoss:           lossRatio(s.joinRequests, s.joinAccepts),
+		JoinLatency:        summarizeLatencies(s.joinLatencies),
+		Uplinks:            s.uplinks,
+		ConfirmedUplinks:   s.confirmedUplinks,
+		Acknowledgments:    s.acknowledgments,
+		AcknowledgmentLoss: lossRatio(s.confirmedUplinks, s.acknowledgments),
+		Downlinks:          s.downlinks,
+		LateDownlinks:      s.lateDownlinks,
+		DownlinkLatency:    summarizeLatencies(s.downlinkLatencies),
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulate
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// udpPullInterval is the interval at which PULL_DATA packets are sent to keep the downlink path open.
+const udpPullInterval = 5 * time.Second
+
+type udpGateway struct {
+	eui       types.EUI64
+	ids       ttnpb.GatewayIdentifiers
+	clock     concentratorClock
+	conn      *net.UDPConn
+	token     uint32
+	cancel    context.CancelFunc
+	downlinks chan *ttnpb.DownlinkMessage
+}
+
+// NewUDPGateway connects a simulated gateway to the Gateway Server using the Semtech UDP packet forwarder protocol.
+func NewUDPGateway(ctx context.Context, address string, eui types.EUI64, logger log.Interface) (Gateway, error) {
+	addr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	gtw := &udpGateway{
+		eui:       eui,
+		ids:       ttnpb.GatewayIdentifiers{EUI: &eui},
+		clock:     concentratorClock(time.Now()),
+		conn:      conn,
+		cancel:    cancel,
+		downlinks: make(chan *ttnpb.DownlinkMessage, 16),
+	}
+	if err := gtw.write(udp.PullData, nil); err != nil {
+		cancel()
+		conn.Close()
+		return nil, err
+	}
+	go func() {
+		ticker := time.NewTicker(udpPullInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := gtw.write(udp.PullData, nil); err != nil {
+					logger.WithError(err).Warn("Failed to send PULL_DATA")
+				}
+			}
+		}
+	}()
+	go func() {
+		defer close(gtw.downlinks)
+		buf := make([]byte, 65507)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.WithError(err).Warn("Failed to read packet")
+				}
+				return
+			}
+			var packet udp.Packet
+			if err := packet.UnmarshalBinary(buf[:n]); err != nil {
+				logger.WithError(err).Warn("Failed to unmarshal packet")
+				continue
+			}
+			if packet.PacketType != udp.PullResp || packet.Data == nil || packet.Data.TxPacket == nil {
+				continue
+			}
+			down, err := udp.ToDownlinkMessage(packet.Data.TxPacket)
+			if err != nil {
+				logger.WithError(err).Warn("Failed to convert downlink")
+				continue
+			}
+			ack := udp.Packet{
+				ProtocolVersion: udp.Version2,
+				Token:           packet.Token,
+				PacketType:      udp.TxAck,
+				GatewayEUI:      &gtw.eui,
+				Data: &udp.Data{
+					TxPacketAck: &udp.TxPacketAck{Error: udp.TxErrNone},
+				},
+			}
+			if b, err := ack.MarshalBinary(); err == nil {
+				conn.Write(b)
+			}
+			select {
+			case gtw.downlinks <- down:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return gtw, nil
+}
+
+func (g *udpGateway) write(packetType udp.PacketType, data *udp.Data) error {
+	token := atomic.AddUint32(&g.token, 1)
+	b, err := udp.Packet{
+		ProtocolVersion: udp.Version2,
+		Token:           [2]byte{byte(token >> 8), byte(token)},
+		PacketType:      packetType,
+		GatewayEUI:      &g.eui,
+		Data:            data,
+	}.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = g.conn.Write(b)
+	return err
+}
+
+// SendUplink implements Gateway.
+func (g *udpGateway) SendUplink(ctx context.Context, up *ttnpb.UplinkMessage) error {
+	setMetadata(up, g.ids, g.clock, time.Now())
+	rxs, _, _ := udp.FromGatewayUp(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{up},
+	})
+	return g.write(udp.PushData, &udp.Data{RxPacket: rxs})
+}
+
+// Downlinks implements Gateway.
+func (g *udpGateway) Downlinks() <-chan *ttnpb.DownlinkMessage {
+	return g.downlinks
+}
+
+// Close implements Gateway.
+func (g *udpGateway) Close() error {
+	g.cancel()
+	return g.conn.Close()
+}
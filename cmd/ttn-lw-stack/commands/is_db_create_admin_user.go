@@ -76,7 +76,14 @@ var (
 			if password == "" {
 				return errMissingFlag.WithAttributes("flag", "password")
 			}
-			hashedPassword, err := auth.Hash(ctx, password)
+			hashValidator, err := config.IS.PasswordHashing.HashValidator()
+			if err != nil {
+				return err
+			}
+			if hashValidator != nil {
+				ctx = auth.NewContextWithPasswordHashValidator(ctx, hashValidator)
+			}
+			hashedPassword, err := auth.HashPassword(ctx, password)
 			if err != nil {
 				return err
 			}
@@ -28,6 +28,8 @@ import (
 	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/console"
+	"go.thethings.network/lorawan-stack/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
@@ -49,7 +51,7 @@ var (
 
 var (
 	startCommand = &cobra.Command{
-		Use:   "start [is|gs|ns|as|js|console|gcs|dtc|all]... [flags]",
+		Use:   "start [is|gs|ns|as|js|console|gcs|dtc|dcs|all]... [flags]",
 		Short: "Start The Things Stack",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var start struct {
@@ -61,6 +63,7 @@ var (
 				Console                    bool
 				GatewayConfigurationServer bool
 				DeviceTemplateConverter    bool
+				DeviceClaimingServer       bool
 			}
 			startDefault := len(args) == 0
 			for _, arg := range args {
@@ -85,6 +88,8 @@ var (
 					start.GatewayConfigurationServer = true
 				case "dtc":
 					start.DeviceTemplateConverter = true
+				case "dcs":
+					start.DeviceClaimingServer = true
 				case "all":
 					start.IdentityServer = true
 					start.GatewayServer = true
@@ -94,6 +99,7 @@ var (
 					start.Console = true
 					start.GatewayConfigurationServer = true
 					start.DeviceTemplateConverter = true
+					start.DeviceClaimingServer = true
 				default:
 					return errUnknownComponent.WithAttributes("component", arg)
 				}
@@ -112,6 +118,7 @@ var (
 
 			c.RegisterGRPC(events_grpc.NewEventsServer(c.Context(), events.DefaultPubSub()))
 			c.RegisterGRPC(component.NewConfigurationServer(c))
+			c.RegisterGRPC(component.NewClusterServer(c))
 
 			host, err := os.Hostname()
 			if err != nil {
@@ -181,11 +188,19 @@ var (
 						Namespace: []string{"as", "io", "webhooks"},
 					})}
 				}
+				asDownlinkTasks := asredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "tasks"},
+				}), 100000, "as", redis.Key(host, strconv.Itoa(os.Getpid())))
+				if err := asDownlinkTasks.Init(); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.DownlinkTasks = asDownlinkTasks
 				as, err := applicationserver.New(c, &config.AS)
 				if err != nil {
 					return shared.ErrInitializeApplicationServer.WithCause(err)
 				}
-				_ = as
+				as.Component.RegisterTask(as.Context(), "queue_downlink", asDownlinkTasks.Run, component.TaskRestartOnFailure)
 			}
 
 			if start.JoinServer || startDefault {
@@ -233,6 +248,19 @@ var (
 				_ = dtc
 			}
 
+			if start.DeviceClaimingServer {
+				logger.Info("Setting up Device Claiming Server")
+				config.DCS.AuthorizedApplications = &dcsredis.AuthorizedApplicationRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"dcs", "applications"},
+				})}
+				dcs, err := deviceclaimingserver.New(c, &config.DCS)
+				if err != nil {
+					return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
+				}
+				_ = dcs
+			}
+
 			if rootRedirect != nil {
 				c.RegisterWeb(rootRedirect)
 			}
@@ -75,6 +75,8 @@ type ApplicationServer struct {
 
 	interopClient InteropClient
 	interopID     string
+
+	downlinkTasks DownlinkTaskQueue
 }
 
 // Context returns the context of the Application Server.
@@ -133,6 +135,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		},
 		interopClient: interopCl,
 		interopID:     conf.Interop.ID,
+		downlinkTasks: conf.DownlinkTasks,
 	}
 
 	as.grpc.asDevices = asEndDeviceRegistryServer{AS: as}
@@ -196,6 +199,21 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 	if as.linkMode == LinkAll {
 		c.RegisterTask(as.Context(), "link_all", as.linkAll, component.TaskRestartOnFailure)
 	}
+	if as.downlinkTasks != nil {
+		c.RegisterTask(as.Context(), "process_downlink", func(ctx context.Context) error {
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
+
+				if err := as.processDownlinkTask(ctx); err != nil {
+					return err
+				}
+			}
+		}, component.TaskRestartOnFailure)
+	}
 	return as, nil
 }
 
@@ -326,26 +344,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 		},
 	)
 	if err != nil {
-		var errorDetails ttnpb.ErrorDetails
-		if ttnErr, ok := err.(errors.ErrorDetails); ok {
-			errorDetails = *ttnpb.ErrorDetailsToProto(ttnErr)
-		}
-		for _, item := range items {
-			link.upCh <- &io.ContextualApplicationUp{
-				Context: ctx,
-				ApplicationUp: &ttnpb.ApplicationUp{
-					EndDeviceIdentifiers: ids,
-					CorrelationIDs:       item.CorrelationIDs,
-					Up: &ttnpb.ApplicationUp_DownlinkFailed{
-						DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
-							ApplicationDownlink: *item,
-							Error:               errorDetails,
-						},
-					},
-				},
-			}
-			registerDropDownlink(ctx, ids, item, err)
-		}
+		failDownlinks(ctx, ids, items, err, link)
 		return err
 	}
 	atomic.AddUint64(&link.downlinks, uint64(len(items)))
@@ -366,16 +365,51 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 	return nil
 }
 
+// failDownlinks sends the given downlink messages as failed with err to the application.
+func failDownlinks(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink, err error, link *link) {
+	var errorDetails ttnpb.ErrorDetails
+	if ttnErr, ok := err.(errors.ErrorDetails); ok {
+		errorDetails = *ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	for _, item := range items {
+		link.upCh <- &io.ContextualApplicationUp{
+			Context: ctx,
+			ApplicationUp: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				CorrelationIDs:       item.CorrelationIDs,
+				Up: &ttnpb.ApplicationUp_DownlinkFailed{
+					DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+						ApplicationDownlink: *item,
+						Error:               errorDetails,
+					},
+				},
+			},
+		}
+		registerDropDownlink(ctx, ids, item, err)
+	}
+}
+
 // DownlinkQueuePush pushes the given downlink messages to the end device's application downlink queue.
+// Downlink messages with QueueAt in the future are held by the Application Server until that time.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
-	return as.downlinkQueueOp(ctx, ids, io.CleanDownlinks(items), ttnpb.AsNsClient.DownlinkQueuePush)
+	items, err := as.scheduleDownlinks(ctx, ids, io.CleanDownlinks(items))
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	return as.downlinkQueueOp(ctx, ids, items, ttnpb.AsNsClient.DownlinkQueuePush)
 }
 
 // DownlinkQueueReplace replaces the end device's application downlink queue with the given downlink messages.
+// Downlink messages with QueueAt in the future are held by the Application Server until that time.
+// Downlink messages that are already held by the Application Server are not replaced.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueueReplace(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
-	return as.downlinkQueueOp(ctx, ids, io.CleanDownlinks(items), ttnpb.AsNsClient.DownlinkQueueReplace)
+	items, err := as.scheduleDownlinks(ctx, ids, io.CleanDownlinks(items))
+	if err != nil {
+		return err
+	}
+	return as.downlinkQueueOp(ctx, ids, items, ttnpb.AsNsClient.DownlinkQueueReplace)
 }
 
 var errNoAppSKey = errors.DefineCorruption("no_app_s_key", "no AppSKey")
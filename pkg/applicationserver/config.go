@@ -52,13 +52,14 @@ type InteropConfig struct {
 
 // Config represents the ApplicationServer configuration.
 type Config struct {
-	LinkMode string         `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
-	Devices  DeviceRegistry `name:"-"`
-	Links    LinkRegistry   `name:"-"`
-	MQTT     MQTTConfig     `name:"mqtt" description:"MQTT configuration"`
-	Webhooks WebhooksConfig `name:"webhooks" description:"Webhooks configuration"`
-	PubSub   PubSubConfig   `name:"pubsub" description:"Pub/sub messaging configuration"`
-	Interop  InteropConfig  `name:"interop" description:"Interop client configuration"`
+	LinkMode      string            `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
+	Devices       DeviceRegistry    `name:"-"`
+	Links         LinkRegistry      `name:"-"`
+	DownlinkTasks DownlinkTaskQueue `name:"-"`
+	MQTT          MQTTConfig        `name:"mqtt" description:"MQTT configuration"`
+	Webhooks      WebhooksConfig    `name:"webhooks" description:"Webhooks configuration"`
+	PubSub        PubSubConfig      `name:"pubsub" description:"Pub/sub messaging configuration"`
+	Interop       InteropConfig     `name:"interop" description:"Interop client configuration"`
 }
 
 var errLinkMode = errors.DefineInvalidArgument("link_mode", "invalid link mode `{value}`")
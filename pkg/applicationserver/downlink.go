@@ -0,0 +1,106 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DownlinkTaskQueue represents an entity, that holds application downlink messages sorted by the time they are
+// pushed to the Network Server.
+type DownlinkTaskQueue interface {
+	// Add adds the downlink message for the device identified by ids, to be popped at time t.
+	// Implementations must ensure that Add returns fast.
+	Add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, down *ttnpb.ApplicationDownlink, t time.Time) error
+
+	// Pop calls f on the most recent downlink message in the schedule, for which timestamp is in range [0, time.Now()],
+	// if such is available, otherwise it blocks until it is.
+	// Context passed to f must be derived from ctx.
+	// Implementations must respect ctx.Deadline() value on best-effort basis, if such is present.
+	Pop(ctx context.Context, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.ApplicationDownlink) error) error
+}
+
+var (
+	errDownlinkExpired    = errors.DefineFailedPrecondition("downlink_expired", "downlink expired")
+	errNoDownlinkSchedule = errors.DefineFailedPrecondition("no_downlink_schedule", "no downlink schedule; downlink cannot be queued at a later time")
+)
+
+// scheduleDownlinks adds the downlink messages with QueueAt in the future to the downlink task queue.
+// scheduleDownlinks returns the downlink messages that should be queued immediately.
+func (as *ApplicationServer) scheduleDownlinks(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) ([]*ttnpb.ApplicationDownlink, error) {
+	now := time.Now()
+	var scheduled, immediate []*ttnpb.ApplicationDownlink
+	for _, item := range items {
+		if item.ExpiresAt != nil && (item.ExpiresAt.Before(now) || item.QueueAt != nil && !item.QueueAt.Before(*item.ExpiresAt)) {
+			return nil, errDownlinkExpired
+		}
+		if item.QueueAt != nil && item.QueueAt.After(now) {
+			scheduled = append(scheduled, item)
+		} else {
+			immediate = append(immediate, item)
+		}
+	}
+	if len(scheduled) == 0 {
+		return immediate, nil
+	}
+	if as.downlinkTasks == nil {
+		return nil, errNoDownlinkSchedule
+	}
+	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:downlink:schedule:%s", events.NewCorrelationID()))
+	for _, item := range scheduled {
+		item.CorrelationIDs = append(item.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
+		if err := as.downlinkTasks.Add(ctx, ids, item, *item.QueueAt); err != nil {
+			return nil, err
+		}
+		registerScheduleDownlink(ctx, ids, item)
+	}
+	return immediate, nil
+}
+
+// processDownlinkTask pushes the next scheduled downlink message to the Network Server.
+// Expired downlink messages and downlink messages that fail to be pushed are reported to the application as failed.
+func (as *ApplicationServer) processDownlinkTask(ctx context.Context) error {
+	return as.downlinkTasks.Pop(ctx, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, down *ttnpb.ApplicationDownlink) error {
+		logger := log.FromContext(ctx).WithField("device_uid", unique.ID(ctx, ids))
+		ctx = log.NewContext(ctx, logger)
+		ctx = events.ContextWithCorrelationID(ctx, down.CorrelationIDs...)
+
+		link, err := as.getLink(ctx, ids.ApplicationIdentifiers)
+		if err != nil {
+			// Without a link, the failure cannot be reported to the application.
+			logger.WithError(err).Warn("Drop scheduled downlink; application not linked")
+			registerDropDownlink(ctx, ids, down, err)
+			return nil
+		}
+		if down.ExpiresAt != nil && down.ExpiresAt.Before(time.Now()) {
+			logger.WithField("expires_at", *down.ExpiresAt).Debug("Drop expired scheduled downlink")
+			failDownlinks(ctx, ids, []*ttnpb.ApplicationDownlink{down}, errDownlinkExpired, link)
+			return nil
+		}
+		if err := as.downlinkQueueOp(ctx, ids, []*ttnpb.ApplicationDownlink{down}, ttnpb.AsNsClient.DownlinkQueuePush); err != nil {
+			// downlinkQueueOp reports the failed downlink to the application through the link.
+			logger.WithError(err).Warn("Failed to push scheduled downlink")
+		}
+		return nil
+	})
+}
@@ -0,0 +1,234 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type mockDownlinkTaskQueue struct {
+	added  []*ttnpb.ApplicationDownlink
+	popped []*ttnpb.ApplicationDownlink
+}
+
+func (q *mockDownlinkTaskQueue) Add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, down *ttnpb.ApplicationDownlink, t time.Time) error {
+	if !t.Equal(*down.QueueAt) {
+		panic("downlink added at a time different from QueueAt")
+	}
+	q.added = append(q.added, down)
+	return nil
+}
+
+func (q *mockDownlinkTaskQueue) Pop(ctx context.Context, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.ApplicationDownlink) error) error {
+	if len(q.popped) == 0 {
+		return nil
+	}
+	down := q.popped[0]
+	q.popped = q.popped[1:]
+	return f(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+	}, down)
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestScheduleDownlinks(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+	}
+	now := time.Now()
+
+	for _, tc := range []struct {
+		Name         string
+		NoQueue      bool
+		Items        []*ttnpb.ApplicationDownlink
+		ImmediateLen int
+		ScheduledLen int
+		Error        error
+	}{
+		{
+			Name: "Immediate",
+			Items: []*ttnpb.ApplicationDownlink{
+				{FPort: 1},
+				{FPort: 2, QueueAt: timePtr(now.Add(-time.Minute))},
+				{FPort: 3, ExpiresAt: timePtr(now.Add(time.Hour))},
+			},
+			ImmediateLen: 3,
+		},
+		{
+			Name: "Scheduled",
+			Items: []*ttnpb.ApplicationDownlink{
+				{FPort: 1},
+				{FPort: 2, QueueAt: timePtr(now.Add(time.Hour))},
+				{FPort: 3, QueueAt: timePtr(now.Add(time.Hour)), ExpiresAt: timePtr(now.Add(2 * time.Hour))},
+			},
+			ImmediateLen: 1,
+			ScheduledLen: 2,
+		},
+		{
+			Name:    "No queue",
+			NoQueue: true,
+			Items: []*ttnpb.ApplicationDownlink{
+				{FPort: 1, QueueAt: timePtr(now.Add(time.Hour))},
+			},
+			Error: errNoDownlinkSchedule,
+		},
+		{
+			Name: "Expired",
+			Items: []*ttnpb.ApplicationDownlink{
+				{FPort: 1, ExpiresAt: timePtr(now.Add(-time.Minute))},
+			},
+			Error: errDownlinkExpired,
+		},
+		{
+			Name: "Expires before queued",
+			Items: []*ttnpb.ApplicationDownlink{
+				{FPort: 1, QueueAt: timePtr(now.Add(2 * time.Hour)), ExpiresAt: timePtr(now.Add(time.Hour))},
+			},
+			Error: errDownlinkExpired,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			q := &mockDownlinkTaskQueue{}
+			as := &ApplicationServer{}
+			if !tc.NoQueue {
+				as.downlinkTasks = q
+			}
+
+			immediate, err := as.scheduleDownlinks(test.Context(), ids, tc.Items)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+				a.So(q.added, should.BeEmpty)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(immediate, should.HaveLength, tc.ImmediateLen)
+			a.So(q.added, should.HaveLength, tc.ScheduledLen)
+			for _, down := range q.added {
+				var hasCorrelationID bool
+				for _, id := range down.CorrelationIDs {
+					if strings.HasPrefix(id, "as:downlink:schedule:") {
+						hasCorrelationID = true
+					}
+				}
+				a.So(hasCorrelationID, should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestProcessDownlinkTaskExpired(t *testing.T) {
+	a := assertions.New(t)
+
+	q := &mockDownlinkTaskQueue{
+		popped: []*ttnpb.ApplicationDownlink{
+			{
+				FPort:     1,
+				QueueAt:   timePtr(time.Now().Add(-time.Hour)),
+				ExpiresAt: timePtr(time.Now().Add(-time.Minute)),
+			},
+		},
+	}
+	as := &ApplicationServer{
+		downlinkTasks: q,
+	}
+	// The application is not linked; the expired downlink is dropped without pushing it to the Network Server.
+	a.So(as.processDownlinkTask(test.Context()), should.BeNil)
+	a.So(q.popped, should.BeEmpty)
+}
+
+type mockDeviceRegistry struct {
+	DeviceRegistry
+}
+
+func (mockDeviceRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+	_, _, err := f(nil)
+	return nil, err
+}
+
+func TestProcessDownlinkTaskFailed(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		ExpiresAt *time.Time
+		Error     errors.Definition
+	}{
+		{
+			Name:      "Expired",
+			ExpiresAt: timePtr(time.Now().Add(-time.Minute)),
+			Error:     errDownlinkExpired,
+		},
+		{
+			Name:  "PushFailed",
+			Error: errDeviceNotFound,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := test.Context()
+			connReady := make(chan struct{})
+			close(connReady)
+			l := &link{
+				connReady: connReady,
+				upCh:      make(chan *io.ContextualApplicationUp, 1),
+			}
+			as := &ApplicationServer{
+				downlinkTasks: &mockDownlinkTaskQueue{
+					popped: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:     1,
+							QueueAt:   timePtr(time.Now().Add(-time.Hour)),
+							ExpiresAt: tc.ExpiresAt,
+						},
+					},
+				},
+				deviceRegistry: mockDeviceRegistry{},
+			}
+			as.links.Store(unique.ID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}), l)
+
+			a.So(as.processDownlinkTask(ctx), should.BeNil)
+			select {
+			case up := <-l.upCh:
+				failed := up.GetDownlinkFailed()
+				if !a.So(failed, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(failed.FPort, should.Equal, 1)
+				a.So(failed.Error.Name, should.Equal, tc.Error.Name())
+			default:
+				t.Fatal("Expected downlink failure to be reported to the application")
+			}
+		})
+	}
+}
@@ -121,6 +121,8 @@ func CleanDownlinks(items []*ttnpb.ApplicationDownlink) []*ttnpb.ApplicationDown
 			Priority:       item.Priority,
 			Confirmed:      item.Confirmed,
 			CorrelationIDs: item.CorrelationIDs,
+			ExpiresAt:      item.ExpiresAt,
+			QueueAt:        item.QueueAt,
 		})
 	}
 	return res
@@ -50,20 +50,22 @@ func applyPubSubFieldMask(dst, src *ttnpb.ApplicationPubSub, paths ...string) (*
 }
 
 // PubSubRegistry is a Redis PubSub registry.
+// The PubSubs and the set of all PubSubs are updated in the same transaction, so all keys of the registry are in
+// the same hash slot with Redis Cluster.
 type PubSubRegistry struct {
 	Redis *ttnredis.Client
 }
 
 func (r *PubSubRegistry) allKey(ctx context.Context) string {
-	return r.Redis.Key("all")
+	return r.Redis.SlotKey("all")
 }
 
 func (r *PubSubRegistry) appKey(uid string) string {
-	return r.Redis.Key("uid", uid)
+	return r.Redis.SlotKey("uid", uid)
 }
 
 func (r *PubSubRegistry) uidKey(appUID, id string) string {
-	return r.Redis.Key("uid", appUID, id)
+	return r.Redis.SlotKey("uid", appUID, id)
 }
 
 func (r *PubSubRegistry) makeUIDKeyFunc(appUID string) func(id string) string {
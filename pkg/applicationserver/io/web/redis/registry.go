@@ -53,11 +53,11 @@ type WebhookRegistry struct {
 }
 
 func (r *WebhookRegistry) appKey(uid string) string {
-	return r.Redis.Key("uid", uid)
+	return r.Redis.Key("uid", r.Redis.HashTag(uid))
 }
 
 func (r *WebhookRegistry) idKey(appUID, id string) string {
-	return r.Redis.Key("uid", appUID, id)
+	return r.Redis.Key("uid", r.Redis.HashTag(appUID), id)
 }
 
 func (r *WebhookRegistry) makeIDKeyFunc(appUID string) func(id string) string {
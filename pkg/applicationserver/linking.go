@@ -42,11 +42,36 @@ func (as *ApplicationServer) linkAll(ctx context.Context) error {
 	)
 }
 
-var linkBackoff = []time.Duration{100 * time.Millisecond, 1 * time.Second, 10 * time.Second}
+var (
+	linkBackoff = []time.Duration{100 * time.Millisecond, 1 * time.Second, 10 * time.Second}
+
+	// linkResponsibilityInterval is the interval in which an instance that is not responsible for a link
+	// checks whether it became responsible, for instance because the responsible instance left the cluster.
+	linkResponsibilityInterval = 10 * time.Second
+)
+
+// isResponsible returns whether this instance is responsible for linking the application.
+// When running multiple Application Server instances in a cluster, only the instance that the cluster
+// routes the application to links, so that the instances do not take over each other's link.
+func (as *ApplicationServer) isResponsible(ctx context.Context, ids ttnpb.ApplicationIdentifiers) bool {
+	peer, err := as.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if err != nil {
+		return true
+	}
+	conn, err := peer.Conn()
+	return err != nil || conn == as.LoopbackConn()
+}
 
 func (as *ApplicationServer) startLinkTask(ctx context.Context, ids ttnpb.ApplicationIdentifiers) {
 	ctx = log.NewContextWithField(ctx, "application_uid", unique.ID(ctx, ids))
 	as.StartTask(ctx, "link", func(ctx context.Context) error {
+		for !as.isResponsible(ctx, ids) {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(linkResponsibilityInterval):
+			}
+		}
 		target, err := as.linkRegistry.Get(ctx, ids, []string{
 			"network_server_address",
 			"api_key",
@@ -169,10 +194,17 @@ func (as *ApplicationServer) link(ctx context.Context, ids ttnpb.ApplicationIden
 	if _, loaded := as.links.LoadOrStore(uid, l); loaded {
 		return errAlreadyLinked.WithAttributes("application_uid", uid)
 	}
+	if err := as.ClaimIDs(ctx, ids); err != nil {
+		as.links.Delete(uid)
+		return err
+	}
 	go func() {
 		<-ctx.Done()
 		as.linkErrors.Store(uid, ctx.Err())
 		as.links.Delete(uid)
+		if err := as.UnclaimIDs(as.Context(), ids); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to release claim on application")
+		}
 		if err := ctx.Err(); err != nil && !errors.IsCanceled(err) {
 			log.FromContext(ctx).WithError(err).Warn("Link failed")
 			registerLinkFail(ctx, l, err)
@@ -82,6 +82,10 @@ var (
 		"as.down.data.drop", "drop downlink data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtScheduleDataDown = events.Define(
+		"as.down.data.schedule", "schedule downlink data message",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtForwardDataDown = events.Define(
 		"as.down.data.forward", "forward downlink data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -312,6 +316,10 @@ func registerReceiveDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers
 	asMetrics.downlinkReceived.WithLabelValues(ctx, ids.ApplicationID).Inc()
 }
 
+func registerScheduleDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink) {
+	events.Publish(evtScheduleDataDown(ctx, ids, msg))
+}
+
 func registerForwardDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, ns string) {
 	events.Publish(evtForwardDataDown(ctx, ids, msg))
 	asMetrics.downlinkForwarded.WithLabelValues(ctx, ns).Inc()
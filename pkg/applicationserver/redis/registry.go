@@ -50,16 +50,18 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is a Redis device registry.
+// The devices and the EUI index are updated in the same transaction, so all keys of the registry are in the same
+// hash slot with Redis Cluster.
 type DeviceRegistry struct {
 	Redis *ttnredis.Client
 }
 
 func (r *DeviceRegistry) uidKey(uid string) string {
-	return r.Redis.Key("uid", uid)
+	return r.Redis.SlotKey("uid", uid)
 }
 
 func (r *DeviceRegistry) euiKey(devEUI, joinEUI types.EUI64) string {
-	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
+	return r.Redis.SlotKey("eui", joinEUI.String(), devEUI.String())
 }
 
 // Get returns the end device by its identifiers.
@@ -241,16 +243,18 @@ func applyLinkFieldMask(dst, src *ttnpb.ApplicationLink, paths ...string) (*ttnp
 }
 
 // LinkRegistry is a store for application links.
+// The links and the set of all links are updated in the same transaction, so all keys of the registry are in the
+// same hash slot with Redis Cluster.
 type LinkRegistry struct {
 	Redis *ttnredis.Client
 }
 
 func (r *LinkRegistry) allKey(ctx context.Context) string {
-	return r.Redis.Key("all")
+	return r.Redis.SlotKey("all")
 }
 
 func (r *LinkRegistry) appKey(uid string) string {
-	return r.Redis.Key("uid", uid)
+	return r.Redis.SlotKey("uid", uid)
 }
 
 // Get returns the link by the application identifiers.
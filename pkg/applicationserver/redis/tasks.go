@@ -0,0 +1,92 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"time"
+
+	ulid "github.com/oklog/ulid/v2"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// DownlinkTaskQueue is an implementation of applicationserver.DownlinkTaskQueue.
+type DownlinkTaskQueue struct {
+	*ttnredis.TaskQueue
+}
+
+const (
+	downlinkKey = "downlink"
+
+	// downlinkTaskIDSeparator separates the unique task ID from the marshaled downlink message.
+	// It does not occur in task IDs nor in the encoding used by ttnredis.MarshalProto.
+	downlinkTaskIDSeparator = ":"
+)
+
+// NewDownlinkTaskQueue returns new downlink task queue.
+func NewDownlinkTaskQueue(cl *ttnredis.Client, maxLen int64, group, id string) *DownlinkTaskQueue {
+	return &DownlinkTaskQueue{TaskQueue: &ttnredis.TaskQueue{
+		Redis:  cl,
+		MaxLen: maxLen,
+		Group:  group,
+		ID:     id,
+		Key:    cl.Key(cl.HashTag(downlinkKey)),
+	}}
+}
+
+// Add adds the downlink message for device identified by ids at time startAt.
+// Each task is prefixed by a unique ID, so that identical downlink messages are scheduled as separate tasks.
+func (q *DownlinkTaskQueue) Add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, down *ttnpb.ApplicationDownlink, startAt time.Time) error {
+	s, err := ttnredis.MarshalProto(&ttnpb.DownlinkQueueRequest{
+		EndDeviceIdentifiers: ids,
+		Downlinks:            []*ttnpb.ApplicationDownlink{down},
+	})
+	if err != nil {
+		return err
+	}
+	id, err := ulid.New(ulid.Now(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	return q.TaskQueue.Add(id.String()+downlinkTaskIDSeparator+s, startAt, false)
+}
+
+// Pop calls f on the most recent downlink message in the schedule, for which timestamp is in range [0, time.Now()],
+// if such is available, otherwise it blocks until it is.
+func (q *DownlinkTaskQueue) Pop(ctx context.Context, f func(context.Context, ttnpb.EndDeviceIdentifiers, *ttnpb.ApplicationDownlink) error) error {
+	return q.TaskQueue.Pop(ctx, func(s string, _ time.Time) error {
+		if i := strings.Index(s, downlinkTaskIDSeparator); i >= 0 {
+			s = s[i+len(downlinkTaskIDSeparator):]
+		}
+		req := &ttnpb.DownlinkQueueRequest{}
+		if err := ttnredis.UnmarshalProto(s, req); err != nil {
+			return err
+		}
+		ctx, err := unique.WithContext(ctx, unique.ID(ctx, req.EndDeviceIdentifiers))
+		if err != nil {
+			return err
+		}
+		for _, down := range req.Downlinks {
+			if err := f(ctx, req.EndDeviceIdentifiers, down); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package argon2 implements the Argon2id algorithm method used to hash passwords.
+package argon2
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"golang.org/x/crypto/argon2"
+)
+
+var defaultInstance = Argon2id{
+	Time:       1,
+	Memory:     64 * 1024,
+	Threads:    4,
+	KeyLength:  32,
+	SaltLength: 16,
+}
+
+// Default returns the default Argon2id instance.
+func Default() Argon2id { return defaultInstance }
+
+// Argon2id is a password derivation method.
+type Argon2id struct {
+	// Time is the number of passes over the memory.
+	Time uint32
+
+	// Memory is the size of the memory in KiB.
+	Memory uint32
+
+	// Threads is the number of threads used.
+	Threads uint8
+
+	// KeyLength is the length of the desired key.
+	KeyLength uint32
+
+	// SaltLength is the length of the salt used.
+	SaltLength int
+}
+
+// Name returns the name of the Argon2id hashing method.
+func (Argon2id) Name() string {
+	return "ARGON2ID"
+}
+
+var errZeroLengthSalt = errors.DefineInternal(
+	"zero_length_salt",
+	"password salt can not have zero length",
+)
+
+// Hash hashes a plain text password.
+func (p Argon2id) Hash(plain string) (string, error) {
+	if p.SaltLength == 0 {
+		return "", errZeroLengthSalt
+	}
+
+	salt := random.Bytes(p.SaltLength)
+	key := argon2.IDKey([]byte(plain), salt, p.Time, p.Memory, p.Threads, p.KeyLength)
+	pass := fmt.Sprintf("%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		p.Name(), argon2.Version, p.Memory, p.Time, p.Threads,
+		base64.RawURLEncoding.EncodeToString(salt),
+		base64.RawURLEncoding.EncodeToString(key),
+	)
+
+	return pass, nil
+}
+
+var errInvalidArgon2id = errors.DefineInternal( // internal because hash is in DB.
+	"invalid_argon2id_format",
+	"password hash has invalid Argon2id format",
+)
+
+var errVersion = errors.DefineInternal(
+	"argon2id_version",
+	"unsupported Argon2id version `{version}`",
+)
+
+type parameters struct {
+	version int
+	memory  uint32
+	time    uint32
+	threads uint8
+	salt    []byte
+	key     []byte
+}
+
+// parse parses the parameters, salt and key from a hashed password.
+func parse(hashed string) (*parameters, error) {
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 5 {
+		return nil, errInvalidArgon2id
+	}
+
+	var params parameters
+	if _, err := fmt.Sscanf(parts[1], "v=%d", &params.version); err != nil {
+		return nil, errInvalidArgon2id.WithCause(err)
+	}
+	if params.version != argon2.Version {
+		return nil, errVersion.WithAttributes("version", params.version)
+	}
+	if _, err := fmt.Sscanf(parts[2], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return nil, errInvalidArgon2id.WithCause(err)
+	}
+
+	var err error
+	if params.salt, err = base64.RawURLEncoding.DecodeString(parts[3]); err != nil {
+		return nil, errInvalidArgon2id.WithCause(err)
+	}
+	if params.key, err = base64.RawURLEncoding.DecodeString(parts[4]); err != nil {
+		return nil, errInvalidArgon2id.WithCause(err)
+	}
+	if len(params.key) == 0 {
+		return nil, errInvalidArgon2id
+	}
+
+	return &params, nil
+}
+
+// Validate validates a plaintext password against a hashed one.
+// The format of the hashed password should be:
+//
+//     ARGON2ID$v=<version>$m=<memory>,t=<time>,p=<threads>$<salt in base64>$<key in base64>
+//
+func (Argon2id) Validate(hashed, plain string) (bool, error) {
+	params, err := parse(hashed)
+	if err != nil {
+		return false, err
+	}
+
+	// Hash the plaintext.
+	key := argon2.IDKey([]byte(plain), params.salt, params.time, params.memory, params.threads, uint32(len(params.key)))
+
+	// Compare the hashed plaintext and the stored hash.
+	return subtle.ConstantTimeCompare(key, params.key) == 1, nil
+}
+
+// NeedsRehash returns whether the hashed password was hashed with different
+// parameters than the ones of this instance.
+func (p Argon2id) NeedsRehash(hashed string) bool {
+	params, err := parse(hashed)
+	if err != nil {
+		return true
+	}
+	return params.time != p.Time ||
+		params.memory != p.Memory ||
+		params.threads != p.Threads ||
+		len(params.salt) != p.SaltLength ||
+		uint32(len(params.key)) != p.KeyLength
+}
@@ -0,0 +1,118 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package argon2
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/assertions"
+)
+
+func TestName(t *testing.T) {
+	a := New(t)
+	h := &Argon2id{}
+	a.So(h.Name(), ShouldEqual, "ARGON2ID")
+}
+
+func TestHash(t *testing.T) {
+	a := New(t)
+
+	h := &Argon2id{
+		Time:       1,
+		Memory:     1024,
+		Threads:    2,
+		KeyLength:  32,
+		SaltLength: 16,
+	}
+
+	plain := "secret"
+
+	hashed, err := h.Hash(plain)
+	a.So(err, ShouldBeNil)
+	a.So(hashed, ShouldStartWith, "ARGON2ID$v=19$m=1024,t=1,p=2$")
+
+	// should validate against plain
+	{
+		ok, err := h.Validate(hashed, plain)
+		a.So(err, ShouldBeNil)
+		a.So(ok, ShouldBeTrue)
+	}
+
+	// should not validate against wrong plain
+	{
+		other, err := h.Hash("othersecret")
+		a.So(err, ShouldBeNil)
+		ok, err := h.Validate(other, plain)
+		a.So(err, ShouldBeNil)
+		a.So(ok, ShouldBeFalse)
+	}
+
+	// should not parse a bad format
+	for _, bad := range []string{
+		"badformat",
+		"ARGON2ID$v=18$m=1024,t=1,p=2$c2FsdA$a2V5",
+		"ARGON2ID$v=19$m=bad,t=1,p=2$c2FsdA$a2V5",
+		"ARGON2ID$v=19$m=1024,t=1,p=2$c2FsdA$foo==",
+		"ARGON2ID$v=19$m=1024,t=1,p=2$c2FsdA$",
+	} {
+		ok, err := h.Validate(bad, plain)
+		a.So(err, ShouldNotBeNil)
+		a.So(ok, ShouldBeFalse)
+	}
+}
+
+func TestHashZeroSalt(t *testing.T) {
+	a := New(t)
+
+	h := &Argon2id{
+		Time:      1,
+		Memory:    1024,
+		Threads:   2,
+		KeyLength: 32,
+	}
+
+	_, err := h.Hash("secret")
+	a.So(err, ShouldNotBeNil)
+}
+
+func TestNeedsRehash(t *testing.T) {
+	a := New(t)
+
+	weak := &Argon2id{
+		Time:       1,
+		Memory:     1024,
+		Threads:    1,
+		KeyLength:  16,
+		SaltLength: 8,
+	}
+	strong := &Argon2id{
+		Time:       2,
+		Memory:     2048,
+		Threads:    2,
+		KeyLength:  32,
+		SaltLength: 16,
+	}
+
+	weakHash, err := weak.Hash("secret")
+	a.So(err, ShouldBeNil)
+	strongHash, err := strong.Hash("secret")
+	a.So(err, ShouldBeNil)
+
+	a.So(weak.NeedsRehash(weakHash), ShouldBeFalse)
+	a.So(weak.NeedsRehash(strongHash), ShouldBeTrue)
+	a.So(strong.NeedsRehash(weakHash), ShouldBeTrue)
+	a.So(strong.NeedsRehash(strongHash), ShouldBeFalse)
+	a.So(strong.NeedsRehash("badformat"), ShouldBeTrue)
+}
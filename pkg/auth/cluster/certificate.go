@@ -0,0 +1,141 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// CertificateAuthType used to identify components that authenticate with client certificates.
+var CertificateAuthType = "ClusterCertificate"
+
+type clusterRolesKeyType struct{}
+
+var clusterRolesKey = clusterRolesKeyType{}
+
+var (
+	errNoClientCertificate      = errors.DefineUnauthenticated("no_client_certificate", "no verified client certificate")
+	errNoSPIFFEID               = errors.DefinePermissionDenied("no_spiffe_id", "client certificate has no SPIFFE ID in trust domain `{trust_domain}`")
+	errInvalidSPIFFEClusterRole = errors.DefinePermissionDenied("spiffe_cluster_role", "invalid cluster role `{role}` in SPIFFE ID `{spiffe_id}`")
+	errClusterRole              = errors.DefinePermissionDenied("cluster_role", "caller does not have any of the cluster roles `{roles}`")
+)
+
+// Roles returns the cluster roles of the caller, as encoded in its client certificate.
+// The roles are only available after VerifyCertificateSource.
+func Roles(ctx context.Context) []ttnpb.ClusterRole {
+	roles, _ := ctx.Value(clusterRolesKey).([]ttnpb.ClusterRole)
+	return roles
+}
+
+// RequireRoles returns a context in which the call is only authorized if the caller has any of the given
+// cluster roles. The roles of the caller are only known if it authenticated with a client certificate,
+// so calls that authenticate with a cluster key or with the loopback key are not affected.
+func RequireRoles(ctx context.Context, roles ...ttnpb.ClusterRole) context.Context {
+	callerRoles := Roles(ctx)
+	if len(roles) == 0 || callerRoles == nil {
+		return ctx
+	}
+	if err := Authorized(ctx); err != nil {
+		return ctx
+	}
+	for _, callerRole := range callerRoles {
+		for _, role := range roles {
+			if callerRole == role {
+				return ctx
+			}
+		}
+	}
+	return NewContext(ctx, errClusterRole.WithAttributes("roles", roles))
+}
+
+// Rights returns the universal rights of the caller in the cluster. Callers that authenticated with
+// a client certificate only get the rights on the entities that their cluster roles work with.
+func Rights(ctx context.Context) *ttnpb.Rights {
+	roles := Roles(ctx)
+	if roles == nil {
+		return ttnpb.AllClusterRights.Implied()
+	}
+	rights := &ttnpb.Rights{}
+	for _, role := range roles {
+		switch role {
+		case ttnpb.ClusterRole_ACCESS, ttnpb.ClusterRole_ENTITY_REGISTRY:
+			rights = rights.Union(ttnpb.AllClusterRights)
+		case ttnpb.ClusterRole_GATEWAY_SERVER:
+			rights = rights.Union(ttnpb.AllGatewayRights)
+		case ttnpb.ClusterRole_NETWORK_SERVER,
+			ttnpb.ClusterRole_APPLICATION_SERVER,
+			ttnpb.ClusterRole_JOIN_SERVER,
+			ttnpb.ClusterRole_CRYPTO_SERVER,
+			ttnpb.ClusterRole_DEVICE_CLAIMING_SERVER:
+			rights = rights.Union(ttnpb.AllApplicationRights)
+		}
+	}
+	return ttnpb.AllClusterRights.Implied().Intersect(rights.Implied())
+}
+
+// VerifyCertificateSource inspects whether the caller presented a verified client certificate
+// with a SPIFFE ID in the trust domain, and returns a context containing the result and the
+// cluster roles of the caller. The SPIFFE ID encodes the cluster roles as path segments, i.e.
+// spiffe://<trust-domain>/<role>[/<role>...], where each role is a lowercase cluster role name,
+// such as gateway_server. Calls that do not present a verified client certificate on the transport,
+// including calls over the loopback connection, are not authorized.
+func VerifyCertificateSource(ctx context.Context, trustDomain string) context.Context {
+	roles, err := verifyCertificateSource(ctx, trustDomain)
+	if err == nil && roles != nil {
+		ctx = context.WithValue(ctx, clusterRolesKey, roles)
+	}
+	return NewContext(ctx, err)
+}
+
+func verifyCertificateSource(ctx context.Context, trustDomain string) ([]ttnpb.ClusterRole, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	switch md.AuthType {
+	case CertificateAuthType:
+	case "":
+		return nil, errNoClientCertificate
+	default:
+		return nil, errUnsupportedAuthType.WithAttributes("auth_type", md.AuthType)
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil, errNoClientCertificate
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, errNoClientCertificate
+	}
+	for _, uri := range tlsInfo.State.VerifiedChains[0][0].URIs {
+		if uri.Scheme != "spiffe" || uri.Host != trustDomain {
+			continue
+		}
+		var roles []ttnpb.ClusterRole
+		for _, segment := range strings.Split(strings.Trim(uri.Path, "/"), "/") {
+			role, ok := ttnpb.ClusterRole_value[strings.ToUpper(segment)]
+			if !ok {
+				return nil, errInvalidSPIFFEClusterRole.WithAttributes("role", segment, "spiffe_id", uri.String())
+			}
+			roles = append(roles, ttnpb.ClusterRole(role))
+		}
+		return roles, nil
+	}
+	return nil, errNoSPIFFEID.WithAttributes("trust_domain", trustDomain)
+}
@@ -0,0 +1,150 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type inProcessAddr struct{}
+
+func (inProcessAddr) Network() string { return "in-process" }
+func (inProcessAddr) String() string  { return "in-process" }
+
+func TestVerifyCertificate(t *testing.T) {
+	ctxWithCertificate := func(uris ...string) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"authorization", fmt.Sprintf("%s %s", cluster.CertificateAuthType, "gs1"),
+		))
+		if uris == nil {
+			return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{}})
+		}
+		cert := &x509.Certificate{}
+		for _, uri := range uris {
+			u, err := url.Parse(uri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cert.URIs = append(cert.URIs, u)
+		}
+		return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{cert}},
+			},
+		}})
+	}
+
+	for _, tc := range []struct {
+		name    string
+		ctx     context.Context
+		success bool
+		roles   []ttnpb.ClusterRole
+	}{
+		{
+			name: "NoPeer",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				"authorization", fmt.Sprintf("%s %s", cluster.CertificateAuthType, "gs1"),
+			)),
+		},
+		{
+			name: "NoCertificate",
+			ctx:  ctxWithCertificate(),
+		},
+		{
+			name: "InProcess",
+			ctx: peer.NewContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				"authorization", fmt.Sprintf("%s %s", cluster.CertificateAuthType, "gs1"),
+			)), &peer.Peer{Addr: inProcessAddr{}}),
+		},
+		{
+			name: "OtherTrustDomain",
+			ctx:  ctxWithCertificate("spiffe://other.example.com/gateway_server"),
+		},
+		{
+			name: "InvalidRole",
+			ctx:  ctxWithCertificate("spiffe://cluster.example.com/gateway_server/foo"),
+		},
+		{
+			name:    "Valid",
+			ctx:     ctxWithCertificate("https://www.example.com", "spiffe://cluster.example.com/gateway_server/network_server"),
+			success: true,
+			roles:   []ttnpb.ClusterRole{ttnpb.ClusterRole_GATEWAY_SERVER, ttnpb.ClusterRole_NETWORK_SERVER},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := cluster.VerifyCertificateSource(tc.ctx, "cluster.example.com")
+			if tc.success {
+				a.So(cluster.Authorized(ctx), should.BeNil)
+			} else {
+				a.So(cluster.Authorized(ctx), should.NotBeNil)
+			}
+			a.So(cluster.Roles(ctx), should.Resemble, tc.roles)
+		})
+	}
+}
+
+func TestRequireRoles(t *testing.T) {
+	a := assertions.New(t)
+
+	withRoles := func(roles ...ttnpb.ClusterRole) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"authorization", fmt.Sprintf("%s %s", cluster.CertificateAuthType, "peer"),
+		))
+		segments := make([]string, 0, len(roles))
+		for _, role := range roles {
+			segments = append(segments, strings.ToLower(role.String()))
+		}
+		u, err := url.Parse(fmt.Sprintf("spiffe://cluster.example.com/%s", strings.Join(segments, "/")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx = peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				VerifiedChains: [][]*x509.Certificate{{{URIs: []*url.URL{u}}}},
+			},
+		}})
+		return cluster.VerifyCertificateSource(ctx, "cluster.example.com")
+	}
+
+	gsCtx := withRoles(ttnpb.ClusterRole_GATEWAY_SERVER)
+	a.So(cluster.Authorized(cluster.RequireRoles(gsCtx)), should.BeNil)
+	a.So(cluster.Authorized(cluster.RequireRoles(gsCtx, ttnpb.ClusterRole_GATEWAY_SERVER)), should.BeNil)
+	a.So(errors.IsPermissionDenied(cluster.Authorized(cluster.RequireRoles(gsCtx, ttnpb.ClusterRole_NETWORK_SERVER))), should.BeTrue)
+	a.So(cluster.Rights(gsCtx).Sorted(), should.Resemble, ttnpb.AllClusterRights.Implied().Intersect(ttnpb.AllGatewayRights.Implied()).Sorted())
+
+	nsCtx := withRoles(ttnpb.ClusterRole_NETWORK_SERVER, ttnpb.ClusterRole_JOIN_SERVER)
+	a.So(cluster.Authorized(cluster.RequireRoles(nsCtx, ttnpb.ClusterRole_APPLICATION_SERVER, ttnpb.ClusterRole_NETWORK_SERVER)), should.BeNil)
+	a.So(cluster.Rights(nsCtx).Sorted(), should.Resemble, ttnpb.AllClusterRights.Implied().Intersect(ttnpb.AllApplicationRights.Implied()).Sorted())
+
+	keyCtx := cluster.NewContext(context.Background(), nil)
+	a.So(cluster.Authorized(cluster.RequireRoles(keyCtx, ttnpb.ClusterRole_NETWORK_SERVER)), should.BeNil)
+	a.So(cluster.Rights(keyCtx).Sorted(), should.Resemble, ttnpb.AllClusterRights.Implied().Sorted())
+}
@@ -41,6 +41,15 @@ var (
 	errInvalidClusterKey   = errors.DefinePermissionDenied("cluster_key", "invalid cluster key")
 )
 
+// IsAuthType returns whether the auth type is used to authenticate calls within the cluster.
+func IsAuthType(authType string) bool {
+	switch authType {
+	case AuthType, CertificateAuthType, LoopbackAuthType:
+		return true
+	}
+	return false
+}
+
 // NewContext returns a context containing the cluster authentication result.
 func NewContext(ctx context.Context, err error) context.Context {
 	ctx = context.WithValue(ctx, clusterAuthKey, err == nil)
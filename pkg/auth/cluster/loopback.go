@@ -0,0 +1,68 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"google.golang.org/grpc/peer"
+)
+
+// LoopbackAuthType used to identify calls of a component to itself over the loopback connection.
+var LoopbackAuthType = "ClusterLoopback"
+
+// inProcessNetwork is the network of the peer address of calls over the loopback connection.
+const inProcessNetwork = "in-process"
+
+var (
+	errNoLoopbackKey      = errors.DefineUnauthenticated("no_loopback_key", "no loopback key auth specified")
+	errNotLoopback        = errors.DefineUnauthenticated("not_loopback", "call is not made over the loopback connection")
+	errInvalidLoopbackKey = errors.DefinePermissionDenied("loopback_key", "invalid loopback key")
+)
+
+// VerifyLoopbackSource inspects whether the call is made over the loopback connection with the given key,
+// and returns a context containing the result. The key must never leave the process, as calls from outside
+// the process, such as HTTP requests, reach the gRPC server over the loopback connection as well.
+func VerifyLoopbackSource(ctx context.Context, key []byte) context.Context {
+	err := verifyLoopbackSource(ctx, key)
+	return NewContext(ctx, err)
+}
+
+func verifyLoopbackSource(ctx context.Context, key []byte) error {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	switch md.AuthType {
+	case LoopbackAuthType:
+	case "":
+		return errNoLoopbackKey
+	default:
+		return errUnsupportedAuthType.WithAttributes("auth_type", md.AuthType)
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil || p.Addr.Network() != inProcessNetwork {
+		return errNotLoopback
+	}
+	value, err := hex.DecodeString(md.AuthValue)
+	if err != nil {
+		return errInvalidLoopbackKey.WithCause(err)
+	}
+	if len(key) == 0 || subtle.ConstantTimeCompare(key, value) != 1 {
+		return errInvalidLoopbackKey
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster_test
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestVerifyLoopback(t *testing.T) {
+	key := []byte{0x2A, 0x9C, 0x2C, 0x3C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C}
+	withAuth := func(authType string, value []byte, addr net.Addr) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			"authorization", fmt.Sprintf("%s %s", authType, hex.EncodeToString(value)),
+		))
+		return peer.NewContext(ctx, &peer.Peer{Addr: addr})
+	}
+
+	for _, tc := range []struct {
+		name      string
+		ctx       context.Context
+		assertion func(error) bool
+	}{
+		{
+			name:      "NoAuth",
+			ctx:       peer.NewContext(context.Background(), &peer.Peer{Addr: inProcessAddr{}}),
+			assertion: errors.IsUnauthenticated,
+		},
+		{
+			name:      "ClusterKey",
+			ctx:       withAuth(cluster.AuthType, key, inProcessAddr{}),
+			assertion: errors.IsInvalidArgument,
+		},
+		{
+			name:      "TCP",
+			ctx:       withAuth(cluster.LoopbackAuthType, key, &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1885}),
+			assertion: errors.IsUnauthenticated,
+		},
+		{
+			name:      "WrongKey",
+			ctx:       withAuth(cluster.LoopbackAuthType, []byte{0x01, 0x02}, inProcessAddr{}),
+			assertion: errors.IsPermissionDenied,
+		},
+		{
+			name: "Valid",
+			ctx:  withAuth(cluster.LoopbackAuthType, key, inProcessAddr{}),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := cluster.VerifyLoopbackSource(tc.ctx, key)
+			err := cluster.Authorized(ctx)
+			if tc.assertion != nil {
+				a.So(tc.assertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(cluster.Roles(ctx), should.BeNil)
+			a.So(cluster.Rights(ctx).Sorted(), should.Resemble, ttnpb.AllClusterRights.Implied().Sorted())
+		})
+	}
+}
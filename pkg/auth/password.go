@@ -19,6 +19,7 @@ import (
 	"crypto/subtle"
 	"strings"
 
+	"go.thethings.network/lorawan-stack/pkg/auth/argon2"
 	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 )
@@ -61,6 +62,69 @@ func HashValidatorFromContext(ctx context.Context) HashValidator {
 // Be sure to add your hashing method to this list if you implement a new one.
 var hashValidators = []HashValidator{
 	defaultHashValidator,
+	argon2.Default(),
+}
+
+var errUnknownHashingMethodName = errors.DefineInvalidArgument(
+	"unknown_hashing_method_name",
+	"unknown hashing method `{method}`",
+)
+
+// HashValidatorByName returns the default instance of the supported hashing method with the given name.
+func HashValidatorByName(name string) (HashValidator, error) {
+	for _, method := range hashValidators {
+		if strings.EqualFold(name, method.Name()) {
+			return method, nil
+		}
+	}
+	return nil, errUnknownHashingMethodName.WithAttributes("method", name)
+}
+
+// Rehasher is implemented by hashing methods that can tell whether a hashed secret
+// was hashed with weaker parameters than the ones they use.
+type Rehasher interface {
+	NeedsRehash(hashed string) bool
+}
+
+type passwordHashValidatorContextKeyType struct{}
+
+var passwordHashValidatorContextKey passwordHashValidatorContextKeyType
+
+// NewContextWithPasswordHashValidator returns a context derived from parent that contains the HashValidator used for passwords.
+func NewContextWithPasswordHashValidator(parent context.Context, hashValidator HashValidator) context.Context {
+	return context.WithValue(parent, passwordHashValidatorContextKey, hashValidator)
+}
+
+// PasswordHashValidatorFromContext returns the HashValidator used for passwords from the context if present.
+// Otherwise it returns the HashValidator from HashValidatorFromContext.
+func PasswordHashValidatorFromContext(ctx context.Context) HashValidator {
+	if hashValidator, ok := ctx.Value(passwordHashValidatorContextKey).(HashValidator); ok {
+		return hashValidator
+	}
+	return HashValidatorFromContext(ctx)
+}
+
+// HashPassword hashes a plaintext password.
+func HashPassword(ctx context.Context, plain string) (string, error) {
+	str, err := PasswordHashValidatorFromContext(ctx).Hash(plain)
+	if err != nil {
+		return "", err
+	}
+	return str, nil
+}
+
+// NeedsPasswordRehash returns whether the hashed password was hashed with a different
+// hashing method or with weaker parameters than the password HashValidator from the context.
+func NeedsPasswordRehash(ctx context.Context, hashed string) bool {
+	hashValidator := PasswordHashValidatorFromContext(ctx)
+	parts := strings.SplitN(hashed, "$", 2)
+	if !strings.EqualFold(parts[0], hashValidator.Name()) {
+		return true
+	}
+	if rehasher, ok := hashValidator.(Rehasher); ok {
+		return rehasher.NeedsRehash(hashed)
+	}
+	return false
 }
 
 // Hash hashes a plaintext secret.
@@ -15,9 +15,13 @@
 package auth
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/argon2"
+	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
@@ -71,3 +75,63 @@ func TestLegacy(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(ok, should.BeTrue)
 }
+
+func TestHashPassword(t *testing.T) {
+	a := assertions.New(t)
+
+	weak := pbkdf2.PBKDF2{
+		Iterations: 10,
+		KeyLength:  32,
+		Algorithm:  pbkdf2.Sha256,
+		SaltLength: 16,
+	}
+	strong := argon2.Argon2id{
+		Time:       1,
+		Memory:     1024,
+		Threads:    1,
+		KeyLength:  32,
+		SaltLength: 16,
+	}
+
+	ctx := NewContextWithHashValidator(test.Context(), weak)
+
+	// Without a password hashing method, the default hashing method is used.
+	weakHash, err := HashPassword(ctx, "secret")
+	a.So(err, should.BeNil)
+	a.So(weakHash, should.StartWith, "PBKDF2$")
+	a.So(NeedsPasswordRehash(ctx, weakHash), should.BeFalse)
+
+	ctx = NewContextWithPasswordHashValidator(ctx, strong)
+
+	strongHash, err := HashPassword(ctx, "secret")
+	a.So(err, should.BeNil)
+	a.So(strongHash, should.StartWith, "ARGON2ID$")
+	a.So(NeedsPasswordRehash(ctx, strongHash), should.BeFalse)
+	a.So(NeedsPasswordRehash(ctx, weakHash), should.BeTrue)
+
+	// Hashes with other parameters are rehashed.
+	tuned := strong
+	tuned.Memory = 2048
+	a.So(NeedsPasswordRehash(NewContextWithPasswordHashValidator(ctx, tuned), strongHash), should.BeTrue)
+
+	// Existing hashes keep validating.
+	for _, hashed := range []string{weakHash, strongHash} {
+		ok, err := Validate(hashed, "secret")
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeTrue)
+	}
+}
+
+func TestHashValidatorByName(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, name := range []string{"PBKDF2", "argon2id"} {
+		hashValidator, err := HashValidatorByName(name)
+		if a.So(err, should.BeNil) {
+			a.So(strings.ToUpper(hashValidator.Name()), should.Equal, strings.ToUpper(name))
+		}
+	}
+
+	_, err := HashValidatorByName("unknown")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -143,3 +143,25 @@ func keyLen(key string) (int, error) {
 	}
 	return len(buf), nil
 }
+
+// NeedsRehash returns whether the hashed password was hashed with a different
+// algorithm or weaker parameters than the ones of this instance.
+func (p PBKDF2) NeedsRehash(hashed string) bool {
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 5 {
+		return true
+	}
+	algorithm, err := parseAlgorithm(parts[1])
+	if err != nil || algorithm != p.Algorithm {
+		return true
+	}
+	iter, err := strconv.ParseInt(parts[2], 10, 32)
+	if err != nil || int(iter) < p.Iterations {
+		return true
+	}
+	keylen, err := keyLen(parts[4])
+	if err != nil || keylen < p.KeyLength {
+		return true
+	}
+	return len(parts[3]) < p.SaltLength
+}
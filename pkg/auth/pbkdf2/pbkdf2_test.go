@@ -152,3 +152,29 @@ func TestInvalidSaltLength(t *testing.T) {
 	_, err := h.Hash("foo")
 	a.So(err, ShouldNotBeNil)
 }
+
+func TestNeedsRehash(t *testing.T) {
+	a := New(t)
+
+	h := &PBKDF2{
+		Iterations: 1000,
+		KeyLength:  32,
+		Algorithm:  Sha256,
+		SaltLength: 16,
+	}
+
+	hashed, err := h.Hash("secret")
+	a.So(err, ShouldBeNil)
+	a.So(h.NeedsRehash(hashed), ShouldBeFalse)
+
+	for _, other := range []PBKDF2{
+		{Iterations: 2000, KeyLength: 32, Algorithm: Sha256, SaltLength: 16},
+		{Iterations: 1000, KeyLength: 64, Algorithm: Sha256, SaltLength: 16},
+		{Iterations: 1000, KeyLength: 32, Algorithm: Sha512, SaltLength: 16},
+		{Iterations: 1000, KeyLength: 32, Algorithm: Sha256, SaltLength: 32},
+	} {
+		a.So(other.NeedsRehash(hashed), ShouldBeTrue)
+	}
+
+	a.So(h.NeedsRehash("badformat"), ShouldBeTrue)
+}
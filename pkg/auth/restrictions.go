@@ -0,0 +1,68 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var (
+	errInvalidCIDR        = errors.DefineInvalidArgument("cidr", "invalid CIDR `{cidr}`")
+	errTokenExpired       = errors.DefineUnauthenticated("token_expired", "token expired at `{expires_at}`")
+	errSourceIPNotAllowed = errors.DefinePermissionDenied("source_ip_not_allowed", "source IP `{source_ip}` is not allowed")
+)
+
+// ValidateCIDRs checks that the given source IP address ranges are valid CIDRs.
+func ValidateCIDRs(cidrs ...string) error {
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errInvalidCIDR.WithAttributes("cidr", cidr).WithCause(err)
+		}
+	}
+	return nil
+}
+
+// ValidateExpiry checks that a token that expires at expiresAt is not expired at now.
+// Tokens without expiry time never expire.
+func ValidateExpiry(expiresAt *time.Time, now time.Time) error {
+	if expiresAt == nil || now.Before(*expiresAt) {
+		return nil
+	}
+	return errTokenExpired.WithAttributes("expires_at", expiresAt.UTC().Format(time.RFC3339))
+}
+
+// ValidateSourceIP checks that the source IP address is within one of the allowed CIDRs.
+// If no CIDRs are given, any source IP address is allowed.
+func ValidateSourceIP(sourceIP string, allowedCIDRs ...string) error {
+	if len(allowedCIDRs) == 0 {
+		return nil
+	}
+	ip := net.ParseIP(sourceIP)
+	if ip != nil {
+		for _, cidr := range allowedCIDRs {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				continue
+			}
+			if ipNet.Contains(ip) {
+				return nil
+			}
+		}
+	}
+	return errSourceIPNotAllowed.WithAttributes("source_ip", sourceIP)
+}
@@ -0,0 +1,79 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestValidateCIDRs(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(auth.ValidateCIDRs(), should.BeNil)
+	a.So(auth.ValidateCIDRs("10.0.0.0/8", "2001:db8::/32"), should.BeNil)
+
+	err := auth.ValidateCIDRs("10.0.0.0/8", "10.0.0.1")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestValidateExpiry(t *testing.T) {
+	a := assertions.New(t)
+
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+
+	a.So(auth.ValidateExpiry(nil, now), should.BeNil)
+	a.So(auth.ValidateExpiry(&future, now), should.BeNil)
+
+	err := auth.ValidateExpiry(&past, now)
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+
+	err = auth.ValidateExpiry(&now, now)
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+}
+
+func TestValidateSourceIP(t *testing.T) {
+	for _, tc := range []struct {
+		SourceIP     string
+		AllowedCIDRs []string
+		Allowed      bool
+	}{
+		{SourceIP: "192.0.2.1", Allowed: true},
+		{SourceIP: "", Allowed: true},
+		{SourceIP: "192.0.2.1", AllowedCIDRs: []string{"192.0.2.0/24"}, Allowed: true},
+		{SourceIP: "192.0.2.1", AllowedCIDRs: []string{"10.0.0.0/8", "192.0.2.1/32"}, Allowed: true},
+		{SourceIP: "2001:db8::1", AllowedCIDRs: []string{"2001:db8::/32"}, Allowed: true},
+		{SourceIP: "198.51.100.1", AllowedCIDRs: []string{"192.0.2.0/24"}, Allowed: false},
+		{SourceIP: "2001:db9::1", AllowedCIDRs: []string{"2001:db8::/32"}, Allowed: false},
+		{SourceIP: "", AllowedCIDRs: []string{"192.0.2.0/24"}, Allowed: false},
+		{SourceIP: "invalid", AllowedCIDRs: []string{"0.0.0.0/0"}, Allowed: false},
+	} {
+		t.Run(tc.SourceIP, func(t *testing.T) {
+			a := assertions.New(t)
+			err := auth.ValidateSourceIP(tc.SourceIP, tc.AllowedCIDRs...)
+			if tc.Allowed {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			}
+		})
+	}
+}
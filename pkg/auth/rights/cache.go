@@ -29,13 +29,19 @@ var now = time.Now // override this in unit tests
 
 func newReq(ctx context.Context, id ttnpb.Identifiers) cachedReq {
 	md := rpcmetadata.FromIncomingContext(ctx)
-	return cachedReq{UniqueID: unique.ID(ctx, id), AuthType: md.AuthType, AuthValue: md.AuthValue}
+	return cachedReq{
+		UniqueID:  unique.ID(ctx, id),
+		AuthType:  md.AuthType,
+		AuthValue: md.AuthValue,
+		SourceIP:  rpcmetadata.SourceIP(ctx),
+	}
 }
 
 type cachedReq struct {
 	UniqueID  string
 	AuthType  string
 	AuthValue string
+	SourceIP  string
 }
 
 func newRes() *cachedRes {
@@ -16,11 +16,14 @@ package rights
 
 import (
 	"context"
+	"strings"
 
+	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // Fetcher interface for rights fetching.
@@ -110,12 +113,44 @@ type accessFetcher struct {
 
 var errNoISConn = errors.DefineUnavailable("no_identity_server_conn", "no connection to Identity Server")
 
+// forwardAuth returns the context and call option for forwarding the caller's credentials to the Identity Server.
+// The source IP address of the caller is forwarded in the X-Forwarded-For header, which the Identity Server
+// only uses if this component is one of its trusted proxies. The source IP address restrictions of API keys are
+// therefore also validated here, against the source IP address of the caller.
+func (f accessFetcher) forwardAuth(ctx context.Context, cc *grpc.ClientConn) (context.Context, grpc.CallOption, error) {
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	if err != nil {
+		return nil, nil, err
+	}
+	sourceIP := rpcmetadata.SourceIP(ctx)
+	if sourceIP != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", sourceIP)
+	}
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if strings.ToLower(md.AuthType) != "bearer" {
+		return ctx, callOpt, nil
+	}
+	if tokenType, _, _, err := auth.SplitToken(md.AuthValue); err != nil || tokenType != auth.APIKey {
+		return ctx, callOpt, nil
+	}
+	info, err := ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if apiKey := info.GetAPIKey(); apiKey != nil {
+		if err := auth.ValidateSourceIP(sourceIP, apiKey.AllowedCIDRs...); err != nil {
+			return nil, nil, err
+		}
+	}
+	return ctx, callOpt, nil
+}
+
 func (f accessFetcher) ApplicationRights(ctx context.Context, appID ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error) {
 	cc := f.getConn(ctx)
 	if cc == nil {
 		return nil, errNoISConn
 	}
-	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	ctx, callOpt, err := f.forwardAuth(ctx, cc)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +167,7 @@ func (f accessFetcher) ClientRights(ctx context.Context, clientID ttnpb.ClientId
 	if cc == nil {
 		return nil, errNoISConn
 	}
-	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	ctx, callOpt, err := f.forwardAuth(ctx, cc)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +184,7 @@ func (f accessFetcher) GatewayRights(ctx context.Context, gtwID ttnpb.GatewayIde
 	if cc == nil {
 		return nil, errNoISConn
 	}
-	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	ctx, callOpt, err := f.forwardAuth(ctx, cc)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +201,7 @@ func (f accessFetcher) OrganizationRights(ctx context.Context, orgID ttnpb.Organ
 	if cc == nil {
 		return nil, errNoISConn
 	}
-	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	ctx, callOpt, err := f.forwardAuth(ctx, cc)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +218,7 @@ func (f accessFetcher) UserRights(ctx context.Context, userID ttnpb.UserIdentifi
 	if cc == nil {
 		return nil, errNoISConn
 	}
-	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, f.allowInsecure)
+	ctx, callOpt, err := f.forwardAuth(ctx, cc)
 	if err != nil {
 		return nil, err
 	}
@@ -20,13 +20,16 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func fetchRights(ctx context.Context, id string, f Fetcher) (res struct {
@@ -88,8 +91,18 @@ type mockUserAccessServer struct {
 	*mockFetcher
 }
 
+type mockEntityAccessServer struct {
+	ttnpb.EntityAccessServer
+	authInfo *ttnpb.AuthInfoResponse
+}
+
+func (as mockEntityAccessServer) AuthInfo(context.Context, *types.Empty) (*ttnpb.AuthInfoResponse, error) {
+	return as.authInfo, nil
+}
+
 type mockAccessServer struct {
 	mockFetcher
+	authInfo *ttnpb.AuthInfoResponse
 }
 
 func (as *mockAccessServer) Server() *grpc.Server {
@@ -99,6 +112,7 @@ func (as *mockAccessServer) Server() *grpc.Server {
 	ttnpb.RegisterGatewayAccessServer(srv, mockGatewayAccessServer{mockFetcher: &as.mockFetcher})
 	ttnpb.RegisterOrganizationAccessServer(srv, mockOrganizationAccessServer{mockFetcher: &as.mockFetcher})
 	ttnpb.RegisterUserAccessServer(srv, mockUserAccessServer{mockFetcher: &as.mockFetcher})
+	ttnpb.RegisterEntityAccessServer(srv, mockEntityAccessServer{authInfo: as.authInfo})
 	return srv
 }
 
@@ -253,3 +267,59 @@ func TestAccessFetcher(t *testing.T) {
 	a.So(authRes.OrgRights, should.Resemble, is.mockFetcher.organizationRights)
 	a.So(authRes.UsrRights, should.Resemble, is.mockFetcher.userRights)
 }
+
+func TestAccessFetcherAPIKeyRestrictions(t *testing.T) {
+	a := assertions.New(t)
+
+	is := &mockAccessServer{
+		mockFetcher: mockFetcher{
+			applicationRights: ttnpb.RightsFrom(ttnpb.RIGHT_APPLICATION_INFO),
+		},
+		authInfo: &ttnpb.AuthInfoResponse{
+			AccessMethod: &ttnpb.AuthInfoResponse_APIKey{
+				APIKey: &ttnpb.AuthInfoResponse_APIKeyAccess{
+					APIKey: ttnpb.APIKey{
+						ID:           "KEYID",
+						Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+						AllowedCIDRs: []string{"192.0.2.0/24"},
+					},
+				},
+			},
+		},
+	}
+	srv := is.Server()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	go srv.Serve(lis)
+
+	cc, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock())
+	if err != nil {
+		panic(err)
+	}
+
+	fetcher := NewAccessFetcher(func(context.Context) *grpc.ClientConn {
+		return cc
+	}, true)
+
+	md := metadata.Pairs("authorization", "Bearer "+auth.JoinToken(auth.APIKey, "KEYID", "KEY"))
+	for _, tc := range []struct {
+		SourceIP string
+		Allowed  bool
+	}{
+		{SourceIP: "192.0.2.1", Allowed: true},
+		{SourceIP: "198.51.100.1", Allowed: false},
+	} {
+		ctx := metadata.NewIncomingContext(test.Context(), md)
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tc.SourceIP), Port: 1234}})
+
+		rights, err := fetcher.ApplicationRights(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		if tc.Allowed {
+			a.So(err, should.BeNil)
+			a.So(rights, should.Resemble, is.mockFetcher.applicationRights)
+		} else {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+	}
+}
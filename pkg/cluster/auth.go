@@ -19,7 +19,9 @@ import (
 	"encoding/hex"
 
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
 	"google.golang.org/grpc"
 )
 
@@ -28,11 +30,57 @@ var HookName = "cluster-hook"
 
 func (c *cluster) TLS() bool { return c.tls }
 
+var errClusterKeyNotAllowed = errors.DefinePermissionDenied("cluster_key_not_allowed", "cluster keys are not allowed when mTLS is enabled")
+
+// WithVerifiedSource verifies the client certificate of calls that authenticate with a certificate
+// if mTLS is enabled, and the cluster key of other calls. If mTLS is enabled, cluster keys are only
+// accepted if this is explicitly allowed, and calls to the own peer authenticate with the loopback key.
 func (c *cluster) WithVerifiedSource(ctx context.Context) context.Context {
+	if c.mtls.Enable {
+		switch rpcmetadata.FromIncomingContext(ctx).AuthType {
+		case clusterauth.CertificateAuthType:
+			return clusterauth.VerifyCertificateSource(ctx, c.mtls.TrustDomain)
+		case clusterauth.LoopbackAuthType:
+			return clusterauth.VerifyLoopbackSource(ctx, c.loopbackKey)
+		case clusterauth.AuthType:
+			if !c.mtls.AllowClusterKeys {
+				return clusterauth.NewContext(ctx, errClusterKeyNotAllowed)
+			}
+		}
+	}
 	return clusterauth.VerifySource(ctx, c.keys)
 }
 
+// certificateCredentials identifies calls that authenticate with the client certificate of the TLS connection.
+// Calls over the loopback connection, which has no client certificate, authenticate with the loopback key instead.
+type certificateCredentials struct {
+	id          string
+	loopbackKey []byte
+}
+
+func (c certificateCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	md := rpcmetadata.MD{
+		ID:        c.id,
+		AuthType:  clusterauth.CertificateAuthType,
+		AuthValue: c.id,
+	}
+	if len(uri) > 0 && rpcserver.IsLoopbackURI(uri[0]) {
+		md.AuthType, md.AuthValue = clusterauth.LoopbackAuthType, hex.EncodeToString(c.loopbackKey)
+	}
+	return md.GetRequestMetadata(ctx, uri...)
+}
+
+func (certificateCredentials) RequireTransportSecurity() bool { return true }
+
+// Auth authenticates with the client certificate if mTLS is enabled, and with the cluster key otherwise.
+// The client certificate is presented by the TLS connection, so the call only identifies the authentication type.
 func (c *cluster) Auth() grpc.CallOption {
+	if c.mtls.Enable {
+		return grpc.PerRPCCredentials(certificateCredentials{
+			id:          c.self.name,
+			loopbackKey: c.loopbackKey,
+		})
+	}
 	md := rpcmetadata.MD{
 		ID:            c.self.name,
 		AuthType:      clusterauth.AuthType,
@@ -15,10 +15,26 @@
 package cluster_test
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/hex"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
@@ -26,7 +42,11 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -81,3 +101,205 @@ func TestVerifySource(t *testing.T) {
 		a.So(errors.IsPermissionDenied(clusterauth.Authorized(ctx)), should.BeTrue)
 	})
 }
+
+func writeCertificate(t *testing.T, dir, name string, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+".pem"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, name+"-key.pem"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestMTLS(t *testing.T) {
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	a := assertions.New(t)
+
+	dir, err := ioutil.TempDir("", "lorawan-stack-cluster-mtls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	notBefore, notAfter := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	ca, caKey := writeCertificate(t, dir, "ca", &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Cluster CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+	spiffeID, _ := url.Parse("spiffe://cluster.example.com/gateway_server")
+	writeCertificate(t, dir, "peer", &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "gs"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		URIs:         []*url.URL{spiffeID},
+	}, ca, caKey)
+
+	mtls := config.ClusterMTLS{
+		Enable:      true,
+		CA:          filepath.Join(dir, "ca.pem"),
+		Certificate: filepath.Join(dir, "peer.pem"),
+		Key:         filepath.Join(dir, "peer-key.pem"),
+		TrustDomain: "cluster.example.com",
+	}
+
+	serverCert, err := tls.LoadX509KeyPair(mtls.Certificate, mtls.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPool, err := mtls.CertPool()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+
+	c, err := New(ctx, &config.Cluster{
+		NetworkServer: lis.Addr().String(),
+		MTLS:          mtls,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(c.TLS(), should.BeTrue)
+
+	verified := make(chan context.Context, 1)
+	srv := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{serverCert},
+			ClientAuth:   tls.VerifyClientCertIfGiven,
+			ClientCAs:    certPool,
+		})),
+		grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+			verified <- c.WithVerifiedSource(stream.Context())
+			return stream.RecvMsg(&pbtypes.Empty{})
+		}),
+	)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	if !a.So(c.Join(), should.BeNil) {
+		t.FailNow()
+	}
+	defer c.Leave()
+
+	cc, err := c.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	for i := 0; i < 50 && err != nil; i++ {
+		time.Sleep(20 * time.Millisecond)
+		cc, err = c.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	}
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	cc.Invoke(ctx, "/ttn.lorawan.v3.Test/Test", &pbtypes.Empty{}, &pbtypes.Empty{}, c.Auth())
+
+	select {
+	case ctx := <-verified:
+		a.So(clusterauth.Authorized(ctx), should.BeNil)
+		a.So(clusterauth.Roles(ctx), should.Resemble, []ttnpb.ClusterRole{ttnpb.ClusterRole_GATEWAY_SERVER})
+		a.So(clusterauth.Authorized(clusterauth.RequireRoles(ctx, ttnpb.ClusterRole_GATEWAY_SERVER)), should.BeNil)
+		a.So(errors.IsPermissionDenied(clusterauth.Authorized(clusterauth.RequireRoles(ctx, ttnpb.ClusterRole_NETWORK_SERVER))), should.BeTrue)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Call not received")
+	}
+
+	loopbackSrv := grpc.NewServer(
+		grpc.UnknownServiceHandler(func(_ interface{}, stream grpc.ServerStream) error {
+			verified <- c.WithVerifiedSource(stream.Context())
+			return stream.RecvMsg(&pbtypes.Empty{})
+		}),
+	)
+	defer loopbackSrv.Stop()
+	loopbackConn, err := rpcserver.StartLoopback(ctx, loopbackSrv)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer loopbackConn.Close()
+
+	for _, tc := range []struct {
+		name       string
+		ctx        context.Context
+		opts       []grpc.CallOption
+		assertAuth func(error) bool
+	}{
+		{
+			name: "Loopback",
+			ctx:  ctx,
+			opts: []grpc.CallOption{c.Auth()},
+		},
+		{
+			name:       "LoopbackWithCertificateAuth",
+			ctx:        metadata.AppendToOutgoingContext(ctx, "authorization", "ClusterCertificate gs"),
+			assertAuth: errors.IsUnauthenticated,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			loopbackConn.Invoke(tc.ctx, "/ttn.lorawan.v3.Test/Test", &pbtypes.Empty{}, &pbtypes.Empty{}, tc.opts...)
+			select {
+			case ctx := <-verified:
+				err := clusterauth.Authorized(ctx)
+				if tc.assertAuth != nil {
+					a.So(tc.assertAuth(err), should.BeTrue)
+					return
+				}
+				a.So(err, should.BeNil)
+				a.So(clusterauth.Roles(ctx), should.BeNil)
+			case <-time.After(5 * time.Second):
+				t.Fatal("Call not received")
+			}
+		})
+	}
+
+	key := []byte{0x2A, 0x9C, 0x2C, 0x3C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C, 0x2A, 0x9C}
+	keyCtx := metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", fmt.Sprintf("ClusterKey %s", hex.EncodeToString(key))))
+	for _, allow := range []bool{false, true} {
+		mtls := mtls
+		mtls.AllowClusterKeys = allow
+		c, err := New(ctx, &config.Cluster{
+			Keys: []string{hex.EncodeToString(key)},
+			MTLS: mtls,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		err = clusterauth.Authorized(c.WithVerifiedSource(keyCtx))
+		if allow {
+			a.So(err, should.BeNil)
+		} else {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+	}
+}
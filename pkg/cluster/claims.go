@@ -0,0 +1,140 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const defaultLeaseTTL = 30 * time.Second
+
+var (
+	// claimScript claims KEYS[1] for ARGV[1] with a lease of ARGV[2] milliseconds, unless it is held by another peer.
+	// The lease is refreshed if the claim is already held by ARGV[1]. It returns the holder of the claim.
+	claimScript = goredis.NewScript(`local holder = redis.call("get", KEYS[1])
+if holder and holder ~= ARGV[1] then
+	return holder
+end
+redis.call("set", KEYS[1], ARGV[1], "px", ARGV[2])
+return ARGV[1]`)
+	// renewScript extends the lease of the claim in KEYS[1] by ARGV[2] milliseconds if it is held by ARGV[1].
+	renewScript = goredis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0`)
+	// releaseScript deletes the claim in KEYS[1] if it is held by ARGV[1].
+	releaseScript = goredis.NewScript(`if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`)
+)
+
+var (
+	errClaim       = errors.DefineUnavailable("claim", "failed to claim identifiers")
+	errClaimed     = errors.DefineAborted("claimed", "identifiers are claimed by `{holder}`")
+	errUnclaim     = errors.DefineUnavailable("unclaim", "failed to release claim on identifiers")
+	errClaimHolder = errors.DefineUnavailable("claim_holder", "failed to get holder of claim on identifiers")
+)
+
+func (c *cluster) claimKey(ctx context.Context, ids ttnpb.Identifiers) string {
+	return c.redis.Key("claim", strings.Replace(ids.EntityType(), " ", "_", -1), unique.ID(ctx, ids))
+}
+
+func (c *cluster) claim(ctx context.Context, ids ttnpb.Identifiers) error {
+	k := c.claimKey(ctx, ids)
+	holder, err := claimScript.Run(c.redis, []string{k}, c.self.name, int64(c.leaseTTL/time.Millisecond)).String()
+	if err != nil {
+		return errClaim.WithCause(err)
+	}
+	if holder != c.self.name {
+		return errClaimed.WithAttributes("holder", holder)
+	}
+	c.claimsMu.Lock()
+	c.claims[k] = struct{}{}
+	c.claimsMu.Unlock()
+	return nil
+}
+
+func (c *cluster) unclaim(ctx context.Context, ids ttnpb.Identifiers) error {
+	k := c.claimKey(ctx, ids)
+	c.claimsMu.Lock()
+	delete(c.claims, k)
+	c.claimsMu.Unlock()
+	if err := releaseScript.Run(c.redis, []string{k}, c.self.name).Err(); err != nil {
+		return errUnclaim.WithCause(err)
+	}
+	return nil
+}
+
+// claimHolder returns the name of the peer that holds the claim on the identifiers.
+// The name is empty if the identifiers are not claimed.
+func (c *cluster) claimHolder(ctx context.Context, ids ttnpb.Identifiers) (string, error) {
+	name, err := c.redis.Get(c.claimKey(ctx, ids)).Result()
+	if err == goredis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", errClaimHolder.WithCause(err)
+	}
+	return name, nil
+}
+
+// renewClaims extends the leases of the claims that are held by this peer.
+// Claims that were taken over by another peer are forgotten.
+func (c *cluster) renewClaims() {
+	c.claimsMu.Lock()
+	keys := make([]string, 0, len(c.claims))
+	for k := range c.claims {
+		keys = append(keys, k)
+	}
+	c.claimsMu.Unlock()
+	ttl := int64(c.leaseTTL / time.Millisecond)
+	for _, k := range keys {
+		renewed, err := renewScript.Run(c.redis, []string{k}, c.self.name, ttl).Int64()
+		if err != nil {
+			log.FromContext(c.ctx).WithError(err).WithField("key", k).Warn("Failed to renew claim")
+			continue
+		}
+		if renewed == 0 {
+			c.claimsMu.Lock()
+			delete(c.claims, k)
+			c.claimsMu.Unlock()
+		}
+	}
+}
+
+// releaseClaims releases all claims that are held by this peer.
+func (c *cluster) releaseClaims() {
+	c.claimsMu.Lock()
+	keys := make([]string, 0, len(c.claims))
+	for k := range c.claims {
+		keys = append(keys, k)
+	}
+	c.claims = make(map[string]struct{})
+	c.claimsMu.Unlock()
+	for _, k := range keys {
+		if err := releaseScript.Run(c.redis, []string{k}, c.self.name).Err(); err != nil {
+			log.FromContext(c.ctx).WithError(err).WithField("key", k).Warn("Failed to release claim")
+		}
+	}
+}
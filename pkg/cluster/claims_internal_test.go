@@ -0,0 +1,62 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestResponsiblePeer(t *testing.T) {
+	a := assertions.New(t)
+
+	peers := []Peer{
+		&peer{name: "as1"},
+		&peer{name: "as2"},
+		&peer{name: "as3"},
+	}
+
+	responsible := make(map[string]Peer)
+	count := make(map[string]int)
+	for i := 0; i < 300; i++ {
+		uid := fmt.Sprintf("app-%d", i)
+		p := responsiblePeer(uid, peers)
+		if !a.So(p, should.NotBeNil) {
+			t.FailNow()
+		}
+		// The responsible peer does not depend on the order of the peers.
+		a.So(responsiblePeer(uid, []Peer{peers[2], peers[0], peers[1]}), should.Equal, p)
+		responsible[uid] = p
+		count[p.Name()]++
+	}
+
+	// The responsibilities are spread over the peers.
+	for _, p := range peers {
+		a.So(count[p.Name()], should.BeGreaterThan, 50)
+	}
+
+	// When a peer leaves, only its responsibilities move to the remaining peers.
+	for uid, p := range responsible {
+		remaining := responsiblePeer(uid, peers[:2])
+		if p == peers[2] {
+			a.So(remaining, should.NotEqual, peers[2])
+		} else {
+			a.So(remaining, should.Equal, p)
+		}
+	}
+}
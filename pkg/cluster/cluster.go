@@ -17,19 +17,26 @@ package cluster
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"net"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/rpcclient"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
@@ -51,6 +58,8 @@ type Cluster interface {
 	// GetPeerConn returns the gRPC client connection of a peer, if the peer is available as
 	// as per GetPeer.
 	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (*grpc.ClientConn, error)
+	// ListPeers returns all peers of the cluster, including their roles and health.
+	ListPeers(ctx context.Context) ([]*ttnpb.ClusterPeer, error)
 
 	// ClaimIDs can be used to indicate that the current peer takes
 	// responsibility for entities identified by ids.
@@ -106,12 +115,21 @@ func WithTLSConfig(tlsConfig *tls.Config) Option {
 	})
 }
 
+// WithRedis makes the cluster claim identifiers and announce and discover peers in Redis,
+// which allows running multiple instances of each role side by side.
+func WithRedis(cl *redis.Client) Option {
+	return optionFunc(func(c *cluster) {
+		c.redis = cl
+	})
+}
+
 // CustomNew allows you to replace the clustering implementation. New will call CustomNew if not nil.
 var CustomNew func(ctx context.Context, config *config.Cluster, options ...Option) (Cluster, error)
 
 // New instantiates a new clustering implementation.
 // The basic clustering implementation allows for a cluster setup with a single-instance deployment of each component
-// (GS/NS/AS/JS).
+// (GS/NS/AS/JS). With Redis (see WithRedis), identifiers are claimed with leases and peers are discovered dynamically,
+// which allows for multiple instances of each component.
 // Network operators can use their own clustering logic, which can be activated by setting the CustomNew variable.
 func New(ctx context.Context, config *config.Cluster, options ...Option) (Cluster, error) {
 	if CustomNew != nil {
@@ -119,9 +137,20 @@ func New(ctx context.Context, config *config.Cluster, options ...Option) (Cluste
 	}
 
 	c := &cluster{
-		ctx:   ctx,
-		tls:   config.TLS,
-		peers: make(map[string]*peer),
+		ctx:         ctx,
+		tls:         config.TLS,
+		peers:       make(map[string]*peer),
+		leaseTTL:    config.Claims.LeaseTTL,
+		claims:      make(map[string]struct{}),
+		dns:         config.DNS,
+		dnsInterval: config.DNS.Interval,
+		lookupSRV:   net.DefaultResolver.LookupSRV,
+	}
+	if c.leaseTTL == 0 {
+		c.leaseTTL = defaultLeaseTTL
+	}
+	if c.dnsInterval == 0 {
+		c.dnsInterval = defaultDNSInterval
 	}
 
 	for i, key := range config.Keys {
@@ -138,9 +167,13 @@ func New(ctx context.Context, config *config.Cluster, options ...Option) (Cluste
 	}
 	if c.keys == nil {
 		c.keys = [][]byte{random.Bytes(32)}
-		log.FromContext(ctx).WithField("key", hex.EncodeToString(c.keys[0])).Warn("No cluster key configured, generated a random one")
+		if !config.MTLS.Enable {
+			log.FromContext(ctx).WithField("key", hex.EncodeToString(c.keys[0])).Warn("No cluster key configured, generated a random one")
+		}
 	}
 
+	c.loopbackKey = random.Bytes(32)
+
 	c.self = &peer{
 		name:   config.Name,
 		target: config.Address,
@@ -184,21 +217,54 @@ func New(ctx context.Context, config *config.Cluster, options ...Option) (Cluste
 		}
 	}
 
+	for _, peer := range c.peers {
+		peer.source = peerSourceStatic
+	}
+
 	for _, option := range options {
 		option.apply(c)
 	}
 
+	if config.MTLS.Enable {
+		tlsConfig, err := config.MTLS.ClientConfig(ctx)
+		if err != nil {
+			return nil, errMTLSConfig.WithCause(err)
+		}
+		c.tls, c.tlsConfig, c.mtls = true, tlsConfig, config.MTLS
+	}
+
 	return c, nil
 }
 
+var errMTLSConfig = errors.DefineFailedPrecondition("mtls_config", "invalid cluster mTLS configuration")
+
 type cluster struct {
-	ctx       context.Context
-	tls       bool
-	tlsConfig *tls.Config
-	peers     map[string]*peer
-	self      *peer
+	ctx         context.Context
+	tls         bool
+	tlsConfig   *tls.Config
+	mtls        config.ClusterMTLS
+	dialOptions []grpc.DialOption
+
+	peersMu sync.RWMutex
+	peers   map[string]*peer
+	self    *peer
 
 	keys [][]byte
+	// loopbackKey authenticates calls to the own peer over the loopback connection if mTLS is enabled.
+	// It is generated for each process and never leaves it.
+	loopbackKey []byte
+
+	redis    *redis.Client
+	leaseTTL time.Duration
+	claimsMu sync.Mutex
+	claims   map[string]struct{}
+
+	dns         config.ClusterDNS
+	dnsInterval time.Duration
+	lookupSRV   func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+
+	discoveryCancel context.CancelFunc
+	discoveryWG     sync.WaitGroup
 }
 
 var errPeerConnection = errors.Define(
@@ -211,68 +277,108 @@ var errPeerEmptyTarget = errors.Define(
 	"peer target address is empty",
 )
 
+// connect connects to the peer. The caller must hold the peers lock.
+func (c *cluster) connect(peer *peer) error {
+	if peer.conn != nil {
+		return nil
+	}
+	peer.ctx, peer.cancel = context.WithCancel(c.ctx)
+	logger := log.FromContext(c.ctx).WithFields(log.Fields(
+		"target", peer.target,
+		"name", peer.Name(),
+		"roles", peer.Roles(),
+	))
+	if peer.target == "" {
+		logger.Warn("Not connecting to peer, empty address.")
+		peer.connErr = errPeerEmptyTarget
+		return nil
+	}
+	logger.Debug("Connecting to peer...")
+	peer.conn, peer.connErr = grpc.DialContext(peer.ctx, peer.target, c.dialOptions...)
+	if peer.connErr != nil {
+		return errPeerConnection.WithCause(peer.connErr).WithAttributes("name", peer.name, "address", peer.target)
+	}
+	return nil
+}
+
+// disconnect disconnects from the peer. The caller must hold the peers lock.
+func (c *cluster) disconnect(peer *peer) error {
+	if peer.cancel != nil {
+		defer peer.cancel()
+	}
+	if peer.conn != nil {
+		return peer.conn.Close()
+	}
+	return nil
+}
+
 func (c *cluster) Join() (err error) {
-	options := rpcclient.DefaultDialOptions(c.ctx)
+	c.dialOptions = rpcclient.DefaultDialOptions(c.ctx)
 	if c.tls {
-		options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(c.tlsConfig)))
+		c.dialOptions = append(c.dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(c.tlsConfig)))
 	} else {
-		options = append(options, grpc.WithInsecure())
+		c.dialOptions = append(c.dialOptions, grpc.WithInsecure())
 	}
+	c.peersMu.Lock()
 	for _, peer := range c.peers {
-		if peer.conn != nil {
-			continue
-		}
-		peer.ctx, peer.cancel = context.WithCancel(c.ctx)
-		logger := log.FromContext(c.ctx).WithFields(log.Fields(
-			"target", peer.target,
-			"name", peer.Name(),
-			"roles", peer.Roles(),
-		))
-		if peer.target == "" {
-			logger.Warn("Not connecting to peer, empty address.")
-			peer.connErr = errPeerEmptyTarget
-			continue
-		}
-		logger.Debug("Connecting to peer...")
-		peer.conn, peer.connErr = grpc.DialContext(peer.ctx, peer.target, options...)
-		if err != nil {
-			return errPeerConnection.WithCause(peer.connErr).WithAttributes("name", peer.name, "address", peer.target)
+		if err := c.connect(peer); err != nil {
+			c.peersMu.Unlock()
+			return err
 		}
 	}
-	return nil
+	c.peersMu.Unlock()
+	return c.startDiscovery()
 }
 
 func (c *cluster) Leave() error {
+	c.stopDiscovery()
+	c.peersMu.Lock()
+	defer c.peersMu.Unlock()
 	for _, peer := range c.peers {
-		if peer.conn != nil {
-			if err := peer.conn.Close(); err != nil {
-				return err
-			}
-		}
-		if peer.cancel != nil {
-			peer.cancel()
+		if err := c.disconnect(peer); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
 func (c *cluster) GetPeers(ctx context.Context, role ttnpb.ClusterRole) ([]Peer, error) {
+	c.peersMu.RLock()
+	defer c.peersMu.RUnlock()
 	var matches []Peer
 	for _, peer := range c.peers {
 		if !peer.HasRole(role) {
 			continue
 		}
 		conn, err := peer.Conn()
-		if err != nil {
+		if err != nil || conn == nil {
 			continue
 		}
-		if conn.GetState() == connectivity.Ready {
+		// The connection to the own peer is a loopback connection, which is always available.
+		if peer == c.self || conn.GetState() == connectivity.Ready {
 			matches = append(matches, peer)
 		}
 	}
 	return matches, nil
 }
 
+// responsiblePeer returns the peer that is responsible for the unique ID by rendezvous hashing.
+// Each peer keeps the same responsibilities when peers join or leave the cluster, except for the
+// responsibilities of the peer that left or are taken over by the peer that joined.
+func responsiblePeer(uid string, peers []Peer) Peer {
+	var (
+		responsible Peer
+		maxWeight   uint64
+	)
+	for _, peer := range peers {
+		h := sha256.Sum256([]byte(peer.Name() + "\x00" + uid))
+		if weight := binary.BigEndian.Uint64(h[:8]); responsible == nil || weight > maxWeight {
+			responsible, maxWeight = peer, weight
+		}
+	}
+	return responsible
+}
+
 var errPeerUnavailable = errors.DefineUnavailable("peer_unavailable", "{cluster_role} cluster peer unavailable")
 
 func (c *cluster) GetPeer(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (Peer, error) {
@@ -280,11 +386,27 @@ func (c *cluster) GetPeer(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb
 	if err != nil {
 		return nil, err
 	}
-	if len(matches) == 1 {
+	switch len(matches) {
+	case 0:
+		return nil, errPeerUnavailable.WithAttributes("cluster_role", strings.Title(strings.Replace(role.String(), "_", " ", -1)))
+	case 1:
 		return matches[0], nil
 	}
-	// The reference cluster only has a single instance of each component, so we don't need to filter on IDs.
-	return nil, errPeerUnavailable.WithAttributes("cluster_role", strings.Title(strings.Replace(role.String(), "_", " ", -1)))
+	if ids == nil {
+		return matches[random.Intn(len(matches))], nil
+	}
+	if c.redis != nil {
+		name, err := c.claimHolder(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, peer := range matches {
+			if peer.Name() == name {
+				return peer, nil
+			}
+		}
+	}
+	return responsiblePeer(unique.ID(ctx, ids), matches), nil
 }
 
 func (c *cluster) GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (*grpc.ClientConn, error) {
@@ -295,14 +417,20 @@ func (c *cluster) GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids t
 	return peer.Conn()
 }
 
-// ClaimIDs is a no-op in the reference implementation.
-// The reference cluster only has a single instance of each component, so we don't need to claim.
+// ClaimIDs claims the identifiers in Redis.
+// Without Redis, the cluster only has a single instance of each component, so this is a no-op.
 func (c *cluster) ClaimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
-	return nil
+	if c.redis == nil {
+		return nil
+	}
+	return c.claim(ctx, ids)
 }
 
-// UnclaimIDs is a no-op in the reference implementation.
-// The reference cluster only has a single instance of each component, so we don't need to unclaim.
+// UnclaimIDs releases the claim on the identifiers in Redis.
+// Without Redis, the cluster only has a single instance of each component, so this is a no-op.
 func (c *cluster) UnclaimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
-	return nil
+	if c.redis == nil {
+		return nil
+	}
+	return c.unclaim(ctx, ids)
 }
@@ -21,9 +21,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/smartystreets/assertions"
 	. "go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -114,3 +116,120 @@ func TestCluster(t *testing.T) {
 		a.So(cc.GetState(), should.Equal, connectivity.Shutdown)
 	}
 }
+
+type asService struct{}
+
+func (asService) Roles() []ttnpb.ClusterRole {
+	return []ttnpb.ClusterRole{ttnpb.ClusterRole_APPLICATION_SERVER}
+}
+func (asService) RegisterServices(s *grpc.Server)                             {}
+func (asService) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {}
+
+func TestClusterClaims(t *testing.T) {
+	a := assertions.New(t)
+
+	cl, flush := test.NewRedis(t, "cluster")
+	defer flush()
+	defer cl.Close()
+
+	newInstance := func(name string) (Cluster, *grpc.ClientConn) {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		go grpc.NewServer().Serve(lis)
+		conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := New(ctx, &config.Cluster{
+			Name:    name,
+			Address: lis.Addr().String(),
+			Claims: config.Claims{
+				Enable:   true,
+				LeaseTTL: time.Second,
+			},
+		}, WithServices(asService{}), WithConn(conn), WithRedis(cl))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.Join(); err != nil {
+			t.Fatal(err)
+		}
+		return c, conn
+	}
+
+	as1, _ := newInstance("as1")
+	as2, _ := newInstance("as2")
+	defer as2.Leave()
+
+	// Wait for the first instance to discover the second one.
+	var peers []Peer
+	for i := 0; i < 50 && len(peers) < 2; i++ {
+		time.Sleep(20 * time.Millisecond)
+		peers, _ = as1.GetPeers(ctx, ttnpb.ClusterRole_APPLICATION_SERVER)
+	}
+	if !a.So(peers, should.HaveLength, 2) {
+		t.FailNow()
+	}
+
+	ids := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+
+	// Unclaimed identifiers are routed to the same instance by all instances.
+	p1, err := as1.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	a.So(err, should.BeNil)
+	p2, err := as2.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	a.So(err, should.BeNil)
+	if a.So(p1, should.NotBeNil) && a.So(p2, should.NotBeNil) {
+		a.So(p1.Name(), should.Equal, p2.Name())
+	}
+
+	// Claimed identifiers are routed to the instance that holds the claim.
+	a.So(as1.ClaimIDs(ctx, ids), should.BeNil)
+	p, err := as1.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "as1")
+	}
+
+	// Claiming again refreshes the claim of the holder.
+	a.So(as1.ClaimIDs(ctx, ids), should.BeNil)
+
+	// Identifiers that are claimed by another instance cannot be claimed.
+	if err := as2.ClaimIDs(ctx, ids); a.So(err, should.NotBeNil) {
+		a.So(errors.IsAborted(err), should.BeTrue)
+	}
+	p, err = as1.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "as1")
+	}
+
+	// Once released, the identifiers can be claimed by another instance.
+	a.So(as1.UnclaimIDs(ctx, ids), should.BeNil)
+	a.So(as2.ClaimIDs(ctx, ids), should.BeNil)
+	p, err = as1.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "as2")
+	}
+
+	// The claim is renewed while the holder is in the cluster.
+	time.Sleep(2 * time.Second)
+	p, err = as1.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "as2")
+	}
+
+	// Releasing a claim that is held by another instance is a no-op.
+	a.So(as1.UnclaimIDs(ctx, ids), should.BeNil)
+	p, err = as1.GetPeer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if a.So(err, should.BeNil) {
+		a.So(p.Name(), should.Equal, "as2")
+	}
+
+	// When an instance leaves, it is no longer discovered.
+	a.So(as1.Leave(), should.BeNil)
+	for i := 0; i < 100 && len(peers) > 1; i++ {
+		time.Sleep(20 * time.Millisecond)
+		peers, _ = as2.GetPeers(ctx, ttnpb.ClusterRole_APPLICATION_SERVER)
+	}
+	a.So(peers, should.HaveLength, 1)
+}
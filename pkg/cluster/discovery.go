@@ -0,0 +1,337 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	goredis "github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc/connectivity"
+)
+
+// Sources of peers.
+const (
+	peerSourceStatic = "static"
+	peerSourceDNS    = "dns"
+	peerSourceRedis  = "redis"
+)
+
+const defaultDNSInterval = 30 * time.Second
+
+var errAnnouncePeer = errors.DefineUnavailable("announce_peer", "failed to announce peer")
+
+// updatePeers replaces the peers of the source by the given peers.
+// New peers are connected to and peers that are no longer present are disconnected from.
+func (c *cluster) updatePeers(source string, peers map[string]*peer) {
+	logger := log.FromContext(c.ctx)
+	c.peersMu.Lock()
+	defer c.peersMu.Unlock()
+	for name, p := range c.peers {
+		if p.source != source {
+			continue
+		}
+		if update, ok := peers[name]; ok && update.target == p.target {
+			p.roles, p.lastSeen = update.roles, update.lastSeen
+			continue
+		}
+		logger.WithFields(log.Fields("name", name, "source", source)).Debug("Peer left")
+		if err := c.disconnect(p); err != nil {
+			logger.WithError(err).WithField("name", name).Warn("Failed to disconnect from peer")
+		}
+		delete(c.peers, name)
+	}
+	// Peers that are discovered by multiple sources are only added once. Peers from DNS are named
+	// after their address, so peers are also considered the same if they have the same target.
+	targets := make(map[string]string, len(c.peers))
+	for name, p := range c.peers {
+		if p.target != "" {
+			targets[p.target] = name
+		}
+	}
+	for name, p := range peers {
+		if _, ok := c.peers[name]; ok {
+			continue
+		}
+		if existing, ok := targets[p.target]; ok && p.target != "" {
+			logger.WithFields(log.Fields("name", name, "source", source, "existing", existing)).Debug("Peer already discovered")
+			continue
+		}
+		p.source = source
+		logger.WithFields(log.Fields("name", name, "source", source, "roles", p.roles)).Debug("Peer discovered")
+		if err := c.connect(p); err != nil {
+			logger.WithError(err).WithField("name", name).Warn("Failed to connect to peer")
+			continue
+		}
+		c.peers[name] = p
+	}
+}
+
+func (c *cluster) peerKey(name string) string {
+	return c.redis.SlotKey("peer", name)
+}
+
+func (c *cluster) peersKey() string {
+	return c.redis.SlotKey("peers")
+}
+
+// announce announces the address and roles of this peer in the membership registry in Redis.
+// The announcement expires if it is not renewed within the lease TTL, so it doubles as heartbeat.
+func (c *cluster) announce() error {
+	if c.self.target == "" {
+		return nil
+	}
+	roles := make([]string, len(c.self.roles))
+	for i, role := range c.self.roles {
+		roles[i] = role.String()
+	}
+	k := c.peerKey(c.self.name)
+	_, err := c.redis.TxPipelined(func(p goredis.Pipeliner) error {
+		p.SAdd(c.peersKey(), c.self.name)
+		p.HMSet(k, map[string]interface{}{
+			"address":   c.self.target,
+			"roles":     strings.Join(roles, ","),
+			"heartbeat": time.Now().UnixNano(),
+		})
+		p.PExpire(k, c.leaseTTL)
+		return nil
+	})
+	if err != nil {
+		return errAnnouncePeer.WithCause(err)
+	}
+	return nil
+}
+
+// withdraw removes the announcement of this peer from the membership registry in Redis.
+func (c *cluster) withdraw() error {
+	_, err := c.redis.TxPipelined(func(p goredis.Pipeliner) error {
+		p.SRem(c.peersKey(), c.self.name)
+		p.Del(c.peerKey(c.self.name))
+		return nil
+	})
+	return err
+}
+
+// discoverRedis discovers the peers that are announced in the membership registry in Redis.
+func (c *cluster) discoverRedis() error {
+	names, err := c.redis.SMembers(c.peersKey()).Result()
+	if err != nil {
+		return err
+	}
+	peers := make(map[string]*peer, len(names))
+	for _, name := range names {
+		if name == c.self.name {
+			continue
+		}
+		fields, err := c.redis.HGetAll(c.peerKey(name)).Result()
+		if err != nil {
+			return err
+		}
+		if len(fields) == 0 {
+			// The announcement expired; the peer left without withdrawing.
+			c.redis.SRem(c.peersKey(), name)
+			continue
+		}
+		p := &peer{
+			name:   name,
+			target: fields["address"],
+		}
+		for _, role := range strings.Split(fields["roles"], ",") {
+			if v, ok := ttnpb.ClusterRole_value[role]; ok {
+				p.roles = append(p.roles, ttnpb.ClusterRole(v))
+			}
+		}
+		if heartbeat, err := strconv.ParseInt(fields["heartbeat"], 10, 64); err == nil {
+			p.lastSeen = time.Unix(0, heartbeat)
+		}
+		peers[name] = p
+	}
+	c.updatePeers(peerSourceRedis, peers)
+	return nil
+}
+
+func (c *cluster) heartbeat() {
+	if err := c.announce(); err != nil {
+		log.FromContext(c.ctx).WithError(err).Warn("Failed to announce peer")
+	}
+	c.renewClaims()
+	if err := c.discoverRedis(); err != nil {
+		log.FromContext(c.ctx).WithError(err).Warn("Failed to discover peers in Redis")
+	}
+}
+
+func (c *cluster) dnsNames() []struct {
+	name  string
+	roles []ttnpb.ClusterRole
+} {
+	return []struct {
+		name  string
+		roles []ttnpb.ClusterRole
+	}{
+		{c.dns.IdentityServer, []ttnpb.ClusterRole{ttnpb.ClusterRole_ACCESS, ttnpb.ClusterRole_ENTITY_REGISTRY}},
+		{c.dns.GatewayServer, []ttnpb.ClusterRole{ttnpb.ClusterRole_GATEWAY_SERVER}},
+		{c.dns.NetworkServer, []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}},
+		{c.dns.ApplicationServer, []ttnpb.ClusterRole{ttnpb.ClusterRole_APPLICATION_SERVER}},
+		{c.dns.JoinServer, []ttnpb.ClusterRole{ttnpb.ClusterRole_JOIN_SERVER}},
+		{c.dns.CryptoServer, []ttnpb.ClusterRole{ttnpb.ClusterRole_CRYPTO_SERVER}},
+	}
+}
+
+func (c *cluster) dnsEnabled() bool {
+	for _, srv := range c.dnsNames() {
+		if srv.name != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// discoverDNS discovers peers from the DNS SRV records of the configured names.
+// The peers are named after their address, so that a peer that serves multiple roles is a single peer.
+// If any lookup fails, the peers are left unchanged.
+func (c *cluster) discoverDNS(ctx context.Context) error {
+	now := time.Now()
+	peers := make(map[string]*peer)
+	for _, srv := range c.dnsNames() {
+		if srv.name == "" {
+			continue
+		}
+		var roles []ttnpb.ClusterRole
+		for _, role := range srv.roles {
+			if !c.self.HasRole(role) {
+				roles = append(roles, role)
+			}
+		}
+		if len(roles) == 0 {
+			continue
+		}
+		_, records, err := c.lookupSRV(ctx, "", "", srv.name)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			target := net.JoinHostPort(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port)))
+			if target == c.self.target {
+				continue
+			}
+			p, ok := peers[target]
+			if !ok {
+				p = &peer{
+					name:     target,
+					target:   target,
+					lastSeen: now,
+				}
+				peers[target] = p
+			}
+			p.roles = append(p.roles, roles...)
+		}
+	}
+	c.updatePeers(peerSourceDNS, peers)
+	return nil
+}
+
+// startDiscovery discovers the peers and keeps discovering them until stopDiscovery is called.
+// With Redis, this peer is announced in the membership registry and its claims are renewed as well.
+func (c *cluster) startDiscovery() error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.discoveryCancel = cancel
+	run := func(interval time.Duration, f func()) {
+		c.discoveryWG.Add(1)
+		go func() {
+			defer c.discoveryWG.Done()
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					f()
+				}
+			}
+		}()
+	}
+	if c.redis != nil {
+		if err := c.announce(); err != nil {
+			return err
+		}
+		if err := c.discoverRedis(); err != nil {
+			return err
+		}
+		run(c.leaseTTL/3, c.heartbeat)
+	}
+	if c.dnsEnabled() {
+		if err := c.discoverDNS(ctx); err != nil {
+			log.FromContext(c.ctx).WithError(err).Warn("Failed to discover peers from DNS")
+		}
+		run(c.dnsInterval, func() {
+			if err := c.discoverDNS(ctx); err != nil {
+				log.FromContext(c.ctx).WithError(err).Warn("Failed to discover peers from DNS")
+			}
+		})
+	}
+	return nil
+}
+
+// stopDiscovery stops discovering peers. With Redis, the claims and the announcement of this peer are released.
+func (c *cluster) stopDiscovery() {
+	if c.discoveryCancel == nil {
+		return
+	}
+	c.discoveryCancel()
+	c.discoveryWG.Wait()
+	c.discoveryCancel = nil
+	if c.redis != nil {
+		c.releaseClaims()
+		if err := c.withdraw(); err != nil {
+			log.FromContext(c.ctx).WithError(err).Warn("Failed to withdraw peer")
+		}
+	}
+}
+
+func (c *cluster) ListPeers(ctx context.Context) ([]*ttnpb.ClusterPeer, error) {
+	c.peersMu.RLock()
+	defer c.peersMu.RUnlock()
+	res := make([]*ttnpb.ClusterPeer, 0, len(c.peers))
+	for _, p := range c.peers {
+		pb := &ttnpb.ClusterPeer{
+			Name:    p.name,
+			Address: p.target,
+			Roles:   p.roles,
+			Tags:    p.tags,
+			Source:  p.source,
+			Self:    p == c.self,
+		}
+		if conn, err := p.Conn(); err == nil && conn != nil {
+			state := conn.GetState()
+			pb.State = state.String()
+			pb.Healthy = p == c.self || state == connectivity.Ready
+		}
+		if !p.lastSeen.IsZero() {
+			lastSeen := p.lastSeen
+			pb.LastSeenAt = &lastSeen
+		}
+		res = append(res, pb)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
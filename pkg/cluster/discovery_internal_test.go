@@ -0,0 +1,160 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestDNSDiscovery(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	go grpc.NewServer().Serve(lis)
+	port := lis.Addr().(*net.TCPAddr).Port
+
+	var (
+		recordsMu sync.Mutex
+		records   = map[string][]*net.SRV{
+			"_grpc._tcp.is.example.com": {
+				{Target: "127.0.0.1.", Port: uint16(port)},
+			},
+			"_grpc._tcp.gs.example.com": {
+				{Target: "127.0.0.1.", Port: uint16(port)},
+				{Target: "127.0.0.2.", Port: uint16(port)},
+			},
+		}
+	)
+
+	c, err := New(ctx, &config.Cluster{
+		Name: "self",
+		DNS: config.ClusterDNS{
+			Interval:       50 * time.Millisecond,
+			IdentityServer: "_grpc._tcp.is.example.com",
+			GatewayServer:  "_grpc._tcp.gs.example.com",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	c.(*cluster).lookupSRV = func(_ context.Context, _, _, name string) (string, []*net.SRV, error) {
+		recordsMu.Lock()
+		defer recordsMu.Unlock()
+		return name, records[name], nil
+	}
+	if !a.So(c.Join(), should.BeNil) {
+		t.FailNow()
+	}
+	defer c.Leave()
+
+	target := net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+	peers, err := c.ListPeers(ctx)
+	a.So(err, should.BeNil)
+	if a.So(peers, should.HaveLength, 3) {
+		a.So(peers[0].Name, should.Equal, target)
+		a.So(peers[0].Source, should.Equal, "dns")
+		a.So(peers[0].Roles, should.Resemble, []ttnpb.ClusterRole{
+			ttnpb.ClusterRole_ACCESS,
+			ttnpb.ClusterRole_ENTITY_REGISTRY,
+			ttnpb.ClusterRole_GATEWAY_SERVER,
+		})
+		a.So(peers[0].LastSeenAt, should.NotBeNil)
+		a.So(peers[1].Name, should.Equal, net.JoinHostPort("127.0.0.2", strconv.Itoa(port)))
+		a.So(peers[2].Name, should.Equal, "self")
+		a.So(peers[2].Self, should.BeTrue)
+	}
+
+	// The peer becomes healthy when it is connected.
+	var gs []Peer
+	for i := 0; i < 50 && len(gs) == 0; i++ {
+		time.Sleep(20 * time.Millisecond)
+		gs, _ = c.GetPeers(ctx, ttnpb.ClusterRole_GATEWAY_SERVER)
+	}
+	if a.So(gs, should.HaveLength, 1) {
+		a.So(gs[0].Name(), should.Equal, target)
+	}
+
+	// Peers that are no longer in the records leave the cluster.
+	recordsMu.Lock()
+	records["_grpc._tcp.gs.example.com"] = records["_grpc._tcp.gs.example.com"][:1]
+	recordsMu.Unlock()
+	for i := 0; i < 50 && len(peers) > 2; i++ {
+		time.Sleep(20 * time.Millisecond)
+		peers, _ = c.ListPeers(ctx)
+	}
+	a.So(peers, should.HaveLength, 2)
+}
+
+func TestUpdatePeersDeduplicates(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cl, err := New(ctx, &config.Cluster{Name: "self"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	c := cl.(*cluster)
+	c.dialOptions = []grpc.DialOption{grpc.WithInsecure()}
+
+	c.updatePeers(peerSourceRedis, map[string]*peer{
+		"as1": {name: "as1", target: "as1.example.com:1884", roles: []ttnpb.ClusterRole{ttnpb.ClusterRole_APPLICATION_SERVER}},
+	})
+	c.updatePeers(peerSourceDNS, map[string]*peer{
+		"as1":                  {name: "as1", target: "10.0.0.1:1884", roles: []ttnpb.ClusterRole{ttnpb.ClusterRole_APPLICATION_SERVER}},
+		"as1.example.com:1884": {name: "as1.example.com:1884", target: "as1.example.com:1884", roles: []ttnpb.ClusterRole{ttnpb.ClusterRole_APPLICATION_SERVER}},
+		"ns1.example.com:1884": {name: "ns1.example.com:1884", target: "ns1.example.com:1884", roles: []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}},
+	})
+
+	peers, err := c.ListPeers(ctx)
+	a.So(err, should.BeNil)
+	if a.So(peers, should.HaveLength, 3) {
+		a.So(peers[0].Name, should.Equal, "as1")
+		a.So(peers[0].Source, should.Equal, "redis")
+		a.So(peers[1].Name, should.Equal, "ns1.example.com:1884")
+		a.So(peers[1].Source, should.Equal, "dns")
+		a.So(peers[2].Name, should.Equal, "self")
+	}
+
+	// When the peer leaves the membership registry, it is discovered through DNS.
+	c.updatePeers(peerSourceRedis, nil)
+	c.updatePeers(peerSourceDNS, map[string]*peer{
+		"as1.example.com:1884": {name: "as1.example.com:1884", target: "as1.example.com:1884", roles: []ttnpb.ClusterRole{ttnpb.ClusterRole_APPLICATION_SERVER}},
+		"ns1.example.com:1884": {name: "ns1.example.com:1884", target: "ns1.example.com:1884", roles: []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}},
+	})
+	peers, err = c.ListPeers(ctx)
+	a.So(err, should.BeNil)
+	if a.So(peers, should.HaveLength, 3) {
+		a.So(peers[0].Name, should.Equal, "as1.example.com:1884")
+		a.So(peers[0].Source, should.Equal, "dns")
+	}
+}
@@ -16,6 +16,7 @@ package cluster
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -41,6 +42,10 @@ type peer struct {
 	tags  map[string]string
 
 	target string
+	// source is the source of the peer: static, dns or redis.
+	source string
+	// lastSeen is the time when the peer was last discovered or last sent a heartbeat.
+	lastSeen time.Time
 
 	ctx     context.Context
 	cancel  context.CancelFunc
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
 )
@@ -30,6 +31,12 @@ func (c *Component) initCluster() (err error) {
 	if tlsConfig, err := c.config.TLS.Config(c.Context()); err == nil {
 		clusterOpts = append(clusterOpts, cluster.WithTLSConfig(tlsConfig))
 	}
+	if c.config.ServiceBase.Cluster.Claims.Enable {
+		clusterOpts = append(clusterOpts, cluster.WithRedis(redis.New(&redis.Config{
+			Redis:     c.config.ServiceBase.Redis,
+			Namespace: []string{"cluster"},
+		})))
+	}
 	c.cluster, err = c.clusterNew(c.ctx, &c.config.ServiceBase.Cluster, clusterOpts...)
 	if err != nil {
 		return err
@@ -60,6 +67,12 @@ func (c *Component) GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids
 	return c.cluster.GetPeerConn(ctx, role, ids)
 }
 
+// ListPeers returns all peers of the cluster, including their roles and health.
+// See package ../cluster for more information.
+func (c *Component) ListPeers(ctx context.Context) ([]*ttnpb.ClusterPeer, error) {
+	return c.cluster.ListPeers(ctx)
+}
+
 // ClaimIDs claims the identifiers in the cluster.
 // See package ../cluster for more information.
 func (c *Component) ClaimIDs(ctx context.Context, ids ttnpb.Identifiers) error {
@@ -0,0 +1,91 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// NewClusterServer returns a new ClusterServer on top of the given component.
+func NewClusterServer(c *Component) *ClusterServer {
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Cluster", cluster.HookName, c.ClusterAuthUnaryHook())
+	return &ClusterServer{component: c}
+}
+
+// ClusterServer implements the Cluster RPC service.
+type ClusterServer struct {
+	component *Component
+}
+
+// Roles implements the rpcserver.Registerer interface. It just returns nil.
+func (c *ClusterServer) Roles() []ttnpb.ClusterRole { return nil }
+
+// RegisterServices registers the Cluster service.
+func (c *ClusterServer) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterClusterServer(s, c)
+}
+
+// RegisterHandlers registers the Cluster service handler.
+func (c *ClusterServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterClusterHandler(c.component.Context(), s, conn)
+}
+
+var errClusterAdminOnly = errors.DefinePermissionDenied("cluster_admin_only", "the cluster is only available to admins and cluster peers")
+
+// requireAdmin requires the caller to be a cluster peer or an admin.
+// Whether the caller is an admin is determined by the Identity Server.
+func (c *ClusterServer) requireAdmin(ctx context.Context) error {
+	if clusterauth.Authorized(ctx) == nil {
+		return nil
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, c.component.AllowInsecureForCredentials())
+	if err != nil {
+		return err
+	}
+	cc, err := c.component.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return err
+	}
+	authInfo, err := ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return err
+	}
+	if !authInfo.IsAdmin {
+		return errClusterAdminOnly
+	}
+	return nil
+}
+
+// ListPeers implements the Cluster service's ListPeers RPC.
+func (c *ClusterServer) ListPeers(ctx context.Context, _ *pbtypes.Empty) (*ttnpb.ClusterPeers, error) {
+	if err := c.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	peers, err := c.component.ListPeers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ClusterPeers{Peers: peers}, nil
+}
@@ -162,6 +162,10 @@ func New(logger log.Stack, config *Config, opts ...Option) (*Component, error) {
 		return nil, err
 	}
 
+	if err = c.initTrustedProxies(); err != nil {
+		return nil, err
+	}
+
 	c.initRights()
 
 	c.initGRPC()
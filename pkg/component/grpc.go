@@ -16,14 +16,17 @@ package component
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 	"net/http"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	echo "github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/metrics"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
@@ -31,6 +34,23 @@ import (
 	"google.golang.org/grpc"
 )
 
+var errTrustedProxy = errors.DefineInvalidArgument("trusted_proxy", "invalid trusted proxy CIDR `{cidr}`")
+
+func (c *Component) initTrustedProxies() error {
+	trustedProxies := make([]*net.IPNet, 0, len(c.config.GRPC.TrustedProxies))
+	for _, cidr := range c.config.GRPC.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errTrustedProxy.WithAttributes("cidr", cidr).WithCause(err)
+		}
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+	c.AddContextFiller(func(ctx context.Context) context.Context {
+		return rpcmetadata.NewContextWithTrustedProxies(ctx, trustedProxies)
+	})
+	return nil
+}
+
 func (c *Component) initGRPC() {
 	rpclog.ReplaceGrpcLogger(c.logger.WithField("namespace", "grpc"))
 
@@ -78,15 +98,29 @@ func (c *Component) serveGRPC(lis net.Listener) error {
 	return c.grpc.Serve(lis)
 }
 
-func (c *Component) grpcEndpoints() []Endpoint {
+func (c *Component) grpcEndpoints() ([]Endpoint, error) {
+	var tlsOpts []TLSConfigOption
+	if mtls := c.config.Cluster.MTLS; mtls.Enable {
+		// Cluster peers present client certificates, which are verified by WithVerifiedSource.
+		// Other clients do not need to present a client certificate.
+		certPool, err := mtls.CertPool()
+		if err != nil {
+			return nil, err
+		}
+		tlsOpts = append(tlsOpts, WithTLSClientAuth(tls.VerifyClientCertIfGiven, certPool, nil))
+	}
 	return []Endpoint{
 		NewTCPEndpoint(c.config.GRPC.Listen, "gRPC"),
-		NewTLSEndpoint(c.config.GRPC.ListenTLS, "gRPC"),
-	}
+		NewTLSEndpoint(c.config.GRPC.ListenTLS, "gRPC", tlsOpts...),
+	}, nil
 }
 
 func (c *Component) listenGRPC() (err error) {
-	return c.serveOnEndpoints(c.grpcEndpoints(), (*Component).serveGRPC, "grpc")
+	endpoints, err := c.grpcEndpoints()
+	if err != nil {
+		return err
+	}
+	return c.serveOnEndpoints(endpoints, (*Component).serveGRPC, "grpc")
 }
 
 // RegisterGRPC registers a gRPC subsystem to the component.
@@ -104,10 +138,11 @@ func (c *Component) WithClusterAuth() grpc.CallOption {
 
 // ClusterAuthUnaryHook ensuring the caller of an RPC is part of the cluster.
 // If a call can't be identified as coming from the cluster, it will be discarded.
-func (c *Component) ClusterAuthUnaryHook() hooks.UnaryHandlerMiddleware {
+// If roles are given, callers that authenticate with a client certificate must have any of these roles.
+func (c *Component) ClusterAuthUnaryHook(roles ...ttnpb.ClusterRole) hooks.UnaryHandlerMiddleware {
 	return func(next grpc.UnaryHandler) grpc.UnaryHandler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
-			ctx = c.cluster.WithVerifiedSource(ctx)
+			ctx = clusterauth.RequireRoles(c.cluster.WithVerifiedSource(ctx), roles...)
 			return next(ctx, req)
 		}
 	}
@@ -115,11 +150,12 @@ func (c *Component) ClusterAuthUnaryHook() hooks.UnaryHandlerMiddleware {
 
 // ClusterAuthStreamHook ensuring the caller of an RPC is part of the cluster.
 // If a call can't be identified as coming from the cluster, it will be discarded.
-func (c *Component) ClusterAuthStreamHook() hooks.StreamHandlerMiddleware {
+// If roles are given, callers that authenticate with a client certificate must have any of these roles.
+func (c *Component) ClusterAuthStreamHook(roles ...ttnpb.ClusterRole) hooks.StreamHandlerMiddleware {
 	return func(hdl grpc.StreamHandler) grpc.StreamHandler {
 		return func(srv interface{}, stream grpc.ServerStream) error {
 			wrapped := grpc_middleware.WrapServerStream(stream)
-			ctx := c.cluster.WithVerifiedSource(stream.Context())
+			ctx := clusterauth.RequireRoles(c.cluster.WithVerifiedSource(stream.Context()), roles...)
 			wrapped.WrappedContext = ctx
 			return hdl(srv, wrapped)
 		}
@@ -44,17 +44,39 @@ type Sentry struct {
 
 // Cluster represents clustering configuration.
 type Cluster struct {
-	Join              []string `name:"join" description:"Addresses of cluster peers to join"`
-	Name              string   `name:"name" description:"Name of the current cluster peer (default: $HOSTNAME)"`
-	Address           string   `name:"address" description:"Address to use for cluster communication"`
-	IdentityServer    string   `name:"identity-server" description:"Address for the Identity Server"`
-	GatewayServer     string   `name:"gateway-server" description:"Address for the Gateway Server"`
-	NetworkServer     string   `name:"network-server" description:"Address for the Network Server"`
-	ApplicationServer string   `name:"application-server" description:"Address for the Application Server"`
-	JoinServer        string   `name:"join-server" description:"Address for the Join Server"`
-	CryptoServer      string   `name:"crypto-server" description:"Address for the Crypto Server"`
-	TLS               bool     `name:"tls" description:"Do cluster gRPC over TLS"`
-	Keys              []string `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"`
+	Join              []string    `name:"join" description:"Addresses of cluster peers to join"`
+	Name              string      `name:"name" description:"Name of the current cluster peer (default: $HOSTNAME)"`
+	Address           string      `name:"address" description:"Address to use for cluster communication"`
+	IdentityServer    string      `name:"identity-server" description:"Address for the Identity Server"`
+	GatewayServer     string      `name:"gateway-server" description:"Address for the Gateway Server"`
+	NetworkServer     string      `name:"network-server" description:"Address for the Network Server"`
+	ApplicationServer string      `name:"application-server" description:"Address for the Application Server"`
+	JoinServer        string      `name:"join-server" description:"Address for the Join Server"`
+	CryptoServer      string      `name:"crypto-server" description:"Address for the Crypto Server"`
+	TLS               bool        `name:"tls" description:"Do cluster gRPC over TLS"`
+	Keys              []string    `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"`
+	Claims            Claims      `name:"claims"`
+	DNS               ClusterDNS  `name:"dns"`
+	MTLS              ClusterMTLS `name:"mtls"`
+}
+
+// ClusterDNS represents the configuration of peer discovery from DNS SRV records.
+// Each record of the SRV name of a role is a peer with that role.
+type ClusterDNS struct {
+	Interval          time.Duration `name:"interval" description:"Interval in which peers are discovered from DNS SRV records"`
+	IdentityServer    string        `name:"identity-server" description:"DNS SRV name of the Identity Server"`
+	GatewayServer     string        `name:"gateway-server" description:"DNS SRV name of the Gateway Server"`
+	NetworkServer     string        `name:"network-server" description:"DNS SRV name of the Network Server"`
+	ApplicationServer string        `name:"application-server" description:"DNS SRV name of the Application Server"`
+	JoinServer        string        `name:"join-server" description:"DNS SRV name of the Join Server"`
+	CryptoServer      string        `name:"crypto-server" description:"DNS SRV name of the Crypto Server"`
+}
+
+// Claims represents the configuration of identifier claims and peer announcements in Redis.
+// This allows running multiple instances of each role side by side.
+type Claims struct {
+	Enable   bool          `name:"enable" description:"Claim identifiers and discover peers in Redis"`
+	LeaseTTL time.Duration `name:"lease-ttl" description:"Time-to-live of claims and peer announcements, which are renewed periodically"`
 }
 
 // GRPC represents gRPC listener configuration.
@@ -63,6 +85,8 @@ type GRPC struct {
 
 	Listen    string `name:"listen" description:"Address for the TCP gRPC server to listen on"`
 	ListenTLS string `name:"listen-tls" description:"Address for the TLS gRPC server to listen on"`
+
+	TrustedProxies []string `name:"trusted-proxies" description:"CIDRs of trusted reverse proxies that set the X-Forwarded-For header"`
 }
 
 // Cookie represents cookie configuration.
@@ -116,14 +140,47 @@ type InteropServer struct {
 
 // Redis represents Redis configuration.
 type Redis struct {
-	Address   string   `name:"address" description:"Address of the Redis server"`
-	Password  string   `name:"password" description:"Password of the Redis server"`
-	Database  int      `name:"database" description:"Redis database to use"`
-	Namespace []string `name:"namespace" description:"Namespace for Redis keys"`
+	Address   string        `name:"address" description:"Address of the Redis server"`
+	Password  string        `name:"password" description:"Password of the Redis server"`
+	Database  int           `name:"database" description:"Redis database to use"`
+	Namespace []string      `name:"namespace" description:"Namespace for Redis keys"`
+	Failover  RedisFailover `name:"failover"`
+	Cluster   RedisCluster  `name:"cluster"`
 }
 
 // IsZero returns whether the Redis configuration is empty.
-func (r Redis) IsZero() bool { return r.Address == "" && r.Database == 0 && len(r.Namespace) == 0 }
+func (r Redis) IsZero() bool {
+	return r.Address == "" &&
+		r.Database == 0 &&
+		len(r.Namespace) == 0 &&
+		r.Failover.IsZero() &&
+		r.Cluster.IsZero()
+}
+
+// RedisFailover represents Redis Sentinel configuration.
+// With Redis Sentinel, the address of the Redis server is obtained from the sentinels.
+type RedisFailover struct {
+	Enable     bool     `name:"enable" description:"Enable failover using Redis Sentinel"`
+	MasterName string   `name:"master-name" description:"Redis Sentinel master name"`
+	Addresses  []string `name:"addresses" description:"Redis Sentinel server addresses"`
+}
+
+// IsZero returns whether the Redis Sentinel configuration is empty.
+func (r RedisFailover) IsZero() bool {
+	return !r.Enable && r.MasterName == "" && len(r.Addresses) == 0
+}
+
+// RedisCluster represents Redis Cluster configuration.
+// Redis Cluster only supports database 0.
+type RedisCluster struct {
+	Enable    bool     `name:"enable" description:"Enable Redis Cluster"`
+	Addresses []string `name:"addresses" description:"Addresses of the Redis Cluster nodes"`
+}
+
+// IsZero returns whether the Redis Cluster configuration is empty.
+func (r RedisCluster) IsZero() bool {
+	return !r.Enable && len(r.Addresses) == 0
+}
 
 // CloudEvents represents configuration for the cloud events backend.
 type CloudEvents struct {
@@ -241,11 +298,21 @@ func (c DeviceRepositoryConfig) Client() *devicerepository.Client {
 	}
 }
 
+// InteropClientDNS represents the configuration of Join Server resolution by JoinEUI through DNS,
+// according to LoRaWAN Backend Interfaces specification.
+type InteropClientDNS struct {
+	Domain    string        `name:"domain" description:"Domain under which Join Servers are resolved by JoinEUI (e.g. joineuis.lora-alliance.org)"`
+	Server    string        `name:"server" description:"Address (host:port) of the DNS server used for resolution. If empty, the system resolver is used"`
+	CacheTTL  time.Duration `name:"cache-ttl" description:"Time to cache Join Server resolution results"`
+	CacheSize int           `name:"cache-size" description:"Maximum number of Join Server resolution results to cache"`
+}
+
 // InteropClient represents the client-side interoperability through LoRaWAN Backend Interfaces configuration.
 type InteropClient struct {
-	Directory   string      `name:"directory" description:"Retrieve the interoperability client configuration from the filesystem"`
-	URL         string      `name:"url" description:"Retrieve the interoperability client configuration from a web server"`
-	FallbackTLS *tls.Config `name:"-"`
+	Directory   string           `name:"directory" description:"Retrieve the interoperability client configuration from the filesystem"`
+	URL         string           `name:"url" description:"Retrieve the interoperability client configuration from a web server"`
+	DNS         InteropClientDNS `name:"dns"`
+	FallbackTLS *tls.Config      `name:"-"`
 }
 
 // IsZero returns whether conf is empty.
@@ -65,17 +65,16 @@ func (t TLS) IsZero() bool {
 
 var errNoKeyPair = errors.DefineFailedPrecondition("no_key_pair", "no TLS key pair")
 
-// Config loads the key pair and returns the server TLS configuration.
-// Config watches the certificate file and reloads the key pair on changes.
-// NOTE: The configuration returned by Config cannot be used for client connections.
-func (t TLS) Config(ctx context.Context) (*tls.Config, error) {
+// watchKeyPair loads the key pair and returns a function that returns the current key pair.
+// watchKeyPair watches the certificate file and reloads the key pair on changes.
+func watchKeyPair(ctx context.Context, certificate, key string) (func() *tls.Certificate, error) {
 	logger := log.FromContext(ctx)
-	if t.Certificate == "" || t.Key == "" {
+	if certificate == "" || key == "" {
 		return nil, errNoKeyPair
 	}
 	var cv atomic.Value
 	loadCertificate := func() error {
-		cert, err := tls.LoadX509KeyPair(t.Certificate, t.Key)
+		cert, err := tls.LoadX509KeyPair(certificate, key)
 		if err != nil {
 			return err
 		}
@@ -86,18 +85,9 @@ func (t TLS) Config(ctx context.Context) (*tls.Config, error) {
 	if err := loadCertificate(); err != nil {
 		return nil, err
 	}
-	var rootCAs *x509.CertPool
-	if t.RootCA != "" {
-		pem, err := ioutil.ReadFile(t.RootCA)
-		if err != nil {
-			return nil, err
-		}
-		rootCAs = x509.NewCertPool()
-		rootCAs.AppendCertsFromPEM(pem)
-	}
 
 	debounce := make(chan struct{}, 1)
-	fs.Watch(t.Certificate, events.HandlerFunc(func(evt events.Event) {
+	fs.Watch(certificate, events.HandlerFunc(func(evt events.Event) {
 		if evt.Name() != "fs.write" {
 			return
 		}
@@ -115,10 +105,76 @@ func (t TLS) Config(ctx context.Context) (*tls.Config, error) {
 		}
 	}))
 
+	return func() *tls.Certificate {
+		return cv.Load().(*tls.Certificate)
+	}, nil
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(pem)
+	return pool, nil
+}
+
+// Config loads the key pair and returns the server TLS configuration.
+// Config watches the certificate file and reloads the key pair on changes.
+// NOTE: The configuration returned by Config cannot be used for client connections.
+func (t TLS) Config(ctx context.Context) (*tls.Config, error) {
+	keyPair, err := watchKeyPair(ctx, t.Certificate, t.Key)
+	if err != nil {
+		return nil, err
+	}
+	var rootCAs *x509.CertPool
+	if t.RootCA != "" {
+		if rootCAs, err = loadCertPool(t.RootCA); err != nil {
+			return nil, err
+		}
+	}
 	return &tls.Config{
 		RootCAs: rootCAs,
 		GetCertificate: func(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
-			return cv.Load().(*tls.Certificate), nil
+			return keyPair(), nil
+		},
+	}, nil
+}
+
+// ClusterMTLS represents the configuration of mutual TLS authentication between cluster peers.
+// Each peer has a certificate with a SPIFFE ID that encodes its cluster roles as path segments,
+// i.e. spiffe://<trust-domain>/<role>[/<role>...], where each role is a lowercase cluster role name.
+type ClusterMTLS struct {
+	Enable      bool   `name:"enable" description:"Authenticate cluster peers with client certificates instead of cluster keys"`
+	CA          string `name:"ca" description:"Location of the CA certificate that issues the certificates of the cluster peers"`
+	Certificate string `name:"certificate" description:"Location of the certificate of this peer"`
+	Key         string `name:"key" description:"Location of the private key of this peer"`
+	TrustDomain string `name:"trust-domain" description:"SPIFFE trust domain of the cluster"`
+	// AllowClusterKeys allows peers to keep authenticating with cluster keys, i.e. while migrating to mTLS.
+	AllowClusterKeys bool `name:"allow-cluster-keys" description:"Accept cluster keys from peers that do not present a client certificate"`
+}
+
+// CertPool returns the pool with the CA certificate that issues the certificates of the cluster peers.
+func (m ClusterMTLS) CertPool() (*x509.CertPool, error) {
+	return loadCertPool(m.CA)
+}
+
+// ClientConfig loads the key pair and returns the TLS configuration for connections to cluster peers.
+// ClientConfig watches the certificate file and reloads the key pair on changes.
+func (m ClusterMTLS) ClientConfig(ctx context.Context) (*tls.Config, error) {
+	keyPair, err := watchKeyPair(ctx, m.Certificate, m.Key)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs, err := m.CertPool()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		RootCAs: rootCAs,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return keyPair(), nil
 		},
 	}, nil
 }
@@ -0,0 +1,390 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/qrcode"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+var (
+	evtClaimEndDevice = events.Define(
+		"dcs.end_device.claim", "claim end device",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+	evtAbortClaimEndDevice = events.Define(
+		"dcs.end_device.claim.abort", "abort claiming end device",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+)
+
+var (
+	errNoSourceDevice           = errors.DefineInvalidArgument("no_source_device", "no source device specified")
+	errQRCodeData               = errors.DefineInvalidArgument("qr_code_data", "QR code data does not contain end device identifiers and authentication code")
+	errApplicationNotAuthorized = errors.DefinePermissionDenied("application_not_authorized", "application `{application_uid}` is not authorized for claiming")
+	errRegisterTargetDevice     = errors.Define("register_target_device", "failed to register target end device")
+	errNoNetworkServer          = errors.DefineFailedPrecondition("no_network_server", "no Network Server available to transfer end device")
+	errNoApplicationServer      = errors.DefineFailedPrecondition("no_application_server", "no Application Server available to transfer end device")
+)
+
+var (
+	// isPaths are the paths of the end device stored in the Identity Server that are transferred.
+	isPaths = []string{
+		"attributes",
+		"description",
+		"join_server_address",
+		"locations",
+		"name",
+		"service_profile_id",
+		"version_ids",
+	}
+	// isGetPaths are the paths of the end device that are read from the Identity Server.
+	isGetPaths = append([]string{
+		"application_server_address",
+		"network_server_address",
+	}, isPaths...)
+	// jsTargetPaths are the paths of the end device set in the Join Server by the claim request.
+	// The root keys and the other registration of the end device are transferred by the Join Server.
+	jsTargetPaths = []string{
+		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"network_server_address",
+		"network_server_kek_label",
+	}
+	// nsPaths are the paths of the end device stored in the Network Server that are transferred.
+	nsPaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+	}
+	// asPaths are the paths of the end device stored in the Application Server that are transferred.
+	asPaths = []string{
+		"formatters",
+	}
+)
+
+// claimAuthenticatedIdentifiers returns the JoinEUI, DevEUI and claim authentication code of the source device
+// of the claim request, either from the authenticated identifiers or from the QR code.
+func claimAuthenticatedIdentifiers(req *ttnpb.ClaimEndDeviceRequest) (joinEUI, devEUI types.EUI64, authenticationCode []byte, err error) {
+	switch source := req.SourceDevice.(type) {
+	case *ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_:
+		ids := source.AuthenticatedIdentifiers
+		return ids.JoinEUI, ids.DevEUI, ids.AuthenticationCode, nil
+	case *ttnpb.ClaimEndDeviceRequest_QRCode:
+		data, err := qrcode.Parse(source.QRCode)
+		if err != nil {
+			return types.EUI64{}, types.EUI64{}, nil, err
+		}
+		authIDs, ok := data.(qrcode.AuthenticatedEndDeviceIdentifiers)
+		if !ok {
+			return types.EUI64{}, types.EUI64{}, nil, errQRCodeData
+		}
+		joinEUI, devEUI, authenticationCode = authIDs.AuthenticatedEndDeviceIdentifiers()
+		return joinEUI, devEUI, authenticationCode, nil
+	default:
+		return types.EUI64{}, types.EUI64{}, nil, errNoSourceDevice
+	}
+}
+
+// claim transfers the end device identified by joinEUI and devEUI to the target application of the request.
+// The claim authentication code is verified by the Join Server.
+// The source end device is read and deleted with the API key of the authorized source application.
+// The target end device is registered with the credentials of the caller. If the target end device can not be
+// registered, the source end device is restored. The root keys are transferred by the Join Server.
+func (dcs *DeviceClaimingServer) claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest, joinEUI, devEUI types.EUI64, authenticationCode []byte) (*ttnpb.EndDeviceIdentifiers, error) {
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	targetCallOpt, err := rpcmetadata.WithForwardedAuth(ctx, dcs.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	isConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	isClient := ttnpb.NewEndDeviceRegistryClient(isConn)
+	sourceIDs, err := isClient.GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEUI: joinEUI,
+		DevEUI:  devEUI,
+	}, dcs.WithClusterAuth())
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.WithField("source_device_uid", unique.ID(ctx, sourceIDs))
+	ctx = log.NewContext(ctx, logger)
+
+	jsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, *sourceIDs)
+	if err != nil {
+		return nil, err
+	}
+	jsClient := ttnpb.NewDcsJsClient(jsConn)
+	if _, err := jsClient.VerifyClaimAuthenticationCode(ctx, &ttnpb.VerifyClaimAuthenticationCodeRequest{
+		JoinEUI:            joinEUI,
+		DevEUI:             devEUI,
+		AuthenticationCode: authenticationCode,
+	}, dcs.WithClusterAuth()); err != nil {
+		return nil, err
+	}
+
+	authorization, err := dcs.authorizedApplications.Get(ctx, sourceIDs.ApplicationIdentifiers)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errApplicationNotAuthorized.WithAttributes("application_uid", unique.ID(ctx, sourceIDs.ApplicationIdentifiers))
+		}
+		return nil, err
+	}
+	sourceCallOpt := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     authorization.APIKey,
+		AllowInsecure: dcs.AllowInsecureForCredentials(),
+	})
+
+	t := &transfer{
+		isClient: isClient,
+		jsClient: jsClient,
+	}
+	if err := dcs.readSourceDevice(ctx, req, t, *sourceIDs, sourceCallOpt); err != nil {
+		return nil, err
+	}
+
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: req.TargetApplicationIDs,
+		DeviceID:               req.TargetDeviceID,
+		JoinEUI:                &joinEUI,
+		DevEUI:                 &devEUI,
+	}
+	if targetIDs.DeviceID == "" {
+		targetIDs.DeviceID = sourceIDs.DeviceID
+	}
+	logger = logger.WithField("target_device_uid", unique.ID(ctx, targetIDs))
+	ctx = log.NewContext(ctx, logger)
+
+	if err := t.transfer(ctx, req, *sourceIDs, targetIDs, sourceCallOpt, targetCallOpt, dcs.WithClusterAuth()); err != nil {
+		events.Publish(evtAbortClaimEndDevice(ctx, targetIDs, err))
+		return nil, err
+	}
+
+	events.Publish(evtClaimEndDevice(ctx, targetIDs, sourceIDs))
+	logger.Info("Claimed end device")
+	return &targetIDs, nil
+}
+
+// transfer contains the registry clients and the end device registrations involved in claiming an end device.
+// The registrations are read from the source end device and are not modified, so that the source end device can be
+// restored if the target end device can not be registered. The Join Server registration, including the root keys,
+// is transferred by the Join Server. The Network Server and Application Server registrations are nil if they are not
+// transferred.
+type transfer struct {
+	isClient ttnpb.EndDeviceRegistryClient
+	jsClient ttnpb.DcsJsClient
+	nsClient ttnpb.NsEndDeviceRegistryClient
+	asClient ttnpb.AsEndDeviceRegistryClient
+
+	isDev, nsDev, asDev *ttnpb.EndDevice
+	// targetCreated indicates whether the target end device is created in the Identity Server.
+	targetCreated bool
+}
+
+// readSourceDevice reads the source end device registrations into t.
+func (dcs *DeviceClaimingServer) readSourceDevice(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest, t *transfer, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) error {
+	var err error
+	t.isDev, err = t.isClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: isGetPaths},
+	}, callOpt)
+	if err != nil {
+		return err
+	}
+
+	if req.TargetNetworkServerAddress != "" && t.isDev.NetworkServerAddress != "" {
+		nsConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, ids)
+		if err != nil {
+			return errNoNetworkServer.WithCause(err)
+		}
+		t.nsClient = ttnpb.NewNsEndDeviceRegistryClient(nsConn)
+		t.nsDev, err = t.nsClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ids,
+			FieldMask:            pbtypes.FieldMask{Paths: nsPaths},
+		}, callOpt)
+		if err != nil {
+			return err
+		}
+	}
+	if req.TargetApplicationServerAddress != "" && t.isDev.ApplicationServerAddress != "" {
+		asConn, err := dcs.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+		if err != nil {
+			return errNoApplicationServer.WithCause(err)
+		}
+		t.asClient = ttnpb.NewAsEndDeviceRegistryClient(asConn)
+		t.asDev, err = t.asClient.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: ids,
+			FieldMask:            pbtypes.FieldMask{Paths: asPaths},
+		}, callOpt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// transfer deletes the source end device and registers the target end device. If the source end device can not be
+// deleted or the target end device can not be registered, the target end device is deleted and the source end
+// device is restored.
+func (t *transfer) transfer(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest, sourceIDs, targetIDs ttnpb.EndDeviceIdentifiers, sourceCallOpt, targetCallOpt, jsCallOpt grpc.CallOption) error {
+	logger := log.FromContext(ctx)
+
+	logger.Debug("Delete source end device")
+	if err := t.deleteDevice(ctx, sourceIDs, sourceCallOpt); err != nil {
+		if rErr := t.restoreSourceDevice(ctx, sourceIDs, sourceCallOpt); rErr != nil {
+			logger.WithError(rErr).Error("Failed to restore source end device")
+		}
+		return err
+	}
+
+	logger.Debug("Register target end device")
+	if err := t.registerTargetDevice(ctx, req, targetIDs, targetCallOpt, jsCallOpt); err != nil {
+		logger.WithError(err).Warn("Failed to register target end device, restore source end device")
+		if t.targetCreated {
+			if dErr := t.deleteDevice(ctx, targetIDs, targetCallOpt); dErr != nil {
+				logger.WithError(dErr).Error("Failed to delete target end device")
+			}
+		}
+		if rErr := t.restoreSourceDevice(ctx, sourceIDs, sourceCallOpt); rErr != nil {
+			logger.WithError(rErr).Error("Failed to restore source end device")
+		}
+		return errRegisterTargetDevice.WithCause(err)
+	}
+	return nil
+}
+
+// deleteDevice deletes the end device with the given identifiers from the Application Server, Network Server and
+// Identity Server. The source end device is deleted from the Join Server when it is transferred by the Join Server.
+func (t *transfer) deleteDevice(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) error {
+	if t.asDev != nil {
+		if _, err := t.asClient.Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if t.nsDev != nil {
+		if _, err := t.nsClient.Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	if _, err := t.isClient.Delete(ctx, &ids, callOpt); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// restoreSourceDevice registers the source end device again from the registrations that were read.
+func (t *transfer) restoreSourceDevice(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) error {
+	isDev := *t.isDev
+	isDev.EndDeviceIdentifiers = ids
+	if _, err := t.isClient.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: isDev,
+	}, callOpt); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return t.setDevice(ctx, ids, callOpt)
+}
+
+// setDevice sets the Network Server and Application Server registrations of the end device with the given identifiers.
+func (t *transfer) setDevice(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption) error {
+	if t.nsDev != nil {
+		nsDev := *t.nsDev
+		nsDev.EndDeviceIdentifiers = ids
+		if _, err := t.nsClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: nsDev,
+			FieldMask: pbtypes.FieldMask{Paths: nsPaths},
+		}, callOpt); err != nil {
+			return err
+		}
+	}
+	if t.asDev != nil {
+		asDev := *t.asDev
+		asDev.EndDeviceIdentifiers = ids
+		if _, err := t.asClient.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: asDev,
+			FieldMask: pbtypes.FieldMask{Paths: asPaths},
+		}, callOpt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// registerTargetDevice registers the end device with the given identifiers, starting with the Identity Server.
+// The Join Server registration is transferred last, so that the source end device is kept in the Join Server if
+// any of the other registrations fail.
+func (t *transfer) registerTargetDevice(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest, ids ttnpb.EndDeviceIdentifiers, callOpt, jsCallOpt grpc.CallOption) error {
+	isDev := *t.isDev
+	isDev.EndDeviceIdentifiers = ids
+	isDev.NetworkServerAddress = req.TargetNetworkServerAddress
+	isDev.ApplicationServerAddress = req.TargetApplicationServerAddress
+	if _, err := t.isClient.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: isDev,
+	}, callOpt); err != nil {
+		return err
+	}
+	t.targetCreated = true
+
+	if err := t.setDevice(ctx, ids, callOpt); err != nil {
+		return err
+	}
+
+	jsDev := ttnpb.EndDevice{
+		EndDeviceIdentifiers:      ids,
+		NetworkServerAddress:      req.TargetNetworkServerAddress,
+		NetworkServerKEKLabel:     req.TargetNetworkServerKEKLabel,
+		ApplicationServerAddress:  req.TargetApplicationServerAddress,
+		ApplicationServerKEKLabel: req.TargetApplicationServerKEKLabel,
+		ApplicationServerID:       req.TargetApplicationServerID,
+	}
+	paths := append([]string{}, jsTargetPaths...)
+	if req.TargetNetID != nil {
+		jsDev.NetID = req.TargetNetID
+		paths = append(paths, "net_id")
+	}
+	if req.InvalidateAuthenticationCode {
+		paths = append(paths, "claim_authentication_code")
+	}
+	if _, err := t.jsClient.TransferEndDevice(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: jsDev,
+		FieldMask: pbtypes.FieldMask{Paths: paths},
+	}, jsCallOpt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestClaimAuthenticatedIdentifiers(t *testing.T) {
+	for _, tc := range []struct {
+		Name                       string
+		Request                    *ttnpb.ClaimEndDeviceRequest
+		ExpectedJoinEUI            types.EUI64
+		ExpectedDevEUI             types.EUI64
+		ExpectedAuthenticationCode []byte
+		ErrorAssertion             func(error) bool
+	}{
+		{
+			Name: "AuthenticatedIdentifiers",
+			Request: &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+					AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+						JoinEUI:            types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+						DevEUI:             types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+						AuthenticationCode: []byte{0x01, 0x02},
+					},
+				},
+			},
+			ExpectedJoinEUI:            types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ExpectedDevEUI:             types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ExpectedAuthenticationCode: []byte{0x01, 0x02},
+		},
+		{
+			Name: "QRCode",
+			Request: &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_QRCode{
+					QRCode: []byte("URN:LW:DP:42FFFFFFFFFFFFFF:4242FFFFFFFFFFFF:42FFFF42:%V0102"),
+				},
+			},
+			ExpectedJoinEUI:            types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ExpectedDevEUI:             types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ExpectedAuthenticationCode: []byte{0x01, 0x02},
+		},
+		{
+			Name: "InvalidQRCode",
+			Request: &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_QRCode{
+					QRCode: []byte("invalid"),
+				},
+			},
+			ErrorAssertion: func(err error) bool { return err != nil },
+		},
+		{
+			Name:    "NoSourceDevice",
+			Request: &ttnpb.ClaimEndDeviceRequest{},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errNoSourceDevice)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			joinEUI, devEUI, authenticationCode, err := claimAuthenticatedIdentifiers(tc.Request)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(joinEUI, should.Resemble, tc.ExpectedJoinEUI)
+			a.So(devEUI, should.Resemble, tc.ExpectedDevEUI)
+			a.So(authenticationCode, should.Resemble, tc.ExpectedAuthenticationCode)
+		})
+	}
+}
+
+// transferRecorder records the calls to the registry clients used to transfer an end device.
+type transferRecorder struct {
+	calls           []string
+	isCreateErr     func(ttnpb.EndDeviceIdentifiers) error
+	jsTransferErr   error
+	jsTransferPaths []string
+}
+
+func (r *transferRecorder) record(name string, ids ttnpb.EndDeviceIdentifiers) {
+	r.calls = append(r.calls, fmt.Sprintf("%s %s", name, ids.DeviceID))
+}
+
+type mockISClient struct {
+	ttnpb.EndDeviceRegistryClient
+	*transferRecorder
+}
+
+func (c mockISClient) Create(ctx context.Context, in *ttnpb.CreateEndDeviceRequest, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	c.record("is.create", in.EndDeviceIdentifiers)
+	if c.isCreateErr != nil {
+		if err := c.isCreateErr(in.EndDeviceIdentifiers); err != nil {
+			return nil, err
+		}
+	}
+	return &in.EndDevice, nil
+}
+
+func (c mockISClient) Delete(ctx context.Context, in *ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*pbtypes.Empty, error) {
+	c.record("is.delete", *in)
+	return ttnpb.Empty, nil
+}
+
+type mockJSClient struct {
+	ttnpb.DcsJsClient
+	*transferRecorder
+}
+
+func (c mockJSClient) TransferEndDevice(ctx context.Context, in *ttnpb.SetEndDeviceRequest, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	c.record("js.transfer", in.EndDevice.EndDeviceIdentifiers)
+	c.jsTransferPaths = in.FieldMask.Paths
+	if c.jsTransferErr != nil {
+		return nil, c.jsTransferErr
+	}
+	return &in.EndDevice, nil
+}
+
+type mockNSClient struct {
+	ttnpb.NsEndDeviceRegistryClient
+	*transferRecorder
+}
+
+func (c mockNSClient) Set(ctx context.Context, in *ttnpb.SetEndDeviceRequest, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	c.record("ns.set", in.EndDevice.EndDeviceIdentifiers)
+	return &in.EndDevice, nil
+}
+
+func (c mockNSClient) Delete(ctx context.Context, in *ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*pbtypes.Empty, error) {
+	c.record("ns.delete", *in)
+	return ttnpb.Empty, nil
+}
+
+type mockASClient struct {
+	ttnpb.AsEndDeviceRegistryClient
+	*transferRecorder
+}
+
+func (c mockASClient) Set(ctx context.Context, in *ttnpb.SetEndDeviceRequest, opts ...grpc.CallOption) (*ttnpb.EndDevice, error) {
+	c.record("as.set", in.EndDevice.EndDeviceIdentifiers)
+	return &in.EndDevice, nil
+}
+
+func (c mockASClient) Delete(ctx context.Context, in *ttnpb.EndDeviceIdentifiers, opts ...grpc.CallOption) (*pbtypes.Empty, error) {
+	c.record("as.delete", *in)
+	return ttnpb.Empty, nil
+}
+
+func TestTransfer(t *testing.T) {
+	errTest := errors.DefineUnavailable("test", "test")
+
+	sourceIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"},
+		DeviceID:               "source-dev",
+		JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"},
+		DeviceID:               "target-dev",
+		JoinEUI:                sourceIDs.JoinEUI,
+		DevEUI:                 sourceIDs.DevEUI,
+	}
+	req := &ttnpb.ClaimEndDeviceRequest{
+		TargetApplicationIDs:           targetIDs.ApplicationIdentifiers,
+		TargetDeviceID:                 targetIDs.DeviceID,
+		TargetNetworkServerAddress:     "target-ns",
+		TargetApplicationServerAddress: "target-as",
+		InvalidateAuthenticationCode:   true,
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		IsCreateErr    func(ttnpb.EndDeviceIdentifiers) error
+		JsTransferErr  error
+		ExpectedCalls  []string
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Success",
+			ExpectedCalls: []string{
+				"as.delete source-dev",
+				"ns.delete source-dev",
+				"is.delete source-dev",
+				"is.create target-dev",
+				"ns.set target-dev",
+				"as.set target-dev",
+				"js.transfer target-dev",
+			},
+		},
+		{
+			Name:          "Join Server failure",
+			JsTransferErr: errTest,
+			ExpectedCalls: []string{
+				"as.delete source-dev",
+				"ns.delete source-dev",
+				"is.delete source-dev",
+				"is.create target-dev",
+				"ns.set target-dev",
+				"as.set target-dev",
+				"js.transfer target-dev",
+				"as.delete target-dev",
+				"ns.delete target-dev",
+				"is.delete target-dev",
+				"is.create source-dev",
+				"ns.set source-dev",
+				"as.set source-dev",
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errRegisterTargetDevice.WithCause(errTest))
+			},
+		},
+		{
+			Name: "Target exists",
+			IsCreateErr: func(ids ttnpb.EndDeviceIdentifiers) error {
+				if ids.DeviceID == targetIDs.DeviceID {
+					return errTest
+				}
+				return nil
+			},
+			ExpectedCalls: []string{
+				"as.delete source-dev",
+				"ns.delete source-dev",
+				"is.delete source-dev",
+				"is.create target-dev",
+				"is.create source-dev",
+				"ns.set source-dev",
+				"as.set source-dev",
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errRegisterTargetDevice.WithCause(errTest))
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			r := &transferRecorder{
+				isCreateErr:   tc.IsCreateErr,
+				jsTransferErr: tc.JsTransferErr,
+			}
+			tr := &transfer{
+				isClient: mockISClient{transferRecorder: r},
+				jsClient: mockJSClient{transferRecorder: r},
+				nsClient: mockNSClient{transferRecorder: r},
+				asClient: mockASClient{transferRecorder: r},
+				isDev: &ttnpb.EndDevice{
+					EndDeviceIdentifiers:     sourceIDs,
+					NetworkServerAddress:     "source-ns",
+					ApplicationServerAddress: "source-as",
+				},
+				nsDev: &ttnpb.EndDevice{EndDeviceIdentifiers: sourceIDs},
+				asDev: &ttnpb.EndDevice{EndDeviceIdentifiers: sourceIDs},
+			}
+			err := tr.transfer(ctx, req, sourceIDs, targetIDs, grpc.EmptyCallOption{}, grpc.EmptyCallOption{}, grpc.EmptyCallOption{})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+			a.So(r.calls, should.Resemble, tc.ExpectedCalls)
+
+			// The source end device registrations are not modified, so that they can be restored.
+			a.So(tr.isDev.EndDeviceIdentifiers, should.Resemble, sourceIDs)
+			a.So(tr.isDev.NetworkServerAddress, should.Equal, "source-ns")
+			a.So(tr.nsDev.EndDeviceIdentifiers, should.Resemble, sourceIDs)
+			a.So(tr.asDev.EndDeviceIdentifiers, should.Resemble, sourceIDs)
+
+			// The root keys are transferred by the Join Server.
+			if r.jsTransferPaths != nil {
+				a.So(r.jsTransferPaths, should.NotContain, "root_keys")
+				a.So(r.jsTransferPaths, should.Contain, "claim_authentication_code")
+			}
+		})
+	}
+}
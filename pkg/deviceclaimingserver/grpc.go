@@ -0,0 +1,64 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type endDeviceClaimingServer struct {
+	DCS *DeviceClaimingServer
+}
+
+// Claim implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.ClaimEndDeviceRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	if err := rights.RequireApplication(ctx, req.TargetApplicationIDs,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	joinEUI, devEUI, authenticationCode, err := claimAuthenticatedIdentifiers(req)
+	if err != nil {
+		return nil, err
+	}
+	return s.DCS.claim(ctx, req, joinEUI, devEUI, authenticationCode)
+}
+
+// AuthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if err := s.DCS.authorizedApplications.Set(ctx, req); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements ttnpb.EndDeviceClaimingServerServer.
+func (s *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if err := s.DCS.authorizedApplications.Delete(ctx, *ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
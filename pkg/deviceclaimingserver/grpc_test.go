@@ -0,0 +1,120 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	. "go.thethings.network/lorawan-stack/pkg/deviceclaimingserver"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestAuthorizeApplication(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Rights         []ttnpb.Right
+		ErrorAssertion func(error) bool
+		SetCalls       int
+		DeleteCalls    int
+	}{
+		{
+			Name:   "PermissionDenied",
+			Rights: []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_READ},
+			ErrorAssertion: func(err error) bool {
+				return errors.IsPermissionDenied(err)
+			},
+		},
+		{
+			Name:        "Success",
+			Rights:      []ttnpb.Right{ttnpb.RIGHT_APPLICATION_DEVICES_WRITE},
+			SetCalls:    1,
+			DeleteCalls: 1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+			appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+			var setCalls, deleteCalls int
+
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			c.AddContextFiller(func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, appIDs): ttnpb.RightsFrom(tc.Rights...),
+					},
+				})
+			})
+			test.Must(New(c, &Config{
+				AuthorizedApplications: &mockAuthorizedApplicationRegistry{
+					SetFunc: func(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error {
+						setCalls++
+						a.So(req.ApplicationIdentifiers, should.Resemble, appIDs)
+						a.So(req.APIKey, should.Equal, "test-key")
+						return nil
+					},
+					DeleteFunc: func(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+						deleteCalls++
+						a.So(ids, should.Resemble, appIDs)
+						return nil
+					},
+				},
+			}))
+			test.Must(c.Start(), nil)
+			defer c.Close()
+
+			mustHavePeer(ctx, c, ttnpb.ClusterRole_DEVICE_CLAIMING_SERVER)
+
+			client := ttnpb.NewEndDeviceClaimingServerClient(c.LoopbackConn())
+
+			_, err := client.AuthorizeApplication(ctx, &ttnpb.AuthorizeApplicationRequest{
+				ApplicationIdentifiers: appIDs,
+				APIKey:                 "test-key",
+			})
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+
+			_, err = client.UnauthorizeApplication(ctx, &appIDs)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+
+			a.So(setCalls, should.Equal, tc.SetCalls)
+			a.So(deleteCalls, should.Equal, tc.DeleteCalls)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	a := assertions.New(t)
+	c := component.MustNew(test.GetLogger(t), &component.Config{})
+	_, err := New(c, &Config{})
+	a.So(err, should.NotBeNil)
+}
@@ -0,0 +1,63 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides Redis implementations of the Device Claiming Server registries.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// AuthorizedApplicationRegistry is a store for authorized applications.
+type AuthorizedApplicationRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *AuthorizedApplicationRegistry) appKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the authorization of the application by its identifiers.
+func (r *AuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error) {
+	defer trace.StartRegion(ctx, "get authorized application").End()
+
+	pb := &ttnpb.AuthorizeApplicationRequest{}
+	if err := ttnredis.GetProto(r.Redis, r.appKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set creates or replaces the authorization of the application.
+func (r *AuthorizedApplicationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error {
+	defer trace.StartRegion(ctx, "set authorized application").End()
+
+	cmd, err := ttnredis.SetProto(r.Redis, r.appKey(unique.ID(ctx, req.ApplicationIdentifiers)), req, 0)
+	if err != nil {
+		return err
+	}
+	return ttnredis.ConvertError(cmd.Err())
+}
+
+// Delete deletes the authorization of the application.
+func (r *AuthorizedApplicationRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete authorized application").End()
+
+	return ttnredis.ConvertError(r.Redis.Del(r.appKey(unique.ID(ctx, ids))).Err())
+}
@@ -0,0 +1,60 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceclaimingserver_test
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+func mustHavePeer(ctx context.Context, c *component.Component, role ttnpb.ClusterRole) {
+	for i := 0; i < 20; i++ {
+		time.Sleep(20 * time.Millisecond)
+		if _, err := c.GetPeer(ctx, role, nil); err == nil {
+			return
+		}
+	}
+	panic("could not connect to peer")
+}
+
+type mockAuthorizedApplicationRegistry struct {
+	GetFunc    func(context.Context, ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error)
+	SetFunc    func(context.Context, *ttnpb.AuthorizeApplicationRequest) error
+	DeleteFunc func(context.Context, ttnpb.ApplicationIdentifiers) error
+}
+
+func (r *mockAuthorizedApplicationRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error) {
+	if r.GetFunc == nil {
+		panic("Get should not be called")
+	}
+	return r.GetFunc(ctx, ids)
+}
+
+func (r *mockAuthorizedApplicationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error {
+	if r.SetFunc == nil {
+		panic("Set should not be called")
+	}
+	return r.SetFunc(ctx, req)
+}
+
+func (r *mockAuthorizedApplicationRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	if r.DeleteFunc == nil {
+		panic("Delete should not be called")
+	}
+	return r.DeleteFunc(ctx, ids)
+}
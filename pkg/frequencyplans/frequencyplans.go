@@ -459,6 +459,127 @@ func (fp *FrequencyPlan) ToConcentratorConfig() (*ttnpb.ConcentratorConfig, erro
 	return cc, nil
 }
 
+var (
+	errNoFrequencyPlans  = errors.DefineInvalidArgument("no_frequency_plans", "no frequency plans")
+	errIncompatibleBands = errors.DefineInvalidArgument("incompatible_bands", "frequency plans of bands `{band_ids}` cannot be combined")
+)
+
+// Combine returns a frequency plan that combines the given frequency plans, for gateways with multiple concentrators.
+// All frequency plans must use the same band. The uplink channels and radios of the frequency plans are concatenated,
+// where the radio indices of the channels are offset by the radios of the preceding frequency plans.
+// The most restrictive time-off-air, dwell time and maximum EIRP settings apply.
+// The LoRa standard channel, FSK channel, listen-before-talk, ping slot and Rx2 settings are taken from the first
+// frequency plan that defines them.
+// The combined frequency plan may exceed the capacity of a single concentrator, so it cannot be used to configure one.
+func Combine(fps ...*FrequencyPlan) (*FrequencyPlan, error) {
+	if len(fps) == 0 {
+		return nil, errNoFrequencyPlans
+	}
+	if len(fps) == 1 {
+		return fps[0], nil
+	}
+	res := &FrequencyPlan{
+		BandID: fps[0].BandID,
+	}
+	downlinkFrequencies := make(map[uint64]bool)
+	for _, fp := range fps {
+		if fp.BandID != res.BandID {
+			return nil, errIncompatibleBands.WithAttributes("band_ids", []string{res.BandID, fp.BandID})
+		}
+		radioOffset := uint8(len(res.Radios))
+		for _, ch := range fp.UplinkChannels {
+			nch := ch.Clone()
+			nch.Radio += radioOffset
+			nch.DwellTime = combinedChannelDwellTime(ch.DwellTime, fp.DwellTime.GetUplinks(), fp.DwellTime.Duration)
+			res.UplinkChannels = append(res.UplinkChannels, *nch)
+		}
+		for _, ch := range fp.DownlinkChannels {
+			if downlinkFrequencies[ch.Frequency] {
+				continue
+			}
+			downlinkFrequencies[ch.Frequency] = true
+			nch := ch.Clone()
+			nch.Radio += radioOffset
+			nch.DwellTime = combinedChannelDwellTime(ch.DwellTime, fp.DwellTime.GetDownlinks(), fp.DwellTime.Duration)
+			res.DownlinkChannels = append(res.DownlinkChannels, *nch)
+		}
+		if res.LoRaStandardChannel == nil && fp.LoRaStandardChannel != nil {
+			res.LoRaStandardChannel = fp.LoRaStandardChannel.Clone()
+			res.LoRaStandardChannel.Radio += radioOffset
+		}
+		if res.FSKChannel == nil && fp.FSKChannel != nil {
+			res.FSKChannel = fp.FSKChannel.Clone()
+			res.FSKChannel.Radio += radioOffset
+		}
+		if fp.TimeOffAir.Fraction > res.TimeOffAir.Fraction {
+			res.TimeOffAir.Fraction = fp.TimeOffAir.Fraction
+		}
+		if fp.TimeOffAir.Duration > res.TimeOffAir.Duration {
+			res.TimeOffAir.Duration = fp.TimeOffAir.Duration
+		}
+		if fp.DwellTime.GetUplinks() || fp.DwellTime.GetDownlinks() {
+			if fp.DwellTime.GetUplinks() {
+				val := true
+				res.DwellTime.Uplinks = &val
+			}
+			if fp.DwellTime.GetDownlinks() {
+				val := true
+				res.DwellTime.Downlinks = &val
+			}
+			if res.DwellTime.Duration == nil || *fp.DwellTime.Duration < *res.DwellTime.Duration {
+				val := *fp.DwellTime.Duration
+				res.DwellTime.Duration = &val
+			}
+		}
+		if res.LBT == nil {
+			res.LBT = fp.LBT.Clone()
+		}
+		if len(res.Radios) == 0 {
+			res.ClockSource = fp.ClockSource
+		}
+		for _, r := range fp.Radios {
+			res.Radios = append(res.Radios, *r.Clone())
+		}
+		if res.PingSlot == nil {
+			res.PingSlot = fp.PingSlot.Clone()
+		}
+		if res.DefaultPingSlotDataRate == nil && fp.DefaultPingSlotDataRate != nil {
+			val := *fp.DefaultPingSlotDataRate
+			res.DefaultPingSlotDataRate = &val
+		}
+		if res.Rx2Channel == nil {
+			res.Rx2Channel = fp.Rx2Channel.Clone()
+		}
+		if res.DefaultRx2DataRate == nil && fp.DefaultRx2DataRate != nil {
+			val := *fp.DefaultRx2DataRate
+			res.DefaultRx2DataRate = &val
+		}
+		if fp.MaxEIRP != nil && (res.MaxEIRP == nil || *fp.MaxEIRP < *res.MaxEIRP) {
+			val := *fp.MaxEIRP
+			res.MaxEIRP = &val
+		}
+	}
+	return res, nil
+}
+
+// combinedChannelDwellTime returns the channel dwell time that makes the frequency plan dwell time explicit, so that
+// the channel keeps its dwell time restrictions when combined with other frequency plans.
+func combinedChannelDwellTime(dt *ChannelDwellTime, fpEnabled bool, fpDuration *time.Duration) *ChannelDwellTime {
+	ndt := dt.Clone()
+	if ndt == nil {
+		ndt = &ChannelDwellTime{}
+	}
+	if ndt.Enabled == nil {
+		val := fpEnabled
+		ndt.Enabled = &val
+	}
+	if ndt.Duration == nil && fpDuration != nil {
+		val := *fpDuration
+		ndt.Duration = &val
+	}
+	return ndt
+}
+
 // FrequencyPlanDescription describes a frequency plan in the YAML format.
 type FrequencyPlanDescription struct {
 	// ID is the unique identifier of the frequency plan.
@@ -654,6 +775,20 @@ func (s *Store) GetByID(id string) (*FrequencyPlan, error) {
 	return fp, err
 }
 
+// GetCombined retrieves the frequency plans that have the given IDs and combines them into one frequency plan.
+// See Combine for how the frequency plans are combined.
+func (s *Store) GetCombined(ids ...string) (*FrequencyPlan, error) {
+	fps := make([]*FrequencyPlan, 0, len(ids))
+	for _, id := range ids {
+		fp, err := s.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		fps = append(fps, fp)
+	}
+	return Combine(fps...)
+}
+
 // GetAllIDs returns the list of IDs of the available frequency plans.
 func (s *Store) GetAllIDs() ([]string, error) {
 	if s == nil {
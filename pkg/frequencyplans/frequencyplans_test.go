@@ -475,3 +475,121 @@ dwell-time:
 		})
 	}
 }
+
+func TestCombine(t *testing.T) {
+	a := assertions.New(t)
+
+	store := frequencyplans.NewStore(fetch.NewMemFetcher(map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: A
+  description: A
+  base-frequency: 923
+  file: a.yml
+- id: B
+  description: B
+  base-frequency: 923
+  file: b.yml
+- id: C
+  description: C
+  base-frequency: 868
+  file: c.yml
+`),
+		"a.yml": []byte(`band-id: AS_923
+uplink-channels:
+- frequency: 923200000
+  radio: 0
+- frequency: 923400000
+  radio: 1
+downlink-channels:
+- frequency: 923200000
+  radio: 0
+- frequency: 923400000
+  radio: 0
+lora-standard-channel:
+  frequency: 922100000
+  radio: 1
+  data-rate: 6
+time-off-air:
+  duration: 100ms
+dwell-time:
+  uplinks: true
+  downlinks: true
+  duration: 400ms
+radios:
+- enable: true
+  frequency: 923600000
+- enable: true
+  frequency: 922000000
+max-eirp: 16
+`),
+		"b.yml": []byte(`band-id: AS_923
+uplink-channels:
+- frequency: 924200000
+  radio: 0
+- frequency: 924400000
+  radio: 1
+downlink-channels:
+- frequency: 923200000
+  radio: 0
+- frequency: 924200000
+  radio: 0
+time-off-air:
+  fraction: 0.1
+  duration: 50ms
+radios:
+- enable: true
+  frequency: 924600000
+- enable: true
+  frequency: 924000000
+max-eirp: 14
+`),
+		"c.yml": []byte(`band-id: EU_863_870
+uplink-channels:
+- frequency: 868100000
+  radio: 0
+radios:
+- enable: true
+  frequency: 867500000
+`),
+	}))
+
+	fp, err := store.GetCombined("A", "B")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(fp.BandID, should.Equal, "AS_923")
+	a.So(fp.UplinkChannels, should.HaveLength, 4)
+	for i, expected := range []struct {
+		Frequency uint64
+		Radio     uint8
+	}{
+		{923200000, 0},
+		{923400000, 1},
+		{924200000, 2},
+		{924400000, 3},
+	} {
+		a.So(fp.UplinkChannels[i].Frequency, should.Equal, expected.Frequency)
+		a.So(fp.UplinkChannels[i].Radio, should.Equal, expected.Radio)
+	}
+	a.So(fp.DownlinkChannels, should.HaveLength, 3)
+	a.So(fp.Radios, should.HaveLength, 4)
+	a.So(fp.Radios[2].Frequency, should.Equal, 924600000)
+	a.So(fp.LoRaStandardChannel.Radio, should.Equal, 1)
+	a.So(fp.TimeOffAir, should.Resemble, frequencyplans.TimeOffAir{Fraction: 0.1, Duration: 100 * time.Millisecond})
+	a.So(*fp.MaxEIRP, should.Equal, 14)
+
+	// Dwell time of frequency plan A applies to its channels only.
+	a.So(fp.RespectsDwellTime(false, 923200000, 500*time.Millisecond), should.BeFalse)
+	a.So(fp.RespectsDwellTime(false, 924200000, 500*time.Millisecond), should.BeTrue)
+	a.So(fp.RespectsDwellTime(true, 924200000, 500*time.Millisecond), should.BeTrue)
+
+	// A single frequency plan is returned as is.
+	single, err := store.GetCombined("A")
+	a.So(err, should.BeNil)
+	a.So(single.UplinkChannels, should.HaveLength, 2)
+
+	_, err = store.GetCombined("A", "C")
+	a.So(err, should.NotBeNil)
+
+	_, err = frequencyplans.Combine()
+	a.So(err, should.NotBeNil)
+}
@@ -17,12 +17,18 @@ package gatewayconfigurationserver
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	echo "github.com/labstack/echo/v4"
 	bscups "go.thethings.network/lorawan-stack/pkg/basicstation/cups"
 	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/basicstationlns/messages"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/cpf"
 	"go.thethings.network/lorawan-stack/pkg/pfconfig/semtechudp"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/shared"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/thethingsgateway"
 	ttgcups "go.thethings.network/lorawan-stack/pkg/thethingsgateway/cups"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/web"
@@ -55,6 +61,12 @@ func (gcs *GatewayConfigurationServer) RegisterRoutes(server *web.Server) {
 	}
 	group := server.Group(ttnpb.HTTPAPIPrefix+"/gcs/gateways/:gateway_id", middleware...)
 	group.GET("/semtechudp/global_conf.json", gcs.handleGetGlobalConfig)
+	group.GET("/multitech/global_conf.json", gcs.handleGetMultitechConfig)
+	group.GET("/kerlink-cpf/lorad/lorad.json", gcs.handleGetLoradConfig)
+	group.GET("/kerlink-cpf/lorafwd/lorafwd.toml", gcs.handleGetLorafwdConfig)
+	group.GET("/basicstation/station.conf", gcs.handleGetStationConfig)
+	group.GET("/basicstation/router_config.json", gcs.handleGetRouterConfig)
+	group.GET("/thethingsgateway/config.json", gcs.handleGetTheThingsGatewayConfig)
 }
 
 // New returns new *GatewayConfigurationServer.
@@ -77,23 +89,24 @@ func New(c *component.Component, conf *Config) (*GatewayConfigurationServer, err
 	return gcs, nil
 }
 
-func (gcs *GatewayConfigurationServer) handleGetGlobalConfig(c echo.Context) error {
+func (gcs *GatewayConfigurationServer) getGateway(c echo.Context, paths ...string) (*ttnpb.Gateway, error) {
 	ctx := gcs.getContext(c)
 	gtwID := c.Get(gatewayIDKey).(ttnpb.GatewayIdentifiers)
 	cc, err := gcs.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	client := ttnpb.NewGatewayRegistryClient(cc)
-	gtw, err := client.Get(ctx, &ttnpb.GetGatewayRequest{
+	return client.Get(ctx, &ttnpb.GetGatewayRequest{
 		GatewayIdentifiers: gtwID,
 		FieldMask: types.FieldMask{
-			Paths: []string{
-				"gateway_server_address",
-				"frequency_plan_id",
-			},
+			Paths: paths,
 		},
 	}, gcs.WithClusterAuth())
+}
+
+func (gcs *GatewayConfigurationServer) handleGetGlobalConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "antennas", "frequency_plan_id", "frequency_plan_ids", "gateway_server_address")
 	if err != nil {
 		return err
 	}
@@ -104,6 +117,86 @@ func (gcs *GatewayConfigurationServer) handleGetGlobalConfig(c echo.Context) err
 	return c.JSONPretty(http.StatusOK, config, "\t")
 }
 
+func (gcs *GatewayConfigurationServer) handleGetMultitechConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "antennas", "frequency_plan_id", "frequency_plan_ids", "gateway_server_address")
+	if err != nil {
+		return err
+	}
+	config, err := semtechudp.BuildMultitech(gtw, gcs.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	return c.JSONPretty(http.StatusOK, config, "\t")
+}
+
+func (gcs *GatewayConfigurationServer) handleGetLoradConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "antennas", "frequency_plan_id", "frequency_plan_ids")
+	if err != nil {
+		return err
+	}
+	config, err := cpf.BuildLorad(gtw, gcs.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	return c.JSONPretty(http.StatusOK, config, "\t")
+}
+
+func (gcs *GatewayConfigurationServer) handleGetLorafwdConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "gateway_server_address")
+	if err != nil {
+		return err
+	}
+	config, err := cpf.BuildLorafwd(gtw)
+	if err != nil {
+		return err
+	}
+	b, err := config.MarshalText()
+	if err != nil {
+		return err
+	}
+	return c.Blob(http.StatusOK, "application/toml", b)
+}
+
+func (gcs *GatewayConfigurationServer) handleGetStationConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "antennas", "frequency_plan_id", "frequency_plan_ids")
+	if err != nil {
+		return err
+	}
+	config, err := basicstation.Build(gtw, gcs.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	return c.JSONPretty(http.StatusOK, config, "\t")
+}
+
+func (gcs *GatewayConfigurationServer) handleGetRouterConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "frequency_plan_id", "frequency_plan_ids")
+	if err != nil {
+		return err
+	}
+	fp, err := gcs.FrequencyPlans.GetCombined(shared.FrequencyPlanIDs(gtw)...)
+	if err != nil {
+		return err
+	}
+	config, err := messages.GetRouterConfig(*fp, true, time.Now())
+	if err != nil {
+		return err
+	}
+	return c.JSONPretty(http.StatusOK, config, "\t")
+}
+
+func (gcs *GatewayConfigurationServer) handleGetTheThingsGatewayConfig(c echo.Context) error {
+	gtw, err := gcs.getGateway(c, "antennas", "auto_update", "frequency_plan_id", "frequency_plan_ids", "gateway_server_address", "update_channel")
+	if err != nil {
+		return err
+	}
+	config, err := thethingsgateway.Build(gtw, gcs.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	return c.JSONPretty(http.StatusOK, config, "\t")
+}
+
 func (gcs *GatewayConfigurationServer) getContext(c echo.Context) context.Context {
 	ctx := gcs.FillContext(c.Request().Context())
 	md := metadata.New(map[string]string{
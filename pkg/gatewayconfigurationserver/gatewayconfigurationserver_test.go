@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,13 +32,17 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
 
 var (
-	registeredGatewayID  = ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"}
+	registeredGatewayID = ttnpb.GatewayIdentifiers{
+		GatewayID: "test-gateway",
+		EUI:       &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x19},
+	}
 	registeredGatewayUID = unique.ID(test.Context(), registeredGatewayID)
 	registeredGatewayKey = "test-key"
 
@@ -57,6 +62,16 @@ func TestWeb(t *testing.T) {
 		GatewayServerAddress: "localhost",
 	}
 
+	fpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, err := test.FrequencyPlansFetcher.File(strings.TrimPrefix(r.URL.Path, "/"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(b)
+	}))
+	defer fpServer.Close()
+
 	httpAddress := "0.0.0.0:8098"
 	conf := &component.Config{
 		ServiceBase: config.ServiceBase{
@@ -64,7 +79,7 @@ func TestWeb(t *testing.T) {
 				Listen: httpAddress,
 			},
 			FrequencyPlans: config.FrequencyPlansConfig{
-				URL: "https://raw.githubusercontent.com/TheThingsNetwork/lorawan-frequency-plans/master",
+				URL: fpServer.URL,
 			},
 			Cluster: config.Cluster{
 				IdentityServer: isAddr,
@@ -87,6 +102,22 @@ func TestWeb(t *testing.T) {
 
 	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
 
+	for _, path := range []string{
+		"semtechudp/global_conf.json",
+		"multitech/global_conf.json",
+		"kerlink-cpf/lorad/lorad.json",
+		"kerlink-cpf/lorafwd/lorafwd.toml",
+		"basicstation/station.conf",
+		"basicstation/router_config.json",
+		"thethingsgateway/config.json",
+	} {
+		t.Run(path, func(t *testing.T) {
+			testAuthorization(t, c, path)
+		})
+	}
+}
+
+func testAuthorization(t *testing.T, c *component.Component, path string) {
 	t.Run("Authorization", func(t *testing.T) {
 		for _, tc := range []struct {
 			Name       string
@@ -116,8 +147,8 @@ func TestWeb(t *testing.T) {
 			t.Run(tc.Name, func(t *testing.T) {
 				a := assertions.New(t)
 				url := fmt.Sprintf(
-					"/api/v3/gcs/gateways/%s/semtechudp/global_conf.json",
-					tc.ID.GatewayID,
+					"/api/v3/gcs/gateways/%s/%s",
+					tc.ID.GatewayID, path,
 				)
 				body := bytes.NewReader([]byte(`{"downlinks":[]}`))
 				req := httptest.NewRequest(http.MethodGet, url, body)
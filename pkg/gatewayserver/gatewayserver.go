@@ -39,7 +39,6 @@ import (
 	iogrpc "go.thethings.network/lorawan-stack/pkg/gatewayserver/io/grpc"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
-	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
@@ -208,7 +207,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		}()
 	}
 
-	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook(ttnpb.ClusterRole_NETWORK_SERVER))
 
 	c.RegisterGRPC(gs)
 	return gs, nil
@@ -310,6 +309,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		FieldMask: pbtypes.FieldMask{
 			Paths: []string{
 				"frequency_plan_id",
+				"frequency_plan_ids",
 				"schedule_downlink_late",
 				"enforce_duty_cycle",
 				"downlink_path_constraint",
@@ -328,22 +328,28 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		gtw = &ttnpb.Gateway{
 			GatewayIdentifiers:     ids,
 			FrequencyPlanID:        fpID,
+			FrequencyPlanIDs:       []string{fpID},
 			EnforceDutyCycle:       true,
 			DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE,
 		}
 	} else if err != nil {
 		return nil, err
 	}
-	fp, err := gs.FrequencyPlans.GetByID(gtw.FrequencyPlanID)
-	if err != nil {
-		return nil, err
+	if len(gtw.FrequencyPlanIDs) == 0 {
+		gtw.FrequencyPlanIDs = []string{gtw.FrequencyPlanID}
 	}
-	scheduler, err := scheduling.NewScheduler(ctx, fp, gtw.EnforceDutyCycle, nil)
+	fps := make(map[string]*frequencyplans.FrequencyPlan, len(gtw.FrequencyPlanIDs))
+	for _, fpID := range gtw.FrequencyPlanIDs {
+		fp, err := gs.FrequencyPlans.GetByID(fpID)
+		if err != nil {
+			return nil, err
+		}
+		fps[fpID] = fp
+	}
+	conn, err := io.NewConnection(ctx, frontend.Protocol(), gtw, fps, gtw.EnforceDutyCycle)
 	if err != nil {
 		return nil, err
 	}
-
-	conn := io.NewConnection(ctx, frontend.Protocol(), gtw, fp, scheduler)
 	gs.connections.Store(uid, conn)
 	registerGatewayConnect(ctx, ids)
 	logger.Info("Connected")
@@ -558,21 +564,24 @@ func (gs *GatewayServer) GetFrequencyPlan(ctx context.Context, ids ttnpb.Gateway
 	}
 	gtw, err := registry.Get(ctx, &ttnpb.GetGatewayRequest{
 		GatewayIdentifiers: ids,
-		FieldMask:          pbtypes.FieldMask{Paths: []string{"frequency_plan_id"}},
+		FieldMask:          pbtypes.FieldMask{Paths: []string{"frequency_plan_id", "frequency_plan_ids"}},
 	}, callOpt)
-	var fpID string
+	var fpIDs []string
 	if err == nil {
-		fpID = gtw.FrequencyPlanID
+		fpIDs = gtw.FrequencyPlanIDs
+		if len(fpIDs) == 0 {
+			fpIDs = []string{gtw.FrequencyPlanID}
+		}
 	} else if errors.IsNotFound(err) {
-		var ok bool
-		fpID, ok = frequencyplans.FallbackIDFromContext(ctx)
+		fpID, ok := frequencyplans.FallbackIDFromContext(ctx)
 		if !ok {
 			return nil, err
 		}
+		fpIDs = []string{fpID}
 	} else {
 		return nil, err
 	}
-	return gs.FrequencyPlans.GetByID(fpID)
+	return gs.FrequencyPlans.GetCombined(fpIDs...)
 }
 
 // ClaimDownlink claims the downlink path for the given gateway.
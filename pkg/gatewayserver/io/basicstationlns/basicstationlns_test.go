@@ -629,11 +629,12 @@ func TestTraffic(t *testing.T) {
 						GatewayID: "eui-0101010101010101",
 						EUI:       &types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
 					},
-					Time:        &[]time.Time{time.Unix(1548059982, 0)}[0],
-					Timestamp:   (uint32)(12666373963464220 & 0xFFFFFFFF),
-					RSSI:        89,
-					ChannelRSSI: 89,
-					SNR:         9.25,
+					Time:            &[]time.Time{time.Unix(1548059982, 0)}[0],
+					Timestamp:       (uint32)(12666373963464220 & 0xFFFFFFFF),
+					RSSI:            89,
+					ChannelRSSI:     89,
+					SNR:             9.25,
+					FrequencyPlanID: test.EUFrequencyPlanID,
 				}},
 				Settings: ttnpb.TxSettings{
 					Frequency:  868300000,
@@ -693,11 +694,12 @@ func TestTraffic(t *testing.T) {
 							GatewayID: "eui-0101010101010101",
 							EUI:       &types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
 						},
-						Time:        &[]time.Time{time.Unix(1548059982, 0)}[0],
-						Timestamp:   (uint32)(12666373963464220 & 0xFFFFFFFF),
-						RSSI:        89,
-						ChannelRSSI: 89,
-						SNR:         9.25,
+						Time:            &[]time.Time{time.Unix(1548059982, 0)}[0],
+						Timestamp:       (uint32)(12666373963464220 & 0xFFFFFFFF),
+						RSSI:            89,
+						ChannelRSSI:     89,
+						SNR:             9.25,
+						FrequencyPlanID: test.EUFrequencyPlanID,
 					},
 				},
 				Settings: ttnpb.TxSettings{
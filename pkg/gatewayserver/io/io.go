@@ -75,6 +75,7 @@ type Connection struct {
 
 	protocol  string
 	gateway   *ttnpb.Gateway
+	fps       map[string]*frequencyplans.FrequencyPlan
 	fp        *frequencyplans.FrequencyPlan
 	scheduler *scheduling.Scheduler
 	rtts      *rtts
@@ -85,14 +86,35 @@ type Connection struct {
 	txAckCh  chan *ttnpb.TxAcknowledgment
 }
 
+var errFrequencyPlanNotFound = errors.DefineNotFound("frequency_plan_not_found", "frequency plan `{id}` not found")
+
 // NewConnection instantiates a new gateway connection.
-func NewConnection(ctx context.Context, protocol string, gateway *ttnpb.Gateway, fp *frequencyplans.FrequencyPlan, scheduler *scheduling.Scheduler) *Connection {
+// The given frequency plans are keyed by the frequency plan IDs of the gateway. The frequency plans are combined in the
+// order of the gateway's frequency plan IDs, and the combined frequency plan is used for scheduling.
+func NewConnection(ctx context.Context, protocol string, gateway *ttnpb.Gateway, fps map[string]*frequencyplans.FrequencyPlan, enforceDutyCycle bool) (*Connection, error) {
+	fpList := make([]*frequencyplans.FrequencyPlan, 0, len(gateway.FrequencyPlanIDs))
+	for _, id := range gateway.FrequencyPlanIDs {
+		fp, ok := fps[id]
+		if !ok {
+			return nil, errFrequencyPlanNotFound.WithAttributes("id", id)
+		}
+		fpList = append(fpList, fp)
+	}
+	fp, err := frequencyplans.Combine(fpList...)
+	if err != nil {
+		return nil, err
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fp, enforceDutyCycle, nil)
+	if err != nil {
+		return nil, err
+	}
 	ctx, cancelCtx := errorcontext.New(ctx)
 	return &Connection{
 		ctx:         ctx,
 		cancelCtx:   cancelCtx,
 		protocol:    protocol,
 		gateway:     gateway,
+		fps:         fps,
 		fp:          fp,
 		scheduler:   scheduler,
 		rtts:        newRTTs(maxRTTs),
@@ -101,7 +123,7 @@ func NewConnection(ctx context.Context, protocol string, gateway *ttnpb.Gateway,
 		statusCh:    make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:     make(chan *ttnpb.TxAcknowledgment, bufferSize),
 		connectTime: time.Now().UnixNano(),
-	}
+	}, nil
 }
 
 // Context returns the connection context.
@@ -137,7 +159,9 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		)).Debug("Synchronized server absolute time only")
 	}
 
+	fpID := c.uplinkFrequencyPlanID(up.Settings.Frequency)
 	for _, md := range up.RxMetadata {
+		md.FrequencyPlanID = fpID
 		if md.AntennaIndex != 0 {
 			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
 			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
@@ -166,6 +190,26 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 	return nil
 }
 
+// uplinkFrequencyPlanID returns the ID of the frequency plan that has an uplink channel with the given frequency.
+// If none of the frequency plans have such a channel, this method returns an empty string.
+func (c *Connection) uplinkFrequencyPlanID(frequency uint64) string {
+	for _, id := range c.gateway.FrequencyPlanIDs {
+		fp := c.fps[id]
+		for _, ch := range fp.UplinkChannels {
+			if ch.Frequency == frequency {
+				return id
+			}
+		}
+		if ch := fp.LoRaStandardChannel; ch != nil && ch.Frequency == frequency {
+			return id
+		}
+		if ch := fp.FSKChannel; ch != nil && ch.Frequency == frequency {
+			return id
+		}
+	}
+	return ""
+}
+
 // HandleStatus updates the status stats and sends the status to the status channel.
 func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) error {
 	select {
@@ -431,8 +475,12 @@ func (c *Connection) RTTStats() (min, max, median time.Duration, count int) {
 }
 
 // FrequencyPlan returns the frequency plan for the gateway.
+// If the gateway has multiple frequency plans, this is the combination of the frequency plans.
 func (c *Connection) FrequencyPlan() *frequencyplans.FrequencyPlan { return c.fp }
 
+// FrequencyPlans returns the frequency plans for the gateway, keyed by frequency plan ID.
+func (c *Connection) FrequencyPlans() map[string]*frequencyplans.FrequencyPlan { return c.fps }
+
 // TimeFromTimestampTime returns the concentrator time by the given timestamp.
 // This method returns false if the clock is not synced with the server.
 func (c *Connection) TimeFromTimestampTime(timestamp uint32) (scheduling.ConcentratorTime, bool) {
@@ -48,6 +48,7 @@ func Test(t *testing.T) {
 	gtw := &ttnpb.Gateway{
 		GatewayIdentifiers: ids,
 		FrequencyPlanID:    "EU_863_870",
+		FrequencyPlanIDs:   []string{"EU_863_870"},
 		Antennas: []ttnpb.GatewayAntenna{
 			{
 				Gain: antennaGain,
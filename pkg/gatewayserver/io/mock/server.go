@@ -25,7 +25,6 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io"
-	"go.thethings.network/lorawan-stack/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -84,17 +83,25 @@ func (s *server) Connect(ctx context.Context, frontend io.Frontend, ids ttnpb.Ga
 		gtw = &ttnpb.Gateway{
 			GatewayIdentifiers: ids,
 			FrequencyPlanID:    test.EUFrequencyPlanID,
+			FrequencyPlanIDs:   []string{test.EUFrequencyPlanID},
 		}
+	} else if len(gtw.FrequencyPlanIDs) == 0 {
+		registered := *gtw
+		registered.FrequencyPlanIDs = []string{gtw.FrequencyPlanID}
+		gtw = &registered
 	}
-	fp, err := s.GetFrequencyPlan(ctx, ids)
-	if err != nil {
-		return nil, err
+	fps := make(map[string]*frequencyplans.FrequencyPlan, len(gtw.FrequencyPlanIDs))
+	for _, fpID := range gtw.FrequencyPlanIDs {
+		fp, err := s.store.GetByID(fpID)
+		if err != nil {
+			return nil, err
+		}
+		fps[fpID] = fp
 	}
-	scheduler, err := scheduling.NewScheduler(ctx, fp, true, nil)
+	conn, err := io.NewConnection(ctx, frontend.Protocol(), gtw, fps, true)
 	if err != nil {
 		return nil, err
 	}
-	conn := io.NewConnection(ctx, frontend.Protocol(), gtw, fp, scheduler)
 	s.connections[unique.ID(ctx, ids)] = conn
 	select {
 	case s.connectionsCh <- conn:
@@ -105,13 +112,14 @@ func (s *server) Connect(ctx context.Context, frontend io.Frontend, ids ttnpb.Ga
 
 // GetFrequencyPlan implements io.Server.
 func (s *server) GetFrequencyPlan(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*frequencyplans.FrequencyPlan, error) {
-	var fpID string
+	fpIDs := []string{test.EUFrequencyPlanID}
 	if gtw, ok := s.gateways[unique.ID(ctx, ids)]; ok {
-		fpID = gtw.FrequencyPlanID
-	} else {
-		fpID = test.EUFrequencyPlanID
+		fpIDs = gtw.FrequencyPlanIDs
+		if len(fpIDs) == 0 {
+			fpIDs = []string{gtw.FrequencyPlanID}
+		}
 	}
-	return s.store.GetByID(fpID)
+	return s.store.GetCombined(fpIDs...)
 }
 
 // ClaimDownlink implements io.Server.
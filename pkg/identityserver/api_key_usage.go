@@ -0,0 +1,153 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/email"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/emails"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// apiKeyUsage keeps track of when API keys were last used, so that the times
+// can be written to the database in batches instead of on every request.
+type apiKeyUsage struct {
+	mu       sync.Mutex
+	lastUsed map[string]time.Time
+}
+
+func (u *apiKeyUsage) record(id string, t time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.lastUsed == nil {
+		u.lastUsed = make(map[string]time.Time)
+	}
+	if t.After(u.lastUsed[id]) {
+		u.lastUsed[id] = t
+	}
+}
+
+// take returns the recorded times and resets the tracker.
+func (u *apiKeyUsage) take() map[string]time.Time {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	lastUsed := u.lastUsed
+	u.lastUsed = nil
+	return lastUsed
+}
+
+// flushAPIKeyUsage writes the recorded times when API keys were last used to
+// the database. If that fails, the times are recorded again for the next flush.
+func (is *IdentityServer) flushAPIKeyUsage(ctx context.Context) error {
+	lastUsed := is.apiKeyUsage.take()
+	if len(lastUsed) == 0 {
+		return nil
+	}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return store.GetAPIKeyStore(db).SetAPIKeysLastUsed(ctx, lastUsed)
+	})
+	if err != nil {
+		for id, t := range lastUsed {
+			is.apiKeyUsage.record(id, t)
+		}
+		return err
+	}
+	return nil
+}
+
+func (is *IdentityServer) flushAPIKeyUsageTask(ctx context.Context) error {
+	interval := is.configFromContext(ctx).APIKeys.LastUsedFlushInterval
+	if interval == 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := is.flushAPIKeyUsage(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to flush API key usage")
+		}
+	}
+}
+
+// remindExpiringAPIKeys sends an email to the contacts of the entities of
+// which API keys expire within the configured reminder period.
+// The contacts are reminded once per API key.
+func (is *IdentityServer) remindExpiringAPIKeys(ctx context.Context) error {
+	reminder := is.configFromContext(ctx).APIKeys.ExpiryReminder
+	var (
+		entityIDs []ttnpb.Identifiers
+		keys      []*ttnpb.APIKey
+	)
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		entityIDs, keys, err = store.GetAPIKeyStore(db).FindExpiringAPIKeys(ctx, time.Now().Add(reminder))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for i, key := range keys {
+		ids, key := entityIDs[i].EntityIdentifiers(), key
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"entity_type", ids.EntityType(),
+			"entity_id", ids.IDString(),
+			"api_key_id", key.ID,
+		))
+		err := is.SendContactsEmail(ctx, ids, func(data emails.Data) email.MessageData {
+			data.SetEntity(ids)
+			return &emails.APIKeyExpiring{Data: data, Identifier: key.PrettyName(), ExpiresAt: *key.ExpiresAt}
+		})
+		if err != nil {
+			logger.WithError(err).Warn("Could not send API key expiry reminder email")
+			continue
+		}
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			return store.GetAPIKeyStore(db).SetAPIKeyExpiryReminded(ctx, key.ID)
+		})
+		if err != nil {
+			logger.WithError(err).Warn("Failed to record API key expiry reminder")
+		}
+	}
+	return nil
+}
+
+func (is *IdentityServer) remindExpiringAPIKeysTask(ctx context.Context) error {
+	interval := is.configFromContext(ctx).APIKeys.ExpiryReminderInterval
+	if interval == 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := is.remindExpiringAPIKeys(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to remind of expiring API keys")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
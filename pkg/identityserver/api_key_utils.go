@@ -16,12 +16,58 @@ package identityserver
 
 import (
 	"context"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
+var errAPIKeyExpiresAtPast = errors.DefineInvalidArgument("api_key_expires_at_past", "API key expiry time `{expires_at}` is in the past")
+
+// validateAPIKeyRestrictions validates the expiry time and the allowed source CIDRs of an API key.
+func validateAPIKeyRestrictions(expiresAt *time.Time, allowedCIDRs []string) error {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return errAPIKeyExpiresAtPast.WithAttributes("expires_at", expiresAt.UTC().Format(time.RFC3339))
+	}
+	return auth.ValidateCIDRs(allowedCIDRs...)
+}
+
+// apiKeyUpdateFieldMask returns the field mask of an API key update. Updates
+// without field mask update the name and rights of the API key.
+func apiKeyUpdateFieldMask(fieldMask types.FieldMask) *types.FieldMask {
+	if len(fieldMask.Paths) == 0 {
+		return &types.FieldMask{Paths: []string{"name", "rights"}}
+	}
+	return &fieldMask
+}
+
+// isAPIKeyDeletion returns whether the API key update deletes the API key,
+// which is the case if the rights are updated to no rights.
+func isAPIKeyDeletion(key *ttnpb.APIKey, fieldMask *types.FieldMask) bool {
+	return ttnpb.HasAnyField(fieldMask.Paths, "rights") && len(key.Rights) == 0
+}
+
+// validateAPIKeyUpdate validates the restrictions of the API key that are in the field mask.
+func validateAPIKeyUpdate(key *ttnpb.APIKey, fieldMask *types.FieldMask) error {
+	var (
+		expiresAt    *time.Time
+		allowedCIDRs []string
+	)
+	if ttnpb.HasAnyField(fieldMask.Paths, "expires_at") {
+		expiresAt = key.ExpiresAt
+	}
+	if ttnpb.HasAnyField(fieldMask.Paths, "allowed_cidrs") {
+		allowedCIDRs = key.AllowedCIDRs
+	}
+	return validateAPIKeyRestrictions(expiresAt, allowedCIDRs)
+}
+
+func generateAPIKey(ctx context.Context, name string, expiresAt *time.Time, allowedCIDRs []string, rights ...ttnpb.Right) (key *ttnpb.APIKey, token string, err error) {
+	if err = validateAPIKeyRestrictions(expiresAt, allowedCIDRs); err != nil {
+		return nil, "", err
+	}
 	token, err = auth.APIKey.Generate(ctx, "")
 	if err != nil {
 		return nil, "", err
@@ -35,10 +81,12 @@ func generateAPIKey(ctx context.Context, name string, rights ...ttnpb.Right) (ke
 		return nil, "", err
 	}
 	key = &ttnpb.APIKey{
-		ID:     generatedID,
-		Key:    hashedKey,
-		Name:   name,
-		Rights: rights,
+		ID:           generatedID,
+		Key:          hashedKey,
+		Name:         name,
+		Rights:       rights,
+		ExpiresAt:    expiresAt,
+		AllowedCIDRs: allowedCIDRs,
 	}
 	return key, token, nil
 }
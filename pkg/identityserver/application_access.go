@@ -70,18 +70,25 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedCIDRs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	evt := evtCreateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.ApplicationIdentifiers, key)
+		if err := is.requireQuota(ctx, db, &req.ApplicationIdentifiers, quotaAPIKeys); err != nil {
+			return err
+		}
+		if err := store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.ApplicationIdentifiers, key); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
-	events.Publish(evtCreateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
+	events.Publish(evt)
 	err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 		data.SetEntity(req.EntityIdentifiers())
 		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
@@ -146,19 +153,33 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	fieldMask := apiKeyUpdateFieldMask(req.FieldMask)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, fieldMask)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, fieldMask); err != nil {
+			return nil, err
+		}
+	}
+	evt := evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil)
+	if deleteKey {
+		evt = evtDeleteApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil)
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers, &req.APIKey)
-		return err
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.ApplicationIdentifiers, &req.APIKey, fieldMask)
+		if err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evt)
 	if key == nil {
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
+	if ttnpb.HasAnyField(fieldMask.Paths, "rights") {
 		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 			data.SetEntity(req.EntityIdentifiers())
 			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
@@ -166,8 +187,6 @@ func (is *IdentityServer) updateApplicationAPIKey(ctx context.Context, req *ttnp
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
 		}
-	} else {
-		events.Publish(evtDeleteApplicationAPIKey(ctx, req.ApplicationIdentifiers, nil))
 	}
 	return key, nil
 }
@@ -206,19 +225,31 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, req.Collaborator.Rights...); err != nil {
 		return nil, err
 	}
+	evt := evtUpdateApplicationCollaborator(ctx, ttnpb.CombineIdentifiers(req.ApplicationIdentifiers, req.Collaborator), nil)
+	if len(req.Collaborator.Rights) == 0 {
+		evt = evtDeleteApplicationCollaborator(ctx, ttnpb.CombineIdentifiers(req.ApplicationIdentifiers, req.Collaborator), nil)
+	}
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return is.getMembershipStore(ctx, db).SetMember(
+		if len(req.Collaborator.Rights) > 0 {
+			if err := is.requireCollaboratorQuota(ctx, db, &req.ApplicationIdentifiers, &req.Collaborator.OrganizationOrUserIdentifiers); err != nil {
+				return err
+			}
+		}
+		if err := is.getMembershipStore(ctx, db).SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.ApplicationIdentifiers,
 			ttnpb.RightsFrom(req.Collaborator.Rights...),
-		)
+		); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evt)
 	if len(req.Collaborator.Rights) > 0 {
-		events.Publish(evtUpdateApplicationCollaborator(ctx, ttnpb.CombineIdentifiers(req.ApplicationIdentifiers, req.Collaborator), nil))
 		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 			data.SetEntity(req.EntityIdentifiers())
 			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
@@ -226,8 +257,6 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
 		}
-	} else {
-		events.Publish(evtDeleteApplicationCollaborator(ctx, ttnpb.CombineIdentifiers(req.ApplicationIdentifiers, req.Collaborator), nil))
 	}
 	return ttnpb.Empty, nil
 }
@@ -0,0 +1,204 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	evtDeleteApplicationClusterDataStart = events.Define(
+		"application.cluster_data.delete.start", "start deleting application data from cluster",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtDeleteApplicationWebhook = events.Define(
+		"application.cluster_data.delete.webhook", "delete webhook of deleted application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtDeleteApplicationPubSub = events.Define(
+		"application.cluster_data.delete.pubsub", "delete pub/sub of deleted application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtDeleteApplicationLink = events.Define(
+		"application.cluster_data.delete.link", "delete link of deleted application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtDeleteApplicationClusterDataFinish = events.Define(
+		"application.cluster_data.delete.finish", "finish deleting application data from cluster",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtDeleteApplicationClusterDataFail = events.Define(
+		"application.cluster_data.delete.fail", "fail to delete application data from cluster",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+)
+
+func isUnimplemented(err error) bool {
+	return errors.HasCode(err, uint32(codes.Unimplemented))
+}
+
+// deleteApplicationClusterData deletes the end devices, integrations and link
+// of the deleted application from the cluster. The end devices are deleted from
+// the Network Server, Application Server and Join Server before they are
+// deleted from the Identity Server. Roles that are not in the cluster are skipped.
+// This is done when the application is deleted, so that its traffic stops. If this
+// fails, it is retried when the application is purged, and the application is not
+// purged until it succeeds.
+func (is *IdentityServer) deleteApplicationClusterData(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (err error) {
+	events.Publish(evtDeleteApplicationClusterDataStart(ctx, ids, nil))
+	defer func() {
+		if err != nil {
+			events.Publish(evtDeleteApplicationClusterDataFail(ctx, ids, err))
+			return
+		}
+		events.Publish(evtDeleteApplicationClusterDataFinish(ctx, ids, nil))
+	}()
+	if err = is.deleteApplicationEndDevices(ctx, ids); err != nil {
+		return err
+	}
+	return is.deleteApplicationIntegrations(ctx, ids)
+}
+
+func (is *IdentityServer) deleteApplicationEndDevices(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	var devs []*ttnpb.EndDevice
+	err := is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		devs, err = store.GetEndDeviceStore(db).ListEndDevices(ctx, &ids, idsFieldMask)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx).WithField("application_id", ids.ApplicationID)
+	callOpt := is.WithClusterAuth()
+	registries := []struct {
+		role   ttnpb.ClusterRole
+		delete func(*grpc.ClientConn, *ttnpb.EndDeviceIdentifiers) error
+		skip   bool
+	}{
+		{
+			role: ttnpb.ClusterRole_NETWORK_SERVER,
+			delete: func(cc *grpc.ClientConn, ids *ttnpb.EndDeviceIdentifiers) error {
+				_, err := ttnpb.NewNsEndDeviceRegistryClient(cc).Delete(ctx, ids, callOpt)
+				return err
+			},
+		},
+		{
+			role: ttnpb.ClusterRole_APPLICATION_SERVER,
+			delete: func(cc *grpc.ClientConn, ids *ttnpb.EndDeviceIdentifiers) error {
+				_, err := ttnpb.NewAsEndDeviceRegistryClient(cc).Delete(ctx, ids, callOpt)
+				return err
+			},
+		},
+		{
+			role: ttnpb.ClusterRole_JOIN_SERVER,
+			delete: func(cc *grpc.ClientConn, ids *ttnpb.EndDeviceIdentifiers) error {
+				_, err := ttnpb.NewJsEndDeviceRegistryClient(cc).Delete(ctx, ids, callOpt)
+				return err
+			},
+		},
+	}
+	for _, dev := range devs {
+		for i, registry := range registries {
+			if registry.skip {
+				continue
+			}
+			cc, err := is.GetPeerConn(ctx, registry.role, dev.EndDeviceIdentifiers)
+			if err != nil {
+				logger.WithError(err).WithField("role", registry.role).Debug("Skip deleting end device data")
+				registries[i].skip = true
+				continue
+			}
+			if err := registry.delete(cc, &dev.EndDeviceIdentifiers); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
+		evt := evtDeleteEndDevice(ctx, dev.EndDeviceIdentifiers, nil)
+		err := is.withDatabase(ctx, func(db *gorm.DB) error {
+			if err := store.GetEndDeviceStore(db).DeleteEndDevice(ctx, &dev.EndDeviceIdentifiers); err != nil {
+				return err
+			}
+			return is.writeAuditLog(ctx, db, evt)
+		})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		events.Publish(evt)
+	}
+	return nil
+}
+
+func (is *IdentityServer) deleteApplicationIntegrations(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	cc, err := is.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithField("application_id", ids.ApplicationID).Debug("Skip deleting application integrations")
+		return nil
+	}
+	callOpt := is.WithClusterAuth()
+
+	webhookRegistry := ttnpb.NewApplicationWebhookRegistryClient(cc)
+	webhooks, err := webhookRegistry.List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask:              *idsFieldMask,
+	}, callOpt)
+	switch {
+	case err == nil:
+		for _, webhook := range webhooks.Webhooks {
+			if _, err := webhookRegistry.Delete(ctx, &webhook.ApplicationWebhookIdentifiers, callOpt); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			events.Publish(evtDeleteApplicationWebhook(ctx, ids, &webhook.ApplicationWebhookIdentifiers))
+		}
+	case !isUnimplemented(err):
+		return err
+	}
+
+	pubsubRegistry := ttnpb.NewApplicationPubSubRegistryClient(cc)
+	pubsubs, err := pubsubRegistry.List(ctx, &ttnpb.ListApplicationPubSubsRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask:              *idsFieldMask,
+	}, callOpt)
+	switch {
+	case err == nil:
+		for _, pubsub := range pubsubs.Pubsubs {
+			if _, err := pubsubRegistry.Delete(ctx, &pubsub.ApplicationPubSubIdentifiers, callOpt); err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			events.Publish(evtDeleteApplicationPubSub(ctx, ids, &pubsub.ApplicationPubSubIdentifiers))
+		}
+	case !isUnimplemented(err):
+		return err
+	}
+
+	if _, err := ttnpb.NewAsClient(cc).DeleteLink(ctx, &ids, callOpt); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	} else {
+		events.Publish(evtDeleteApplicationLink(ctx, ids, nil))
+	}
+	return nil
+}
@@ -20,10 +20,10 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
-	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -40,6 +40,14 @@ var (
 		"application.delete", "delete application",
 		ttnpb.RIGHT_APPLICATION_INFO,
 	)
+	evtRestoreApplication = events.Define(
+		"application.restore", "restore application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtPurgeApplication = events.Define(
+		"application.purge", "purge application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
 )
 
 func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.CreateApplicationRequest) (app *ttnpb.Application, err error) {
@@ -58,7 +66,11 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 	if err := validateContactInfo(req.Application.ContactInfo); err != nil {
 		return nil, err
 	}
+	evt := evtCreateApplication(ctx, req.ApplicationIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.requireQuota(ctx, db, req.Collaborator.Identifiers(), quotaApplications); err != nil {
+			return err
+		}
 		app, err = store.GetApplicationStore(db).CreateApplication(ctx, &req.Application)
 		if err != nil {
 			return err
@@ -71,6 +83,9 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 		); err != nil {
 			return err
 		}
+		if err = store.GetQuotaStore(db).SetOwner(ctx, &req.Collaborator, app.ApplicationIdentifiers); err != nil {
+			return err
+		}
 		if len(req.ContactInfo) > 0 {
 			cleanContactInfo(req.ContactInfo)
 			app.ContactInfo, err = store.GetContactInfoStore(db).SetContactInfo(ctx, app.ApplicationIdentifiers, req.ContactInfo)
@@ -78,12 +93,12 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateApplication(ctx, req.ApplicationIdentifiers, nil))
+	events.Publish(evt)
 	return app, nil
 }
 
@@ -142,6 +157,9 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 			return nil, err
 		}
 	}
+	if req.Deleted {
+		ctx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
 	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
 	defer func() {
@@ -194,6 +212,7 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 			return nil, err
 		}
 	}
+	evt := evtUpdateApplication(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).UpdateApplication(ctx, &req.Application, &req.FieldMask)
 		if err != nil {
@@ -206,35 +225,69 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateApplication(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths))
+	events.Publish(evt)
 	return app, nil
 }
 
-var errApplicationHasDevices = errors.DefineFailedPrecondition("application_has_devices", "application still has `{count}` devices")
-
 func (is *IdentityServer) deleteApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
 	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
 		return nil, err
 	}
+	evt := evtDeleteApplication(ctx, ids, nil)
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, ids)
-		if err != nil {
+		if err := store.GetApplicationStore(db).DeleteApplication(ctx, ids); err != nil {
 			return err
 		}
-		if total > 0 {
-			return errApplicationHasDevices.WithAttributes("count", int(total))
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evt)
+	// The application is deleted first, so that cluster peers get the rights to
+	// delete its data. If this fails, the data is deleted when the application is purged.
+	if err := is.deleteApplicationClusterData(ctx, *ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to delete application data from cluster")
+	}
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) restoreApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireApplication(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
+		return nil, err
+	}
+	evt := evtRestoreApplication(ctx, ids, nil)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetApplicationStore(db).RestoreApplication(ctx, ids); err != nil {
+			return err
 		}
-		return store.GetApplicationStore(db).DeleteApplication(ctx, ids)
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteApplication(ctx, ids, nil))
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireApplication(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_APPLICATION_DELETE); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
 
@@ -261,3 +314,11 @@ func (ar *applicationRegistry) Update(ctx context.Context, req *ttnpb.UpdateAppl
 func (ar *applicationRegistry) Delete(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
 	return ar.deleteApplication(ctx, req)
 }
+
+func (ar *applicationRegistry) Restore(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.restoreApplication(ctx, req)
+}
+
+func (ar *applicationRegistry) Purge(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*types.Empty, error) {
+	return ar.purgeApplication(ctx, req)
+}
@@ -18,9 +18,11 @@ import (
 	"testing"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"google.golang.org/grpc"
@@ -190,6 +192,97 @@ func TestApplicationsCRUD(t *testing.T) {
 
 		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
 		a.So(err, should.BeNil)
+
+		list, err := reg.List(ctx, &ttnpb.ListApplicationsRequest{
+			FieldMask:    types.FieldMask{Paths: []string{"name"}},
+			Collaborator: userID.OrganizationOrUserIdentifiers(),
+			Deleted:      true,
+		}, creds)
+		a.So(err, should.BeNil)
+		if a.So(list.Applications, should.HaveLength, 1) {
+			a.So(list.Applications[0].ApplicationIdentifiers, should.Resemble, created.ApplicationIdentifiers)
+			a.So(list.Applications[0].DeletedAt, should.NotBeNil)
+		}
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		got, err = reg.Get(ctx, &ttnpb.GetApplicationRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			FieldMask:              types.FieldMask{Paths: []string{"name"}},
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(got.Name, should.Equal, updated.Name)
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.NotBeNil)
+	})
+}
+
+func TestApplicationsDeleteEndDevices(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewApplicationRegistryClient(cc)
+		devReg := ttnpb.NewEndDeviceRegistryClient(cc)
+
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+
+		created, err := reg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "bar"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = devReg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: created.ApplicationIdentifiers,
+					DeviceID:               "bar-device",
+				},
+			},
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			total, err := store.GetEndDeviceStore(db).CountEndDevices(ctx, &created.ApplicationIdentifiers)
+			a.So(total, should.BeZeroValue)
+			return err
+		})
+		a.So(err, should.BeNil)
+
+		_, err = reg.Restore(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+		}
+
+		list, err := devReg.List(ctx, &ttnpb.ListEndDevicesRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			FieldMask:              types.FieldMask{Paths: []string{"ids"}},
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(list.EndDevices, should.BeEmpty)
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		_, err = reg.Purge(ctx, &created.ApplicationIdentifiers, creds)
+		a.So(err, should.BeNil)
 	})
 }
 
@@ -0,0 +1,140 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// auditLogEntry returns the audit log entry for the event.
+func (is *IdentityServer) auditLogEntry(ctx context.Context, evt events.Event) *ttnpb.AuditLogEntry {
+	entry := &ttnpb.AuditLogEntry{
+		Operation: evt.Name(),
+		SourceIP:  rpcmetadata.SourceIP(ctx),
+	}
+	if ids := evt.Identifiers(); len(ids) > 0 {
+		entry.EntityIDs = ids[0]
+		if len(ids) > 1 {
+			entry.RelatedIDs = ids[1]
+		}
+	}
+	if paths, ok := evt.Data().([]string); ok {
+		entry.Paths = paths
+	}
+	if authInfo, err := is.authInfo(ctx); err == nil {
+		if apiKey := authInfo.GetAPIKey(); apiKey != nil {
+			entry.ActorIDs = &apiKey.EntityIDs
+			entry.ActorAPIKeyID = apiKey.ID
+		} else if accessToken := authInfo.GetOAuthAccessToken(); accessToken != nil {
+			entry.ActorIDs = accessToken.UserIDs.EntityIdentifiers()
+		}
+	}
+	return entry
+}
+
+// writeAuditLog records the event in the audit log. It must be called in the database
+// transaction of the operation that the event describes, so that the operation is only
+// committed together with its audit log entry. The caller publishes the event after
+// the transaction is committed.
+func (is *IdentityServer) writeAuditLog(ctx context.Context, db *gorm.DB, evt events.Event) error {
+	_, err := store.GetAuditLogStore(db).CreateEntry(ctx, is.auditLogEntry(ctx, evt))
+	return err
+}
+
+// publishAudited publishes the event and records it in the audit log. It is used for events
+// that do not describe a change in the database, such as failed attempts, so failing to
+// record them is logged instead of returned to the caller.
+func (is *IdentityServer) publishAudited(ctx context.Context, evt events.Event) {
+	events.Publish(evt)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithField("operation", evt.Name()).Error("Could not write audit log entry")
+	}
+}
+
+var errAuditLogAdminOnly = errors.DefinePermissionDenied("audit_log_admin_only", "the audit log of all entities is only available to admins")
+
+// requireAuditLogRights requires the rights to view the audit log of the entity.
+// The audit log contains the identities and IP addresses of the actors, so only
+// those that can manage the collaborators of the entity can view it.
+// Admins can view the audit log of all entities, including deleted entities.
+func (is *IdentityServer) requireAuditLogRights(ctx context.Context, ids *ttnpb.EntityIdentifiers) error {
+	if is.IsAdmin(ctx) {
+		return nil
+	}
+	if ids == nil {
+		return errAuditLogAdminOnly
+	}
+	switch ids := ids.Identifiers().(type) {
+	case *ttnpb.ApplicationIdentifiers:
+		return rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_SETTINGS_COLLABORATORS)
+	case *ttnpb.ClientIdentifiers:
+		return rights.RequireClient(ctx, *ids, ttnpb.RIGHT_CLIENT_ALL)
+	case *ttnpb.EndDeviceIdentifiers:
+		return rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_COLLABORATORS)
+	case *ttnpb.GatewayIdentifiers:
+		return rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_SETTINGS_COLLABORATORS)
+	case *ttnpb.OrganizationIdentifiers:
+		return rights.RequireOrganization(ctx, *ids, ttnpb.RIGHT_ORGANIZATION_SETTINGS_MEMBERS)
+	case *ttnpb.UserIdentifiers:
+		return rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_ALL)
+	}
+	return nil
+}
+
+func (is *IdentityServer) listAuditLogEntries(ctx context.Context, req *ttnpb.ListAuditLogEntriesRequest) (entries *ttnpb.AuditLogEntries, err error) {
+	entityIDs := req.EntityIDs
+	if entityIDs != nil && entityIDs.Ids == nil {
+		entityIDs = nil
+	}
+	if err = is.requireAuditLogRights(ctx, entityIDs); err != nil {
+		return nil, err
+	}
+	var total uint64
+	ctx = store.WithPagination(ctx, req.Limit, req.Page, &total)
+	defer func() {
+		if err == nil {
+			setTotalHeader(ctx, total)
+		}
+	}()
+	entries = &ttnpb.AuditLogEntries{}
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		entries.Entries, err = store.GetAuditLogStore(db).FindEntries(ctx, entityIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+type auditLog struct {
+	*IdentityServer
+}
+
+func (al *auditLog) List(ctx context.Context, req *ttnpb.ListAuditLogEntriesRequest) (*ttnpb.AuditLogEntries, error) {
+	return al.listAuditLogEntries(ctx, req)
+}
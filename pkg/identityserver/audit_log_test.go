@@ -0,0 +1,109 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestAuditLogPermissionDenied(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewAuditLogClient(cc)
+
+		_, err := reg.List(ctx, &ttnpb.ListAuditLogEntriesRequest{})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.List(ctx, &ttnpb.ListAuditLogEntriesRequest{}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.List(ctx, &ttnpb.ListAuditLogEntriesRequest{
+			EntityIDs: adminUser.UserIdentifiers.EntityIdentifiers(),
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+	})
+}
+
+func TestAuditLog(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+
+		gtwReg := ttnpb.NewGatewayRegistryClient(cc)
+		created, err := gtwReg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "audited"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = gtwReg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				Name:               "Audited Gateway",
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"name"}},
+		}, creds)
+		a.So(err, should.BeNil)
+		_, err = gtwReg.Delete(ctx, &created.GatewayIdentifiers, creds)
+		a.So(err, should.BeNil)
+
+		reg := ttnpb.NewAuditLogClient(cc)
+
+		// Admins can view the audit log of deleted entities.
+		entries, err := reg.List(ctx, &ttnpb.ListAuditLogEntriesRequest{
+			EntityIDs: created.GatewayIdentifiers.EntityIdentifiers(),
+		}, userCreds(adminUserIdx))
+		if a.So(err, should.BeNil) && a.So(entries.Entries, should.HaveLength, 3) {
+			a.So(entries.Entries[0].Operation, should.Equal, "gateway.delete")
+			a.So(entries.Entries[1].Operation, should.Equal, "gateway.update")
+			a.So(entries.Entries[1].Paths, should.Resemble, []string{"name"})
+			a.So(entries.Entries[2].Operation, should.Equal, "gateway.create")
+			for _, entry := range entries.Entries {
+				a.So(entry.EntityIDs.IDString(), should.Equal, "audited")
+				a.So(entry.ActorIDs.GetUserIDs().GetUserID(), should.Equal, userID.UserID)
+				a.So(entry.ActorAPIKeyID, should.NotBeEmpty)
+				a.So(entry.SourceIP, should.NotBeEmpty)
+			}
+		}
+
+		entries, err = reg.List(ctx, &ttnpb.ListAuditLogEntriesRequest{
+			Limit: 1,
+		}, userCreds(adminUserIdx))
+		if a.So(err, should.BeNil) && a.So(entries.Entries, should.HaveLength, 1) {
+			a.So(entries.Entries[0].Operation, should.Equal, "gateway.delete")
+		}
+	})
+}
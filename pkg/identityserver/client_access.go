@@ -83,19 +83,31 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 	if err := rights.RequireClient(ctx, req.ClientIdentifiers, req.Collaborator.Rights...); err != nil {
 		return nil, err
 	}
+	evt := evtUpdateClientCollaborator(ctx, ttnpb.CombineIdentifiers(req.ClientIdentifiers, req.Collaborator), nil)
+	if len(req.Collaborator.Rights) == 0 {
+		evt = evtDeleteClientCollaborator(ctx, ttnpb.CombineIdentifiers(req.ClientIdentifiers, req.Collaborator), nil)
+	}
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return is.getMembershipStore(ctx, db).SetMember(
+		if len(req.Collaborator.Rights) > 0 {
+			if err := is.requireCollaboratorQuota(ctx, db, &req.ClientIdentifiers, &req.Collaborator.OrganizationOrUserIdentifiers); err != nil {
+				return err
+			}
+		}
+		if err := is.getMembershipStore(ctx, db).SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.ClientIdentifiers,
 			ttnpb.RightsFrom(req.Collaborator.Rights...),
-		)
+		); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evt)
 	if len(req.Collaborator.Rights) > 0 {
-		events.Publish(evtUpdateClientCollaborator(ctx, ttnpb.CombineIdentifiers(req.ClientIdentifiers, req.Collaborator), nil))
 		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 			data.SetEntity(req.EntityIdentifiers())
 			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
@@ -103,8 +115,6 @@ func (is *IdentityServer) setClientCollaborator(ctx context.Context, req *ttnpb.
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
 		}
-	} else {
-		events.Publish(evtDeleteClientCollaborator(ctx, ttnpb.CombineIdentifiers(req.ClientIdentifiers, req.Collaborator), nil))
 	}
 	return ttnpb.Empty, nil
 }
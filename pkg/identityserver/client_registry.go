@@ -41,6 +41,14 @@ var (
 		"client.delete", "delete OAuth client",
 		ttnpb.RIGHT_CLIENT_ALL,
 	)
+	evtRestoreClient = events.Define(
+		"client.restore", "restore OAuth client",
+		ttnpb.RIGHT_CLIENT_ALL,
+	)
+	evtPurgeClient = events.Define(
+		"client.purge", "purge OAuth client",
+		ttnpb.RIGHT_CLIENT_ALL,
+	)
 )
 
 func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateClientRequest) (cli *ttnpb.Client, err error) {
@@ -81,6 +89,7 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 		req.Client.Endorsed = false
 	}
 
+	evt := evtCreateClient(ctx, req.ClientIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		cli, err = store.GetClientStore(db).CreateClient(ctx, &req.Client)
 		if err != nil {
@@ -101,7 +110,7 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
@@ -109,7 +118,7 @@ func (is *IdentityServer) createClient(ctx context.Context, req *ttnpb.CreateCli
 
 	cli.Secret = secret // Return the unhashed secret, in case it was generated.
 
-	events.Publish(evtCreateClient(ctx, req.ClientIdentifiers, nil))
+	events.Publish(evt)
 	return cli, nil
 }
 
@@ -168,6 +177,9 @@ func (is *IdentityServer) listClients(ctx context.Context, req *ttnpb.ListClient
 			return nil, err
 		}
 	}
+	if req.Deleted {
+		ctx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
 	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
 	defer func() {
@@ -233,6 +245,7 @@ func (is *IdentityServer) updateClient(ctx context.Context, req *ttnpb.UpdateCli
 		}
 	}
 
+	evt := evtUpdateClient(ctx, req.ClientIdentifiers, req.FieldMask.Paths)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		cli, err = store.GetClientStore(db).UpdateClient(ctx, &req.Client, &req.FieldMask)
 		if err != nil {
@@ -245,12 +258,12 @@ func (is *IdentityServer) updateClient(ctx context.Context, req *ttnpb.UpdateCli
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateClient(ctx, req.ClientIdentifiers, req.FieldMask.Paths))
+	events.Publish(evt)
 	// TODO: Send emails (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	// - If client state changed (approved, rejected, flagged, suspended)
 	return cli, nil
@@ -260,13 +273,51 @@ func (is *IdentityServer) deleteClient(ctx context.Context, ids *ttnpb.ClientIde
 	if err := rights.RequireClient(ctx, *ids, ttnpb.RIGHT_CLIENT_ALL); err != nil {
 		return nil, err
 	}
+	evt := evtDeleteClient(ctx, ids, nil)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetClientStore(db).DeleteClient(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) restoreClient(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireClient(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_CLIENT_ALL); err != nil {
+		return nil, err
+	}
+	evt := evtRestoreClient(ctx, ids, nil)
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetClientStore(db).DeleteClient(ctx, ids)
+		if err := store.GetClientStore(db).RestoreClient(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteClient(ctx, ids, nil))
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeClient(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireClient(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_CLIENT_ALL); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
 
@@ -293,3 +344,11 @@ func (cr *clientRegistry) Update(ctx context.Context, req *ttnpb.UpdateClientReq
 func (cr *clientRegistry) Delete(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
 	return cr.deleteClient(ctx, req)
 }
+
+func (cr *clientRegistry) Restore(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	return cr.restoreClient(ctx, req)
+}
+
+func (cr *clientRegistry) Purge(ctx context.Context, req *ttnpb.ClientIdentifiers) (*types.Empty, error) {
+	return cr.purgeClient(ctx, req)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package emails
+
+// UserStateChanged is the email that is sent when an admin changes the state of a user.
+type UserStateChanged struct {
+	Data
+	State string
+}
+
+// TemplateName returns the name of the template to use for this email.
+func (UserStateChanged) TemplateName() string { return "user_state_changed" }
+
+const userStateChangedSubject = `The state of your user {{.User.ID}} was changed`
+
+const userStateChangedText = `Dear {{.User.Name}},
+
+The state of your user "{{.User.ID}}" on {{.Network.Name}} was changed to "{{.State}}".
+{{if eq .State "approved"}}
+Your account was approved. You can now use {{.Network.Name}}.
+{{else if eq .State "rejected"}}
+Your account was rejected. You can still view and delete your account, but you can not use {{.Network.Name}}.
+{{else if eq .State "suspended"}}
+Your account was suspended. You can no longer log in or use {{.Network.Name}}.
+{{end}}
+If you have any questions, please contact the administrators of {{.Network.Name}}.
+`
+
+// DefaultTemplates returns the default templates for this email.
+func (UserStateChanged) DefaultTemplates() (subject, html, text string) {
+	return userStateChangedSubject, "", userStateChangedText
+}
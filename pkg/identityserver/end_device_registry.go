@@ -48,17 +48,21 @@ func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.Create
 	if err = blacklist.Check(ctx, req.DeviceID); err != nil {
 		return nil, err
 	}
+	evt := evtCreateEndDevice(ctx, req.EndDeviceIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.requireQuota(ctx, db, &req.ApplicationIdentifiers, quotaEndDevices); err != nil {
+			return err
+		}
 		dev, err = store.GetEndDeviceStore(db).CreateEndDevice(ctx, &req.EndDevice)
 		if err != nil {
 			return err
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateEndDevice(ctx, req.EndDeviceIdentifiers, nil))
+	events.Publish(evt)
 	return dev, nil
 }
 
@@ -132,14 +136,18 @@ func (is *IdentityServer) updateEndDevice(ctx context.Context, req *ttnpb.Update
 	if len(req.FieldMask.Paths) == 0 {
 		req.FieldMask.Paths = updatePaths
 	}
+	evt := evtUpdateEndDevice(ctx, req.EndDeviceIdentifiers, req.FieldMask.Paths)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		dev, err = store.GetEndDeviceStore(db).UpdateEndDevice(ctx, &req.EndDevice, &req.FieldMask)
-		return err
+		if err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateEndDevice(ctx, req.EndDeviceIdentifiers, req.FieldMask.Paths))
+	events.Publish(evt)
 	return dev, nil
 }
 
@@ -147,13 +155,17 @@ func (is *IdentityServer) deleteEndDevice(ctx context.Context, ids *ttnpb.EndDev
 	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
+	evt := evtDeleteEndDevice(ctx, ids, nil)
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetEndDeviceStore(db).DeleteEndDevice(ctx, ids)
+		if err := store.GetEndDeviceStore(db).DeleteEndDevice(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteEndDevice(ctx, ids, nil))
+	events.Publish(evt)
 	return ttnpb.Empty, nil
 }
 
@@ -72,12 +72,12 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 	if md.AuthType == "" {
 		return &ttnpb.AuthInfoResponse{}, nil
 	}
-	if md.AuthType == clusterauth.AuthType {
+	if clusterauth.IsAuthType(md.AuthType) {
 		if err := clusterauth.Authorized(ctx); err != nil {
 			return nil, err
 		}
 		return &ttnpb.AuthInfoResponse{
-			UniversalRights: ttnpb.AllClusterRights.Implied(),
+			UniversalRights: clusterauth.Rights(ctx),
 		}, nil
 	}
 	if strings.ToLower(md.AuthType) != "bearer" {
@@ -116,6 +116,14 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 			if !valid {
 				return errInvalidAuthorization
 			}
+			now := time.Now()
+			if err := auth.ValidateExpiry(apiKey.ExpiresAt, now); err != nil {
+				return err
+			}
+			if err := auth.ValidateSourceIP(rpcmetadata.SourceIP(ctx), apiKey.AllowedCIDRs...); err != nil {
+				return err
+			}
+			is.apiKeyUsage.record(apiKey.ID, now)
 			apiKey.Key = ""
 			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_APIKey{
@@ -16,8 +16,11 @@ package identityserver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -85,5 +88,63 @@ func TestEntityAccess(t *testing.T) {
 			a.So(err, should.BeNil)
 			a.So(authInfo.GetUniversalRights().GetRights(), should.NotBeEmpty)
 		})
+
+		t.Run("Restricted API Key", func(t *testing.T) {
+			a := assertions.New(t)
+			userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+			appID := userApplications(&userID).Applications[0].ApplicationIdentifiers
+			reg := ttnpb.NewApplicationAccessClient(cc)
+
+			past := time.Now().Add(-time.Hour)
+			_, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+				ApplicationIdentifiers: appID,
+				Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+				ExpiresAt:              &past,
+			}, creds)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			_, err = reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+				ApplicationIdentifiers: appID,
+				Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+				AllowedCIDRs:           []string{"192.0.2.1"},
+			}, creds)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			future := time.Now().Add(time.Hour)
+			key, err := reg.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+				ApplicationIdentifiers: appID,
+				Rights:                 []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+				ExpiresAt:              &future,
+				AllowedCIDRs:           []string{"192.0.2.0/24"},
+			}, creds)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			keyCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "bearer",
+				AuthValue:     key.Key,
+				AllowInsecure: true,
+			})
+
+			allowedCtx := metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "192.0.2.1")
+			authInfo, err := cli.AuthInfo(allowedCtx, ttnpb.Empty, keyCreds)
+			a.So(err, should.BeNil)
+			a.So(authInfo.GetAPIKey(), should.NotBeNil)
+
+			deniedCtx := metadata.AppendToOutgoingContext(ctx, "x-forwarded-for", "198.51.100.1")
+			_, err = cli.AuthInfo(deniedCtx, ttnpb.Empty, keyCreds)
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+			a.So(is.flushAPIKeyUsage(ctx), should.BeNil)
+
+			got, err := reg.GetAPIKey(ctx, &ttnpb.GetApplicationAPIKeyRequest{
+				ApplicationIdentifiers: appID,
+				KeyID:                  key.ID,
+			}, creds)
+			if a.So(err, should.BeNil) {
+				a.So(got.LastUsedAt, should.NotBeNil)
+				a.So(got.AllowedCIDRs, should.Resemble, []string{"192.0.2.0/24"})
+			}
+		})
 	})
 }
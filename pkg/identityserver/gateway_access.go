@@ -70,18 +70,25 @@ func (is *IdentityServer) createGatewayAPIKey(ctx context.Context, req *ttnpb.Cr
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedCIDRs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	evt := evtCreateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GatewayIdentifiers, key)
+		if err := is.requireQuota(ctx, db, &req.GatewayIdentifiers, quotaAPIKeys); err != nil {
+			return err
+		}
+		if err := store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.GatewayIdentifiers, key); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
-	events.Publish(evtCreateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
+	events.Publish(evt)
 	err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 		data.SetEntity(req.EntityIdentifiers())
 		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
@@ -146,19 +153,33 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	fieldMask := apiKeyUpdateFieldMask(req.FieldMask)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, fieldMask)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, fieldMask); err != nil {
+			return nil, err
+		}
+	}
+	evt := evtUpdateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil)
+	if deleteKey {
+		evt = evtDeleteGatewayAPIKey(ctx, req.GatewayIdentifiers, nil)
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GatewayIdentifiers, &req.APIKey)
-		return err
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.GatewayIdentifiers, &req.APIKey, fieldMask)
+		if err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evt)
 	if key == nil {
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
+	if ttnpb.HasAnyField(fieldMask.Paths, "rights") {
 		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 			data.SetEntity(req.EntityIdentifiers())
 			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
@@ -166,8 +187,6 @@ func (is *IdentityServer) updateGatewayAPIKey(ctx context.Context, req *ttnpb.Up
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
 		}
-	} else {
-		events.Publish(evtDeleteGatewayAPIKey(ctx, req.GatewayIdentifiers, nil))
 	}
 	return key, nil
 }
@@ -206,19 +225,31 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 	if err := rights.RequireGateway(ctx, req.GatewayIdentifiers, req.Collaborator.Rights...); err != nil {
 		return nil, err
 	}
+	evt := evtUpdateGatewayCollaborator(ctx, ttnpb.CombineIdentifiers(req.GatewayIdentifiers, req.Collaborator), nil)
+	if len(req.Collaborator.Rights) == 0 {
+		evt = evtDeleteGatewayCollaborator(ctx, ttnpb.CombineIdentifiers(req.GatewayIdentifiers, req.Collaborator), nil)
+	}
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return is.getMembershipStore(ctx, db).SetMember(
+		if len(req.Collaborator.Rights) > 0 {
+			if err := is.requireCollaboratorQuota(ctx, db, &req.GatewayIdentifiers, &req.Collaborator.OrganizationOrUserIdentifiers); err != nil {
+				return err
+			}
+		}
+		if err := is.getMembershipStore(ctx, db).SetMember(
 			ctx,
 			&req.Collaborator.OrganizationOrUserIdentifiers,
 			req.GatewayIdentifiers,
 			ttnpb.RightsFrom(req.Collaborator.Rights...),
-		)
+		); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evt)
 	if len(req.Collaborator.Rights) > 0 {
-		events.Publish(evtUpdateGatewayCollaborator(ctx, ttnpb.CombineIdentifiers(req.GatewayIdentifiers, req.Collaborator), nil))
 		err = is.SendContactsEmail(ctx, req.EntityIdentifiers(), func(data emails.Data) email.MessageData {
 			data.SetEntity(req.EntityIdentifiers())
 			return &emails.CollaboratorChanged{Data: data, Collaborator: req.Collaborator}
@@ -226,8 +257,6 @@ func (is *IdentityServer) setGatewayCollaborator(ctx context.Context, req *ttnpb
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send collaborator updated notification email")
 		}
-	} else {
-		events.Publish(evtDeleteGatewayCollaborator(ctx, ttnpb.CombineIdentifiers(req.GatewayIdentifiers, req.Collaborator), nil))
 	}
 	return ttnpb.Empty, nil
 }
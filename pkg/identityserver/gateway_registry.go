@@ -16,10 +16,12 @@ package identityserver
 
 import (
 	"context"
+	"strings"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -39,12 +41,23 @@ var (
 		"gateway.delete", "delete gateway",
 		ttnpb.RIGHT_GATEWAY_INFO,
 	)
+	evtRestoreGateway = events.Define(
+		"gateway.restore", "restore gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
+	evtPurgeGateway = events.Define(
+		"gateway.purge", "purge gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
 )
 
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
 	if err = blacklist.Check(ctx, req.GatewayID); err != nil {
 		return nil, err
 	}
+	if strings.HasPrefix(req.GatewayID, reservedGatewayIDPrefix) {
+		return nil, errReservedGatewayID.WithAttributes("gateway_id", req.GatewayID)
+	}
 	if usrIDs := req.Collaborator.GetUserIDs(); usrIDs != nil {
 		if err = rights.RequireUser(ctx, *usrIDs, ttnpb.RIGHT_USER_GATEWAYS_CREATE); err != nil {
 			return nil, err
@@ -57,7 +70,14 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 	if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 		return nil, err
 	}
+	if err := is.validateFrequencyPlanIDs(req.FrequencyPlanIDs); err != nil {
+		return nil, err
+	}
+	evt := evtCreateGateway(ctx, req.GatewayIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		if err = is.requireQuota(ctx, db, req.Collaborator.Identifiers(), quotaGateways); err != nil {
+			return err
+		}
 		gtw, err = store.GetGatewayStore(db).CreateGateway(ctx, &req.Gateway)
 		if err != nil {
 			return err
@@ -70,6 +90,9 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 		); err != nil {
 			return err
 		}
+		if err = store.GetQuotaStore(db).SetOwner(ctx, &req.Collaborator, gtw.GatewayIdentifiers); err != nil {
+			return err
+		}
 		if len(req.ContactInfo) > 0 {
 			cleanContactInfo(req.ContactInfo)
 			gtw.ContactInfo, err = store.GetContactInfoStore(db).SetContactInfo(ctx, gtw.GatewayIdentifiers, req.ContactInfo)
@@ -77,12 +100,12 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateGateway(ctx, req.GatewayIdentifiers, nil))
+	events.Publish(evt)
 	return gtw, nil
 }
 
@@ -161,6 +184,9 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 			return nil, err
 		}
 	}
+	if req.Deleted {
+		ctx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
 	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
 	defer func() {
@@ -200,6 +226,27 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	return gtws, nil
 }
 
+// reservedGatewayIDPrefix is the prefix of the gateway IDs that the Network Server uses for gateways of
+// forwarding Network Servers in passive roaming.
+const reservedGatewayIDPrefix = "roaming-"
+
+var errReservedGatewayID = errors.DefineInvalidArgument("reserved_gateway_id", "the gateway ID `{gateway_id}` is reserved")
+
+var errFrequencyPlanIDsConflict = errors.DefineInvalidArgument(
+	"frequency_plan_ids_conflict",
+	"can not update both `frequency_plan_id` and `frequency_plan_ids`",
+)
+
+// validateFrequencyPlanIDs validates that the frequency plans can be combined, as the Gateway Server does when the
+// gateway connects. The frequency plans are not validated if the Identity Server has no frequency plans configured.
+func (is *IdentityServer) validateFrequencyPlanIDs(ids []string) error {
+	if len(ids) < 2 || is.FrequencyPlans == nil {
+		return nil
+	}
+	_, err := is.FrequencyPlans.GetCombined(ids...)
+	return err
+}
+
 func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -208,11 +255,23 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	if len(req.FieldMask.Paths) == 0 {
 		req.FieldMask.Paths = updatePaths
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_id") {
+		if ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_ids") {
+			return nil, errFrequencyPlanIDsConflict
+		}
+		req.FrequencyPlanIDs = nil
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_ids") {
+		if err := is.validateFrequencyPlanIDs(req.FrequencyPlanIDs); err != nil {
+			return nil, err
+		}
+	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "contact_info") {
 		if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 			return nil, err
 		}
 	}
+	evt := evtUpdateGateway(ctx, req.GatewayIdentifiers, req.FieldMask.Paths)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).UpdateGateway(ctx, &req.Gateway, &req.FieldMask)
 		if err != nil {
@@ -225,12 +284,12 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateGateway(ctx, req.GatewayIdentifiers, req.FieldMask.Paths))
+	events.Publish(evt)
 	return gtw, nil
 }
 
@@ -238,13 +297,51 @@ func (is *IdentityServer) deleteGateway(ctx context.Context, ids *ttnpb.GatewayI
 	if err := rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
 		return nil, err
 	}
+	evt := evtDeleteGateway(ctx, ids, nil)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetGatewayStore(db).DeleteGateway(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) restoreGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireGateway(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
+		return nil, err
+	}
+	evt := evtRestoreGateway(ctx, ids, nil)
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetGatewayStore(db).DeleteGateway(ctx, ids)
+		if err := store.GetGatewayStore(db).RestoreGateway(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteGateway(ctx, ids, nil))
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireGateway(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_GATEWAY_DELETE); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
 
@@ -275,3 +372,11 @@ func (gr *gatewayRegistry) Update(ctx context.Context, req *ttnpb.UpdateGatewayR
 func (gr *gatewayRegistry) Delete(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
 	return gr.deleteGateway(ctx, req)
 }
+
+func (gr *gatewayRegistry) Restore(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.restoreGateway(ctx, req)
+}
+
+func (gr *gatewayRegistry) Purge(ctx context.Context, req *ttnpb.GatewayIdentifiers) (*types.Empty, error) {
+	return gr.purgeGateway(ctx, req)
+}
@@ -127,6 +127,17 @@ func TestGatewaysCRUD(t *testing.T) {
 
 		eui := types.EUI64{1, 2, 3, 4, 5, 6, 7, 8}
 
+		_, err := reg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "roaming-000013"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
 		created, err := reg.Create(ctx, &ttnpb.CreateGatewayRequest{
 			Gateway: ttnpb.Gateway{
 				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
@@ -186,6 +197,42 @@ func TestGatewaysCRUD(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(updated.Name, should.Equal, "Updated Name")
 
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				FrequencyPlanID:    "EU_863_870",
+				FrequencyPlanIDs:   []string{"EU_863_870", "EU_863_870"},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"frequency_plan_id", "frequency_plan_ids"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				FrequencyPlanIDs:   []string{"EU_863_870", "US_902_928_FSB_2"},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"frequency_plan_ids"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		updated, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				FrequencyPlanIDs:   []string{"EU_863_870", "EU_863_870"},
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"frequency_plan_ids"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		a.So(updated.FrequencyPlanIDs, should.Resemble, []string{"EU_863_870", "EU_863_870"})
+
 		for _, collaborator := range []*ttnpb.OrganizationOrUserIdentifiers{nil, userID.OrganizationOrUserIdentifiers()} {
 			list, err := reg.List(ctx, &ttnpb.ListGatewaysRequest{
 				FieldMask:    ptypes.FieldMask{Paths: []string{"name"}},
@@ -22,6 +22,8 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/postgres" // Postgres database driver.
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/argon2"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
@@ -82,6 +84,58 @@ type Config struct {
 			registryMu sync.Mutex
 		} `name:"templates"`
 	} `name:"email"`
+	Quota struct {
+		Applications  int `name:"applications" description:"Default maximum number of applications per user or organization (0 for unlimited)"`
+		Gateways      int `name:"gateways" description:"Default maximum number of gateways per user or organization (0 for unlimited)"`
+		EndDevices    int `name:"end-devices" description:"Default maximum number of end devices per application (0 for unlimited)"`
+		APIKeys       int `name:"api-keys" description:"Default maximum number of API keys per entity (0 for unlimited)"`
+		Collaborators int `name:"collaborators" description:"Default maximum number of collaborators per entity (0 for unlimited)"`
+	} `name:"quota"`
+	Delete struct {
+		Retention     time.Duration `name:"retention" description:"How long deleted entities can be restored before they are purged (0 to keep them indefinitely)"`
+		PurgeInterval time.Duration `name:"purge-interval" description:"Interval for purging deleted entities of which the retention expired"`
+	} `name:"delete"`
+	APIKeys struct {
+		LastUsedFlushInterval  time.Duration `name:"last-used-flush-interval" description:"Interval for writing the times when API keys were last used to the database"`
+		ExpiryReminder         time.Duration `name:"expiry-reminder" description:"How long before expiry the contacts of the entity are reminded of an expiring API key (0 to disable)"`
+		ExpiryReminderInterval time.Duration `name:"expiry-reminder-interval" description:"Interval for checking for expiring API keys"`
+	} `name:"api-keys"`
+	PasswordHashing PasswordHashingConfig `name:"password-hashing"`
+}
+
+// PasswordHashingConfig is the configuration for hashing user passwords.
+type PasswordHashingConfig struct {
+	Algorithm string `name:"algorithm" description:"Hashing algorithm for user passwords (PBKDF2, ARGON2ID)"`
+	Argon2ID  struct {
+		Time    uint32 `name:"time" description:"Number of passes over the memory"`
+		Memory  uint32 `name:"memory" description:"Size of the memory in KiB"`
+		Threads uint8  `name:"threads" description:"Number of threads"`
+	} `name:"argon2id"`
+}
+
+// HashValidator returns the configured HashValidator for user passwords.
+// It returns nil if no algorithm is configured.
+func (c PasswordHashingConfig) HashValidator() (auth.HashValidator, error) {
+	if c.Algorithm == "" {
+		return nil, nil
+	}
+	hashValidator, err := auth.HashValidatorByName(c.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := hashValidator.(argon2.Argon2id); ok {
+		if c.Argon2ID.Time != 0 {
+			p.Time = c.Argon2ID.Time
+		}
+		if c.Argon2ID.Memory != 0 {
+			p.Memory = c.Argon2ID.Memory
+		}
+		if c.Argon2ID.Threads != 0 {
+			p.Threads = c.Argon2ID.Threads
+		}
+		hashValidator = p
+	}
+	return hashValidator, nil
 }
 
 // IdentityServer implements the Identity Server component.
@@ -96,6 +150,10 @@ type IdentityServer struct {
 	oauth  oauth.Server
 
 	redis *redis.Client
+
+	apiKeyUsage apiKeyUsage
+
+	passwordHashValidator auth.HashValidator
 }
 
 // Context returns the context of the Identity Server.
@@ -126,6 +184,10 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		ctx:       log.NewContextWithField(c.Context(), "namespace", "identityserver"),
 		config:    config,
 	}
+	is.passwordHashValidator, err = is.config.PasswordHashing.HashValidator()
+	if err != nil {
+		return nil, err
+	}
 	is.db, err = store.Open(is.Context(), is.config.DatabaseURI)
 	if err != nil {
 		return nil, err
@@ -146,17 +208,22 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		store.UserSessionStore
 		store.ClientStore
 		store.OAuthStore
+		store.MembershipStore
 	}{
 		UserStore:        store.GetUserStore(is.db),
 		UserSessionStore: store.GetUserSessionStore(is.db),
 		ClientStore:      store.GetClientStore(is.db),
 		OAuthStore:       store.GetOAuthStore(is.db),
-	}, is.config.OAuth)
+		MembershipStore:  store.GetMembershipStore(is.db),
+	}, is.config.OAuth, oauth.WithUserProvisioner(is.createFederatedUser))
 
 	c.AddContextFiller(func(ctx context.Context) context.Context {
 		ctx = is.withRequestAccessCache(ctx)
 		ctx = rights.NewContextWithFetcher(ctx, is)
 		ctx = rights.NewContextWithCache(ctx)
+		if is.passwordHashValidator != nil {
+			ctx = auth.NewContextWithPasswordHashValidator(ctx, is.passwordHashValidator)
+		}
 		return ctx
 	})
 
@@ -182,6 +249,16 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.OAuthAuthorizationRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AuditLog", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.QuotaRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
+
+	if is.config.Delete.Retention > 0 {
+		c.RegisterTask(is.Context(), "purge_deleted", is.purgeDeletedTask, component.TaskRestartOnFailure)
+	}
+	c.RegisterTask(is.Context(), "flush_api_key_usage", is.flushAPIKeyUsageTask, component.TaskRestartOnFailure)
+	if is.config.APIKeys.ExpiryReminder > 0 {
+		c.RegisterTask(is.Context(), "remind_expiring_api_keys", is.remindExpiringAPIKeysTask, component.TaskRestartOnFailure)
+	}
 
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
@@ -211,6 +288,8 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterEntityRegistrySearchServer(s, &registrySearch{IdentityServer: is, adminOnly: true})
 	ttnpb.RegisterOAuthAuthorizationRegistryServer(s, &oauthRegistry{IdentityServer: is})
 	ttnpb.RegisterContactInfoRegistryServer(s, &contactInfoRegistry{IdentityServer: is})
+	ttnpb.RegisterAuditLogServer(s, &auditLog{IdentityServer: is})
+	ttnpb.RegisterQuotaRegistryServer(s, &quotaRegistry{IdentityServer: is})
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -231,6 +310,8 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterEntityRegistrySearchHandler(is.Context(), s, conn)
 	ttnpb.RegisterOAuthAuthorizationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterContactInfoRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterAuditLogHandler(is.Context(), s, conn)
+	ttnpb.RegisterQuotaRegistryHandler(is.Context(), s, conn)
 }
 
 // Roles returns the roles that the Identity Server fulfills.
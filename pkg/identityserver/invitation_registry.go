@@ -51,14 +51,19 @@ func (is *IdentityServer) sendInvitation(ctx context.Context, in *ttnpb.SendInvi
 		Token:     token,
 		ExpiresAt: time.Now().Add(is.configFromContext(ctx).UserRegistration.Invitation.TokenTTL),
 	}
+	var evt events.Event
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		invitation, err = store.GetInvitationStore(db).CreateInvitation(ctx, invitation)
-		return err
+		if err != nil {
+			return err
+		}
+		evt = evtCreateInvitation(ctx, nil, invitation)
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateInvitation(ctx, nil, invitation))
+	events.Publish(evt)
 	// TODO: Send invitation email (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
 	return invitation, nil
 }
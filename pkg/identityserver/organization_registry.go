@@ -40,6 +40,14 @@ var (
 		"organization.delete", "delete organization",
 		ttnpb.RIGHT_ORGANIZATION_INFO,
 	)
+	evtRestoreOrganization = events.Define(
+		"organization.restore", "restore organization",
+		ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
+	evtPurgeOrganization = events.Define(
+		"organization.purge", "purge organization",
+		ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
 )
 
 var errNestedOrganizations = errors.DefineInvalidArgument("nested_organizations", "organizations can not be nested")
@@ -58,6 +66,7 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 	if err := validateContactInfo(req.Organization.ContactInfo); err != nil {
 		return nil, err
 	}
+	evt := evtCreateOrganization(ctx, req.OrganizationIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).CreateOrganization(ctx, &req.Organization)
 		if err != nil {
@@ -78,12 +87,12 @@ func (is *IdentityServer) createOrganization(ctx context.Context, req *ttnpb.Cre
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtCreateOrganization(ctx, req.OrganizationIdentifiers, nil))
+	events.Publish(evt)
 	return org, nil
 }
 
@@ -140,6 +149,9 @@ func (is *IdentityServer) listOrganizations(ctx context.Context, req *ttnpb.List
 	} else if orgIDs := req.Collaborator.GetOrganizationIDs(); orgIDs != nil {
 		return nil, errNestedOrganizations
 	}
+	if req.Deleted {
+		ctx = store.WithSoftDeleted(ctx, true)
+	}
 	var total uint64
 	paginateCtx := store.WithPagination(ctx, req.Limit, req.Page, &total)
 	defer func() {
@@ -192,6 +204,7 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 			return nil, err
 		}
 	}
+	evt := evtUpdateOrganization(ctx, req.OrganizationIdentifiers, req.FieldMask.Paths)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		org, err = store.GetOrganizationStore(db).UpdateOrganization(ctx, &req.Organization, &req.FieldMask)
 		if err != nil {
@@ -204,12 +217,12 @@ func (is *IdentityServer) updateOrganization(ctx context.Context, req *ttnpb.Upd
 				return err
 			}
 		}
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateOrganization(ctx, req.OrganizationIdentifiers, req.FieldMask.Paths))
+	events.Publish(evt)
 	return org, nil
 }
 
@@ -217,13 +230,51 @@ func (is *IdentityServer) deleteOrganization(ctx context.Context, ids *ttnpb.Org
 	if err := rights.RequireOrganization(ctx, *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
 		return nil, err
 	}
+	evt := evtDeleteOrganization(ctx, ids, nil)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetOrganizationStore(db).DeleteOrganization(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) restoreOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireOrganization(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
+		return nil, err
+	}
+	evt := evtRestoreOrganization(ctx, ids, nil)
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetOrganizationStore(db).DeleteOrganization(ctx, ids)
+		if err := store.GetOrganizationStore(db).RestoreOrganization(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteOrganization(ctx, ids, nil))
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeOrganization(ctx context.Context, ids *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireOrganization(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_ORGANIZATION_DELETE); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
 
@@ -250,3 +301,11 @@ func (or *organizationRegistry) Update(ctx context.Context, req *ttnpb.UpdateOrg
 func (or *organizationRegistry) Delete(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
 	return or.deleteOrganization(ctx, req)
 }
+
+func (or *organizationRegistry) Restore(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.restoreOrganization(ctx, req)
+}
+
+func (or *organizationRegistry) Purge(ctx context.Context, req *ttnpb.OrganizationIdentifiers) (*types.Empty, error) {
+	return or.purgeOrganization(ctx, req)
+}
@@ -21,6 +21,9 @@ import (
 	"image/color"
 	"image/draw"
 	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -116,3 +119,49 @@ func TestStore(t *testing.T) {
 		a.So(pic.Sizes[400], should.Equal, "picture/400.png")
 	}
 }
+
+func TestDelete(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	dir, err := ioutil.TempDir("", "picture")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	var blobConfig blob.Config
+	blobConfig.Provider, blobConfig.Local.Directory = "local", dir
+	if !a.So(os.Mkdir(filepath.Join(dir, "pictures"), 0755), should.BeNil) {
+		t.FailNow()
+	}
+	bucket, err := blobConfig.GetBucket(ctx, "pictures")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var b bytes.Buffer
+	png.Encode(&b, makeCheckers(800, 800))
+	pic, err := picture.Store(ctx, bucket, "picture", &ttnpb.Picture{
+		Embedded: &ttnpb.Picture_Embedded{
+			MimeType: "image/png",
+			Data:     b.Bytes(),
+		},
+	}, 400)
+	if !a.So(err, should.BeNil) || !a.So(pic.Sizes, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	pic.Sizes[1024] = "https://example.com/picture.png"
+
+	a.So(picture.Delete(ctx, bucket, pic), should.BeNil)
+	for size, key := range pic.Sizes {
+		if size == 1024 {
+			continue
+		}
+		exists, err := bucket.Exists(ctx, key)
+		a.So(err, should.BeNil)
+		a.So(exists, should.BeFalse)
+	}
+
+	// Deleting again is not an error.
+	a.So(picture.Delete(ctx, bucket, pic), should.BeNil)
+}
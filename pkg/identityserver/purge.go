@@ -0,0 +1,228 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	ttnblob "go.thethings.network/lorawan-stack/pkg/blob"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/picture"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var idsFieldMask = &types.FieldMask{Paths: []string{"ids"}}
+
+var errEntityNotDeleted = errors.DefineFailedPrecondition("entity_not_deleted", "{entity_type} `{entity_id}` is not deleted")
+
+// purgeEntity permanently deletes the soft-deleted entity and the data related
+// to it. For applications, this includes the end devices, integrations and link
+// in the Network Server, Application Server and Join Server of the cluster that
+// were not deleted when the application was deleted. For users, this includes
+// the stored profile picture.
+func (is *IdentityServer) purgeEntity(ctx context.Context, ids ttnpb.Identifiers) (err error) {
+	var evt events.Event
+	switch ids := ids.(type) {
+	case *ttnpb.ApplicationIdentifiers:
+		evt = evtPurgeApplication(ctx, ids, nil)
+		// The data in the cluster is only deleted for applications that are deleted. This is
+		// done outside of a transaction, as it calls the cluster peers.
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			return requireApplicationDeleted(ctx, db, ids)
+		})
+		if err != nil {
+			return err
+		}
+		if err = is.deleteApplicationClusterData(ctx, *ids); err != nil {
+			return err
+		}
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			// The application may have been restored in the meantime.
+			if err := requireApplicationDeleted(ctx, db, ids); err != nil {
+				return err
+			}
+			if err := store.GetApplicationStore(db).PurgeApplication(ctx, ids); err != nil {
+				return err
+			}
+			return is.writeAuditLog(ctx, db, evt)
+		})
+	case *ttnpb.ClientIdentifiers:
+		evt = evtPurgeClient(ctx, ids, nil)
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			if err := store.GetClientStore(db).PurgeClient(ctx, ids); err != nil {
+				return err
+			}
+			return is.writeAuditLog(ctx, db, evt)
+		})
+	case *ttnpb.GatewayIdentifiers:
+		evt = evtPurgeGateway(ctx, ids, nil)
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			if err := store.GetGatewayStore(db).PurgeGateway(ctx, ids); err != nil {
+				return err
+			}
+			return is.writeAuditLog(ctx, db, evt)
+		})
+	case *ttnpb.OrganizationIdentifiers:
+		evt = evtPurgeOrganization(ctx, ids, nil)
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			if err := store.GetOrganizationStore(db).PurgeOrganization(ctx, ids); err != nil {
+				return err
+			}
+			return is.writeAuditLog(ctx, db, evt)
+		})
+	case *ttnpb.UserIdentifiers:
+		var profilePicture *ttnpb.Picture
+		evt = evtPurgeUser(ctx, ids, nil)
+		err = is.withDatabase(ctx, func(db *gorm.DB) error {
+			usrStore := store.GetUserStore(db)
+			usr, err := usrStore.GetUser(store.WithSoftDeleted(ctx, false), ids, &types.FieldMask{Paths: []string{"profile_picture"}})
+			if err != nil {
+				return err
+			}
+			profilePicture = usr.ProfilePicture
+			if err := usrStore.PurgeUser(ctx, ids); err != nil {
+				return err
+			}
+			return is.writeAuditLog(ctx, db, evt)
+		})
+		if err == nil && profilePicture != nil {
+			if err := is.deleteProfilePicture(ctx, profilePicture); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to delete profile picture of purged user")
+			}
+		}
+	default:
+		panic(fmt.Sprintf("can't purge entity of type %T", ids))
+	}
+	if err != nil {
+		return err
+	}
+	events.Publish(evt)
+	return nil
+}
+
+func requireApplicationDeleted(ctx context.Context, db *gorm.DB, ids *ttnpb.ApplicationIdentifiers) error {
+	app, err := store.GetApplicationStore(db).GetApplication(store.WithSoftDeleted(ctx, false), ids, idsFieldMask)
+	if err != nil {
+		return err
+	}
+	if app.DeletedAt == nil {
+		return errEntityNotDeleted.WithAttributes("entity_type", ids.EntityType(), "entity_id", ids.IDString())
+	}
+	return nil
+}
+
+func (is *IdentityServer) deleteProfilePicture(ctx context.Context, pic *ttnpb.Picture) error {
+	bucket, err := ttnblob.Config(is.Component.GetBaseConfig(ctx).Blob).GetBucket(ctx, is.configFromContext(ctx).ProfilePicture.Bucket)
+	if err != nil {
+		return err
+	}
+	return picture.Delete(ctx, bucket, pic)
+}
+
+// purgeDeleted purges the entities that were deleted longer than the retention
+// period ago.
+func (is *IdentityServer) purgeDeleted(ctx context.Context) error {
+	retention := is.configFromContext(ctx).Delete.Retention
+	deletedBefore := time.Now().Add(-retention)
+	var expired []ttnpb.Identifiers
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		ctx := store.WithSoftDeleted(ctx, true)
+		apps, err := store.GetApplicationStore(db).FindApplications(ctx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			if app.DeletedAt.Before(deletedBefore) {
+				expired = append(expired, &app.ApplicationIdentifiers)
+			}
+		}
+		clis, err := store.GetClientStore(db).FindClients(ctx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, cli := range clis {
+			if cli.DeletedAt.Before(deletedBefore) {
+				expired = append(expired, &cli.ClientIdentifiers)
+			}
+		}
+		gtws, err := store.GetGatewayStore(db).FindGateways(ctx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, gtw := range gtws {
+			if gtw.DeletedAt.Before(deletedBefore) {
+				expired = append(expired, &gtw.GatewayIdentifiers)
+			}
+		}
+		orgs, err := store.GetOrganizationStore(db).FindOrganizations(ctx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, org := range orgs {
+			if org.DeletedAt.Before(deletedBefore) {
+				expired = append(expired, &org.OrganizationIdentifiers)
+			}
+		}
+		usrs, err := store.GetUserStore(db).FindUsers(ctx, nil, idsFieldMask)
+		if err != nil {
+			return err
+		}
+		for _, usr := range usrs {
+			if usr.DeletedAt.Before(deletedBefore) {
+				expired = append(expired, &usr.UserIdentifiers)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx)
+	for _, ids := range expired {
+		logger := logger.WithFields(log.Fields("entity_type", ids.EntityType(), "entity_id", ids.IDString()))
+		if err := is.purgeEntity(ctx, ids); err != nil {
+			logger.WithError(err).Warn("Failed to purge deleted entity")
+			continue
+		}
+		logger.Info("Purged deleted entity")
+	}
+	return nil
+}
+
+func (is *IdentityServer) purgeDeletedTask(ctx context.Context) error {
+	interval := is.configFromContext(ctx).Delete.PurgeInterval
+	if interval == 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := is.purgeDeleted(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to purge deleted entities")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtUpdateQuota = events.Define(
+		"quota.update", "update quota",
+		ttnpb.RIGHT_USER_INFO, ttnpb.RIGHT_ORGANIZATION_INFO,
+	)
+)
+
+var (
+	errQuotaExceeded   = errors.DefineResourceExhausted("quota_exceeded", "quota of `{quota}` {resource} exceeded")
+	errQuotaAdminOnly  = errors.DefinePermissionDenied("quota_admin_only", "quotas can only be set by admins")
+	errQuotaEntityType = errors.DefineInvalidArgument("quota_entity_type", "entity type `{entity_type}` has no quota")
+)
+
+// Resources that are limited by quotas.
+const (
+	quotaApplications  = "applications"
+	quotaGateways      = "gateways"
+	quotaEndDevices    = "end devices"
+	quotaAPIKeys       = "API keys"
+	quotaCollaborators = "collaborators"
+)
+
+func quotaLimit(quota *ttnpb.Quota, resource string) uint32 {
+	switch resource {
+	case quotaApplications:
+		return quota.Applications
+	case quotaGateways:
+		return quota.Gateways
+	case quotaEndDevices:
+		return quota.EndDevices
+	case quotaAPIKeys:
+		return quota.APIKeys
+	case quotaCollaborators:
+		return quota.Collaborators
+	}
+	return 0
+}
+
+// mostPermissiveQuota returns the quota with for each resource the highest limit,
+// where 0 means that there is no limit.
+func mostPermissiveQuota(quotas ...*ttnpb.Quota) *ttnpb.Quota {
+	max := func(a, b uint32) uint32 {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		if a > b {
+			return a
+		}
+		return b
+	}
+	res := *quotas[0]
+	for _, quota := range quotas[1:] {
+		res.Applications = max(res.Applications, quota.Applications)
+		res.Gateways = max(res.Gateways, quota.Gateways)
+		res.EndDevices = max(res.EndDevices, quota.EndDevices)
+		res.APIKeys = max(res.APIKeys, quota.APIKeys)
+		res.Collaborators = max(res.Collaborators, quota.Collaborators)
+	}
+	return &res
+}
+
+func organizationOrUserIdentifiers(ids ttnpb.Identifiers) *ttnpb.OrganizationOrUserIdentifiers {
+	switch ids := ids.(type) {
+	case *ttnpb.OrganizationIdentifiers:
+		return ids.OrganizationOrUserIdentifiers()
+	case *ttnpb.UserIdentifiers:
+		return ids.OrganizationOrUserIdentifiers()
+	}
+	return nil
+}
+
+func (is *IdentityServer) defaultQuota(ctx context.Context) *ttnpb.Quota {
+	conf := is.configFromContext(ctx).Quota
+	return &ttnpb.Quota{
+		Applications:  uint32(conf.Applications),
+		Gateways:      uint32(conf.Gateways),
+		EndDevices:    uint32(conf.EndDevices),
+		APIKeys:       uint32(conf.APIKeys),
+		Collaborators: uint32(conf.Collaborators),
+	}
+}
+
+// accountQuota returns the quota of the user or organization, which is the
+// default quota with the overrides of the user or organization.
+func (is *IdentityServer) accountQuota(ctx context.Context, db *gorm.DB, ids *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.Quota, error) {
+	quota := is.defaultQuota(ctx)
+	override, err := store.GetQuotaStore(db).GetQuota(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range []struct {
+		limit    *uint32
+		override *types.UInt32Value
+	}{
+		{&quota.Applications, override.Applications},
+		{&quota.Gateways, override.Gateways},
+		{&quota.EndDevices, override.EndDevices},
+		{&quota.APIKeys, override.APIKeys},
+		{&quota.Collaborators, override.Collaborators},
+	} {
+		if field.override != nil {
+			*field.limit = field.override.Value
+		}
+	}
+	return quota, nil
+}
+
+// entityQuota returns the quota that applies to the entity. For users and
+// organizations, this is their own quota. For other entities, this is the most
+// permissive quota of their direct collaborators.
+func (is *IdentityServer) entityQuota(ctx context.Context, db *gorm.DB, ids ttnpb.Identifiers) (*ttnpb.Quota, error) {
+	if ouIDs := organizationOrUserIdentifiers(ids); ouIDs != nil {
+		return is.accountQuota(ctx, db, ouIDs)
+	}
+	members, err := store.GetMembershipStore(db).FindMembers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return is.defaultQuota(ctx), nil
+	}
+	quotas := make([]*ttnpb.Quota, 0, len(members))
+	for member := range members {
+		quota, err := is.accountQuota(ctx, db, member)
+		if err != nil {
+			return nil, err
+		}
+		quotas = append(quotas, quota)
+	}
+	return mostPermissiveQuota(quotas...), nil
+}
+
+// quotaUsage returns the number of resources that count towards the quota of the entity.
+func (is *IdentityServer) quotaUsage(ctx context.Context, db *gorm.DB, ids ttnpb.Identifiers, resource string) (uint64, error) {
+	switch resource {
+	case quotaApplications, quotaGateways:
+		entityType := "application"
+		if resource == quotaGateways {
+			entityType = "gateway"
+		}
+		ouIDs := organizationOrUserIdentifiers(ids)
+		if ouIDs == nil {
+			return 0, nil
+		}
+		return store.GetQuotaStore(db).CountOwned(ctx, ouIDs, entityType)
+	case quotaEndDevices:
+		appIDs, ok := ids.(*ttnpb.ApplicationIdentifiers)
+		if !ok {
+			return 0, nil
+		}
+		return store.GetEndDeviceStore(db).CountEndDevices(ctx, appIDs)
+	case quotaAPIKeys:
+		if _, ok := ids.(*ttnpb.ClientIdentifiers); ok {
+			return 0, nil
+		}
+		keys, err := store.GetAPIKeyStore(db).FindAPIKeys(ctx, ids)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(len(keys)), nil
+	case quotaCollaborators:
+		if _, ok := ids.(*ttnpb.UserIdentifiers); ok {
+			return 0, nil
+		}
+		members, err := store.GetMembershipStore(db).FindMembers(ctx, ids)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(len(members)), nil
+	}
+	return 0, nil
+}
+
+// requireQuota returns an error if creating another resource would exceed the
+// quota that applies to the entity.
+func (is *IdentityServer) requireQuota(ctx context.Context, db *gorm.DB, ids ttnpb.Identifiers, resource string) error {
+	quota, err := is.entityQuota(ctx, db, ids)
+	if err != nil {
+		return err
+	}
+	limit := quotaLimit(quota, resource)
+	if limit == 0 {
+		return nil
+	}
+	used, err := is.quotaUsage(ctx, db, ids, resource)
+	if err != nil {
+		return err
+	}
+	if used >= uint64(limit) {
+		return errQuotaExceeded.WithAttributes(
+			"quota", limit,
+			"resource", resource,
+		)
+	}
+	return nil
+}
+
+// requireCollaboratorQuota is like requireQuota for collaborators, but only
+// requires the quota if the collaborator is not already a collaborator of the entity.
+func (is *IdentityServer) requireCollaboratorQuota(ctx context.Context, db *gorm.DB, ids ttnpb.Identifiers, collaborator *ttnpb.OrganizationOrUserIdentifiers) error {
+	_, err := store.GetMembershipStore(db).GetMember(ctx, collaborator, ids)
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+	return is.requireQuota(ctx, db, ids, quotaCollaborators)
+}
+
+func (is *IdentityServer) requireQuotaRights(ctx context.Context, ids ttnpb.Identifiers) error {
+	switch ids := ids.(type) {
+	case *ttnpb.ApplicationIdentifiers:
+		return rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_INFO)
+	case *ttnpb.ClientIdentifiers:
+		return rights.RequireClient(ctx, *ids, ttnpb.RIGHT_CLIENT_ALL)
+	case *ttnpb.GatewayIdentifiers:
+		return rights.RequireGateway(ctx, *ids, ttnpb.RIGHT_GATEWAY_INFO)
+	case *ttnpb.OrganizationIdentifiers:
+		return rights.RequireOrganization(ctx, *ids, ttnpb.RIGHT_ORGANIZATION_INFO)
+	case *ttnpb.UserIdentifiers:
+		return rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_INFO)
+	}
+	return nil
+}
+
+func (is *IdentityServer) getQuota(ctx context.Context, req *ttnpb.GetQuotaRequest) (*ttnpb.QuotaUsage, error) {
+	ids := req.EntityIDs.Identifiers()
+	if _, ok := ids.(*ttnpb.EndDeviceIdentifiers); ok {
+		return nil, errQuotaEntityType.WithAttributes("entity_type", ids.EntityType())
+	}
+	if err := is.requireQuotaRights(ctx, ids); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.QuotaUsage{}
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		quota, err := is.entityQuota(ctx, db, ids)
+		if err != nil {
+			return err
+		}
+		res.Quota = *quota
+		for resource, usage := range map[string]*uint32{
+			quotaApplications:  &res.Usage.Applications,
+			quotaGateways:      &res.Usage.Gateways,
+			quotaEndDevices:    &res.Usage.EndDevices,
+			quotaAPIKeys:       &res.Usage.APIKeys,
+			quotaCollaborators: &res.Usage.Collaborators,
+		} {
+			used, err := is.quotaUsage(ctx, db, ids, resource)
+			if err != nil {
+				return err
+			}
+			*usage = uint32(used)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) setQuota(ctx context.Context, req *ttnpb.SetQuotaRequest) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if !is.IsAdmin(ctx) {
+		return nil, errQuotaAdminOnly
+	}
+	evt := evtUpdateQuota(ctx, req.OrganizationOrUserIdentifiers, nil)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetQuotaStore(db).SetQuota(ctx, &req.OrganizationOrUserIdentifiers, &req.Quota); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+type quotaRegistry struct {
+	*IdentityServer
+}
+
+func (qr *quotaRegistry) Get(ctx context.Context, req *ttnpb.GetQuotaRequest) (*ttnpb.QuotaUsage, error) {
+	return qr.getQuota(ctx, req)
+}
+
+func (qr *quotaRegistry) Set(ctx context.Context, req *ttnpb.SetQuotaRequest) (*types.Empty, error) {
+	return qr.setQuota(ctx, req)
+}
@@ -0,0 +1,135 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+func TestQuotaPermissionDenied(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewQuotaRegistryClient(cc)
+
+		_, err := reg.Get(ctx, &ttnpb.GetQuotaRequest{
+			EntityIDs: *adminUser.UserIdentifiers.EntityIdentifiers(),
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Set(ctx, &ttnpb.SetQuotaRequest{
+			OrganizationOrUserIdentifiers: *defaultUser.OrganizationOrUserIdentifiers(),
+			Quota:                         ttnpb.QuotaOverride{Applications: &types.UInt32Value{Value: 100}},
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+	})
+}
+
+func TestQuota(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewQuotaRegistryClient(cc)
+		appReg := ttnpb.NewApplicationRegistryClient(cc)
+
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+
+		usage, err := reg.Get(ctx, &ttnpb.GetQuotaRequest{
+			EntityIDs: *userID.EntityIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(usage.Quota.Applications, should.BeZeroValue)
+		// Applications that the user collaborates on, but does not own, do not count.
+		a.So(usage.Usage.Applications, should.BeZeroValue)
+
+		_, err = reg.Set(ctx, &ttnpb.SetQuotaRequest{
+			OrganizationOrUserIdentifiers: *userID.OrganizationOrUserIdentifiers(),
+			Quota:                         ttnpb.QuotaOverride{Applications: &types.UInt32Value{Value: 1}},
+		}, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
+
+		_, err = appReg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = appReg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app-exceeded"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		}
+
+		usage, err = reg.Get(ctx, &ttnpb.GetQuotaRequest{
+			EntityIDs: *userID.EntityIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(usage.Quota.Applications, should.Equal, 1)
+		a.So(usage.Usage.Applications, should.Equal, 1)
+
+		// An override of 0 means that there is no limit.
+		_, err = reg.Set(ctx, &ttnpb.SetQuotaRequest{
+			OrganizationOrUserIdentifiers: *userID.OrganizationOrUserIdentifiers(),
+			Quota:                         ttnpb.QuotaOverride{Applications: &types.UInt32Value{Value: 0}},
+		}, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
+
+		_, err = appReg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app-unlimited"},
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+
+		_, err = appReg.Delete(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app-unlimited"}, creds)
+		a.So(err, should.BeNil)
+
+		appUsage, err := reg.Get(ctx, &ttnpb.GetQuotaRequest{
+			EntityIDs: *ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app"}.EntityIdentifiers(),
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(appUsage.Usage.Collaborators, should.Equal, 1)
+		a.So(appUsage.Usage.EndDevices, should.BeZeroValue)
+
+		_, err = reg.Set(ctx, &ttnpb.SetQuotaRequest{
+			OrganizationOrUserIdentifiers: *userID.OrganizationOrUserIdentifiers(),
+		}, userCreds(adminUserIdx))
+		a.So(err, should.BeNil)
+
+		_, err = appReg.Delete(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "quota-app"}, creds)
+		a.So(err, should.BeNil)
+	})
+}
@@ -19,11 +19,34 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
+// deletedApplicationClusterRights are the rights that cluster peers have on
+// deleted applications, so that they can delete the data of those applications.
+var deletedApplicationClusterRights = ttnpb.RightsFrom(
+	ttnpb.RIGHT_APPLICATION_INFO,
+	ttnpb.RIGHT_APPLICATION_LINK,
+	ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+	ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+	ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+)
+
+// isClusterPeer returns whether the caller is an authorized cluster peer.
+func (is *IdentityServer) isClusterPeer(ctx context.Context) bool {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if !clusterauth.IsAuthType(md.AuthType) {
+		return false
+	}
+	return clusterauth.Authorized(ctx) == nil
+}
+
 func allPotentialRights(entityID ttnpb.Identifiers, rights *ttnpb.Rights) *ttnpb.Rights {
 	switch entityID.EntityType() {
 	case "application":
@@ -132,13 +155,23 @@ func (is *IdentityServer) ApplicationRights(ctx context.Context, appIDs ttnpb.Ap
 	if !is.IsAdmin(ctx) && universal == nil {
 		return &ttnpb.Rights{}, nil
 	}
-	err = is.withDatabase(ctx, func(db *gorm.DB) error {
-		_, err := store.GetApplicationStore(db).GetApplication(ctx, &appIDs, &types.FieldMask{Paths: []string{"ids"}})
+	getCtx := ctx
+	isClusterPeer := is.isClusterPeer(ctx)
+	if isClusterPeer {
+		// Cluster peers delete the data of deleted applications.
+		getCtx = store.WithSoftDeleted(ctx, false)
+	}
+	var app *ttnpb.Application
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		app, err = store.GetApplicationStore(db).GetApplication(getCtx, &appIDs, &types.FieldMask{Paths: []string{"ids"}})
 		return err
 	})
 	if err != nil {
 		return nil, err
 	}
+	if isClusterPeer && app.DeletedAt != nil {
+		return deletedApplicationClusterRights.Intersect(universal), nil
+	}
 	return universal, nil
 }
 
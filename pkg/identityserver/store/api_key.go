@@ -14,7 +14,12 @@
 
 package store
 
-import "go.thethings.network/lorawan-stack/pkg/ttnpb"
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
 
 // APIKey model.
 type APIKey struct {
@@ -26,6 +31,11 @@ type APIKey struct {
 	Rights Rights `gorm:"type:INT ARRAY"`
 	Name   string `gorm:"type:VARCHAR"`
 
+	ExpiresAt        *time.Time `gorm:"index:api_key_expires_at_index"`
+	ExpiryRemindedAt *time.Time
+	LastUsedAt       *time.Time
+	AllowedCIDRs     pq.StringArray `gorm:"type:VARCHAR ARRAY;column:allowed_cidrs"`
+
 	EntityID   string `gorm:"type:UUID;index:api_key_entity_index;not null"`
 	EntityType string `gorm:"type:VARCHAR(32);index:api_key_entity_index;not null"`
 }
@@ -35,10 +45,17 @@ func init() {
 }
 
 func (k APIKey) toPB() *ttnpb.APIKey {
+	var allowedCIDRs []string
+	if len(k.AllowedCIDRs) > 0 {
+		allowedCIDRs = k.AllowedCIDRs
+	}
 	return &ttnpb.APIKey{
-		ID:     k.APIKeyID,
-		Key:    k.Key,
-		Name:   k.Name,
-		Rights: k.Rights.Rights,
+		ID:           k.APIKeyID,
+		Key:          k.Key,
+		Name:         k.Name,
+		Rights:       k.Rights.Rights,
+		ExpiresAt:    cleanTimePtr(k.ExpiresAt),
+		LastUsedAt:   cleanTimePtr(k.LastUsedAt),
+		AllowedCIDRs: allowedCIDRs,
 	}
 }
@@ -17,8 +17,11 @@ package store
 import (
 	"context"
 	"runtime/trace"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -39,12 +42,14 @@ func (s *apiKeyStore) CreateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 		return err
 	}
 	model := &APIKey{
-		APIKeyID:   key.ID,
-		Key:        key.Key,
-		Rights:     Rights{Rights: key.Rights},
-		Name:       key.Name,
-		EntityID:   entity.PrimaryKey(),
-		EntityType: entityTypeForID(entityID),
+		APIKeyID:     key.ID,
+		Key:          key.Key,
+		Rights:       Rights{Rights: key.Rights},
+		Name:         key.Name,
+		ExpiresAt:    cleanTimePtr(key.ExpiresAt),
+		AllowedCIDRs: pq.StringArray(key.AllowedCIDRs),
+		EntityID:     entity.PrimaryKey(),
+		EntityType:   entityTypeForID(entityID),
 	}
 	return s.createEntity(ctx, model)
 }
@@ -102,7 +107,7 @@ func (s *apiKeyStore) GetAPIKey(ctx context.Context, id string) (ttnpb.Identifie
 	return ids, keyModel.toPB(), nil
 }
 
-func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error) {
+func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey, fieldMask *types.FieldMask) (*ttnpb.APIKey, error) {
 	defer trace.StartRegion(ctx, "update api key").End()
 	entity, err := s.findEntity(ctx, entityID, "id")
 	if err != nil {
@@ -121,13 +126,89 @@ func (s *apiKeyStore) UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifie
 		}
 		return nil, err
 	}
-	if len(key.Rights) == 0 {
+	if ttnpb.HasAnyField(fieldMask.Paths, "rights") && len(key.Rights) == 0 {
 		return nil, query.Delete(&keyModel).Error
 	}
-	keyModel.Name = key.Name
-	keyModel.Rights = Rights{Rights: key.Rights}
-	if err = query.Select("name", "rights", "updated_at").Save(&keyModel).Error; err != nil {
+	columns := []string{"updated_at"}
+	for _, path := range fieldMask.Paths {
+		switch path {
+		case "name":
+			keyModel.Name = key.Name
+			columns = append(columns, "name")
+		case "rights":
+			keyModel.Rights = Rights{Rights: key.Rights}
+			columns = append(columns, "rights")
+		case "allowed_cidrs":
+			keyModel.AllowedCIDRs = pq.StringArray(key.AllowedCIDRs)
+			columns = append(columns, "allowed_cidrs")
+		case "expires_at":
+			if expiresAt := cleanTimePtr(key.ExpiresAt); !timePtrEqual(expiresAt, keyModel.ExpiresAt) {
+				keyModel.ExpiresAt, keyModel.ExpiryRemindedAt = expiresAt, nil
+				columns = append(columns, "expires_at", "expiry_reminded_at")
+			}
+		}
+	}
+	if err = query.Select(columns).Save(&keyModel).Error; err != nil {
 		return nil, err
 	}
 	return keyModel.toPB(), nil
 }
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func (s *apiKeyStore) SetAPIKeysLastUsed(ctx context.Context, lastUsed map[string]time.Time) error {
+	defer trace.StartRegion(ctx, "set api keys last used").End()
+	for id, t := range lastUsed {
+		err := s.query(ctx, APIKey{}).
+			Where(APIKey{APIKeyID: id}).
+			Where("last_used_at IS NULL OR last_used_at < ?", t).
+			UpdateColumn("last_used_at", cleanTime(t)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *apiKeyStore) FindExpiringAPIKeys(ctx context.Context, before time.Time) ([]ttnpb.Identifiers, []*ttnpb.APIKey, error) {
+	defer trace.StartRegion(ctx, "find expiring api keys").End()
+	var keyModels []APIKey
+	err := s.query(ctx, APIKey{}).
+		Where("expires_at > ? AND expires_at <= ? AND expiry_reminded_at IS NULL", cleanTime(time.Now()), cleanTime(before)).
+		Order("expires_at").
+		Find(&keyModels).Error
+	if err != nil {
+		return nil, nil, err
+	}
+	entities := make([]polymorphicEntity, len(keyModels))
+	for i, keyModel := range keyModels {
+		entities[i] = polymorphicEntity{EntityType: keyModel.EntityType, EntityUUID: keyModel.EntityID}
+	}
+	identifiers, err := s.findIdentifiers(entities...)
+	if err != nil {
+		return nil, nil, err
+	}
+	entityIDs := make([]ttnpb.Identifiers, 0, len(keyModels))
+	keyProtos := make([]*ttnpb.APIKey, 0, len(keyModels))
+	for i, keyModel := range keyModels {
+		ids, ok := identifiers[entities[i]]
+		if !ok {
+			continue // The entity was (soft-)deleted.
+		}
+		entityIDs = append(entityIDs, ids)
+		keyProtos = append(keyProtos, keyModel.toPB())
+	}
+	return entityIDs, keyProtos, nil
+}
+
+func (s *apiKeyStore) SetAPIKeyExpiryReminded(ctx context.Context, id string) error {
+	defer trace.StartRegion(ctx, "set api key expiry reminded").End()
+	return s.query(ctx, APIKey{}).
+		Where(APIKey{APIKeyID: id}).
+		UpdateColumn("expiry_reminded_at", cleanTime(time.Now())).Error
+}
@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
@@ -106,7 +108,7 @@ func TestAPIKeyStore(t *testing.T) {
 					ID:     strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
 					Name:   fmt.Sprintf("Updated %s API key", tt.Name),
 					Rights: tt.Rights,
-				})
+				}, &types.FieldMask{Paths: []string{"name", "rights"}})
 				a.So(err, should.BeNil)
 
 				ids, got, err = store.GetAPIKey(ctx, key.ID)
@@ -119,7 +121,7 @@ func TestAPIKeyStore(t *testing.T) {
 				updated, err = store.UpdateAPIKey(ctx, tt.Identifiers, &ttnpb.APIKey{
 					ID: strings.ToUpper(fmt.Sprintf("%sKEYID", tt.Name)),
 					// Empty rights
-				})
+				}, &types.FieldMask{Paths: []string{"rights"}})
 				a.So(err, should.BeNil)
 				a.So(updated, should.BeNil)
 
@@ -133,5 +135,80 @@ func TestAPIKeyStore(t *testing.T) {
 				a.So(keys, should.HaveLength, 0)
 			})
 		}
+
+		t.Run("Restrictions", func(t *testing.T) {
+			a := assertions.New(t)
+
+			now := cleanTime(time.Now())
+			expiresAt := now.Add(24 * time.Hour)
+			key := &ttnpb.APIKey{
+				ID:           "RESTRICTEDKEYID",
+				Key:          "RESTRICTEDKEY",
+				Name:         "Restricted API key",
+				Rights:       []ttnpb.Right{ttnpb.RIGHT_APPLICATION_ALL},
+				ExpiresAt:    &expiresAt,
+				AllowedCIDRs: []string{"192.0.2.0/24", "2001:db8::/32"},
+			}
+
+			err := store.CreateAPIKey(ctx, appIDs, key)
+			a.So(err, should.BeNil)
+
+			_, got, err := store.GetAPIKey(ctx, key.ID)
+			a.So(err, should.BeNil)
+			a.So(got, should.Resemble, key)
+
+			err = store.SetAPIKeysLastUsed(ctx, map[string]time.Time{key.ID: now})
+			a.So(err, should.BeNil)
+
+			_, got, err = store.GetAPIKey(ctx, key.ID)
+			a.So(err, should.BeNil)
+			if a.So(got.LastUsedAt, should.NotBeNil) {
+				a.So(*got.LastUsedAt, should.Equal, now)
+			}
+
+			ids, expiring, err := store.FindExpiringAPIKeys(ctx, now.Add(time.Hour))
+			a.So(err, should.BeNil)
+			a.So(expiring, should.BeEmpty)
+
+			ids, expiring, err = store.FindExpiringAPIKeys(ctx, now.Add(48*time.Hour))
+			a.So(err, should.BeNil)
+			if a.So(expiring, should.HaveLength, 1) {
+				a.So(expiring[0].ID, should.Equal, key.ID)
+				a.So(ids[0], should.Resemble, appIDs)
+			}
+
+			err = store.SetAPIKeyExpiryReminded(ctx, key.ID)
+			a.So(err, should.BeNil)
+
+			_, expiring, err = store.FindExpiringAPIKeys(ctx, now.Add(48*time.Hour))
+			a.So(err, should.BeNil)
+			a.So(expiring, should.BeEmpty)
+
+			expiresAt = now.Add(36 * time.Hour)
+			updated, err := store.UpdateAPIKey(ctx, appIDs, &ttnpb.APIKey{
+				ID:        key.ID,
+				ExpiresAt: &expiresAt,
+			}, &types.FieldMask{Paths: []string{"expires_at"}})
+			a.So(err, should.BeNil)
+			if a.So(updated, should.NotBeNil) {
+				a.So(*updated.ExpiresAt, should.Equal, expiresAt)
+				a.So(updated.Name, should.Equal, key.Name)
+				a.So(updated.Rights, should.Resemble, key.Rights)
+				a.So(updated.AllowedCIDRs, should.Resemble, key.AllowedCIDRs)
+			}
+
+			_, expiring, err = store.FindExpiringAPIKeys(ctx, now.Add(48*time.Hour))
+			a.So(err, should.BeNil)
+			a.So(expiring, should.HaveLength, 1)
+
+			updated, err = store.UpdateAPIKey(ctx, appIDs, &ttnpb.APIKey{
+				ID: key.ID,
+			}, &types.FieldMask{Paths: []string{"allowed_cidrs"}})
+			a.So(err, should.BeNil)
+			if a.So(updated, should.NotBeNil) {
+				a.So(*updated.ExpiresAt, should.Equal, expiresAt)
+				a.So(updated.AllowedCIDRs, should.BeEmpty)
+			}
+		})
 	})
 }
@@ -45,7 +45,7 @@ func selectApplicationFields(ctx context.Context, query *gorm.DB, fieldMask *typ
 	var notFoundPaths []string
 	for _, path := range ttnpb.TopLevelFields(fieldMask.Paths) {
 		switch path {
-		case "ids", "created_at", "updated_at":
+		case "ids", "created_at", "updated_at", "deleted_at":
 			// always selected
 		case attributesField:
 			query = query.Preload("Attributes")
@@ -60,7 +60,7 @@ func selectApplicationFields(ctx context.Context, query *gorm.DB, fieldMask *typ
 	if len(notFoundPaths) > 0 {
 		warning.Add(ctx, fmt.Sprintf("unsupported field mask paths: %s", strings.Join(notFoundPaths, ", ")))
 	}
-	return query.Select(cleanFields(append(append(modelColumns, "application_id"), applicationColumns...)...))
+	return query.Select(cleanFields(append(append(modelColumns, "deleted_at", "application_id"), applicationColumns...)...))
 }
 
 func (s *applicationStore) CreateApplication(ctx context.Context, app *ttnpb.Application) (*ttnpb.Application, error) {
@@ -83,7 +83,7 @@ func (s *applicationStore) FindApplications(ctx context.Context, ids []*ttnpb.Ap
 	for i, id := range ids {
 		idStrings[i] = id.GetApplicationID()
 	}
-	query := s.query(ctx, Application{}, withApplicationID(idStrings...))
+	query := s.query(ctx, Application{}, withApplicationID(idStrings...), withOnlyDeleted(ctx, "applications"))
 	query = selectApplicationFields(ctx, query, fieldMask)
 	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
 		countTotal(ctx, query.Model(&Application{}))
@@ -153,3 +153,32 @@ func (s *applicationStore) DeleteApplication(ctx context.Context, id *ttnpb.Appl
 	defer trace.StartRegion(ctx, "delete application").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *applicationStore) RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore application").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *applicationStore) PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge application").End()
+	model, err := s.findDeletedEntity(ctx, id)
+	if err != nil {
+		return err
+	}
+	var deviceUUIDs []string
+	if err = s.query(ctx, EndDevice{}, withApplicationID(id.GetApplicationID())).Pluck("id", &deviceUUIDs).Error; err != nil {
+		return err
+	}
+	if len(deviceUUIDs) > 0 {
+		if err = s.purgeEntityAssociations("device", deviceUUIDs...); err != nil {
+			return err
+		}
+		if err = s.DB.Where("end_device_id IN (?)", deviceUUIDs).Delete(&EndDeviceLocation{}).Error; err != nil {
+			return err
+		}
+		if err = s.DB.Where("id IN (?)", deviceUUIDs).Delete(&EndDevice{}).Error; err != nil {
+			return err
+		}
+	}
+	return s.purgeEntity("application", model)
+}
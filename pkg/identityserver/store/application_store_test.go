@@ -32,7 +32,7 @@ func TestApplicationStore(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &Application{}, &Attribute{})
+		prepareTest(db, &Application{}, &Attribute{}, &EndDevice{}, &EndDeviceLocation{})
 		store := GetApplicationStore(db)
 
 		created, err := store.CreateApplication(ctx, &ttnpb.Application{
@@ -111,5 +111,33 @@ func TestApplicationStore(t *testing.T) {
 		list, err = store.FindApplications(ctx, nil, nil)
 		a.So(err, should.BeNil)
 		a.So(list, should.BeEmpty)
+
+		list, err = store.FindApplications(WithSoftDeleted(ctx, true), nil, nil)
+		a.So(err, should.BeNil)
+		if a.So(list, should.HaveLength, 1) {
+			a.So(list[0].DeletedAt, should.NotBeNil)
+		}
+
+		err = store.RestoreApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		a.So(err, should.BeNil)
+
+		got, err = store.GetApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, nil)
+		a.So(err, should.BeNil)
+		a.So(got.DeletedAt, should.BeNil)
+
+		err = store.PurgeApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		err = store.DeleteApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		a.So(err, should.BeNil)
+
+		err = store.PurgeApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"})
+		a.So(err, should.BeNil)
+
+		list, err = store.FindApplications(WithSoftDeleted(ctx, false), nil, nil)
+		a.So(err, should.BeNil)
+		a.So(list, should.BeEmpty)
 	})
 }
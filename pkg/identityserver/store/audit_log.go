@@ -0,0 +1,107 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// AuditLogEntry model.
+// Entities are referenced by their type and ID string instead of their primary key,
+// so that entries remain available after the entities are deleted.
+type AuditLogEntry struct {
+	Model
+
+	EntityType string `gorm:"type:VARCHAR(32);index:audit_log_entry_entity_index"`
+	EntityID   string `gorm:"type:VARCHAR;index:audit_log_entry_entity_index"`
+
+	RelatedType string `gorm:"type:VARCHAR(32)"`
+	RelatedID   string `gorm:"type:VARCHAR"`
+
+	Operation string         `gorm:"type:VARCHAR;not null"`
+	Paths     pq.StringArray `gorm:"type:VARCHAR ARRAY"`
+
+	ActorType     string `gorm:"type:VARCHAR(32)"`
+	ActorID       string `gorm:"type:VARCHAR"`
+	ActorAPIKeyID string `gorm:"type:VARCHAR;column:actor_api_key_id"`
+
+	SourceIP string `gorm:"type:VARCHAR(64);column:source_ip"`
+}
+
+func init() {
+	registerModel(&AuditLogEntry{})
+}
+
+// auditLogIDs returns the type and ID string of the entity, or empty strings if ids is nil.
+func auditLogIDs(ids *ttnpb.EntityIdentifiers) (entityType, id string) {
+	if ids == nil || ids.Ids == nil {
+		return "", ""
+	}
+	return entityTypeForID(ids), ids.IDString()
+}
+
+// auditLogEntityIdentifiers is the inverse of auditLogIDs.
+func auditLogEntityIdentifiers(entityType, id string) *ttnpb.EntityIdentifiers {
+	switch entityType {
+	case "application":
+		return ttnpb.ApplicationIdentifiers{ApplicationID: id}.EntityIdentifiers()
+	case "client":
+		return ttnpb.ClientIdentifiers{ClientID: id}.EntityIdentifiers()
+	case "end_device":
+		parts := strings.SplitN(id, ".", 2)
+		if len(parts) != 2 {
+			return nil
+		}
+		return ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: parts[0]},
+			DeviceID:               parts[1],
+		}.EntityIdentifiers()
+	case "gateway":
+		return ttnpb.GatewayIdentifiers{GatewayID: id}.EntityIdentifiers()
+	case "organization":
+		return ttnpb.OrganizationIdentifiers{OrganizationID: id}.EntityIdentifiers()
+	case "user":
+		return ttnpb.UserIdentifiers{UserID: id}.EntityIdentifiers()
+	default:
+		return nil
+	}
+}
+
+func (e AuditLogEntry) toPB() *ttnpb.AuditLogEntry {
+	return &ttnpb.AuditLogEntry{
+		ID:            e.ID,
+		CreatedAt:     cleanTime(e.CreatedAt),
+		EntityIDs:     auditLogEntityIdentifiers(e.EntityType, e.EntityID),
+		RelatedIDs:    auditLogEntityIdentifiers(e.RelatedType, e.RelatedID),
+		Operation:     e.Operation,
+		Paths:         e.Paths,
+		ActorIDs:      auditLogEntityIdentifiers(e.ActorType, e.ActorID),
+		ActorAPIKeyID: e.ActorAPIKeyID,
+		SourceIP:      e.SourceIP,
+	}
+}
+
+func (e *AuditLogEntry) fromPB(pb *ttnpb.AuditLogEntry) {
+	e.EntityType, e.EntityID = auditLogIDs(pb.EntityIDs)
+	e.RelatedType, e.RelatedID = auditLogIDs(pb.RelatedIDs)
+	e.Operation = pb.Operation
+	e.Paths = pq.StringArray(pb.Paths)
+	e.ActorType, e.ActorID = auditLogIDs(pb.ActorIDs)
+	e.ActorAPIKeyID = pb.ActorAPIKeyID
+	e.SourceIP = pb.SourceIP
+}
@@ -0,0 +1,66 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetAuditLogStore returns an AuditLogStore on the given db (or transaction).
+func GetAuditLogStore(db *gorm.DB) AuditLogStore {
+	return &auditLogStore{store: newStore(db)}
+}
+
+type auditLogStore struct {
+	*store
+}
+
+func (s *auditLogStore) CreateEntry(ctx context.Context, entry *ttnpb.AuditLogEntry) (*ttnpb.AuditLogEntry, error) {
+	defer trace.StartRegion(ctx, "create audit log entry").End()
+	var model AuditLogEntry
+	model.fromPB(entry)
+	if err := s.createEntity(ctx, &model); err != nil {
+		return nil, convertError(err)
+	}
+	return model.toPB(), nil
+}
+
+func (s *auditLogStore) FindEntries(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.AuditLogEntry, error) {
+	defer trace.StartRegion(ctx, "find audit log entries").End()
+	query := s.query(ctx, AuditLogEntry{})
+	if entityID != nil {
+		entityType, id := auditLogIDs(entityID)
+		query = query.Where(AuditLogEntry{EntityType: entityType, EntityID: id})
+	}
+	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
+		countTotal(ctx, query.Model(&AuditLogEntry{}))
+		query = query.Limit(limit).Offset(offset)
+	}
+	query = query.Order("created_at DESC")
+	var models []AuditLogEntry
+	if err := query.Find(&models).Error; err != nil {
+		return nil, err
+	}
+	setTotal(ctx, uint64(len(models)))
+	pb := make([]*ttnpb.AuditLogEntry, len(models))
+	for i, model := range models {
+		pb[i] = model.toPB()
+	}
+	return pb, nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestAuditLogStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &AuditLogEntry{})
+
+		store := GetAuditLogStore(db)
+
+		gtwIDs := ttnpb.GatewayIdentifiers{GatewayID: "foo-gtw"}
+		devIDs := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+			DeviceID:               "foo-dev",
+		}
+		userIDs := ttnpb.UserIdentifiers{UserID: "foo-usr"}
+
+		created, err := store.CreateEntry(ctx, &ttnpb.AuditLogEntry{
+			EntityIDs:     gtwIDs.EntityIdentifiers(),
+			RelatedIDs:    userIDs.EntityIdentifiers(),
+			Operation:     "gateway.collaborator.update",
+			ActorIDs:      userIDs.EntityIdentifiers(),
+			ActorAPIKeyID: "KEYID",
+			SourceIP:      "192.0.2.1",
+		})
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.ID, should.NotBeEmpty)
+			a.So(created.CreatedAt, should.NotBeZeroValue)
+		}
+
+		_, err = store.CreateEntry(ctx, &ttnpb.AuditLogEntry{
+			EntityIDs: devIDs.EntityIdentifiers(),
+			Operation: "end_device.update",
+			Paths:     []string{"name", "description"},
+			ActorIDs:  userIDs.EntityIdentifiers(),
+		})
+		a.So(err, should.BeNil)
+
+		entries, err := store.FindEntries(ctx, gtwIDs.EntityIdentifiers())
+		a.So(err, should.BeNil)
+		if a.So(entries, should.HaveLength, 1) {
+			a.So(entries[0].EntityIDs, should.Resemble, gtwIDs.EntityIdentifiers())
+			a.So(entries[0].RelatedIDs, should.Resemble, userIDs.EntityIdentifiers())
+			a.So(entries[0].Operation, should.Equal, "gateway.collaborator.update")
+			a.So(entries[0].ActorIDs, should.Resemble, userIDs.EntityIdentifiers())
+			a.So(entries[0].ActorAPIKeyID, should.Equal, "KEYID")
+			a.So(entries[0].SourceIP, should.Equal, "192.0.2.1")
+		}
+
+		entries, err = store.FindEntries(ctx, devIDs.EntityIdentifiers())
+		a.So(err, should.BeNil)
+		if a.So(entries, should.HaveLength, 1) {
+			a.So(entries[0].EntityIDs, should.Resemble, devIDs.EntityIdentifiers())
+			a.So(entries[0].RelatedIDs, should.BeNil)
+			a.So(entries[0].Paths, should.Resemble, []string{"name", "description"})
+		}
+
+		var total uint64
+		entries, err = store.FindEntries(WithPagination(ctx, 1, 1, &total), nil)
+		a.So(err, should.BeNil)
+		a.So(entries, should.HaveLength, 1)
+		a.So(total, should.Equal, 2)
+	})
+}
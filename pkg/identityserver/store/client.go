@@ -111,6 +111,7 @@ func (cli Client) toPB(pb *ttnpb.Client, fieldMask *types.FieldMask) {
 	pb.ClientIdentifiers.ClientID = cli.ClientID
 	pb.CreatedAt = cleanTime(cli.CreatedAt)
 	pb.UpdatedAt = cleanTime(cli.UpdatedAt)
+	pb.DeletedAt = cleanTimePtr(cli.DeletedAt)
 	if fieldMask == nil || len(fieldMask.Paths) == 0 {
 		fieldMask = defaultClientFieldMask
 	}
@@ -45,7 +45,7 @@ func selectClientFields(ctx context.Context, query *gorm.DB, fieldMask *types.Fi
 	var notFoundPaths []string
 	for _, path := range ttnpb.TopLevelFields(fieldMask.Paths) {
 		switch path {
-		case "ids", "created_at", "updated_at":
+		case "ids", "created_at", "updated_at", "deleted_at":
 			// always selected
 		case attributesField:
 			query = query.Preload("Attributes")
@@ -60,7 +60,7 @@ func selectClientFields(ctx context.Context, query *gorm.DB, fieldMask *types.Fi
 	if len(notFoundPaths) > 0 {
 		warning.Add(ctx, fmt.Sprintf("unsupported field mask paths: %s", strings.Join(notFoundPaths, ", ")))
 	}
-	return query.Select(cleanFields(append(append(modelColumns, "client_id"), clientColumns...)...))
+	return query.Select(cleanFields(append(append(modelColumns, "deleted_at", "client_id"), clientColumns...)...))
 }
 
 func (s *clientStore) CreateClient(ctx context.Context, cli *ttnpb.Client) (*ttnpb.Client, error) {
@@ -83,7 +83,7 @@ func (s *clientStore) FindClients(ctx context.Context, ids []*ttnpb.ClientIdenti
 	for i, id := range ids {
 		idStrings[i] = id.GetClientID()
 	}
-	query := s.query(ctx, Client{}, withClientID(idStrings...))
+	query := s.query(ctx, Client{}, withClientID(idStrings...), withOnlyDeleted(ctx, "clients"))
 	query = selectClientFields(ctx, query, fieldMask)
 	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
 		countTotal(ctx, query.Model(Client{}))
@@ -153,3 +153,22 @@ func (s *clientStore) DeleteClient(ctx context.Context, id *ttnpb.ClientIdentifi
 	defer trace.StartRegion(ctx, "delete client").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *clientStore) RestoreClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore client").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *clientStore) PurgeClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge client").End()
+	model, err := s.findDeletedEntity(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, related := range []interface{}{&ClientAuthorization{}, &AuthorizationCode{}, &AccessToken{}} {
+		if err = s.DB.Where("client_id = ?", model.PrimaryKey()).Delete(related).Error; err != nil {
+			return err
+		}
+	}
+	return s.purgeEntity("client", model)
+}
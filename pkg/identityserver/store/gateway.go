@@ -18,6 +18,7 @@ import (
 	"sort"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -47,7 +48,8 @@ type Gateway struct {
 	AutoUpdate    bool   `gorm:"not null"`
 	UpdateChannel string `gorm:"type:VARCHAR"`
 
-	FrequencyPlanID string `gorm:"type:VARCHAR"`
+	FrequencyPlanID  string         `gorm:"type:VARCHAR"`
+	FrequencyPlanIDs pq.StringArray `gorm:"type:VARCHAR ARRAY;column:frequency_plan_ids"`
 
 	StatusPublic   bool `gorm:"not null"`
 	LocationPublic bool `gorm:"not null"`
@@ -85,6 +87,16 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	autoUpdateField:           func(pb *ttnpb.Gateway, gtw *Gateway) { pb.AutoUpdate = gtw.AutoUpdate },
 	updateChannelField:        func(pb *ttnpb.Gateway, gtw *Gateway) { pb.UpdateChannel = gtw.UpdateChannel },
 	frequencyPlanIDField:      func(pb *ttnpb.Gateway, gtw *Gateway) { pb.FrequencyPlanID = gtw.FrequencyPlanID },
+	frequencyPlanIDsField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		switch {
+		case len(gtw.FrequencyPlanIDs) > 0:
+			pb.FrequencyPlanIDs = []string(gtw.FrequencyPlanIDs)
+		case gtw.FrequencyPlanID != "":
+			pb.FrequencyPlanIDs = []string{gtw.FrequencyPlanID}
+		default:
+			pb.FrequencyPlanIDs = nil
+		}
+	},
 	statusPublicField:         func(pb *ttnpb.Gateway, gtw *Gateway) { pb.StatusPublic = gtw.StatusPublic },
 	locationPublicField:       func(pb *ttnpb.Gateway, gtw *Gateway) { pb.LocationPublic = gtw.LocationPublic },
 	scheduleDownlinkLateField: func(pb *ttnpb.Gateway, gtw *Gateway) { pb.ScheduleDownlinkLate = gtw.ScheduleDownlinkLate },
@@ -115,14 +127,25 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 		gtw.HardwareVersion = pb.HardwareVersion
 		gtw.FirmwareVersion = pb.FirmwareVersion
 	},
-	brandIDField:                func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.BrandID = pb.BrandID },
-	modelIDField:                func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.ModelID = pb.ModelID },
-	hardwareVersionField:        func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.HardwareVersion = pb.HardwareVersion },
-	firmwareVersionField:        func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.FirmwareVersion = pb.FirmwareVersion },
-	gatewayServerAddressField:   func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.GatewayServerAddress = pb.GatewayServerAddress },
-	autoUpdateField:             func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.AutoUpdate = pb.AutoUpdate },
-	updateChannelField:          func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.UpdateChannel = pb.UpdateChannel },
-	frequencyPlanIDField:        func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.FrequencyPlanID = pb.FrequencyPlanID },
+	brandIDField:              func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.BrandID = pb.BrandID },
+	modelIDField:              func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.ModelID = pb.ModelID },
+	hardwareVersionField:      func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.HardwareVersion = pb.HardwareVersion },
+	firmwareVersionField:      func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.FirmwareVersion = pb.FirmwareVersion },
+	gatewayServerAddressField: func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.GatewayServerAddress = pb.GatewayServerAddress },
+	autoUpdateField:           func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.AutoUpdate = pb.AutoUpdate },
+	updateChannelField:        func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.UpdateChannel = pb.UpdateChannel },
+	frequencyPlanIDField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.FrequencyPlanID = pb.FrequencyPlanID
+		gtw.FrequencyPlanIDs = nil
+	},
+	frequencyPlanIDsField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.FrequencyPlanIDs = pq.StringArray(pb.FrequencyPlanIDs)
+		if len(pb.FrequencyPlanIDs) > 0 {
+			gtw.FrequencyPlanID = pb.FrequencyPlanIDs[0]
+		} else {
+			gtw.FrequencyPlanID = pb.FrequencyPlanID
+		}
+	},
 	statusPublicField:           func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.StatusPublic = pb.StatusPublic },
 	locationPublicField:         func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.LocationPublic = pb.LocationPublic },
 	scheduleDownlinkLateField:   func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.ScheduleDownlinkLate = pb.ScheduleDownlinkLate },
@@ -170,7 +193,8 @@ var gatewayColumnNames = map[string][]string{
 	firmwareVersionField:        {"firmware_version"},
 	autoUpdateField:             {autoUpdateField},
 	updateChannelField:          {updateChannelField},
-	frequencyPlanIDField:        {frequencyPlanIDField},
+	frequencyPlanIDField:        {frequencyPlanIDField, frequencyPlanIDsField},
+	frequencyPlanIDsField:       {frequencyPlanIDField, frequencyPlanIDsField},
 	statusPublicField:           {statusPublicField},
 	locationPublicField:         {locationPublicField},
 	scheduleDownlinkLateField:   {scheduleDownlinkLateField},
@@ -184,6 +208,7 @@ func (gtw Gateway) toPB(pb *ttnpb.Gateway, fieldMask *pbtypes.FieldMask) {
 	pb.GatewayIdentifiers.EUI = gtw.GatewayEUI.toPB() // Always present.
 	pb.CreatedAt = cleanTime(gtw.CreatedAt)
 	pb.UpdatedAt = cleanTime(gtw.UpdatedAt)
+	pb.DeletedAt = cleanTimePtr(gtw.DeletedAt)
 	if fieldMask == nil || len(fieldMask.Paths) == 0 {
 		fieldMask = defaultGatewayFieldMask
 	}
@@ -45,7 +45,7 @@ func selectGatewayFields(ctx context.Context, query *gorm.DB, fieldMask *types.F
 	var notFoundPaths []string
 	for _, path := range ttnpb.TopLevelFields(fieldMask.Paths) {
 		switch path {
-		case "ids", "created_at", "updated_at":
+		case "ids", "created_at", "updated_at", "deleted_at":
 			// always selected
 		case attributesField:
 			query = query.Preload("Attributes")
@@ -62,7 +62,7 @@ func selectGatewayFields(ctx context.Context, query *gorm.DB, fieldMask *types.F
 	if len(notFoundPaths) > 0 {
 		warning.Add(ctx, fmt.Sprintf("unsupported field mask paths: %s", strings.Join(notFoundPaths, ", ")))
 	}
-	return query.Select(cleanFields(append(append(modelColumns, "gateway_id", "gateway_eui"), gatewayColumns...)...))
+	return query.Select(cleanFields(append(append(modelColumns, "deleted_at", "gateway_id", "gateway_eui"), gatewayColumns...)...))
 }
 
 func (s *gatewayStore) CreateGateway(ctx context.Context, gtw *ttnpb.Gateway) (*ttnpb.Gateway, error) {
@@ -85,7 +85,7 @@ func (s *gatewayStore) FindGateways(ctx context.Context, ids []*ttnpb.GatewayIde
 	for i, id := range ids {
 		idStrings[i] = id.GetGatewayID()
 	}
-	query := s.query(ctx, Gateway{}, withGatewayID(idStrings...))
+	query := s.query(ctx, Gateway{}, withGatewayID(idStrings...), withOnlyDeleted(ctx, "gateways"))
 	query = selectGatewayFields(ctx, query, fieldMask)
 	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
 		countTotal(ctx, query.Model(Gateway{}))
@@ -163,3 +163,20 @@ func (s *gatewayStore) DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdent
 	defer trace.StartRegion(ctx, "delete gateway").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *gatewayStore) RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore gateway").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *gatewayStore) PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge gateway").End()
+	model, err := s.findDeletedEntity(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = s.DB.Where(&GatewayAntenna{GatewayID: model.PrimaryKey()}).Delete(&GatewayAntenna{}).Error; err != nil {
+		return err
+	}
+	return s.purgeEntity("gateway", model)
+}
@@ -134,6 +134,28 @@ func TestGatewayStore(t *testing.T) {
 		a.So(updated.Antennas, should.HaveLength, 0)
 		a.So(err, should.BeNil)
 
+		updated, err = store.UpdateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo"},
+			FrequencyPlanIDs:   []string{"US_902_928_FSB_1", "US_902_928_FSB_2"},
+		}, &pbtypes.FieldMask{Paths: []string{"frequency_plan_ids"}})
+		a.So(err, should.BeNil)
+
+		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, &pbtypes.FieldMask{Paths: []string{"frequency_plan_id", "frequency_plan_ids"}})
+		a.So(err, should.BeNil)
+		a.So(got.FrequencyPlanID, should.Equal, "US_902_928_FSB_1")
+		a.So(got.FrequencyPlanIDs, should.Resemble, []string{"US_902_928_FSB_1", "US_902_928_FSB_2"})
+
+		updated, err = store.UpdateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo"},
+			FrequencyPlanID:    "EU_863_870",
+		}, &pbtypes.FieldMask{Paths: []string{"frequency_plan_id"}})
+		a.So(err, should.BeNil)
+
+		got, err = store.GetGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"}, &pbtypes.FieldMask{Paths: []string{"frequency_plan_id", "frequency_plan_ids"}})
+		a.So(err, should.BeNil)
+		a.So(got.FrequencyPlanID, should.Equal, "EU_863_870")
+		a.So(got.FrequencyPlanIDs, should.Resemble, []string{"EU_863_870"})
+
 		err = store.DeleteGateway(ctx, &ttnpb.GatewayIdentifiers{GatewayID: "foo"})
 		a.So(err, should.BeNil)
 
@@ -43,12 +43,14 @@ func (s *membershipStore) FindMemberships(ctx context.Context, id *ttnpb.Organiz
 	if entityType == "organization" {
 		query = query.Table("accounts").
 			Select(`DISTINCT "accounts"."uid" AS "friendly_id"`).
-			Joins(fmt.Sprintf(`JOIN "memberships" ON "memberships"."entity_type" = '%s' AND "memberships"."entity_id" = "accounts"."account_id"`, entityType))
+			Joins(fmt.Sprintf(`JOIN "memberships" ON "memberships"."entity_type" = '%s' AND "memberships"."entity_id" = "accounts"."account_id"`, entityType)).
+			Joins(`JOIN "organizations" ON "organizations"."id" = "accounts"."account_id"`)
 	} else {
 		query = query.
 			Select(fmt.Sprintf(`DISTINCT "%[1]ss"."%[1]s_id" AS "friendly_id"`, entityType)).
 			Joins(fmt.Sprintf(`JOIN "memberships" ON "memberships"."entity_type" = '%[1]s' AND "memberships"."entity_id" = "%[1]ss"."id"`, entityType))
 	}
+	query = query.Scopes(withOnlyDeleted(ctx, entityType+"s"))
 	query = query.Order(`"friendly_id"`).
 		Where(fmt.Sprintf(`"memberships"."entity_type" = '%s' AND "memberships"."account_id" = (?)`, entityType), accountQuery)
 	if includeIndirect && id.EntityType() == "user" {
@@ -17,6 +17,7 @@ package store
 import (
 	"time"
 
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -64,16 +65,21 @@ type AuthorizationCode struct {
 	RedirectURI string `gorm:"type:VARCHAR;column:redirect_uri"`
 	State       string `gorm:"type:VARCHAR"`
 	ExpiresAt   time.Time
+
+	OpenIDScopes pq.StringArray `gorm:"type:VARCHAR ARRAY;column:openid_scopes"`
+	Nonce        string         `gorm:"type:VARCHAR"`
 }
 
 func (a AuthorizationCode) toPB() *ttnpb.OAuthAuthorizationCode {
 	pb := &ttnpb.OAuthAuthorizationCode{
-		Rights:      a.Rights.Rights,
-		Code:        a.Code,
-		RedirectURI: a.RedirectURI,
-		State:       a.State,
-		CreatedAt:   cleanTime(a.CreatedAt),
-		ExpiresAt:   cleanTime(a.ExpiresAt),
+		Rights:       a.Rights.Rights,
+		Code:         a.Code,
+		RedirectURI:  a.RedirectURI,
+		State:        a.State,
+		CreatedAt:    cleanTime(a.CreatedAt),
+		ExpiresAt:    cleanTime(a.ExpiresAt),
+		OpenIDScopes: a.OpenIDScopes,
+		Nonce:        a.Nonce,
 	}
 	if a.Client != nil {
 		pb.ClientIDs.ClientID = a.Client.ClientID
@@ -105,6 +111,8 @@ type AccessToken struct {
 	RefreshToken string `gorm:"type:VARCHAR;not null"`
 
 	ExpiresAt time.Time
+
+	OpenIDScopes pq.StringArray `gorm:"type:VARCHAR ARRAY;column:openid_scopes"`
 }
 
 func (a AccessToken) toPB() *ttnpb.OAuthAccessToken {
@@ -115,6 +123,7 @@ func (a AccessToken) toPB() *ttnpb.OAuthAccessToken {
 		RefreshToken: a.RefreshToken,
 		CreatedAt:    cleanTime(a.CreatedAt),
 		ExpiresAt:    cleanTime(a.ExpiresAt),
+		OpenIDScopes: a.OpenIDScopes,
 	}
 	if a.Client != nil {
 		pb.ClientIDs.ClientID = a.Client.ClientID
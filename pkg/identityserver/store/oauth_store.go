@@ -19,6 +19,7 @@ import (
 	"runtime/trace"
 
 	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -144,13 +145,15 @@ func (s *oauthStore) CreateAuthorizationCode(ctx context.Context, code *ttnpb.OA
 		return err
 	}
 	codeModel := AuthorizationCode{
-		ClientID:    client.PrimaryKey(),
-		UserID:      user.PrimaryKey(),
-		Rights:      Rights{Rights: code.Rights},
-		Code:        code.Code,
-		RedirectURI: code.RedirectURI,
-		State:       code.State,
-		ExpiresAt:   code.ExpiresAt,
+		ClientID:     client.PrimaryKey(),
+		UserID:       user.PrimaryKey(),
+		Rights:       Rights{Rights: code.Rights},
+		Code:         code.Code,
+		RedirectURI:  code.RedirectURI,
+		State:        code.State,
+		ExpiresAt:    code.ExpiresAt,
+		OpenIDScopes: pq.StringArray(code.OpenIDScopes),
+		Nonce:        code.Nonce,
 	}
 	codeModel.CreatedAt = cleanTime(code.CreatedAt)
 	return s.createEntity(ctx, &codeModel)
@@ -209,6 +212,7 @@ func (s *oauthStore) CreateAccessToken(ctx context.Context, token *ttnpb.OAuthAc
 		AccessToken:  token.AccessToken,
 		RefreshToken: token.RefreshToken,
 		ExpiresAt:    token.ExpiresAt,
+		OpenIDScopes: pq.StringArray(token.OpenIDScopes),
 	}
 	tokenModel.CreatedAt = cleanTime(token.CreatedAt)
 	return s.createEntity(ctx, &tokenModel)
@@ -129,12 +129,14 @@ func TestOAuthStore(t *testing.T) {
 			start := time.Now()
 
 			err = store.CreateAuthorizationCode(ctx, &ttnpb.OAuthAuthorizationCode{
-				ClientIDs:   *clientIDs,
-				UserIDs:     *userIDs,
-				Rights:      rights,
-				Code:        code,
-				RedirectURI: redirectURI,
-				State:       state,
+				ClientIDs:    *clientIDs,
+				UserIDs:      *userIDs,
+				Rights:       rights,
+				Code:         code,
+				RedirectURI:  redirectURI,
+				State:        state,
+				OpenIDScopes: []string{"openid", "email"},
+				Nonce:        "nonce",
 			})
 
 			a.So(err, should.BeNil)
@@ -148,7 +150,10 @@ func TestOAuthStore(t *testing.T) {
 			a.So(got.Code, should.Equal, code)
 			a.So(got.RedirectURI, should.Equal, redirectURI)
 			a.So(got.State, should.Equal, state)
+			a.So(got.OpenIDScopes, should.Resemble, []string{"openid", "email"})
+			a.So(got.Nonce, should.Equal, "nonce")
 			a.So(got.CreatedAt, should.HappenAfter, start)
+			a.So(got.OpenIDScopes, should.Resemble, []string{"openid", "profile"})
 			a.So(got.Rights, should.HaveLength, len(rights))
 
 			for _, right := range rights {
@@ -200,6 +205,7 @@ func TestOAuthStore(t *testing.T) {
 				AccessToken:  access,
 				RefreshToken: refresh,
 				Rights:       rights,
+				OpenIDScopes: []string{"openid", "profile"},
 			}, prevID)
 
 			a.So(err, should.BeNil)
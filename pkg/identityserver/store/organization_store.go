@@ -44,12 +44,12 @@ func selectOrganizationFields(ctx context.Context, query *gorm.DB, fieldMask *ty
 	}
 	var organizationColumns []string
 	var notFoundPaths []string
-	for _, column := range modelColumns {
+	for _, column := range append(modelColumns, "deleted_at") {
 		organizationColumns = append(organizationColumns, "organizations."+column)
 	}
 	for _, path := range ttnpb.TopLevelFields(fieldMask.Paths) {
 		switch path {
-		case "ids", "created_at", "updated_at":
+		case "ids", "created_at", "updated_at", "deleted_at":
 			// always selected
 		case attributesField:
 			query = query.Preload("Attributes")
@@ -87,7 +87,7 @@ func (s *organizationStore) FindOrganizations(ctx context.Context, ids []*ttnpb.
 	for i, id := range ids {
 		idStrings[i] = id.GetOrganizationID()
 	}
-	query := s.query(ctx, Organization{}, withOrganizationID(idStrings...))
+	query := s.query(ctx, Organization{}, withOrganizationID(idStrings...), withOnlyDeleted(ctx, "organizations"))
 	query = selectOrganizationFields(ctx, query, fieldMask)
 	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
 		countTotal(ctx, query.Model(Organization{}))
@@ -157,3 +157,20 @@ func (s *organizationStore) DeleteOrganization(ctx context.Context, id *ttnpb.Or
 	defer trace.StartRegion(ctx, "delete organization").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *organizationStore) RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore organization").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *organizationStore) PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge organization").End()
+	model, err := s.findDeletedEntity(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = s.purgeAccount("organization", model.PrimaryKey()); err != nil {
+		return err
+	}
+	return s.purgeEntity("organization", model)
+}
@@ -260,7 +260,7 @@ func (p *Populator) populateOrganizations(ctx context.Context, db *gorm.DB) (err
 func (p *Populator) populateUsers(ctx context.Context, db *gorm.DB) (err error) {
 	for i, user := range p.Users {
 		password := user.Password
-		hashedPassword, _ := auth.Hash(ctx, user.Password)
+		hashedPassword, _ := auth.HashPassword(ctx, user.Password)
 		user.Password = hashedPassword
 		p.Users[i], err = GetUserStore(db).CreateUser(ctx, user)
 		if err != nil {
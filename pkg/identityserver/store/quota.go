@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Quota model. Columns that are NULL are not overridden.
+type Quota struct {
+	Model
+
+	EntityID   string `gorm:"type:UUID;unique_index:quota_entity_index;not null"`
+	EntityType string `gorm:"type:VARCHAR(32);unique_index:quota_entity_index;not null"`
+
+	Applications  *int
+	Gateways      *int
+	EndDevices    *int
+	APIKeys       *int `gorm:"column:api_keys"`
+	Collaborators *int
+}
+
+// Ownership model. The entity counts towards the quota of the account that owns it.
+type Ownership struct {
+	Model
+
+	AccountID  string `gorm:"type:UUID;index:ownership_account_index;not null"`
+	EntityID   string `gorm:"type:UUID;unique_index:ownership_entity_index;not null"`
+	EntityType string `gorm:"type:VARCHAR(32);unique_index:ownership_entity_index;not null"`
+}
+
+func init() {
+	registerModel(&Quota{}, &Ownership{})
+}
+
+func quotaValueToPB(v *int) *types.UInt32Value {
+	if v == nil {
+		return nil
+	}
+	return &types.UInt32Value{Value: uint32(*v)}
+}
+
+func quotaValueFromPB(v *types.UInt32Value) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(v.Value)
+	return &i
+}
+
+func (q Quota) toPB() *ttnpb.QuotaOverride {
+	return &ttnpb.QuotaOverride{
+		Applications:  quotaValueToPB(q.Applications),
+		Gateways:      quotaValueToPB(q.Gateways),
+		EndDevices:    quotaValueToPB(q.EndDevices),
+		APIKeys:       quotaValueToPB(q.APIKeys),
+		Collaborators: quotaValueToPB(q.Collaborators),
+	}
+}
+
+func (q *Quota) fromPB(pb *ttnpb.QuotaOverride) {
+	q.Applications = quotaValueFromPB(pb.Applications)
+	q.Gateways = quotaValueFromPB(pb.Gateways)
+	q.EndDevices = quotaValueFromPB(pb.EndDevices)
+	q.APIKeys = quotaValueFromPB(pb.APIKeys)
+	q.Collaborators = quotaValueFromPB(pb.Collaborators)
+}
@@ -0,0 +1,150 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// GetQuotaStore returns a QuotaStore on the given db (or transaction).
+func GetQuotaStore(db *gorm.DB) QuotaStore {
+	return &quotaStore{store: newStore(db)}
+}
+
+type quotaStore struct {
+	*store
+}
+
+func (s *quotaStore) GetQuota(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.QuotaOverride, error) {
+	defer trace.StartRegion(ctx, "get quota").End()
+	entityID := ids.Identifiers()
+	entity, err := s.findEntity(ctx, entityID, "id")
+	if err != nil {
+		return nil, err
+	}
+	var model Quota
+	err = s.query(ctx, Quota{}).Where(Quota{
+		EntityType: entityTypeForID(entityID),
+		EntityID:   entity.PrimaryKey(),
+	}).First(&model).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &ttnpb.QuotaOverride{}, nil
+		}
+		return nil, err
+	}
+	return model.toPB(), nil
+}
+
+func (s *quotaStore) SetQuota(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, quota *ttnpb.QuotaOverride) error {
+	defer trace.StartRegion(ctx, "set quota").End()
+	entityID := ids.Identifiers()
+	entity, err := s.findEntity(ctx, entityID, "id")
+	if err != nil {
+		return err
+	}
+	var model Quota
+	err = s.query(ctx, Quota{}).Where(Quota{
+		EntityType: entityTypeForID(entityID),
+		EntityID:   entity.PrimaryKey(),
+	}).First(&model).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	model.SetContext(ctx)
+	model.EntityType, model.EntityID = entityTypeForID(entityID), entity.PrimaryKey()
+	model.fromPB(quota)
+	if model.ID == "" {
+		return s.createEntity(ctx, &model)
+	}
+	return s.DB.Save(&model).Error
+}
+
+func (s *quotaStore) findAccount(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers) (*Account, error) {
+	var account Account
+	err := s.query(ctx, Account{}).Where(Account{
+		UID:         ids.IDString(),
+		AccountType: ids.EntityType(),
+	}).First(&account).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errNotFoundForID(ids)
+		}
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (s *quotaStore) SetOwner(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) error {
+	defer trace.StartRegion(ctx, "set owner").End()
+	account, err := s.findAccount(ctx, ids)
+	if err != nil {
+		return err
+	}
+	entity, err := s.findEntity(ctx, entityID, "id")
+	if err != nil {
+		return err
+	}
+	var model Ownership
+	err = s.query(ctx, Ownership{}).Where(Ownership{
+		EntityType: entityTypeForID(entityID),
+		EntityID:   entity.PrimaryKey(),
+	}).First(&model).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	model.SetContext(ctx)
+	model.AccountID = account.PrimaryKey()
+	model.EntityType, model.EntityID = entityTypeForID(entityID), entity.PrimaryKey()
+	if model.ID == "" {
+		return s.createEntity(ctx, &model)
+	}
+	return s.DB.Save(&model).Error
+}
+
+func (s *quotaStore) CountOwned(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, entityType string) (uint64, error) {
+	defer trace.StartRegion(ctx, fmt.Sprintf("count owned %ss", entityType)).End()
+	account, err := s.findAccount(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	err = s.query(ctx, Ownership{}).Where(Ownership{
+		AccountID:  account.PrimaryKey(),
+		EntityType: entityType,
+	}).Count(&total).Error
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// migrateOwnerships sets the owners of applications and gateways that were created before ownerships were recorded.
+// The owner is the account with the oldest membership of the entity, which is the account that created it.
+func migrateOwnerships(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO ownerships (created_at, updated_at, account_id, entity_id, entity_type)
+		SELECT DISTINCT ON (m.entity_type, m.entity_id) now(), now(), m.account_id, m.entity_id, m.entity_type
+		FROM memberships m
+		WHERE m.entity_type IN ('application', 'gateway')
+		AND NOT EXISTS (
+			SELECT 1 FROM ownerships o WHERE o.entity_type = m.entity_type AND o.entity_id = m.entity_id
+		)
+		ORDER BY m.entity_type, m.entity_id, m.created_at`).Error
+}
@@ -0,0 +1,119 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/jinzhu/gorm"
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+)
+
+func TestQuotaStore(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Account{}, &User{}, &Application{}, &Gateway{}, &Membership{}, &Quota{}, &Ownership{})
+
+		usr, err := GetUserStore(db).CreateUser(ctx, &ttnpb.User{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "foo"},
+		})
+		a.So(err, should.BeNil)
+
+		s := GetQuotaStore(db)
+
+		quota, err := s.GetQuota(ctx, usr.OrganizationOrUserIdentifiers())
+		a.So(err, should.BeNil)
+		a.So(quota, should.Resemble, &ttnpb.QuotaOverride{})
+
+		err = s.SetQuota(ctx, usr.OrganizationOrUserIdentifiers(), &ttnpb.QuotaOverride{
+			Applications: &types.UInt32Value{Value: 10},
+			EndDevices:   &types.UInt32Value{Value: 0},
+		})
+		a.So(err, should.BeNil)
+
+		quota, err = s.GetQuota(ctx, usr.OrganizationOrUserIdentifiers())
+		a.So(err, should.BeNil)
+		a.So(quota, should.Resemble, &ttnpb.QuotaOverride{
+			Applications: &types.UInt32Value{Value: 10},
+			EndDevices:   &types.UInt32Value{Value: 0},
+		})
+
+		err = s.SetQuota(ctx, usr.OrganizationOrUserIdentifiers(), &ttnpb.QuotaOverride{Gateways: &types.UInt32Value{Value: 5}})
+		a.So(err, should.BeNil)
+
+		quota, err = s.GetQuota(ctx, usr.OrganizationOrUserIdentifiers())
+		a.So(err, should.BeNil)
+		a.So(quota, should.Resemble, &ttnpb.QuotaOverride{Gateways: &types.UInt32Value{Value: 5}})
+
+		err = s.SetQuota(ctx, ttnpb.UserIdentifiers{UserID: "bar"}.OrganizationOrUserIdentifiers(), &ttnpb.QuotaOverride{})
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
+		total, err := s.CountOwned(ctx, usr.OrganizationOrUserIdentifiers(), "application")
+		a.So(err, should.BeNil)
+		a.So(total, should.BeZeroValue)
+
+		app, err := GetApplicationStore(db).CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		})
+		a.So(err, should.BeNil)
+
+		err = s.SetOwner(ctx, usr.OrganizationOrUserIdentifiers(), app.ApplicationIdentifiers)
+		a.So(err, should.BeNil)
+
+		total, err = s.CountOwned(ctx, usr.OrganizationOrUserIdentifiers(), "application")
+		a.So(err, should.BeNil)
+		a.So(total, should.Equal, 1)
+
+		total, err = s.CountOwned(ctx, usr.OrganizationOrUserIdentifiers(), "gateway")
+		a.So(err, should.BeNil)
+		a.So(total, should.BeZeroValue)
+
+		// Entities that were created before ownerships were recorded are owned by their first member.
+		legacyApp, err := GetApplicationStore(db).CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "legacy-app"},
+		})
+		a.So(err, should.BeNil)
+		err = GetMembershipStore(db).SetMember(ctx, usr.OrganizationOrUserIdentifiers(), legacyApp.ApplicationIdentifiers, ttnpb.RightsFrom(ttnpb.RIGHT_ALL))
+		a.So(err, should.BeNil)
+		legacyGtw, err := GetGatewayStore(db).CreateGateway(ctx, &ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "legacy-gtw"},
+		})
+		a.So(err, should.BeNil)
+		err = GetMembershipStore(db).SetMember(ctx, usr.OrganizationOrUserIdentifiers(), legacyGtw.GatewayIdentifiers, ttnpb.RightsFrom(ttnpb.RIGHT_ALL))
+		a.So(err, should.BeNil)
+
+		for i := 0; i < 2; i++ {
+			err = migrateOwnerships(db)
+			a.So(err, should.BeNil)
+
+			total, err = s.CountOwned(ctx, usr.OrganizationOrUserIdentifiers(), "application")
+			a.So(err, should.BeNil)
+			a.So(total, should.Equal, 2)
+
+			total, err = s.CountOwned(ctx, usr.OrganizationOrUserIdentifiers(), "gateway")
+			a.So(err, should.BeNil)
+			a.So(total, should.Equal, 1)
+		}
+	})
+}
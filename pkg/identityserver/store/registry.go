@@ -39,8 +39,16 @@ func Check(db *gorm.DB) error {
 }
 
 // AutoMigrate automatically migrates the database for the registered models.
+// Data that is derived from existing tables, like the owners of entities, is migrated as well.
 func AutoMigrate(db *gorm.DB) *gorm.DB {
-	return db.AutoMigrate(models...)
+	db = db.AutoMigrate(models...)
+	if db.Error != nil {
+		return db
+	}
+	if err := migrateOwnerships(db); err != nil {
+		db.AddError(err)
+	}
+	return db
 }
 
 // clear database tables for the given models.
@@ -0,0 +1,132 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type softDeletedOptionsKeyType struct{}
+
+var softDeletedOptionsKey softDeletedOptionsKeyType
+
+type softDeletedOptions struct {
+	onlyDeleted bool
+}
+
+// WithSoftDeleted instructs the store to include soft-deleted entities in the
+// results. If onlyDeleted is true, entity listings only contain soft-deleted
+// entities.
+func WithSoftDeleted(ctx context.Context, onlyDeleted bool) context.Context {
+	return context.WithValue(ctx, softDeletedOptionsKey, softDeletedOptions{
+		onlyDeleted: onlyDeleted,
+	})
+}
+
+func withSoftDeleted(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if _, ok := ctx.Value(softDeletedOptionsKey).(softDeletedOptions); ok {
+			return db.Unscoped()
+		}
+		return db
+	}
+}
+
+// withOnlyDeleted restricts the results to the soft-deleted rows of the given
+// table if that was requested with WithSoftDeleted.
+func withOnlyDeleted(ctx context.Context, table string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if opts, ok := ctx.Value(softDeletedOptionsKey).(softDeletedOptions); ok && opts.onlyDeleted {
+			return db.Where(fmt.Sprintf(`"%s"."deleted_at" IS NOT NULL`, table))
+		}
+		return db
+	}
+}
+
+// findDeletedEntity finds the entity if it is soft-deleted.
+func (s *store) findDeletedEntity(ctx context.Context, entityID ttnpb.Identifiers) (modelInterface, error) {
+	ctx = WithSoftDeleted(ctx, true)
+	model := modelForID(entityID)
+	table := s.DB.NewScope(model).TableName()
+	query := s.query(ctx, model, withID(entityID), withOnlyDeleted(ctx, table)).Select(table + ".id")
+	if err := query.First(model).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errNotFoundForID(entityID)
+		}
+		return nil, convertError(err)
+	}
+	return model, nil
+}
+
+func (s *store) restoreEntity(ctx context.Context, entityID ttnpb.Identifiers) error {
+	model, err := s.findDeletedEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	return s.DB.Unscoped().Model(model).UpdateColumn("deleted_at", gorm.Expr("NULL")).Error
+}
+
+// purgeEntity permanently deletes the entity model and its polymorphic records.
+func (s *store) purgeEntity(entityType string, model modelInterface) error {
+	if err := s.purgeEntityAssociations(entityType, model.PrimaryKey()); err != nil {
+		return err
+	}
+	return s.DB.Unscoped().Delete(model).Error
+}
+
+// purgeEntityAssociations permanently deletes the polymorphic records of the
+// entities with the given type and UUIDs.
+func (s *store) purgeEntityAssociations(entityType string, entityUUIDs ...string) error {
+	if len(entityUUIDs) == 0 {
+		return nil
+	}
+	for _, model := range []interface{}{
+		&Attribute{}, &ContactInfo{}, &ContactInfoValidation{}, &APIKey{}, &Membership{}, &Quota{}, &Ownership{},
+	} {
+		err := s.DB.Unscoped().
+			Where("entity_type = ? AND entity_id IN (?)", entityType, entityUUIDs).
+			Delete(model).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeAccount permanently deletes the account of the user or organization
+// with the given UUID, and the memberships and ownerships of that account.
+func (s *store) purgeAccount(accountType, accountUUID string) error {
+	var account Account
+	err := s.DB.Unscoped().
+		Where(&Account{AccountType: accountType, AccountID: accountUUID}).
+		First(&account).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil
+		}
+		return err
+	}
+	if err = s.DB.Unscoped().Where(&Membership{AccountID: account.ID}).Delete(&Membership{}).Error; err != nil {
+		return err
+	}
+	if err = s.DB.Unscoped().Where(&Ownership{AccountID: account.ID}).Delete(&Ownership{}).Error; err != nil {
+		return err
+	}
+	return s.DB.Unscoped().Delete(&account).Error
+}
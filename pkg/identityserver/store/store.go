@@ -38,7 +38,7 @@ type store struct {
 }
 
 func (s *store) query(ctx context.Context, model interface{}, funcs ...func(*gorm.DB) *gorm.DB) *gorm.DB {
-	query := s.DB.Model(model).Scopes(withContext(ctx))
+	query := s.DB.Model(model).Scopes(withContext(ctx), withSoftDeleted(ctx))
 	if len(funcs) > 0 {
 		query = query.Scopes(funcs...)
 	}
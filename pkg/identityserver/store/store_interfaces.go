@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -31,6 +32,8 @@ type ApplicationStore interface {
 	GetApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	UpdateApplication(ctx context.Context, app *ttnpb.Application, fieldMask *types.FieldMask) (*ttnpb.Application, error)
 	DeleteApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	RestoreApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
+	PurgeApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers) error
 }
 
 // ClientStore interface for storing Clients.
@@ -43,6 +46,8 @@ type ClientStore interface {
 	GetClient(ctx context.Context, id *ttnpb.ClientIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Client, error)
 	UpdateClient(ctx context.Context, cli *ttnpb.Client, fieldMask *types.FieldMask) (*ttnpb.Client, error)
 	DeleteClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
+	RestoreClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
+	PurgeClient(ctx context.Context, id *ttnpb.ClientIdentifiers) error
 }
 
 // EndDeviceStore interface for storing EndDevices.
@@ -69,6 +74,8 @@ type GatewayStore interface {
 	GetGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	UpdateGateway(ctx context.Context, gtw *ttnpb.Gateway, fieldMask *types.FieldMask) (*ttnpb.Gateway, error)
 	DeleteGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	RestoreGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
+	PurgeGateway(ctx context.Context, id *ttnpb.GatewayIdentifiers) error
 }
 
 // OrganizationStore interface for storing Organizations.
@@ -81,6 +88,8 @@ type OrganizationStore interface {
 	GetOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	UpdateOrganization(ctx context.Context, org *ttnpb.Organization, fieldMask *types.FieldMask) (*ttnpb.Organization, error)
 	DeleteOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	RestoreOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
+	PurgeOrganization(ctx context.Context, id *ttnpb.OrganizationIdentifiers) error
 }
 
 // UserStore interface for storing Users.
@@ -91,8 +100,11 @@ type UserStore interface {
 	CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error)
 	FindUsers(ctx context.Context, ids []*ttnpb.UserIdentifiers, fieldMask *types.FieldMask) ([]*ttnpb.User, error)
 	GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fieldMask *types.FieldMask) (*ttnpb.User, error)
+	GetUserByPrimaryEmailAddress(ctx context.Context, email string, fieldMask *types.FieldMask) (*ttnpb.User, error)
 	UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *types.FieldMask) (*ttnpb.User, error)
 	DeleteUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	RestoreUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
+	PurgeUser(ctx context.Context, id *ttnpb.UserIdentifiers) error
 }
 
 // UserSessionStore interface for storing User sessions.
@@ -135,8 +147,16 @@ type APIKeyStore interface {
 	FindAPIKeys(ctx context.Context, entityID ttnpb.Identifiers) ([]*ttnpb.APIKey, error)
 	// Get an API key by its ID.
 	GetAPIKey(ctx context.Context, id string) (ttnpb.Identifiers, *ttnpb.APIKey, error)
-	// Update key rights on an entity. Rights can be deleted by not passing any rights, in which case the returned API key will be nil.
-	UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey) (*ttnpb.APIKey, error)
+	// Update the fields of the API key in the field mask. The key is deleted if the rights are in the field mask
+	// and no rights are passed, in which case the returned API key will be nil.
+	UpdateAPIKey(ctx context.Context, entityID ttnpb.Identifiers, key *ttnpb.APIKey, fieldMask *types.FieldMask) (*ttnpb.APIKey, error)
+	// Set the time when the API keys with the given IDs were last used.
+	SetAPIKeysLastUsed(ctx context.Context, lastUsed map[string]time.Time) error
+	// Find the API keys that expire before the given time and of which the
+	// contacts of the entity were not yet reminded.
+	FindExpiringAPIKeys(ctx context.Context, before time.Time) ([]ttnpb.Identifiers, []*ttnpb.APIKey, error)
+	// Record that the contacts of the entity were reminded of the expiry of the API key.
+	SetAPIKeyExpiryReminded(ctx context.Context, id string) error
 }
 
 // OAuthStore interface for the OAuth server.
@@ -180,3 +200,24 @@ type ContactInfoStore interface {
 	// Confirm a validation. Only the ID and Token need to be set.
 	Validate(ctx context.Context, validation *ttnpb.ContactInfoValidation) error
 }
+
+// QuotaStore interface for storing the quotas of users and organizations.
+type QuotaStore interface {
+	// GetQuota returns the quota override of the user or organization. Fields
+	// that are not overridden are nil.
+	GetQuota(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers) (*ttnpb.QuotaOverride, error)
+	SetQuota(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, quota *ttnpb.QuotaOverride) error
+	// SetOwner records the user or organization that owns the entity.
+	SetOwner(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) error
+	// CountOwned counts the entities of the given type that are owned by the
+	// user or organization, including deleted entities that are not purged.
+	CountOwned(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, entityType string) (uint64, error)
+}
+
+// AuditLogStore interface for storing the audit log.
+type AuditLogStore interface {
+	CreateEntry(ctx context.Context, entry *ttnpb.AuditLogEntry) (*ttnpb.AuditLogEntry, error)
+	// FindEntries returns the entries of the given entity, newest first.
+	// If the entity is nil, the entries of all entities are returned.
+	FindEntries(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*ttnpb.AuditLogEntry, error)
+}
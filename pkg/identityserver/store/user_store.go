@@ -23,6 +23,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/warning"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -44,12 +45,12 @@ func selectUserFields(ctx context.Context, query *gorm.DB, fieldMask *types.Fiel
 	}
 	var userColumns []string
 	var notFoundPaths []string
-	for _, column := range modelColumns {
+	for _, column := range append(modelColumns, "deleted_at") {
 		userColumns = append(userColumns, "users."+column)
 	}
 	for _, path := range ttnpb.TopLevelFields(fieldMask.Paths) {
 		switch path {
-		case "ids", "created_at", "updated_at":
+		case "ids", "created_at", "updated_at", "deleted_at":
 			// always selected
 		case attributesField:
 			query = query.Preload("Attributes")
@@ -91,7 +92,7 @@ func (s *userStore) FindUsers(ctx context.Context, ids []*ttnpb.UserIdentifiers,
 	for i, id := range ids {
 		idStrings[i] = id.GetUserID()
 	}
-	query := s.query(ctx, User{}, withUserID(idStrings...))
+	query := s.query(ctx, User{}, withUserID(idStrings...), withOnlyDeleted(ctx, "users"))
 	query = selectUserFields(ctx, query, fieldMask)
 	if limit, offset := limitAndOffsetFromContext(ctx); limit != 0 {
 		countTotal(ctx, query.Model(User{}))
@@ -128,6 +129,24 @@ func (s *userStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return userProto, nil
 }
 
+var errUserEmailNotFound = errors.DefineNotFound("user_email_not_found", "user with primary email address `{email}` not found")
+
+func (s *userStore) GetUserByPrimaryEmailAddress(ctx context.Context, email string, fieldMask *types.FieldMask) (*ttnpb.User, error) {
+	defer trace.StartRegion(ctx, "get user by primary email address").End()
+	query := s.query(ctx, User{}).Where(&User{PrimaryEmailAddress: email})
+	query = selectUserFields(ctx, query, fieldMask)
+	var userModel User
+	if err := query.Preload("Account").First(&userModel).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, errUserEmailNotFound.WithAttributes("email", email)
+		}
+		return nil, err
+	}
+	userProto := &ttnpb.User{}
+	userModel.toPB(userProto, fieldMask)
+	return userProto, nil
+}
+
 func (s *userStore) UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *types.FieldMask) (updated *ttnpb.User, err error) {
 	defer trace.StartRegion(ctx, "update user").End()
 	query := s.query(ctx, User{}, withUserID(usr.GetUserID()))
@@ -177,3 +196,41 @@ func (s *userStore) DeleteUser(ctx context.Context, id *ttnpb.UserIdentifiers) (
 	defer trace.StartRegion(ctx, "delete user").End()
 	return s.deleteEntity(ctx, id)
 }
+
+func (s *userStore) RestoreUser(ctx context.Context, id *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "restore user").End()
+	return s.restoreEntity(ctx, id)
+}
+
+func (s *userStore) PurgeUser(ctx context.Context, id *ttnpb.UserIdentifiers) error {
+	defer trace.StartRegion(ctx, "purge user").End()
+	model, err := s.findDeletedEntity(ctx, id)
+	if err != nil {
+		return err
+	}
+	var userModel User
+	if err = s.DB.Unscoped().Select("profile_picture_id").Where("id = ?", model.PrimaryKey()).First(&userModel).Error; err != nil {
+		return err
+	}
+	for _, related := range []interface{}{&UserSession{}, &ClientAuthorization{}, &AuthorizationCode{}, &AccessToken{}} {
+		if err = s.DB.Where("user_id = ?", model.PrimaryKey()).Delete(related).Error; err != nil {
+			return err
+		}
+	}
+	err = s.DB.Model(&Invitation{}).
+		Where("accepted_by_id = ?", model.PrimaryKey()).
+		UpdateColumn("accepted_by_id", gorm.Expr("NULL")).Error
+	if err != nil {
+		return err
+	}
+	if err = s.purgeAccount("user", model.PrimaryKey()); err != nil {
+		return err
+	}
+	if err = s.purgeEntity("user", model); err != nil {
+		return err
+	}
+	if userModel.ProfilePictureID != nil {
+		return s.DB.Unscoped().Where("id = ?", *userModel.ProfilePictureID).Delete(&Picture{}).Error
+	}
+	return nil
+}
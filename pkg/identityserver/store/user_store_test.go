@@ -36,9 +36,10 @@ func TestUserStore(t *testing.T) {
 		store := GetUserStore(db)
 
 		created, err := store.CreateUser(ctx, &ttnpb.User{
-			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "foo"},
-			Name:            "Foo User",
-			Description:     "The Amazing Foo User",
+			UserIdentifiers:     ttnpb.UserIdentifiers{UserID: "foo"},
+			Name:                "Foo User",
+			Description:         "The Amazing Foo User",
+			PrimaryEmailAddress: "foo@example.com",
 			Attributes: map[string]string{
 				"foo": "bar",
 				"bar": "baz",
@@ -71,6 +72,16 @@ func TestUserStore(t *testing.T) {
 		a.So(got.CreatedAt, should.Equal, created.CreatedAt)
 		a.So(got.UpdatedAt, should.Equal, created.UpdatedAt)
 
+		got, err = store.GetUserByPrimaryEmailAddress(ctx, "foo@example.com", &types.FieldMask{Paths: []string{"name"}})
+		a.So(err, should.BeNil)
+		a.So(got.UserID, should.Equal, "foo")
+		a.So(got.Name, should.Equal, "Foo User")
+
+		_, err = store.GetUserByPrimaryEmailAddress(ctx, "bar@example.com", nil)
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsNotFound(err), should.BeTrue)
+		}
+
 		_, err = store.UpdateUser(ctx, &ttnpb.User{
 			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "bar"},
 		}, nil)
@@ -59,18 +59,25 @@ func (is *IdentityServer) createUserAPIKey(ctx context.Context, req *ttnpb.Creat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
-	key, token, err := generateAPIKey(ctx, req.Name, req.Rights...)
+	key, token, err := generateAPIKey(ctx, req.Name, req.ExpiresAt, req.AllowedCIDRs, req.Rights...)
 	if err != nil {
 		return nil, err
 	}
+	evt := evtCreateUserAPIKey(ctx, req.UserIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.UserIdentifiers, key)
+		if err := is.requireQuota(ctx, db, &req.UserIdentifiers, quotaAPIKeys); err != nil {
+			return err
+		}
+		if err := store.GetAPIKeyStore(db).CreateAPIKey(ctx, req.UserIdentifiers, key); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
 	key.Key = token
-	events.Publish(evtCreateUserAPIKey(ctx, req.UserIdentifiers, nil))
+	events.Publish(evt)
 	err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
 		data.SetEntity(req.EntityIdentifiers())
 		return &emails.APIKeyCreated{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
@@ -135,19 +142,33 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, req.Rights...); err != nil {
 		return nil, err
 	}
+	fieldMask := apiKeyUpdateFieldMask(req.FieldMask)
+	deleteKey := isAPIKeyDeletion(&req.APIKey, fieldMask)
+	if !deleteKey {
+		if err = validateAPIKeyUpdate(&req.APIKey, fieldMask); err != nil {
+			return nil, err
+		}
+	}
+	evt := evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil)
+	if deleteKey {
+		evt = evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil)
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
-		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers, &req.APIKey)
-		return err
+		key, err = store.GetAPIKeyStore(db).UpdateAPIKey(ctx, req.UserIdentifiers, &req.APIKey, fieldMask)
+		if err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evt)
 	if key == nil {
 		return &ttnpb.APIKey{}, nil
 	}
 	key.Key = ""
-	if len(req.Rights) > 0 {
-		events.Publish(evtUpdateUserAPIKey(ctx, req.UserIdentifiers, nil))
+	if ttnpb.HasAnyField(fieldMask.Paths, "rights") {
 		err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
 			data.SetEntity(req.EntityIdentifiers())
 			return &emails.APIKeyChanged{Data: data, Identifier: key.PrettyName(), Rights: key.Rights}
@@ -155,8 +176,6 @@ func (is *IdentityServer) updateUserAPIKey(ctx context.Context, req *ttnpb.Updat
 		if err != nil {
 			log.FromContext(ctx).WithError(err).Error("Could not send API key update notification email")
 		}
-	} else {
-		events.Publish(evtDeleteUserAPIKey(ctx, req.UserIdentifiers, nil))
 	}
 	return key, nil
 }
@@ -51,6 +51,14 @@ var (
 		"user.delete", "delete user",
 		ttnpb.RIGHT_USER_INFO,
 	)
+	evtRestoreUser = events.Define(
+		"user.restore", "restore user",
+		ttnpb.RIGHT_USER_INFO,
+	)
+	evtPurgeUser = events.Define(
+		"user.purge", "purge user",
+		ttnpb.RIGHT_USER_INFO,
+	)
 	evtUpdateUserIncorrectPassword = events.Define(
 		"user.update.incorrect_password", "update user failure: incorrect password",
 		ttnpb.RIGHT_USER_INFO,
@@ -151,7 +159,7 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 	if err := is.validatePasswordStrength(ctx, req.User.Password); err != nil {
 		return nil, err
 	}
-	hashedPassword, err := auth.Hash(ctx, req.User.Password)
+	hashedPassword, err := auth.HashPassword(ctx, req.User.Password)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +174,7 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 	}
 	defer func() { is.setFullProfilePictureURL(ctx, usr) }()
 
+	evt := evtCreateUser(ctx, req.UserIdentifiers, nil)
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		if req.InvitationToken != "" {
 			invitationToken, err := store.GetInvitationStore(db).GetInvitation(ctx, req.InvitationToken)
@@ -195,7 +204,7 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 			}
 		}
 
-		return nil
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
@@ -208,10 +217,74 @@ func (is *IdentityServer) createUser(ctx context.Context, req *ttnpb.CreateUserR
 	}
 
 	usr.Password = "" // Create doesn't have a FieldMask, so we need to manually remove the password.
-	events.Publish(evtCreateUser(ctx, req.UserIdentifiers, nil))
+	events.Publish(evt)
 	return usr, nil
 }
 
+// createFederatedUser creates a user that is provisioned by an external identity provider. The same registration
+// requirements apply as for users that register themselves, except that the primary email address is already
+// validated by the identity provider. Federated users do not know their password; it can be set through the password
+// reset flow.
+func (is *IdentityServer) createFederatedUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	if err := blacklist.Check(ctx, usr.UserID); err != nil {
+		return nil, err
+	}
+	if is.configFromContext(ctx).UserRegistration.Invitation.Required {
+		return nil, errInvitationTokenRequired
+	}
+	if err := validate.Email(usr.PrimaryEmailAddress); err != nil {
+		return nil, err
+	}
+
+	if is.configFromContext(ctx).UserRegistration.AdminApproval.Required {
+		usr.State = ttnpb.STATE_REQUESTED
+	} else {
+		usr.State = ttnpb.STATE_APPROVED
+	}
+	usr.Admin = false
+	usr.RequirePasswordUpdate = false
+	usr.TemporaryPassword = ""
+	usr.TemporaryPasswordCreatedAt = nil
+	usr.TemporaryPasswordExpiresAt = nil
+	usr.ContactInfo = []*ttnpb.ContactInfo{{
+		ContactMethod: ttnpb.CONTACT_METHOD_EMAIL,
+		Value:         usr.PrimaryEmailAddress,
+		ValidatedAt:   usr.PrimaryEmailAddressValidatedAt,
+	}}
+
+	password, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := auth.HashPassword(ctx, password)
+	if err != nil {
+		return nil, err
+	}
+	usr.Password = hashedPassword
+	now := time.Now()
+	usr.PasswordUpdatedAt = &now
+
+	evt := evtCreateUser(ctx, usr.UserIdentifiers, nil)
+	var created *ttnpb.User
+	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
+		created, err = store.GetUserStore(db).CreateUser(ctx, usr)
+		if err != nil {
+			return err
+		}
+		created.ContactInfo, err = store.GetContactInfoStore(db).SetContactInfo(ctx, created.UserIdentifiers, usr.ContactInfo)
+		if err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	created.Password = ""
+	events.Publish(evt)
+	return created, nil
+}
+
 func (is *IdentityServer) getUser(ctx context.Context, req *ttnpb.GetUserRequest) (usr *ttnpb.User, err error) {
 	if err = is.RequireAuthenticated(ctx); err != nil {
 		return nil, err
@@ -278,6 +351,14 @@ func (is *IdentityServer) setFullProfilePictureURL(ctx context.Context, usr *ttn
 	}
 }
 
+var stateFieldMask = &types.FieldMask{Paths: []string{"state"}}
+
+// userStateName returns the name of the user state as used in emails,
+// for example "approved" for STATE_APPROVED.
+func userStateName(state ttnpb.State) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "STATE_"))
+}
+
 func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserRequest) (usr *ttnpb.User, err error) {
 	if err = rights.RequireUser(ctx, req.UserIdentifiers, ttnpb.RIGHT_USER_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -311,7 +392,7 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 	}
 
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "temporary_password") {
-		hashedTemporaryPassword, err := auth.Hash(ctx, req.User.TemporaryPassword)
+		hashedTemporaryPassword, err := auth.HashPassword(ctx, req.User.TemporaryPassword)
 		if err != nil {
 			return nil, err
 		}
@@ -340,6 +421,8 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 		defer func() { is.setFullProfilePictureURL(ctx, usr) }()
 	}
 
+	var stateChanged bool
+	var evt events.Event
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		updatingContactInfo := ttnpb.HasAnyField(req.FieldMask.Paths, "contact_info")
 		var contactInfo []*ttnpb.ContactInfo
@@ -371,6 +454,13 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 				}
 			}
 		}
+		if ttnpb.HasAnyField(req.FieldMask.Paths, "state") {
+			current, err := store.GetUserStore(db).GetUser(ctx, &req.User.UserIdentifiers, stateFieldMask)
+			if err != nil {
+				return err
+			}
+			stateChanged = current.State != req.User.State
+		}
 		usr, err = store.GetUserStore(db).UpdateUser(ctx, &req.User, &req.FieldMask)
 		if err != nil {
 			return err
@@ -378,15 +468,24 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 		if updatingContactInfo {
 			usr.ContactInfo = contactInfo
 		}
-		return nil
+		evt = evtUpdateUser(ctx, req.UserIdentifiers, req.FieldMask.Paths)
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateUser(ctx, req.UserIdentifiers, req.FieldMask.Paths))
+	events.Publish(evt)
+
+	if stateChanged {
+		err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
+			return &emails.UserStateChanged{Data: data, State: userStateName(req.User.State)}
+		})
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("Could not send user state change notification email")
+		}
+	}
 
 	// TODO: Send emails (https://github.com/TheThingsNetwork/lorawan-stack/issues/72).
-	// - If user state changed (approved, rejected, flagged, suspended)
 	// - If primary email address changed
 
 	return usr, nil
@@ -414,11 +513,12 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 	if err := is.validatePasswordStrength(ctx, req.New); err != nil {
 		return nil, err
 	}
-	hashedPassword, err := auth.Hash(ctx, req.New)
+	hashedPassword, err := auth.HashPassword(ctx, req.New)
 	if err != nil {
 		return nil, err
 	}
 	updateMask := updatePasswordFieldMask
+	var evt events.Event
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		usr, err := store.GetUserStore(db).GetUser(ctx, &req.UserIdentifiers, temporaryPasswordFieldMask)
 		if err != nil {
@@ -437,7 +537,7 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 			// }
 		} else {
 			if usr.TemporaryPassword == "" {
-				events.Publish(evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
+				is.publishAudited(ctx, evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
 				return errIncorrectPassword
 			}
 			region := trace.StartRegion(ctx, "validate temporary password")
@@ -447,10 +547,10 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 			case err != nil:
 				return err
 			case !valid:
-				events.Publish(evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
+				is.publishAudited(ctx, evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
 				return errIncorrectPassword
 			case usr.TemporaryPasswordExpiresAt.Before(time.Now()):
-				events.Publish(evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
+				is.publishAudited(ctx, evtUpdateUserIncorrectPassword(ctx, req.UserIdentifiers, nil))
 				return errTemporaryPasswordExpired
 			}
 			usr.TemporaryPassword, usr.TemporaryPasswordCreatedAt, usr.TemporaryPasswordExpiresAt = "", nil, nil
@@ -489,12 +589,16 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 		now := time.Now()
 		usr.Password, usr.PasswordUpdatedAt, usr.RequirePasswordUpdate = hashedPassword, &now, false
 		usr, err = store.GetUserStore(db).UpdateUser(ctx, usr, updateMask)
-		return err
+		if err != nil {
+			return err
+		}
+		evt = evtUpdateUser(ctx, req.UserIdentifiers, updateMask)
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtUpdateUser(ctx, req.UserIdentifiers, updateMask))
+	events.Publish(evt)
 	err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
 		return &emails.PasswordChanged{Data: data}
 	})
@@ -511,11 +615,12 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 	if err != nil {
 		return nil, err
 	}
-	hashedTemporaryPassword, err := auth.Hash(ctx, temporaryPassword)
+	hashedTemporaryPassword, err := auth.HashPassword(ctx, temporaryPassword)
 	if err != nil {
 		return nil, err
 	}
 	now := time.Now()
+	evt := evtUpdateUser(ctx, req.UserIdentifiers, updateTemporaryPasswordFieldMask)
 	err = is.withDatabase(ctx, func(db *gorm.DB) error {
 		usr, err := store.GetUserStore(db).GetUser(ctx, &req.UserIdentifiers, temporaryPasswordFieldMask)
 		if err != nil {
@@ -528,7 +633,10 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 		expires := now.Add(time.Hour)
 		usr.TemporaryPasswordCreatedAt, usr.TemporaryPasswordExpiresAt = &now, &expires
 		usr, err = store.GetUserStore(db).UpdateUser(ctx, usr, updateTemporaryPasswordFieldMask)
-		return err
+		if err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
@@ -537,7 +645,7 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 		"user_uid", unique.ID(ctx, req.UserIdentifiers),
 		"temporary_password", temporaryPassword,
 	)).Info("Created temporary password")
-	events.Publish(evtUpdateUser(ctx, req.UserIdentifiers, updateTemporaryPasswordFieldMask))
+	events.Publish(evt)
 	err = is.SendUserEmail(ctx, &req.UserIdentifiers, func(data emails.Data) email.MessageData {
 		return &emails.TemporaryPassword{
 			Data:              data,
@@ -554,13 +662,51 @@ func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentif
 	if err := rights.RequireUser(ctx, *ids, ttnpb.RIGHT_USER_DELETE); err != nil {
 		return nil, err
 	}
+	evt := evtDeleteUser(ctx, ids, nil)
 	err := is.withDatabase(ctx, func(db *gorm.DB) error {
-		return store.GetUserStore(db).DeleteUser(ctx, ids)
+		if err := store.GetUserStore(db).DeleteUser(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
 	})
 	if err != nil {
 		return nil, err
 	}
-	events.Publish(evtDeleteUser(ctx, ids, nil))
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) restoreUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireUser(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_USER_DELETE); err != nil {
+		return nil, err
+	}
+	evt := evtRestoreUser(ctx, ids, nil)
+	err := is.withDatabase(ctx, func(db *gorm.DB) error {
+		if err := store.GetUserStore(db).RestoreUser(ctx, ids); err != nil {
+			return err
+		}
+		return is.writeAuditLog(ctx, db, evt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evt)
+	return ttnpb.Empty, nil
+}
+
+func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	if err := is.RequireAuthenticated(ctx); err != nil {
+		return nil, err
+	}
+	if err := rights.RequireUser(store.WithSoftDeleted(ctx, false), *ids, ttnpb.RIGHT_USER_DELETE); err != nil {
+		return nil, err
+	}
+	if err := is.purgeEntity(ctx, ids); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
 
@@ -591,3 +737,11 @@ func (ur *userRegistry) CreateTemporaryPassword(ctx context.Context, req *ttnpb.
 func (ur *userRegistry) Delete(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
 	return ur.deleteUser(ctx, req)
 }
+
+func (ur *userRegistry) Restore(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.restoreUser(ctx, req)
+}
+
+func (ur *userRegistry) Purge(ctx context.Context, req *ttnpb.UserIdentifiers) (*types.Empty, error) {
+	return ur.purgeUser(ctx, req)
+}
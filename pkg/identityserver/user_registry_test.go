@@ -107,6 +107,54 @@ func TestUsersPermissionNotRequired(t *testing.T) {
 	})
 }
 
+func TestCreateFederatedUser(t *testing.T) {
+	testWithIdentityServer(t, func(is *IdentityServer, _ *grpc.ClientConn) {
+		now := time.Now()
+		newFederatedUser := func(id string) *ttnpb.User {
+			return &ttnpb.User{
+				UserIdentifiers:                ttnpb.UserIdentifiers{UserID: id},
+				PrimaryEmailAddress:            id + "@example.com",
+				PrimaryEmailAddressValidatedAt: &now,
+			}
+		}
+
+		t.Run("Blacklisted", func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := is.createFederatedUser(is.Context(), newFederatedUser("admin"))
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+
+		t.Run("Invitation required", func(t *testing.T) {
+			a := assertions.New(t)
+			conf := &Config{UserRegistration: is.config.UserRegistration}
+			conf.UserRegistration.Invitation.Required = true
+			ctx := context.WithValue(is.Context(), ctxKey, conf)
+			_, err := is.createFederatedUser(ctx, newFederatedUser("federated-invited-user"))
+			if a.So(err, should.NotBeNil) {
+				a.So(errors.IsUnauthenticated(err), should.BeTrue)
+			}
+		})
+
+		t.Run("Admin approval required", func(t *testing.T) {
+			a := assertions.New(t)
+			conf := &Config{UserRegistration: is.config.UserRegistration}
+			conf.UserRegistration.AdminApproval.Required = true
+			ctx := context.WithValue(is.Context(), ctxKey, conf)
+			usr := newFederatedUser("federated-user")
+			usr.Admin = true
+			created, err := is.createFederatedUser(ctx, usr)
+			if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+				a.So(created.State, should.Equal, ttnpb.STATE_REQUESTED)
+				a.So(created.Admin, should.BeFalse)
+				a.So(created.PrimaryEmailAddressValidatedAt, should.NotBeNil)
+				a.So(created.Password, should.BeEmpty)
+			}
+		})
+	})
+}
+
 func TestUserUpdateInvalidPassword(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
@@ -215,6 +263,58 @@ func TestUsersWeakPassword(t *testing.T) {
 	})
 }
 
+func TestUsersAdminApproval(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		is.config.UserRegistration.AdminApproval.Required = true
+		defer func() { is.config.UserRegistration.AdminApproval.Required = false }()
+
+		reg := ttnpb.NewUserRegistryClient(cc)
+		adminCreds := userCreds(adminUserIdx)
+		userID := ttnpb.UserIdentifiers{UserID: "test-approval-user"}
+
+		created, err := reg.Create(ctx, &ttnpb.CreateUserRequest{
+			User: ttnpb.User{
+				UserIdentifiers:     userID,
+				PrimaryEmailAddress: "test-approval-user@example.com",
+				Password:            "test password",
+				State:               ttnpb.STATE_APPROVED,
+			},
+		})
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.State, should.Equal, ttnpb.STATE_REQUESTED)
+		}
+
+		for _, state := range []ttnpb.State{ttnpb.STATE_APPROVED, ttnpb.STATE_FLAGGED, ttnpb.STATE_SUSPENDED} {
+			updated, err := reg.Update(ctx, &ttnpb.UpdateUserRequest{
+				User: ttnpb.User{
+					UserIdentifiers: userID,
+					State:           state,
+				},
+				FieldMask: types.FieldMask{Paths: []string{"state"}},
+			}, adminCreds)
+			a.So(err, should.BeNil)
+			if a.So(updated, should.NotBeNil) {
+				a.So(updated.State, should.Equal, state)
+			}
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateUserRequest{
+			User: ttnpb.User{
+				UserIdentifiers: population.Users[defaultUserIdx].UserIdentifiers,
+				State:           ttnpb.STATE_APPROVED,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"state"}},
+		}, userCreds(defaultUserIdx))
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+	})
+}
+
 func TestUsersCRUD(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
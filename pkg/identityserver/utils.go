@@ -25,7 +25,7 @@ import (
 )
 
 var (
-	getPaths    = []string{"ids", "created_at", "updated_at"}
+	getPaths    = []string{"ids", "created_at", "updated_at", "deleted_at"}
 	updatePaths = []string{"updated_at"}
 )
 
@@ -320,8 +320,56 @@ type prefixJoinServerClient struct {
 	prefix types.EUI64Prefix
 }
 
+type nsRPCPaths struct {
+	SNS string `yaml:"sns"`
+	FNS string `yaml:"fns"`
+}
+
+func (p nsRPCPaths) sns() string {
+	if p.SNS == "" {
+		return "sns"
+	}
+	return p.SNS
+}
+
+func (p nsRPCPaths) fns() string {
+	if p.FNS == "" {
+		return "fns"
+	}
+	return p.FNS
+}
+
+type networkServerHTTPClient struct {
+	Client         http.Client
+	NewRequestFunc func(func(nsRPCPaths) string, interface{}) (*http.Request, error)
+	Protocol       JoinServerProtocol
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, pathFunc func(nsRPCPaths) string, req, res interface{}) error {
+	httpReq, err := cl.NewRequestFunc(pathFunc, req)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, httpReq.WithContext(ctx), res, cl.Client.Do)
+}
+
+func makeNetworkServerHTTPRequestFunc(scheme, fqdn string, port uint32, rpcPaths nsRPCPaths, headers map[string]string) func(func(nsRPCPaths) string, interface{}) (*http.Request, error) {
+	return func(pathFunc func(nsRPCPaths) string, pld interface{}) (*http.Request, error) {
+		return newHTTPRequest(serverURL(scheme, fqdn, pathFunc(rpcPaths), port), pld, headers)
+	}
+}
+
+// netIDNetworkServerClient is a client of a Network Server with which a roaming agreement exists.
+type netIDNetworkServerClient struct {
+	*networkServerHTTPClient
+	netID  types.NetID
+	prefix types.DevAddrPrefix
+}
+
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	dnsJoinServers *dnsJoinServers          // Optional.
+	networkServers []netIDNetworkServerClient
 }
 
 var errUnknownProtocol = errors.DefineInvalidArgument("unknown_protocol", "unknown protocol")
@@ -377,25 +425,32 @@ func (conf tlsConfig) TLSConfig(fetcher fetch.Interface) (*tls.Config, error) {
 const InteropClientConfigurationName = "config.yaml"
 
 // NewClient return new interop client.
+// If a DNS domain is configured, Join Servers that are not statically configured are resolved through DNS.
 // fallbackTLS is optional.
 func NewClient(ctx context.Context, conf config.InteropClient, fallbackTLS *tls.Config) (*Client, error) {
 	fetcher := conf.Fetcher()
-	if fetcher == nil {
+	if fetcher == nil && conf.DNS.Domain == "" {
 		return nil, errUnknownConfig
 	}
-	confFileBytes, err := fetcher.File(InteropClientConfigurationName)
-	if err != nil {
-		return nil, err
-	}
 
 	var yamlConf struct {
 		JoinServers []struct {
 			File     string              `yaml:"file"`
 			JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
-	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
-		return nil, err
+	if fetcher != nil {
+		confFileBytes, err := fetcher.File(InteropClientConfigurationName)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
+			return nil, err
+		}
 	}
 
 	type ComponentConfig struct {
@@ -406,6 +461,26 @@ func NewClient(ctx context.Context, conf config.InteropClient, fallbackTLS *tls.
 		TLS     tlsConfig         `yaml:"tls"`
 	}
 
+	newHTTPClient := func(fetcher fetch.Interface, conf tlsConfig) (*http.Client, error) {
+		tlsConf := fallbackTLS
+		if !conf.IsZero() {
+			var err error
+			tlsConf, err = conf.TLSConfig(fetcher)
+			if err != nil {
+				return nil, err
+			}
+		}
+		var tr *http.Transport
+		if tlsConf != nil {
+			tr = &http.Transport{
+				TLSClientConfig: tlsConf,
+			}
+		}
+		return &http.Client{
+			Transport: tr,
+		}, nil
+	}
+
 	jss := make([]prefixJoinServerClient, 0, len(yamlConf.JoinServers))
 	for _, jsConf := range yamlConf.JoinServers {
 		jsConfEls := strings.Split(filepath.ToSlash(jsConf.File), "/")
@@ -428,24 +503,12 @@ func NewClient(ctx context.Context, conf config.InteropClient, fallbackTLS *tls.
 		var js joinServerClient
 		switch yamlJSConf.Protocol {
 		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
-			tlsConf := fallbackTLS
-			if !yamlJSConf.TLS.IsZero() {
-				tlsConf, err = yamlJSConf.TLS.TLSConfig(fetcher)
-				if err != nil {
-					return nil, err
-				}
-			}
-
-			var tr *http.Transport
-			if tlsConf != nil {
-				tr = &http.Transport{
-					TLSClientConfig: tlsConf,
-				}
+			httpClient, err := newHTTPClient(fetcher, yamlJSConf.TLS)
+			if err != nil {
+				return nil, err
 			}
 			js = &joinServerHTTPClient{
-				Client: http.Client{
-					Transport: tr,
-				},
+				Client:         *httpClient,
 				NewRequestFunc: makeJoinServerHTTPRequestFunc("https", yamlJSConf.DNS, yamlJSConf.FQDN, yamlJSConf.Port, yamlJSConf.Paths, yamlJSConf.Headers),
 				Protocol:       yamlJSConf.Protocol,
 			}
@@ -466,26 +529,96 @@ func NewClient(ctx context.Context, conf config.InteropClient, fallbackTLS *tls.
 		}
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
+
+	nss := make([]netIDNetworkServerClient, 0, len(yamlConf.NetworkServers))
+	for _, nsConf := range yamlConf.NetworkServers {
+		nsConfEls := strings.Split(filepath.ToSlash(nsConf.File), "/")
+
+		fetcher := fetch.WithBasePath(fetcher, nsConfEls[:len(nsConfEls)-1]...)
+		nsFileBytes, err := fetcher.File(nsConfEls[len(nsConfEls)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var yamlNSConf struct {
+			ComponentConfig `yaml:",inline"`
+			Paths           nsRPCPaths         `yaml:"paths"`
+			Protocol        JoinServerProtocol `yaml:"protocol"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &yamlNSConf); err != nil {
+			return nil, err
+		}
+
+		switch yamlNSConf.Protocol {
+		case LoRaWANJoinServerProtocol1_0, LoRaWANJoinServerProtocol1_1:
+		default:
+			return nil, errUnknownProtocol
+		}
+		httpClient, err := newHTTPClient(fetcher, yamlNSConf.TLS)
+		if err != nil {
+			return nil, err
+		}
+		ns := &networkServerHTTPClient{
+			Client:         *httpClient,
+			NewRequestFunc: makeNetworkServerHTTPRequestFunc("https", yamlNSConf.FQDN, yamlNSConf.Port, yamlNSConf.Paths, yamlNSConf.Headers),
+			Protocol:       yamlNSConf.Protocol,
+		}
+		for _, netID := range nsConf.NetIDs {
+			devAddr, err := types.NewDevAddr(netID, nil)
+			if err != nil {
+				return nil, err
+			}
+			nss = append(nss, netIDNetworkServerClient{
+				networkServerHTTPClient: ns,
+				netID:                   netID,
+				prefix: types.DevAddrPrefix{
+					DevAddr: devAddr,
+					Length:  uint8(32 - types.NwkAddrBits(netID)),
+				},
+			})
+		}
+	}
+
+	var dnsJSs *dnsJoinServers
+	if conf.DNS.Domain != "" {
+		httpClient, err := newHTTPClient(fetcher, tlsConfig{})
+		if err != nil {
+			return nil, err
+		}
+		dnsJSs = newDNSJoinServers(conf.DNS, httpClient)
+	}
+
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		dnsJoinServers: dnsJSs,
+		networkServers: nss,
 	}, nil
 }
 
-func (cl Client) joinServer(joinEUI types.EUI64) (joinServerClient, bool) {
+func (cl Client) joinServer(ctx context.Context, joinEUI types.EUI64) (joinServerClient, error) {
 	// NOTE: joinServers slice is sorted by prefix length and the range start decreasing, hence the first match is the most specific one.
 	for _, js := range cl.joinServers {
 		if js.prefix.Matches(joinEUI) {
-			return js.joinServerClient, true
+			return js.joinServerClient, nil
 		}
 	}
-	return nil, false
+	if cl.dnsJoinServers != nil {
+		js, ok, err := cl.dnsJoinServers.joinServer(ctx, joinEUI)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return js, nil
+		}
+	}
+	return nil, errNotRegistered
 }
 
 // GetAppSKey performs AppSKey request to Join Server associated with req.JoinEUI.
 func (cl Client) GetAppSKey(ctx context.Context, asID string, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error) {
-	js, ok := cl.joinServer(req.JoinEUI)
-	if !ok {
-		return nil, errNotRegistered
+	js, err := cl.joinServer(ctx, req.JoinEUI)
+	if err != nil {
+		return nil, err
 	}
 	return js.GetAppSKey(ctx, asID, req)
 }
@@ -496,9 +629,84 @@ func (cl Client) HandleJoinRequest(ctx context.Context, netID types.NetID, req *
 	if pld == nil {
 		return nil, ErrMalformedMessage
 	}
-	js, ok := cl.joinServer(pld.JoinEUI)
-	if !ok {
-		return nil, errNotRegistered
+	js, err := cl.joinServer(ctx, pld.JoinEUI)
+	if err != nil {
+		return nil, err
 	}
 	return js.HandleJoinRequest(ctx, netID, req)
 }
+
+func (cl Client) networkServer(netID types.NetID) (*networkServerHTTPClient, bool) {
+	for _, ns := range cl.networkServers {
+		if ns.netID.Equal(netID) {
+			return ns.networkServerHTTPClient, true
+		}
+	}
+	return nil, false
+}
+
+// RoamingNetID returns the NetID of the Network Server with which a roaming agreement exists,
+// and to which devAddr belongs.
+func (cl Client) RoamingNetID(devAddr types.DevAddr) (types.NetID, bool) {
+	for _, ns := range cl.networkServers {
+		if ns.prefix.Matches(devAddr) {
+			return ns.netID, true
+		}
+	}
+	return types.NetID{}, false
+}
+
+// HasRoamingAgreement returns whether a roaming agreement exists with the Network Server identified by netID.
+func (cl Client) HasRoamingAgreement(netID types.NetID) bool {
+	_, ok := cl.networkServer(netID)
+	return ok
+}
+
+// PRStartRequest performs a passive roaming start request to the Network Server identified by req.ReceiverID,
+// according to LoRaWAN Backend Interfaces specification.
+// The sender ID and the protocol version of the request are set by the client.
+func (cl Client) PRStartRequest(ctx context.Context, netID types.NetID, req *PRStartReq) (*PRStartAns, error) {
+	ns, ok := cl.networkServer(types.NetID(req.ReceiverID))
+	if !ok {
+		return nil, ErrNoRoamingAgreement
+	}
+	req.ProtocolVersion = ns.Protocol.BackendInterfacesVersion()
+	req.MessageType = MessageTypePRStartReq
+	req.SenderID = NetID(netID)
+
+	ans := &PRStartAns{}
+	if err := ns.exchange(ctx, nsRPCPaths.sns, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// XmitDataRequest performs a transmit data request to the Network Server identified by req.ReceiverID,
+// according to LoRaWAN Backend Interfaces specification.
+// Requests carrying downlink metadata are sent to the fNS, other requests are sent to the sNS.
+// The sender ID and the protocol version of the request are set by the client.
+func (cl Client) XmitDataRequest(ctx context.Context, netID types.NetID, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, ok := cl.networkServer(types.NetID(req.ReceiverID))
+	if !ok {
+		return nil, ErrNoRoamingAgreement
+	}
+	req.ProtocolVersion = ns.Protocol.BackendInterfacesVersion()
+	req.MessageType = MessageTypeXmitDataReq
+	req.SenderID = NetID(netID)
+
+	pathFunc := nsRPCPaths.sns
+	if req.DLMetaData != nil {
+		pathFunc = nsRPCPaths.fns
+	}
+	ans := &XmitDataAns{}
+	if err := ns.exchange(ctx, pathFunc, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
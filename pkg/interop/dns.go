@@ -0,0 +1,148 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+const (
+	// defaultDNSCacheTTL is the default time to cache Join Server resolution results.
+	defaultDNSCacheTTL = time.Hour
+	// defaultDNSCacheSize is the default maximum number of cached Join Server resolution results.
+	defaultDNSCacheSize = 4096
+)
+
+type dnsCacheEntry struct {
+	js        joinServerClient // nil if the JoinEUI does not resolve.
+	expiresAt time.Time
+}
+
+// dnsJoinServers resolves Join Servers by JoinEUI through DNS, using the FQDN convention of the LoRaWAN Backend
+// Interfaces specification. The Join Server of a JoinEUI is reachable over HTTPS on the FQDN constructed by
+// JoinServerFQDN if the FQDN resolves to an address (A or AAAA records). NAPTR records are not looked up.
+// Resolution results, both positive and negative, are cached. When the cache is full, expired results are removed
+// first, and then the results that expire first.
+type dnsJoinServers struct {
+	domain     string
+	ttl        time.Duration
+	size       int
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	newClient  func(fqdn string) joinServerClient
+
+	mu    sync.Mutex
+	cache map[types.EUI64]dnsCacheEntry
+}
+
+func newDNSJoinServers(conf config.InteropClientDNS, httpClient *http.Client) *dnsJoinServers {
+	resolver := net.DefaultResolver
+	if conf.Server != "" {
+		server := conf.Server
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, server)
+			},
+		}
+	}
+	ttl := conf.CacheTTL
+	if ttl == 0 {
+		ttl = defaultDNSCacheTTL
+	}
+	size := conf.CacheSize
+	if size <= 0 {
+		size = defaultDNSCacheSize
+	}
+	return &dnsJoinServers{
+		domain:     conf.Domain,
+		ttl:        ttl,
+		size:       size,
+		lookupHost: resolver.LookupHost,
+		newClient: func(fqdn string) joinServerClient {
+			return &joinServerHTTPClient{
+				Client:         *httpClient,
+				NewRequestFunc: makeJoinServerHTTPRequestFunc("https", "", fqdn, 0, jsRPCPaths{}, nil),
+				Protocol:       LoRaWANJoinServerProtocol1_0,
+			}
+		},
+		cache: make(map[types.EUI64]dnsCacheEntry),
+	}
+}
+
+// joinServer returns the Join Server client for the given JoinEUI.
+// If the JoinEUI does not resolve, joinServer returns false.
+func (r *dnsJoinServers) joinServer(ctx context.Context, joinEUI types.EUI64) (joinServerClient, bool, error) {
+	now := time.Now()
+	r.mu.Lock()
+	entry, ok := r.cache[joinEUI]
+	r.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.js, entry.js != nil, nil
+	}
+
+	fqdn := JoinServerFQDN(joinEUI, r.domain)
+	logger := log.FromContext(ctx).WithField("fqdn", fqdn)
+	entry = dnsCacheEntry{
+		expiresAt: now.Add(r.ttl),
+	}
+	if _, err := r.lookupHost(ctx, fqdn); err != nil {
+		if dnsErr, ok := err.(*net.DNSError); !ok || !dnsErr.IsNotFound {
+			return nil, false, err
+		}
+		logger.Debug("Join Server not found in DNS")
+	} else {
+		logger.Debug("Resolved Join Server in DNS")
+		entry.js = r.newClient(fqdn)
+	}
+
+	r.mu.Lock()
+	if _, ok := r.cache[joinEUI]; !ok && len(r.cache) >= r.size {
+		r.evict(now)
+	}
+	r.cache[joinEUI] = entry
+	r.mu.Unlock()
+	return entry.js, entry.js != nil, nil
+}
+
+// evict removes the expired results from the cache. If none of the results are expired, the result that expires
+// first is removed. r.mu must be held.
+func (r *dnsJoinServers) evict(now time.Time) {
+	var (
+		first    types.EUI64
+		firstAt  time.Time
+		hasFirst bool
+	)
+	for joinEUI, entry := range r.cache {
+		if !now.Before(entry.expiresAt) {
+			delete(r.cache, joinEUI)
+			continue
+		}
+		if !hasFirst || entry.expiresAt.Before(firstAt) {
+			first, firstAt, hasFirst = joinEUI, entry.expiresAt, true
+		}
+	}
+	if len(r.cache) >= r.size && hasFirst {
+		delete(r.cache, first)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDNSJoinServers(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	registeredJoinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}
+	unregisteredJoinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}
+	errTest := errors.New("test")
+
+	lookups := map[string]int{}
+	var lookupErr error
+	r := newDNSJoinServers(config.InteropClientDNS{
+		Domain:   "joineuis.test",
+		CacheTTL: time.Hour,
+	}, nil)
+	r.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		lookups[host]++
+		if lookupErr != nil {
+			return nil, lookupErr
+		}
+		if host == JoinServerFQDN(registeredJoinEUI, "joineuis.test") {
+			return []string{"192.0.2.1"}, nil
+		}
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	var newClientFQDNs []string
+	r.newClient = func(fqdn string) joinServerClient {
+		newClientFQDNs = append(newClientFQDNs, fqdn)
+		return &joinServerHTTPClient{}
+	}
+
+	registeredFQDN := "0.0.0.0.0.0.0.d.e.7.5.d.3.b.0.7.joineuis.test"
+	unregisteredFQDN := "1.0.0.0.0.0.0.d.e.7.5.d.3.b.0.7.joineuis.test"
+
+	// Resolve and cache.
+	for i := 0; i < 2; i++ {
+		js, ok, err := r.joinServer(ctx, registeredJoinEUI)
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeTrue)
+		a.So(js, should.NotBeNil)
+	}
+	a.So(lookups[registeredFQDN], should.Equal, 1)
+	a.So(newClientFQDNs, should.Resemble, []string{registeredFQDN})
+
+	// Negative results are cached.
+	for i := 0; i < 2; i++ {
+		js, ok, err := r.joinServer(ctx, unregisteredJoinEUI)
+		a.So(err, should.BeNil)
+		a.So(ok, should.BeFalse)
+		a.So(js, should.BeNil)
+	}
+	a.So(lookups[unregisteredFQDN], should.Equal, 1)
+
+	// Expired entries are resolved again and resolution errors are not cached.
+	r.cache[registeredJoinEUI] = dnsCacheEntry{
+		js:        r.cache[registeredJoinEUI].js,
+		expiresAt: time.Now().Add(-time.Second),
+	}
+	lookupErr = errTest
+	for i := 0; i < 2; i++ {
+		_, _, err := r.joinServer(ctx, registeredJoinEUI)
+		a.So(err, should.HaveSameErrorDefinitionAs, errTest)
+	}
+	a.So(lookups[registeredFQDN], should.Equal, 3)
+
+	lookupErr = nil
+	_, ok, err := r.joinServer(ctx, registeredJoinEUI)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+	a.So(lookups[registeredFQDN], should.Equal, 4)
+}
+
+func TestDNSJoinServersCacheSize(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	r := newDNSJoinServers(config.InteropClientDNS{
+		Domain:    "joineuis.test",
+		CacheTTL:  time.Hour,
+		CacheSize: 2,
+	}, nil)
+	r.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+
+	joinEUIs := []types.EUI64{
+		{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00},
+		{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01},
+		{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x02},
+		{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x03},
+	}
+	for _, joinEUI := range joinEUIs[:2] {
+		_, _, err := r.joinServer(ctx, joinEUI)
+		a.So(err, should.BeNil)
+	}
+	a.So(r.cache, should.HaveLength, 2)
+
+	// The result that expires first is evicted.
+	_, _, err := r.joinServer(ctx, joinEUIs[2])
+	a.So(err, should.BeNil)
+	a.So(r.cache, should.HaveLength, 2)
+	a.So(r.cache, should.NotContainKey, joinEUIs[0])
+	a.So(r.cache, should.ContainKey, joinEUIs[2])
+
+	// Expired results are evicted first.
+	r.cache[joinEUIs[2]] = dnsCacheEntry{
+		expiresAt: time.Now().Add(-time.Second),
+	}
+	_, _, err = r.joinServer(ctx, joinEUIs[3])
+	a.So(err, should.BeNil)
+	a.So(r.cache, should.HaveLength, 2)
+	a.So(r.cache, should.ContainKey, joinEUIs[1])
+	a.So(r.cache, should.ContainKey, joinEUIs[3])
+}
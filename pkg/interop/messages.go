@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -219,6 +220,105 @@ type HomeNSAns struct {
 	HNetID NetID
 }
 
+// NsNsMessageHeader contains the message header for NS to NS messages.
+type NsNsMessageHeader struct {
+	MessageHeader
+	SenderID   NetID
+	ReceiverID NetID
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsNsMessageHeader) AnswerHeader() (NsNsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsNsMessageHeader{}, err
+	}
+	return NsNsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		ReceiverID:    h.SenderID,
+	}, nil
+}
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+// In downlink metadata, only the ULToken is used.
+type GWInfoElement struct {
+	ID        Buffer   `json:",omitempty"`
+	RFRegion  string   `json:",omitempty"`
+	ULToken   Buffer   `json:",omitempty"`
+	DLAllowed bool     `json:",omitempty"`
+	RSSI      *int32   `json:",omitempty"`
+	SNR       *float32 `json:",omitempty"`
+	Lat       *float64 `json:",omitempty"`
+	Lon       *float64 `json:",omitempty"`
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI     *EUI64   `json:",omitempty"`
+	DevAddr    *DevAddr `json:",omitempty"`
+	FPort      *uint8   `json:",omitempty"`
+	FCntUp     *uint32  `json:",omitempty"`
+	Confirmed  bool     `json:",omitempty"`
+	DataRate   *uint32  `json:",omitempty"`
+	ULFreq     *float64 `json:",omitempty"` // MHz
+	FNSULToken Buffer   `json:",omitempty"`
+	RecvTime   time.Time
+	RFRegion   string `json:",omitempty"`
+	GWCnt      int
+	GWInfo     []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI         *EUI64          `json:",omitempty"`
+	FPort          *uint8          `json:",omitempty"`
+	FCntDown       *uint32         `json:",omitempty"`
+	Confirmed      bool            `json:",omitempty"`
+	DLFreq1        *float64        `json:",omitempty"` // MHz
+	DLFreq2        *float64        `json:",omitempty"` // MHz
+	RXDelay1       *uint32         `json:",omitempty"` // seconds
+	ClassMode      string          `json:",omitempty"`
+	DataRate1      *uint32         `json:",omitempty"`
+	DataRate2      *uint32         `json:",omitempty"`
+	FNSULToken     Buffer          `json:",omitempty"`
+	GWInfo         []GWInfoElement `json:",omitempty"`
+	HiPriorityFlag bool            `json:",omitempty"`
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsNsMessageHeader
+	Result   Result
+	Lifetime *uint32 `json:",omitempty"`
+	DevEUI   *EUI64  `json:",omitempty"`
+}
+
+// XmitDataReq is a transmit data request message.
+// Uplink messages, sent by the fNS to the sNS, contain ULMetaData.
+// Downlink messages, sent by the sNS to the fNS, contain DLMetaData.
+type XmitDataReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsNsMessageHeader
+	Result  Result
+	DLFreq1 *float64 `json:",omitempty"` // MHz
+	DLFreq2 *float64 `json:",omitempty"` // MHz
+}
+
 // parseMessage parses the header and the message type of the request body.
 // This middleware sets the header in the context on the `headerKey` and the message on the `messageKey`.
 func parseMessage() echo.MiddlewareFunc {
@@ -258,6 +358,14 @@ func parseMessage() echo.MiddlewareFunc {
 				msg = &HomeNSReq{}
 			case MessageTypeHomeNSAns:
 				msg = &HomeNSAns{}
+			case MessageTypePRStartReq:
+				msg = &PRStartReq{}
+			case MessageTypePRStartAns:
+				msg = &PRStartAns{}
+			case MessageTypeXmitDataReq:
+				msg = &XmitDataReq{}
+			case MessageTypeXmitDataAns:
+				msg = &XmitDataAns{}
 			default:
 				return ErrMalformedMessage
 			}
@@ -54,11 +54,14 @@ type HomeNetworkServer interface {
 }
 
 // ServingNetworkServer represents a Serving Network Server.
+// In passive roaming, the Home Network Server is the Serving Network Server.
 type ServingNetworkServer interface {
+	PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error)
 }
 
 // ForwardingNetworkServer represents a Forwarding Network Server.
 type ForwardingNetworkServer interface {
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
 }
 
 // ApplicationServer represents an Application Server.
@@ -79,6 +82,14 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*HomeNSAns, error)
 	return nil, errNotRegistered
 }
 
+func (noopServer) PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error) {
+	return nil, errNotRegistered
+}
+
+func (noopServer) XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error) {
+	return nil, errNotRegistered
+}
+
 // Server is the server.
 type Server struct {
 	SenderClientCAs map[string][]*x509.Certificate
@@ -182,13 +193,13 @@ func (s *Server) RegisterHNS(hNS HomeNetworkServer) {
 // RegisterSNS registers the Serving Network Server for hNS-sNS, fNS-sNS and JS-vNS messages.
 func (s *Server) RegisterSNS(sNS ServingNetworkServer) {
 	s.sNS = sNS
-	s.rootGroup.POST("/sns", s.handleNsRequest)
+	s.rootGroup.POST("/sns", s.handleSNSRequest)
 }
 
 // RegisterFNS registers the Forwarding Network Server for sNS-fNS and JS-vNS messages.
 func (s *Server) RegisterFNS(fNS ForwardingNetworkServer) {
 	s.fNS = fNS
-	s.rootGroup.POST("/fns", s.handleNsRequest)
+	s.rootGroup.POST("/fns", s.handleFNSRequest)
 }
 
 // RegisterAS registers the Application Server for JS-AS messages.
@@ -196,12 +207,17 @@ func (s *Server) RegisterAS(as ApplicationServer) {
 	s.as = as
 }
 
-func (s *Server) handleRequest(c echo.Context) error {
+func requestContext(c echo.Context) context.Context {
 	cid := fmt.Sprintf("interop:%s:%s", c.Request().URL.Path, c.Request().Header.Get(echo.HeaderXRequestID))
 	ctx := events.ContextWithCorrelationID(c.Request().Context(), cid)
 	if state := c.Request().TLS; state != nil {
 		ctx = auth.NewContextWithX509DN(ctx, state.PeerCertificates[0].Subject)
 	}
+	return ctx
+}
+
+func (s *Server) handleRequest(c echo.Context) error {
+	ctx := requestContext(c)
 
 	var ans interface{}
 	var err error
@@ -222,6 +238,40 @@ func (s *Server) handleRequest(c echo.Context) error {
 }
 
 func (s *Server) handleNsRequest(c echo.Context) error {
-	// TODO: Implement LoRaWAN roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
+	// TODO: Implement LoRaWAN handover roaming (https://github.com/TheThingsNetwork/lorawan-stack/issues/230)
 	return echo.NewHTTPError(http.StatusNotFound)
 }
+
+func (s *Server) handleSNSRequest(c echo.Context) error {
+	ctx := requestContext(c)
+
+	var ans interface{}
+	var err error
+	switch req := c.Get(messageKey).(type) {
+	case *PRStartReq:
+		ans, err = s.sNS.PRStartRequest(ctx, req)
+	default:
+		return ErrMalformedMessage
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, ans)
+}
+
+func (s *Server) handleFNSRequest(c echo.Context) error {
+	ctx := requestContext(c)
+
+	var ans interface{}
+	var err error
+	switch req := c.Get(messageKey).(type) {
+	case *XmitDataReq:
+		ans, err = s.fNS.XmitDataRequest(ctx, req)
+	default:
+		return ErrMalformedMessage
+	}
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, ans)
+}
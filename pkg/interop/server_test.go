@@ -16,6 +16,7 @@ package interop_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -36,6 +37,7 @@ func TestServeHTTP(t *testing.T) {
 		sNS               ServingNetworkServer
 		fNS               ForwardingNetworkServer
 		AS                ApplicationServer
+		Path              string
 		RequestBody       interface{}
 		ResponseAssertion func(*testing.T, *http.Response) bool
 	}{
@@ -87,6 +89,70 @@ func TestServeHTTP(t *testing.T) {
 				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
 			},
 		},
+		{
+			Name: "PRStartReq/Success",
+			sNS: &mockServingNetworkServer{
+				PRStartRequestFunc: func(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &PRStartAns{
+						NsNsMessageHeader: header,
+						Result: Result{
+							ResultCode: ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			Path: "/sns",
+			RequestBody: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypePRStartReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x02},
+				},
+				PHYPayload: Buffer{0x40, 0x42, 0xff, 0xff, 0x42},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg PRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.MessageType, should.Equal, MessageTypePRStartAns) &&
+					a.So(msg.SenderID, should.Resemble, NetID{0x0, 0x0, 0x02}) &&
+					a.So(msg.ReceiverID, should.Resemble, NetID{0x0, 0x0, 0x01}) &&
+					a.So(msg.Result, should.Resemble, Result{ResultCode: ResultSuccess})
+			},
+		},
+		{
+			Name: "XmitDataReq/NotRegistered",
+			Path: "/fns",
+			RequestBody: &XmitDataReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					MessageHeader: MessageHeader{
+						MessageType:     MessageTypeXmitDataReq,
+						ProtocolVersion: "1.1",
+					},
+					SenderID:   NetID{0x0, 0x0, 0x01},
+					ReceiverID: NetID{0x0, 0x0, 0x02},
+				},
+				PHYPayload: Buffer{0x60, 0x42, 0xff, 0xff, 0x42},
+				DLMetaData: &DLMetaData{
+					ClassMode: "A",
+				},
+			},
+			ResponseAssertion: func(t *testing.T, res *http.Response) bool {
+				a := assertions.New(t)
+				return a.So(res.StatusCode, should.Equal, http.StatusNotFound)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -107,6 +173,9 @@ func TestServeHTTP(t *testing.T) {
 			if tc.sNS != nil {
 				s.RegisterSNS(tc.sNS)
 			}
+			if tc.fNS != nil {
+				s.RegisterFNS(tc.fNS)
+			}
 			if tc.AS != nil {
 				s.RegisterAS(tc.AS)
 			}
@@ -121,7 +190,7 @@ func TestServeHTTP(t *testing.T) {
 			if !a.So(err, should.BeNil) {
 				t.Fatal("Failed to marshal request body")
 			}
-			res, err := client.Post(srv.URL, "application/json", bytes.NewReader(buf))
+			res, err := client.Post(srv.URL+tc.Path, "application/json", bytes.NewReader(buf))
 			if !a.So(err, should.BeNil) {
 				t.Fatal("Request failed")
 			}
@@ -0,0 +1,194 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// claimAuthenticationCodeLength is the length in bytes of generated claim authentication codes.
+const claimAuthenticationCodeLength = 4
+
+var (
+	errClaimAuthenticationCode         = errors.DefinePermissionDenied("claim_authentication_code", "invalid claim authentication code")
+	errClaimAuthenticationCodeNotValid = errors.DefinePermissionDenied("claim_authentication_code_not_valid", "claim authentication code is not valid at this time")
+)
+
+// claimAuthenticationCodePaths are the paths of the claim authentication code of the end device.
+var claimAuthenticationCodePaths = [...]string{
+	"claim_authentication_code.valid_from",
+	"claim_authentication_code.valid_to",
+	"claim_authentication_code.value",
+}
+
+// claimTransferPaths are the paths of the end device that are transferred to the target end device when claiming.
+var claimTransferPaths = [...]string{
+	"claim_authentication_code",
+	"net_id",
+	"provisioner_id",
+	"provisioning_data",
+	"resets_join_nonces",
+	"root_keys",
+}
+
+// claimTargetPaths are the paths of the end device that can be set on the target end device when claiming.
+var claimTargetPaths = [...]string{
+	"application_server_address",
+	"application_server_id",
+	"application_server_kek_label",
+	"claim_authentication_code",
+	"net_id",
+	"network_server_address",
+	"network_server_kek_label",
+}
+
+// prepareClaimAuthenticationCode validates the validity window of the given claim authentication code.
+// If the code has no value, a copy of the code with a random value is returned.
+func prepareClaimAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode) (*ttnpb.EndDeviceAuthenticationCode, error) {
+	if code == nil {
+		return nil, nil
+	}
+	if code.ValidFrom != nil && code.ValidTo != nil && code.ValidTo.Before(*code.ValidFrom) {
+		return nil, errInvalidFieldValue.WithAttributes("field", "claim_authentication_code.valid_to")
+	}
+	if len(code.Value) > 0 {
+		return code, nil
+	}
+	generated := *code
+	generated.Value = random.Bytes(claimAuthenticationCodeLength)
+	return &generated, nil
+}
+
+// verifyClaimAuthenticationCode verifies that the given code matches the stored claim authentication code and that
+// the stored code is valid at the given time.
+func verifyClaimAuthenticationCode(stored *ttnpb.EndDeviceAuthenticationCode, code []byte, at time.Time) error {
+	if stored == nil || len(stored.Value) == 0 || subtle.ConstantTimeCompare(stored.Value, code) != 1 {
+		return errClaimAuthenticationCode
+	}
+	if stored.ValidFrom != nil && at.Before(*stored.ValidFrom) ||
+		stored.ValidTo != nil && at.After(*stored.ValidTo) {
+		return errClaimAuthenticationCodeNotValid
+	}
+	return nil
+}
+
+// VerifyClaimAuthenticationCode verifies the claim authentication code of the end device identified by the JoinEUI
+// and DevEUI of the given request, and returns the identifiers of the end device if the code is valid.
+// The root keys of the end device are never read.
+func (js *JoinServer) VerifyClaimAuthenticationCode(ctx context.Context, req *ttnpb.VerifyClaimAuthenticationCodeRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+
+	dev, err := js.devices.GetByEUI(ctx, req.JoinEUI, req.DevEUI, []string{
+		"claim_authentication_code",
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errDeviceNotFound
+		}
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	if err := verifyClaimAuthenticationCode(dev.ClaimAuthenticationCode, req.AuthenticationCode, time.Now()); err != nil {
+		return nil, err
+	}
+	return &dev.EndDeviceIdentifiers, nil
+}
+
+// TransferEndDevice transfers the end device identified by the JoinEUI and DevEUI of the given end device to the
+// identifiers of the given end device, and sets the fields in the field mask on the transferred end device.
+// The root keys are transferred within the Join Server and are never returned.
+// If the transferred end device can not be stored, the source end device is restored.
+func (js *JoinServer) TransferEndDevice(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if req.EndDevice.JoinEUI == nil || req.EndDevice.JoinEUI.IsZero() {
+		return nil, errNoJoinEUI
+	}
+	if req.EndDevice.DevEUI == nil || req.EndDevice.DevEUI.IsZero() {
+		return nil, errNoDevEUI
+	}
+	if !ttnpb.HasOnlyAllowedFields(req.FieldMask.Paths, claimTargetPaths[:]...) {
+		return nil, errInvalidFieldMask
+	}
+
+	source, err := js.devices.GetByEUI(ctx, *req.EndDevice.JoinEUI, *req.EndDevice.DevEUI, ttnpb.EndDeviceFieldPathsTopLevel)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errDeviceNotFound
+		}
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	logger := log.FromContext(ctx).WithField("source_device_uid", unique.ID(ctx, source.EndDeviceIdentifiers))
+
+	targetIDs := req.EndDevice.EndDeviceIdentifiers
+	targetIDs.DevAddr = nil
+	target := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: targetIDs,
+	}
+	if err := target.SetFields(source, claimTransferPaths[:]...); err != nil {
+		return nil, err
+	}
+	if err := target.SetFields(&req.EndDevice, req.FieldMask.Paths...); err != nil {
+		return nil, err
+	}
+	sets := append(append(ttnpb.ExcludeFields(claimTransferPaths[:], req.FieldMask.Paths...), req.FieldMask.Paths...),
+		"ids.application_ids",
+		"ids.dev_eui",
+		"ids.device_id",
+		"ids.join_eui",
+	)
+
+	if err := DeleteDevice(ctx, js.devices, source.ApplicationIdentifiers, source.DeviceID); err != nil {
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	dev, err := js.devices.SetByID(ctx, targetIDs.ApplicationIdentifiers, targetIDs.DeviceID, req.FieldMask.Paths, func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if stored != nil {
+			return nil, nil, errDuplicateIdentifiers
+		}
+		return target, sets, nil
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to store transferred end device, restore source end device")
+		if _, rErr := js.devices.SetByID(ctx, source.ApplicationIdentifiers, source.DeviceID, nil, func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored != nil {
+				return nil, nil, errDuplicateIdentifiers
+			}
+			return source, append(ttnpb.ExcludeFields(ttnpb.EndDeviceFieldPathsTopLevel, "created_at", "ids", "updated_at"),
+				"ids.application_ids",
+				"ids.dev_eui",
+				"ids.device_id",
+				"ids.join_eui",
+			), nil
+		}); rErr != nil {
+			logger.WithError(rErr).Error("Failed to restore source end device")
+		}
+		return nil, errRegistryOperation.WithCause(err)
+	}
+	events.Publish(evtDeleteEndDevice(ctx, source.EndDeviceIdentifiers, nil))
+	events.Publish(evtCreateEndDevice(ctx, targetIDs, nil))
+	return dev, nil
+}
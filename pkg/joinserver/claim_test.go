@@ -0,0 +1,344 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestVerifyClaimAuthenticationCode(t *testing.T) {
+	ctx := test.Context()
+
+	errTest := errors.New("test")
+	before := time.Now().Add(-time.Hour)
+	after := time.Now().Add(time.Hour)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+		JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	deviceWithCode := func(code *ttnpb.EndDeviceAuthenticationCode) func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error) {
+		return func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+			a := assertions.New(test.MustTFromContext(ctx))
+			a.So(joinEUI, should.Resemble, *ids.JoinEUI)
+			a.So(devEUI, should.Resemble, *ids.DevEUI)
+			a.So(paths, should.HaveSameElementsDeep, []string{
+				"claim_authentication_code",
+			})
+			return &ttnpb.EndDevice{
+				EndDeviceIdentifiers:    ids,
+				ClaimAuthenticationCode: code,
+			}, nil
+		}
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		ContextFunc func(context.Context) context.Context
+
+		GetByEUI           func(context.Context, types.EUI64, types.EUI64, []string) (*ttnpb.EndDevice, error)
+		AuthenticationCode []byte
+
+		ErrorAssertion func(*testing.T, error) bool
+	}{
+		{
+			Name:        "No cluster auth",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, errTest) },
+			GetByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+				t.Error("GetByEUI must not be called")
+				return nil, errTest
+			},
+			AuthenticationCode: []byte{0x01, 0x02},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, errTest)
+			},
+		},
+		{
+			Name:        "Registry error",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			GetByEUI: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+				return nil, errTest
+			},
+			AuthenticationCode: []byte{0x01, 0x02},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, ErrRegistryOperation.WithCause(errTest))
+			},
+		},
+		{
+			Name:               "No code",
+			ContextFunc:        func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			GetByEUI:           deviceWithCode(nil),
+			AuthenticationCode: []byte{0x01, 0x02},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrClaimAuthenticationCode)
+			},
+		},
+		{
+			Name:        "Code mismatch",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			GetByEUI: deviceWithCode(&ttnpb.EndDeviceAuthenticationCode{
+				Value: []byte{0x01, 0x02},
+			}),
+			AuthenticationCode: []byte{0x01, 0x03},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrClaimAuthenticationCode)
+			},
+		},
+		{
+			Name:        "Code not yet valid",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			GetByEUI: deviceWithCode(&ttnpb.EndDeviceAuthenticationCode{
+				Value:     []byte{0x01, 0x02},
+				ValidFrom: &after,
+			}),
+			AuthenticationCode: []byte{0x01, 0x02},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrClaimAuthenticationCodeNotValid)
+			},
+		},
+		{
+			Name:        "Code expired",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			GetByEUI: deviceWithCode(&ttnpb.EndDeviceAuthenticationCode{
+				Value:   []byte{0x01, 0x02},
+				ValidTo: &before,
+			}),
+			AuthenticationCode: []byte{0x01, 0x02},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.HaveSameErrorDefinitionAs, ErrClaimAuthenticationCodeNotValid)
+			},
+		},
+		{
+			Name:        "Valid code",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			GetByEUI: deviceWithCode(&ttnpb.EndDeviceAuthenticationCode{
+				Value:     []byte{0x01, 0x02},
+				ValidFrom: &before,
+				ValidTo:   &after,
+			}),
+			AuthenticationCode: []byte{0x01, 0x02},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.ContextWithT(tc.ContextFunc(ctx), t)
+
+			js := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						GetByEUIFunc: tc.GetByEUI,
+					},
+				},
+			)).(*JoinServer)
+			res, err := js.VerifyClaimAuthenticationCode(ctx, &ttnpb.VerifyClaimAuthenticationCodeRequest{
+				JoinEUI:            *ids.JoinEUI,
+				DevEUI:             *ids.DevEUI,
+				AuthenticationCode: tc.AuthenticationCode,
+			})
+
+			if tc.ErrorAssertion != nil {
+				if !tc.ErrorAssertion(t, err) {
+					t.Errorf("Received unexpected error: %s", err)
+				}
+				a.So(res, should.BeNil)
+				return
+			}
+
+			a.So(err, should.BeNil)
+			a.So(res, should.Resemble, &ids)
+		})
+	}
+}
+
+func TestTransferEndDevice(t *testing.T) {
+	ctx := test.Context()
+
+	errTest := errors.New("test")
+
+	sourceIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "source-app"},
+		DeviceID:               "source-dev",
+		JoinEUI:                &types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	targetIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "target-app"},
+		DeviceID:               "target-dev",
+		JoinEUI:                sourceIDs.JoinEUI,
+		DevEUI:                 sourceIDs.DevEUI,
+	}
+	rootKeys := &ttnpb.RootKeys{
+		AppKey: &ttnpb.KeyEnvelope{
+			Key: &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		},
+	}
+	newSource := func() *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers:     sourceIDs,
+			NetworkServerAddress:     "source-ns",
+			ApplicationServerAddress: "source-as",
+			RootKeys:                 rootKeys,
+			ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+				Value: []byte{0x01, 0x02},
+			},
+		}
+	}
+	req := &ttnpb.SetEndDeviceRequest{
+		EndDevice: ttnpb.EndDevice{
+			EndDeviceIdentifiers:     targetIDs,
+			NetworkServerAddress:     "target-ns",
+			ApplicationServerAddress: "target-as",
+		},
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{
+				"application_server_address",
+				"claim_authentication_code",
+				"network_server_address",
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		Request        *ttnpb.SetEndDeviceRequest
+		CreateErr      error
+		ErrorAssertion func(*testing.T, error) bool
+		ExpectedDevice *ttnpb.EndDevice
+	}{
+		{
+			Name:        "No cluster auth",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, errTest) },
+			Request:     req,
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, errTest)
+			},
+			ExpectedDevice: newSource(),
+		},
+		{
+			Name:        "Root keys in field mask",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Request: &ttnpb.SetEndDeviceRequest{
+				EndDevice: req.EndDevice,
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"root_keys"},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			ExpectedDevice: newSource(),
+		},
+		{
+			Name:        "Registry error",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Request:     req,
+			CreateErr:   errTest,
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.EqualErrorOrDefinition, ErrRegistryOperation.WithCause(errTest))
+			},
+			ExpectedDevice: newSource(),
+		},
+		{
+			Name:        "Success",
+			ContextFunc: func(ctx context.Context) context.Context { return clusterauth.NewContext(ctx, nil) },
+			Request:     req,
+			ExpectedDevice: &ttnpb.EndDevice{
+				EndDeviceIdentifiers:     targetIDs,
+				NetworkServerAddress:     "target-ns",
+				ApplicationServerAddress: "target-as",
+				RootKeys:                 rootKeys,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx := test.ContextWithT(tc.ContextFunc(ctx), t)
+
+			devices := map[string]*ttnpb.EndDevice{
+				sourceIDs.DeviceID: newSource(),
+			}
+			js := test.Must(New(
+				component.MustNew(test.GetLogger(t), &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						GetByEUIFunc: func(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, error) {
+							for _, dev := range devices {
+								if dev.JoinEUI.Equal(joinEUI) && dev.DevEUI.Equal(devEUI) {
+									return dev, nil
+								}
+							}
+							t.Error("Source device not found")
+							return nil, errTest
+						},
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+							dev, _, err := f(devices[devID])
+							if err != nil {
+								return nil, err
+							}
+							if dev == nil {
+								delete(devices, devID)
+								return nil, nil
+							}
+							if devID == targetIDs.DeviceID && tc.CreateErr != nil {
+								return nil, tc.CreateErr
+							}
+							devices[devID] = dev
+							return &ttnpb.EndDevice{
+								EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+							}, nil
+						},
+					},
+				},
+			)).(*JoinServer)
+			res, err := js.TransferEndDevice(ctx, tc.Request)
+
+			if tc.ErrorAssertion != nil {
+				if !tc.ErrorAssertion(t, err) {
+					t.Errorf("Received unexpected error: %s", err)
+				}
+				a.So(res, should.BeNil)
+			} else {
+				a.So(err, should.BeNil)
+				if a.So(res, should.NotBeNil) {
+					a.So(res.EndDeviceIdentifiers, should.Resemble, targetIDs)
+					a.So(res.RootKeys, should.BeNil)
+				}
+			}
+			if !a.So(devices, should.HaveLength, 1) {
+				return
+			}
+			for _, dev := range devices {
+				a.So(dev, should.Resemble, tc.ExpectedDevice)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type dcsJsServer struct {
+	JS *JoinServer
+}
+
+// VerifyClaimAuthenticationCode verifies the claim authentication code of the end device identified by the supplied request.
+func (srv dcsJsServer) VerifyClaimAuthenticationCode(ctx context.Context, req *ttnpb.VerifyClaimAuthenticationCodeRequest) (*ttnpb.EndDeviceIdentifiers, error) {
+	return srv.JS.VerifyClaimAuthenticationCode(ctx, req)
+}
+
+// TransferEndDevice transfers the end device identified by the JoinEUI and DevEUI of the supplied end device.
+func (srv dcsJsServer) TransferEndDevice(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+	return srv.JS.TransferEndDevice(ctx, req)
+}
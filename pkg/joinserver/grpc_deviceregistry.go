@@ -58,6 +58,11 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 		}
 		paths = append(paths, "provisioner_id", "provisioning_data")
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, claimAuthenticationCodePaths[:]...) {
+		if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ_KEYS); err != nil {
+			return nil, err
+		}
+	}
 	logger := log.FromContext(ctx)
 	dev, err := srv.JS.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, paths)
 	if errors.IsNotFound(err) {
@@ -149,6 +154,18 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, claimAuthenticationCodePaths[:]...) {
+		if err := rights.RequireApplication(ctx, req.EndDevice.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS); err != nil {
+			return nil, err
+		}
+		if ttnpb.HasAnyField(req.FieldMask.Paths, "claim_authentication_code.value") {
+			code, err := prepareClaimAuthenticationCode(req.EndDevice.ClaimAuthenticationCode)
+			if err != nil {
+				return nil, err
+			}
+			req.EndDevice.ClaimAuthenticationCode = code
+		}
+	}
 
 	var evt events.Event
 	dev, err := srv.JS.devices.SetByID(ctx, req.EndDevice.ApplicationIdentifiers, req.EndDevice.DeviceID, req.FieldMask.Paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
@@ -621,6 +621,110 @@ func TestDeviceRegistrySet(t *testing.T) {
 			},
 			SetByIDCalls: 1,
 		},
+
+		{
+			Name: "Set claim authentication code/Permission denied",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers:    deepcopy.Copy(registeredDevice.EndDeviceIdentifiers).(ttnpb.EndDeviceIdentifiers),
+					ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"claim_authentication_code"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name: "Set claim authentication code/Invalid validity",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: deepcopy.Copy(registeredDevice.EndDeviceIdentifiers).(ttnpb.EndDeviceIdentifiers),
+					ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+						ValidFrom: timePtr(time.Unix(2, 0).UTC()),
+						ValidTo:   timePtr(time.Unix(1, 0).UTC()),
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"claim_authentication_code"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name: "Set claim authentication code/Generate",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+							ttnpb.RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+						),
+					},
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: ttnpb.EndDevice{
+					EndDeviceIdentifiers: deepcopy.Copy(registeredDevice.EndDeviceIdentifiers).(ttnpb.EndDeviceIdentifiers),
+					ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+						ValidTo: timePtr(time.Unix(1, 0).UTC()),
+					},
+				},
+				FieldMask: pbtypes.FieldMask{
+					Paths: []string{"claim_authentication_code"},
+				},
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				dev, sets, err := cb(deepcopy.Copy(registeredDevice).(*ttnpb.EndDevice))
+				a.So(sets, should.HaveSameElementsDeep, []string{
+					"claim_authentication_code",
+				})
+				return dev, err
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				a := assertions.New(t)
+				return a.So(dev.ClaimAuthenticationCode, should.NotBeNil) &&
+					a.So(dev.ClaimAuthenticationCode.Value, should.HaveLength, 4) &&
+					a.So(dev.ClaimAuthenticationCode.ValidTo, should.Resemble, timePtr(time.Unix(1, 0).UTC()))
+			},
+			SetByIDCalls: 1,
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
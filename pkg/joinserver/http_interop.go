@@ -121,6 +121,14 @@ func (srv interopServer) HomeNSRequest(ctx context.Context, in *interop.HomeNSRe
 
 	netID, err := srv.JS.GetHomeNetID(ctx, types.EUI64(in.ReceiverID), types.EUI64(in.DevEUI))
 	if err != nil {
+		switch {
+		case errors.Resemble(err, errCallerNotAuthorized):
+			return nil, interop.ErrActivation.WithCause(err)
+		case errors.Resemble(err, errRegistryOperation):
+			if errors.IsNotFound(errors.Cause(err)) {
+				return nil, interop.ErrUnknownDevEUI.WithCause(err)
+			}
+		}
 		return nil, err
 	}
 	if netID == nil {
@@ -522,6 +522,30 @@ func TestInteropHomeNSRequest(t *testing.T) {
 				return a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrActivation)
 			},
 		},
+		{
+			Name: "UnknownDevEUI",
+			HomeNSReq: &interop.HomeNSReq{
+				NsJsMessageHeader: interop.NsJsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						ProtocolVersion: "1.0",
+						MessageType:     interop.MessageTypeHomeNSReq,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x13},
+					ReceiverID: interop.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+					SenderNSID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				DevEUI: interop.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			},
+			ExpectedJoinEUI: types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			ExpectedDevEUI:  types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+			GetNetIDFunc: func() (*types.NetID, error) {
+				return nil, errRegistryOperation.WithCause(errDeviceNotFound)
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownDevEUI)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			ctx := log.NewContext(test.Context(), test.GetLogger(t))
@@ -74,6 +74,7 @@ type JoinServer struct {
 	grpc struct {
 		nsJs      nsJsServer
 		asJs      asJsServer
+		dcsJs     dcsJsServer
 		jsDevices jsEndDeviceRegistryServer
 		js        jsServer
 	}
@@ -103,15 +104,18 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	js.grpc.jsDevices = jsEndDeviceRegistryServer{JS: js}
 	js.grpc.asJs = asJsServer{JS: js}
 	js.grpc.nsJs = nsJsServer{JS: js}
+	js.grpc.dcsJs = dcsJsServer{JS: js}
 	js.grpc.js = jsServer{JS: js}
 	js.interop = interopServer{JS: js}
 
 	// TODO: Support authentication from non-cluster-local NS and AS (https://github.com/TheThingsNetwork/lorawan-stack/issues/4).
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.DcsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Js", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
-	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", cluster.HookName, c.ClusterAuthUnaryHook())
-	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", cluster.HookName, c.ClusterAuthUnaryHook(ttnpb.ClusterRole_NETWORK_SERVER))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook(ttnpb.ClusterRole_APPLICATION_SERVER))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.DcsJs", cluster.HookName, c.ClusterAuthUnaryHook(ttnpb.ClusterRole_DEVICE_CLAIMING_SERVER))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Js", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(js)
@@ -128,6 +132,7 @@ func (js *JoinServer) Roles() []ttnpb.ClusterRole {
 func (js *JoinServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsJsServer(s, js.grpc.asJs)
 	ttnpb.RegisterNsJsServer(s, js.grpc.nsJs)
+	ttnpb.RegisterDcsJsServer(s, js.grpc.dcsJs)
 	ttnpb.RegisterJsEndDeviceRegistryServer(s, js.grpc.jsDevices)
 	ttnpb.RegisterJsServer(s, js.grpc.js)
 }
@@ -24,14 +24,16 @@ import (
 )
 
 var (
-	ErrCallerNotAuthorized = errCallerNotAuthorized
-	ErrDevNonceTooSmall    = errDevNonceTooSmall
-	ErrNoAppSKey           = errNoAppSKey
-	ErrNoFNwkSIntKey       = errNoFNwkSIntKey
-	ErrNoNwkSEncKey        = errNoNwkSEncKey
-	ErrNoSNwkSIntKey       = errNoSNwkSIntKey
-	ErrRegistryOperation   = errRegistryOperation
-	ErrReuseDevNonce       = errReuseDevNonce
+	ErrCallerNotAuthorized             = errCallerNotAuthorized
+	ErrClaimAuthenticationCode         = errClaimAuthenticationCode
+	ErrClaimAuthenticationCodeNotValid = errClaimAuthenticationCodeNotValid
+	ErrDevNonceTooSmall                = errDevNonceTooSmall
+	ErrNoAppSKey                       = errNoAppSKey
+	ErrNoFNwkSIntKey                   = errNoFNwkSIntKey
+	ErrNoNwkSEncKey                    = errNoNwkSEncKey
+	ErrNoSNwkSIntKey                   = errNoSNwkSIntKey
+	ErrRegistryOperation               = errRegistryOperation
+	ErrReuseDevNonce                   = errReuseDevNonce
 )
 
 func KeyToBytes(key types.AES128Key) []byte { return key[:] }
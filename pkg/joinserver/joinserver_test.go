@@ -51,6 +51,8 @@ var (
 
 func eui64Ptr(eui types.EUI64) *types.EUI64 { return &eui }
 
+func timePtr(t time.Time) *time.Time { return &t }
+
 func mustEncryptJoinAccept(key types.AES128Key, pld []byte) []byte {
 	b, err := crypto.EncryptJoinAccept(key, pld)
 	if err != nil {
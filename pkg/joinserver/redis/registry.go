@@ -55,6 +55,8 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is an implementation of joinserver.DeviceRegistry.
+// The devices and the EUI and provisioner indexes are updated in the same transaction, so all keys of the registry
+// are in the same hash slot with Redis Cluster.
 type DeviceRegistry struct {
 	Redis *ttnredis.Client
 }
@@ -71,15 +73,15 @@ func provisionerUniqueID(dev *ttnpb.EndDevice) (string, error) {
 }
 
 func (r *DeviceRegistry) uidKey(uid string) string {
-	return r.Redis.Key("uid", uid)
+	return r.Redis.SlotKey("uid", uid)
 }
 
 func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
-	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
+	return r.Redis.SlotKey("eui", joinEUI.String(), devEUI.String())
 }
 
 func (r *DeviceRegistry) provisionerKey(provisionerID, pid string) string {
-	return r.Redis.Key("provisioner", provisionerID, pid)
+	return r.Redis.SlotKey("provisioner", provisionerID, pid)
 }
 
 // GetByID gets device by appID, devID.
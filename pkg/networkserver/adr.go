@@ -15,7 +15,11 @@
 package networkserver
 
 import (
-	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -75,6 +79,9 @@ const optimalADRUplinkCount = 20
 // DefaultADRMargin is the default ADR margin used if not specified in MACSettings of the device or NS-wide defaults.
 const DefaultADRMargin = 15
 
+// conservativeADRMargin is the margin in dB added by the conservative ADR algorithm.
+const conservativeADRMargin = 5
+
 func deviceADRMargin(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) float32 {
 	if dev.MACSettings != nil && dev.MACSettings.ADRMargin != nil {
 		return dev.MACSettings.ADRMargin.Value
@@ -132,7 +139,98 @@ loop:
 	return float32(lost) / float32(n)
 }
 
-func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MACSettings) error {
+// ADRAlgorithm computes the desired ADR parameters of a device.
+type ADRAlgorithm interface {
+	// AdaptDataRate sets the desired ADR data rate index, transmit power index and number of transmissions
+	// in dev.MACState.DesiredParameters, based on dev.RecentADRUplinks.
+	// dev.RecentADRUplinks must not be empty.
+	AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy band.Band, defaults ttnpb.MACSettings) error
+}
+
+// defaultADRAlgorithms are the ADR algorithms built into the Network Server.
+var defaultADRAlgorithms = map[ttnpb.ADRAlgorithm]ADRAlgorithm{
+	ttnpb.ADR_ALGORITHM_MARGIN: marginADRAlgorithm{},
+	ttnpb.ADR_ALGORITHM_CONSERVATIVE: marginADRAlgorithm{
+		extraMargin:      conservativeADRMargin,
+		maxDataRateSteps: 1,
+	},
+	ttnpb.ADR_ALGORITHM_BLIND: blindADRAlgorithm{},
+	ttnpb.ADR_ALGORITHM_FIXED: fixedADRAlgorithm{},
+}
+
+func deviceADRAlgorithm(dev *ttnpb.EndDevice, phy band.Band, bandDefaults map[string]ttnpb.ADRAlgorithm, defaults ttnpb.MACSettings) ttnpb.ADRAlgorithm {
+	if dev.MACSettings != nil && dev.MACSettings.ADRAlgorithm != nil {
+		return dev.MACSettings.ADRAlgorithm.Value
+	}
+	if alg, ok := bandDefaults[phy.ID]; ok {
+		return alg
+	}
+	if defaults.ADRAlgorithm != nil {
+		return defaults.ADRAlgorithm.Value
+	}
+	return ttnpb.ADR_ALGORITHM_MARGIN
+}
+
+func deviceADRFixedDataRateIndex(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) (ttnpb.DataRateIndex, bool) {
+	if dev.MACSettings != nil && dev.MACSettings.ADRFixedDataRateIndex != nil {
+		return dev.MACSettings.ADRFixedDataRateIndex.Value, true
+	}
+	if defaults.ADRFixedDataRateIndex != nil {
+		return defaults.ADRFixedDataRateIndex.Value, true
+	}
+	return 0, false
+}
+
+var errUnknownADRAlgorithm = errors.DefineNotFound("unknown_adr_algorithm", "unknown ADR algorithm `{algorithm}`")
+
+// adaptDataRate computes the desired ADR parameters of dev using the ADR algorithm configured for dev.
+func (ns *NetworkServer) adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice) error {
+	if len(dev.RecentADRUplinks) == 0 {
+		return nil
+	}
+	_, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
+	if err != nil {
+		return err
+	}
+	alg := deviceADRAlgorithm(dev, phy, ns.bandADRAlgorithms, ns.defaultMACSettings)
+	impl, ok := ns.adrAlgorithms[alg]
+	if !ok {
+		return errUnknownADRAlgorithm.WithAttributes("algorithm", alg)
+	}
+	return impl.AdaptDataRate(ctx, dev, phy, ns.defaultMACSettings)
+}
+
+// adaptNbTrans sets the desired number of transmissions of dev based on the loss rate of ups.
+func adaptNbTrans(dev *ttnpb.EndDevice, ups ...*ttnpb.UplinkMessage) {
+	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
+	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
+		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+	}
+
+	if len(ups) >= 2 {
+		switch r := lossRate(dev.MACState.CurrentParameters.ADRNbTrans, ups...); {
+		case r < 0.05:
+			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
+		case r < 0.10:
+		case r < 0.30:
+			dev.MACState.DesiredParameters.ADRNbTrans = 2 + dev.MACState.DesiredParameters.ADRNbTrans/2
+		default:
+			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+		}
+	}
+}
+
+// marginADRAlgorithm increases the data rate and decreases the transmit power as long as the SNR of recent uplinks
+// leaves enough margin above the demodulation floor.
+type marginADRAlgorithm struct {
+	// extraMargin is the margin in dB added to the device's ADR margin.
+	extraMargin float32
+	// maxDataRateSteps is the maximum number of data rate steps per adaptation. Zero means no limit.
+	maxDataRateSteps uint
+}
+
+// AdaptDataRate implements ADRAlgorithm.
+func (a marginADRAlgorithm) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy band.Band, defaults ttnpb.MACSettings) error {
 	ups := dev.RecentADRUplinks
 	if len(ups) == 0 {
 		return nil
@@ -147,11 +245,6 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttn
 		}
 	}
 
-	_, phy, err := getDeviceBandVersion(dev, fps)
-	if err != nil {
-		return err
-	}
-
 	up := ups[len(ups)-1]
 
 	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
@@ -171,14 +264,17 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttn
 	// minimum (floor) that we need to demodulate the signal. We subtract a
 	// configurable margin, and an extra safety margin if we're afraid that we
 	// don't have enough data for our decision.
-	margin := maxSNR - df - deviceADRMargin(dev, defaults)
+	margin := maxSNR - df - deviceADRMargin(dev, defaults) - a.extraMargin
 	if len(ups) < optimalADRUplinkCount {
 		margin -= safetyMargin
 	}
 
 	// As long as we have enough margin to increase the data rate, we do that.
 	// If we change the DR, we reset the Tx power.
-	for int(dev.MACState.DesiredParameters.ADRDataRateIndex) < int(phy.MaxADRDataRateIndex) {
+	for steps := uint(0); int(dev.MACState.DesiredParameters.ADRDataRateIndex) < int(phy.MaxADRDataRateIndex); steps++ {
+		if a.maxDataRateSteps > 0 && steps >= a.maxDataRateSteps {
+			break
+		}
 		newMargin := margin - drStep
 		if newMargin < 0 {
 			break
@@ -198,21 +294,59 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttn
 		dev.MACState.DesiredParameters.ADRTxPowerIndex++
 	}
 
-	dev.MACState.DesiredParameters.ADRNbTrans = dev.MACState.CurrentParameters.ADRNbTrans
-	if dev.MACState.DesiredParameters.ADRNbTrans > maxNbTrans {
-		dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+	adaptNbTrans(dev, ups...)
+	return nil
+}
+
+// blindADRAlgorithm moves the data rate one step at a time along the data rate ladder of the band, based on the loss
+// rate of recent uplinks only. It is meant for mobile devices, for which the SNR of recent uplinks does not represent
+// the link quality of the next uplinks. The transmit power is always the maximum.
+type blindADRAlgorithm struct{}
+
+// AdaptDataRate implements ADRAlgorithm.
+func (blindADRAlgorithm) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy band.Band, defaults ttnpb.MACSettings) error {
+	ups := dev.RecentADRUplinks
+
+	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	adaptNbTrans(dev, ups...)
+
+	if len(ups) < 2 {
+		return nil
+	}
+	switch r := lossRate(dev.MACState.CurrentParameters.ADRNbTrans, ups...); {
+	case r < 0.05 && len(ups) >= optimalADRUplinkCount:
+		if dev.MACState.DesiredParameters.ADRDataRateIndex < ttnpb.DataRateIndex(phy.MaxADRDataRateIndex) {
+			dev.MACState.DesiredParameters.ADRDataRateIndex++
+		}
+	case r >= 0.30:
+		if dev.MACState.DesiredParameters.ADRDataRateIndex > 0 {
+			dev.MACState.DesiredParameters.ADRDataRateIndex--
+		}
 	}
+	return nil
+}
 
-	if len(ups) >= 2 {
-		switch r := lossRate(dev.MACState.CurrentParameters.ADRNbTrans, ups...); {
-		case r < 0.05:
-			dev.MACState.DesiredParameters.ADRNbTrans = 1 + dev.MACState.DesiredParameters.ADRNbTrans/3
-		case r < 0.10:
-		case r < 0.30:
-			dev.MACState.DesiredParameters.ADRNbTrans = 2 + dev.MACState.DesiredParameters.ADRNbTrans/2
-		default:
-			dev.MACState.DesiredParameters.ADRNbTrans = maxNbTrans
+// fixedADRAlgorithm configures the device to use the fixed data rate from its MAC settings at maximum transmit power.
+// If no fixed data rate is configured, the current data rate is kept.
+// A fixed data rate above the maximum ADR data rate of the band is clamped to that maximum.
+type fixedADRAlgorithm struct{}
+
+// AdaptDataRate implements ADRAlgorithm.
+func (fixedADRAlgorithm) AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy band.Band, defaults ttnpb.MACSettings) error {
+	dev.MACState.DesiredParameters.ADRDataRateIndex = dev.MACState.CurrentParameters.ADRDataRateIndex
+	if drIdx, ok := deviceADRFixedDataRateIndex(dev, defaults); ok {
+		if maxIdx := ttnpb.DataRateIndex(phy.MaxADRDataRateIndex); drIdx > maxIdx {
+			// The index is validated on registration, but may be invalid in the band of a changed frequency plan.
+			log.FromContext(ctx).WithFields(log.Fields(
+				"adr_fixed_data_rate_index", drIdx,
+				"max_adr_data_rate_index", maxIdx,
+			)).Warn("Fixed ADR data rate index exceeds band maximum, use maximum instead")
+			drIdx = maxIdx
 		}
+		dev.MACState.DesiredParameters.ADRDataRateIndex = drIdx
 	}
+	dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	adaptNbTrans(dev, dev.RecentADRUplinks...)
 	return nil
 }
@@ -306,7 +306,12 @@ func TestAdaptDataRate(t *testing.T) {
 
 			dev := CopyEndDevice(tc.Device)
 
-			err := adaptDataRate(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher), ttnpb.MACSettings{})
+			_, phy, err := getDeviceBandVersion(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			err = marginADRAlgorithm{}.AdaptDataRate(test.Context(), dev, phy, ttnpb.MACSettings{})
 			if err != nil && !a.So(err, should.Equal, tc.Error) ||
 				err == nil && !a.So(err, should.BeNil) {
 				t.FailNow()
@@ -320,3 +325,194 @@ func TestAdaptDataRate(t *testing.T) {
 		})
 	}
 }
+
+// adrRecording is a sequence of uplinks of a device, starting at FCnt 1.
+type adrRecording struct {
+	// SNR is the maximum SNR of each uplink.
+	SNR []float32
+	// Lost are the FCnts of uplinks that were not received.
+	Lost map[uint32]bool
+}
+
+var (
+	// staticMeterRecording is a synthetic sequence that models a static meter with good coverage and no loss.
+	staticMeterRecording = adrRecording{
+		SNR: []float32{
+			7.5, 8, 7.25, 7.75, 8.5, 7, 7.5, 8.25, 7.75, 8,
+			7.25, 7.5, 8, 7.75, 7, 8.25, 7.5, 7.75, 8, 7.25,
+			7.5, 8, 7.25, 7.75, 8.5, 7, 7.5, 8.25, 7.75, 8,
+			7.25, 7.5, 8, 7.75, 7, 8.25, 7.5, 7.75, 8, 7.25,
+			7.5, 8, 7.25, 7.75, 8.5, 7, 7.5, 8.25, 7.75, 8,
+			7.25, 7.5, 8, 7.75, 7, 8.25, 7.5, 7.75, 8, 7.25,
+		},
+	}
+	// mobileTrackerRecording is a synthetic sequence that models a vehicle tracker, which moves out of coverage and back.
+	mobileTrackerRecording = adrRecording{
+		SNR: []float32{
+			9, 8.5, 9.5, 8, 9, 7.5, 8.5, 9, 8, 9.5,
+			8, 7, 6.5, 5, 4, 2.5, 1, -1.5, -3, -5,
+			-7, -9, -11, -12.5, -14, -15, -16.5, -17, -18, -17.5,
+			-16, -14.5, -12, -10, -8.5, -6, -4, -2.5, 0, 2,
+			4, 5.5, 7, 8, 8.5, 9, 8.5, 9.5, 9, 8,
+			8.5, 9, 9.5, 8, 9, 8.5, 9, 8, 9.5, 9,
+		},
+		Lost: map[uint32]bool{
+			22: true, 24: true, 25: true, 27: true, 28: true, 29: true, 31: true, 33: true,
+		},
+	}
+)
+
+// simulateADR feeds the uplinks of the sequence one by one to alg and applies the desired ADR parameters as if the device
+// accepted every LinkADRReq immediately. simulateADR returns the ADR parameters after the last uplink.
+func simulateADR(t *testing.T, alg ADRAlgorithm, settings *ttnpb.MACSettings, rec adrRecording) ttnpb.MACParameters {
+	dev := &ttnpb.EndDevice{
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+		MACSettings:       settings,
+		MACState: &ttnpb.MACState{
+			CurrentParameters: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_0,
+				ADRNbTrans:       1,
+			},
+		},
+	}
+	_, phy, err := getDeviceBandVersion(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+	if err != nil {
+		t.Fatalf("Failed to get band: %s", err)
+	}
+	for i, snr := range rec.SNR {
+		fCnt := uint32(i + 1)
+		if rec.Lost[fCnt] {
+			continue
+		}
+		drIdx := dev.MACState.CurrentParameters.ADRDataRateIndex
+		up := newADRUplink(fCnt, 0, 1, false, ttnpb.TxSettings{
+			DataRate:      phy.DataRates[drIdx].Rate,
+			DataRateIndex: drIdx,
+		})
+		up.RxMetadata[0].SNR = snr
+		dev.RecentADRUplinks = appendRecentUplink(dev.RecentADRUplinks, up, optimalADRUplinkCount)
+
+		if err := alg.AdaptDataRate(test.Context(), dev, phy, ttnpb.MACSettings{}); err != nil {
+			t.Fatalf("Failed to adapt data rate after uplink with FCnt %d: %s", fCnt, err)
+		}
+		current, desired := &dev.MACState.CurrentParameters, dev.MACState.DesiredParameters
+		if current.ADRDataRateIndex != desired.ADRDataRateIndex ||
+			current.ADRTxPowerIndex != desired.ADRTxPowerIndex ||
+			current.ADRNbTrans != desired.ADRNbTrans {
+			current.ADRDataRateIndex = desired.ADRDataRateIndex
+			current.ADRTxPowerIndex = desired.ADRTxPowerIndex
+			current.ADRNbTrans = desired.ADRNbTrans
+			dev.RecentADRUplinks = nil
+		}
+	}
+	return dev.MACState.CurrentParameters
+}
+
+func TestADRAlgorithmSimulation(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		Algorithm ttnpb.ADRAlgorithm
+		Settings  *ttnpb.MACSettings
+		Recording adrRecording
+		Expected  ttnpb.MACParameters
+	}{
+		{
+			Name:      "margin/static meter",
+			Algorithm: ttnpb.ADR_ALGORITHM_MARGIN,
+			Recording: staticMeterRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_5,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "conservative/static meter",
+			Algorithm: ttnpb.ADR_ALGORITHM_CONSERVATIVE,
+			Recording: staticMeterRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_3,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "blind/static meter",
+			Algorithm: ttnpb.ADR_ALGORITHM_BLIND,
+			Recording: staticMeterRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_3,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "fixed/static meter",
+			Algorithm: ttnpb.ADR_ALGORITHM_FIXED,
+			Settings: &ttnpb.MACSettings{
+				ADRFixedDataRateIndex: &ttnpb.MACSettings_DataRateIndexValue{Value: ttnpb.DATA_RATE_2},
+			},
+			Recording: staticMeterRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_2,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "fixed/static meter/above band maximum",
+			Algorithm: ttnpb.ADR_ALGORITHM_FIXED,
+			Settings: &ttnpb.MACSettings{
+				ADRFixedDataRateIndex: &ttnpb.MACSettings_DataRateIndexValue{Value: ttnpb.DATA_RATE_15},
+			},
+			Recording: staticMeterRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_5,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "margin/mobile tracker",
+			Algorithm: ttnpb.ADR_ALGORITHM_MARGIN,
+			Recording: mobileTrackerRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_4,
+				ADRTxPowerIndex:  5,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "conservative/mobile tracker",
+			Algorithm: ttnpb.ADR_ALGORITHM_CONSERVATIVE,
+			Recording: mobileTrackerRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_2,
+				ADRTxPowerIndex:  5,
+				ADRNbTrans:       1,
+			},
+		},
+		{
+			Name:      "blind/mobile tracker",
+			Algorithm: ttnpb.ADR_ALGORITHM_BLIND,
+			Recording: mobileTrackerRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_0,
+				ADRNbTrans:       3,
+			},
+		},
+		{
+			Name:      "fixed/mobile tracker",
+			Algorithm: ttnpb.ADR_ALGORITHM_FIXED,
+			Recording: mobileTrackerRecording,
+			Expected: ttnpb.MACParameters{
+				ADRDataRateIndex: ttnpb.DATA_RATE_0,
+				ADRNbTrans:       1,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			params := simulateADR(t, defaultADRAlgorithms[tc.Algorithm], tc.Settings, tc.Recording)
+			a.So(params.ADRDataRateIndex, should.Equal, tc.Expected.ADRDataRateIndex)
+			a.So(params.ADRTxPowerIndex, should.Equal, tc.Expected.ADRTxPowerIndex)
+			a.So(params.ADRNbTrans, should.Equal, tc.Expected.ADRNbTrans)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtBeginChannelPlanReconciliation = events.Define(
+		"ns.mac.channel_plan.reconciliation.begin", "begin channel plan reconciliation",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+	evtCompleteChannelPlanReconciliation = events.Define(
+		"ns.mac.channel_plan.reconciliation.complete", "complete channel plan reconciliation",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+
+	errIncompatibleFrequencyPlan = errors.DefineFailedPrecondition("incompatible_frequency_plan", "frequency plan `{id}` uses band `{band_id}`, which differs from the band `{device_band_id}` the device operates in")
+)
+
+// channelPending returns whether the channel configured on the device as cur still differs from des.
+// A nil channel represents a channel, which is not configured.
+func channelPending(cur, des *ttnpb.MACParameters_Channel, macVersion ttnpb.MACVersion) bool {
+	switch {
+	case cur == nil && des == nil:
+		return false
+	case cur == nil:
+		return des.EnableUplink
+	case des == nil:
+		return cur.EnableUplink
+	case !cur.EnableUplink && !des.EnableUplink:
+		return false
+	}
+	if cur.EnableUplink != des.EnableUplink ||
+		cur.UplinkFrequency != des.UplinkFrequency ||
+		cur.MinDataRateIndex != des.MinDataRateIndex ||
+		cur.MaxDataRateIndex != des.MaxDataRateIndex {
+		return true
+	}
+	// NOTE: DLChannelReq is only supported since LoRaWAN 1.0.2.
+	return macVersion.Compare(ttnpb.MAC_V1_0_2) >= 0 && cur.DownlinkFrequency != des.DownlinkFrequency
+}
+
+// pendingChannelCount returns the amount of channels in macState, which are not yet configured as desired.
+func pendingChannelCount(macState *ttnpb.MACState) uint32 {
+	cur, des := macState.CurrentParameters.Channels, macState.DesiredParameters.Channels
+	n := len(cur)
+	if len(des) > n {
+		n = len(des)
+	}
+	var count uint32
+	for i := 0; i < n; i++ {
+		var curCh, desCh *ttnpb.MACParameters_Channel
+		if i < len(cur) {
+			curCh = cur[i]
+		}
+		if i < len(des) {
+			desCh = des[i]
+		}
+		if channelPending(curCh, desCh, macState.LoRaWANVersion) {
+			count++
+		}
+	}
+	return count
+}
+
+// checkFrequencyPlanCompatibility checks whether a device operating in the frequency plan identified by curID
+// can be migrated to the frequency plan identified by newID without rejoining.
+func checkFrequencyPlanCompatibility(curID, newID string, fps *frequencyplans.Store) error {
+	curFP, err := fps.GetByID(curID)
+	if err != nil {
+		return err
+	}
+	newFP, err := fps.GetByID(newID)
+	if err != nil {
+		return err
+	}
+	if newFP.BandID != curFP.BandID {
+		return errIncompatibleFrequencyPlan.WithAttributes(
+			"id", newID,
+			"band_id", newFP.BandID,
+			"device_band_id", curFP.BandID,
+		)
+	}
+	return nil
+}
+
+// reconcileChannelPlan sets the desired channels of dev according to its frequency plan and starts the channel plan
+// reconciliation. The current channels of dev are not modified, the resulting difference is resolved by
+// NewChannelReq, LinkADRReq and DLChannelReq MAC commands on subsequent downlinks.
+func reconcileChannelPlan(dev *ttnpb.EndDevice, fps *frequencyplans.Store, now time.Time) error {
+	fp, phy, err := getDeviceBandVersion(dev, fps)
+	if err != nil {
+		return err
+	}
+	chs := desiredChannels(fp, phy)
+	// NOTE: Channels configured on the device, which are not part of the new plan, are disabled using the channel mask.
+	for i := len(chs); i < len(dev.MACState.CurrentParameters.Channels); i++ {
+		ch := &ttnpb.MACParameters_Channel{}
+		if cur := dev.MACState.CurrentParameters.Channels[i]; cur != nil {
+			*ch = *cur
+		}
+		ch.EnableUplink = false
+		chs = append(chs, ch)
+	}
+	dev.MACState.DesiredParameters.Channels = chs
+	dev.MACState.ChannelPlanReconciliation = &ttnpb.MACState_ChannelPlanReconciliation{
+		FrequencyPlanID: dev.FrequencyPlanID,
+		StartedAt:       now,
+	}
+	updateChannelPlanReconciliation(dev.MACState, now)
+	return nil
+}
+
+// reconcileChannelPlan starts the channel plan reconciliation of the device identified by ids, if it has a MAC state.
+func (ns *NetworkServer) reconcileChannelPlan(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	var evs []events.Event
+	var addDownlinkTask bool
+	_, err := ns.devices.SetByID(ctx, ids.ApplicationIdentifiers, ids.DeviceID,
+		[]string{
+			"frequency_plan_id",
+			"lorawan_phy_version",
+			"mac_state",
+		},
+		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil || dev.MACState == nil {
+				return dev, nil, nil
+			}
+			if err := reconcileChannelPlan(dev, ns.FrequencyPlans, time.Now().UTC()); err != nil {
+				return nil, nil, err
+			}
+			rec := dev.MACState.ChannelPlanReconciliation
+			evs = append(evs, evtBeginChannelPlanReconciliation(ctx, ids, rec))
+			if rec.CompletedAt != nil {
+				evs = append(evs, evtCompleteChannelPlanReconciliation(ctx, ids, rec))
+			} else {
+				addDownlinkTask = dev.MACState.DeviceClass != ttnpb.CLASS_A
+			}
+			return dev, []string{
+				"mac_state.channel_plan_reconciliation",
+				"mac_state.desired_parameters.channels",
+			}, nil
+		})
+	if err != nil {
+		return err
+	}
+	for _, evt := range evs {
+		events.Publish(evt)
+	}
+	if addDownlinkTask {
+		startAt := time.Now().UTC()
+		log.FromContext(ctx).WithField("start_at", startAt).Debug("Add downlink task for channel plan reconciliation")
+		return ns.downlinkTasks.Add(ctx, ids, startAt, true)
+	}
+	return nil
+}
+
+// updateChannelPlanReconciliation updates the progress of the channel plan reconciliation in macState, if any.
+// updateChannelPlanReconciliation returns true if the reconciliation completed.
+func updateChannelPlanReconciliation(macState *ttnpb.MACState, now time.Time) bool {
+	rec := macState.ChannelPlanReconciliation
+	if rec == nil || rec.CompletedAt != nil {
+		return false
+	}
+	rec.PendingChannels = pendingChannelCount(macState)
+	if rec.PendingChannels > 0 {
+		return false
+	}
+	rec.CompletedAt = &now
+	return true
+}
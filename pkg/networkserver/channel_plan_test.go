@@ -0,0 +1,148 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCheckFrequencyPlanCompatibility(t *testing.T) {
+	a := assertions.New(t)
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	a.So(checkFrequencyPlanCompatibility(test.EUFrequencyPlanID, test.ExampleFrequencyPlanID, fps), should.BeNil)
+	a.So(checkFrequencyPlanCompatibility(test.EUFrequencyPlanID, test.USFrequencyPlanID, fps), should.HaveSameErrorDefinitionAs, errIncompatibleFrequencyPlan)
+}
+
+// newConfiguredDevice returns a LoRaWAN 1.1 device operating in the frequency plan identified by fpID,
+// which has all channels of the frequency plan configured.
+func newConfiguredDevice(t *testing.T, fpID string, fps *frequencyplans.Store) *ttnpb.EndDevice {
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+			DeviceID:               "test-dev",
+		},
+		FrequencyPlanID:   fpID,
+		LoRaWANVersion:    ttnpb.MAC_V1_1,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+	}
+	macState, err := newMACState(dev, fps, ttnpb.MACSettings{})
+	if err != nil {
+		t.Fatalf("Failed to create MAC state: %s", err)
+	}
+	macState.CurrentParameters.Channels = deepcopy.Copy(macState.DesiredParameters.Channels).([]*ttnpb.MACParameters_Channel)
+	dev.MACState = macState
+	return dev
+}
+
+func TestChannelPlanReconciliation(t *testing.T) {
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	for _, tc := range []struct {
+		Name                    string
+		From, To                string
+		ExpectedPendingChannels uint32
+		ExpectedNewChannelReqs  int
+		ExpectedDLChannelReqs   int
+	}{
+		{
+			Name:                    "same plan",
+			From:                    test.EUFrequencyPlanID,
+			To:                      test.EUFrequencyPlanID,
+			ExpectedPendingChannels: 0,
+		},
+		{
+			Name:                    "remove channels",
+			From:                    test.EUFrequencyPlanID,
+			To:                      test.ExampleFrequencyPlanID,
+			ExpectedPendingChannels: 7,
+			ExpectedDLChannelReqs:   2,
+		},
+		{
+			Name:                    "add channels",
+			From:                    test.ExampleFrequencyPlanID,
+			To:                      test.EUFrequencyPlanID,
+			ExpectedPendingChannels: 7,
+			ExpectedNewChannelReqs:  5,
+			ExpectedDLChannelReqs:   2,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := newConfiguredDevice(t, tc.From, fps)
+			currentChannels := deepcopy.Copy(dev.MACState.CurrentParameters.Channels).([]*ttnpb.MACParameters_Channel)
+
+			startedAt := time.Unix(42, 0).UTC()
+			dev.FrequencyPlanID = tc.To
+			if !a.So(reconcileChannelPlan(dev, fps, startedAt), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(dev.MACState.CurrentParameters.Channels, should.Resemble, currentChannels)
+			if !a.So(dev.MACState.ChannelPlanReconciliation, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(dev.MACState.ChannelPlanReconciliation.FrequencyPlanID, should.Equal, tc.To)
+			a.So(dev.MACState.ChannelPlanReconciliation.StartedAt, should.Equal, startedAt)
+			a.So(dev.MACState.ChannelPlanReconciliation.PendingChannels, should.Equal, tc.ExpectedPendingChannels)
+			if tc.ExpectedPendingChannels == 0 {
+				a.So(dev.MACState.ChannelPlanReconciliation.CompletedAt, should.Resemble, &startedAt)
+				return
+			}
+			a.So(dev.MACState.ChannelPlanReconciliation.CompletedAt, should.BeNil)
+
+			// Simulate the device accepting all MAC commands generated.
+			ctx := test.Context()
+			_, _, ok := enqueueNewChannelReq(ctx, dev, 255, 255)
+			a.So(ok, should.BeTrue)
+			a.So(dev.MACState.PendingRequests, should.HaveLength, tc.ExpectedNewChannelReqs)
+			for range dev.MACState.PendingRequests {
+				_, err := handleNewChannelAns(ctx, dev, &ttnpb.MACCommand_NewChannelAns{FrequencyAck: true, DataRateAck: true})
+				a.So(err, should.BeNil)
+			}
+			a.So(dev.MACState.PendingRequests, should.BeEmpty)
+			a.So(updateChannelPlanReconciliation(dev.MACState, startedAt.Add(time.Minute)), should.BeFalse)
+
+			_, _, ok, err := enqueueLinkADRReq(ctx, dev, 255, 255, fps)
+			a.So(err, should.BeNil)
+			a.So(ok, should.BeTrue)
+			a.So(dev.MACState.PendingRequests, should.NotBeEmpty)
+			_, err = handleLinkADRAns(ctx, dev, &ttnpb.MACCommand_LinkADRAns{ChannelMaskAck: true, DataRateIndexAck: true, TxPowerIndexAck: true}, 0, fps)
+			a.So(err, should.BeNil)
+
+			_, _, ok = enqueueDLChannelReq(ctx, dev, 255, 255)
+			a.So(ok, should.BeTrue)
+			a.So(dev.MACState.PendingRequests, should.HaveLength, tc.ExpectedDLChannelReqs)
+			for range dev.MACState.PendingRequests {
+				_, err := handleDLChannelAns(ctx, dev, &ttnpb.MACCommand_DLChannelAns{ChannelIndexAck: true, FrequencyAck: true})
+				a.So(err, should.BeNil)
+			}
+
+			completedAt := startedAt.Add(2 * time.Minute)
+			a.So(updateChannelPlanReconciliation(dev.MACState, completedAt), should.BeTrue)
+			a.So(dev.MACState.ChannelPlanReconciliation.PendingChannels, should.BeZeroValue)
+			a.So(dev.MACState.ChannelPlanReconciliation.CompletedAt, should.Resemble, &completedAt)
+			a.So(updateChannelPlanReconciliation(dev.MACState, completedAt.Add(time.Minute)), should.BeFalse)
+		})
+	}
+}
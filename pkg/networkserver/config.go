@@ -15,6 +15,7 @@
 package networkserver
 
 import (
+	"strconv"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -25,25 +26,29 @@ import (
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	Devices             DeviceRegistry         `name:"-"`
-	DownlinkTasks       DownlinkTaskQueue      `name:"-"`
-	NetID               types.NetID            `name:"net-id" description:"NetID of this Network Server"`
-	DevAddrPrefixes     []types.DevAddrPrefix  `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
-	DeduplicationWindow time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
-	CooldownWindow      time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
-	DownlinkPriorities  DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
-	DefaultMACSettings  MACSettingConfig       `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
-	Interop             config.InteropClient   `name:"interop" description:"Interop client configuration"`
+	Devices               DeviceRegistry         `name:"-"`
+	DownlinkTasks         DownlinkTaskQueue      `name:"-"`
+	NetID                 types.NetID            `name:"net-id" description:"NetID of this Network Server"`
+	DevAddrPrefixes       []types.DevAddrPrefix  `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
+	DeduplicationWindow   time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
+	CooldownWindow        time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	DownlinkPriorities    DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
+	DefaultMACSettings    MACSettingConfig       `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	BandADRAlgorithms     map[string]string      `name:"band-adr-algorithms" description:"ADR algorithm Network Server should use per band ID if not configured in device's MAC settings (MARGIN, CONSERVATIVE, BLIND, FIXED)"`
+	Interop               config.InteropClient   `name:"interop" description:"Interop client configuration"`
+	RoamingUplinkTokenKey []byte                 `name:"roaming-uplink-token-key" description:"AES key (16, 24 or 32 bytes) for encrypting and authenticating the uplink tokens of roaming uplink messages"`
+	FairUse               FairUseConfig          `name:"fair-use" description:"Fair-use policy configuration"`
 }
 
 // MACSettingConfig defines MAC-layer configuration.
 type MACSettingConfig struct {
-	ADRMargin              *float32       `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
-	DesiredRx1Delay        *ttnpb.RxDelay `name:"desired-rx1-delay" description:"Desired Rx1Delay value Network Server should use if not configured in device's MAC settings"`
-	ClassBTimeout          *time.Duration `name:"class-b-timeout" description:"Deadline for a device in class B mode to respond to requests from the Network Server if not configured in device's MAC settings"`
-	ClassCTimeout          *time.Duration `name:"class-c-timeout" description:"Deadline for a device in class C mode to respond to requests from the Network Server if not configured in device's MAC settings"`
-	StatusTimePeriodicity  *time.Duration `name:"status-time-periodicity" description:"The interval after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
-	StatusCountPeriodicity *uint32        `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
+	ADRMargin              *float32            `name:"adr-margin" description:"The default margin Network Server should add in ADR requests if not configured in device's MAC settings"`
+	ADRAlgorithm           *ttnpb.ADRAlgorithm `name:"adr-algorithm" description:"The default ADR algorithm Network Server should use if not configured in device's MAC settings or band (MARGIN, CONSERVATIVE, BLIND, FIXED)"`
+	DesiredRx1Delay        *ttnpb.RxDelay      `name:"desired-rx1-delay" description:"Desired Rx1Delay value Network Server should use if not configured in device's MAC settings"`
+	ClassBTimeout          *time.Duration      `name:"class-b-timeout" description:"Deadline for a device in class B mode to respond to requests from the Network Server if not configured in device's MAC settings"`
+	ClassCTimeout          *time.Duration      `name:"class-c-timeout" description:"Deadline for a device in class C mode to respond to requests from the Network Server if not configured in device's MAC settings"`
+	StatusTimePeriodicity  *time.Duration      `name:"status-time-periodicity" description:"The interval after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
+	StatusCountPeriodicity *uint32             `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
 }
 
 // DownlinkPriorityConfig defines priorities for downlink messages.
@@ -86,3 +91,55 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 	}
 	return p, nil
 }
+
+// FairUseConfig defines the fair-use policy enforced per end device.
+// The limits apply to a rolling window of 24 hours. A limit of zero means unlimited.
+type FairUseConfig struct {
+	UplinkAirtime            time.Duration     `name:"uplink-airtime" description:"Maximum uplink airtime per end device in 24 hours (0 = unlimited)"`
+	Downlinks                uint32            `name:"downlinks" description:"Maximum number of downlink messages per end device in 24 hours (0 = unlimited)"`
+	ApplicationUplinkAirtime map[string]string `name:"application-uplink-airtime" description:"Maximum uplink airtime per end device in 24 hours per application ID, overriding uplink-airtime"`
+	ApplicationDownlinks     map[string]string `name:"application-downlinks" description:"Maximum number of downlink messages per end device in 24 hours per application ID, overriding downlinks"`
+	RejectDownlinks          bool              `name:"reject-downlinks" description:"Reject application downlink messages for end devices exceeding the fair-use limits"`
+}
+
+var errFairUseLimit = errors.DefineInvalidArgument("fair_use_limit", "invalid fair-use limit `{value}` for application `{application_id}`")
+
+// Parse attempts to parse the configuration and returns a FairUsePolicy.
+func (c FairUseConfig) Parse() (FairUsePolicy, error) {
+	p := FairUsePolicy{
+		Default: FairUseLimits{
+			UplinkAirtime: c.UplinkAirtime,
+			Downlinks:     c.Downlinks,
+		},
+		RejectDownlinks: c.RejectDownlinks,
+	}
+	if len(c.ApplicationUplinkAirtime) == 0 && len(c.ApplicationDownlinks) == 0 {
+		return p, nil
+	}
+	p.Applications = make(map[string]FairUseLimits, len(c.ApplicationUplinkAirtime)+len(c.ApplicationDownlinks))
+	for appID, v := range c.ApplicationUplinkAirtime {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return FairUsePolicy{}, errFairUseLimit.WithAttributes("value", v, "application_id", appID)
+		}
+		limits, ok := p.Applications[appID]
+		if !ok {
+			limits = p.Default
+		}
+		limits.UplinkAirtime = d
+		p.Applications[appID] = limits
+	}
+	for appID, v := range c.ApplicationDownlinks {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return FairUsePolicy{}, errFairUseLimit.WithAttributes("value", v, "application_id", appID)
+		}
+		limits, ok := p.Applications[appID]
+		if !ok {
+			limits = p.Default
+		}
+		limits.Downlinks = uint32(n)
+		p.Applications[appID] = limits
+	}
+	return p, nil
+}
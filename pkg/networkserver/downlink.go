@@ -30,8 +30,10 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 )
 
@@ -82,6 +84,9 @@ func loggerWithApplicationDownlinkFields(logger log.Interface, down *ttnpb.Appli
 	} else {
 		pairs = append(pairs, "class_b_c", false)
 	}
+	if down.ExpiresAt != nil {
+		pairs = append(pairs, "expires_at", *down.ExpiresAt)
+	}
 	return logger.WithFields(log.Fields(pairs...))
 }
 
@@ -98,6 +103,7 @@ type generatedDownlink struct {
 type generateDownlinkState struct {
 	baseApplicationUps        []*ttnpb.ApplicationUp
 	ifScheduledApplicationUps []*ttnpb.ApplicationUp
+	baseEvents                []events.Event
 
 	ApplicationDownlink      *ttnpb.ApplicationDownlink
 	NeedsDownlinkQueueUpdate bool
@@ -112,6 +118,13 @@ func (s generateDownlinkState) appendApplicationUplinks(ups []*ttnpb.Application
 	}
 }
 
+func (s generateDownlinkState) appendEvents(evs []events.Event, scheduled bool) []events.Event {
+	if !scheduled {
+		return append(evs, s.baseEvents...)
+	}
+	return append(append(evs, s.baseEvents...), s.Events...)
+}
+
 // generateDownlink attempts to generate a downlink.
 // generateDownlink returns the generated downlink, application uplinks associated with the generation and error, if any.
 // generateDownlink may mutate the device in order to record the downlink generated.
@@ -210,8 +223,11 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 			)
 		}
 
+		var ok bool
+		cmds, maxDownLen, maxUpLen, ok = enqueueOperatorMACCommands(ctx, dev, maxDownLen, maxUpLen, cmds)
+		fPending = fPending || !ok
+
 		for _, f := range enqueuers {
-			var ok bool
 			maxDownLen, maxUpLen, ok = f(ctx, dev, maxDownLen, maxUpLen)
 			fPending = fPending || !ok
 			// TODO: Buffer events https://github.com/TheThingsNetwork/lorawan-stack/issues/789
@@ -259,6 +275,22 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 	for _, down := range dev.QueuedApplicationDownlinks {
 		logger := loggerWithApplicationDownlinkFields(logger, down)
 
+		if down.ExpiresAt != nil && down.ExpiresAt.Before(time.Now()) {
+			logger.Debug("Drop expired application downlink")
+			st.baseEvents = append(st.baseEvents, evtExpireDownlink(events.ContextWithCorrelationID(ctx, down.CorrelationIDs...), dev.EndDeviceIdentifiers, down))
+			st.baseApplicationUps = append(st.baseApplicationUps, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+				CorrelationIDs:       append(events.CorrelationIDsFromContext(ctx), down.CorrelationIDs...),
+				Up: &ttnpb.ApplicationUp_DownlinkFailed{
+					DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+						ApplicationDownlink: *down,
+						Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
+					},
+				},
+			})
+			startIdx++
+			continue
+		}
 		if len(down.FRMPayload) > int(maxDownLen) {
 			logger.WithField("max_down_len", maxDownLen).Debug("Skip application downlink with payload length exceeding band regulations")
 			st.baseApplicationUps = append(st.baseApplicationUps, &ttnpb.ApplicationUp{
@@ -318,6 +350,7 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 		case ttnpb.CLASS_B, ttnpb.CLASS_C:
 			if absTime := down.GetClassBC().GetAbsoluteTime(); absTime != nil && absTime.Before(time.Now()) {
 				logger.Debug("Drop expired downlink")
+				st.baseEvents = append(st.baseEvents, evtExpireDownlink(events.ContextWithCorrelationID(ctx, down.CorrelationIDs...), dev.EndDeviceIdentifiers, down))
 				st.baseApplicationUps = append(st.baseApplicationUps, &ttnpb.ApplicationUp{
 					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
 					CorrelationIDs:       append(events.CorrelationIDsFromContext(ctx), down.CorrelationIDs...),
@@ -504,6 +537,9 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 type downlinkPath struct {
 	ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+	// forwardingNetID is the NetID of the forwarding Network Server, if the downlink path goes through
+	// a forwarding Network Server in passive roaming.
+	forwardingNetID *types.NetID
 }
 
 func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
@@ -527,6 +563,9 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 				},
 			},
 		}
+		if netID, ok := forwardingNetID(md); ok {
+			path.forwardingNetID = &netID
+		}
 		switch md.DownlinkPathConstraint {
 		case ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE:
 			head = append(head, path)
@@ -638,12 +677,27 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 	logger := log.FromContext(ctx)
 
 	type attempt struct {
-		peer  cluster.Peer
-		paths []*ttnpb.DownlinkPath
+		peer            cluster.Peer
+		forwardingNetID *types.NetID
+		paths           []*ttnpb.DownlinkPath
 	}
 	attempts := make([]*attempt, 0, len(paths))
 
 	for _, path := range paths {
+		if netID := path.forwardingNetID; netID != nil {
+			var a *attempt
+			if len(attempts) > 0 && attempts[len(attempts)-1].forwardingNetID != nil && attempts[len(attempts)-1].forwardingNetID.Equal(*netID) {
+				a = attempts[len(attempts)-1]
+			} else {
+				a = &attempt{
+					forwardingNetID: netID,
+				}
+				attempts = append(attempts, a)
+			}
+			a.paths = append(a.paths, path.DownlinkPath)
+			continue
+		}
+
 		logger := logger.WithField(
 			"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
 		)
@@ -655,7 +709,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 		}
 
 		var a *attempt
-		if len(attempts) > 0 && attempts[len(attempts)-1].peer == p {
+		if len(attempts) > 0 && attempts[len(attempts)-1].forwardingNetID == nil && attempts[len(attempts)-1].peer == p {
 			a = attempts[len(attempts)-1]
 		} else {
 			a = &attempt{
@@ -678,6 +732,28 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 			},
 		}
 
+		if a.forwardingNetID != nil {
+			if ns.interopClient == nil {
+				errs = append(errs, errNoPath)
+				continue
+			}
+			logger.WithFields(log.Fields(
+				"forwarding_net_id", *a.forwardingNetID,
+				"path_count", len(req.DownlinkPaths),
+			)).Debug("Schedule downlink through forwarding Network Server")
+			xmitReq := newRoamingXmitDataRequest(down)
+			xmitReq.ReceiverID = interop.NetID(*a.forwardingNetID)
+			if _, err := ns.interopClient.XmitDataRequest(ctx, ns.netID, xmitReq); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			logger.Debug("Scheduled downlink through forwarding Network Server")
+			return &scheduledDownlink{
+				Message:    down,
+				TransmitAt: time.Now(),
+			}, nil
+		}
+
 		logger.WithField("path_count", len(req.DownlinkPaths)).Debug("Schedule downlink")
 		cc, err := a.peer.Conn()
 		if err != nil {
@@ -821,6 +897,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 		var nextDownlinkAt time.Time
 		_, err := ns.devices.SetByID(ctx, devID.ApplicationIdentifiers, devID.DeviceID,
 			[]string{
+				"airtime_usage",
 				"frequency_plan_id",
 				"last_dev_status_received_at",
 				"lorawan_phy_version",
@@ -912,7 +989,11 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					dev.PendingMACState.QueuedJoinAccept = nil
 					dev.PendingMACState.RxWindowsAvailable = false
 					dev.RecentDownlinks = appendRecentDownlink(dev.RecentDownlinks, down.Message, recentDownlinkCount)
+					if usage := ns.recordDownlinkAirtime(ctx, dev, phy, down.Message); usage != nil {
+						queuedEvents = append(queuedEvents, evtExceedFairUse(ctx, dev.EndDeviceIdentifiers, usage))
+					}
 					return dev, []string{
+						"airtime_usage",
 						"pending_mac_state.pending_join_request",
 						"pending_mac_state.queued_join_accept",
 						"pending_mac_state.rx_windows_available",
@@ -1024,6 +1105,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 								logger.WithError(err).Warn("Failed to generate downlink, skip downlink slot")
 							}
 							queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+							queuedEvents = genState.appendEvents(queuedEvents, false)
 							if genState.ApplicationDownlink != nil && genState.NeedsDownlinkQueueUpdate {
 								dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.QueuedApplicationDownlinks...)
 							}
@@ -1040,6 +1122,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							if err != nil {
 								logger.WithError(err).Warn("Failed to generate Tx request from uplink, skip downlink slot")
 								queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+								queuedEvents = genState.appendEvents(queuedEvents, false)
 								if genState.ApplicationDownlink != nil && genState.NeedsDownlinkQueueUpdate {
 									dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.QueuedApplicationDownlinks...)
 								}
@@ -1066,6 +1149,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 							}
 							logger.Warn("All Gateway Servers failed to schedule downlink, skip downlink slot")
 							queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+							queuedEvents = genState.appendEvents(queuedEvents, false)
 							if genState.ApplicationDownlink != nil && genState.NeedsDownlinkQueueUpdate {
 								dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.QueuedApplicationDownlinks...)
 							}
@@ -1076,9 +1160,13 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						}
 						dev.MACState.RxWindowsAvailable = false
 						dev.RecentDownlinks = appendRecentDownlink(dev.RecentDownlinks, down.Message, recentDownlinkCount)
+						if usage := ns.recordDownlinkAirtime(ctx, dev, phy, down.Message); usage != nil {
+							queuedEvents = append(queuedEvents, evtExceedFairUse(ctx, dev.EndDeviceIdentifiers, usage))
+						}
 						queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, true)
-						queuedEvents = append(queuedEvents, genState.Events...)
+						queuedEvents = genState.appendEvents(queuedEvents, true)
 						return dev, []string{
+							"airtime_usage",
 							"mac_state",
 							"queued_application_downlinks",
 							"recent_downlinks",
@@ -1159,9 +1247,13 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						}
 						dev.MACState.RxWindowsAvailable = false
 						dev.RecentDownlinks = appendRecentDownlink(dev.RecentDownlinks, down.Message, recentDownlinkCount)
+						if usage := ns.recordDownlinkAirtime(ctx, dev, phy, down.Message); usage != nil {
+							queuedEvents = append(queuedEvents, evtExceedFairUse(ctx, dev.EndDeviceIdentifiers, usage))
+						}
 						queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, true)
-						queuedEvents = append(queuedEvents, genState.Events...)
+						queuedEvents = genState.appendEvents(queuedEvents, true)
 						return dev, []string{
+							"airtime_usage",
 							"mac_state",
 							"queued_application_downlinks",
 							"recent_downlinks",
@@ -1200,6 +1292,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						logger.WithError(err).Warn("Failed to generate downlink, skip downlink slot")
 					}
 					queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+					queuedEvents = genState.appendEvents(queuedEvents, false)
 					if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "queued_application_downlinks") {
 						dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.QueuedApplicationDownlinks...)
 					}
@@ -1230,6 +1323,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					if len(paths) == 0 {
 						logger.Warn("No downlink path available, skip downlink slot")
 						queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+						queuedEvents = genState.appendEvents(queuedEvents, false)
 						if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "queued_application_downlinks") {
 							dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.QueuedApplicationDownlinks...)
 						}
@@ -1241,6 +1335,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						nextDownlinkAt = absTime.Add(-gsScheduleWindow)
 						logger.WithField("retry_at", nextDownlinkAt).Info("Downlink scheduled too soon, retry downlink slot")
 						queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+						queuedEvents = genState.appendEvents(queuedEvents, false)
 						if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "queued_application_downlinks") {
 							dev.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.QueuedApplicationDownlinks...)
 						}
@@ -1264,6 +1359,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						logger = logger.WithError(err)
 					}
 					queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
+					queuedEvents = genState.appendEvents(queuedEvents, false)
 					if ok && genState.ApplicationDownlink != nil {
 						pathErrs, ok := schedErr.pathErrors()
 						if ok {
@@ -1329,9 +1425,13 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				}
 				dev.MACState.RxWindowsAvailable = false
 				dev.RecentDownlinks = appendRecentDownlink(dev.RecentDownlinks, down.Message, recentDownlinkCount)
+				if usage := ns.recordDownlinkAirtime(ctx, dev, phy, down.Message); usage != nil {
+					queuedEvents = append(queuedEvents, evtExceedFairUse(ctx, dev.EndDeviceIdentifiers, usage))
+				}
 				queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, true)
-				queuedEvents = append(queuedEvents, genState.Events...)
+				queuedEvents = genState.appendEvents(queuedEvents, true)
 				return dev, []string{
+					"airtime_usage",
 					"mac_state",
 					"queued_application_downlinks",
 					"recent_downlinks",
@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -44,6 +45,7 @@ import (
 
 func TestProcessDownlinkTask(t *testing.T) {
 	getPaths := []string{
+		"airtime_usage",
 		"frequency_plan_id",
 		"last_dev_status_received_at",
 		"lorawan_phy_version",
@@ -1936,6 +1938,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
+						"airtime_usage",
 						"mac_state",
 						"queued_application_downlinks",
 						"recent_downlinks",
@@ -1947,6 +1950,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						a.So([]time.Time{start, *resp.Device.MACState.LastConfirmedDownlinkAt, time.Now().Add(time.Second)}, should.BeChronological)
 						setDevice.MACState.LastConfirmedDownlinkAt = resp.Device.MACState.LastConfirmedDownlinkAt
 					}
+					if a.So(resp.Device, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(resp.Device.AirtimeUsage.Buckets[0].Downlinks, should.Equal, 1)
+						setDevice.AirtimeUsage = resp.Device.AirtimeUsage
+					}
 					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
@@ -2235,6 +2244,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
+						"airtime_usage",
 						"mac_state",
 						"queued_application_downlinks",
 						"recent_downlinks",
@@ -2246,6 +2256,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						a.So([]time.Time{start, *resp.Device.MACState.LastConfirmedDownlinkAt, time.Now().Add(time.Second)}, should.BeChronological)
 						setDevice.MACState.LastConfirmedDownlinkAt = resp.Device.MACState.LastConfirmedDownlinkAt
 					}
+					if a.So(resp.Device, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(resp.Device.AirtimeUsage.Buckets[0].Downlinks, should.Equal, 1)
+						setDevice.AirtimeUsage = resp.Device.AirtimeUsage
+					}
 					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
@@ -2673,6 +2689,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
+						"airtime_usage",
 						"mac_state",
 						"queued_application_downlinks",
 						"recent_downlinks",
@@ -2684,6 +2701,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						a.So([]time.Time{start, *resp.Device.MACState.LastConfirmedDownlinkAt, time.Now().Add(time.Second)}, should.BeChronological)
 						setDevice.MACState.LastConfirmedDownlinkAt = resp.Device.MACState.LastConfirmedDownlinkAt
 					}
+					if a.So(resp.Device, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(resp.Device.AirtimeUsage.Buckets[0].Downlinks, should.Equal, 1)
+						setDevice.AirtimeUsage = resp.Device.AirtimeUsage
+					}
 					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
@@ -3064,6 +3087,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
+						"airtime_usage",
 						"mac_state",
 						"queued_application_downlinks",
 						"recent_downlinks",
@@ -3075,6 +3099,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						a.So([]time.Time{start, *resp.Device.MACState.LastConfirmedDownlinkAt, time.Now().Add(time.Second)}, should.BeChronological)
 						setDevice.MACState.LastConfirmedDownlinkAt = resp.Device.MACState.LastConfirmedDownlinkAt
 					}
+					if a.So(resp.Device, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(resp.Device.AirtimeUsage.Buckets[0].Downlinks, should.Equal, 1)
+						setDevice.AirtimeUsage = resp.Device.AirtimeUsage
+					}
 					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
@@ -3388,6 +3418,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
+						"airtime_usage",
 						"mac_state",
 						"queued_application_downlinks",
 						"recent_downlinks",
@@ -3399,6 +3430,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						a.So([]time.Time{start, *resp.Device.MACState.LastConfirmedDownlinkAt, time.Now().Add(time.Second)}, should.BeChronological)
 						setDevice.MACState.LastConfirmedDownlinkAt = resp.Device.MACState.LastConfirmedDownlinkAt
 					}
+					if a.So(resp.Device, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(resp.Device.AirtimeUsage.Buckets[0].Downlinks, should.Equal, 1)
+						setDevice.AirtimeUsage = resp.Device.AirtimeUsage
+					}
 					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
@@ -4588,6 +4625,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
+						"airtime_usage",
 						"pending_mac_state.pending_join_request",
 						"pending_mac_state.queued_join_accept",
 						"pending_mac_state.rx_windows_available",
@@ -4595,6 +4633,12 @@ func TestProcessDownlinkTask(t *testing.T) {
 						"pending_session.keys",
 						"recent_downlinks",
 					})
+					if a.So(resp.Device, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage, should.NotBeNil) &&
+						a.So(resp.Device.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(resp.Device.AirtimeUsage.Buckets[0].Downlinks, should.Equal, 1)
+						setDevice.AirtimeUsage = resp.Device.AirtimeUsage
+					}
 					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
@@ -4744,12 +4788,22 @@ func TestGenerateDownlink(t *testing.T) {
 		return
 	}
 
+	expiredDown := &ttnpb.ApplicationDownlink{
+		Confirmed:      false,
+		FCnt:           41,
+		FPort:          1,
+		FRMPayload:     []byte("expired"),
+		ExpiresAt:      TimePtr(time.Now().Add(-time.Second)),
+		CorrelationIDs: []string{"expired-downlink-correlation-id"},
+	}
+
 	for _, tc := range []struct {
 		Name                         string
 		Device                       *ttnpb.EndDevice
 		Bytes                        []byte
 		ApplicationDownlinkAssertion func(t *testing.T, down *ttnpb.ApplicationDownlink) bool
 		DeviceAssertion              func(*testing.T, *ttnpb.EndDevice) bool
+		BaseEventsAssertion          func(*testing.T, []events.Event) bool
 		Error                        error
 	}{
 		{
@@ -5045,6 +5099,125 @@ func TestGenerateDownlink(t *testing.T) {
 				})
 			},
 		},
+		{
+			Name: "1.1/expired app downlink/unconfirmed app downlink/no MAC/no ack",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+					DevAddr:                &devAddr,
+				},
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:     ttnpb.MAC_V1_1,
+					RxWindowsAvailable: true,
+				},
+				Session: &ttnpb.Session{
+					DevAddr: devAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: &nwkSEncKey,
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: &sNwkSIntKey,
+						},
+					},
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					expiredDown,
+					{
+						Confirmed:  false,
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				FrequencyPlanID:   band.EU_863_870,
+				RecentUplinks: []*ttnpb.UplinkMessage{{
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+						Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+					},
+				}},
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: devAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+								ADR: true,
+							},
+							FCnt: 42,
+						},
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			ApplicationDownlinkAssertion: func(t *testing.T, down *ttnpb.ApplicationDownlink) bool {
+				return assertions.New(t).So(down, should.Resemble, &ttnpb.ApplicationDownlink{
+					Confirmed:  false,
+					FCnt:       42,
+					FPort:      1,
+					FRMPayload: []byte("test"),
+				})
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				return assertions.New(t).So(dev, should.Resemble, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						DevAddr:                &devAddr,
+					},
+					MACState: &ttnpb.MACState{
+						LoRaWANVersion:     ttnpb.MAC_V1_1,
+						RxWindowsAvailable: true,
+					},
+					Session: &ttnpb.Session{
+						DevAddr: devAddr,
+						SessionKeys: ttnpb.SessionKeys{
+							NwkSEncKey: &ttnpb.KeyEnvelope{
+								Key: &nwkSEncKey,
+							},
+							SNwkSIntKey: &ttnpb.KeyEnvelope{
+								Key: &sNwkSIntKey,
+							},
+						},
+					},
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					FrequencyPlanID:   band.EU_863_870,
+					RecentUplinks: []*ttnpb.UplinkMessage{{
+						Payload: &ttnpb.Message{
+							MHDR: ttnpb.MHDR{
+								MType: ttnpb.MType_UNCONFIRMED_UP,
+							},
+							Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+						},
+					}},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{},
+				})
+			},
+			BaseEventsAssertion: func(t *testing.T, evs []events.Event) bool {
+				a := assertions.New(t)
+				return a.So(evs, should.HaveLength, 1) && a.So(evs[0], should.ResembleEvent, evtExpireDownlink(
+					events.ContextWithCorrelationID(test.Context(), expiredDown.CorrelationIDs...),
+					ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						DevAddr:                &devAddr,
+					},
+					expiredDown,
+				))
+			},
+		},
 		{
 			Name: "1.1/unconfirmed app downlink/no MAC/ack",
 			Device: &ttnpb.EndDevice{
@@ -5686,6 +5859,10 @@ func TestGenerateDownlink(t *testing.T) {
 			} else {
 				a.So(dev, should.Resemble, tc.Device)
 			}
+
+			if tc.BaseEventsAssertion != nil {
+				a.So(tc.BaseEventsAssertion(t, genState.appendEvents(nil, false)), should.BeTrue)
+			}
 		})
 	}
 }
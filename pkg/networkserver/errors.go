@@ -45,6 +45,8 @@ var (
 	errInvalidFNwkSIntKey         = errors.DefineInvalidArgument("invalid_f_nwk_s_int_key", "invalid FNwkSIntKey")
 	errInvalidNwkSEncKey          = errors.DefineInvalidArgument("invalid_nwk_s_enc_key", "invalid NwkSEncKey")
 	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
+	errInvalidRoamingMetadata     = errors.DefineInvalidArgument("roaming_metadata", "invalid roaming metadata")
+	errInvalidRoamingUplinkToken  = errors.DefineInvalidArgument("roaming_uplink_token", "invalid roaming uplink token")
 	errInvalidSNwkSIntKey         = errors.DefineInvalidArgument("invalid_s_nwk_s_int_key", "invalid SNwkSIntKey")
 	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
 	errMACRequestNotFound         = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
@@ -58,6 +60,7 @@ var (
 	errUnknownChannel             = errors.Define("unknown_chanel", "channel is unknown")
 	errUnknownMACState            = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
 	errUnknownNwkSEncKey          = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
+	errUnknownRFRegion            = errors.DefineInvalidArgument("unknown_rf_region", "unknown RF region `{rf_region}`")
 	errUnknownSession             = errors.DefineNotFound("unknown_session", "unknown session")
 	errUnknownSNwkSIntKey         = errors.DefineNotFound("unknown_s_nwk_s_int_key", "SNwkSIntKey is unknown")
 	errUnsupportedLoRaWANVersion  = errors.DefineInvalidArgument("unsupported_lorawan_version", "unsupported LoRaWAN version: {version}", "version")
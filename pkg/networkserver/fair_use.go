@@ -0,0 +1,221 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/toa"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtExceedFairUse = events.Define(
+		"ns.fair_use.exceed", "exceed fair-use limits",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+
+	errFairUseExceeded = errors.DefineResourceExhausted("fair_use_exceeded", "device exceeds the fair-use limits")
+)
+
+const (
+	// airtimeUsageWindow is the length of the rolling window the fair-use limits apply to.
+	airtimeUsageWindow = 24 * time.Hour
+	// airtimeUsageBucketDuration is the time accounted by a single airtime usage bucket.
+	airtimeUsageBucketDuration = time.Hour
+)
+
+// FairUseLimits define the maximum usage of an end device in a rolling window of 24 hours.
+// A limit of zero means unlimited.
+type FairUseLimits struct {
+	// UplinkAirtime is the maximum total airtime of uplink messages.
+	UplinkAirtime time.Duration
+	// Downlinks is the maximum number of downlink messages.
+	Downlinks uint32
+}
+
+// FairUsePolicy defines the fair-use policy enforced by the Network Server.
+type FairUsePolicy struct {
+	// Default are the limits for end devices of applications, which have no limits configured in Applications.
+	Default FairUseLimits
+	// Applications are the limits per application ID.
+	Applications map[string]FairUseLimits
+	// RejectDownlinks indicates whether application downlink messages are rejected for end devices exceeding the limits.
+	RejectDownlinks bool
+}
+
+// Limits returns the limits for end devices of the application identified by ids.
+func (p FairUsePolicy) Limits(ids ttnpb.ApplicationIdentifiers) FairUseLimits {
+	if limits, ok := p.Applications[ids.ApplicationID]; ok {
+		return limits
+	}
+	return p.Default
+}
+
+// airtimeUsageWindowStart returns the start of the window ending at now.
+func airtimeUsageWindowStart(now time.Time) time.Time {
+	return now.Truncate(airtimeUsageBucketDuration).Add(airtimeUsageBucketDuration - airtimeUsageWindow)
+}
+
+// airtimeUsageBucket returns the bucket of dev accounting usage at now, creating it if necessary.
+// Buckets, which are outside of the window ending at now, are removed.
+func airtimeUsageBucket(dev *ttnpb.EndDevice, now time.Time) *ttnpb.EndDeviceAirtimeUsage_Bucket {
+	if dev.AirtimeUsage == nil {
+		dev.AirtimeUsage = &ttnpb.EndDeviceAirtimeUsage{}
+	}
+	usage := dev.AirtimeUsage
+
+	windowStart := airtimeUsageWindowStart(now)
+	i := 0
+	for i < len(usage.Buckets) && usage.Buckets[i].Start.Before(windowStart) {
+		i++
+	}
+	usage.Buckets = append(usage.Buckets[:0], usage.Buckets[i:]...)
+
+	start := now.Truncate(airtimeUsageBucketDuration)
+	if n := len(usage.Buckets); n > 0 && !usage.Buckets[n-1].Start.Before(start) {
+		return usage.Buckets[n-1]
+	}
+	b := &ttnpb.EndDeviceAirtimeUsage_Bucket{
+		Start: start,
+	}
+	usage.Buckets = append(usage.Buckets, b)
+	return b
+}
+
+// exceeded returns whether the usage in s exceeds the limits.
+func (l FairUseLimits) exceeded(s *ttnpb.EndDeviceAirtimeUsageSummary) bool {
+	return l.UplinkAirtime > 0 && s.UplinkAirtime > l.UplinkAirtime ||
+		l.Downlinks > 0 && s.Downlinks > l.Downlinks
+}
+
+// summarizeAirtimeUsage returns the summary of usage in the window ending at now given limits.
+func summarizeAirtimeUsage(usage *ttnpb.EndDeviceAirtimeUsage, limits FairUseLimits, now time.Time) *ttnpb.EndDeviceAirtimeUsageSummary {
+	s := &ttnpb.EndDeviceAirtimeUsageSummary{
+		WindowStart:        airtimeUsageWindowStart(now),
+		UplinkAirtimeLimit: limits.UplinkAirtime,
+		DownlinksLimit:     limits.Downlinks,
+	}
+	if usage == nil {
+		return s
+	}
+	for _, b := range usage.Buckets {
+		if b.Start.Before(s.WindowStart) || b.Start.After(now) {
+			continue
+		}
+		s.UplinkAirtime += b.UplinkAirtime
+		s.DownlinkAirtime += b.DownlinkAirtime
+		s.Uplinks += b.Uplinks
+		s.Downlinks += b.Downlinks
+	}
+	s.Exceeded = limits.exceeded(s)
+	s.ExceededAt = usage.ExceededAt
+	return s
+}
+
+// recordAirtime accounts a message with airtime d transmitted at now in the airtime usage of dev.
+// recordAirtime returns the summary of the usage if dev exceeds limits for the first time in the window
+// as a result of the message and nil otherwise.
+func recordAirtime(dev *ttnpb.EndDevice, limits FairUseLimits, uplink bool, d time.Duration, now time.Time) *ttnpb.EndDeviceAirtimeUsageSummary {
+	b := airtimeUsageBucket(dev, now)
+	if uplink {
+		b.Uplinks++
+		b.UplinkAirtime += d
+	} else {
+		b.Downlinks++
+		b.DownlinkAirtime += d
+	}
+
+	s := summarizeAirtimeUsage(dev.AirtimeUsage, limits, now)
+	if !s.Exceeded {
+		return nil
+	}
+	if exceededAt := dev.AirtimeUsage.ExceededAt; exceededAt != nil && !exceededAt.Before(s.WindowStart) {
+		return nil
+	}
+	dev.AirtimeUsage.ExceededAt = &now
+	s.ExceededAt = &now
+	return s
+}
+
+// downlinkAirtime returns the airtime of down.
+// As the receive window the downlink is transmitted in is decided by the Gateway Server,
+// the airtime is computed for the first receive window the request allows.
+func downlinkAirtime(phy band.Band, down *ttnpb.DownlinkMessage) (time.Duration, error) {
+	req := down.GetRequest()
+	if req == nil {
+		return 0, errInvalidDataRate
+	}
+	drIdx, freq := req.Rx1DataRateIndex, req.Rx1Frequency
+	if freq == 0 {
+		drIdx, freq = req.Rx2DataRateIndex, req.Rx2Frequency
+	}
+	if int(drIdx) >= len(phy.DataRates) || phy.DataRates[drIdx].Rate == (ttnpb.DataRate{}) {
+		return 0, errInvalidDataRate
+	}
+	settings := ttnpb.TxSettings{
+		DataRate:      phy.DataRates[drIdx].Rate,
+		DataRateIndex: drIdx,
+		Frequency:     freq,
+	}
+	if settings.DataRate.GetLoRa() != nil {
+		settings.CodingRate = phy.LoRaCodingRate
+	}
+	return toa.Compute(len(down.RawPayload), settings)
+}
+
+// recordUplinkAirtime accounts up in the airtime usage of dev.
+// recordUplinkAirtime returns the summary of the usage if dev exceeds the fair-use limits for the first time
+// in the window as a result of up and nil otherwise.
+func (ns *NetworkServer) recordUplinkAirtime(ctx context.Context, dev *ttnpb.EndDevice, up *ttnpb.UplinkMessage) *ttnpb.EndDeviceAirtimeUsageSummary {
+	var d time.Duration
+	if up.Settings.DataRate != (ttnpb.DataRate{}) {
+		var err error
+		d, err = toa.Compute(len(up.RawPayload), up.Settings)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Debug("Failed to compute uplink airtime")
+		}
+	}
+	return recordAirtime(dev, ns.fairUse.Limits(dev.ApplicationIdentifiers), true, d, time.Now().UTC())
+}
+
+// recordDownlinkAirtime accounts down in the airtime usage of dev.
+// recordDownlinkAirtime returns the summary of the usage if dev exceeds the fair-use limits for the first time
+// in the window as a result of down and nil otherwise.
+func (ns *NetworkServer) recordDownlinkAirtime(ctx context.Context, dev *ttnpb.EndDevice, phy band.Band, down *ttnpb.DownlinkMessage) *ttnpb.EndDeviceAirtimeUsageSummary {
+	d, err := downlinkAirtime(phy, down)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Failed to compute downlink airtime")
+	}
+	return recordAirtime(dev, ns.fairUse.Limits(dev.ApplicationIdentifiers), false, d, time.Now().UTC())
+}
+
+// checkFairUse returns errFairUseExceeded if application downlink should be rejected for dev.
+func (ns *NetworkServer) checkFairUse(dev *ttnpb.EndDevice) error {
+	if !ns.fairUse.RejectDownlinks {
+		return nil
+	}
+	limits := ns.fairUse.Limits(dev.ApplicationIdentifiers)
+	s := summarizeAirtimeUsage(dev.AirtimeUsage, limits, time.Now().UTC())
+	if s.Exceeded || limits.Downlinks > 0 && s.Downlinks >= limits.Downlinks {
+		return errFairUseExceeded
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestFairUseConfigParse(t *testing.T) {
+	a := assertions.New(t)
+
+	p, err := FairUseConfig{
+		UplinkAirtime: 30 * time.Second,
+		Downlinks:     10,
+		ApplicationUplinkAirtime: map[string]string{
+			"test-app-1": "1m",
+		},
+		ApplicationDownlinks: map[string]string{
+			"test-app-1": "20",
+			"test-app-2": "0",
+		},
+		RejectDownlinks: true,
+	}.Parse()
+	a.So(err, should.BeNil)
+	a.So(p, should.Resemble, FairUsePolicy{
+		Default: FairUseLimits{
+			UplinkAirtime: 30 * time.Second,
+			Downlinks:     10,
+		},
+		Applications: map[string]FairUseLimits{
+			"test-app-1": {
+				UplinkAirtime: time.Minute,
+				Downlinks:     20,
+			},
+			"test-app-2": {
+				UplinkAirtime: 30 * time.Second,
+			},
+		},
+		RejectDownlinks: true,
+	})
+	a.So(p.Limits(ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-1"}), should.Resemble, p.Applications["test-app-1"])
+	a.So(p.Limits(ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-3"}), should.Resemble, p.Default)
+
+	_, err = FairUseConfig{
+		ApplicationUplinkAirtime: map[string]string{
+			"test-app": "30",
+		},
+	}.Parse()
+	a.So(err, should.HaveSameErrorDefinitionAs, errFairUseLimit)
+
+	_, err = FairUseConfig{
+		ApplicationDownlinks: map[string]string{
+			"test-app": "-1",
+		},
+	}.Parse()
+	a.So(err, should.HaveSameErrorDefinitionAs, errFairUseLimit)
+}
+
+func TestRecordAirtime(t *testing.T) {
+	a := assertions.New(t)
+
+	limits := FairUseLimits{
+		UplinkAirtime: 3 * time.Second,
+		Downlinks:     2,
+	}
+	start := time.Date(2019, 7, 1, 12, 30, 0, 0, time.UTC)
+	dev := &ttnpb.EndDevice{}
+
+	a.So(recordAirtime(dev, limits, true, time.Second, start), should.BeNil)
+	a.So(recordAirtime(dev, limits, false, 100*time.Millisecond, start.Add(time.Minute)), should.BeNil)
+	a.So(recordAirtime(dev, limits, true, time.Second, start.Add(time.Hour)), should.BeNil)
+	a.So(dev.AirtimeUsage, should.Resemble, &ttnpb.EndDeviceAirtimeUsage{
+		Buckets: []*ttnpb.EndDeviceAirtimeUsage_Bucket{
+			{
+				Start:           time.Date(2019, 7, 1, 12, 0, 0, 0, time.UTC),
+				UplinkAirtime:   time.Second,
+				DownlinkAirtime: 100 * time.Millisecond,
+				Uplinks:         1,
+				Downlinks:       1,
+			},
+			{
+				Start:         time.Date(2019, 7, 1, 13, 0, 0, 0, time.UTC),
+				UplinkAirtime: time.Second,
+				Uplinks:       1,
+			},
+		},
+	})
+
+	exceededAt := start.Add(2 * time.Hour)
+	a.So(recordAirtime(dev, limits, true, 1500*time.Millisecond, exceededAt), should.Resemble, &ttnpb.EndDeviceAirtimeUsageSummary{
+		WindowStart:        time.Date(2019, 6, 30, 15, 0, 0, 0, time.UTC),
+		UplinkAirtime:      3500 * time.Millisecond,
+		DownlinkAirtime:    100 * time.Millisecond,
+		Uplinks:            3,
+		Downlinks:          1,
+		UplinkAirtimeLimit: 3 * time.Second,
+		DownlinksLimit:     2,
+		Exceeded:           true,
+		ExceededAt:         &exceededAt,
+	})
+	a.So(dev.AirtimeUsage.ExceededAt, should.Resemble, &exceededAt)
+
+	// Limits are only reported exceeded once per window.
+	a.So(recordAirtime(dev, limits, true, time.Second, exceededAt.Add(time.Minute)), should.BeNil)
+
+	// Buckets outside of the window are removed.
+	now := start.Add(24 * time.Hour)
+	s := summarizeAirtimeUsage(dev.AirtimeUsage, limits, now)
+	a.So(s.UplinkAirtime, should.Equal, 3500*time.Millisecond)
+	a.So(s.Uplinks, should.Equal, 3)
+	a.So(s.Downlinks, should.BeZeroValue)
+	a.So(s.Exceeded, should.BeTrue)
+
+	a.So(recordAirtime(dev, limits, false, 100*time.Millisecond, now), should.BeNil)
+	a.So(dev.AirtimeUsage.Buckets, should.HaveLength, 3)
+	a.So(dev.AirtimeUsage.Buckets[0].Start, should.Equal, time.Date(2019, 7, 1, 13, 0, 0, 0, time.UTC))
+
+	// Limits may be exceeded again once the previous excess left the window.
+	now = start.Add(27 * time.Hour)
+	a.So(recordAirtime(dev, limits, false, 100*time.Millisecond, now), should.BeNil)
+	s = recordAirtime(dev, limits, false, 100*time.Millisecond, now)
+	if a.So(s, should.NotBeNil) {
+		a.So(s.Downlinks, should.Equal, 3)
+		a.So(s.Exceeded, should.BeTrue)
+		a.So(s.ExceededAt, should.Resemble, &now)
+	}
+}
+
+func TestDownlinkAirtime(t *testing.T) {
+	a := assertions.New(t)
+	phy := band.All[band.EU_863_870]
+
+	down := &ttnpb.DownlinkMessage{
+		RawPayload: make([]byte, 13),
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:            ttnpb.CLASS_A,
+				Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+				Rx1Frequency:     868100000,
+				Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+				Rx2Frequency:     869525000,
+			},
+		},
+	}
+	d, err := downlinkAirtime(phy, down)
+	a.So(err, should.BeNil)
+	a.So(d, should.Equal, 46336*time.Microsecond)
+
+	down.GetRequest().Rx1Frequency = 0
+	d, err = downlinkAirtime(phy, down)
+	a.So(err, should.BeNil)
+	a.So(d, should.Equal, 1155072*time.Microsecond)
+
+	_, err = downlinkAirtime(phy, &ttnpb.DownlinkMessage{})
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidDataRate)
+}
+
+func TestCheckFairUse(t *testing.T) {
+	a := assertions.New(t)
+
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	dev := &ttnpb.EndDevice{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: appID,
+			DeviceID:               "test-dev",
+		},
+	}
+	limits := FairUseLimits{
+		Downlinks: 1,
+	}
+	recordAirtime(dev, limits, false, time.Second, time.Now().UTC())
+
+	ns := &NetworkServer{
+		fairUse: FairUsePolicy{
+			Default: limits,
+		},
+	}
+	a.So(ns.checkFairUse(dev), should.BeNil)
+
+	ns.fairUse.RejectDownlinks = true
+	a.So(ns.checkFairUse(dev), should.HaveSameErrorDefinitionAs, errFairUseExceeded)
+
+	ns.fairUse.Applications = map[string]FairUseLimits{
+		appID.ApplicationID: {
+			Downlinks: 2,
+		},
+	}
+	a.So(ns.checkFairUse(dev), should.BeNil)
+}
@@ -120,6 +120,7 @@ func validateApplicationDownlinks(macState ttnpb.MACState, session ttnpb.Session
 // - The device has neither MACState and Session, nor PendingMACState and PendingSession set.
 // - Items belong to different sessions;
 // - An item has ClassBC set, but device is in Class A mode.
+// - An item's ClassBC.AbsoluteTime or ExpiresAt is in the past.
 // - An item's FRMPayload is longer than 250.
 // - An item's session is neither the device's session or pending session;
 // - An item's FCnt is not higher than the previous for the corresponding session;
@@ -137,6 +138,9 @@ func validateQueuedApplicationDownlinks(dev *ttnpb.EndDevice) error {
 		if absTime := down.GetClassBC().GetAbsoluteTime(); absTime != nil && absTime.Before(time.Now()) {
 			return errExpiredDownlink
 		}
+		if down.ExpiresAt != nil && down.ExpiresAt.Before(time.Now()) {
+			return errExpiredDownlink
+		}
 		if len(down.FRMPayload) > 250 {
 			return errInvalidPayload
 		}
@@ -168,6 +172,7 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 
 	dev, err := ns.devices.SetByID(ctx, req.EndDeviceIdentifiers.ApplicationIdentifiers, req.EndDeviceIdentifiers.DeviceID,
 		[]string{
+			"airtime_usage",
 			"mac_state",
 			"multicast",
 			"pending_mac_state",
@@ -179,6 +184,11 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 			if dev == nil {
 				return nil, nil, errDeviceNotFound
 			}
+			if len(req.Downlinks) > 0 {
+				if err := ns.checkFairUse(dev); err != nil {
+					return nil, nil, err
+				}
+			}
 			dev.QueuedApplicationDownlinks = req.Downlinks
 			if err := validateQueuedApplicationDownlinks(dev); err != nil {
 				return nil, nil, err
@@ -214,6 +224,7 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 
 	dev, err := ns.devices.SetByID(ctx, req.EndDeviceIdentifiers.ApplicationIdentifiers, req.EndDeviceIdentifiers.DeviceID,
 		[]string{
+			"airtime_usage",
 			"mac_state",
 			"multicast",
 			"pending_mac_state",
@@ -225,6 +236,11 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 			if dev == nil {
 				return nil, nil, errDeviceNotFound
 			}
+			if len(req.Downlinks) > 0 {
+				if err := ns.checkFairUse(dev); err != nil {
+					return nil, nil, err
+				}
+			}
 			dev.QueuedApplicationDownlinks = append(dev.QueuedApplicationDownlinks, req.Downlinks...)
 			if err := validateQueuedApplicationDownlinks(dev); err != nil {
 				return nil, nil, err
@@ -207,6 +207,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -265,6 +266,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -340,6 +342,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -436,6 +439,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -528,6 +532,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -599,6 +604,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -682,6 +688,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -872,6 +879,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -931,6 +939,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -1006,6 +1015,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -1101,6 +1111,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -1211,6 +1222,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -1267,6 +1279,65 @@ func TestDownlinkQueuePush(t *testing.T) {
 			SetByIDCalls: 1,
 		},
 
+		{
+			Name: "Invalid request/push/Class C/expired",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(ctx, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_LINK,
+							},
+						},
+					},
+				})
+			},
+			SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
+				a.So(devID, should.Equal, "test-dev-id")
+				dev, sets, err := f(&ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						DeviceID:               "test-dev-id",
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+					},
+					MACState: &ttnpb.MACState{
+						DeviceClass:    ttnpb.CLASS_C,
+						LoRaWANVersion: ttnpb.MAC_V1_1,
+					},
+					Session: &ttnpb.Session{
+						SessionKeys: ttnpb.SessionKeys{
+							SessionKeyID: []byte("testSession"),
+						},
+					},
+				})
+				if !a.So(err, should.BeError) {
+					t.Error("Error was expected")
+					return nil, errors.New("Error was expected")
+				}
+				a.So(sets, should.BeNil)
+				a.So(dev, should.BeNil)
+				return nil, err
+			},
+			Request: &ttnpb.DownlinkQueueRequest{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DeviceID:               "test-dev-id",
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+				},
+				Downlinks: []*ttnpb.ApplicationDownlink{
+					{SessionKeyID: []byte("testSession"), FCnt: 1, ExpiresAt: TimePtr(time.Now().Add(-time.Second))},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				if !assertions.New(t).So(errors.IsFailedPrecondition(err), should.BeTrue) {
+					t.Errorf("Received error: %s", err)
+					return false
+				}
+				return true
+			},
+			SetByIDCalls: 1,
+		},
+
 		{
 			Name: "Invalid request/push/Class C/FCnt lower than NFCntDown",
 			ContextFunc: func(ctx context.Context) context.Context {
@@ -1295,6 +1366,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -1375,6 +1447,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"airtime_usage",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -16,12 +16,14 @@ package networkserver
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -100,6 +102,23 @@ func validABPSessionKey(key *ttnpb.KeyEnvelope) bool {
 	return key != nil && key.KEKLabel == "" && !key.Key.IsZero()
 }
 
+// validateADRFixedDataRateIndex checks that the fixed ADR data rate index in the MAC settings of dev, if any,
+// does not exceed the maximum ADR data rate index of the band of dev.
+func validateADRFixedDataRateIndex(dev *ttnpb.EndDevice, fps *frequencyplans.Store) error {
+	drIdx := dev.GetMACSettings().GetADRFixedDataRateIndex()
+	if drIdx == nil {
+		return nil
+	}
+	_, phy, err := getDeviceBandVersion(dev, fps)
+	if err != nil {
+		return err
+	}
+	if drIdx.Value > ttnpb.DataRateIndex(phy.MaxADRDataRateIndex) {
+		return errInvalidFieldValue.WithAttributes("field", "mac_settings.adr_fixed_data_rate_index")
+	}
+	return nil
+}
+
 // Set implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "session.dev_addr") && (req.EndDevice.Session == nil || req.EndDevice.Session.DevAddr.IsZero()) {
@@ -144,6 +163,20 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			"queued_application_downlinks",
 		)
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mac_settings.adr_fixed_data_rate_index") {
+		gets = append(gets,
+			"frequency_plan_id",
+			"lorawan_phy_version",
+		)
+	}
+	// NOTE: Channels are only reconciled if the MAC state is not modified by the request itself.
+	reconcileChannels := ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_id")
+	for _, path := range req.FieldMask.Paths {
+		if path == "mac_state" || strings.HasPrefix(path, "mac_state.") {
+			reconcileChannels = false
+			break
+		}
+	}
 
 	var evt events.Event
 	var addDownlinkTask bool
@@ -155,6 +188,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 
 		if dev == nil {
 			evt = evtCreateEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, nil)
+			reconcileChannels = false
 		} else {
 			evt = evtUpdateEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, req.FieldMask.Paths)
 			if err := ttnpb.ProhibitFields(req.FieldMask.Paths,
@@ -171,6 +205,29 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			addDownlinkTask = ttnpb.HasAnyField(req.FieldMask.Paths, "mac_state.device_class") &&
 				req.EndDevice.MACState.DeviceClass != ttnpb.CLASS_A &&
 				(len(dev.QueuedApplicationDownlinks) > 0 || !dev.MACState.CurrentParameters.Equal(dev.MACState.DesiredParameters))
+			if reconcileChannels && dev.FrequencyPlanID != req.EndDevice.FrequencyPlanID {
+				if err := checkFrequencyPlanCompatibility(dev.FrequencyPlanID, req.EndDevice.FrequencyPlanID, ns.FrequencyPlans); err != nil {
+					return nil, nil, err
+				}
+			} else {
+				reconcileChannels = false
+			}
+			if ttnpb.HasAnyField(req.FieldMask.Paths, "mac_settings.adr_fixed_data_rate_index") {
+				withBand := &ttnpb.EndDevice{
+					FrequencyPlanID:   dev.FrequencyPlanID,
+					LoRaWANPHYVersion: dev.LoRaWANPHYVersion,
+					MACSettings:       req.EndDevice.MACSettings,
+				}
+				if ttnpb.HasAnyField(req.FieldMask.Paths, "frequency_plan_id") {
+					withBand.FrequencyPlanID = req.EndDevice.FrequencyPlanID
+				}
+				if ttnpb.HasAnyField(req.FieldMask.Paths, "lorawan_phy_version") {
+					withBand.LoRaWANPHYVersion = req.EndDevice.LoRaWANPHYVersion
+				}
+				if err := validateADRFixedDataRateIndex(withBand, ns.FrequencyPlans); err != nil {
+					return nil, nil, err
+				}
+			}
 			return &req.EndDevice, sets, nil
 		}
 
@@ -252,6 +309,12 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			return nil, nil, errInvalidFieldMask.WithCause(err)
 		}
 
+		if ttnpb.HasAnyField(sets, "mac_settings.adr_fixed_data_rate_index") {
+			if err := validateADRFixedDataRateIndex(&req.EndDevice, ns.FrequencyPlans); err != nil {
+				return nil, nil, err
+			}
+		}
+
 		if ttnpb.HasAnyField(sets, "supports_class_b") && req.EndDevice.SupportsClassB {
 			if err := ttnpb.RequireFields(sets,
 				"mac_settings.ping_slot_date_rate_index",
@@ -366,6 +429,11 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	if evt != nil {
 		events.Publish(evt)
 	}
+	if reconcileChannels && dev != nil {
+		if err := ns.reconcileChannelPlan(ctx, dev.EndDeviceIdentifiers); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to reconcile channel plan after frequency plan change")
+		}
+	}
 	if addDownlinkTask {
 		startAt := time.Now().UTC()
 		log.FromContext(ctx).WithField("start_at", startAt).Debug("Add downlink task")
@@ -396,3 +464,60 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 	}
 	return ttnpb.Empty, err
 }
+
+// QueueMACCommands implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) QueueMACCommands(ctx context.Context, req *ttnpb.QueueMACCommandsRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	dev, err := ns.devices.SetByID(ctx, req.ApplicationIdentifiers, req.DeviceID,
+		[]string{
+			"frequency_plan_id",
+			"lorawan_phy_version",
+			"mac_state",
+			"multicast",
+		},
+		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound
+			}
+			if dev.MACState == nil {
+				return nil, nil, errUnknownMACState
+			}
+			_, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := queueOperatorMACCommands(dev, phy, req.MACCommands...); err != nil {
+				return nil, nil, err
+			}
+			return dev, []string{
+				"mac_state.desired_parameters",
+				"mac_state.queued_operator_commands",
+			}, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtQueueOperatorMACCommands(ctx, req.EndDeviceIdentifiers, req.MACCommands))
+	if dev.MACState.DeviceClass != ttnpb.CLASS_A {
+		startAt := time.Now().UTC()
+		log.FromContext(ctx).WithField("start_at", startAt).Debug("Add downlink task with operator MAC commands queued")
+		if err = ns.downlinkTasks.Add(ctx, dev.EndDeviceIdentifiers, startAt, true); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to add downlink task for device after queueing MAC commands")
+		}
+	}
+	return ttnpb.Empty, nil
+}
+
+// GetAirtimeUsage implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetAirtimeUsage(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceAirtimeUsageSummary, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	dev, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, []string{"airtime_usage"})
+	if err != nil {
+		return nil, err
+	}
+	return summarizeAirtimeUsage(dev.AirtimeUsage, ns.fairUse.Limits(req.ApplicationIdentifiers), time.Now().UTC()), nil
+}
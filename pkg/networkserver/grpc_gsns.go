@@ -597,6 +597,9 @@ matchLoop:
 			}
 		}
 		match.Device.MACState.PendingRequests = match.Device.MACState.PendingRequests[:0]
+		if updateChannelPlanReconciliation(match.Device.MACState, time.Now().UTC()) {
+			match.QueuedEvents = append(match.QueuedEvents, evtCompleteChannelPlanReconciliation.BindData(match.Device.MACState.ChannelPlanReconciliation))
+		}
 
 		if match.Pending {
 			if match.Device.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 {
@@ -718,6 +721,7 @@ func appendRecentUplink(recent []*ttnpb.UplinkMessage, up *ttnpb.UplinkMessage,
 }
 
 var handleUplinkGetPaths = [...]string{
+	"airtime_usage",
 	"frequency_plan_id",
 	"last_dev_status_received_at",
 	"lorawan_phy_version",
@@ -751,6 +755,12 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	))
 	ctx = log.NewContext(ctx, logger)
 
+	if ns.interopClient != nil && !ns.hasDevAddrPrefix(pld.DevAddr) {
+		if netID, ok := ns.interopClient.RoamingNetID(pld.DevAddr); ok {
+			return ns.forwardDataUplink(ctx, up, acc, netID)
+		}
+	}
+
 	logger.Debug("Match device")
 
 	var addrMatches []*ttnpb.EndDevice
@@ -828,6 +838,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, recentUplinkCount)
 			paths = append(paths, "recent_uplinks")
 
+			if usage := ns.recordUplinkAirtime(ctx, stored, up); usage != nil {
+				queuedEvents = append(queuedEvents, evtExceedFairUse.BindData(usage))
+			}
+			paths = append(paths, "airtime_usage")
+
 			paths = append(paths, "recent_adr_uplinks")
 			if !pld.FHDR.ADR {
 				stored.RecentADRUplinks = nil
@@ -839,7 +854,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				return stored, paths, nil
 			}
 
-			if err := adaptDataRate(stored, ns.FrequencyPlans, ns.defaultMACSettings); err != nil {
+			if err := ns.adaptDataRate(ctx, stored); err != nil {
 				handleErr = true
 				return nil, nil, err
 			}
@@ -1098,8 +1113,10 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	registerMergeMetadata(ctx, up)
 
 	var invalidatedQueue []*ttnpb.ApplicationDownlink
+	var exceededUsage *ttnpb.EndDeviceAirtimeUsageSummary
 	dev, err = ns.devices.SetByID(ctx, dev.EndDeviceIdentifiers.ApplicationIdentifiers, dev.EndDeviceIdentifiers.DeviceID,
 		[]string{
+			"airtime_usage",
 			"frequency_plan_id",
 			"lorawan_phy_version",
 			"queued_application_downlinks",
@@ -1131,6 +1148,9 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, recentUplinkCount)
 			paths = append(paths, "recent_uplinks")
 
+			exceededUsage = ns.recordUplinkAirtime(ctx, stored, up)
+			paths = append(paths, "airtime_usage")
+
 			invalidatedQueue = stored.QueuedApplicationDownlinks
 			stored.QueuedApplicationDownlinks = nil
 			paths = append(paths, "queued_application_downlinks")
@@ -1144,6 +1164,9 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	if err != nil {
 		return err
 	}
+	if exceededUsage != nil {
+		events.Publish(evtExceedFairUse(ctx, dev.EndDeviceIdentifiers, exceededUsage))
+	}
 
 	go func() {
 		logger := logger.WithField(
@@ -1200,13 +1223,19 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	up.ReceivedAt = time.Now().UTC()
+	clearForwardingNetIDs(up.RxMetadata...)
+	return ns.handleUplink(ctx, up)
+}
 
+// handleUplink handles the uplink message up, which is either received from a Gateway Server
+// or forwarded by a Network Server in passive roaming.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*pbtypes.Empty, error) {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIDs,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
 	)...)
 	up.CorrelationIDs = events.CorrelationIDsFromContext(ctx)
-	up.ReceivedAt = time.Now().UTC()
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
 		return nil, errDecodePayload.WithCause(err)
@@ -121,6 +121,7 @@ func sendUplinkDuplicates(ctx context.Context, handle func(ctx context.Context,
 
 func TestHandleUplink(t *testing.T) {
 	dataGetPaths := [...]string{
+		"airtime_usage",
 		"frequency_plan_id",
 		"last_dev_status_received_at",
 		"lorawan_phy_version",
@@ -150,6 +151,7 @@ func TestHandleUplink(t *testing.T) {
 	}
 
 	joinSetByEUIGetPaths := [...]string{
+		"airtime_usage",
 		"frequency_plan_id",
 		"lorawan_phy_version",
 		"queued_application_downlinks",
@@ -157,6 +159,7 @@ func TestHandleUplink(t *testing.T) {
 	}
 
 	joinSetByEUISetPaths := [...]string{
+		"airtime_usage",
 		"pending_mac_state",
 		"queued_application_downlinks",
 		"recent_uplinks",
@@ -1326,6 +1329,9 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, joinSetByEUISetPaths[:])
+					if a.So(dev.AirtimeUsage, should.NotBeNil) && a.So(dev.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(dev.AirtimeUsage.Buckets[0].Uplinks, should.Equal, 1)
+					}
 
 					macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_1)
 					macState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_3
@@ -1543,6 +1549,9 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, joinSetByEUISetPaths[:])
+					if a.So(dev.AirtimeUsage, should.NotBeNil) && a.So(dev.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(dev.AirtimeUsage.Buckets[0].Uplinks, should.Equal, 1)
+					}
 
 					macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_2)
 					macState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_3
@@ -1727,6 +1736,9 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, joinSetByEUISetPaths[:])
+					if a.So(dev.AirtimeUsage, should.NotBeNil) && a.So(dev.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(dev.AirtimeUsage.Buckets[0].Uplinks, should.Equal, 1)
+					}
 
 					macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_1)
 					macState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_3
@@ -1944,6 +1956,9 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, joinSetByEUISetPaths[:])
+					if a.So(dev.AirtimeUsage, should.NotBeNil) && a.So(dev.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(dev.AirtimeUsage.Buckets[0].Uplinks, should.Equal, 1)
+					}
 
 					macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_2)
 					macState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_3
@@ -2164,6 +2179,9 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, joinSetByEUISetPaths[:])
+					if a.So(dev.AirtimeUsage, should.NotBeNil) && a.So(dev.AirtimeUsage.Buckets, should.HaveLength, 1) {
+						a.So(dev.AirtimeUsage.Buckets[0].Uplinks, should.Equal, 1)
+					}
 
 					macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_1)
 					macState.DesiredParameters.Rx1Delay = ttnpb.RX_DELAY_3
@@ -2348,6 +2366,7 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, []string{
+						"airtime_usage",
 						"mac_state",
 						"pending_mac_state",
 						"pending_session",
@@ -2560,6 +2579,7 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, []string{
+						"airtime_usage",
 						"mac_state",
 						"pending_mac_state",
 						"pending_session",
@@ -2774,6 +2794,7 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, []string{
+						"airtime_usage",
 						"mac_state",
 						"pending_mac_state",
 						"pending_session",
@@ -2994,6 +3015,7 @@ func TestHandleUplink(t *testing.T) {
 						return false
 					}
 					a.So(sets, should.HaveSameElementsDeep, []string{
+						"airtime_usage",
 						"mac_state",
 						"pending_mac_state",
 						"pending_session",
@@ -0,0 +1,106 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+type interopServer struct {
+	NS *NetworkServer
+}
+
+// PRStartRequest handles the passive roaming start request, which is sent by the forwarding Network Server
+// when it receives an uplink message of a device served by this Network Server.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !types.NetID(in.ReceiverID).Equal(srv.NS.netID) {
+		return nil, interop.ErrUnknownReceiver
+	}
+	if srv.NS.interopClient == nil || !srv.NS.interopClient.HasRoamingAgreement(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement
+	}
+	if in.ULMetaData.DevAddr == nil || !srv.NS.hasDevAddrPrefix(types.DevAddr(*in.ULMetaData.DevAddr)) {
+		return nil, interop.ErrUnknownDevAddr
+	}
+	up, err := uplinkFromPRStartRequest(in)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+
+	if _, err := srv.NS.handleUplink(ctx, up); err != nil {
+		switch {
+		case errors.Resemble(err, errDecodePayload),
+			errors.Resemble(err, errUnsupportedLoRaWANVersion):
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		case errors.Resemble(err, errDeviceNotFound):
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		}
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	// The Network Server does not keep passive roaming state; each uplink message is forwarded by the fNS.
+	lifetime := uint32(0)
+	return &interop.PRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		Lifetime: &lifetime,
+	}, nil
+}
+
+// XmitDataRequest handles the transmit data request, which is sent by the serving Network Server
+// to schedule a downlink message through the gateways of this Network Server.
+func (srv interopServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if !types.NetID(in.ReceiverID).Equal(srv.NS.netID) {
+		return nil, interop.ErrUnknownReceiver
+	}
+	if srv.NS.interopClient == nil || !srv.NS.interopClient.HasRoamingAgreement(types.NetID(in.SenderID)) {
+		return nil, interop.ErrNoRoamingAgreement
+	}
+	req, paths, err := txRequestFromXmitDataRequest(in, srv.NS.roamingUplinkTokenAEAD)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	if _, err := srv.NS.scheduleDownlinkByPaths(ctx, req, in.PHYPayload, paths...); err != nil {
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DLFreq1: in.DLMetaData.DLFreq1,
+		DLFreq2: in.DLMetaData.DLFreq2,
+	}, nil
+}
@@ -0,0 +1,214 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtQueueOperatorMACCommands = events.Define(
+		"ns.mac.operator.queue", "queue operator MAC commands",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+
+	errMACCommandNotQueueable = errors.DefineInvalidArgument("mac_command_not_queueable", "MAC command `{cid}` can not be queued")
+	errMACCommandNotSupported = errors.DefineFailedPrecondition("mac_command_not_supported", "MAC command `{cid}` is not supported by the device")
+)
+
+// operatorMACCommandMinVersions contains the MAC commands, which may be queued by an operator,
+// mapped to the minimum LoRaWAN version supporting them.
+var operatorMACCommandMinVersions = map[ttnpb.MACCommandIdentifier]ttnpb.MACVersion{
+	ttnpb.CID_LINK_CHECK:     ttnpb.MAC_V1_0,
+	ttnpb.CID_DEV_STATUS:     ttnpb.MAC_V1_0,
+	ttnpb.CID_RX_PARAM_SETUP: ttnpb.MAC_V1_0,
+	ttnpb.CID_NEW_CHANNEL:    ttnpb.MAC_V1_0,
+	ttnpb.CID_DEVICE_TIME:    ttnpb.MAC_V1_0_3,
+	ttnpb.CID_FORCE_REJOIN:   ttnpb.MAC_V1_1,
+}
+
+func validDataRateIndex(phy band.Band, idx ttnpb.DataRateIndex) bool {
+	return int(idx) < len(phy.DataRates) && phy.DataRates[idx].Rate.Modulation != nil
+}
+
+// validateOperatorMACCommand validates whether cmd may be queued by an operator for dev operating in phy.
+func validateOperatorMACCommand(dev *ttnpb.EndDevice, phy band.Band, cmd *ttnpb.MACCommand) error {
+	minVersion, ok := operatorMACCommandMinVersions[cmd.CID]
+	if !ok {
+		return errMACCommandNotQueueable.WithAttributes("cid", cmd.CID)
+	}
+	if dev.MACState.LoRaWANVersion.Compare(minVersion) < 0 || dev.Multicast {
+		return errMACCommandNotSupported.WithAttributes("cid", cmd.CID)
+	}
+	if err := cmd.ValidateFields(); err != nil {
+		return err
+	}
+
+	switch cmd.CID {
+	case ttnpb.CID_DEV_STATUS:
+		return nil
+
+	case ttnpb.CID_LINK_CHECK:
+		if cmd.GetLinkCheckAns() == nil {
+			return errNoPayload
+		}
+		return nil
+
+	case ttnpb.CID_DEVICE_TIME:
+		if cmd.GetDeviceTimeAns() == nil {
+			return errNoPayload
+		}
+		return nil
+
+	case ttnpb.CID_RX_PARAM_SETUP:
+		pld := cmd.GetRxParamSetupReq()
+		if pld == nil {
+			return errNoPayload
+		}
+		if !validDataRateIndex(phy, pld.Rx2DataRateIndex) {
+			return errInvalidDataRate
+		}
+		if _, err := phy.Rx1DataRate(dev.MACState.CurrentParameters.ADRDataRateIndex, pld.Rx1DataRateOffset, dev.MACState.CurrentParameters.DownlinkDwellTime.GetValue()); err != nil {
+			return errInvalidFieldValue.WithAttributes("field", "rx1_data_rate_offset").WithCause(err)
+		}
+		if _, ok := phy.FindSubBand(pld.Rx2Frequency); !ok {
+			return errInvalidFieldValue.WithAttributes("field", "rx2_frequency")
+		}
+		return nil
+
+	case ttnpb.CID_NEW_CHANNEL:
+		pld := cmd.GetNewChannelReq()
+		if pld == nil {
+			return errNoPayload
+		}
+		if phy.CFListType != ttnpb.CFListType_FREQUENCIES {
+			return errMACCommandNotSupported.WithAttributes("cid", cmd.CID)
+		}
+		// NOTE: Default channels can not be modified and channels can only be appended to the end of the channel list.
+		if pld.ChannelIndex < uint32(len(phy.UplinkChannels)) ||
+			pld.ChannelIndex >= uint32(phy.MaxUplinkChannels) ||
+			pld.ChannelIndex > uint32(len(dev.MACState.DesiredParameters.Channels)) {
+			return errInvalidChannelIndex
+		}
+		if !validDataRateIndex(phy, pld.MinDataRateIndex) ||
+			!validDataRateIndex(phy, pld.MaxDataRateIndex) ||
+			pld.MinDataRateIndex > pld.MaxDataRateIndex {
+			return errInvalidDataRate
+		}
+		if _, ok := phy.FindSubBand(pld.Frequency); !ok {
+			return errInvalidFieldValue.WithAttributes("field", "frequency")
+		}
+		return nil
+
+	case ttnpb.CID_FORCE_REJOIN:
+		pld := cmd.GetForceRejoinReq()
+		if pld == nil {
+			return errNoPayload
+		}
+		if !validDataRateIndex(phy, pld.DataRateIndex) {
+			return errInvalidDataRate
+		}
+		return nil
+	}
+	panic("unreachable")
+}
+
+// queueOperatorMACCommands validates cmds and queues them to be sent to dev.
+// RxParamSetupReq and NewChannelReq update the desired MAC parameters of the device, which causes the requests
+// to be generated on the next downlink. All other commands are added to the queued operator commands.
+// If any of cmds is invalid, dev is not modified.
+func queueOperatorMACCommands(dev *ttnpb.EndDevice, phy band.Band, cmds ...*ttnpb.MACCommand) error {
+	for _, cmd := range cmds {
+		if err := validateOperatorMACCommand(dev, phy, cmd); err != nil {
+			return err
+		}
+	}
+	for _, cmd := range cmds {
+		switch cmd.CID {
+		case ttnpb.CID_RX_PARAM_SETUP:
+			pld := cmd.GetRxParamSetupReq()
+			dev.MACState.DesiredParameters.Rx1DataRateOffset = pld.Rx1DataRateOffset
+			dev.MACState.DesiredParameters.Rx2DataRateIndex = pld.Rx2DataRateIndex
+			dev.MACState.DesiredParameters.Rx2Frequency = pld.Rx2Frequency
+
+		case ttnpb.CID_NEW_CHANNEL:
+			pld := cmd.GetNewChannelReq()
+			if pld.ChannelIndex == uint32(len(dev.MACState.DesiredParameters.Channels)) {
+				dev.MACState.DesiredParameters.Channels = append(dev.MACState.DesiredParameters.Channels, &ttnpb.MACParameters_Channel{
+					DownlinkFrequency: pld.Frequency,
+				})
+			}
+			ch := dev.MACState.DesiredParameters.Channels[pld.ChannelIndex]
+			ch.UplinkFrequency = pld.Frequency
+			ch.MinDataRateIndex = pld.MinDataRateIndex
+			ch.MaxDataRateIndex = pld.MaxDataRateIndex
+			ch.EnableUplink = true
+
+		default:
+			dev.MACState.QueuedOperatorCommands = append(dev.MACState.QueuedOperatorCommands, cmd)
+		}
+	}
+	return nil
+}
+
+func operatorMACCommandEvent(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, cmd *ttnpb.MACCommand) events.Event {
+	switch cmd.CID {
+	case ttnpb.CID_LINK_CHECK:
+		return evtEnqueueLinkCheckAnswer(ctx, ids, cmd.GetLinkCheckAns())
+	case ttnpb.CID_DEV_STATUS:
+		return evtEnqueueDevStatusRequest(ctx, ids, nil)
+	case ttnpb.CID_DEVICE_TIME:
+		return evtEnqueueDeviceTimeAnswer(ctx, ids, cmd.GetDeviceTimeAns())
+	case ttnpb.CID_FORCE_REJOIN:
+		return evtEnqueueForceRejoinRequest(ctx, ids, cmd.GetForceRejoinReq())
+	}
+	return nil
+}
+
+// enqueueOperatorMACCommands appends the queued operator commands of dev, which fit in maxDownLen and maxUpLen, to cmds.
+// Requests are added to the pending requests of dev instead.
+// enqueueOperatorMACCommands returns the answers, the remaining maxDownLen and maxUpLen and
+// whether all queued operator commands were enqueued.
+func enqueueOperatorMACCommands(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen, maxUpLen uint16, cmds []*ttnpb.MACCommand) ([]*ttnpb.MACCommand, uint16, uint16, bool) {
+	for i, cmd := range dev.MACState.QueuedOperatorCommands {
+		desc := lorawan.DefaultMACCommands[cmd.CID]
+		downLen, upLen := 1+desc.DownlinkLength, uint16(0)
+		if !desc.InitiatedByDevice {
+			upLen = 1 + desc.UplinkLength
+		}
+		if downLen > maxDownLen || upLen > maxUpLen {
+			dev.MACState.QueuedOperatorCommands = dev.MACState.QueuedOperatorCommands[i:]
+			return cmds, maxDownLen, maxUpLen, false
+		}
+		maxDownLen -= downLen
+		maxUpLen -= upLen
+		if desc.InitiatedByDevice {
+			cmds = append(cmds, cmd)
+		} else {
+			dev.MACState.PendingRequests = append(dev.MACState.PendingRequests, cmd)
+		}
+		if evt := operatorMACCommandEvent(ctx, dev.EndDeviceIdentifiers, cmd); evt != nil {
+			events.Publish(evt)
+		}
+	}
+	dev.MACState.QueuedOperatorCommands = nil
+	return cmds, maxDownLen, maxUpLen, true
+}
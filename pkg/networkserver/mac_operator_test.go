@@ -0,0 +1,374 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestQueueOperatorMACCommands(t *testing.T) {
+	phy := test.Must(test.Must(band.GetByID(band.EU_863_870)).(band.Band).Version(ttnpb.PHY_V1_1_REV_B)).(band.Band)
+
+	makeDevice := func(ver ttnpb.MACVersion) *ttnpb.EndDevice {
+		chs := make([]*ttnpb.MACParameters_Channel, 0, len(phy.UplinkChannels))
+		for _, ch := range phy.UplinkChannels {
+			chs = append(chs, &ttnpb.MACParameters_Channel{
+				UplinkFrequency:   ch.Frequency,
+				DownlinkFrequency: ch.Frequency,
+				MinDataRateIndex:  ch.MinDataRate,
+				MaxDataRateIndex:  ch.MaxDataRate,
+				EnableUplink:      true,
+			})
+		}
+		return &ttnpb.EndDevice{
+			MACState: &ttnpb.MACState{
+				LoRaWANVersion: ver,
+				CurrentParameters: ttnpb.MACParameters{
+					Rx2Frequency: phy.DefaultRx2Parameters.Frequency,
+					Channels:     deepcopy.Copy(chs).([]*ttnpb.MACParameters_Channel),
+				},
+				DesiredParameters: ttnpb.MACParameters{
+					Rx2Frequency: phy.DefaultRx2Parameters.Frequency,
+					Channels:     chs,
+				},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Device   *ttnpb.EndDevice
+		Commands []*ttnpb.MACCommand
+		Expected func(*ttnpb.EndDevice)
+		Error    error
+	}{
+		{
+			Name:   "1.1/DevStatusReq,LinkCheckAns,ForceRejoinReq",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				ttnpb.CID_DEV_STATUS.MACCommand(),
+				(&ttnpb.MACCommand_LinkCheckAns{Margin: 20, GatewayCount: 2}).MACCommand(),
+				(&ttnpb.MACCommand_ForceRejoinReq{DataRateIndex: ttnpb.DATA_RATE_2, MaxRetries: 3}).MACCommand(),
+			},
+			Expected: func(dev *ttnpb.EndDevice) {
+				dev.MACState.QueuedOperatorCommands = []*ttnpb.MACCommand{
+					ttnpb.CID_DEV_STATUS.MACCommand(),
+					(&ttnpb.MACCommand_LinkCheckAns{Margin: 20, GatewayCount: 2}).MACCommand(),
+					(&ttnpb.MACCommand_ForceRejoinReq{DataRateIndex: ttnpb.DATA_RATE_2, MaxRetries: 3}).MACCommand(),
+				}
+			},
+		},
+		{
+			Name:   "1.0.3/DeviceTimeAns",
+			Device: makeDevice(ttnpb.MAC_V1_0_3),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DeviceTimeAns{Time: time.Unix(42, 0).UTC()}).MACCommand(),
+			},
+			Expected: func(dev *ttnpb.EndDevice) {
+				dev.MACState.QueuedOperatorCommands = []*ttnpb.MACCommand{
+					(&ttnpb.MACCommand_DeviceTimeAns{Time: time.Unix(42, 0).UTC()}).MACCommand(),
+				}
+			},
+		},
+		{
+			Name:   "1.0.2/DeviceTimeAns",
+			Device: makeDevice(ttnpb.MAC_V1_0_2),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_DeviceTimeAns{Time: time.Unix(42, 0).UTC()}).MACCommand(),
+			},
+			Error: errMACCommandNotSupported,
+		},
+		{
+			Name:   "1.0.3/DevStatusReq,ForceRejoinReq",
+			Device: makeDevice(ttnpb.MAC_V1_0_3),
+			Commands: []*ttnpb.MACCommand{
+				ttnpb.CID_DEV_STATUS.MACCommand(),
+				(&ttnpb.MACCommand_ForceRejoinReq{DataRateIndex: ttnpb.DATA_RATE_2}).MACCommand(),
+			},
+			Error: errMACCommandNotSupported,
+		},
+		{
+			Name:   "1.1/LinkADRReq",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkADRReq{DataRateIndex: ttnpb.DATA_RATE_2}).MACCommand(),
+			},
+			Error: errMACCommandNotQueueable,
+		},
+		{
+			Name:   "1.1/LinkCheckAns/no payload",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				ttnpb.CID_LINK_CHECK.MACCommand(),
+			},
+			Error: errNoPayload,
+		},
+		{
+			Name:   "1.1/RxParamSetupReq",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupReq{
+					Rx1DataRateOffset: 2,
+					Rx2DataRateIndex:  ttnpb.DATA_RATE_3,
+					Rx2Frequency:      869525000,
+				}).MACCommand(),
+			},
+			Expected: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.Rx1DataRateOffset = 2
+				dev.MACState.DesiredParameters.Rx2DataRateIndex = ttnpb.DATA_RATE_3
+				dev.MACState.DesiredParameters.Rx2Frequency = 869525000
+			},
+		},
+		{
+			Name:   "1.1/RxParamSetupReq/invalid data rate",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupReq{
+					Rx2DataRateIndex: ttnpb.DATA_RATE_14,
+					Rx2Frequency:     869525000,
+				}).MACCommand(),
+			},
+			Error: errInvalidDataRate,
+		},
+		{
+			Name:   "1.1/RxParamSetupReq/invalid frequency",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_RxParamSetupReq{
+					Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+					Rx2Frequency:     915000000,
+				}).MACCommand(),
+			},
+			Error: errInvalidFieldValue,
+		},
+		{
+			Name:   "1.1/NewChannelReq",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelReq{
+					ChannelIndex:     3,
+					Frequency:        867100000,
+					MinDataRateIndex: ttnpb.DATA_RATE_0,
+					MaxDataRateIndex: ttnpb.DATA_RATE_5,
+				}).MACCommand(),
+			},
+			Expected: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.Channels = append(dev.MACState.DesiredParameters.Channels, &ttnpb.MACParameters_Channel{
+					UplinkFrequency:   867100000,
+					DownlinkFrequency: 867100000,
+					MinDataRateIndex:  ttnpb.DATA_RATE_0,
+					MaxDataRateIndex:  ttnpb.DATA_RATE_5,
+					EnableUplink:      true,
+				})
+			},
+		},
+		{
+			Name:   "1.1/NewChannelReq/default channel",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelReq{
+					ChannelIndex:     1,
+					Frequency:        867100000,
+					MaxDataRateIndex: ttnpb.DATA_RATE_5,
+				}).MACCommand(),
+			},
+			Error: errInvalidChannelIndex,
+		},
+		{
+			Name:   "1.1/NewChannelReq/gap",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_NewChannelReq{
+					ChannelIndex:     5,
+					Frequency:        867100000,
+					MaxDataRateIndex: ttnpb.DATA_RATE_5,
+				}).MACCommand(),
+			},
+			Error: errInvalidChannelIndex,
+		},
+		{
+			Name:   "1.1/DevStatusReq,NewChannelReq/invalid data rate range",
+			Device: makeDevice(ttnpb.MAC_V1_1),
+			Commands: []*ttnpb.MACCommand{
+				ttnpb.CID_DEV_STATUS.MACCommand(),
+				(&ttnpb.MACCommand_NewChannelReq{
+					ChannelIndex:     3,
+					Frequency:        867100000,
+					MinDataRateIndex: ttnpb.DATA_RATE_5,
+					MaxDataRateIndex: ttnpb.DATA_RATE_2,
+				}).MACCommand(),
+			},
+			Error: errInvalidDataRate,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := deepcopy.Copy(tc.Device).(*ttnpb.EndDevice)
+			err := queueOperatorMACCommands(dev, phy, deepcopy.Copy(tc.Commands).([]*ttnpb.MACCommand)...)
+			expected := deepcopy.Copy(tc.Device).(*ttnpb.EndDevice)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+			} else {
+				a.So(err, should.BeNil)
+				tc.Expected(expected)
+			}
+			a.So(dev, should.Resemble, expected)
+		})
+	}
+}
+
+func TestEnqueueOperatorMACCommands(t *testing.T) {
+	linkCheckAns := (&ttnpb.MACCommand_LinkCheckAns{Margin: 20, GatewayCount: 2}).MACCommand()
+	forceRejoinReq := (&ttnpb.MACCommand_ForceRejoinReq{DataRateIndex: ttnpb.DATA_RATE_2}).MACCommand()
+	devStatusReq := ttnpb.CID_DEV_STATUS.MACCommand()
+
+	for _, tc := range []struct {
+		Name                                              string
+		Device, Expected                                  *ttnpb.EndDevice
+		ExpectedCommands                                  []*ttnpb.MACCommand
+		EventNames                                        []string
+		InputMaxDownlinkLength, ExpectedMaxDownlinkLength uint16
+		InputMaxUplinkLength, ExpectedMaxUplinkLength     uint16
+		Ok                                                bool
+	}{
+		{
+			Name: "no commands",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{},
+			},
+			InputMaxDownlinkLength:    42,
+			ExpectedMaxDownlinkLength: 42,
+			InputMaxUplinkLength:      24,
+			ExpectedMaxUplinkLength:   24,
+			Ok:                        true,
+		},
+		{
+			Name: "all fit",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					QueuedOperatorCommands: []*ttnpb.MACCommand{
+						devStatusReq,
+						linkCheckAns,
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					PendingRequests: []*ttnpb.MACCommand{
+						devStatusReq,
+					},
+				},
+			},
+			ExpectedCommands: []*ttnpb.MACCommand{
+				linkCheckAns,
+			},
+			EventNames: []string{
+				"ns.mac.dev_status.request",
+				"ns.mac.link_check.answer",
+			},
+			InputMaxDownlinkLength:    42,
+			ExpectedMaxDownlinkLength: 38,
+			InputMaxUplinkLength:      24,
+			ExpectedMaxUplinkLength:   21,
+			Ok:                        true,
+		},
+		{
+			Name: "downlink too short",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					QueuedOperatorCommands: []*ttnpb.MACCommand{
+						linkCheckAns,
+						forceRejoinReq,
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					QueuedOperatorCommands: []*ttnpb.MACCommand{
+						forceRejoinReq,
+					},
+				},
+			},
+			ExpectedCommands: []*ttnpb.MACCommand{
+				linkCheckAns,
+			},
+			EventNames: []string{
+				"ns.mac.link_check.answer",
+			},
+			InputMaxDownlinkLength:    5,
+			ExpectedMaxDownlinkLength: 2,
+			InputMaxUplinkLength:      24,
+			ExpectedMaxUplinkLength:   24,
+			Ok:                        false,
+		},
+		{
+			Name: "uplink too short",
+			Device: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					QueuedOperatorCommands: []*ttnpb.MACCommand{
+						devStatusReq,
+					},
+				},
+			},
+			Expected: &ttnpb.EndDevice{
+				MACState: &ttnpb.MACState{
+					QueuedOperatorCommands: []*ttnpb.MACCommand{
+						devStatusReq,
+					},
+				},
+			},
+			InputMaxDownlinkLength:    42,
+			ExpectedMaxDownlinkLength: 42,
+			InputMaxUplinkLength:      2,
+			ExpectedMaxUplinkLength:   2,
+			Ok:                        false,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := deepcopy.Copy(tc.Device).(*ttnpb.EndDevice)
+
+			var cmds []*ttnpb.MACCommand
+			var maxDownLen, maxUpLen uint16
+			var ok bool
+			evs := test.CollectEvents(func() {
+				cmds, maxDownLen, maxUpLen, ok = enqueueOperatorMACCommands(test.Context(), dev, tc.InputMaxDownlinkLength, tc.InputMaxUplinkLength, nil)
+			})
+			a.So(dev, should.Resemble, tc.Expected)
+			a.So(cmds, should.Resemble, tc.ExpectedCommands)
+			a.So(maxDownLen, should.Equal, tc.ExpectedMaxDownlinkLength)
+			a.So(maxUpLen, should.Equal, tc.ExpectedMaxUplinkLength)
+			a.So(ok, should.Equal, tc.Ok)
+			if a.So(evs, should.HaveLength, len(tc.EventNames)) {
+				for i, name := range tc.EventNames {
+					a.So(evs[i].Name(), should.Equal, name)
+				}
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ package networkserver
 
 import (
 	"context"
+	"crypto/cipher"
 	"crypto/tls"
 	"hash/fnv"
 	"io"
@@ -25,11 +26,13 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -88,6 +91,10 @@ type DownlinkPriorities struct {
 // InteropClient is a client, which Network Server can use for interoperability.
 type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	RoamingNetID(types.DevAddr) (types.NetID, bool)
+	HasRoamingAgreement(types.NetID) bool
+	PRStartRequest(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // NetworkServer implements the Network Server component.
@@ -119,7 +126,13 @@ type NetworkServer struct {
 
 	defaultMACSettings ttnpb.MACSettings
 
-	interopClient InteropClient
+	adrAlgorithms     map[ttnpb.ADRAlgorithm]ADRAlgorithm
+	bandADRAlgorithms map[string]ttnpb.ADRAlgorithm
+
+	interopClient          InteropClient
+	roamingUplinkTokenAEAD cipher.AEAD
+
+	fairUse FairUsePolicy
 }
 
 // Option configures the NetworkServer.
@@ -148,6 +161,13 @@ func WithASUplinkHandler(f func(context.Context, ttnpb.ApplicationIdentifiers, *
 	}
 }
 
+// WithADRAlgorithm registers the ADR algorithm implementation for alg, overriding the built-in implementation, if any.
+func WithADRAlgorithm(alg ttnpb.ADRAlgorithm, impl ADRAlgorithm) Option {
+	return func(ns *NetworkServer) {
+		ns.adrAlgorithms[alg] = impl
+	}
+}
+
 // New returns new NetworkServer.
 func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer, error) {
 	devAddrPrefixes := conf.DevAddrPrefixes
@@ -167,6 +187,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if err != nil {
 		return nil, err
 	}
+	fairUse, err := conf.FairUse.Parse()
+	if err != nil {
+		return nil, err
+	}
 
 	ctx := log.NewContextWithField(c.Context(), "namespace", "networkserver")
 
@@ -186,6 +210,18 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		}
 	}
 
+	roamingUplinkTokenKey := conf.RoamingUplinkTokenKey
+	if len(roamingUplinkTokenKey) == 0 {
+		if interopCl != nil {
+			log.FromContext(ctx).Warn("No roaming uplink token key configured, using a random key")
+		}
+		roamingUplinkTokenKey = random.Bytes(32)
+	}
+	roamingUplinkTokenAEAD, err := newRoamingUplinkTokenAEAD(roamingUplinkTokenKey)
+	if err != nil {
+		return nil, errInvalidConfiguration.WithCause(err)
+	}
+
 	ns := &NetworkServer{
 		Component:               c,
 		ctx:                     ctx,
@@ -203,7 +239,24 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			ClassCTimeout:         conf.DefaultMACSettings.ClassCTimeout,
 			StatusTimePeriodicity: conf.DefaultMACSettings.StatusTimePeriodicity,
 		},
-		interopClient: interopCl,
+		interopClient:          interopCl,
+		roamingUplinkTokenAEAD: roamingUplinkTokenAEAD,
+		adrAlgorithms:          make(map[ttnpb.ADRAlgorithm]ADRAlgorithm, len(defaultADRAlgorithms)),
+		bandADRAlgorithms:      make(map[string]ttnpb.ADRAlgorithm, len(conf.BandADRAlgorithms)),
+		fairUse:                fairUse,
+	}
+	for alg, impl := range defaultADRAlgorithms {
+		ns.adrAlgorithms[alg] = impl
+	}
+	for bandID, s := range conf.BandADRAlgorithms {
+		if _, err := band.GetByID(bandID); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		var alg ttnpb.ADRAlgorithm
+		if err := alg.UnmarshalText([]byte(s)); err != nil {
+			return nil, errInvalidConfiguration.WithCause(err)
+		}
+		ns.bandADRAlgorithms[bandID] = alg
 	}
 	ns.hashPool.New = func() interface{} {
 		return fnv.New64a()
@@ -218,6 +271,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if conf.DefaultMACSettings.DesiredRx1Delay != nil {
 		ns.defaultMACSettings.DesiredRx1Delay = &ttnpb.MACSettings_RxDelayValue{Value: *conf.DefaultMACSettings.DesiredRx1Delay}
 	}
+	if conf.DefaultMACSettings.ADRAlgorithm != nil {
+		ns.defaultMACSettings.ADRAlgorithm = &ttnpb.MACSettings_ADRAlgorithmValue{Value: *conf.DefaultMACSettings.ADRAlgorithm}
+	}
 	if conf.DefaultMACSettings.StatusCountPeriodicity != nil {
 		ns.defaultMACSettings.StatusCountPeriodicity = &pbtypes.UInt32Value{Value: *conf.DefaultMACSettings.StatusCountPeriodicity}
 	}
@@ -269,9 +325,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	hooks.RegisterStreamHook("/ttn.lorawan.v3.AsNs", rpclog.NamespaceHook, rpclog.StreamNamespaceHook("networkserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsNs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("networkserver"))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Ns", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("networkserver"))
-	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsNs", cluster.HookName, c.ClusterAuthUnaryHook())
-	hooks.RegisterStreamHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthStreamHook())
-	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthUnaryHook())
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsNs", cluster.HookName, c.ClusterAuthUnaryHook(ttnpb.ClusterRole_GATEWAY_SERVER))
+	hooks.RegisterStreamHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthStreamHook(ttnpb.ClusterRole_APPLICATION_SERVER))
+	hooks.RegisterUnaryHook("/ttn.lorawan.v3.AsNs", cluster.HookName, c.ClusterAuthUnaryHook(ttnpb.ClusterRole_APPLICATION_SERVER))
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.Ns", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	ns.RegisterTask(ns.Context(), "process_downlink", func(ctx context.Context) error {
@@ -289,6 +345,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	}, component.TaskRestartOnFailure)
 
 	c.RegisterGRPC(ns)
+	c.RegisterInterop(ns)
 	return ns, nil
 }
 
@@ -311,6 +368,13 @@ func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Client
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
 }
 
+// RegisterInterop registers the NS-NS interop services for passive roaming.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	is := interopServer{NS: ns}
+	srv.RegisterSNS(is)
+	srv.RegisterFNS(is)
+}
+
 // Roles returns the roles that the Network Server fulfills.
 func (ns *NetworkServer) Roles() []ttnpb.ClusterRole {
 	return []ttnpb.ClusterRole{ttnpb.ClusterRole_NETWORK_SERVER}
@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/crypto"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -44,6 +45,7 @@ var (
 
 	ErrABPJoinRequest            = errABPJoinRequest
 	ErrDecodePayload             = errDecodePayload
+	ErrInvalidFieldValue         = errInvalidFieldValue
 	ErrUnsupportedLoRaWANVersion = errUnsupportedLoRaWANVersion
 
 	EvtBeginApplicationLink    = evtBeginApplicationLink
@@ -596,7 +598,11 @@ var _ InteropClient = MockInteropClient{}
 
 // MockInteropClient is a mock InteropClient used for testing.
 type MockInteropClient struct {
-	HandleJoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	HandleJoinRequestFunc   func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	RoamingNetIDFunc        func(types.DevAddr) (types.NetID, bool)
+	HasRoamingAgreementFunc func(types.NetID) bool
+	PRStartRequestFunc      func(context.Context, types.NetID, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequestFunc     func(context.Context, types.NetID, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // HandleJoinRequest calls HandleJoinRequestFunc if set and panics otherwise.
@@ -607,6 +613,38 @@ func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.Ne
 	return m.HandleJoinRequestFunc(ctx, netID, req)
 }
 
+// RoamingNetID calls RoamingNetIDFunc if set and panics otherwise.
+func (m MockInteropClient) RoamingNetID(devAddr types.DevAddr) (types.NetID, bool) {
+	if m.RoamingNetIDFunc == nil {
+		panic("RoamingNetID called, but not set")
+	}
+	return m.RoamingNetIDFunc(devAddr)
+}
+
+// HasRoamingAgreement calls HasRoamingAgreementFunc if set and panics otherwise.
+func (m MockInteropClient) HasRoamingAgreement(netID types.NetID) bool {
+	if m.HasRoamingAgreementFunc == nil {
+		panic("HasRoamingAgreement called, but not set")
+	}
+	return m.HasRoamingAgreementFunc(netID)
+}
+
+// PRStartRequest calls PRStartRequestFunc if set and panics otherwise.
+func (m MockInteropClient) PRStartRequest(ctx context.Context, netID types.NetID, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc == nil {
+		panic("PRStartRequest called, but not set")
+	}
+	return m.PRStartRequestFunc(ctx, netID, req)
+}
+
+// XmitDataRequest calls XmitDataRequestFunc if set and panics otherwise.
+func (m MockInteropClient) XmitDataRequest(ctx context.Context, netID types.NetID, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc == nil {
+		panic("XmitDataRequest called, but not set")
+	}
+	return m.XmitDataRequestFunc(ctx, netID, req)
+}
+
 type InteropClientHandleJoinRequestResponse struct {
 	Response *ttnpb.JoinResponse
 	Error    error
@@ -1039,6 +1077,12 @@ func newMockInteropClient() (InteropClient, InteropClientEnvironment, func()) {
 	handleJoinCh := make(chan InteropClientHandleJoinRequestRequest)
 	return &MockInteropClient{
 			HandleJoinRequestFunc: MakeInteropClientHandleJoinRequestChFunc(handleJoinCh),
+			RoamingNetIDFunc: func(types.DevAddr) (types.NetID, bool) {
+				return types.NetID{}, false
+			},
+			HasRoamingAgreementFunc: func(types.NetID) bool {
+				return false
+			},
 		}, InteropClientEnvironment{
 			HandleJoinRequest: handleJoinCh,
 		},
@@ -128,6 +128,10 @@ var (
 		"ns.up.rejoin.forward", "forward rejoin-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtExpireDownlink = events.Define(
+		"ns.down.data.expire", "drop expired application downlink",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtEnqueueProprietaryMACAnswer  = defineEnqueueMACAnswerEvent("proprietary", "proprietary MAC command")
 	evtEnqueueProprietaryMACRequest = defineEnqueueMACRequestEvent("proprietary", "proprietary MAC command")
 	evtReceiveProprietaryMAC        = events.Define(
@@ -167,6 +171,14 @@ var nsMetrics = &messageMetrics{
 		},
 		[]string{messageType},
 	),
+	uplinkRoamed: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_roamed_total",
+			Help:      "Total number of uplinks forwarded to home Network Servers",
+		},
+		[]string{messageType},
+	),
 	uplinkDropped: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -194,6 +206,7 @@ type messageMetrics struct {
 	uplinkReceived       *metrics.ContextualCounterVec
 	uplinkUniqueReceived *metrics.ContextualCounterVec
 	uplinkForwarded      *metrics.ContextualCounterVec
+	uplinkRoamed         *metrics.ContextualCounterVec
 	uplinkDropped        *metrics.ContextualCounterVec
 	uplinkGateways       *metrics.ContextualHistogramVec
 }
@@ -202,6 +215,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkReceived.Describe(ch)
 	m.uplinkUniqueReceived.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
+	m.uplinkRoamed.Describe(ch)
 	m.uplinkDropped.Describe(ch)
 	m.uplinkGateways.Describe(ch)
 }
@@ -210,6 +224,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkReceived.Collect(ch)
 	m.uplinkUniqueReceived.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
+	m.uplinkRoamed.Collect(ch)
 	m.uplinkDropped.Collect(ch)
 	m.uplinkGateways.Collect(ch)
 }
@@ -239,6 +254,10 @@ func registerForwardDataUplink(ctx context.Context, msg *ttnpb.UplinkMessage) {
 	nsMetrics.uplinkForwarded.WithLabelValues(ctx, uplinkMTypeLabel(msg)).Inc()
 }
 
+func registerRoamDataUplink(ctx context.Context, msg *ttnpb.UplinkMessage) {
+	nsMetrics.uplinkRoamed.WithLabelValues(ctx, uplinkMTypeLabel(msg)).Inc()
+}
+
 func registerForwardJoinRequest(ctx context.Context, msg *ttnpb.UplinkMessage) {
 	nsMetrics.uplinkForwarded.WithLabelValues(ctx, uplinkMTypeLabel(msg)).Inc()
 }
@@ -51,20 +51,22 @@ func applyDeviceFieldMask(dst, src *ttnpb.EndDevice, paths ...string) (*ttnpb.En
 }
 
 // DeviceRegistry is an implementation of networkserver.DeviceRegistry.
+// The devices and the EUI and DevAddr indexes are updated in the same transaction, so all keys of the
+// registry are in the same hash slot with Redis Cluster.
 type DeviceRegistry struct {
 	Redis *ttnredis.Client
 }
 
 func (r *DeviceRegistry) uidKey(uid string) string {
-	return r.Redis.Key("uid", uid)
+	return r.Redis.SlotKey("uid", uid)
 }
 
 func (r *DeviceRegistry) addrKey(addr types.DevAddr) string {
-	return r.Redis.Key("addr", addr.String())
+	return r.Redis.SlotKey("addr", addr.String())
 }
 
 func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
-	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
+	return r.Redis.SlotKey("eui", joinEUI.String(), devEUI.String())
 }
 
 // GetByID gets device by appID, devID.
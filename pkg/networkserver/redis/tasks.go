@@ -39,7 +39,7 @@ func NewDownlinkTaskQueue(cl *ttnredis.Client, maxLen int64, group, id string) *
 		MaxLen: maxLen,
 		Group:  group,
 		ID:     id,
-		Key:    cl.Key(downlinkKey),
+		Key:    cl.Key(cl.HashTag(downlinkKey)),
 	}}
 }
 
@@ -0,0 +1,389 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// rfRegions maps band IDs to the RF region names used in LoRaWAN Backend Interfaces.
+var rfRegions = map[string]string{
+	band.AS_923:     "AS923",
+	band.AU_915_928: "Australia915",
+	band.CN_470_510: "China470",
+	band.CN_779_787: "China779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "India865",
+	band.KR_920_923: "SouthKorea920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US902",
+}
+
+// bandIDFromRFRegion returns the band ID corresponding to the given RF region name.
+func bandIDFromRFRegion(rfRegion string) (string, bool) {
+	for id, name := range rfRegions {
+		if name == rfRegion {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// roamingGatewayIDPrefix is the prefix of the gateway IDs used in the metadata of uplink messages received
+// through passive roaming. The remainder of the gateway ID is the NetID of the forwarding Network Server.
+// The Identity Server does not allow registering gateways with this prefix.
+const roamingGatewayIDPrefix = "roaming-"
+
+// forwardingNetIDField is the advanced metadata field that holds the NetID of the forwarding Network Server.
+// This field is only set by the passive roaming start request handler; it is removed from uplink messages
+// received from the Gateway Server.
+const forwardingNetIDField = "forwarding_net_id"
+
+// roamingGatewayIdentifiers returns the gateway identifiers which represent gateways of the forwarding Network Server
+// identified by netID.
+func roamingGatewayIdentifiers(netID types.NetID) ttnpb.GatewayIdentifiers {
+	return ttnpb.GatewayIdentifiers{
+		GatewayID: roamingGatewayIDPrefix + strings.ToLower(netID.String()),
+	}
+}
+
+// forwardingNetID returns the NetID of the forwarding Network Server, if md is the metadata of an uplink message
+// received through passive roaming.
+func forwardingNetID(md *ttnpb.RxMetadata) (types.NetID, bool) {
+	v, ok := md.GetAdvanced().GetFields()[forwardingNetIDField]
+	if !ok {
+		return types.NetID{}, false
+	}
+	var netID types.NetID
+	if err := netID.UnmarshalText([]byte(v.GetStringValue())); err != nil {
+		return types.NetID{}, false
+	}
+	return netID, true
+}
+
+// clearForwardingNetIDs removes the forwarding Network Server NetID from mds, so that uplink messages received from
+// the Gateway Server can not be used to schedule downlink messages through a forwarding Network Server.
+func clearForwardingNetIDs(mds ...*ttnpb.RxMetadata) {
+	for _, md := range mds {
+		if md.GetAdvanced() != nil {
+			delete(md.Advanced.Fields, forwardingNetIDField)
+		}
+	}
+}
+
+// hasDevAddrPrefix returns whether addr matches any of the DevAddr prefixes of ns.
+func (ns *NetworkServer) hasDevAddrPrefix(addr types.DevAddr) bool {
+	for _, prefix := range ns.devAddrPrefixes {
+		if addr.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// newRoamingUplinkTokenAEAD returns the AEAD used to encrypt and authenticate roaming uplink tokens with key.
+func newRoamingUplinkTokenAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// marshalRoamingUplinkToken returns the ULToken, which is used by the serving Network Server to schedule
+// downlink messages through the gateway that received the uplink message with metadata md.
+// The ULToken is encrypted and authenticated with aead, and can only be used by the Network Server identified by
+// homeNetID.
+func marshalRoamingUplinkToken(aead cipher.AEAD, homeNetID types.NetID, md *ttnpb.RxMetadata) ([]byte, error) {
+	b, err := (&ttnpb.RxMetadata{
+		GatewayIdentifiers:     md.GatewayIdentifiers,
+		UplinkToken:            md.UplinkToken,
+		DownlinkPathConstraint: md.DownlinkPathConstraint,
+	}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+	nonce := random.Bytes(aead.NonceSize())
+	return aead.Seal(nonce, nonce, b, homeNetID[:]), nil
+}
+
+// unmarshalRoamingUplinkToken returns the metadata encoded in the ULToken by marshalRoamingUplinkToken.
+// The ULToken must be sent by the Network Server identified by homeNetID.
+func unmarshalRoamingUplinkToken(aead cipher.AEAD, homeNetID types.NetID, b []byte) (*ttnpb.RxMetadata, error) {
+	if len(b) < aead.NonceSize() {
+		return nil, errInvalidRoamingUplinkToken
+	}
+	b, err := aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], homeNetID[:])
+	if err != nil {
+		return nil, errInvalidRoamingUplinkToken.WithCause(err)
+	}
+	md := &ttnpb.RxMetadata{}
+	if err := md.Unmarshal(b); err != nil {
+		return nil, errInvalidRoamingUplinkToken.WithCause(err)
+	}
+	return md, nil
+}
+
+// newPRStartRequest returns the passive roaming start request for the deduplicated uplink message up, which is sent
+// to the home Network Server identified by homeNetID. The ULTokens are encrypted and authenticated with aead.
+func newPRStartRequest(up *ttnpb.UplinkMessage, fps *frequencyplans.Store, aead cipher.AEAD, homeNetID types.NetID) (*interop.PRStartReq, error) {
+	pld := up.Payload.GetMACPayload()
+	devAddr := interop.DevAddr(pld.DevAddr)
+	dataRate := uint32(up.Settings.DataRateIndex)
+	ulFreq := float64(up.Settings.Frequency) / 1e6
+
+	md := interop.ULMetaData{
+		DevAddr:   &devAddr,
+		Confirmed: up.Payload.MType == ttnpb.MType_CONFIRMED_UP,
+		DataRate:  &dataRate,
+		ULFreq:    &ulFreq,
+		RecvTime:  up.ReceivedAt,
+		GWCnt:     len(up.RxMetadata),
+	}
+	for _, rxMD := range up.RxMetadata {
+		var rfRegion string
+		if fp, err := fps.GetByID(rxMD.FrequencyPlanID); err == nil {
+			rfRegion = rfRegions[fp.BandID]
+		}
+		if md.RFRegion == "" {
+			md.RFRegion = rfRegion
+		}
+		ulToken, err := marshalRoamingUplinkToken(aead, homeNetID, rxMD)
+		if err != nil {
+			return nil, err
+		}
+		rssi := int32(rxMD.RSSI)
+		snr := rxMD.SNR
+		gwInfo := interop.GWInfoElement{
+			ID:        interop.Buffer(rxMD.GatewayID),
+			RFRegion:  rfRegion,
+			ULToken:   ulToken,
+			DLAllowed: len(rxMD.UplinkToken) > 0 && rxMD.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+			RSSI:      &rssi,
+			SNR:       &snr,
+		}
+		if loc := rxMD.Location; loc != nil {
+			lat, lon := loc.Latitude, loc.Longitude
+			gwInfo.Lat, gwInfo.Lon = &lat, &lon
+		}
+		md.GWInfo = append(md.GWInfo, gwInfo)
+	}
+	return &interop.PRStartReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			ReceiverID: interop.NetID(homeNetID),
+		},
+		PHYPayload: up.RawPayload,
+		ULMetaData: md,
+	}, nil
+}
+
+// uplinkFromPRStartRequest returns the uplink message carried by the passive roaming start request req.
+func uplinkFromPRStartRequest(req *interop.PRStartReq) (*ttnpb.UplinkMessage, error) {
+	md := req.ULMetaData
+	if md.DataRate == nil || md.ULFreq == nil || len(md.GWInfo) == 0 {
+		return nil, errInvalidRoamingMetadata
+	}
+	bandID, ok := bandIDFromRFRegion(md.RFRegion)
+	if !ok {
+		return nil, errUnknownRFRegion.WithAttributes("rf_region", md.RFRegion)
+	}
+	phy, err := band.GetByID(bandID)
+	if err != nil {
+		return nil, err
+	}
+	if *md.DataRate >= uint32(len(phy.DataRates)) || phy.DataRates[*md.DataRate].Rate.Modulation == nil {
+		return nil, errDataRateNotFound
+	}
+
+	receivedAt := md.RecvTime.UTC()
+	if now := time.Now().UTC(); receivedAt.IsZero() || receivedAt.After(now) {
+		receivedAt = now
+	}
+	up := &ttnpb.UplinkMessage{
+		RawPayload: req.PHYPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      phy.DataRates[*md.DataRate].Rate,
+			DataRateIndex: ttnpb.DataRateIndex(*md.DataRate),
+			Frequency:     uint64(*md.ULFreq*1e6 + 0.5),
+		},
+		ReceivedAt: receivedAt,
+	}
+	gtwIDs := roamingGatewayIdentifiers(types.NetID(req.SenderID))
+	for _, gwInfo := range md.GWInfo {
+		rxMD := &ttnpb.RxMetadata{
+			GatewayIdentifiers: gtwIDs,
+			UplinkToken:        gwInfo.ULToken,
+			Advanced: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					forwardingNetIDField: {
+						Kind: &pbtypes.Value_StringValue{
+							StringValue: types.NetID(req.SenderID).String(),
+						},
+					},
+				},
+			},
+		}
+		if !gwInfo.DLAllowed || len(gwInfo.ULToken) == 0 {
+			rxMD.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
+		}
+		if gwInfo.RSSI != nil {
+			rxMD.RSSI = float32(*gwInfo.RSSI)
+			rxMD.ChannelRSSI = float32(*gwInfo.RSSI)
+		}
+		if gwInfo.SNR != nil {
+			rxMD.SNR = *gwInfo.SNR
+		}
+		if gwInfo.Lat != nil && gwInfo.Lon != nil {
+			rxMD.Location = &ttnpb.Location{
+				Latitude:  *gwInfo.Lat,
+				Longitude: *gwInfo.Lon,
+			}
+		}
+		up.RxMetadata = append(up.RxMetadata, rxMD)
+	}
+	return up, nil
+}
+
+// forwardDataUplink forwards the data uplink message up to the home Network Server identified by netID
+// once deduplication is done.
+func (ns *NetworkServer) forwardDataUplink(ctx context.Context, up *ttnpb.UplinkMessage, acc *metadataAccumulator, netID types.NetID) (err error) {
+	logger := log.FromContext(ctx).WithField("home_net_id", netID)
+	ctx = log.NewContext(ctx, logger)
+	defer func() {
+		if err != nil {
+			registerDropDataUplink(ctx, up, err)
+			logger.WithError(err).Debug("Failed to forward uplink to home Network Server")
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-ns.deduplicationDone(ctx, up):
+	}
+	up.RxMetadata = acc.Accumulated()
+	registerMergeMetadata(ctx, up)
+
+	req, err := newPRStartRequest(up, ns.FrequencyPlans, ns.roamingUplinkTokenAEAD, netID)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Forward uplink to home Network Server")
+	if _, err := ns.interopClient.PRStartRequest(ctx, ns.netID, req); err != nil {
+		return err
+	}
+	registerRoamDataUplink(ctx, up)
+	return nil
+}
+
+// newRoamingXmitDataRequest returns the transmit data request, which schedules the downlink message down through
+// the forwarding Network Server using the downlink paths of the request.
+func newRoamingXmitDataRequest(down *ttnpb.DownlinkMessage) *interop.XmitDataReq {
+	req := down.GetRequest()
+	md := &interop.DLMetaData{
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	switch req.Class {
+	case ttnpb.CLASS_A:
+		md.ClassMode = "A"
+		rxDelay := uint32(req.Rx1Delay)
+		md.RXDelay1 = &rxDelay
+		if req.Rx1Frequency != 0 {
+			freq, dataRate := float64(req.Rx1Frequency)/1e6, uint32(req.Rx1DataRateIndex)
+			md.DLFreq1, md.DataRate1 = &freq, &dataRate
+		}
+	case ttnpb.CLASS_B:
+		md.ClassMode = "B"
+	case ttnpb.CLASS_C:
+		md.ClassMode = "C"
+	}
+	if req.Rx2Frequency != 0 {
+		freq, dataRate := float64(req.Rx2Frequency)/1e6, uint32(req.Rx2DataRateIndex)
+		md.DLFreq2, md.DataRate2 = &freq, &dataRate
+	}
+	for _, path := range req.DownlinkPaths {
+		md.GWInfo = append(md.GWInfo, interop.GWInfoElement{
+			ULToken: path.GetUplinkToken(),
+		})
+	}
+	return &interop.XmitDataReq{
+		PHYPayload: down.RawPayload,
+		DLMetaData: md,
+	}
+}
+
+// txRequestFromXmitDataRequest returns the TxRequest and the downlink paths for the transmit data request req
+// received by the forwarding Network Server. The ULTokens are decrypted and authenticated with aead.
+func txRequestFromXmitDataRequest(req *interop.XmitDataReq, aead cipher.AEAD) (*ttnpb.TxRequest, []downlinkPath, error) {
+	md := req.DLMetaData
+	if md == nil {
+		return nil, nil, errInvalidRoamingMetadata
+	}
+	txReq := &ttnpb.TxRequest{
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if md.HiPriorityFlag {
+		txReq.Priority = ttnpb.TxSchedulePriority_HIGH
+	}
+	switch md.ClassMode {
+	case "A", "":
+		txReq.Class = ttnpb.CLASS_A
+	case "B":
+		txReq.Class = ttnpb.CLASS_B
+	case "C":
+		txReq.Class = ttnpb.CLASS_C
+	default:
+		return nil, nil, errInvalidRoamingMetadata
+	}
+	if md.RXDelay1 != nil {
+		txReq.Rx1Delay = ttnpb.RxDelay(*md.RXDelay1)
+	}
+	if md.DLFreq1 != nil && md.DataRate1 != nil {
+		txReq.Rx1Frequency = uint64(*md.DLFreq1*1e6 + 0.5)
+		txReq.Rx1DataRateIndex = ttnpb.DataRateIndex(*md.DataRate1)
+	}
+	if md.DLFreq2 != nil && md.DataRate2 != nil {
+		txReq.Rx2Frequency = uint64(*md.DLFreq2*1e6 + 0.5)
+		txReq.Rx2DataRateIndex = ttnpb.DataRateIndex(*md.DataRate2)
+	}
+	if txReq.Rx1Frequency == 0 && txReq.Rx2Frequency == 0 {
+		return nil, nil, errInvalidRoamingMetadata
+	}
+
+	mds := make([]*ttnpb.RxMetadata, 0, len(md.GWInfo))
+	for _, gwInfo := range md.GWInfo {
+		rxMD, err := unmarshalRoamingUplinkToken(aead, types.NetID(req.SenderID), gwInfo.ULToken)
+		if err != nil {
+			return nil, nil, errInvalidRoamingMetadata.WithCause(err)
+		}
+		mds = append(mds, rxMD)
+	}
+	return txReq, downlinkPathsFromMetadata(mds...), nil
+}
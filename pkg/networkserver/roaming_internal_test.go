@@ -0,0 +1,274 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"crypto/cipher"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/interop"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRoamingGatewayIdentifiers(t *testing.T) {
+	a := assertions.New(t)
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	ids := roamingGatewayIdentifiers(netID)
+	a.So(ids.GatewayID, should.Equal, "roaming-000013")
+
+	// Gateway IDs do not mark downlink paths through a forwarding Network Server.
+	_, ok := forwardingNetID(&ttnpb.RxMetadata{GatewayIdentifiers: ids})
+	a.So(ok, should.BeFalse)
+}
+
+func TestForwardingNetID(t *testing.T) {
+	a := assertions.New(t)
+
+	newMetadata := func(netID string) *ttnpb.RxMetadata {
+		return &ttnpb.RxMetadata{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"},
+			UplinkToken:        []byte("token"),
+			Advanced: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					forwardingNetIDField: {
+						Kind: &pbtypes.Value_StringValue{StringValue: netID},
+					},
+				},
+			},
+		}
+	}
+
+	md := newMetadata("000013")
+	netID, ok := forwardingNetID(md)
+	a.So(ok, should.BeTrue)
+	a.So(netID, should.Equal, types.NetID{0x00, 0x00, 0x13})
+	if paths := downlinkPathsFromMetadata(md); a.So(paths, should.HaveLength, 1) && a.So(paths[0].forwardingNetID, should.NotBeNil) {
+		a.So(*paths[0].forwardingNetID, should.Equal, types.NetID{0x00, 0x00, 0x13})
+	}
+
+	_, ok = forwardingNetID(newMetadata("gtw"))
+	a.So(ok, should.BeFalse)
+	_, ok = forwardingNetID(&ttnpb.RxMetadata{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"}})
+	a.So(ok, should.BeFalse)
+
+	// Metadata received from the Gateway Server can not mark paths through a forwarding Network Server.
+	clearForwardingNetIDs(md)
+	_, ok = forwardingNetID(md)
+	a.So(ok, should.BeFalse)
+	if paths := downlinkPathsFromMetadata(md); a.So(paths, should.HaveLength, 1) {
+		a.So(paths[0].forwardingNetID, should.BeNil)
+	}
+}
+
+func newTestRoamingUplinkTokenAEAD(t *testing.T) cipher.AEAD {
+	aead, err := newRoamingUplinkTokenAEAD([]byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f})
+	if err != nil {
+		t.Fatalf("Failed to create AEAD: %v", err)
+	}
+	return aead
+}
+
+func TestPRStartRequest(t *testing.T) {
+	a := assertions.New(t)
+
+	aead := newTestRoamingUplinkTokenAEAD(t)
+	homeNetID := types.NetID{0x00, 0x00, 0x42}
+
+	receivedAt := time.Unix(1000, 0).UTC()
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x42, 0xff, 0xff, 0x42, 0x00, 0x01, 0x00, 0x01, 0x02, 0x03, 0x04},
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_CONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: types.DevAddr{0x42, 0xff, 0xff, 0x42},
+						FCnt:    1,
+					},
+				},
+			},
+		},
+		Settings: ttnpb.TxSettings{
+			DataRate:      band.All[band.EU_863_870].DataRates[5].Rate,
+			DataRateIndex: ttnpb.DATA_RATE_5,
+			Frequency:     868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+				UplinkToken:        []byte("token-1"),
+				FrequencyPlanID:    test.EUFrequencyPlanID,
+				RSSI:               -42,
+				SNR:                7.5,
+				Location: &ttnpb.Location{
+					Latitude:  52.37,
+					Longitude: 4.89,
+				},
+			},
+			{
+				GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-2"},
+				UplinkToken:            []byte("token-2"),
+				DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+				FrequencyPlanID:        test.EUFrequencyPlanID,
+				RSSI:                   -100,
+				SNR:                    -2,
+			},
+		},
+		ReceivedAt: receivedAt,
+	}
+
+	req, err := newPRStartRequest(up, frequencyplans.NewStore(test.FrequencyPlansFetcher), aead, homeNetID)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(req.ReceiverID, should.Equal, interop.NetID(homeNetID))
+	a.So(req.PHYPayload, should.Resemble, interop.Buffer(up.RawPayload))
+	a.So(req.ULMetaData.RFRegion, should.Equal, "EU868")
+	a.So(*req.ULMetaData.DevAddr, should.Equal, interop.DevAddr{0x42, 0xff, 0xff, 0x42})
+	a.So(*req.ULMetaData.DataRate, should.Equal, 5)
+	a.So(*req.ULMetaData.ULFreq, should.Equal, 868.1)
+	a.So(req.ULMetaData.Confirmed, should.BeTrue)
+	a.So(req.ULMetaData.GWCnt, should.Equal, 2)
+	if !a.So(req.ULMetaData.GWInfo, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(req.ULMetaData.GWInfo[0].DLAllowed, should.BeTrue)
+	a.So(req.ULMetaData.GWInfo[1].DLAllowed, should.BeFalse)
+
+	req.SenderID = interop.NetID{0x00, 0x00, 0x13}
+	res, err := uplinkFromPRStartRequest(req)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.RawPayload, should.Resemble, up.RawPayload)
+	a.So(res.Settings, should.Resemble, up.Settings)
+	a.So(res.ReceivedAt, should.Equal, receivedAt)
+	if !a.So(res.RxMetadata, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, md := range res.RxMetadata {
+		netID, ok := forwardingNetID(md)
+		a.So(ok, should.BeTrue)
+		a.So(netID, should.Equal, types.NetID{0x00, 0x00, 0x13})
+	}
+	a.So(res.RxMetadata[0].RSSI, should.Equal, -42)
+	a.So(res.RxMetadata[0].SNR, should.Equal, 7.5)
+	a.So(res.RxMetadata[0].Location, should.Resemble, up.RxMetadata[0].Location)
+	a.So(res.RxMetadata[0].DownlinkPathConstraint, should.Equal, ttnpb.DOWNLINK_PATH_CONSTRAINT_NONE)
+	a.So(res.RxMetadata[1].DownlinkPathConstraint, should.Equal, ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER)
+
+	a.So(res.RxMetadata[0].UplinkToken, should.NotContain, []byte("token-1"))
+	md, err := unmarshalRoamingUplinkToken(aead, homeNetID, res.RxMetadata[0].UplinkToken)
+	if a.So(err, should.BeNil) {
+		a.So(md.GatewayIdentifiers, should.Resemble, up.RxMetadata[0].GatewayIdentifiers)
+		a.So(md.UplinkToken, should.Resemble, up.RxMetadata[0].UplinkToken)
+	}
+	// The ULToken can only be used by the home Network Server.
+	_, err = unmarshalRoamingUplinkToken(aead, types.NetID{0x00, 0x00, 0x13}, res.RxMetadata[0].UplinkToken)
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidRoamingUplinkToken)
+
+	req.ULMetaData.RFRegion = "Unknown"
+	_, err = uplinkFromPRStartRequest(req)
+	a.So(err, should.HaveSameErrorDefinitionAs, errUnknownRFRegion)
+}
+
+func TestXmitDataRequest(t *testing.T) {
+	a := assertions.New(t)
+
+	aead := newTestRoamingUplinkTokenAEAD(t)
+	homeNetID := types.NetID{0x00, 0x00, 0x42}
+
+	ulToken, err := marshalRoamingUplinkToken(aead, homeNetID, &ttnpb.RxMetadata{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"},
+		UplinkToken:        []byte("token-1"),
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	txReq := &ttnpb.TxRequest{
+		Class: ttnpb.CLASS_A,
+		DownlinkPaths: []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_UplinkToken{
+					UplinkToken: ulToken,
+				},
+			},
+		},
+		Rx1Delay:         ttnpb.RX_DELAY_1,
+		Rx1DataRateIndex: ttnpb.DATA_RATE_5,
+		Rx1Frequency:     868100000,
+		Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+		Rx2Frequency:     869525000,
+		Priority:         ttnpb.TxSchedulePriority_HIGHEST,
+	}
+	req := newRoamingXmitDataRequest(&ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60, 0x42, 0xff, 0xff, 0x42},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: txReq,
+		},
+	})
+	if !a.So(req.DLMetaData, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(req.DLMetaData.ClassMode, should.Equal, "A")
+	a.So(*req.DLMetaData.DLFreq1, should.Equal, 868.1)
+	a.So(*req.DLMetaData.DLFreq2, should.Equal, 869.525)
+	a.So(*req.DLMetaData.RXDelay1, should.Equal, 1)
+	a.So(req.DLMetaData.HiPriorityFlag, should.BeTrue)
+
+	req.SenderID = interop.NetID(homeNetID)
+	res, paths, err := txRequestFromXmitDataRequest(req, aead)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.Class, should.Equal, ttnpb.CLASS_A)
+	a.So(res.Rx1Delay, should.Equal, ttnpb.RX_DELAY_1)
+	a.So(res.Rx1DataRateIndex, should.Equal, ttnpb.DATA_RATE_5)
+	a.So(res.Rx1Frequency, should.Equal, 868100000)
+	a.So(res.Rx2DataRateIndex, should.Equal, ttnpb.DATA_RATE_0)
+	a.So(res.Rx2Frequency, should.Equal, 869525000)
+	a.So(res.Priority, should.Equal, ttnpb.TxSchedulePriority_HIGH)
+	if a.So(paths, should.HaveLength, 1) {
+		a.So(paths[0].GatewayIdentifiers, should.Resemble, ttnpb.GatewayIdentifiers{GatewayID: "gateway-1"})
+		a.So(paths[0].GetUplinkToken(), should.Resemble, []byte("token-1"))
+	}
+
+	// ULTokens of other Network Servers and tampered ULTokens are rejected.
+	req.SenderID = interop.NetID{0x00, 0x00, 0x13}
+	_, _, err = txRequestFromXmitDataRequest(req, aead)
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidRoamingMetadata)
+	req.SenderID = interop.NetID(homeNetID)
+	ulToken[len(ulToken)-1] ^= 0xff
+	_, _, err = txRequestFromXmitDataRequest(req, aead)
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidRoamingMetadata)
+	ulToken[len(ulToken)-1] ^= 0xff
+
+	req.DLMetaData.ClassMode = "D"
+	_, _, err = txRequestFromXmitDataRequest(req, aead)
+	a.So(err, should.HaveSameErrorDefinitionAs, errInvalidRoamingMetadata)
+}
@@ -272,7 +272,14 @@ func newMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb
 		}
 	}
 
-	macState.DesiredParameters.Channels = make([]*ttnpb.MACParameters_Channel, 0, len(phy.UplinkChannels)+len(fp.UplinkChannels))
+	macState.DesiredParameters.Channels = desiredChannels(fp, phy)
+
+	return macState, nil
+}
+
+// desiredChannels returns the channels a device operating in phy should use according to fp.
+func desiredChannels(fp *frequencyplans.FrequencyPlan, phy band.Band) []*ttnpb.MACParameters_Channel {
+	chs := make([]*ttnpb.MACParameters_Channel, 0, len(phy.UplinkChannels)+len(fp.UplinkChannels))
 	for i, upCh := range phy.UplinkChannels {
 		channel := &ttnpb.MACParameters_Channel{
 			MinDataRateIndex: upCh.MinDataRate,
@@ -280,12 +287,12 @@ func newMACState(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb
 			UplinkFrequency:  upCh.Frequency,
 		}
 		channel.DownlinkFrequency = phy.DownlinkChannels[i%len(phy.DownlinkChannels)].Frequency
-		macState.DesiredParameters.Channels = append(macState.DesiredParameters.Channels, channel)
+		chs = append(chs, channel)
 	}
 
 outerUp:
 	for _, upCh := range fp.UplinkChannels {
-		for _, ch := range macState.DesiredParameters.Channels {
+		for _, ch := range chs {
 			if ch.UplinkFrequency == upCh.Frequency {
 				ch.MinDataRateIndex = ttnpb.DataRateIndex(upCh.MinDataRate)
 				ch.MaxDataRateIndex = ttnpb.DataRateIndex(upCh.MaxDataRate)
@@ -294,7 +301,7 @@ outerUp:
 			}
 		}
 
-		macState.DesiredParameters.Channels = append(macState.DesiredParameters.Channels, &ttnpb.MACParameters_Channel{
+		chs = append(chs, &ttnpb.MACParameters_Channel{
 			MinDataRateIndex: ttnpb.DataRateIndex(upCh.MinDataRate),
 			MaxDataRateIndex: ttnpb.DataRateIndex(upCh.MaxDataRate),
 			UplinkFrequency:  upCh.Frequency,
@@ -303,13 +310,12 @@ outerUp:
 	}
 
 	if len(fp.DownlinkChannels) > 0 {
-		for i, ch := range macState.DesiredParameters.Channels {
+		for i, ch := range chs {
 			downCh := fp.DownlinkChannels[i%len(fp.DownlinkChannels)]
 			if downCh.Frequency != 0 {
 				ch.DownlinkFrequency = downCh.Frequency
 			}
 		}
 	}
-
-	return macState, nil
+	return chs
 }
@@ -0,0 +1,453 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/web/cookie"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// FederationProviderConfig is the configuration of an external OpenID Connect identity provider.
+type FederationProviderConfig struct {
+	Name               string            `name:"name" description:"Display name of the identity provider"`
+	Issuer             string            `name:"issuer" description:"Issuer URL of the identity provider"`
+	ClientID           string            `name:"client-id" description:"OAuth client ID registered at the identity provider"`
+	ClientSecret       string            `name:"client-secret" description:"OAuth client secret registered at the identity provider"`
+	Scopes             []string          `name:"scopes" description:"Additional scopes to request from the identity provider"`
+	AutoProvision      bool              `name:"auto-provision" description:"Create users that do not exist yet"`
+	GroupsClaim        string            `name:"groups-claim" description:"ID token claim that contains the groups of the user"`
+	GroupOrganizations map[string]string `name:"group-organizations" description:"Organization IDs by group of the identity provider"`
+	OrganizationRights []string          `name:"organization-rights" description:"Rights of organization memberships added for groups (default RIGHT_ORGANIZATION_INFO)"`
+}
+
+// federationProvider is the information about an identity provider that is exposed to the frontend.
+type federationProvider struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (s *server) federationProviders() []federationProvider {
+	providers := make([]federationProvider, 0, len(s.config.Federation))
+	for id, config := range s.config.Federation {
+		name := config.Name
+		if name == "" {
+			name = id
+		}
+		providers = append(providers, federationProvider{ID: id, Name: name})
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i].ID < providers[j].ID })
+	return providers
+}
+
+var federationHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+var (
+	errFederationProviderNotFound      = errors.DefineNotFound("federation_provider_not_found", "identity provider `{provider}` not found")
+	errFederationRequest               = errors.DefineUnavailable("federation_request", "request to identity provider `{provider}` failed")
+	errFederationResponse              = errors.DefineUnavailable("federation_response", "identity provider `{provider}` responded with status `{status}`")
+	errFederationState                 = errors.DefinePermissionDenied("federation_state", "invalid or expired federated login state")
+	errFederationDenied                = errors.DefinePermissionDenied("federation_denied", "identity provider denied login with `{error}`")
+	errFederationIDToken               = errors.DefinePermissionDenied("federation_id_token", "invalid ID token from identity provider `{provider}`")
+	errFederationEmailNotVerified      = errors.DefinePermissionDenied("federation_email_not_verified", "email address not verified by identity provider `{provider}`")
+	errFederationUserNotFound          = errors.DefinePermissionDenied("federation_user_not_found", "no user with email address `{email}`")
+	errFederationUserEmailNotValidated = errors.DefinePermissionDenied("federation_user_email_not_validated", "email address `{email}` of user not validated")
+	errFederationUserID                = errors.DefineInvalidArgument("federation_user_id", "could not derive user ID for `{email}`")
+	errUnknownRight                    = errors.DefineInvalidArgument("unknown_right", "unknown right `{right}`")
+)
+
+// providerDiscovery is the part of the OpenID Connect discovery document of an identity provider that is used.
+type providerDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func getFederationJSON(ctx context.Context, provider string, req *http.Request, v interface{}) error {
+	res, err := federationHTTPClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return errFederationRequest.WithAttributes("provider", provider).WithCause(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return errFederationResponse.WithAttributes("provider", provider, "status", res.Status)
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return errFederationRequest.WithAttributes("provider", provider).WithCause(err)
+	}
+	return nil
+}
+
+func discoverProvider(ctx context.Context, id string, config FederationProviderConfig) (*providerDiscovery, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(config.Issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var discovery providerDiscovery
+	if err := getFederationJSON(ctx, id, req, &discovery); err != nil {
+		return nil, err
+	}
+	return &discovery, nil
+}
+
+const federationCookieName = "_federation"
+
+func (s *server) federationCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     federationCookieName,
+		Path:     s.config.UI.MountPath(),
+		HTTPOnly: true,
+	}
+}
+
+type federationCookie struct {
+	Provider string `json:"provider"`
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Next     string `json:"next"`
+}
+
+func (s *server) federationRedirectURI(provider string) string {
+	return fmt.Sprintf("%s/login/%s/callback", strings.TrimSuffix(s.config.UI.CanonicalURL, "/"), provider)
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (s *server) getFederationProvider(c echo.Context) (string, FederationProviderConfig, error) {
+	id := c.Param("provider")
+	config, ok := s.config.Federation[id]
+	if !ok {
+		return "", FederationProviderConfig{}, errFederationProviderNotFound.WithAttributes("provider", id)
+	}
+	return id, config, nil
+}
+
+// FederatedLogin redirects the user to the external identity provider.
+func (s *server) FederatedLogin(c echo.Context) error {
+	id, config, err := s.getFederationProvider(c)
+	if err != nil {
+		return err
+	}
+	discovery, err := discoverProvider(c.Request().Context(), id, config)
+	if err != nil {
+		return err
+	}
+	state, err := randomString()
+	if err != nil {
+		return err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return err
+	}
+	err = s.federationCookie().Set(c, &federationCookie{
+		Provider: id,
+		State:    state,
+		Nonce:    nonce,
+		Next:     c.QueryParam(nextKey),
+	})
+	if err != nil {
+		return err
+	}
+	location, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return errFederationRequest.WithAttributes("provider", id).WithCause(err)
+	}
+	query := location.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", config.ClientID)
+	query.Set("redirect_uri", s.federationRedirectURI(id))
+	query.Set("scope", strings.Join(append([]string{scopeOpenID, scopeProfile, scopeEmail}, config.Scopes...), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	location.RawQuery = query.Encode()
+	return c.Redirect(http.StatusFound, location.String())
+}
+
+// federatedClaims are the claims of the ID token issued by the identity provider.
+type federatedClaims struct {
+	jwt.Claims
+	Nonce             string `json:"nonce"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+}
+
+// exchangeFederatedCode exchanges the authorization code for an ID token and verifies it.
+func exchangeFederatedCode(ctx context.Context, id string, config FederationProviderConfig, discovery *providerDiscovery, code, redirectURI string) (*jwt.JSONWebToken, error) {
+	req, err := http.NewRequest(http.MethodPost, discovery.TokenEndpoint, strings.NewReader(url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {redirectURI},
+	}.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(config.ClientID), url.QueryEscape(config.ClientSecret))
+	var tokenResponse struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := getFederationJSON(ctx, id, req, &tokenResponse); err != nil {
+		return nil, err
+	}
+	idToken, err := jwt.ParseSigned(tokenResponse.IDToken)
+	if err != nil {
+		return nil, errFederationIDToken.WithAttributes("provider", id).WithCause(err)
+	}
+	return idToken, nil
+}
+
+// verifyFederatedIDToken verifies the signature of the ID token with the keys of the identity provider and
+// returns the claims in the ID token.
+func verifyFederatedIDToken(ctx context.Context, id string, discovery *providerDiscovery, idToken *jwt.JSONWebToken, claims ...interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, discovery.JWKSURI, nil)
+	if err != nil {
+		return err
+	}
+	var keys jose.JSONWebKeySet
+	if err := getFederationJSON(ctx, id, req, &keys); err != nil {
+		return err
+	}
+	candidates := keys.Keys
+	if len(idToken.Headers) > 0 && idToken.Headers[0].KeyID != "" {
+		candidates = keys.Key(idToken.Headers[0].KeyID)
+	}
+	for _, key := range candidates {
+		if err = idToken.Claims(key.Key, claims...); err == nil {
+			return nil
+		}
+	}
+	return errFederationIDToken.WithAttributes("provider", id).WithCause(err)
+}
+
+// FederatedLoginCallback handles the redirect back from the external identity provider.
+// The user is looked up by the verified email address, and created if the identity provider allows that.
+func (s *server) FederatedLoginCallback(c echo.Context) error {
+	ctx := c.Request().Context()
+	id, config, err := s.getFederationProvider(c)
+	if err != nil {
+		return err
+	}
+	var state federationCookie
+	ok, err := s.federationCookie().Get(c, &state)
+	if err != nil {
+		return err
+	}
+	s.federationCookie().Remove(c)
+	if !ok || state.Provider != id || state.State == "" || state.State != c.QueryParam("state") {
+		return errFederationState
+	}
+	if upstreamErr := c.QueryParam("error"); upstreamErr != "" {
+		return errFederationDenied.WithAttributes("error", upstreamErr)
+	}
+	discovery, err := discoverProvider(ctx, id, config)
+	if err != nil {
+		return err
+	}
+	idToken, err := exchangeFederatedCode(ctx, id, config, discovery, c.QueryParam("code"), s.federationRedirectURI(id))
+	if err != nil {
+		return err
+	}
+	var claims federatedClaims
+	var rawClaims map[string]interface{}
+	if err := verifyFederatedIDToken(ctx, id, discovery, idToken, &claims, &rawClaims); err != nil {
+		return err
+	}
+	issuer := discovery.Issuer
+	if issuer == "" {
+		issuer = config.Issuer
+	}
+	if err := claims.Validate(jwt.Expected{
+		Issuer:   issuer,
+		Audience: jwt.Audience{config.ClientID},
+		Time:     s.now(),
+	}); err != nil {
+		return errFederationIDToken.WithAttributes("provider", id).WithCause(err)
+	}
+	if claims.Nonce != state.Nonce {
+		return errFederationIDToken.WithAttributes("provider", id)
+	}
+	if claims.Email == "" || !claims.EmailVerified {
+		return errFederationEmailNotVerified.WithAttributes("provider", id)
+	}
+
+	user, err := s.store.GetUserByPrimaryEmailAddress(ctx, claims.Email, &types.FieldMask{Paths: []string{
+		"primary_email_address_validated_at",
+	}})
+	switch {
+	case err == nil:
+		// Only link to users that validated their email address, as the identity provider may hand out email
+		// addresses of other users.
+		if user.PrimaryEmailAddressValidatedAt == nil {
+			return errFederationUserEmailNotValidated.WithAttributes("email", claims.Email)
+		}
+		if err := s.requireUserNotSuspended(ctx, &user.UserIdentifiers); err != nil {
+			return err
+		}
+	case !errors.IsNotFound(err):
+		return err
+	case !config.AutoProvision || s.provisionUser == nil:
+		return errFederationUserNotFound.WithAttributes("email", claims.Email)
+	default:
+		if user, err = s.provisionFederatedUser(ctx, issuer, &claims); err != nil {
+			return err
+		}
+		log.FromContext(ctx).WithFields(log.Fields(
+			"provider", id,
+			"user_uid", user.UserID,
+		)).Info("Created user from identity provider")
+	}
+
+	if config.GroupsClaim != "" {
+		if err := s.addFederatedMemberships(ctx, config, user.UserIdentifiers, rawClaims[config.GroupsClaim]); err != nil {
+			return err
+		}
+	}
+
+	if err := s.createSession(c, user.UserIdentifiers); err != nil {
+		return err
+	}
+	next := state.Next
+	if next == "" || !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") {
+		next = s.config.UI.MountPath()
+	}
+	return c.Redirect(http.StatusFound, next)
+}
+
+var invalidUserIDChars = regexp.MustCompile("[^a-z0-9]+")
+
+// federatedUserIDs returns candidate user IDs for a user of an identity provider.
+func federatedUserIDs(issuer string, claims *federatedClaims) []string {
+	base := claims.PreferredUsername
+	if base == "" {
+		base = strings.SplitN(claims.Email, "@", 2)[0]
+	}
+	base = strings.Trim(invalidUserIDChars.ReplaceAllString(strings.ToLower(base), "-"), "-")
+	if len(base) > 27 {
+		base = strings.TrimRight(base[:27], "-")
+	}
+	hash := sha256.Sum256([]byte(issuer + " " + claims.Subject))
+	suffix := hex.EncodeToString(hash[:4])
+	if len(base) < 3 {
+		return []string{"user-" + suffix}
+	}
+	return []string{base, base + "-" + suffix}
+}
+
+func (s *server) provisionFederatedUser(ctx context.Context, issuer string, claims *federatedClaims) (*ttnpb.User, error) {
+	var ids *ttnpb.UserIdentifiers
+	for _, candidate := range federatedUserIDs(issuer, claims) {
+		candidateIDs := &ttnpb.UserIdentifiers{UserID: candidate}
+		if err := candidateIDs.ValidateFields("user_id"); err != nil {
+			continue
+		}
+		if err := blacklist.Check(ctx, candidate); err != nil {
+			continue
+		}
+		_, err := s.store.GetUser(ctx, candidateIDs, nil)
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		ids = candidateIDs
+		break
+	}
+	if ids == nil {
+		return nil, errFederationUserID.WithAttributes("email", claims.Email)
+	}
+	now := s.now()
+	return s.provisionUser(ctx, &ttnpb.User{
+		UserIdentifiers:                *ids,
+		Name:                           claims.Name,
+		PrimaryEmailAddress:            claims.Email,
+		PrimaryEmailAddressValidatedAt: &now,
+	})
+}
+
+// addFederatedMemberships adds memberships of the user to the organizations that are mapped to the groups
+// in the given claim. Existing memberships are left untouched and memberships are never removed.
+func (s *server) addFederatedMemberships(ctx context.Context, config FederationProviderConfig, userIDs ttnpb.UserIdentifiers, groupsClaim interface{}) error {
+	var groups []string
+	switch groupsClaim := groupsClaim.(type) {
+	case string:
+		groups = strings.Fields(groupsClaim)
+	case []interface{}:
+		for _, group := range groupsClaim {
+			if group, ok := group.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+	rights := []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO}
+	if len(config.OrganizationRights) > 0 {
+		rights = make([]ttnpb.Right, 0, len(config.OrganizationRights))
+		for _, name := range config.OrganizationRights {
+			right, ok := ttnpb.Right_value[name]
+			if !ok {
+				return errUnknownRight.WithAttributes("right", name)
+			}
+			rights = append(rights, ttnpb.Right(right))
+		}
+	}
+	for _, group := range groups {
+		orgID, ok := config.GroupOrganizations[group]
+		if !ok {
+			continue
+		}
+		entityIDs := ttnpb.OrganizationIdentifiers{OrganizationID: orgID}.Identifiers()
+		_, err := s.store.GetMember(ctx, userIDs.OrganizationOrUserIdentifiers(), entityIDs)
+		if err == nil {
+			continue
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := s.store.SetMember(ctx, userIDs.OrganizationOrUserIdentifiers(), entityIDs, ttnpb.RightsFrom(rights...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,371 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/oauth"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/webui"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// stubProvider is a minimal OpenID Connect identity provider.
+type stubProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	nonce  string
+	claims map[string]interface{}
+}
+
+func newStubProvider() *stubProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	p := &stubProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.URL,
+			"authorization_endpoint": p.URL + "/authorize",
+			"token_endpoint":         p.URL + "/token",
+			"jwks_uri":               p.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &p.key.PublicKey, KeyID: "stub", Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if clientID, clientSecret, ok := r.BasicAuth(); !ok || clientID != "ttn" || clientSecret != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.PostFormValue("code") != "the-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		signer, err := jose.NewSigner(jose.SigningKey{
+			Algorithm: jose.RS256,
+			Key:       jose.JSONWebKey{Key: p.key, KeyID: "stub"},
+		}, nil)
+		if err != nil {
+			panic(err)
+		}
+		now := time.Now()
+		idToken, err := jwt.Signed(signer).Claims(jwt.Claims{
+			Issuer:   p.URL,
+			Subject:  "upstream-user",
+			Audience: jwt.Audience{"ttn"},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(time.Minute)),
+		}).Claims(map[string]interface{}{
+			"nonce": p.nonce,
+		}).Claims(p.claims).CompactSerialize()
+		if err != nil {
+			panic(err)
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "upstream-access-token",
+			"token_type":   "bearer",
+			"id_token":     idToken,
+		})
+	})
+	p.Server = httptest.NewServer(mux)
+	return p
+}
+
+func TestFederatedLogin(t *testing.T) {
+	ctx := test.Context()
+	provider := newStubProvider()
+	defer provider.Close()
+
+	store := &mockStore{}
+	var provisioned *ttnpb.User
+	provisionUser := func(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+		provisioned = usr
+		return &ttnpb.User{UserIdentifiers: usr.UserIdentifiers}, nil
+	}
+	c := component.MustNew(test.GetLogger(t), &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		Federation: map[string]oauth.FederationProviderConfig{
+			"stub": {
+				Name:               "Stub",
+				Issuer:             provider.URL,
+				ClientID:           "ttn",
+				ClientSecret:       "secret",
+				AutoProvision:      true,
+				GroupsClaim:        "groups",
+				GroupOrganizations: map[string]string{"admins": "admin-org"},
+			},
+			"strict": {
+				Issuer:       provider.URL,
+				ClientID:     "ttn",
+				ClientSecret: "secret",
+			},
+		},
+	}, oauth.WithUserProvisioner(provisionUser))
+	c.RegisterWeb(s)
+	if err := c.Start(); err != nil {
+		panic(err)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		panic(err)
+	}
+	do := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		for _, c := range jar.Cookies(req.URL) {
+			req.AddCookie(c)
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		if cookies := res.Result().Cookies(); len(cookies) > 0 {
+			jar.SetCookies(req.URL, cookies)
+		}
+		return res
+	}
+	login := func(t *testing.T, providerID string) (state string) {
+		a := assertions.New(t)
+		res := do("/oauth/login/" + providerID + "?n=" + url.QueryEscape("/oauth/authorize?client_id=client"))
+		if !a.So(res.Code, should.Equal, http.StatusFound) {
+			t.FailNow()
+		}
+		location, err := url.Parse(res.Header().Get("Location"))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(location.Path, should.Equal, "/authorize")
+		query := location.Query()
+		a.So(query.Get("response_type"), should.Equal, "code")
+		a.So(query.Get("client_id"), should.Equal, "ttn")
+		a.So(query.Get("redirect_uri"), should.Equal, "https://example.com/oauth/login/"+providerID+"/callback")
+		a.So(strings.Fields(query.Get("scope")), should.Contain, "openid")
+		a.So(query.Get("state"), should.NotBeEmpty)
+		a.So(query.Get("nonce"), should.NotBeEmpty)
+		provider.nonce = query.Get("nonce")
+		return query.Get("state")
+	}
+
+	t.Run("Login page", func(t *testing.T) {
+		a := assertions.New(t)
+		res := do("/oauth/login")
+		a.So(res.Code, should.Equal, http.StatusOK)
+		a.So(res.Body.String(), should.ContainSubstring, `"federation_providers":[{"id":"strict","name":"strict"},{"id":"stub","name":"Stub"}]`)
+	})
+
+	t.Run("Unknown provider", func(t *testing.T) {
+		a := assertions.New(t)
+		res := do("/oauth/login/unknown")
+		a.So(res.Code, should.Equal, http.StatusNotFound)
+	})
+
+	t.Run("Invalid state", func(t *testing.T) {
+		a := assertions.New(t)
+		login(t, "stub")
+		store.reset()
+		res := do("/oauth/login/stub/callback?code=the-code&state=invalid")
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	t.Run("Email not verified", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "stub")
+		store.reset()
+		provider.claims = map[string]interface{}{
+			"email":          "fed.user@example.com",
+			"email_verified": false,
+		}
+		res := do("/oauth/login/stub/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.BeEmpty)
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "strict")
+		store.reset()
+		store.err.getUserByEmail = mockErrNotFound
+		provider.claims = map[string]interface{}{
+			"email":          "fed.user@example.com",
+			"email_verified": true,
+		}
+		res := do("/oauth/login/strict/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.NotContain, "CreateUser")
+		a.So(store.calls, should.NotContain, "CreateSession")
+	})
+
+	t.Run("Link existing user", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "strict")
+		store.reset()
+		store.res.user = mockValidatedUser
+		store.res.session = mockSession
+		provider.claims = map[string]interface{}{
+			"email":          "user@example.com",
+			"email_verified": true,
+		}
+		res := do("/oauth/login/strict/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("Location"), should.Equal, "/oauth/authorize?client_id=client")
+		a.So(store.req.email, should.Equal, "user@example.com")
+		a.So(store.calls, should.NotContain, "CreateUser")
+		a.So(store.calls, should.Contain, "CreateSession")
+		a.So(store.req.session.UserIdentifiers, should.Resemble, mockUser.UserIdentifiers)
+	})
+
+	t.Run("Link user with unvalidated email", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "stub")
+		store.reset()
+		store.res.user = mockUser
+		store.res.session = mockSession
+		provider.claims = map[string]interface{}{
+			"email":          "user@example.com",
+			"email_verified": true,
+			"groups":         []string{"admins"},
+		}
+		res := do("/oauth/login/stub/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.NotContain, "SetMember")
+		a.So(store.calls, should.NotContain, "CreateSession")
+	})
+
+	t.Run("Suspended user", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "stub")
+		store.reset()
+		store.res.user = &ttnpb.User{
+			UserIdentifiers:                mockUser.UserIdentifiers,
+			PrimaryEmailAddressValidatedAt: mockValidatedUser.PrimaryEmailAddressValidatedAt,
+			State:                          ttnpb.STATE_SUSPENDED,
+		}
+		store.err.getMember = mockErrNotFound
+		provider.claims = map[string]interface{}{
+			"email":          "user@example.com",
+			"email_verified": true,
+			"groups":         []string{"admins"},
+		}
+		res := do("/oauth/login/stub/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(store.calls, should.Contain, "GetUser")
+		a.So(store.calls, should.NotContain, "SetMember")
+		a.So(store.calls, should.NotContain, "CreateSession")
+	})
+
+	t.Run("Provision user", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "stub")
+		store.reset()
+		provisioned = nil
+		store.err.getUserByEmail = mockErrNotFound
+		store.err.getUser = mockErrNotFound
+		store.err.getMember = mockErrNotFound
+		store.res.session = &ttnpb.UserSession{
+			UserIdentifiers: ttnpb.UserIdentifiers{UserID: "fed-user"},
+			SessionID:       "session_id",
+		}
+		provider.claims = map[string]interface{}{
+			"email":              "fed.user@example.com",
+			"email_verified":     true,
+			"name":               "Federated User",
+			"preferred_username": "Fed.User",
+			"groups":             []string{"admins", "users"},
+		}
+		res := do("/oauth/login/stub/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusFound)
+		a.So(res.Header().Get("Location"), should.Equal, "/oauth/authorize?client_id=client")
+
+		a.So(store.calls, should.NotContain, "CreateUser")
+		if a.So(provisioned, should.NotBeNil) {
+			a.So(provisioned.UserID, should.Equal, "fed-user")
+			a.So(provisioned.Name, should.Equal, "Federated User")
+			a.So(provisioned.PrimaryEmailAddress, should.Equal, "fed.user@example.com")
+			a.So(provisioned.PrimaryEmailAddressValidatedAt, should.NotBeNil)
+		}
+		a.So(store.calls, should.Contain, "SetMember")
+		a.So(store.req.memberIDs.GetUserIDs().GetUserID(), should.Equal, "fed-user")
+		a.So(store.req.entityIDs.IDString(), should.Equal, "admin-org")
+		a.So(store.req.rights.GetRights(), should.Resemble, []ttnpb.Right{ttnpb.RIGHT_ORGANIZATION_INFO})
+		a.So(store.calls, should.Contain, "CreateSession")
+
+		store.reset()
+		store.res.session = mockSession
+		store.res.user = mockUser
+		res = do("/oauth/api/me")
+		a.So(res.Code, should.Equal, http.StatusOK)
+	})
+
+	t.Run("Provision user with blacklisted ID", func(t *testing.T) {
+		a := assertions.New(t)
+		state := login(t, "stub")
+		store.reset()
+		provisioned = nil
+		store.err.getUserByEmail = mockErrNotFound
+		store.err.getUser = mockErrNotFound
+		store.res.session = mockSession
+		provider.claims = map[string]interface{}{
+			"email":              "admin@example.com",
+			"email_verified":     true,
+			"preferred_username": "admin",
+		}
+		res := do("/oauth/login/stub/callback?code=the-code&state=" + state)
+		a.So(res.Code, should.Equal, http.StatusFound)
+		if a.So(provisioned, should.NotBeNil) {
+			a.So(provisioned.UserID, should.NotEqual, "admin")
+			a.So(provisioned.UserID, should.StartWith, "admin-")
+		}
+	})
+}
@@ -77,7 +77,11 @@ func (s *server) Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc {
 		if ar == nil {
 			return s.output(c, resp)
 		}
-		ar.UserData = userData{UserIdentifiers: session.UserIdentifiers}
+		ar.UserData = userData{
+			UserIdentifiers: session.UserIdentifiers,
+			OpenIDScopes:    openIDScopes(ar.Scope),
+			Nonce:           req.FormValue("nonce"),
+		}
 		client := ttnpb.Client(ar.Client.(osinClient))
 		if !clientHasGrant(&client, ttnpb.GRANT_AUTHORIZATION_CODE) {
 			resp.InternalError = errClientMissingGrant.WithAttributes("grant", "authorization_code")
@@ -103,6 +107,12 @@ func (s *server) Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc {
 			oauth2.FinishAuthorizeRequest(resp, req, ar)
 			return s.output(c, resp)
 		}
+		if err := s.requireUserNotSuspended(req.Context(), &session.UserIdentifiers); err != nil {
+			resp.InternalError = err
+			resp.SetError(osin.E_ACCESS_DENIED, resp.InternalError.Error())
+			oauth2.FinishAuthorizeRequest(resp, req, ar)
+			return s.output(c, resp)
+		}
 		ar.Authorized = client.SkipAuthorization
 		ar.Scope = rightsToScope(client.Rights...)
 		if !ar.Authorized {
@@ -212,11 +222,29 @@ func (s *server) Token(c echo.Context) error {
 			ar.Authorized = true
 		}
 	}
+	if ar.Authorized && (ar.Type == osin.AUTHORIZATION_CODE || ar.Type == osin.REFRESH_TOKEN) {
+		// Tokens of suspended users can not be exchanged or refreshed.
+		if err := s.requireUserNotSuspended(req.Context(), &userIDs); err != nil {
+			resp.InternalError = err
+			ar.Authorized = false
+		}
+	}
+	var idToken string
 	if ar.Authorized {
+		if ud := ar.UserData.(userData); ar.Type == osin.AUTHORIZATION_CODE && hasScope(ud.OpenIDScopes, scopeOpenID) {
+			var err error
+			idToken, err = s.idToken(req.Context(), &client, ud)
+			if err != nil {
+				return err
+			}
+		}
 		events.Publish(evtTokenExchange(req.Context(), ttnpb.CombineIdentifiers(userIDs, client.ClientIdentifiers), nil))
 	}
 	oauth2.FinishAccessRequest(resp, req, ar)
 	delete(resp.Output, "scope")
+	if idToken != "" && !resp.IsError {
+		resp.Output["id_token"] = idToken
+	}
 	return s.output(c, resp)
 }
 
@@ -0,0 +1,363 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// OpenID Connect scopes.
+const (
+	scopeOpenID  = "openid"
+	scopeProfile = "profile"
+	scopeEmail   = "email"
+)
+
+var supportedOpenIDScopes = []string{scopeOpenID, scopeProfile, scopeEmail}
+
+// openIDScopes returns the OpenID Connect scopes in the given space-separated scope.
+// If the openid scope is not requested, no scopes are returned.
+func openIDScopes(scope string) []string {
+	var scopes []string
+	var isOpenID bool
+	for _, s := range strings.Fields(scope) {
+		for _, supported := range supportedOpenIDScopes {
+			if s != supported {
+				continue
+			}
+			if s == scopeOpenID {
+				isOpenID = true
+			}
+			scopes = append(scopes, s)
+		}
+	}
+	if !isOpenID {
+		return nil
+	}
+	return scopes
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	errReadSigningKey    = errors.DefineInvalidArgument("read_signing_key", "read signing key from `{file}`")
+	errInvalidSigningKey = errors.DefineInvalidArgument("invalid_signing_key", "invalid RSA signing key `{key}`")
+	errNoSigningKeys     = errors.DefineFailedPrecondition("no_signing_keys", "no signing keys for ID tokens configured")
+)
+
+// keyRing holds the keys used for signing ID tokens.
+// The keys are loaded from the configuration, so that all instances of the OAuth server sign with the same keys.
+type keyRing struct {
+	keys  []string
+	files []string
+
+	loadOnce sync.Once
+	loadErr  error
+	loaded   []jose.JSONWebKey // Signing key first, followed by the keys that are only published.
+}
+
+func newJSONWebKey(key *rsa.PrivateKey) (jose.JSONWebKey, error) {
+	jwk := jose.JSONWebKey{
+		Key:       key,
+		Algorithm: string(jose.RS256),
+		Use:       "sig",
+	}
+	public := jwk.Public()
+	thumbprint, err := public.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return jose.JSONWebKey{}, err
+	}
+	jwk.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	return jwk, nil
+}
+
+func parseRSAPrivateKey(data []byte) (*rsa.PrivateKey, bool) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, false
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, true
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, false
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	return rsaKey, ok
+}
+
+func (r *keyRing) add(name string, data []byte) error {
+	key, ok := parseRSAPrivateKey(data)
+	if !ok {
+		return errInvalidSigningKey.WithAttributes("key", name)
+	}
+	jwk, err := newJSONWebKey(key)
+	if err != nil {
+		return err
+	}
+	r.loaded = append(r.loaded, jwk)
+	return nil
+}
+
+func (r *keyRing) load() error {
+	for i, key := range r.keys {
+		if err := r.add(fmt.Sprintf("signing-keys[%d]", i), []byte(key)); err != nil {
+			return err
+		}
+	}
+	for _, file := range r.files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return errReadSigningKey.WithAttributes("file", file).WithCause(err)
+		}
+		if err := r.add(file, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get returns the signing key followed by the keys that are published for verification.
+func (r *keyRing) get() ([]jose.JSONWebKey, error) {
+	r.loadOnce.Do(func() { r.loadErr = r.load() })
+	if r.loadErr != nil {
+		return nil, r.loadErr
+	}
+	return r.loaded, nil
+}
+
+func (s *server) issuer() string {
+	issuer := s.config.OIDC.Issuer
+	if issuer == "" {
+		issuer = s.config.UI.CanonicalURL
+	}
+	return strings.TrimSuffix(issuer, "/")
+}
+
+// endpoint returns the URL of the endpoint at the given path relative to the issuer.
+func (s *server) endpoint(p string) string {
+	u, err := url.Parse(s.issuer())
+	if err != nil {
+		return s.issuer() + "/" + p
+	}
+	u.Path = path.Join("/", u.Path, p)
+	u.RawPath = ""
+	return u.String()
+}
+
+type providerMetadata struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// OpenIDConfiguration serves the OpenID Connect discovery document.
+func (s *server) OpenIDConfiguration(c echo.Context) error {
+	issuer := s.issuer()
+	return c.JSON(http.StatusOK, providerMetadata{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             s.endpoint("authorize"),
+		TokenEndpoint:                     s.endpoint("token"),
+		UserInfoEndpoint:                  s.endpoint("userinfo"),
+		JWKSURI:                           s.endpoint("jwks"),
+		ScopesSupported:                   supportedOpenIDScopes,
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token", "password"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{string(jose.RS256)},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nonce",
+			"name", "preferred_username", "email", "email_verified", "admin",
+		},
+	})
+}
+
+// JWKS serves the public keys that can be used to verify ID tokens.
+func (s *server) JWKS(c echo.Context) error {
+	keys, err := s.keys.get()
+	if err != nil {
+		return err
+	}
+	set := jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, len(keys))}
+	for i, key := range keys {
+		set.Keys[i] = key.Public()
+	}
+	return c.JSON(http.StatusOK, set)
+}
+
+// userClaims are the standard claims about the user.
+type userClaims struct {
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     *bool  `json:"email_verified,omitempty"`
+	Admin             *bool  `json:"admin,omitempty"`
+}
+
+func newUserClaims(user *ttnpb.User, scopes []string) userClaims {
+	var claims userClaims
+	if hasScope(scopes, scopeProfile) {
+		claims.Name = user.Name
+		claims.PreferredUsername = user.UserID
+		admin := user.Admin
+		claims.Admin = &admin
+	}
+	if hasScope(scopes, scopeEmail) && user.PrimaryEmailAddress != "" {
+		claims.Email = user.PrimaryEmailAddress
+		verified := user.PrimaryEmailAddressValidatedAt != nil
+		claims.EmailVerified = &verified
+	}
+	return claims
+}
+
+type idTokenClaims struct {
+	jwt.Claims
+	Nonce string `json:"nonce,omitempty"`
+	userClaims
+}
+
+// idToken returns a signed ID token for the user that authorized the client.
+func (s *server) idToken(ctx context.Context, client *ttnpb.Client, data userData) (string, error) {
+	keys, err := s.keys.get()
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return "", errNoSigningKeys
+	}
+	user, err := s.store.GetUser(ctx, &data.UserIdentifiers, nil)
+	if err != nil {
+		return "", err
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: keys[0]},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", err
+	}
+	now := s.now()
+	return jwt.Signed(signer).Claims(idTokenClaims{
+		Claims: jwt.Claims{
+			Issuer:   s.issuer(),
+			Subject:  data.UserID,
+			Audience: jwt.Audience{client.ClientID},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(s.config.OIDC.IDTokenTTL)),
+		},
+		Nonce:      data.Nonce,
+		userClaims: newUserClaims(user, data.OpenIDScopes),
+	}).CompactSerialize()
+}
+
+var (
+	errNoBearerToken      = errors.DefineUnauthenticated("no_bearer_token", "no bearer token")
+	errAccessTokenExpired = errors.DefineUnauthenticated("access_token_expired", "access token expired")
+	errInsufficientRights = errors.DefinePermissionDenied("insufficient_rights", "insufficient rights")
+	errInsufficientScope  = errors.DefinePermissionDenied("insufficient_scope", "access token does not have the `{scope}` scope")
+	errInvalidAccessToken = errors.DefineUnauthenticated("invalid_access_token", "invalid access token")
+)
+
+const bearerPrefix = "bearer "
+
+type userInfoResponse struct {
+	Subject string `json:"sub"`
+	userClaims
+}
+
+// UserInfo serves the claims about the user that authorized the access token.
+// The access token must have the user info right and must have been issued with the openid scope.
+// Only the claims of the OpenID Connect scopes granted to the access token are served.
+func (s *server) UserInfo(c echo.Context) error {
+	ctx := c.Request().Context()
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		return errNoBearerToken
+	}
+	tokenType, id, key, err := auth.SplitToken(header[len(bearerPrefix):])
+	if err != nil {
+		return errInvalidAccessToken.WithCause(err)
+	}
+	if tokenType != auth.AccessToken {
+		return errNoAccessToken
+	}
+	token, err := s.store.GetAccessToken(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errInvalidAccessToken
+		}
+		return err
+	}
+	if valid, err := auth.Validate(token.AccessToken, key); err != nil || !valid {
+		return errInvalidAccessToken
+	}
+	if token.ExpiresAt.Before(s.now()) {
+		return errAccessTokenExpired
+	}
+	if !ttnpb.RightsFrom(token.Rights...).IncludesAll(ttnpb.RIGHT_USER_INFO) {
+		return errInsufficientRights
+	}
+	if !hasScope(token.OpenIDScopes, scopeOpenID) {
+		return errInsufficientScope.WithAttributes("scope", scopeOpenID)
+	}
+	user, err := s.store.GetUser(ctx, &token.UserIDs, nil)
+	if err != nil {
+		return err
+	}
+	if user.State == ttnpb.STATE_SUSPENDED {
+		return errUserSuspended
+	}
+	return c.JSON(http.StatusOK, userInfoResponse{
+		Subject:    user.UserID,
+		userClaims: newUserClaims(user, token.OpenIDScopes),
+	})
+}
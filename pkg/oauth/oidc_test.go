@@ -0,0 +1,356 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/auth"
+	"go.thethings.network/lorawan-stack/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/oauth"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/webui"
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func generateSigningKey() string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}))
+}
+
+func TestOpenIDConnectDiscovery(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Issuer string
+		Prefix string
+	}{
+		{
+			Name:   "TrailingSlash",
+			Issuer: "https://example.com/oauth/",
+			Prefix: "https://example.com/oauth/",
+		},
+		{
+			Name:   "Path",
+			Issuer: "https://example.com/tenant/oauth",
+			Prefix: "https://example.com/tenant/oauth/",
+		},
+		{
+			Name:   "Host",
+			Issuer: "https://example.com",
+			Prefix: "https://example.com/",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			c := component.MustNew(test.GetLogger(t), &component.Config{})
+			s := oauth.NewServer(test.Context(), &mockStore{}, oauth.Config{
+				Mount: "/oauth",
+				OIDC: oauth.OIDCConfig{
+					Issuer: tc.Issuer,
+				},
+			})
+			c.RegisterWeb(s)
+			if err := c.Start(); err != nil {
+				panic(err)
+			}
+			defer c.Close()
+
+			req := httptest.NewRequest("GET", "/oauth/.well-known/openid-configuration", nil)
+			res := httptest.NewRecorder()
+			c.ServeHTTP(res, req)
+			a.So(res.Code, should.Equal, http.StatusOK)
+			var metadata map[string]interface{}
+			a.So(json.Unmarshal(res.Body.Bytes(), &metadata), should.BeNil)
+			a.So(metadata["authorization_endpoint"], should.Equal, tc.Prefix+"authorize")
+			a.So(metadata["token_endpoint"], should.Equal, tc.Prefix+"token")
+			a.So(metadata["userinfo_endpoint"], should.Equal, tc.Prefix+"userinfo")
+			a.So(metadata["jwks_uri"], should.Equal, tc.Prefix+"jwks")
+		})
+	}
+}
+
+func TestOpenIDConnect(t *testing.T) {
+	ctx := test.Context()
+	store := &mockStore{}
+	c := component.MustNew(test.GetLogger(t), &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s := oauth.NewServer(ctx, store, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		OIDC: oauth.OIDCConfig{
+			IDTokenTTL:  10 * time.Minute,
+			SigningKeys: []string{generateSigningKey()},
+		},
+	})
+	c.RegisterWeb(s)
+	if err := c.Start(); err != nil {
+		panic(err)
+	}
+
+	do := func(method, path string, body interface{}, header http.Header) *httptest.ResponseRecorder {
+		var buf bytes.Buffer
+		if body != nil {
+			json.NewEncoder(&buf).Encode(body)
+		}
+		req := httptest.NewRequest(method, path, &buf)
+		req.URL.Scheme, req.URL.Host = "http", req.Host
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, req)
+		return res
+	}
+
+	user := &ttnpb.User{
+		UserIdentifiers:                mockUser.UserIdentifiers,
+		Name:                           "Test User",
+		PrimaryEmailAddress:            "user@example.com",
+		PrimaryEmailAddressValidatedAt: &time.Time{},
+		Admin:                          true,
+	}
+
+	t.Run("Discovery", func(t *testing.T) {
+		a := assertions.New(t)
+		res := do("GET", "/oauth/.well-known/openid-configuration", nil, nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+		var metadata map[string]interface{}
+		a.So(json.Unmarshal(res.Body.Bytes(), &metadata), should.BeNil)
+		a.So(metadata["issuer"], should.Equal, "https://example.com/oauth")
+		a.So(metadata["jwks_uri"], should.Equal, "https://example.com/oauth/jwks")
+		a.So(metadata["userinfo_endpoint"], should.Equal, "https://example.com/oauth/userinfo")
+	})
+
+	var keys jose.JSONWebKeySet
+	t.Run("JWKS", func(t *testing.T) {
+		a := assertions.New(t)
+		res := do("GET", "/oauth/jwks", nil, nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+		a.So(json.Unmarshal(res.Body.Bytes(), &keys), should.BeNil)
+		if a.So(keys.Keys, should.HaveLength, 1) {
+			a.So(keys.Keys[0].IsPublic(), should.BeTrue)
+			a.So(keys.Keys[0].KeyID, should.NotBeEmpty)
+		}
+	})
+
+	t.Run("IDToken", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		store.res.client = mockClient
+		store.res.user = user
+		store.res.authorizationCode = &ttnpb.OAuthAuthorizationCode{
+			UserIDs:      mockUser.UserIdentifiers,
+			ClientIDs:    mockClient.ClientIdentifiers,
+			Rights:       mockClient.Rights,
+			Code:         "the code",
+			RedirectURI:  "http://uri/callback",
+			CreatedAt:    time.Now().Truncate(time.Second),
+			ExpiresAt:    time.Now().Truncate(time.Second).Add(time.Hour),
+			OpenIDScopes: []string{"openid", "email"},
+			Nonce:        "the nonce",
+		}
+		res := do("POST", "/oauth/token", map[string]string{
+			"grant_type":    "authorization_code",
+			"code":          "the code",
+			"redirect_uri":  "http://uri/callback",
+			"client_id":     "client",
+			"client_secret": "secret",
+		}, nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+
+		var tokenResponse struct {
+			AccessToken string `json:"access_token"`
+			IDToken     string `json:"id_token"`
+		}
+		a.So(json.Unmarshal(res.Body.Bytes(), &tokenResponse), should.BeNil)
+		a.So(tokenResponse.AccessToken, should.NotBeEmpty)
+		if a.So(store.req.token, should.NotBeNil) {
+			a.So(store.req.token.OpenIDScopes, should.Resemble, []string{"openid", "email"})
+		}
+
+		idToken, err := jwt.ParseSigned(tokenResponse.IDToken)
+		if !a.So(err, should.BeNil) || !a.So(idToken.Headers, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		key := keys.Key(idToken.Headers[0].KeyID)
+		if !a.So(key, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		var claims struct {
+			jwt.Claims
+			Nonce         string `json:"nonce"`
+			Name          string `json:"name"`
+			Email         string `json:"email"`
+			EmailVerified bool   `json:"email_verified"`
+		}
+		a.So(idToken.Claims(key[0].Key, &claims), should.BeNil)
+		a.So(claims.Validate(jwt.Expected{
+			Issuer:   "https://example.com/oauth",
+			Subject:  "user",
+			Audience: jwt.Audience{"client"},
+			Time:     time.Now(),
+		}), should.BeNil)
+		a.So(claims.Nonce, should.Equal, "the nonce")
+		a.So(claims.Email, should.Equal, "user@example.com")
+		a.So(claims.EmailVerified, should.BeTrue)
+		a.So(claims.Name, should.BeEmpty) // No profile scope.
+	})
+
+	t.Run("NoIDToken", func(t *testing.T) {
+		a := assertions.New(t)
+		store.reset()
+		store.res.client = mockClient
+		store.res.user = user
+		store.res.authorizationCode = &ttnpb.OAuthAuthorizationCode{
+			UserIDs:     mockUser.UserIdentifiers,
+			ClientIDs:   mockClient.ClientIdentifiers,
+			Rights:      mockClient.Rights,
+			Code:        "the code",
+			RedirectURI: "http://uri/callback",
+			CreatedAt:   time.Now().Truncate(time.Second),
+			ExpiresAt:   time.Now().Truncate(time.Second).Add(time.Hour),
+		}
+		res := do("POST", "/oauth/token", map[string]string{
+			"grant_type":    "authorization_code",
+			"code":          "the code",
+			"redirect_uri":  "http://uri/callback",
+			"client_id":     "client",
+			"client_secret": "secret",
+		}, nil)
+		a.So(res.Code, should.Equal, http.StatusOK)
+		a.So(res.Body.String(), should.NotContainSubstring, "id_token")
+	})
+
+	t.Run("UserInfo", func(t *testing.T) {
+		a := assertions.New(t)
+
+		hashValidator := pbkdf2.Default()
+		hashValidator.Iterations = 10
+		hashCtx := auth.NewContextWithHashValidator(ctx, hashValidator)
+		key, err := auth.GenerateKey(hashCtx)
+		if err != nil {
+			panic(err)
+		}
+		hash, err := auth.Hash(hashCtx, key)
+		if err != nil {
+			panic(err)
+		}
+		token := auth.JoinToken(auth.AccessToken, "TOKENID", key)
+
+		res := do("GET", "/oauth/userinfo", nil, nil)
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+
+		store.reset()
+		store.res.user = user
+		store.res.accessToken = &ttnpb.OAuthAccessToken{
+			UserIDs:      mockUser.UserIdentifiers,
+			ClientIDs:    mockClient.ClientIdentifiers,
+			ID:           "TOKENID",
+			AccessToken:  hash,
+			Rights:       []ttnpb.Right{ttnpb.RIGHT_USER_INFO},
+			CreatedAt:    time.Now(),
+			ExpiresAt:    time.Now().Add(time.Hour),
+			OpenIDScopes: []string{"openid", "profile", "email"},
+		}
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + token}})
+		a.So(res.Code, should.Equal, http.StatusOK)
+		a.So(store.req.tokenID, should.Equal, "TOKENID")
+		var userInfo map[string]interface{}
+		a.So(json.Unmarshal(res.Body.Bytes(), &userInfo), should.BeNil)
+		a.So(userInfo, should.Resemble, map[string]interface{}{
+			"sub":                "user",
+			"name":               "Test User",
+			"preferred_username": "user",
+			"email":              "user@example.com",
+			"email_verified":     true,
+			"admin":              true,
+		})
+
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + auth.JoinToken(auth.AccessToken, "TOKENID", "wrong")}})
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+
+		store.res.accessToken.Rights = []ttnpb.Right{ttnpb.RIGHT_USER_APPLICATIONS_LIST}
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + token}})
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+
+		store.res.accessToken.Rights = []ttnpb.Right{ttnpb.RIGHT_USER_INFO}
+		store.res.accessToken.OpenIDScopes = []string{"openid", "email"}
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + token}})
+		a.So(res.Code, should.Equal, http.StatusOK)
+		userInfo = nil
+		a.So(json.Unmarshal(res.Body.Bytes(), &userInfo), should.BeNil)
+		a.So(userInfo, should.Resemble, map[string]interface{}{
+			"sub":            "user",
+			"email":          "user@example.com",
+			"email_verified": true,
+		})
+
+		store.res.accessToken.OpenIDScopes = nil
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + token}})
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+
+		store.res.accessToken.OpenIDScopes = []string{"openid", "profile", "email"}
+		store.res.user = &ttnpb.User{
+			UserIdentifiers: user.UserIdentifiers,
+			State:           ttnpb.STATE_SUSPENDED,
+		}
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + token}})
+		a.So(res.Code, should.Equal, http.StatusForbidden)
+		a.So(res.Body.String(), should.NotContainSubstring, "user@example.com")
+
+		store.res.user = user
+		store.res.accessToken.ExpiresAt = time.Now().Add(-time.Minute)
+		res = do("GET", "/oauth/userinfo", nil, http.Header{"Authorization": {"Bearer " + token}})
+		a.So(res.Code, should.Equal, http.StatusUnauthorized)
+	})
+}
@@ -27,6 +27,7 @@ import (
 	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/web"
 	"go.thethings.network/lorawan-stack/pkg/webui"
 )
@@ -40,6 +41,11 @@ type Server interface {
 	Logout(c echo.Context) error
 	Authorize(authorizePage echo.HandlerFunc) echo.HandlerFunc
 	Token(c echo.Context) error
+	OpenIDConfiguration(c echo.Context) error
+	JWKS(c echo.Context) error
+	UserInfo(c echo.Context) error
+	FederatedLogin(c echo.Context) error
+	FederatedLoginCallback(c echo.Context) error
 }
 
 type server struct {
@@ -47,6 +53,24 @@ type server struct {
 	config     Config
 	osinConfig *osin.ServerConfig
 	store      Store
+	keys       *keyRing
+
+	provisionUser UserProvisioner
+}
+
+// UserProvisioner creates a user that is provisioned by an external identity provider.
+type UserProvisioner func(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error)
+
+// Option configures the OAuth server.
+type Option func(*server)
+
+// WithUserProvisioner returns an option that sets the function that creates users that are provisioned by
+// external identity providers. The function is responsible for enforcing the user registration requirements.
+// Without a UserProvisioner, federated login is only possible for existing users.
+func WithUserProvisioner(provisioner UserProvisioner) Option {
+	return func(s *server) {
+		s.provisionUser = provisioner
+	}
 }
 
 // Store used by the OAuth server.
@@ -58,6 +82,8 @@ type Store interface {
 	store.ClientStore
 	// OAuth is needed for OAuth authorizations.
 	store.OAuthStore
+	// MembershipStore is needed for organization memberships of federated users.
+	store.MembershipStore
 }
 
 // UIConfig is the combined configuration for the OAuth UI.
@@ -68,28 +94,51 @@ type UIConfig struct {
 
 // FrontendConfig is the configuration for the OAuth frontend.
 type FrontendConfig struct {
-	Language string          `json:"language" name:"-"`
-	IS       webui.APIConfig `json:"is" name:"is"`
+	Language            string               `json:"language" name:"-"`
+	IS                  webui.APIConfig      `json:"is" name:"is"`
+	FederationProviders []federationProvider `json:"federation_providers,omitempty" name:"-"`
+}
+
+// OIDCConfig is the configuration for the OpenID Connect provider.
+type OIDCConfig struct {
+	Issuer          string        `name:"issuer" description:"Issuer identifier of the OpenID Connect provider (defaults to the canonical URL of the OAuth UI)"`
+	IDTokenTTL      time.Duration `name:"id-token-ttl" description:"Validity of issued ID tokens"`
+	SigningKeys     []string      `name:"signing-keys" description:"PEM encoded RSA private keys for signing ID tokens. The first key signs, all keys are published. All instances must use the same keys"`
+	SigningKeyFiles []string      `name:"signing-key-file" description:"Files with PEM encoded RSA private keys for signing ID tokens, used after the signing keys"`
 }
 
 // Config is the configuration for the OAuth server.
 type Config struct {
-	Mount string   `name:"mount" description:"Path on the server where the OAuth server will be served"`
-	UI    UIConfig `name:"ui"`
+	Mount string     `name:"mount" description:"Path on the server where the OAuth server will be served"`
+	UI    UIConfig   `name:"ui"`
+	OIDC  OIDCConfig `name:"oidc"`
+
+	Federation map[string]FederationProviderConfig `name:"federation" file-only:"true" description:"External OpenID Connect identity providers by ID"`
 }
 
 // NewServer returns a new OAuth server on top of the given store.
-func NewServer(ctx context.Context, store Store, config Config) Server {
+func NewServer(ctx context.Context, store Store, config Config, opts ...Option) Server {
 	s := &server{
 		ctx:    ctx,
 		config: config,
 		store:  store,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 
 	if s.config.Mount == "" {
 		s.config.Mount = s.config.UI.MountPath()
 	}
 
+	if s.config.OIDC.IDTokenTTL == 0 {
+		s.config.OIDC.IDTokenTTL = time.Hour
+	}
+	s.keys = &keyRing{
+		keys:  s.config.OIDC.SigningKeys,
+		files: s.config.OIDC.SigningKeyFiles,
+	}
+
 	s.osinConfig = &osin.ServerConfig{
 		AuthorizationExpiration: int32((5 * time.Minute).Seconds()),
 		AccessExpiration:        int32(time.Hour.Seconds()),
@@ -213,6 +262,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 				c.Set("template_data", s.config.UI.TemplateData)
 				frontendConfig := s.config.UI.FrontendConfig
 				frontendConfig.Language = s.config.UI.TemplateData.Language
+				frontendConfig.FederationProviders = s.federationProviders()
 				c.Set("app_config", struct {
 					FrontendConfig
 				}{
@@ -235,6 +285,7 @@ func (s *server) RegisterRoutes(server *web.Server) {
 		TokenLookup: "form:csrf",
 	}))
 	page.GET("/login", webui.Template.Handler, s.redirectToNext)
+	page.GET("/login/:provider", s.FederatedLogin)
 	page.GET("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 	page.POST("/authorize", s.Authorize(webui.Template.Handler), s.redirectToLogin)
 
@@ -246,5 +297,10 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	// No CSRF here:
 	group.GET("/code", webui.Template.Handler)
 	group.GET("/local-callback", s.redirectToLocal)
+	group.GET("/login/:provider/callback", s.FederatedLoginCallback)
 	group.POST("/token", s.Token)
+	group.GET("/.well-known/openid-configuration", s.OpenIDConfiguration)
+	group.GET("/jwks", s.JWKS)
+	group.GET("/userinfo", s.UserInfo)
+	group.POST("/userinfo", s.UserInfo)
 }
@@ -60,6 +60,14 @@ var (
 	mockUser = &ttnpb.User{
 		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
 	}
+	mockValidatedUser = &ttnpb.User{
+		UserIdentifiers:                ttnpb.UserIdentifiers{UserID: "user"},
+		PrimaryEmailAddressValidatedAt: func() *time.Time { t := time.Now().Truncate(time.Second); return &t }(),
+	}
+	mockSuspendedUser = &ttnpb.User{
+		UserIdentifiers: ttnpb.UserIdentifiers{UserID: "user"},
+		State:           ttnpb.STATE_SUSPENDED,
+	}
 	mockClient = &ttnpb.Client{
 		ClientIdentifiers: ttnpb.ClientIdentifiers{ClientID: "client"},
 		State:             ttnpb.STATE_APPROVED,
@@ -81,6 +89,7 @@ func init() {
 		panic(err)
 	}
 	mockUser.Password = password
+	mockSuspendedUser.Password = password
 
 	secret, err := auth.Hash(ctx, "secret")
 	if err != nil {
@@ -205,6 +214,33 @@ func TestOAuthFlow(t *testing.T) {
 			Body:         loginFormData{"json", "user", "wrong_pass"},
 			ExpectedCode: http.StatusUnauthorized,
 		},
+		{
+			Name: "login suspended user",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockSuspendedUser
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusForbidden,
+		},
+		{
+			Name: "login rehash error",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+				s.err.updateUser = mockErrNotFound
+			},
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "UpdateUser")
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
 		{
 			Name: "login",
 			StoreSetup: func(s *mockStore) {
@@ -215,6 +251,19 @@ func TestOAuthFlow(t *testing.T) {
 			Path:         "/oauth/api/auth/login",
 			Body:         loginFormData{"json", "user", "pass"},
 			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				// The password of the mock user is hashed with weaker parameters than the default.
+				a.So(s.calls, should.Contain, "UpdateUser")
+				if a.So(s.req.user, should.NotBeNil) {
+					a.So(s.req.user.UserID, should.Equal, "user")
+					a.So(s.req.user.Password, should.NotEqual, mockUser.Password)
+					ok, err := auth.Validate(s.req.user.Password, "pass")
+					a.So(err, should.BeNil)
+					a.So(ok, should.BeTrue)
+				}
+				a.So(s.req.fieldMask.Paths, should.Resemble, []string{"password"})
+			},
 		},
 		{
 			Name: "GET me with auth",
@@ -366,6 +415,18 @@ func TestOAuthFlow(t *testing.T) {
 			ExpectedCode:     http.StatusFound,
 			ExpectedRedirect: "http://uri/callback?error=invalid_grant",
 		},
+		{
+			Name: "user suspended",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockSuspendedUser
+				s.res.client = mockClient
+			},
+			Method:           "GET",
+			Path:             "/oauth/authorize?client_id=client&redirect_uri=http://uri/callback&response_type=code&state=foo",
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "http://uri/callback?error=access_denied",
+		},
 		{
 			Name: "authorize client",
 			StoreSetup: func(s *mockStore) {
@@ -393,6 +454,27 @@ func TestOAuthFlow(t *testing.T) {
 				a.So(s.req.authorizationCode.State, should.Equal, "foo")
 			},
 		},
+		{
+			Name: "authorize OpenID Connect client",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.client = mockClient
+				s.err.getAuthorization = mockErrNotFound
+			},
+			Method:           "POST",
+			Path:             "/oauth/authorize?client_id=client&redirect_uri=http://uri/callback&response_type=code&state=foo&scope=openid+email+other&nonce=bar",
+			Body:             authorizeFormData{encoding: "form", Authorize: true},
+			ExpectedCode:     http.StatusFound,
+			ExpectedRedirect: "http://uri/callback?code=",
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "CreateAuthorizationCode")
+				a.So(s.req.authorizationCode.Rights, should.Resemble, mockClient.Rights)
+				a.So(s.req.authorizationCode.OpenIDScopes, should.Resemble, []string{"openid", "email"})
+				a.So(s.req.authorizationCode.Nonce, should.Equal, "bar")
+			},
+		},
 		{
 			Name: "logout",
 			StoreSetup: func(s *mockStore) {
@@ -611,6 +693,36 @@ func TestTokenExchange(t *testing.T) {
 				a.So(s.req.previousID, should.Equal, "IBTFXELDVVT64Y26IZZFFNSL7GWZY2Y3ALQQI3A")
 			},
 		},
+		{
+			Name: "Exchange Refresh Token of Suspended User",
+			StoreSetup: func(s *mockStore) {
+				s.res.client = mockClient
+				s.res.user = mockSuspendedUser
+				s.res.accessToken = &ttnpb.OAuthAccessToken{
+					UserIDs:      mockUser.UserIdentifiers,
+					ClientIDs:    mockClient.ClientIdentifiers,
+					ID:           "SFUBFRKYTGULGPAXXM4SHIBYMKCPTIMQBM63ZGQ",
+					RefreshToken: "PBKDF2$sha256$20000$IGAiKs46xX_M64E5$4xpyqnQT8SOa_Vf4xhEPk6WOZnhmAjG2mqGQiYBhm2s",
+					Rights:       mockClient.Rights,
+					CreatedAt:    time.Now().Truncate(time.Second),
+					ExpiresAt:    time.Now().Truncate(time.Second).Add(time.Hour),
+				}
+			},
+			Method: "POST",
+			Path:   "/oauth/token",
+			Body: map[string]string{
+				"grant_type":    "refresh_token",
+				"refresh_token": "OJSWM.IBTFXELDVVT64Y26IZZFFNSL7GWZY2Y3ALQQI3A.GCPIASDUP7UZJ6YL5OP2ESZB7CKRFV4JJQYTMDOSDIOE7O75IAMQ",
+				"client_id":     "client",
+				"client_secret": "secret",
+			},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "GetUser")
+				a.So(s.calls, should.NotContain, "CreateAccessToken")
+			},
+		},
 	} {
 		name := tt.Name
 		if name == "" {
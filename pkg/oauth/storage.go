@@ -57,6 +57,11 @@ func (cli osinClient) GetUserData() interface{} { return nil }
 type userData struct {
 	ttnpb.UserIdentifiers
 	ID string
+
+	// OpenIDScopes and Nonce are set on authorization requests of OpenID Connect clients.
+	// OpenIDScopes are also set on access tokens issued to OpenID Connect clients.
+	OpenIDScopes []string
+	Nonce        string
 }
 
 // storage wraps IS stores, while implementing the osin.Storage interface.
@@ -83,7 +88,8 @@ func (s *storage) GetClient(id string) (osin.Client, error) {
 }
 
 func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
-	userIDs := data.UserData.(userData).UserIdentifiers
+	ud := data.UserData.(userData)
+	userIDs := ud.UserIdentifiers
 	client := ttnpb.Client(data.Client.(osinClient))
 	rights := rightsFromScope(data.Scope)
 	_, err := s.oauth.Authorize(s.ctx, &ttnpb.OAuthClientAuthorization{
@@ -98,14 +104,16 @@ func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
 		data.CreatedAt = time.Now()
 	}
 	err = s.oauth.CreateAuthorizationCode(s.ctx, &ttnpb.OAuthAuthorizationCode{
-		ClientIDs:   client.ClientIdentifiers,
-		UserIDs:     userIDs,
-		Rights:      rights,
-		Code:        data.Code,
-		RedirectURI: data.RedirectUri,
-		State:       data.State,
-		CreatedAt:   data.CreatedAt,
-		ExpiresAt:   data.CreatedAt.Add(time.Duration(data.ExpiresIn) * time.Second),
+		ClientIDs:    client.ClientIdentifiers,
+		UserIDs:      userIDs,
+		Rights:       rights,
+		Code:         data.Code,
+		RedirectURI:  data.RedirectUri,
+		State:        data.State,
+		CreatedAt:    data.CreatedAt,
+		ExpiresAt:    data.CreatedAt.Add(time.Duration(data.ExpiresIn) * time.Second),
+		OpenIDScopes: ud.OpenIDScopes,
+		Nonce:        ud.Nonce,
 	})
 	if err != nil {
 		return err
@@ -130,7 +138,11 @@ func (s *storage) LoadAuthorize(code string) (data *osin.AuthorizeData, err erro
 		RedirectUri: authorizationCode.RedirectURI,
 		State:       authorizationCode.State,
 		CreatedAt:   authorizationCode.CreatedAt,
-		UserData:    userData{UserIdentifiers: authorizationCode.UserIDs},
+		UserData: userData{
+			UserIdentifiers: authorizationCode.UserIDs,
+			OpenIDScopes:    authorizationCode.OpenIDScopes,
+			Nonce:           authorizationCode.Nonce,
+		},
 	}, nil
 }
 
@@ -184,7 +196,7 @@ func (s *storage) SaveAccess(data *osin.AccessData) error {
 			return err
 		}
 	}
-	userIDs := data.UserData.(userData).UserIdentifiers
+	ud := data.UserData.(userData)
 	client := ttnpb.Client(data.Client.(osinClient))
 	rights := rightsFromScope(data.Scope)
 	if data.CreatedAt.IsZero() {
@@ -202,13 +214,14 @@ func (s *storage) SaveAccess(data *osin.AccessData) error {
 	}
 	return s.oauth.CreateAccessToken(s.ctx, &ttnpb.OAuthAccessToken{
 		ClientIDs:    client.ClientIdentifiers,
-		UserIDs:      userIDs,
+		UserIDs:      ud.UserIdentifiers,
 		Rights:       rights,
 		ID:           accessID,
 		AccessToken:  accessHash,
 		RefreshToken: refreshHash,
 		CreatedAt:    data.CreatedAt,
 		ExpiresAt:    data.CreatedAt.Add(time.Duration(data.ExpiresIn) * time.Second),
+		OpenIDScopes: ud.OpenIDScopes,
 	}, previousID)
 }
 
@@ -228,7 +241,11 @@ func (s *storage) loadAccess(id string) (*osin.AccessData, error) {
 		ExpiresIn:    int32(accessToken.ExpiresAt.Sub(accessToken.CreatedAt).Seconds()),
 		Scope:        rightsToScope(accessToken.Rights...),
 		CreatedAt:    accessToken.CreatedAt,
-		UserData:     userData{UserIdentifiers: accessToken.UserIDs, ID: id},
+		UserData: userData{
+			UserIdentifiers: accessToken.UserIDs,
+			ID:              id,
+			OpenIDScopes:    accessToken.OpenIDScopes,
+		},
 	}, nil
 }
 
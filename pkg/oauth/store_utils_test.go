@@ -39,6 +39,11 @@ type mockStoreContents struct {
 		token             *ttnpb.OAuthAccessToken
 		previousID        string
 		tokenID           string
+		email             string
+		user              *ttnpb.User
+		memberIDs         *ttnpb.OrganizationOrUserIdentifiers
+		entityIDs         ttnpb.Identifiers
+		rights            *ttnpb.Rights
 	}
 	res struct {
 		session           *ttnpb.UserSession
@@ -47,9 +52,16 @@ type mockStoreContents struct {
 		authorization     *ttnpb.OAuthClientAuthorization
 		authorizationCode *ttnpb.OAuthAuthorizationCode
 		accessToken       *ttnpb.OAuthAccessToken
+		createdUser       *ttnpb.User
+		memberRights      *ttnpb.Rights
 	}
 	err struct {
 		getUser                 error
+		getUserByEmail          error
+		createUser              error
+		updateUser              error
+		getMember               error
+		setMember               error
 		createSession           error
 		getSession              error
 		deleteSession           error
@@ -70,6 +82,7 @@ type mockStore struct {
 	store.UserSessionStore
 	store.ClientStore
 	store.OAuthStore
+	store.MembershipStore
 
 	mockStoreContents
 }
@@ -89,6 +102,28 @@ func (s *mockStore) GetUser(ctx context.Context, id *ttnpb.UserIdentifiers, fiel
 	return s.res.user, s.err.getUser
 }
 
+func (s *mockStore) GetUserByPrimaryEmailAddress(ctx context.Context, email string, fieldMask *types.FieldMask) (*ttnpb.User, error) {
+	s.req.ctx, s.req.email, s.req.fieldMask = ctx, email, fieldMask
+	s.calls = append(s.calls, "GetUserByPrimaryEmailAddress")
+	return s.res.user, s.err.getUserByEmail
+}
+
+func (s *mockStore) CreateUser(ctx context.Context, usr *ttnpb.User) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user = ctx, usr
+	s.calls = append(s.calls, "CreateUser")
+	if s.err.createUser == nil {
+		// The created user can be retrieved afterwards.
+		s.res.user, s.err.getUser = s.res.createdUser, nil
+	}
+	return s.res.createdUser, s.err.createUser
+}
+
+func (s *mockStore) UpdateUser(ctx context.Context, usr *ttnpb.User, fieldMask *types.FieldMask) (*ttnpb.User, error) {
+	s.req.ctx, s.req.user, s.req.fieldMask = ctx, usr, fieldMask
+	s.calls = append(s.calls, "UpdateUser")
+	return usr, s.err.updateUser
+}
+
 func (s *mockStore) CreateSession(ctx context.Context, sess *ttnpb.UserSession) (*ttnpb.UserSession, error) {
 	s.req.ctx, s.req.session = ctx, sess
 	s.calls = append(s.calls, "CreateSession")
@@ -163,3 +198,15 @@ func (s *mockStore) DeleteAccessToken(ctx context.Context, tokenID string) error
 	s.calls = append(s.calls, "DeleteAccessToken")
 	return s.err.deleteAccessToken
 }
+
+func (s *mockStore) GetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers) (*ttnpb.Rights, error) {
+	s.req.ctx, s.req.memberIDs, s.req.entityIDs = ctx, id, entityID
+	s.calls = append(s.calls, "GetMember")
+	return s.res.memberRights, s.err.getMember
+}
+
+func (s *mockStore) SetMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID ttnpb.Identifiers, rights *ttnpb.Rights) error {
+	s.req.ctx, s.req.memberIDs, s.req.entityIDs, s.req.rights = ctx, id, entityID, rights
+	s.calls = append(s.calls, "SetMember")
+	return s.err.setMember
+}
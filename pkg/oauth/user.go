@@ -27,7 +27,9 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 	"go.thethings.network/lorawan-stack/pkg/web/cookie"
 )
 
@@ -151,7 +153,22 @@ type loginRequest struct {
 	Password string `json:"password" form:"password"`
 }
 
-var errIncorrectPasswordOrUserID = errors.DefineUnauthenticated("no_user_id_password_match", "incorrect password or user ID")
+var (
+	errIncorrectPasswordOrUserID = errors.DefineUnauthenticated("no_user_id_password_match", "incorrect password or user ID")
+	errUserSuspended             = errors.DefinePermissionDenied("user_suspended", "user was suspended")
+)
+
+// requireUserNotSuspended returns an error if the user was suspended.
+func (s *server) requireUserNotSuspended(ctx context.Context, ids *ttnpb.UserIdentifiers) error {
+	user, err := s.store.GetUser(ctx, ids, &types.FieldMask{Paths: []string{"state"}})
+	if err != nil {
+		return err
+	}
+	if user.GetState() == ttnpb.STATE_SUSPENDED {
+		return errUserSuspended
+	}
+	return nil
+}
 
 func (s *server) doLogin(ctx context.Context, userID, password string) error {
 	ids := &ttnpb.UserIdentifiers{UserID: userID}
@@ -161,7 +178,7 @@ func (s *server) doLogin(ctx context.Context, userID, password string) error {
 	user, err := s.store.GetUser(
 		ctx,
 		ids,
-		&types.FieldMask{Paths: []string{"password"}},
+		&types.FieldMask{Paths: []string{"password", "state"}},
 	)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -176,9 +193,37 @@ func (s *server) doLogin(ctx context.Context, userID, password string) error {
 		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
 		return errIncorrectPasswordOrUserID
 	}
+	if user.State == ttnpb.STATE_SUSPENDED {
+		events.Publish(evtUserLoginFailed(ctx, user.UserIdentifiers, nil))
+		return errUserSuspended
+	}
+	if auth.NeedsPasswordRehash(ctx, user.Password) {
+		s.rehashPassword(ctx, user.UserIdentifiers, password)
+	}
 	return nil
 }
 
+// rehashPassword hashes the password of the user with the current password hashing
+// method and stores it. Failures are logged, so that they do not prevent the login.
+func (s *server) rehashPassword(ctx context.Context, ids ttnpb.UserIdentifiers, password string) {
+	logger := log.FromContext(ctx).WithField("user_uid", unique.ID(ctx, ids))
+	hashedPassword, err := auth.HashPassword(ctx, password)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to rehash password")
+		return
+	}
+	_, err = s.store.UpdateUser(
+		ctx,
+		&ttnpb.User{UserIdentifiers: ids, Password: hashedPassword},
+		&types.FieldMask{Paths: []string{"password"}},
+	)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to store rehashed password")
+		return
+	}
+	logger.Debug("Rehashed password")
+}
+
 func (s *server) Login(c echo.Context) error {
 	ctx := c.Request().Context()
 	req := new(loginRequest)
@@ -188,7 +233,15 @@ func (s *server) Login(c echo.Context) error {
 	if err := s.doLogin(ctx, req.UserID, req.Password); err != nil {
 		return err
 	}
-	userIDs := ttnpb.UserIdentifiers{UserID: req.UserID}
+	if err := s.createSession(c, ttnpb.UserIdentifiers{UserID: req.UserID}); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createSession creates a new session for the user and sets the auth cookie.
+func (s *server) createSession(c echo.Context, userIDs ttnpb.UserIdentifiers) error {
+	ctx := c.Request().Context()
 	session, err := s.store.CreateSession(ctx, &ttnpb.UserSession{
 		UserIdentifiers: userIDs,
 	})
@@ -196,15 +249,11 @@ func (s *server) Login(c echo.Context) error {
 		return err
 	}
 	events.Publish(evtUserLogin(ctx, userIDs, nil))
-	err = s.updateAuthCookie(c, func(cookie *authCookie) error {
+	return s.updateAuthCookie(c, func(cookie *authCookie) error {
 		cookie.UserID = session.UserID
 		cookie.SessionID = session.SessionID
 		return nil
 	})
-	if err != nil {
-		return err
-	}
-	return c.NoContent(http.StatusNoContent)
 }
 
 func (s *server) Logout(c echo.Context) error {
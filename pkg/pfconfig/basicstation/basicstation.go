@@ -0,0 +1,81 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package basicstation implements the station.conf configuration for LoRa Basic Station.
+package basicstation
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/shared"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errNoEUI = errors.DefineFailedPrecondition("no_eui", "gateway `{gateway_id}` has no EUI")
+
+// Config represents the station.conf configuration of LoRa Basic Station.
+type Config struct {
+	RadioConf   shared.SX1301Config `json:"radio_conf"`
+	StationConf StationConf         `json:"station_conf"`
+}
+
+// StationConf contains the configuration of the station process.
+type StationConf struct {
+	RouterID  basicstation.EUI `json:"routerid"`
+	LogFile   string           `json:"log_file,omitempty"`
+	LogLevel  string           `json:"log_level,omitempty"`
+	LogSize   uint32           `json:"log_size,omitempty"`
+	LogRotate uint32           `json:"log_rotate,omitempty"`
+}
+
+const (
+	defaultLogFile   = "stderr"
+	defaultLogLevel  = "INFO"
+	defaultLogSize   = 10000000
+	defaultLogRotate = 3
+)
+
+// Build builds a station.conf configuration for the given gateway, using the given frequency plan store.
+func Build(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error) {
+	if gateway.EUI == nil {
+		return nil, errNoEUI.WithAttributes("gateway_id", gateway.GatewayID)
+	}
+	frequencyPlan, err := store.GetCombined(shared.FrequencyPlanIDs(gateway)...)
+	if err != nil {
+		return nil, err
+	}
+	sx1301Config, err := shared.BuildSX1301Config(frequencyPlan)
+	if err != nil {
+		return nil, err
+	}
+	sx1301Config.AntennaGain = shared.AntennaGain(gateway)
+	// The TX frequency range and LUT are not part of the Basic Station radio configuration and would cause parsing errors.
+	for i := range sx1301Config.Radios {
+		sx1301Config.Radios[i].TxFreqMin = 0
+		sx1301Config.Radios[i].TxFreqMax = 0
+	}
+	sx1301Config.TxLUTConfigs = nil
+
+	return &Config{
+		RadioConf: *sx1301Config,
+		StationConf: StationConf{
+			RouterID:  basicstation.EUI{EUI64: *gateway.EUI},
+			LogFile:   defaultLogFile,
+			LogLevel:  defaultLogLevel,
+			LogSize:   defaultLogSize,
+			LogRotate: defaultLogRotate,
+		},
+	}, nil
+}
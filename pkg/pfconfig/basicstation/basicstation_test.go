@@ -0,0 +1,75 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basicstation_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/pkg/pfconfig/basicstation"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestBuild(t *testing.T) {
+	a := assertions.New(t)
+	store := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	_, err := Build(&ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gateway"},
+		FrequencyPlanIDs:   []string{test.EUFrequencyPlanID},
+	}, store)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	config, err := Build(&ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+			GatewayID: "test-gateway",
+			EUI:       &types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x19},
+		},
+		FrequencyPlanIDs: []string{test.EUFrequencyPlanID},
+		Antennas: []ttnpb.GatewayAntenna{
+			{Gain: 3},
+		},
+	}, store)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(config.RadioConf.AntennaGain, should.Equal, 3)
+	a.So(config.RadioConf.TxLUTConfigs, should.BeEmpty)
+	for _, radio := range config.RadioConf.Radios {
+		a.So(radio.TxFreqMin, should.BeZeroValue)
+		a.So(radio.TxFreqMax, should.BeZeroValue)
+	}
+
+	b, err := json.Marshal(config)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var res struct {
+		RadioConf   map[string]interface{} `json:"radio_conf"`
+		StationConf map[string]interface{} `json:"station_conf"`
+	}
+	if !a.So(json.Unmarshal(b, &res), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.StationConf["routerid"], should.Equal, "58a0:cbff:fe80:19")
+	a.So(res.RadioConf, should.ContainKey, "chan_multiSF_0")
+	a.So(res.RadioConf, should.NotContainKey, "tx_lut_0")
+}
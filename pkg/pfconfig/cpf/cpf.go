@@ -0,0 +1,106 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpf implements the configuration for the Kerlink Common Packet Forwarder (CPF).
+// The CPF consists of the lorad concentrator daemon, configured with JSON, and the lorafwd
+// forwarder daemon, configured with TOML.
+package cpf
+
+import (
+	"bytes"
+	"net"
+	"strconv"
+	"text/template"
+
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/shared"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// LoradConfig represents the configuration of the lorad daemon.
+type LoradConfig struct {
+	SX1301Conf shared.SX1301Config `json:"SX1301_conf"`
+}
+
+// BuildLorad builds a lorad configuration for the given gateway, using the given frequency plan store.
+func BuildLorad(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*LoradConfig, error) {
+	frequencyPlan, err := store.GetCombined(shared.FrequencyPlanIDs(gateway)...)
+	if err != nil {
+		return nil, err
+	}
+	sx1301Config, err := shared.BuildSX1301Config(frequencyPlan)
+	if err != nil {
+		return nil, err
+	}
+	sx1301Config.AntennaGain = shared.AntennaGain(gateway)
+	return &LoradConfig{
+		SX1301Conf: *sx1301Config,
+	}, nil
+}
+
+// LorafwdConfig represents the configuration of the lorafwd daemon.
+type LorafwdConfig struct {
+	GatewayID       *types.EUI64
+	Node            string
+	UplinkPort      uint16
+	DownlinkPort    uint16
+	IgnoreCRCErrors bool
+}
+
+const defaultGWMPPort = 1700
+
+// BuildLorafwd builds a lorafwd configuration for the given gateway.
+func BuildLorafwd(gateway *ttnpb.Gateway) (*LorafwdConfig, error) {
+	host, portStr, err := net.SplitHostPort(gateway.GatewayServerAddress)
+	if err != nil {
+		host = gateway.GatewayServerAddress
+		portStr = strconv.Itoa(defaultGWMPPort)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	return &LorafwdConfig{
+		GatewayID:       gateway.EUI,
+		Node:            host,
+		UplinkPort:      uint16(port),
+		DownlinkPort:    uint16(port),
+		IgnoreCRCErrors: true,
+	}, nil
+}
+
+var lorafwdTemplate = template.Must(template.New("lorafwd.toml").Parse(`# lorafwd configuration
+{{ with .GatewayID }}
+[gateway]
+id = 0x{{ . }}
+{{ end }}
+[filter]
+crc.ignore = {{ .IgnoreCRCErrors }}
+
+[gwmp]
+node = "{{ .Node }}"
+service.uplink = {{ .UplinkPort }}
+service.downlink = {{ .DownlinkPort }}
+`))
+
+// MarshalText implements encoding.TextMarshaler.
+// It encodes the lorafwd configuration as TOML.
+func (c LorafwdConfig) MarshalText() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := lorafwdTemplate.Execute(&buf, c); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,130 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpf_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/pkg/pfconfig/cpf"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestBuildLorad(t *testing.T) {
+	a := assertions.New(t)
+	store := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	config, err := BuildLorad(&ttnpb.Gateway{
+		FrequencyPlanIDs: []string{test.EUFrequencyPlanID},
+		Antennas: []ttnpb.GatewayAntenna{
+			{Gain: 2.5},
+		},
+	}, store)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(config.SX1301Conf.AntennaGain, should.Equal, 2.5)
+	a.So(config.SX1301Conf.Channels, should.HaveLength, 8)
+
+	// Gateways without frequency plan IDs use the legacy frequency plan ID.
+	config, err = BuildLorad(&ttnpb.Gateway{
+		FrequencyPlanID: test.EUFrequencyPlanID,
+	}, store)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(config.SX1301Conf.Channels, should.HaveLength, 8)
+
+	// The combined frequency plans exceed the capacity of a single concentrator.
+	_, err = BuildLorad(&ttnpb.Gateway{
+		FrequencyPlanIDs: []string{test.EUFrequencyPlanID, test.EUFrequencyPlanID},
+	}, store)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = BuildLorad(&ttnpb.Gateway{
+		FrequencyPlanIDs: []string{test.EUFrequencyPlanID, test.USFrequencyPlanID},
+	}, store)
+	a.So(err, should.NotBeNil)
+
+	_, err = BuildLorad(&ttnpb.Gateway{
+		FrequencyPlanIDs: []string{"UNKNOWN"},
+	}, store)
+	a.So(err, should.NotBeNil)
+}
+
+func TestBuildLorafwd(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Gateway  *ttnpb.Gateway
+		Expected string
+	}{
+		{
+			Name: "DefaultPort",
+			Gateway: &ttnpb.Gateway{
+				GatewayServerAddress: "localhost",
+			},
+			Expected: `# lorafwd configuration
+
+[filter]
+crc.ignore = true
+
+[gwmp]
+node = "localhost"
+service.uplink = 1700
+service.downlink = 1700
+`,
+		},
+		{
+			Name: "CustomPortWithEUI",
+			Gateway: &ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+					EUI: &types.EUI64{0x00, 0x00, 0x02, 0x4b, 0x08, 0x06, 0x01, 0x12},
+				},
+				GatewayServerAddress: "gs.example.com:1701",
+			},
+			Expected: `# lorafwd configuration
+
+[gateway]
+id = 0x0000024B08060112
+
+[filter]
+crc.ignore = true
+
+[gwmp]
+node = "gs.example.com"
+service.uplink = 1701
+service.downlink = 1701
+`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			config, err := BuildLorafwd(tc.Gateway)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			b, err := config.MarshalText()
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(b), should.Equal, tc.Expected)
+		})
+	}
+}
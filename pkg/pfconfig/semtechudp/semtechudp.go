@@ -32,6 +32,7 @@ type Config struct {
 
 // GatewayConf contains the configuration for the gateway's server connection.
 type GatewayConf struct {
+	GatewayID      string        `json:"gateway_ID,omitempty"`
 	ServerAddress  string        `json:"server_address"`
 	ServerPortUp   uint32        `json:"serv_port_up"`
 	ServerPortDown uint32        `json:"serv_port_down"`
@@ -57,7 +58,7 @@ func Build(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error)
 	server.Enabled = true
 	c.GatewayConf.Servers = append(c.GatewayConf.Servers, server)
 
-	frequencyPlan, err := store.GetByID(gateway.FrequencyPlanID)
+	frequencyPlan, err := store.GetCombined(shared.FrequencyPlanIDs(gateway)...)
 	if err != nil {
 		return nil, err
 	}
@@ -65,8 +66,22 @@ func Build(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error)
 	if err != nil {
 		return nil, err
 	}
+	sx1301Config.AntennaGain = shared.AntennaGain(gateway)
 
 	c.SX1301Conf = *sx1301Config
 
 	return &c, nil
 }
+
+// BuildMultitech builds a packet forwarder configuration for Multitech Conduit gateways.
+// The Multitech packet forwarder requires the gateway EUI to be part of the gateway configuration.
+func BuildMultitech(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error) {
+	c, err := Build(gateway, store)
+	if err != nil {
+		return nil, err
+	}
+	if gateway.EUI != nil {
+		c.GatewayConf.GatewayID = gateway.EUI.String()
+	}
+	return c, nil
+}
@@ -26,6 +26,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
 )
 
 func TestBuild(t *testing.T) {
@@ -130,6 +132,29 @@ func TestBuild(t *testing.T) {
 
 }
 
+func TestBuildMultitech(t *testing.T) {
+	a := assertions.New(t)
+	store := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	config, err := BuildMultitech(&ttnpb.Gateway{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+			EUI: &types.EUI64{0x00, 0x80, 0x00, 0x00, 0xa0, 0x00, 0x12, 0x34},
+		},
+		FrequencyPlanIDs:     []string{test.EUFrequencyPlanID},
+		GatewayServerAddress: "gs.example.com:1701",
+		Antennas: []ttnpb.GatewayAntenna{
+			{Gain: 3},
+		},
+	}, store)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(config.GatewayConf.GatewayID, should.Equal, "00800000A0001234")
+	a.So(config.GatewayConf.ServerAddress, should.Equal, "gs.example.com")
+	a.So(config.GatewayConf.ServerPortUp, should.Equal, 1701)
+	a.So(config.SX1301Conf.AntennaGain, should.Equal, 3)
+}
+
 func removeDescs(m map[string]interface{}) {
 	for k, v := range m {
 		if strings.HasSuffix(k, "desc") {
@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 // SX1301Config contains the configuration for the SX1301 concentrator.
@@ -199,12 +200,30 @@ var defaultTxLUTConfigs = []TxLUTConfig{
 	{PAGain: 3, MixGain: 14, RFPower: 27},
 }
 
+const (
+	sx1301Radios          = 2
+	sx1301MultiSFChannels = 8
+)
+
+var (
+	errTooManyRadios   = errors.DefineInvalidArgument("too_many_radios", "frequency plan has `{count}` radios, but an SX1301 concentrator has `{max}`")
+	errTooManyChannels = errors.DefineInvalidArgument("too_many_channels", "frequency plan has `{count}` uplink channels, but an SX1301 concentrator has `{max}`")
+)
+
 // BuildSX1301Config builds the SX1301 configuration for the given frequency plan.
+// Frequency plans that exceed the radios or multi-SF channels of a single SX1301 concentrator, such as combined
+// frequency plans of gateways with multiple concentrators, are rejected.
 func BuildSX1301Config(frequencyPlan *frequencyplans.FrequencyPlan) (*SX1301Config, error) {
 	band, err := band.GetByID(frequencyPlan.BandID)
 	if err != nil {
 		return nil, err
 	}
+	if n := len(frequencyPlan.Radios); n > sx1301Radios {
+		return nil, errTooManyRadios.WithAttributes("count", n, "max", sx1301Radios)
+	}
+	if n := len(frequencyPlan.UplinkChannels); n > sx1301MultiSFChannels {
+		return nil, errTooManyChannels.WithAttributes("count", n, "max", sx1301MultiSFChannels)
+	}
 
 	conf := new(SX1301Config)
 
@@ -248,11 +267,7 @@ func BuildSX1301Config(frequencyPlan *frequencyplans.FrequencyPlan) (*SX1301Conf
 		conf.Radios[i] = rfConfig
 	}
 
-	numChannels := len(frequencyPlan.UplinkChannels)
-	if numChannels < 8 {
-		numChannels = 8
-	}
-	conf.Channels = make([]IFConfig, numChannels)
+	conf.Channels = make([]IFConfig, sx1301MultiSFChannels)
 	for i, channel := range frequencyPlan.UplinkChannels {
 		ifConfig := IFConfig{
 			Enable:  true,
@@ -292,3 +307,20 @@ func BuildSX1301Config(frequencyPlan *frequencyplans.FrequencyPlan) (*SX1301Conf
 
 	return conf, nil
 }
+
+// AntennaGain returns the gain of the first antenna of the gateway, or 0 if the gateway has no antennas.
+func AntennaGain(gateway *ttnpb.Gateway) float32 {
+	if len(gateway.Antennas) == 0 {
+		return 0
+	}
+	return gateway.Antennas[0].Gain
+}
+
+// FrequencyPlanIDs returns the frequency plan IDs of the gateway.
+// If the gateway has no frequency plan IDs, the legacy frequency plan ID is used.
+func FrequencyPlanIDs(gateway *ttnpb.Gateway) []string {
+	if len(gateway.FrequencyPlanIDs) == 0 && gateway.FrequencyPlanID != "" {
+		return []string{gateway.FrequencyPlanID}
+	}
+	return gateway.FrequencyPlanIDs
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/smartystreets/assertions"
 	"github.com/smartystreets/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 )
 
@@ -149,5 +150,50 @@ func TestSX1301Conf(t *testing.T) {
 			}
 		})
 	}
+}
+
+func TestSX1301ConfCombined(t *testing.T) {
+	a := assertions.New(t)
+
+	eightChannelPlan := func(radioFrequencies ...uint64) *frequencyplans.FrequencyPlan {
+		fp := &frequencyplans.FrequencyPlan{
+			BandID: "EU_863_870",
+		}
+		for i, frequency := range radioFrequencies {
+			fp.Radios = append(fp.Radios, frequencyplans.Radio{
+				Enable:    true,
+				ChipType:  "SX1257",
+				Frequency: frequency,
+			})
+			for j := uint64(0); j < 4; j++ {
+				fp.UplinkChannels = append(fp.UplinkChannels, frequencyplans.Channel{
+					Frequency:   frequency - 300000 + j*200000,
+					Radio:       uint8(i),
+					MaxDataRate: 5,
+				})
+			}
+		}
+		return fp
+	}
+	fp1 := eightChannelPlan(867500000, 868500000)
+	fp2 := eightChannelPlan(865500000, 866500000)
+
+	for _, fp := range []*frequencyplans.FrequencyPlan{fp1, fp2} {
+		cfg, err := BuildSX1301Config(fp)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(cfg.Radios, should.HaveLength, 2)
+		a.So(cfg.Channels, should.HaveLength, 8)
+	}
+
+	combined, err := frequencyplans.Combine(fp1, fp2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(combined.Radios, should.HaveLength, 4)
+	a.So(combined.UplinkChannels, should.HaveLength, 16)
 
+	_, err = BuildSX1301Config(combined)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
 }
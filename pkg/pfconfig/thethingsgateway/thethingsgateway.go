@@ -0,0 +1,97 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package thethingsgateway implements the JSON configuration for The Things Gateway.
+package thethingsgateway
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/pfconfig/shared"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Config represents the configuration of The Things Gateway.
+type Config struct {
+	FrequencyPlanID string              `json:"frequency_plan"`
+	SX1301Conf      shared.SX1301Config `json:"SX1301_conf"`
+	Router          RouterConf          `json:"router"`
+	AutoUpdate      bool                `json:"auto_update"`
+	UpdateChannel   string              `json:"update_channel,omitempty"`
+}
+
+// RouterConf contains the configuration of the MQTT connection to the Gateway Server.
+type RouterConf struct {
+	MQTTAddress string `json:"mqtt_address"`
+}
+
+const defaultMQTTPort = "8882"
+
+var errFrequencyPlanCount = errors.DefineInvalidArgument(
+	"frequency_plan_count",
+	"The Things Gateway supports one frequency plan, but the gateway has `{count}`",
+)
+
+// Build builds a configuration for The Things Gateway, using the given frequency plan store.
+// The Things Gateway supports a single frequency plan.
+func Build(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error) {
+	frequencyPlanIDs := shared.FrequencyPlanIDs(gateway)
+	if len(frequencyPlanIDs) != 1 {
+		return nil, errFrequencyPlanCount.WithAttributes("count", len(frequencyPlanIDs))
+	}
+	frequencyPlan, err := store.GetByID(frequencyPlanIDs[0])
+	if err != nil {
+		return nil, err
+	}
+	sx1301Config, err := shared.BuildSX1301Config(frequencyPlan)
+	if err != nil {
+		return nil, err
+	}
+	sx1301Config.AntennaGain = shared.AntennaGain(gateway)
+	// The Things Gateway uses its own TX power calibration.
+	sx1301Config.TxLUTConfigs = nil
+
+	mqttAddress, err := mqttAddress(gateway.GatewayServerAddress)
+	if err != nil {
+		return nil, err
+	}
+	return &Config{
+		FrequencyPlanID: frequencyPlanIDs[0],
+		SX1301Conf:      *sx1301Config,
+		Router: RouterConf{
+			MQTTAddress: mqttAddress,
+		},
+		AutoUpdate:    gateway.AutoUpdate,
+		UpdateChannel: gateway.UpdateChannel,
+	}, nil
+}
+
+// mqttAddress prepends the scheme "mqtts" and appends the default port if they are not in the address.
+func mqttAddress(address string) (string, error) {
+	if address == "" || strings.Contains(address, "://") {
+		return address, nil
+	}
+	host, port := address, defaultMQTTPort
+	if strings.Contains(address, ":") {
+		var err error
+		if host, port, err = net.SplitHostPort(address); err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("mqtts://%s:%s", host, port), nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thethingsgateway_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/pkg/pfconfig/thethingsgateway"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestBuild(t *testing.T) {
+	store := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	for _, tc := range []struct {
+		Name        string
+		Address     string
+		MQTTAddress string
+	}{
+		{
+			Name:        "Host",
+			Address:     "gs.example.com",
+			MQTTAddress: "mqtts://gs.example.com:8882",
+		},
+		{
+			Name:        "HostPort",
+			Address:     "gs.example.com:1883",
+			MQTTAddress: "mqtts://gs.example.com:1883",
+		},
+		{
+			Name:        "URL",
+			Address:     "mqtt://gs.example.com:1883",
+			MQTTAddress: "mqtt://gs.example.com:1883",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			config, err := Build(&ttnpb.Gateway{
+				FrequencyPlanID:      test.EUFrequencyPlanID,
+				GatewayServerAddress: tc.Address,
+				AutoUpdate:           true,
+				UpdateChannel:        "stable",
+			}, store)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(config.FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+			a.So(config.Router.MQTTAddress, should.Equal, tc.MQTTAddress)
+			a.So(config.AutoUpdate, should.BeTrue)
+			a.So(config.UpdateChannel, should.Equal, "stable")
+			a.So(config.SX1301Conf.TxLUTConfigs, should.BeEmpty)
+		})
+	}
+}
+
+func TestBuildFrequencyPlanIDs(t *testing.T) {
+	a := assertions.New(t)
+	store := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	config, err := Build(&ttnpb.Gateway{
+		FrequencyPlanIDs: []string{test.EUFrequencyPlanID},
+	}, store)
+	if a.So(err, should.BeNil) {
+		a.So(config.FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+	}
+
+	_, err = Build(&ttnpb.Gateway{
+		FrequencyPlanIDs: []string{test.EUFrequencyPlanID, test.EUFrequencyPlanID},
+	}, store)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
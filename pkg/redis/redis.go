@@ -68,8 +68,9 @@ func Key(ks ...string) string {
 
 // Client represents a Redis store client.
 type Client struct {
-	*redis.Client
+	redis.UniversalClient
 	namespace string
+	cluster   bool
 }
 
 // Config represents Redis configuration.
@@ -78,15 +79,39 @@ type Config struct {
 	Namespace []string
 }
 
-// New returns a new initialized Redis store.
-func New(conf *Config) *Client {
-	return &Client{
-		namespace: Key(append(conf.Redis.Namespace, conf.Namespace...)...),
-		Client: redis.NewClient(&redis.Options{
+// NewClient returns a new Redis client for the configuration.
+// Depending on the configuration, this is a client of Redis Sentinel, Redis Cluster or a single Redis server.
+func NewClient(conf config.Redis) redis.UniversalClient {
+	switch {
+	case conf.Failover.Enable:
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:    conf.Failover.MasterName,
+			SentinelAddrs: conf.Failover.Addresses,
+			Password:      conf.Password,
+			DB:            conf.Database,
+		})
+	case conf.Cluster.Enable:
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:    conf.Cluster.Addresses,
+			Password: conf.Password,
+		})
+	default:
+		return redis.NewClient(&redis.Options{
 			Addr:     conf.Address,
 			Password: conf.Password,
 			DB:       conf.Database,
-		}),
+		})
+	}
+}
+
+// New returns a new initialized Redis store.
+// With Redis Cluster, keys are distributed over the nodes by their hash slot. Keys that are used together in a
+// transaction or script must be in the same hash slot; see HashTag and SlotKey.
+func New(conf *Config) *Client {
+	return &Client{
+		namespace:       Key(append(conf.Redis.Namespace, conf.Namespace...)...),
+		cluster:         conf.Cluster.Enable,
+		UniversalClient: NewClient(conf.Redis),
 	}
 }
 
@@ -95,6 +120,25 @@ func (cl *Client) Key(ks ...string) string {
 	return Key(append([]string{cl.namespace}, ks...)...)
 }
 
+// HashTag returns k as a hash tag if the client uses Redis Cluster, so that all keys that contain the hash tag are in
+// the same hash slot. HashTag should wrap the part of the key that identifies the entity, like the application UID,
+// so that keys of different entities are distributed over the nodes.
+// Without Redis Cluster, HashTag returns k, so that keys do not change.
+func (cl *Client) HashTag(k string) string {
+	if !cl.cluster {
+		return k
+	}
+	return "{" + k + "}"
+}
+
+// SlotKey constructs the full key like Key. With Redis Cluster, the namespace is a hash tag, so that all keys of the
+// namespace that are constructed with SlotKey are in the same hash slot.
+// SlotKey should only be used for keys of different entities that are used together in a transaction or script,
+// like indexes that must be consistent with the entities. Otherwise, use Key with HashTag.
+func (cl *Client) SlotKey(ks ...string) string {
+	return Key(append([]string{cl.HashTag(cl.namespace)}, ks...)...)
+}
+
 // ProtoCmd is a command, which can unmarshal its result into a protocol buffer.
 type ProtoCmd struct {
 	result func() (string, error)
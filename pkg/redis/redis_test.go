@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-redis/redis"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	. "go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -31,6 +32,80 @@ import (
 
 var Timeout = 10 * test.Delay
 
+func TestNew(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		Config    config.Redis
+		Assertion func(redis.UniversalClient) bool
+		Key       string
+		TaggedKey string
+		SlotKey   string
+	}{
+		{
+			Name: "Single",
+			Config: config.Redis{
+				Address:   "localhost:6379",
+				Namespace: []string{"ttn", "v3"},
+			},
+			Assertion: func(cl redis.UniversalClient) bool {
+				_, ok := cl.(*redis.Client)
+				return ok
+			},
+			Key:       "ttn:v3:ns:devices:foo",
+			TaggedKey: "ttn:v3:ns:devices:foo:bar",
+			SlotKey:   "ttn:v3:ns:devices:foo",
+		},
+		{
+			Name: "Failover",
+			Config: config.Redis{
+				Namespace: []string{"ttn", "v3"},
+				Failover: config.RedisFailover{
+					Enable:     true,
+					MasterName: "master",
+					Addresses:  []string{"localhost:26379", "localhost:26380"},
+				},
+			},
+			Assertion: func(cl redis.UniversalClient) bool {
+				_, ok := cl.(*redis.Client)
+				return ok
+			},
+			Key:       "ttn:v3:ns:devices:foo",
+			TaggedKey: "ttn:v3:ns:devices:foo:bar",
+			SlotKey:   "ttn:v3:ns:devices:foo",
+		},
+		{
+			Name: "Cluster",
+			Config: config.Redis{
+				Namespace: []string{"ttn", "v3"},
+				Cluster: config.RedisCluster{
+					Enable:    true,
+					Addresses: []string{"localhost:7000", "localhost:7001"},
+				},
+			},
+			Assertion: func(cl redis.UniversalClient) bool {
+				_, ok := cl.(*redis.ClusterClient)
+				return ok
+			},
+			Key:       "ttn:v3:ns:devices:foo",
+			TaggedKey: "ttn:v3:ns:devices:{foo}:bar",
+			SlotKey:   "{ttn:v3:ns}:devices:foo",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			cl := New(&Config{
+				Redis:     tc.Config,
+				Namespace: []string{"ns"},
+			})
+			defer cl.Close()
+			a.So(tc.Assertion(cl.UniversalClient), should.BeTrue)
+			a.So(cl.Key("devices", "foo"), should.Equal, tc.Key)
+			a.So(cl.Key("devices", cl.HashTag("foo"), "bar"), should.Equal, tc.TaggedKey)
+			a.So(cl.SlotKey("devices", "foo"), should.Equal, tc.SlotKey)
+		})
+	}
+}
+
 func TestAddTask(t *testing.T) {
 	a := assertions.New(t)
 
@@ -43,7 +118,7 @@ func TestAddTask(t *testing.T) {
 		t.FailNow()
 	}
 
-	rets, err := cl.Client.XRead(&redis.XReadArgs{
+	rets, err := cl.UniversalClient.XRead(&redis.XReadArgs{
 		Streams: []string{InputTaskKey(cl.Key("testKey")), "0"},
 		Count:   10,
 		Block:   -1,
@@ -71,7 +146,7 @@ func TestAddTask(t *testing.T) {
 		t.FailNow()
 	}
 
-	rets, err = cl.Client.XRead(&redis.XReadArgs{
+	rets, err = cl.UniversalClient.XRead(&redis.XReadArgs{
 		Streams: []string{InputTaskKey(cl.Key("testKey")), "0"},
 		Count:   10,
 		Block:   -1,
@@ -187,7 +262,7 @@ func TestDispatchTasks(t *testing.T) {
 			},
 		},
 	} {
-		_, err := cl.Client.XAdd(x).Result()
+		_, err := cl.UniversalClient.XAdd(x).Result()
 		if !a.So(err, should.BeNil) {
 			t.FailNow()
 		}
@@ -348,7 +423,7 @@ func TestPopTask(t *testing.T) {
 			},
 		},
 	} {
-		_, err := cl.Client.XAdd(x).Result()
+		_, err := cl.UniversalClient.XAdd(x).Result()
 		if !a.So(err, should.BeNil) {
 			t.FailNow()
 		}
@@ -0,0 +1,88 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcmetadata
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// inProcessNetwork is the network of the peer address of loopback connections, such as the connections of the HTTP gateway.
+const inProcessNetwork = "in-process"
+
+type trustedProxiesKeyType struct{}
+
+var trustedProxiesKey trustedProxiesKeyType
+
+// NewContextWithTrustedProxies returns a derived context with the networks of the trusted proxies.
+// Trusted proxies are allowed to set the X-Forwarded-For metadata of the requests that they forward.
+func NewContextWithTrustedProxies(ctx context.Context, trustedProxies []*net.IPNet) context.Context {
+	return context.WithValue(ctx, trustedProxiesKey, trustedProxies)
+}
+
+func isTrustedProxy(ctx context.Context, ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	trustedProxies, _ := ctx.Value(trustedProxiesKey).([]*net.IPNet)
+	for _, ipNet := range trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func hostIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// SourceIP returns the IP address of the client that the request originates from.
+// The address of the transport peer is used, unless the peer is the HTTP gateway or a trusted proxy.
+// In that case, the X-Forwarded-For metadata is walked from the right, skipping the addresses of
+// trusted proxies, so that addresses that are added by the client itself are never used.
+func SourceIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	sourceIP := hostIP(p.Addr.String())
+	inProcess := p.Addr.Network() == inProcessNetwork
+	if !inProcess && !isTrustedProxy(ctx, net.ParseIP(sourceIP)) {
+		return sourceIP
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	var forwardedFor []string
+	for _, header := range md.Get("x-forwarded-for") {
+		forwardedFor = append(forwardedFor, strings.Split(header, ",")...)
+	}
+	if inProcess {
+		sourceIP = ""
+	}
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		sourceIP = strings.TrimSpace(forwardedFor[i])
+		if !isTrustedProxy(ctx, net.ParseIP(sourceIP)) {
+			break
+		}
+	}
+	return sourceIP
+}
@@ -0,0 +1,94 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcmetadata_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+	. "go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+type addr string
+
+func (addr) Network() string  { return "tcp" }
+func (a addr) String() string { return string(a) }
+
+type inProcessAddr struct{}
+
+func (inProcessAddr) Network() string { return "in-process" }
+func (inProcessAddr) String() string  { return "in-process" }
+
+func TestSourceIP(t *testing.T) {
+	_, trusted, _ := net.ParseCIDR("10.0.0.0/8")
+	for _, tc := range []struct {
+		Name           string
+		Peer           *peer.Peer
+		ForwardedFor   []string
+		TrustedProxies []*net.IPNet
+		SourceIP       string
+	}{
+		{
+			Name:     "NoPeer",
+			SourceIP: "",
+		},
+		{
+			Name:     "Peer",
+			Peer:     &peer.Peer{Addr: addr("192.0.2.1:1234")},
+			SourceIP: "192.0.2.1",
+		},
+		{
+			Name:         "UntrustedForwardedFor",
+			Peer:         &peer.Peer{Addr: addr("192.0.2.1:1234")},
+			ForwardedFor: []string{"198.51.100.1"},
+			SourceIP:     "192.0.2.1",
+		},
+		{
+			Name:           "TrustedProxy",
+			Peer:           &peer.Peer{Addr: addr("10.0.0.1:1234")},
+			ForwardedFor:   []string{"198.51.100.1, 192.0.2.1, 10.0.0.2"},
+			TrustedProxies: []*net.IPNet{trusted},
+			SourceIP:       "192.0.2.1",
+		},
+		{
+			Name:         "HTTPGateway",
+			Peer:         &peer.Peer{Addr: inProcessAddr{}},
+			ForwardedFor: []string{"198.51.100.1, 192.0.2.1"},
+			SourceIP:     "192.0.2.1",
+		},
+		{
+			Name:     "Loopback",
+			Peer:     &peer.Peer{Addr: inProcessAddr{}},
+			SourceIP: "",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			ctx := test.Context()
+			if tc.Peer != nil {
+				ctx = peer.NewContext(ctx, tc.Peer)
+			}
+			if tc.ForwardedFor != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{"x-forwarded-for": tc.ForwardedFor})
+			}
+			ctx = NewContextWithTrustedProxies(ctx, tc.TrustedProxies)
+			assertions.New(t).So(SourceIP(ctx), should.Equal, tc.SourceIP)
+		})
+	}
+}
@@ -17,6 +17,7 @@ package rpcserver
 import (
 	"context"
 	"net"
+	"net/url"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/version"
@@ -93,13 +94,21 @@ func (a inProcessAddr) String() string { return string(a) }
 
 func (l inProcessListener) Addr() net.Addr { return inProcessAddr("in-process") }
 
+// inProcessConn is the server side of a loopback connection. Its remote address identifies the peer
+// of calls over the loopback connection, as the server does not use the in-process transport credentials.
+type inProcessConn struct {
+	net.Conn
+}
+
+func (inProcessConn) RemoteAddr() net.Addr { return inProcessAddr("in-process") }
+
 func inProcessDialer(lis *inProcessListener) func(string, time.Duration) (net.Conn, error) {
 	return func(addr string, timeout time.Duration) (net.Conn, error) {
 		server, client := net.Pipe()
 		select {
 		case <-time.After(timeout):
 			return nil, context.DeadlineExceeded
-		case lis.ch <- server:
+		case lis.ch <- inProcessConn{Conn: server}:
 			return client, nil
 		}
 	}
@@ -117,3 +126,9 @@ func StartLoopback(ctx context.Context, s *grpc.Server, opts ...grpc.DialOption)
 			grpc.WithTransportCredentials(&inProcessCredentials{}),
 		}, opts...)...)
 }
+
+// IsLoopbackURI returns whether the URI passed to credentials.PerRPCCredentials is of a call over a loopback connection.
+func IsLoopbackURI(uri string) bool {
+	u, err := url.Parse(uri)
+	return err == nil && u.Host == inProcess
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"runtime/debug"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/getsentry/raven-go"
@@ -32,6 +33,7 @@ import (
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.opencensus.io/plugin/ocgrpc"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/fillcontext"
@@ -235,7 +237,29 @@ type Server struct {
 	*runtime.ServeMux
 }
 
-// ServeHTTP forwards requests to the gRPC gateway
+var errClusterAuthOverHTTP = errors.DefineUnauthenticated("cluster_auth_http", "cluster authentication is not supported over HTTP")
+
+// hasClusterAuth returns whether the request authenticates as a cluster peer, either in the Authorization header
+// or in the Authorization metadata header that the gRPC gateway forwards.
+func hasClusterAuth(r *http.Request) bool {
+	for _, key := range []string{"Authorization", runtime.MetadataHeaderPrefix + "Authorization"} {
+		for _, value := range r.Header[http.CanonicalHeaderKey(key)] {
+			if clusterauth.IsAuthType(strings.SplitN(value, " ", 2)[0]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ServeHTTP forwards requests to the gRPC gateway.
+// Requests that authenticate as a cluster peer are rejected, as the gateway calls the gRPC services
+// over the loopback connection, where the transport does not identify the caller.
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if hasClusterAuth(r) {
+		_, outboundMarshaler := runtime.MarshalerForRequest(s.ServeMux, r)
+		runtime.HTTPError(r.Context(), s.ServeMux, outboundMarshaler, w, r, errClusterAuthOverHTTP)
+		return
+	}
 	s.ServeMux.ServeHTTP(w, r)
 }
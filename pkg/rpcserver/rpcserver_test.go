@@ -16,6 +16,9 @@ package rpcserver_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/types"
@@ -99,7 +102,7 @@ func TestNewRPCServer(t *testing.T) {
 		a.So(mock.pushCtx, should.NotBeNil)
 		a.So(mock.pushCtx.Value(&mockKey{}), should.Resemble, "foo")
 		a.So(grpc_ctxtags.Extract(mock.pushCtx).Values(), should.Resemble, map[string]interface{}{
-			"peer.address":        "pipe",
+			"peer.address":        "in-process",
 			"grpc.request.method": "/ttn.lorawan.v3.AppAs/DownlinkQueuePush",
 			"grpc.request.foo":    "bar",
 		})
@@ -124,7 +127,7 @@ func TestNewRPCServer(t *testing.T) {
 
 		a.So(mock.subCtx.Value(&mockKey{}), should.Resemble, "foo")
 		a.So(grpc_ctxtags.Extract(mock.subCtx).Values(), should.Resemble, map[string]interface{}{
-			"peer.address":        "pipe",
+			"peer.address":        "in-process",
 			"grpc.request.method": "/ttn.lorawan.v3.AppAs/Subscribe",
 			"grpc.request.foo":    "bar",
 		})
@@ -132,6 +135,66 @@ func TestNewRPCServer(t *testing.T) {
 
 		a.So(logHandler.entries, should.HaveLength, 2)
 	})
+
+	if err := ttnpb.RegisterAppAsHandler(ctx, server.ServeMux, loopbackConn); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Header         string
+		Value          string
+		ExpectedStatus int
+	}{
+		{
+			Name:           "HTTP/Bearer",
+			Header:         "Authorization",
+			Value:          "Bearer token",
+			ExpectedStatus: http.StatusOK,
+		},
+		{
+			Name:           "HTTP/ClusterKey",
+			Header:         "Authorization",
+			Value:          "ClusterKey 2a9c2c3c",
+			ExpectedStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:           "HTTP/ClusterCertificate",
+			Header:         "Authorization",
+			Value:          "ClusterCertificate gs",
+			ExpectedStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:           "HTTP/ClusterLoopback",
+			Header:         "Authorization",
+			Value:          "ClusterLoopback 2a9c2c3c",
+			ExpectedStatus: http.StatusUnauthorized,
+		},
+		{
+			Name:           "HTTP/Metadata/ClusterCertificate",
+			Header:         "Grpc-Metadata-Authorization",
+			Value:          "ClusterCertificate gs",
+			ExpectedStatus: http.StatusUnauthorized,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			mock.pushReq = nil
+
+			req := httptest.NewRequest(http.MethodPost, "/as/applications/bar/devices/foo/down/push", strings.NewReader("{}"))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(tc.Header, tc.Value)
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+
+			a.So(rec.Code, should.Equal, tc.ExpectedStatus)
+			if tc.ExpectedStatus == http.StatusOK {
+				a.So(mock.pushReq, should.NotBeNil)
+			} else {
+				a.So(mock.pushReq, should.BeNil)
+			}
+		})
+	}
 }
 
 type mockKey struct{}
@@ -39,6 +39,7 @@ type Application struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt              time.Time         `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	UpdatedAt              time.Time         `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	DeletedAt              *time.Time        `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
 	Name                   string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Description            string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes             map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -93,6 +94,13 @@ func (m *Application) GetUpdatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Application) GetDeletedAt() *time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
 func (m *Application) GetName() string {
 	if m != nil {
 		return m.Name
@@ -221,7 +229,9 @@ type ListApplicationsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted applications.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -293,6 +303,13 @@ func (m *ListApplicationsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListApplicationsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateApplicationRequest struct {
 	Application `protobuf:"bytes,1,opt,name=application,proto3,embedded=application" json:"application"`
 	// Collaborator to grant all rights on the newly created application.
@@ -491,10 +508,12 @@ func (m *GetApplicationAPIKeyRequest) GetKeyID() string {
 
 type CreateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	Name                   string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                 []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                 []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt              *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs           []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}   `json:"-"`
+	XXX_sizecache          int32      `json:"-"`
 }
 
 func (m *CreateApplicationAPIKeyRequest) Reset()      { *m = CreateApplicationAPIKeyRequest{} }
@@ -543,11 +562,28 @@ func (m *CreateApplicationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateApplicationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateApplicationAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateApplicationAPIKeyRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	APIKey                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	// The names of the API key fields that should be updated.
+	// If this is not set, the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateApplicationAPIKeyRequest) Reset()      { *m = UpdateApplicationAPIKeyRequest{} }
@@ -582,6 +618,13 @@ func (m *UpdateApplicationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateApplicationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateApplicationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListApplicationCollaboratorsRequest struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	// Limit the number of results per page.
@@ -762,76 +805,82 @@ func init() {
 }
 
 var fileDescriptor_57d90136b1f4f7b1 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xde, 0xf1, 0x4f, 0x52, 0x8f, 0xd3, 0x24, 0x5a, 0x51, 0x58, 0x25, 0x65, 0xe2, 0x6e, 0xa3,
-	0x2a, 0x2d, 0xf1, 0x1a, 0xb9, 0x17, 0xa8, 0x80, 0xc8, 0x1b, 0x7e, 0x14, 0x02, 0x04, 0x16, 0x7a,
-	0xa1, 0x2a, 0xd6, 0xd8, 0x1e, 0x6f, 0x46, 0xb6, 0x77, 0x97, 0xd9, 0x71, 0xca, 0x16, 0x21, 0x55,
-	0x9c, 0x2a, 0x4e, 0x15, 0x27, 0xc4, 0x09, 0xf5, 0x80, 0x7a, 0xe0, 0xd0, 0x13, 0xaa, 0x04, 0x87,
-	0x9e, 0x50, 0x0e, 0x1c, 0x72, 0x42, 0x3d, 0x85, 0x7a, 0x7d, 0x89, 0xc4, 0xa5, 0x37, 0xaa, 0x9c,
-	0xd0, 0xce, 0xae, 0xe3, 0xf5, 0x0f, 0x91, 0xa0, 0x95, 0xd5, 0xdb, 0xcc, 0xce, 0xf7, 0xde, 0xfb,
-	0xde, 0x9b, 0xf7, 0xcd, 0x5b, 0x78, 0xb6, 0x69, 0x33, 0x7c, 0x0d, 0x5b, 0x79, 0x97, 0xe3, 0x6a,
-	0xa3, 0x80, 0x1d, 0x5a, 0xc0, 0x8e, 0xd3, 0xa4, 0x55, 0xcc, 0xa9, 0x6d, 0x69, 0x0e, 0xb3, 0xb9,
-	0x2d, 0xcf, 0x72, 0x6e, 0x69, 0x11, 0x50, 0xdb, 0xb9, 0xb8, 0x50, 0x32, 0x29, 0xdf, 0x6e, 0x57,
-	0xb4, 0xaa, 0xdd, 0x2a, 0x10, 0x6b, 0xc7, 0xf6, 0x1c, 0x66, 0x7f, 0xe1, 0x15, 0x04, 0xb8, 0x9a,
-	0x37, 0x89, 0x95, 0xdf, 0xc1, 0x4d, 0x5a, 0xc3, 0x9c, 0x14, 0x46, 0x16, 0xa1, 0xcb, 0x85, 0x7c,
-	0xcc, 0x85, 0x69, 0x9b, 0x76, 0x68, 0x5c, 0x69, 0xd7, 0xc5, 0x4e, 0x6c, 0xc4, 0x2a, 0x82, 0x9f,
-	0x36, 0x6d, 0xdb, 0x6c, 0x92, 0x90, 0x9f, 0x65, 0xd9, 0x5c, 0xd0, 0x73, 0xa3, 0xd3, 0x5c, 0x74,
-	0x7a, 0xe4, 0xa3, 0x4e, 0x49, 0xb3, 0x56, 0x6e, 0x61, 0xb7, 0x11, 0x21, 0x96, 0x86, 0x11, 0x9c,
-	0xb6, 0x88, 0xcb, 0x71, 0xcb, 0x89, 0x00, 0xcb, 0xa3, 0x75, 0xa8, 0xda, 0x16, 0xc7, 0x55, 0x5e,
-	0xa6, 0x56, 0xbd, 0x47, 0x63, 0x4c, 0xb5, 0x68, 0x8d, 0x58, 0x9c, 0xd6, 0x29, 0x61, 0x3d, 0x36,
-	0x68, 0x14, 0xc4, 0xa8, 0xb9, 0xcd, 0xa3, 0x73, 0xf5, 0xc7, 0x14, 0xcc, 0x96, 0xfa, 0x35, 0x96,
-	0xdf, 0x85, 0x49, 0x5a, 0x73, 0x15, 0x90, 0x03, 0x2b, 0xd9, 0xe2, 0x39, 0x6d, 0xb0, 0xd6, 0x5a,
-	0x0c, 0xb9, 0xd1, 0x0f, 0xa5, 0xcf, 0x1f, 0xea, 0xe9, 0x6f, 0x40, 0x62, 0x1e, 0xec, 0xee, 0x2f,
-	0x49, 0x7b, 0xfb, 0x4b, 0xc0, 0x08, 0x9c, 0xc8, 0xeb, 0x10, 0x56, 0x19, 0xc1, 0x9c, 0xd4, 0xca,
-	0x98, 0x2b, 0x09, 0xe1, 0x72, 0x41, 0x0b, 0x93, 0xd7, 0x7a, 0xc9, 0x6b, 0x9f, 0xf4, 0x92, 0xd7,
-	0x4f, 0x04, 0xe6, 0xb7, 0xfe, 0x5c, 0x02, 0x46, 0x26, 0xb2, 0x2b, 0xf1, 0xc0, 0x49, 0xdb, 0xa9,
-	0xf5, 0x9c, 0x24, 0xff, 0x8b, 0x93, 0xc8, 0xae, 0xc4, 0xe5, 0x45, 0x98, 0xb2, 0x70, 0x8b, 0x28,
-	0xa9, 0x1c, 0x58, 0xc9, 0xe8, 0xd3, 0x87, 0x7a, 0x8a, 0x25, 0x94, 0xa2, 0x21, 0x3e, 0xca, 0x17,
-	0x60, 0xb6, 0x46, 0xdc, 0x2a, 0xa3, 0x4e, 0x90, 0x97, 0x92, 0x16, 0x98, 0x13, 0x87, 0x7a, 0x9a,
-	0x25, 0x95, 0xbd, 0x39, 0x23, 0x7e, 0x28, 0x7b, 0x10, 0x62, 0xce, 0x19, 0xad, 0xb4, 0x39, 0x71,
-	0x95, 0xa9, 0x5c, 0x72, 0x25, 0x5b, 0x7c, 0xe9, 0x98, 0x2a, 0x69, 0xa5, 0x23, 0xf4, 0x5b, 0x16,
-	0x67, 0x9e, 0xbe, 0x7a, 0xa8, 0x9f, 0xff, 0x1e, 0x9c, 0x53, 0x97, 0x99, 0xaa, 0x2c, 0x17, 0xd1,
-	0x67, 0x57, 0x70, 0xfe, 0xfa, 0xcb, 0xf9, 0x57, 0xaf, 0xae, 0xac, 0x5d, 0xba, 0x92, 0xbf, 0xba,
-	0xd6, 0xdb, 0x9e, 0xff, 0xb2, 0xb8, 0xfa, 0xd5, 0xb2, 0x11, 0x0b, 0x26, 0xbf, 0x01, 0x67, 0xe2,
-	0x4d, 0xa0, 0x4c, 0x8b, 0xe0, 0x8b, 0xc3, 0xc1, 0xd7, 0x43, 0xcc, 0x86, 0x55, 0xb7, 0x8d, 0x6c,
-	0xb5, 0xbf, 0x59, 0x78, 0x1d, 0xce, 0x0d, 0x91, 0x91, 0xe7, 0x61, 0xb2, 0x41, 0x3c, 0x71, 0xd9,
-	0x19, 0x23, 0x58, 0xca, 0xcf, 0xc1, 0xf4, 0x0e, 0x6e, 0xb6, 0x89, 0xb8, 0xad, 0x8c, 0x11, 0x6e,
-	0x2e, 0x25, 0x5e, 0x01, 0xea, 0x16, 0x9c, 0x89, 0xe5, 0xe5, 0xca, 0x6b, 0x70, 0x26, 0xa6, 0xcd,
-	0xa0, 0x63, 0xc6, 0xd2, 0x89, 0xd9, 0x18, 0x03, 0x06, 0xea, 0x2f, 0x00, 0x9e, 0x7a, 0x87, 0xf0,
-	0x38, 0x80, 0x7c, 0xde, 0x26, 0x2e, 0x97, 0x31, 0x9c, 0x8b, 0x21, 0xcb, 0x4f, 0xa3, 0x1f, 0x67,
-	0x71, 0x1c, 0x19, 0xb0, 0x87, 0x7d, 0x59, 0xfe, 0x6b, 0x6b, 0xbe, 0x1d, 0x40, 0xde, 0xc7, 0x6e,
-	0x43, 0x4f, 0x05, 0x9e, 0x8c, 0x4c, 0xbd, 0xf7, 0x41, 0xfd, 0x1b, 0xc0, 0x17, 0xde, 0xa3, 0x6e,
-	0x9c, 0xbe, 0xdb, 0xe3, 0xff, 0x51, 0x70, 0x53, 0xcd, 0x26, 0xae, 0xd8, 0x0c, 0x73, 0x9b, 0x45,
-	0xe4, 0xf3, 0xc3, 0xe4, 0xb7, 0x98, 0x89, 0x2d, 0x7a, 0x5d, 0xd8, 0x6e, 0xb1, 0xcb, 0x2e, 0x61,
-	0xb1, 0x1c, 0x8c, 0x01, 0x17, 0x4f, 0xcc, 0x37, 0xb8, 0x58, 0x9b, 0xd5, 0x08, 0x13, 0x0a, 0xca,
-	0x18, 0xe1, 0x46, 0x46, 0x30, 0xdd, 0xa4, 0x2d, 0xca, 0x85, 0x30, 0x4e, 0x8a, 0xa6, 0xbf, 0x90,
-	0x54, 0x0e, 0xa6, 0x8d, 0xf0, 0xb3, 0x2c, 0xc3, 0x94, 0x83, 0x4d, 0x22, 0x34, 0x71, 0xd2, 0x10,
-	0x6b, 0xf5, 0x77, 0x00, 0x95, 0x75, 0x21, 0xcf, 0x31, 0x57, 0xb7, 0x05, 0xb3, 0xb1, 0x4a, 0x47,
-	0x99, 0x1f, 0xd7, 0x14, 0x63, 0xee, 0x2a, 0xee, 0x41, 0x2e, 0x0f, 0xd5, 0x32, 0xf1, 0x3f, 0x6a,
-	0xa9, 0xcf, 0xc4, 0x63, 0x0c, 0x56, 0x56, 0xfd, 0x09, 0x40, 0xe5, 0xb2, 0x78, 0x28, 0x26, 0x91,
-	0xce, 0x13, 0xf7, 0xdd, 0xcf, 0x00, 0xbe, 0x38, 0xd4, 0x77, 0xa5, 0x0f, 0x37, 0x36, 0x89, 0xe7,
-	0x4e, 0x50, 0x3d, 0x47, 0x6d, 0x93, 0x38, 0xbe, 0x6d, 0x92, 0xb1, 0xb6, 0xb9, 0x0d, 0xe0, 0xe2,
-	0xa0, 0xdc, 0x43, 0xde, 0x13, 0xa4, 0x9d, 0x83, 0x53, 0x0d, 0xe2, 0x95, 0x69, 0x2d, 0x7c, 0xdd,
-	0xf4, 0x8c, 0xbf, 0xbf, 0x94, 0xde, 0x24, 0xde, 0xc6, 0x9b, 0x46, 0xba, 0x41, 0xbc, 0x8d, 0x9a,
-	0xba, 0x0f, 0x20, 0x1a, 0xe9, 0xed, 0x89, 0xf3, 0xec, 0x4d, 0xab, 0xc4, 0xb8, 0x69, 0xf5, 0x1a,
-	0x9c, 0x0a, 0x07, 0xb8, 0x92, 0xcc, 0x25, 0x57, 0x66, 0x8b, 0xa7, 0x86, 0xc3, 0x1a, 0xc1, 0xa9,
-	0x7e, 0xf2, 0x50, 0x87, 0xdf, 0x82, 0x69, 0x35, 0xfd, 0x75, 0x10, 0xca, 0x88, 0x6c, 0xd4, 0xdf,
-	0x00, 0x44, 0x23, 0xdd, 0x3e, 0xf1, 0x04, 0x4b, 0x70, 0x1a, 0x3b, 0xb4, 0x1c, 0xcc, 0x9e, 0x50,
-	0x02, 0xcf, 0x8f, 0xb8, 0x16, 0x94, 0xc6, 0xb8, 0x9a, 0xc2, 0x0e, 0xdd, 0x24, 0x9e, 0xfa, 0x2b,
-	0x80, 0x67, 0x87, 0x74, 0xb0, 0x1e, 0x93, 0xf5, 0xb3, 0xae, 0x86, 0xbf, 0x00, 0x3c, 0x33, 0xa8,
-	0x86, 0x38, 0xfb, 0x09, 0x92, 0xaf, 0x3e, 0x8d, 0xf7, 0x75, 0x34, 0xcc, 0xe0, 0x1b, 0xfb, 0x07,
-	0x80, 0x67, 0x3e, 0x7e, 0x16, 0xb2, 0xfd, 0x60, 0x6c, 0xb6, 0xa7, 0x47, 0xff, 0xa1, 0xfa, 0x98,
-	0xe3, 0x86, 0x87, 0x7e, 0x1b, 0xec, 0x76, 0x10, 0xd8, 0xeb, 0x20, 0xf0, 0xa0, 0x83, 0xa4, 0x87,
-	0x1d, 0x24, 0x1d, 0x74, 0x90, 0xf4, 0xa8, 0x83, 0xa4, 0xc7, 0x1d, 0x04, 0x6e, 0xf8, 0x08, 0xdc,
-	0xf4, 0x91, 0x74, 0xc7, 0x47, 0xe0, 0xae, 0x8f, 0xa4, 0x7b, 0x3e, 0x92, 0xee, 0xfb, 0x48, 0xda,
-	0xf5, 0x11, 0xd8, 0xf3, 0x11, 0x78, 0xe0, 0x23, 0xe9, 0xa1, 0x8f, 0xc0, 0x81, 0x8f, 0xa4, 0x47,
-	0x3e, 0x02, 0x8f, 0x7d, 0x24, 0xdd, 0xe8, 0x22, 0xe9, 0x66, 0x17, 0x81, 0x5b, 0x5d, 0x24, 0x7d,
-	0xd7, 0x45, 0xe0, 0x87, 0x2e, 0x92, 0xee, 0x74, 0x91, 0x74, 0xb7, 0x8b, 0xc0, 0xbd, 0x2e, 0x02,
-	0xf7, 0xbb, 0x08, 0x7c, 0xba, 0x6a, 0xda, 0x1a, 0xdf, 0x26, 0x7c, 0x9b, 0x5a, 0xa6, 0xab, 0x59,
-	0x84, 0x5f, 0xb3, 0x59, 0xa3, 0x30, 0xf8, 0xa7, 0xef, 0x34, 0xcc, 0x02, 0xe7, 0x96, 0x53, 0xa9,
-	0x4c, 0x89, 0xb9, 0x72, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xb5, 0x97, 0xb7, 0x5e,
-	0x0d, 0x00, 0x00,
+	// 1187 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3f, 0x8c, 0x13, 0xc7,
+	0x17, 0xde, 0xf1, 0x9f, 0xf3, 0x79, 0xec, 0x83, 0xd3, 0xea, 0xc7, 0x2f, 0xab, 0x83, 0x8c, 0xcd,
+	0x72, 0x42, 0x86, 0xe0, 0x75, 0x64, 0x9a, 0x04, 0x85, 0x9c, 0xbc, 0x26, 0x41, 0x0e, 0x49, 0x2e,
+	0xd9, 0x84, 0x26, 0x88, 0x58, 0x63, 0xef, 0x78, 0x6f, 0xe4, 0xf5, 0xee, 0x66, 0x77, 0x7c, 0x60,
+	0xa2, 0x48, 0x28, 0x4d, 0x50, 0x2a, 0x42, 0x15, 0xa5, 0x8a, 0xa8, 0x28, 0x52, 0x50, 0x45, 0x48,
+	0x49, 0x41, 0x49, 0x91, 0xe2, 0xaa, 0x88, 0xea, 0x82, 0xd7, 0x45, 0x90, 0xd2, 0x50, 0x22, 0x57,
+	0xd1, 0xfe, 0x31, 0x5e, 0xff, 0xe1, 0x12, 0x02, 0xb2, 0xe8, 0x76, 0x76, 0xbe, 0xf7, 0xbd, 0xef,
+	0xbd, 0x79, 0xdf, 0x8e, 0x0d, 0x8f, 0xe8, 0xa6, 0x8d, 0x2f, 0x61, 0xa3, 0xe8, 0x30, 0xdc, 0x6c,
+	0x97, 0xb0, 0x45, 0x4b, 0xd8, 0xb2, 0x74, 0xda, 0xc4, 0x8c, 0x9a, 0x86, 0x64, 0xd9, 0x26, 0x33,
+	0xf9, 0x7d, 0x8c, 0x19, 0x52, 0x08, 0x94, 0xb6, 0x4f, 0xae, 0x55, 0x34, 0xca, 0xb6, 0xba, 0x0d,
+	0xa9, 0x69, 0x76, 0x4a, 0xc4, 0xd8, 0x36, 0x7b, 0x96, 0x6d, 0x5e, 0xee, 0x95, 0x7c, 0x70, 0xb3,
+	0xa8, 0x11, 0xa3, 0xb8, 0x8d, 0x75, 0xaa, 0x62, 0x46, 0x4a, 0x33, 0x0f, 0x01, 0xe5, 0x5a, 0x31,
+	0x42, 0xa1, 0x99, 0x9a, 0x19, 0x04, 0x37, 0xba, 0x2d, 0x7f, 0xe5, 0x2f, 0xfc, 0xa7, 0x10, 0x7e,
+	0x48, 0x33, 0x4d, 0x4d, 0x27, 0x81, 0x3e, 0xc3, 0x30, 0x99, 0x2f, 0xcf, 0x09, 0x77, 0xf3, 0xe1,
+	0xee, 0x13, 0x8e, 0x16, 0x25, 0xba, 0x5a, 0xef, 0x60, 0xa7, 0x1d, 0x22, 0x72, 0xd3, 0x08, 0x46,
+	0x3b, 0xc4, 0x61, 0xb8, 0x63, 0x85, 0x80, 0xf5, 0xd9, 0x3e, 0x34, 0x4d, 0x83, 0xe1, 0x26, 0xab,
+	0x53, 0xa3, 0x35, 0x92, 0x31, 0xa7, 0x5b, 0x54, 0x25, 0x06, 0xa3, 0x2d, 0x4a, 0xec, 0x91, 0x1a,
+	0x34, 0x0b, 0xb2, 0xa9, 0xb6, 0xc5, 0xc2, 0x7d, 0xf1, 0xcf, 0x04, 0xcc, 0x54, 0xc6, 0x3d, 0xe6,
+	0xdf, 0x83, 0x71, 0xaa, 0x3a, 0x02, 0xc8, 0x83, 0x42, 0xa6, 0x7c, 0x54, 0x9a, 0xec, 0xb5, 0x14,
+	0x41, 0xd6, 0xc6, 0xa9, 0xe4, 0xd5, 0xa1, 0x9c, 0xfc, 0x16, 0xc4, 0x56, 0xc1, 0xbd, 0xdd, 0x1c,
+	0xb7, 0xb3, 0x9b, 0x03, 0x8a, 0x47, 0xc2, 0x57, 0x21, 0x6c, 0xda, 0x04, 0x33, 0xa2, 0xd6, 0x31,
+	0x13, 0x62, 0x3e, 0xe5, 0x9a, 0x14, 0x14, 0x2f, 0x8d, 0x8a, 0x97, 0x3e, 0x1d, 0x15, 0x2f, 0x2f,
+	0x7b, 0xe1, 0xd7, 0xff, 0xc8, 0x01, 0x25, 0x1d, 0xc6, 0x55, 0x98, 0x47, 0xd2, 0xb5, 0xd4, 0x11,
+	0x49, 0xfc, 0x59, 0x48, 0xc2, 0xb8, 0x0a, 0xe3, 0x37, 0x20, 0x54, 0x89, 0x4e, 0x42, 0x92, 0xe5,
+	0x7f, 0x24, 0x49, 0x04, 0x04, 0x61, 0x4c, 0x85, 0xf1, 0x07, 0x61, 0xc2, 0xc0, 0x1d, 0x22, 0x24,
+	0xf2, 0xa0, 0x90, 0x96, 0x53, 0x43, 0x39, 0x61, 0xc7, 0x84, 0xb2, 0xe2, 0xbf, 0xe4, 0x8f, 0xc3,
+	0x8c, 0x4a, 0x9c, 0xa6, 0x4d, 0x2d, 0xaf, 0x31, 0x42, 0xd2, 0xc7, 0x2c, 0x0f, 0xe5, 0xa4, 0x1d,
+	0x17, 0x76, 0xf6, 0x2b, 0xd1, 0x4d, 0xbe, 0x07, 0x21, 0x66, 0xcc, 0xa6, 0x8d, 0x2e, 0x23, 0x8e,
+	0xb0, 0x94, 0x8f, 0x17, 0x32, 0xe5, 0xd7, 0xf6, 0x68, 0xb3, 0x54, 0x79, 0x82, 0x7e, 0xc7, 0x60,
+	0x76, 0x4f, 0x3e, 0x31, 0x94, 0x8f, 0xfd, 0x00, 0x8e, 0x8a, 0xeb, 0xb6, 0x28, 0xac, 0x97, 0xd1,
+	0xe7, 0x17, 0x70, 0xf1, 0xca, 0xeb, 0xc5, 0x37, 0x2f, 0x16, 0x36, 0x4e, 0x5d, 0x28, 0x5e, 0xdc,
+	0x18, 0x2d, 0x8f, 0x7d, 0x59, 0x3e, 0xf1, 0xd5, 0xba, 0x12, 0x49, 0xc6, 0xbf, 0x0d, 0xb3, 0xd1,
+	0x29, 0x12, 0x52, 0x7e, 0xf2, 0x83, 0xd3, 0xc9, 0xab, 0x01, 0xa6, 0x66, 0xb4, 0x4c, 0x25, 0xd3,
+	0x1c, 0x2f, 0xd6, 0x4e, 0xc3, 0xfd, 0x53, 0x62, 0xf8, 0x55, 0x18, 0x6f, 0x93, 0x9e, 0x3f, 0x2d,
+	0x69, 0xc5, 0x7b, 0xe4, 0xff, 0x07, 0x93, 0xdb, 0x58, 0xef, 0x12, 0xff, 0xb8, 0xd3, 0x4a, 0xb0,
+	0x38, 0x15, 0x7b, 0x03, 0x88, 0x9b, 0x30, 0x1b, 0xa9, 0xcb, 0xe1, 0x37, 0x60, 0x36, 0x62, 0x6e,
+	0x6f, 0xe4, 0xe6, 0xca, 0x89, 0xc4, 0x28, 0x13, 0x01, 0xe2, 0x2f, 0x00, 0x1e, 0x38, 0x4b, 0x58,
+	0x14, 0x40, 0xbe, 0xe8, 0x12, 0x87, 0xf1, 0x18, 0xee, 0x8f, 0x20, 0xeb, 0x2f, 0x62, 0xa0, 0xf7,
+	0xe1, 0x28, 0xd2, 0x53, 0x0f, 0xc7, 0xbe, 0x7e, 0xea, 0x6c, 0xbf, 0xeb, 0x41, 0x3e, 0xc0, 0x4e,
+	0x5b, 0x4e, 0x78, 0x4c, 0x4a, 0xba, 0x35, 0x7a, 0x21, 0x7e, 0x17, 0x83, 0xaf, 0xbc, 0x4f, 0x9d,
+	0xa8, 0x7c, 0x67, 0xa4, 0xff, 0x63, 0xef, 0xa4, 0x74, 0x1d, 0x37, 0x4c, 0x1b, 0x33, 0xd3, 0x0e,
+	0xc5, 0x17, 0xa7, 0xc5, 0x6f, 0xda, 0x1a, 0x36, 0xe8, 0x15, 0x3f, 0x76, 0xd3, 0x3e, 0xef, 0x10,
+	0x3b, 0x52, 0x83, 0x32, 0x41, 0xf1, 0xdc, 0x7a, 0xbd, 0x83, 0x35, 0x6d, 0x95, 0xd8, 0xbe, 0x05,
+	0xd3, 0x4a, 0xb0, 0xe0, 0x11, 0x4c, 0xea, 0xb4, 0x43, 0x99, 0x6f, 0x8c, 0x15, 0x7f, 0xe8, 0x8f,
+	0xc7, 0x85, 0x87, 0x29, 0x25, 0x78, 0xcd, 0xf3, 0x30, 0x61, 0x61, 0x8d, 0xf8, 0x9e, 0x58, 0x51,
+	0xfc, 0x67, 0x5e, 0x80, 0xa9, 0xd0, 0x58, 0xc2, 0x52, 0x1e, 0x14, 0x96, 0x95, 0xd1, 0x52, 0xfc,
+	0x0d, 0x40, 0xa1, 0xea, 0x3b, 0x7f, 0xce, 0xa1, 0x6e, 0xc2, 0x4c, 0xe4, 0x0c, 0xc2, 0x9e, 0xec,
+	0x35, 0x2e, 0x73, 0x4e, 0x31, 0xca, 0xc0, 0xd7, 0xa7, 0xba, 0x1c, 0xfb, 0x0f, 0x5d, 0x96, 0xb3,
+	0xd1, 0x1c, 0x93, 0x3d, 0x17, 0x7f, 0x02, 0x50, 0x38, 0xef, 0x7f, 0x83, 0x16, 0x51, 0xce, 0x73,
+	0x4f, 0xe4, 0xcf, 0x00, 0xbe, 0x3a, 0x35, 0x91, 0x95, 0x8f, 0x6a, 0xe7, 0x48, 0xcf, 0x59, 0xa0,
+	0xaf, 0x9e, 0x0c, 0x54, 0x6c, 0xef, 0x81, 0x8a, 0x8f, 0x07, 0x4a, 0xbc, 0x09, 0xe0, 0xc1, 0xb3,
+	0x64, 0x56, 0xf7, 0x02, 0x65, 0xe7, 0xe1, 0x52, 0x9b, 0xf4, 0xea, 0x54, 0x0d, 0xbe, 0x7b, 0x72,
+	0xda, 0xdd, 0xcd, 0x25, 0xcf, 0x91, 0x5e, 0xed, 0x8c, 0x92, 0x6c, 0x93, 0x5e, 0x4d, 0x15, 0x77,
+	0x63, 0x10, 0xcd, 0xcc, 0xf6, 0xc2, 0x75, 0x8e, 0xee, 0xb1, 0xd8, 0xbc, 0x7b, 0xec, 0x2d, 0xb8,
+	0x14, 0xfc, 0x36, 0x10, 0xe2, 0xf9, 0x78, 0x61, 0x5f, 0xf9, 0xc0, 0x74, 0x5a, 0xc5, 0xdb, 0x95,
+	0x57, 0x86, 0x32, 0xbc, 0x01, 0x52, 0x62, 0xf2, 0x6b, 0x2f, 0x95, 0x12, 0xc6, 0x78, 0xf3, 0x47,
+	0x2e, 0x5b, 0xd4, 0x26, 0x4e, 0x1d, 0x07, 0xdf, 0x83, 0x7f, 0x75, 0xc7, 0x86, 0x31, 0x15, 0xc6,
+	0x9f, 0x86, 0x2b, 0x58, 0xd7, 0xcd, 0x4b, 0x44, 0xad, 0x37, 0xa9, 0x6a, 0x3b, 0x42, 0x32, 0x1f,
+	0x2f, 0xa4, 0x65, 0x61, 0x28, 0x27, 0x6f, 0x80, 0xd8, 0x6a, 0xde, 0xdd, 0xcd, 0x65, 0x2b, 0x01,
+	0xa0, 0x5a, 0x3b, 0xa3, 0x38, 0x4a, 0x36, 0x84, 0x57, 0x3d, 0xb4, 0xf8, 0x4d, 0x0c, 0xa2, 0x19,
+	0xb7, 0x2d, 0xbc, 0xc1, 0x15, 0x98, 0xc2, 0x16, 0xad, 0x7b, 0xb7, 0x62, 0x60, 0xc1, 0xff, 0xcf,
+	0x50, 0xfb, 0x92, 0xe6, 0x50, 0x2d, 0x61, 0x8b, 0x9e, 0x23, 0xbd, 0x29, 0x23, 0xc7, 0x9f, 0xdd,
+	0xc8, 0xbf, 0x02, 0x78, 0x64, 0xca, 0xc8, 0xd5, 0xc8, 0x77, 0xe9, 0x65, 0xb7, 0xf3, 0x5f, 0x00,
+	0x1e, 0x3e, 0x4b, 0x9e, 0xa6, 0x7e, 0x81, 0xe2, 0x9b, 0x2f, 0xe2, 0x82, 0x98, 0x4d, 0x33, 0x79,
+	0x49, 0xfc, 0x0e, 0xe0, 0xe1, 0x4f, 0x5e, 0x86, 0x6a, 0x3f, 0x9c, 0x5b, 0xed, 0xa1, 0xd9, 0x9f,
+	0x87, 0x63, 0xcc, 0x5e, 0xb7, 0x9f, 0x7c, 0x13, 0xdc, 0xeb, 0x23, 0xb0, 0xd3, 0x47, 0xe0, 0x7e,
+	0x1f, 0x71, 0x0f, 0xfa, 0x88, 0x7b, 0xd8, 0x47, 0xdc, 0xa3, 0x3e, 0xe2, 0x1e, 0xf7, 0x11, 0xb8,
+	0xea, 0x22, 0x70, 0xcd, 0x45, 0xdc, 0x2d, 0x17, 0x81, 0xdb, 0x2e, 0xe2, 0xee, 0xb8, 0x88, 0xbb,
+	0xeb, 0x22, 0xee, 0x9e, 0x8b, 0xc0, 0x8e, 0x8b, 0xc0, 0x7d, 0x17, 0x71, 0x0f, 0x5c, 0x04, 0x1e,
+	0xba, 0x88, 0x7b, 0xe4, 0x22, 0xf0, 0xd8, 0x45, 0xdc, 0xd5, 0x01, 0xe2, 0xae, 0x0d, 0x10, 0xb8,
+	0x3e, 0x40, 0xdc, 0xf7, 0x03, 0x04, 0x7e, 0x1c, 0x20, 0xee, 0xd6, 0x00, 0x71, 0xb7, 0x07, 0x08,
+	0xdc, 0x19, 0x20, 0x70, 0x77, 0x80, 0xc0, 0x67, 0x27, 0x34, 0x53, 0x62, 0x5b, 0x84, 0x6d, 0x51,
+	0x43, 0x73, 0x24, 0x83, 0xb0, 0x4b, 0xa6, 0xdd, 0x2e, 0x4d, 0xfe, 0x0b, 0xb2, 0xda, 0x5a, 0x89,
+	0x31, 0xc3, 0x6a, 0x34, 0x96, 0x7c, 0x3f, 0x9d, 0xfc, 0x7b, 0x00, 0x98, 0x89, 0xb1, 0x6e, 0x7a,
+	0x0e, 0x00, 0x00,
 }
 
 func (this *Application) Equal(that interface{}) bool {
@@ -862,6 +911,13 @@ func (this *Application) Equal(that interface{}) bool {
 	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
 	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
 	if this.Name != that1.Name {
 		return false
 	}
@@ -976,6 +1032,9 @@ func (this *ListApplicationsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateApplicationRequest) Equal(that interface{}) bool {
@@ -1122,6 +1181,21 @@ func (this *CreateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1149,6 +1223,9 @@ func (this *UpdateApplicationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListApplicationCollaboratorsRequest) Equal(that interface{}) bool {
@@ -1315,6 +1392,16 @@ func (m *Application) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)))
+		n4, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -1366,19 +1453,19 @@ func (m *GetApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n4, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n5, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.FieldMask.Size()))
-	n5, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n6, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	return i, nil
 }
 
@@ -1401,20 +1488,20 @@ func (m *ListApplicationsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintApplication(dAtA, i, uint64(m.Collaborator.Size()))
-		n6, err := m.Collaborator.MarshalTo(dAtA[i:])
+		n7, err := m.Collaborator.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.FieldMask.Size()))
-	n7, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n8, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -1431,6 +1518,16 @@ func (m *ListApplicationsRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintApplication(dAtA, i, uint64(m.Page))
 	}
+	if m.Deleted {
+		dAtA[i] = 0x30
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1452,19 +1549,19 @@ func (m *CreateApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Application.Size()))
-	n8, err := m.Application.MarshalTo(dAtA[i:])
+	n9, err := m.Application.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Collaborator.Size()))
-	n9, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n10, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	return i, nil
 }
 
@@ -1486,19 +1583,19 @@ func (m *UpdateApplicationRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Application.Size()))
-	n10, err := m.Application.MarshalTo(dAtA[i:])
+	n11, err := m.Application.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.FieldMask.Size()))
-	n11, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n12, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	return i, nil
 }
 
@@ -1520,11 +1617,11 @@ func (m *ListApplicationAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n12, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n13, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1556,11 +1653,11 @@ func (m *GetApplicationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n13, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n14, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	if len(m.KeyID) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1588,11 +1685,11 @@ func (m *CreateApplicationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n14, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n15, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1600,21 +1697,46 @@ func (m *CreateApplicationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.Name)
 	}
 	if len(m.Rights) > 0 {
-		dAtA16 := make([]byte, len(m.Rights)*10)
-		var j15 int
+		dAtA17 := make([]byte, len(m.Rights)*10)
+		var j16 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j16++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintApplication(dAtA, i, uint64(j15))
-		i += copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintApplication(dAtA, i, uint64(j16))
+		i += copy(dAtA[i:], dAtA17[:j16])
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintApplication(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n18, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
@@ -1637,19 +1759,27 @@ func (m *UpdateApplicationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n17, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.APIKey.Size()))
-	n18, err := m.APIKey.MarshalTo(dAtA[i:])
+	n20, err := m.APIKey.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintApplication(dAtA, i, uint64(m.FieldMask.Size()))
+	n21, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
 	return i, nil
 }
 
@@ -1671,11 +1801,11 @@ func (m *ListApplicationCollaboratorsRequest) MarshalTo(dAtA []byte) (int, error
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n19, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n22, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1707,19 +1837,19 @@ func (m *GetApplicationCollaboratorRequest) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n20, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n23, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n21, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n24, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n24
 	return i, nil
 }
 
@@ -1741,19 +1871,19 @@ func (m *SetApplicationCollaboratorRequest) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n22, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n25, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintApplication(dAtA, i, uint64(m.Collaborator.Size()))
-	n23, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n26, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
 	return i, nil
 }
 
@@ -1790,6 +1920,9 @@ func NewPopulatedApplication(r randyApplication, easy bool) *Application {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
+	if r.Intn(10) != 0 {
+		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1830,6 +1963,7 @@ func NewPopulatedListApplicationsRequest(r randyApplication, easy bool) *ListApp
 	this.Order = randStringApplication(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1888,6 +2022,14 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v18 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v18)
+	for i := 0; i < v18; i++ {
+		this.AllowedCIDRs[i] = randStringApplication(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1895,10 +2037,12 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *UpdateApplicationAPIKeyRequest {
 	this := &UpdateApplicationAPIKeyRequest{}
-	v18 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v18
-	v19 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v19
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
+	v20 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1906,8 +2050,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bool) *ListApplicationCollaboratorsRequest {
 	this := &ListApplicationCollaboratorsRequest{}
-	v20 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v20
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -1917,10 +2061,10 @@ func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bo
 
 func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool) *GetApplicationCollaboratorRequest {
 	this := &GetApplicationCollaboratorRequest{}
-	v21 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v23 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v23
+	v24 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1928,10 +2072,10 @@ func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool
 
 func NewPopulatedSetApplicationCollaboratorRequest(r randyApplication, easy bool) *SetApplicationCollaboratorRequest {
 	this := &SetApplicationCollaboratorRequest{}
-	v23 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v25 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v25
+	v26 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1956,9 +2100,9 @@ func randUTF8RuneApplication(r randyApplication) rune {
 	return rune(ru + 61)
 }
 func randStringApplication(r randyApplication) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v27 := r.Intn(100)
+	tmps := make([]rune, v27)
+	for i := 0; i < v27; i++ {
 		tmps[i] = randUTF8RuneApplication(r)
 	}
 	return string(tmps)
@@ -1980,11 +2124,11 @@ func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		v26 := r.Int63()
+		v28 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v28 *= -1
 		}
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v28))
 	case 1:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2043,6 +2187,10 @@ func (m *Application) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
+	if m.DeletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
 	return n
 }
 
@@ -2096,6 +2244,9 @@ func (m *ListApplicationsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovApplication(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -2176,6 +2327,16 @@ func (m *CreateApplicationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovApplication(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2189,6 +2350,8 @@ func (m *UpdateApplicationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovApplication(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovApplication(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplication(uint64(l))
 	return n
 }
 
@@ -2270,6 +2433,7 @@ func (this *Application) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
 		`ContactInfo:` + strings.Replace(fmt.Sprintf("%v", this.ContactInfo), "ContactInfo", "ContactInfo", 1) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2305,6 +2469,7 @@ func (this *ListApplicationsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2362,6 +2527,8 @@ func (this *CreateApplicationAPIKeyRequest) String() string {
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2373,6 +2540,7 @@ func (this *UpdateApplicationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateApplicationAPIKeyRequest{`,
 		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationIdentifiers.String(), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2772,6 +2940,42 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3170,6 +3374,26 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3837,6 +4061,74 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3956,6 +4248,39 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ var ApplicationFieldPathsNested = []string{
 	"attributes",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"ids",
 	"ids.application_id",
@@ -17,6 +18,7 @@ var ApplicationFieldPathsTopLevel = []string{
 	"attributes",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"ids",
 	"name",
@@ -47,6 +49,7 @@ var ListApplicationsRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -55,6 +58,7 @@ var ListApplicationsRequestFieldPathsNested = []string{
 
 var ListApplicationsRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -65,6 +69,7 @@ var CreateApplicationRequestFieldPathsNested = []string{
 	"application.attributes",
 	"application.contact_info",
 	"application.created_at",
+	"application.deleted_at",
 	"application.description",
 	"application.ids",
 	"application.ids.application_id",
@@ -88,6 +93,7 @@ var UpdateApplicationRequestFieldPathsNested = []string{
 	"application.attributes",
 	"application.contact_info",
 	"application.created_at",
+	"application.deleted_at",
 	"application.description",
 	"application.ids",
 	"application.ids.application_id",
@@ -123,30 +129,39 @@ var GetApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateApplicationAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
 	"application_ids",
 	"application_ids.application_id",
+	"expires_at",
 	"name",
 	"rights",
 }
 
 var CreateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
 	"application_ids",
+	"expires_at",
 	"name",
 	"rights",
 }
 var UpdateApplicationAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
 	"application_ids",
 	"application_ids.application_id",
+	"field_mask",
 }
 
 var UpdateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
 	"application_ids",
+	"field_mask",
 }
 var ListApplicationCollaboratorsRequestFieldPathsNested = []string{
 	"application_ids",
@@ -86,6 +86,18 @@ func (m *Application) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "deleted_at":
+
+			if v, ok := interface{}(m.GetDeletedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationValidationError{
+						field:  "deleted_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "name":
 
 			if utf8.RuneCountInString(m.GetName()) > 50 {
@@ -462,6 +474,8 @@ func (m *ListApplicationsRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListApplicationsRequestValidationError{
 				field:  name,
@@ -991,6 +1005,27 @@ func (m *CreateApplicationAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateApplicationAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			if len(m.GetAllowedCIDRs()) > 32 {
+				return CreateApplicationAPIKeyRequestValidationError{
+					field:  "allowed_cidrs",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
 		default:
 			return CreateApplicationAPIKeyRequestValidationError{
 				field:  name,
@@ -1097,6 +1132,18 @@ func (m *UpdateApplicationAPIKeyRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateApplicationAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateApplicationAPIKeyRequestValidationError{
 				field:  name,
@@ -36,63 +36,65 @@ func init() {
 }
 
 var fileDescriptor_f6c42f4fe8e3c902 = []byte{
-	// 885 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x6c, 0xe3, 0x44,
-	0x14, 0xc7, 0x3d, 0x5d, 0x14, 0x89, 0xd9, 0x85, 0xd5, 0x0e, 0x12, 0x48, 0xde, 0x65, 0x84, 0x8c,
-	0x76, 0x8b, 0x96, 0xcd, 0x18, 0x36, 0x02, 0xb4, 0x50, 0x01, 0xfd, 0x40, 0xa1, 0x2a, 0x88, 0xaa,
-	0x15, 0x97, 0x5c, 0x8a, 0x93, 0x4e, 0x1d, 0x2b, 0xa9, 0xc7, 0x78, 0x26, 0x2d, 0x69, 0x54, 0xa9,
-	0x70, 0xaa, 0x7a, 0x02, 0xf1, 0x21, 0x84, 0x40, 0x42, 0x08, 0x44, 0x2f, 0x48, 0x3d, 0xf6, 0xd8,
-	0x63, 0x8f, 0x95, 0xb8, 0xf4, 0xd8, 0xd8, 0x1c, 0x7a, 0x00, 0xa9, 0xc7, 0x1e, 0x38, 0x20, 0x8f,
-	0x6d, 0x6a, 0x27, 0xa9, 0xd3, 0x24, 0x7b, 0xcb, 0xcc, 0xbc, 0x79, 0xef, 0x37, 0xef, 0xf9, 0xff,
-	0x57, 0xe0, 0x83, 0x3a, 0x73, 0x8d, 0x75, 0xc3, 0xce, 0x73, 0x61, 0x54, 0x6a, 0xba, 0xe1, 0x58,
-	0xba, 0xe1, 0x38, 0x75, 0xab, 0x62, 0x08, 0x8b, 0xd9, 0x4b, 0x9c, 0xba, 0x6b, 0x56, 0x85, 0x72,
-	0xe2, 0xb8, 0x4c, 0x30, 0xf4, 0xb4, 0x10, 0x36, 0x89, 0x6e, 0x90, 0xb5, 0x82, 0x9a, 0x37, 0x2d,
-	0x51, 0x6d, 0x94, 0x49, 0x85, 0xad, 0xea, 0x26, 0x33, 0x99, 0x2e, 0xc3, 0xca, 0x8d, 0x15, 0xb9,
-	0x92, 0x0b, 0xf9, 0x2b, 0xbc, 0xae, 0xde, 0x31, 0x19, 0x33, 0xeb, 0x34, 0xac, 0x62, 0xdb, 0x4c,
-	0xc8, 0x22, 0x51, 0x72, 0xf5, 0x76, 0x74, 0xfa, 0x7f, 0x0e, 0xba, 0xea, 0x88, 0x66, 0x74, 0xf8,
-	0x62, 0x26, 0xe7, 0xe5, 0x41, 0xd6, 0x32, 0xb5, 0x85, 0xb5, 0x62, 0x51, 0x37, 0x2e, 0x83, 0xbb,
-	0x83, 0x5c, 0xcb, 0xac, 0x8a, 0xe8, 0xfc, 0xe1, 0x3f, 0x39, 0xf8, 0xcc, 0xe4, 0x45, 0xea, 0x05,
-	0x6a, 0x5a, 0x5c, 0xb8, 0x4d, 0xe4, 0x03, 0x98, 0x9b, 0x76, 0xa9, 0x21, 0x28, 0x7a, 0x89, 0xa4,
-	0xfb, 0x40, 0xc2, 0xfd, 0xd4, 0xad, 0x4f, 0x1b, 0x94, 0x0b, 0xf5, 0x76, 0x67, 0x64, 0x22, 0x46,
-	0xfb, 0x0a, 0x7c, 0xf1, 0xe7, 0x5f, 0x5f, 0x8f, 0xed, 0x00, 0xad, 0xa0, 0x37, 0x38, 0x75, 0xb9,
-	0xde, 0xaa, 0xb0, 0x7a, 0xdd, 0x28, 0x33, 0xd7, 0x10, 0xcc, 0x25, 0xc1, 0xde, 0x92, 0xb5, 0xcc,
-	0xe3, 0x1f, 0x9b, 0xc9, 0x27, 0xf3, 0x37, 0xc1, 0xfd, 0xd2, 0xbc, 0x36, 0xa7, 0x33, 0xd7, 0x34,
-	0x6c, 0x6b, 0x23, 0xdc, 0xec, 0xc8, 0x90, 0x3c, 0x93, 0x99, 0x3a, 0x36, 0xba, 0x32, 0xa2, 0xcf,
-	0x01, 0xbc, 0x56, 0xa4, 0x02, 0xdd, 0xed, 0x04, 0x2f, 0x52, 0x31, 0xe8, 0xfb, 0x5e, 0x97, 0xcf,
-	0x7b, 0x05, 0x91, 0x54, 0x15, 0xbd, 0x95, 0xfc, 0xc0, 0x02, 0xa8, 0xf4, 0x7a, 0x13, 0xfd, 0x0d,
-	0xe0, 0x13, 0x1f, 0x58, 0x5c, 0xa0, 0xf1, 0xce, 0xec, 0xc1, 0x6e, 0xa2, 0x02, 0x8f, 0x31, 0xee,
-	0x64, 0x60, 0x70, 0xed, 0xc7, 0xb0, 0xcf, 0xdf, 0x02, 0xf4, 0x54, 0x8a, 0xa4, 0xf4, 0x1a, 0x1a,
-	0xa6, 0xf1, 0xa5, 0x0f, 0xd1, 0xe3, 0xec, 0x3a, 0xda, 0x01, 0x30, 0xf7, 0xb1, 0xb3, 0xdc, 0xf3,
-	0xc3, 0x0a, 0xf7, 0x07, 0x6d, 0xfc, 0x23, 0xf9, 0xde, 0x82, 0x9a, 0xd1, 0x78, 0xd2, 0xa3, 0xf1,
-	0xc1, 0xfc, 0x1d, 0x98, 0x9b, 0xa1, 0x75, 0x2a, 0x28, 0xba, 0x97, 0x51, 0x61, 0xf6, 0x42, 0x55,
-	0xea, 0xb3, 0x24, 0xd4, 0x2d, 0x89, 0x75, 0x4b, 0xde, 0x0b, 0x74, 0xab, 0xdd, 0x93, 0x10, 0x2f,
-	0xdc, 0xc7, 0x99, 0xd3, 0xdf, 0x7c, 0xf8, 0xef, 0x75, 0x78, 0x2b, 0x91, 0x7a, 0xb2, 0x52, 0xa1,
-	0x9c, 0xa3, 0x16, 0x84, 0xc1, 0xb0, 0x17, 0xa4, 0x32, 0x07, 0x60, 0xe9, 0x88, 0x0b, 0xef, 0x6b,
-	0x79, 0xc9, 0x32, 0x8e, 0xee, 0x66, 0xb3, 0x44, 0x46, 0x80, 0x7e, 0x00, 0xf0, 0x46, 0x24, 0xe9,
-	0xf9, 0xd9, 0x39, 0xda, 0x44, 0xa4, 0xaf, 0xe0, 0xc3, 0xc0, 0x78, 0x3a, 0x5d, 0x1c, 0xe1, 0xb1,
-	0x36, 0x25, 0x39, 0x26, 0xb4, 0x37, 0x06, 0x53, 0x44, 0x60, 0x52, 0xf9, 0x1a, 0x6d, 0x4a, 0x85,
-	0x7e, 0x07, 0xe0, 0x75, 0xa9, 0x03, 0x99, 0x92, 0xa3, 0x7c, 0x1f, 0x91, 0x44, 0x71, 0x31, 0xda,
-	0x73, 0xbd, 0xd1, 0xb8, 0xf6, 0x8e, 0x64, 0x7b, 0x84, 0x86, 0x65, 0x0b, 0xba, 0xf6, 0x64, 0xe0,
-	0x12, 0x61, 0xcb, 0x5e, 0xce, 0x36, 0x90, 0xab, 0xf5, 0xeb, 0x7d, 0xc9, 0x34, 0x85, 0xde, 0x1d,
-	0x92, 0x49, 0x6f, 0xd5, 0x68, 0x53, 0x7a, 0xca, 0xef, 0x00, 0xde, 0x88, 0xc4, 0x74, 0xc9, 0x48,
-	0xbb, 0xa4, 0x76, 0x35, 0xc4, 0x8f, 0x24, 0xe2, 0xac, 0x3a, 0x33, 0x34, 0xa2, 0xe1, 0x58, 0x4b,
-	0x35, 0xda, 0x24, 0x91, 0x02, 0xbf, 0xb9, 0x06, 0x6f, 0x16, 0xa9, 0x98, 0x4e, 0x38, 0x0a, 0x7a,
-	0x35, 0xbb, 0x99, 0xc9, 0xd8, 0x98, 0x77, 0xbc, 0xc7, 0x95, 0x74, 0x1c, 0x77, 0x98, 0xcd, 0xa9,
-	0xf6, 0xeb, 0x98, 0x7c, 0xc1, 0x4f, 0x63, 0xe8, 0xad, 0x01, 0x9f, 0x90, 0x34, 0xbd, 0x52, 0x19,
-	0x7d, 0x32, 0xc2, 0x75, 0x69, 0xc3, 0xfd, 0x5c, 0xb8, 0xb4, 0x81, 0x3e, 0x1b, 0xa5, 0x46, 0xd2,
-	0x86, 0x07, 0xb5, 0x6c, 0xf4, 0x1b, 0x80, 0x37, 0x17, 0xfb, 0x8d, 0x65, 0xb1, 0xef, 0x58, 0x2e,
-	0x73, 0xcb, 0xa2, 0x1c, 0xc2, 0xa4, 0x3a, 0x31, 0xc2, 0x03, 0xa5, 0x3d, 0xfc, 0x01, 0xe0, 0xad,
-	0xc0, 0x01, 0x92, 0xc5, 0x39, 0x2a, 0xf4, 0x31, 0x89, 0x54, 0x74, 0xcc, 0xfa, 0x7c, 0x97, 0xeb,
-	0x25, 0xa3, 0xb4, 0x19, 0x89, 0xfc, 0x36, 0x1a, 0x09, 0x79, 0xea, 0x17, 0x70, 0xd8, 0xc6, 0xe0,
-	0xa8, 0x8d, 0xc1, 0x71, 0x1b, 0x2b, 0x27, 0x6d, 0xac, 0x9c, 0xb6, 0xb1, 0x72, 0xd6, 0xc6, 0xca,
-	0x79, 0x1b, 0x83, 0x2d, 0x0f, 0x83, 0x6d, 0x0f, 0x2b, 0xbb, 0x1e, 0x06, 0x7b, 0x1e, 0x56, 0xf6,
-	0x3d, 0xac, 0x1c, 0x78, 0x58, 0x39, 0xf4, 0x30, 0x38, 0xf2, 0x30, 0x38, 0xf6, 0xb0, 0x72, 0xe2,
-	0x61, 0x70, 0xea, 0x61, 0xe5, 0xcc, 0xc3, 0xe0, 0xdc, 0xc3, 0xca, 0x96, 0x8f, 0x95, 0x6d, 0x1f,
-	0x83, 0x2f, 0x7d, 0xac, 0x7c, 0xef, 0x63, 0xf0, 0xb3, 0x8f, 0x95, 0x5d, 0x1f, 0x2b, 0x7b, 0x3e,
-	0x06, 0xfb, 0x3e, 0x06, 0x07, 0x3e, 0x06, 0xa5, 0x07, 0x26, 0x23, 0xa2, 0x4a, 0x45, 0xd5, 0xb2,
-	0x4d, 0x4e, 0x6c, 0x2a, 0xd6, 0x99, 0x5b, 0xd3, 0xd3, 0x7f, 0x0d, 0x9d, 0x9a, 0xa9, 0x0b, 0x61,
-	0x3b, 0xe5, 0x72, 0x4e, 0x4e, 0xab, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x33, 0x89,
-	0xa9, 0x2e, 0x0b, 0x00, 0x00,
+	// 923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
+	0x14, 0xde, 0x49, 0xc1, 0xc0, 0xb4, 0x50, 0x75, 0x90, 0x40, 0xda, 0x96, 0x11, 0x5a, 0xa8, 0x53,
+	0x85, 0x78, 0x16, 0x6a, 0x01, 0x2a, 0x54, 0x40, 0xd2, 0x20, 0x13, 0x15, 0x44, 0x94, 0x8a, 0x8b,
+	0x2f, 0x61, 0xed, 0x4c, 0xd7, 0x2b, 0xbb, 0x3b, 0xcb, 0xce, 0xb8, 0xc5, 0xb5, 0x22, 0x15, 0x4e,
+	0x55, 0x4e, 0x20, 0x7e, 0x84, 0x10, 0x48, 0x08, 0x81, 0xc8, 0x05, 0x29, 0xc7, 0x1c, 0x73, 0xcc,
+	0x31, 0x12, 0x97, 0xdc, 0x88, 0x77, 0x39, 0xe4, 0xc0, 0x21, 0xc7, 0x1c, 0x38, 0xa0, 0x9d, 0xdd,
+	0x25, 0xbb, 0xb6, 0xb3, 0x1b, 0xdb, 0xdc, 0x3c, 0x33, 0x6f, 0xde, 0xf7, 0xbd, 0xef, 0xed, 0xfb,
+	0xc6, 0x70, 0xb6, 0xc5, 0x5c, 0xe3, 0x9e, 0x61, 0x97, 0xb8, 0x30, 0xea, 0x4d, 0xdd, 0x70, 0x2c,
+	0xdd, 0x70, 0x9c, 0x96, 0x55, 0x37, 0x84, 0xc5, 0xec, 0x15, 0x4e, 0xdd, 0xbb, 0x56, 0x9d, 0x72,
+	0xe2, 0xb8, 0x4c, 0x30, 0xf4, 0x94, 0x10, 0x36, 0x89, 0x6e, 0x90, 0xbb, 0x65, 0xb5, 0x64, 0x5a,
+	0xa2, 0xd1, 0xae, 0x91, 0x3a, 0xbb, 0xa3, 0x9b, 0xcc, 0x64, 0xba, 0x0c, 0xab, 0xb5, 0x6f, 0xcb,
+	0x95, 0x5c, 0xc8, 0x5f, 0xe1, 0x75, 0xf5, 0x92, 0xc9, 0x98, 0xd9, 0xa2, 0x21, 0x8a, 0x6d, 0x33,
+	0x21, 0x41, 0xa2, 0xe4, 0xea, 0xc5, 0xe8, 0xf4, 0xbf, 0x1c, 0xf4, 0x8e, 0x23, 0x3a, 0xd1, 0xe1,
+	0x0b, 0x99, 0x3c, 0x4f, 0x0e, 0xb2, 0x56, 0xa9, 0x2d, 0xac, 0xdb, 0x16, 0x75, 0x63, 0x18, 0x3c,
+	0x18, 0xe4, 0x5a, 0x66, 0x43, 0x44, 0xe7, 0x57, 0xff, 0x7c, 0x1c, 0x3e, 0x3d, 0x77, 0x9c, 0x7a,
+	0x99, 0x9a, 0x16, 0x17, 0x6e, 0x07, 0xf9, 0x00, 0x16, 0x6e, 0xb8, 0xd4, 0x10, 0x14, 0x5d, 0x21,
+	0x69, 0x1d, 0x48, 0xb8, 0x9f, 0xba, 0xf5, 0x49, 0x9b, 0x72, 0xa1, 0x5e, 0xec, 0x8f, 0x4c, 0xc4,
+	0x68, 0x5f, 0x82, 0xcf, 0xff, 0xf8, 0xeb, 0xab, 0xa9, 0x75, 0xa0, 0x95, 0xf5, 0x36, 0xa7, 0x2e,
+	0xd7, 0xbb, 0x75, 0xd6, 0x6a, 0x19, 0x35, 0xe6, 0x1a, 0x82, 0xb9, 0x24, 0xd8, 0x5b, 0xb1, 0x56,
+	0x79, 0xfc, 0x63, 0x2d, 0x59, 0x32, 0x7f, 0x03, 0xcc, 0x54, 0x97, 0xb4, 0x9b, 0x3a, 0x73, 0x4d,
+	0xc3, 0xb6, 0xee, 0x87, 0x9b, 0x7d, 0x19, 0x92, 0x67, 0x32, 0x53, 0xdf, 0xc6, 0x40, 0x46, 0xf4,
+	0x19, 0x80, 0x67, 0x2a, 0x54, 0xa0, 0xcb, 0xfd, 0xc4, 0x2b, 0x54, 0x8c, 0x5a, 0xdf, 0x6b, 0xb2,
+	0xbc, 0x97, 0x11, 0x49, 0xa1, 0xe8, 0xdd, 0xc4, 0x4a, 0x92, 0x4a, 0xaf, 0xd7, 0xd0, 0xdf, 0x00,
+	0x3e, 0xf2, 0xbe, 0xc5, 0x05, 0x9a, 0xee, 0xcf, 0x1e, 0xec, 0x26, 0x10, 0x78, 0x4c, 0xe3, 0x52,
+	0x06, 0x0d, 0xae, 0xfd, 0x10, 0xea, 0xfc, 0x0d, 0x40, 0x4f, 0xa6, 0x98, 0x54, 0x5f, 0x45, 0xe3,
+	0x08, 0x5f, 0xfd, 0x00, 0xfd, 0x9f, 0xaa, 0xa3, 0x75, 0x00, 0x0b, 0x1f, 0x39, 0xab, 0x43, 0x3f,
+	0xac, 0x70, 0x7f, 0x54, 0xe1, 0xaf, 0xc9, 0x7a, 0xcb, 0x6a, 0x86, 0xf0, 0x64, 0x88, 0xf0, 0x41,
+	0xff, 0x1d, 0x58, 0x58, 0xa0, 0x2d, 0x2a, 0x28, 0x2a, 0x66, 0x20, 0x2c, 0x1e, 0x4f, 0x95, 0xfa,
+	0x0c, 0x09, 0xe7, 0x96, 0xc4, 0x73, 0x4b, 0xde, 0x0d, 0xe6, 0x56, 0x2b, 0x4a, 0x12, 0xcf, 0xcf,
+	0xe0, 0xcc, 0xee, 0xaf, 0xa1, 0x0e, 0x7c, 0x6c, 0x99, 0x72, 0xc1, 0xdc, 0xc9, 0x21, 0x89, 0x84,
+	0xbc, 0xa2, 0x15, 0xb3, 0x21, 0x75, 0x37, 0xc2, 0x6b, 0xc3, 0x47, 0x97, 0xda, 0xae, 0x39, 0x39,
+	0xf0, 0xac, 0x04, 0x2e, 0xce, 0xbc, 0x98, 0x03, 0xec, 0x04, 0x68, 0x57, 0xff, 0x39, 0x0b, 0x2f,
+	0x24, 0x00, 0xe6, 0xea, 0x75, 0xca, 0x39, 0xea, 0x42, 0x18, 0x7c, 0xde, 0xcb, 0xd2, 0x8b, 0x46,
+	0x60, 0xd4, 0x17, 0x17, 0xde, 0xd7, 0x4a, 0x92, 0xd1, 0x34, 0xba, 0x9c, 0x27, 0x45, 0x08, 0xf7,
+	0x3d, 0x80, 0xe7, 0x22, 0x13, 0x5b, 0x5a, 0xbc, 0x49, 0x3b, 0x88, 0xe4, 0x5a, 0x5c, 0x18, 0x18,
+	0x7f, 0x8f, 0x03, 0x3c, 0xc2, 0x63, 0x6d, 0x5e, 0xf2, 0xb8, 0xae, 0xbd, 0x3e, 0x9a, 0x07, 0x04,
+	0xb6, 0x5c, 0x6a, 0xd2, 0x8e, 0xf4, 0xa4, 0x6f, 0x01, 0x3c, 0x2b, 0x27, 0x5f, 0xa6, 0xe4, 0xa8,
+	0x94, 0x63, 0x0b, 0x51, 0x5c, 0x4c, 0xed, 0xd9, 0xe1, 0xd4, 0xb8, 0xf6, 0xb6, 0xe4, 0x76, 0x0d,
+	0x8d, 0xcb, 0x2d, 0x50, 0xed, 0x89, 0xc0, 0x17, 0x43, 0xc9, 0x5e, 0xca, 0xb6, 0xcc, 0xd3, 0xe9,
+	0xf5, 0x9e, 0xe4, 0x34, 0x8f, 0xde, 0x19, 0x93, 0x93, 0xde, 0x6d, 0xd2, 0x8e, 0x9c, 0xab, 0xdf,
+	0x00, 0x3c, 0x17, 0xd9, 0xc7, 0x09, 0x2d, 0x1d, 0x30, 0x97, 0xd3, 0x51, 0xfc, 0x50, 0x52, 0x5c,
+	0x54, 0x17, 0xc6, 0xa6, 0x68, 0x38, 0xd6, 0x4a, 0x93, 0x76, 0x48, 0xe4, 0x39, 0x5f, 0x9f, 0x81,
+	0xe7, 0x2b, 0x54, 0xdc, 0x48, 0x78, 0x28, 0x7a, 0x25, 0x5b, 0xcc, 0x64, 0x6c, 0xcc, 0x77, 0x7a,
+	0xc8, 0x95, 0x74, 0x1c, 0x77, 0x98, 0xcd, 0xa9, 0xf6, 0xcb, 0x94, 0xac, 0xe0, 0xc7, 0x29, 0xf4,
+	0xe6, 0x88, 0x25, 0x24, 0x6d, 0xbe, 0x5a, 0x43, 0x1f, 0x4f, 0x70, 0x5d, 0x3e, 0x3c, 0x79, 0xef,
+	0x4e, 0xf5, 0x3e, 0xfa, 0x74, 0x12, 0x8c, 0xe4, 0xc3, 0x33, 0xea, 0x23, 0x85, 0x7e, 0x05, 0xf0,
+	0xfc, 0xad, 0xbc, 0xb6, 0xdc, 0xca, 0x6d, 0xcb, 0x49, 0x9e, 0x59, 0x91, 0x4d, 0x98, 0x53, 0xaf,
+	0x4f, 0x50, 0xa0, 0xb4, 0x87, 0xdf, 0x01, 0xbc, 0x10, 0x38, 0x40, 0x12, 0x9c, 0xa3, 0x72, 0x8e,
+	0x49, 0xa4, 0xa2, 0x63, 0xae, 0xcf, 0x0d, 0xb8, 0x5e, 0x32, 0x4a, 0x5b, 0x90, 0x94, 0xdf, 0x42,
+	0x13, 0x51, 0x9e, 0xff, 0x19, 0xec, 0xf4, 0x30, 0xd8, 0xed, 0x61, 0xb0, 0xd7, 0xc3, 0xca, 0x7e,
+	0x0f, 0x2b, 0x07, 0x3d, 0xac, 0x1c, 0xf6, 0xb0, 0x72, 0xd4, 0xc3, 0xe0, 0x81, 0x87, 0xc1, 0x43,
+	0x0f, 0x2b, 0x1b, 0x1e, 0x06, 0x9b, 0x1e, 0x56, 0xb6, 0x3c, 0xac, 0x6c, 0x7b, 0x58, 0xd9, 0xf1,
+	0x30, 0xd8, 0xf5, 0x30, 0xd8, 0xf3, 0xb0, 0xb2, 0xef, 0x61, 0x70, 0xe0, 0x61, 0xe5, 0xd0, 0xc3,
+	0xe0, 0xc8, 0xc3, 0xca, 0x03, 0x1f, 0x2b, 0x0f, 0x7d, 0x0c, 0xbe, 0xf0, 0xb1, 0xf2, 0x9d, 0x8f,
+	0xc1, 0x4f, 0x3e, 0x56, 0x36, 0x7c, 0xac, 0x6c, 0xfa, 0x18, 0x6c, 0xf9, 0x18, 0x6c, 0xfb, 0x18,
+	0x54, 0x67, 0x4d, 0x46, 0x44, 0x83, 0x8a, 0x86, 0x65, 0x9b, 0x9c, 0xd8, 0x54, 0xdc, 0x63, 0x6e,
+	0x53, 0x4f, 0xff, 0x19, 0x76, 0x9a, 0xa6, 0x2e, 0x84, 0xed, 0xd4, 0x6a, 0x05, 0xd9, 0xad, 0xf2,
+	0xbf, 0x03, 0x00, 0x6a, 0x91, 0xc1, 0xc6, 0x20, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -118,6 +120,11 @@ type ApplicationRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*Applications, error)
 	Update(ctx context.Context, in *UpdateApplicationRequest, opts ...grpc.CallOption) (*Application, error)
 	Delete(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted application.
+	Restore(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the application. This permanently deletes the application and the data
+	// related to it, after which its ID can be reused.
+	Purge(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type applicationRegistryClient struct {
@@ -173,6 +180,24 @@ func (c *applicationRegistryClient) Delete(ctx context.Context, in *ApplicationI
 	return out, nil
 }
 
+func (c *applicationRegistryClient) Restore(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationRegistryClient) Purge(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationRegistryServer is the server API for ApplicationRegistry service.
 type ApplicationRegistryServer interface {
 	// Create a new application. This also sets the given organization or user as
@@ -186,6 +211,11 @@ type ApplicationRegistryServer interface {
 	List(context.Context, *ListApplicationsRequest) (*Applications, error)
 	Update(context.Context, *UpdateApplicationRequest) (*Application, error)
 	Delete(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted application.
+	Restore(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// Purge the application. This permanently deletes the application and the data
+	// related to it, after which its ID can be reused.
+	Purge(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
 }
 
 func RegisterApplicationRegistryServer(s *grpc.Server, srv ApplicationRegistryServer) {
@@ -282,6 +312,42 @@ func _ApplicationRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).Restore(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).Purge(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationRegistry",
 	HandlerType: (*ApplicationRegistryServer)(nil),
@@ -306,6 +372,14 @@ var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ApplicationRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _ApplicationRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/application_services.proto",
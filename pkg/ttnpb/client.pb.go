@@ -67,6 +67,7 @@ type Client struct {
 	ClientIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt         time.Time         `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	UpdatedAt         time.Time         `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	DeletedAt         *time.Time        `protobuf:"bytes,15,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
 	Name              string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Description       string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes        map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -143,6 +144,13 @@ func (m *Client) GetUpdatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Client) GetDeletedAt() *time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
 func (m *Client) GetName() string {
 	if m != nil {
 		return m.Name
@@ -320,7 +328,9 @@ type ListClientsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted OAuth clients.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -392,6 +402,13 @@ func (m *ListClientsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListClientsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateClientRequest struct {
 	Client `protobuf:"bytes,1,opt,name=client,proto3,embedded=client" json:"client"`
 	// Collaborator to grant all rights on the newly created client.
@@ -657,82 +674,83 @@ func init() {
 }
 
 var fileDescriptor_c5f33a3b812bf10c = []byte{
-	// 1188 bytes of a gzipped FileDescriptorProto
+	// 1212 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6c, 0x13, 0x47,
-	0x14, 0xde, 0xb1, 0x13, 0x27, 0x9e, 0xfc, 0xe0, 0x0e, 0x2d, 0xdd, 0x86, 0x74, 0xe2, 0xba, 0x11,
-	0x32, 0x08, 0xaf, 0x91, 0x11, 0x52, 0x4b, 0x7f, 0xc0, 0x1b, 0x02, 0x44, 0x6d, 0xe3, 0x76, 0x92,
-	0xa8, 0x12, 0x94, 0x5a, 0x93, 0xf5, 0x64, 0x33, 0xb2, 0xbd, 0xeb, 0xce, 0x8c, 0x43, 0x43, 0x55,
-	0x09, 0xf5, 0x84, 0x7a, 0x42, 0x3d, 0xa1, 0x9e, 0xaa, 0xf6, 0x50, 0x8e, 0x1c, 0x39, 0xa2, 0x9e,
-	0x38, 0xe6, 0x56, 0x4e, 0x14, 0xaf, 0x2f, 0x39, 0xa2, 0x9e, 0x50, 0x4e, 0xd5, 0xfe, 0x38, 0x71,
-	0x6c, 0x83, 0xd4, 0x06, 0x7a, 0x9b, 0x37, 0xef, 0x7b, 0x6f, 0xde, 0x7c, 0xf3, 0xbd, 0xb7, 0x0b,
-	0x71, 0xcd, 0x15, 0xf4, 0x3a, 0x75, 0x72, 0x52, 0x51, 0xab, 0x9a, 0xa7, 0x0d, 0x9e, 0xb7, 0x6a,
-	0x9c, 0x39, 0xca, 0x68, 0x08, 0x57, 0xb9, 0x68, 0x52, 0x29, 0xc7, 0x88, 0x30, 0xc6, 0xc6, 0xe9,
-	0xa9, 0xa2, 0xcd, 0xd5, 0x7a, 0x73, 0xd5, 0xb0, 0xdc, 0x7a, 0x9e, 0x39, 0x1b, 0xee, 0x66, 0x43,
-	0xb8, 0xdf, 0x6e, 0xe6, 0x03, 0xb0, 0x95, 0xb3, 0x99, 0x93, 0xdb, 0xa0, 0x35, 0x5e, 0xa1, 0x8a,
-	0xe5, 0xfb, 0x16, 0x61, 0xca, 0xa9, 0x5c, 0x57, 0x0a, 0xdb, 0xb5, 0xdd, 0x30, 0x78, 0xb5, 0xb9,
-	0x16, 0x58, 0x81, 0x11, 0xac, 0x22, 0x78, 0xda, 0x76, 0x5d, 0xbb, 0xc6, 0xf6, 0x50, 0x6b, 0x9c,
-	0xd5, 0x2a, 0xe5, 0x3a, 0x95, 0xd5, 0x08, 0x31, 0xd3, 0x8b, 0x50, 0xbc, 0xce, 0xa4, 0xa2, 0xf5,
-	0x46, 0x04, 0x98, 0x1d, 0x70, 0x49, 0xd7, 0x51, 0xd4, 0x52, 0x65, 0xee, 0xac, 0x75, 0x0e, 0x7a,
-	0xbb, 0x1f, 0xc5, 0x9c, 0x66, 0x5d, 0x46, 0xee, 0x77, 0xfb, 0xdd, 0xbc, 0xc2, 0x1c, 0xc5, 0xd7,
-	0x38, 0x13, 0x1d, 0xd0, 0x00, 0x3a, 0x05, 0xb7, 0xd7, 0x55, 0xe4, 0xcf, 0x6c, 0x27, 0x60, 0x62,
-	0x2e, 0xe0, 0x17, 0xcd, 0xc3, 0x38, 0xaf, 0x48, 0x1d, 0xa4, 0x41, 0x76, 0xac, 0xf0, 0x8e, 0xb1,
-	0x9f, 0x67, 0x23, 0x04, 0x2d, 0xec, 0x1d, 0x60, 0xa6, 0x76, 0xcc, 0xe1, 0x1f, 0x41, 0x2c, 0x05,
-	0x1e, 0x3e, 0x9e, 0xd1, 0xb6, 0x1e, 0xcf, 0x00, 0xe2, 0xc7, 0xa3, 0x39, 0x08, 0x2d, 0xc1, 0xa8,
-	0x62, 0x95, 0x32, 0x55, 0x7a, 0x2c, 0xc8, 0x36, 0x65, 0x84, 0x8c, 0x18, 0x1d, 0x46, 0x8c, 0xe5,
-	0x0e, 0x23, 0xe6, 0xa8, 0x1f, 0x7e, 0xfb, 0xaf, 0x19, 0x40, 0x92, 0x51, 0x5c, 0x51, 0xf9, 0x49,
-	0x9a, 0x8d, 0x4a, 0x27, 0x49, 0xfc, 0xdf, 0x24, 0x89, 0xe2, 0x8a, 0x0a, 0x1d, 0x85, 0x43, 0x0e,
-	0xad, 0x33, 0x7d, 0x28, 0x0d, 0xb2, 0x49, 0x73, 0x64, 0xc7, 0x1c, 0x12, 0x31, 0xbd, 0x40, 0x82,
-	0x4d, 0x74, 0x02, 0x8e, 0x55, 0x98, 0xb4, 0x04, 0x6f, 0x28, 0xee, 0x3a, 0xfa, 0x70, 0x80, 0x19,
-	0xdd, 0x31, 0x87, 0x45, 0x5c, 0xdf, 0x3a, 0x44, 0xba, 0x9d, 0x48, 0x41, 0x48, 0x95, 0x12, 0x7c,
-	0xb5, 0xa9, 0x98, 0xd4, 0x13, 0xe9, 0x78, 0x76, 0xac, 0x70, 0x6c, 0x30, 0x41, 0x46, 0x71, 0x17,
-	0x38, 0xef, 0x28, 0xb1, 0x69, 0x9e, 0xdc, 0x31, 0x8f, 0xff, 0x0c, 0x8e, 0x65, 0x66, 0x45, 0x46,
-	0x9f, 0x2d, 0xe0, 0xaf, 0xaf, 0xd2, 0xdc, 0x8d, 0x53, 0xb9, 0xf7, 0xaf, 0x65, 0xcf, 0x9d, 0xbd,
-	0x9a, 0xbb, 0x76, 0xae, 0x63, 0x1e, 0xff, 0xae, 0x70, 0xf2, 0xfb, 0x59, 0xd2, 0x75, 0x0e, 0xfa,
-	0x18, 0x8e, 0x77, 0x8b, 0x42, 0x1f, 0x09, 0xce, 0x3d, 0xda, 0x77, 0x6e, 0x88, 0x59, 0x70, 0xd6,
-	0x5c, 0x32, 0x66, 0xed, 0x19, 0xe8, 0x08, 0x4c, 0x48, 0x66, 0x09, 0xa6, 0xf4, 0x51, 0xff, 0x72,
-	0x24, 0xb2, 0xd0, 0x19, 0x38, 0x21, 0x58, 0x85, 0x0b, 0x66, 0xa9, 0x72, 0x53, 0x70, 0xa9, 0x27,
-	0xd3, 0xf1, 0x6c, 0xd2, 0x4c, 0x79, 0x8f, 0x67, 0xc6, 0x49, 0xe4, 0x58, 0x21, 0x0b, 0x92, 0x8c,
-	0x77, 0x60, 0x2b, 0x82, 0x4b, 0x74, 0x06, 0x0e, 0x4b, 0x45, 0x15, 0xd3, 0x61, 0x1a, 0x64, 0x27,
-	0x0b, 0x6f, 0xf4, 0xd6, 0xb1, 0xe4, 0x3b, 0x03, 0x06, 0x7f, 0xf0, 0x45, 0x41, 0x42, 0x34, 0xca,
-	0x41, 0x24, 0xab, 0xbc, 0x51, 0xa6, 0x4d, 0xb5, 0xee, 0x0a, 0x7e, 0x83, 0x06, 0x74, 0x8f, 0xa5,
-	0x41, 0x76, 0x94, 0xbc, 0xe6, 0x7b, 0x8a, 0xdd, 0x0e, 0x34, 0x05, 0x47, 0x99, 0x53, 0x71, 0x85,
-	0x64, 0x15, 0x7d, 0x3c, 0x00, 0xed, 0xda, 0xe8, 0x3c, 0x4c, 0xd8, 0x82, 0x3a, 0x4a, 0xea, 0x13,
-	0xe9, 0x78, 0x76, 0xb2, 0xf0, 0x56, 0x6f, 0x09, 0x97, 0x7c, 0xef, 0xf2, 0x66, 0x83, 0x99, 0x13,
-	0x3b, 0x26, 0xfc, 0x09, 0x8c, 0x64, 0xa2, 0x5a, 0xa2, 0x38, 0xf4, 0x21, 0x4c, 0x84, 0xea, 0xd7,
-	0x27, 0x83, 0x0c, 0x7d, 0x97, 0x20, 0xbe, 0xb7, 0x2f, 0x3a, 0x8c, 0x99, 0xfa, 0x08, 0x1e, 0xea,
-	0x79, 0x5d, 0x94, 0x82, 0xf1, 0x2a, 0xdb, 0x0c, 0x7a, 0x26, 0x49, 0xfc, 0x25, 0x7a, 0x1d, 0x0e,
-	0x6f, 0xd0, 0x5a, 0x93, 0x05, 0xca, 0x4f, 0x92, 0xd0, 0x38, 0x1b, 0x7b, 0x0f, 0x64, 0x3e, 0x80,
-	0x23, 0xa1, 0x46, 0x24, 0x3a, 0x05, 0x47, 0xc2, 0xa1, 0xe6, 0xb7, 0x9b, 0xff, 0xaa, 0x47, 0x06,
-	0xab, 0x89, 0x74, 0x60, 0x99, 0xdf, 0x01, 0x4c, 0x5d, 0x62, 0x2a, 0xda, 0x66, 0xdf, 0x34, 0x99,
-	0x54, 0x88, 0x40, 0x18, 0xfa, 0xcb, 0x07, 0x6c, 0xdc, 0xa4, 0x15, 0x81, 0x24, 0x3a, 0x07, 0xe1,
-	0xde, 0x3c, 0x7b, 0x6e, 0xfb, 0x5e, 0xf4, 0x21, 0x9f, 0x51, 0x59, 0x35, 0x87, 0xfc, 0x24, 0x24,
-	0xb9, 0xd6, 0xd9, 0xc8, 0xfc, 0x0d, 0x20, 0xfa, 0x94, 0xcb, 0xa8, 0x54, 0xd9, 0xa9, 0xf5, 0x0b,
-	0x5f, 0xcd, 0xb5, 0x1a, 0x5d, 0x75, 0x05, 0x55, 0xae, 0x88, 0xaa, 0xcd, 0xf5, 0x56, 0x5b, 0x12,
-	0x36, 0x75, 0x22, 0x31, 0x94, 0xc4, 0x8a, 0x64, 0xa2, 0xab, 0x72, 0xb2, 0x2f, 0xc5, 0x81, 0x4b,
-	0xf5, 0xdf, 0xca, 0x15, 0x15, 0x26, 0x82, 0x01, 0x93, 0x24, 0xa1, 0x81, 0x30, 0x1c, 0xae, 0xf1,
-	0x3a, 0x57, 0xc1, 0xdc, 0x98, 0x08, 0x14, 0x7d, 0x22, 0xae, 0x6f, 0x8f, 0x90, 0x70, 0x1b, 0x21,
-	0x38, 0xd4, 0xa0, 0x36, 0x0b, 0x46, 0xc6, 0x04, 0x09, 0xd6, 0x99, 0xfb, 0x00, 0x1e, 0x9e, 0x0b,
-	0xa6, 0xd7, 0xfe, 0x17, 0x3a, 0x0f, 0x13, 0x21, 0xb5, 0xd1, 0x7d, 0x9f, 0xf3, 0xce, 0x03, 0x9e,
-	0x24, 0x8a, 0x43, 0xe5, 0x1e, 0xde, 0x62, 0xff, 0x81, 0x37, 0x73, 0xbc, 0x3b, 0xfd, 0x7e, 0x16,
-	0x33, 0x77, 0x00, 0x3c, 0xbc, 0x12, 0xcc, 0xcc, 0x97, 0x5d, 0xfa, 0x81, 0xa5, 0x74, 0x17, 0x40,
-	0xbc, 0x27, 0xa5, 0xb9, 0xae, 0xaa, 0xe5, 0xab, 0x6c, 0x81, 0x5d, 0x01, 0xc4, 0x5e, 0x2c, 0x80,
-	0x78, 0x97, 0x00, 0xfe, 0x04, 0x70, 0x7a, 0xb7, 0x3f, 0xbb, 0x2b, 0x7d, 0x95, 0x85, 0x5a, 0x2f,
-	0x43, 0x1b, 0xfd, 0x27, 0xec, 0xd7, 0xc7, 0x1f, 0x00, 0x4e, 0x2f, 0xfd, 0xdf, 0x37, 0x5b, 0x1c,
-	0x78, 0xb3, 0xe9, 0xfe, 0x6f, 0xdf, 0x1e, 0xe6, 0x45, 0x22, 0x3f, 0xf1, 0x15, 0x4c, 0xee, 0x7e,
-	0x1c, 0xd0, 0x34, 0xd4, 0x2f, 0x91, 0xe2, 0xe2, 0x72, 0xb9, 0xb8, 0xb2, 0x7c, 0xb9, 0x44, 0x16,
-	0xae, 0x14, 0x97, 0x17, 0x4a, 0x8b, 0xe5, 0xb9, 0xd2, 0x85, 0xf9, 0x94, 0x86, 0x10, 0x9c, 0x0c,
-	0xbd, 0x9f, 0x17, 0x97, 0x96, 0xbe, 0x2c, 0x91, 0x0b, 0x29, 0x80, 0xde, 0x84, 0x87, 0xc3, 0x3d,
-	0x32, 0x7f, 0x91, 0xcc, 0x2f, 0x5d, 0x2e, 0x2f, 0x97, 0x3e, 0x99, 0x5f, 0x4c, 0xc5, 0xa6, 0x86,
-	0x6e, 0xfd, 0x86, 0x35, 0xf3, 0x57, 0xf0, 0xb0, 0x85, 0xc1, 0x56, 0x0b, 0x83, 0x47, 0x2d, 0xac,
-	0x3d, 0x69, 0x61, 0x6d, 0xbb, 0x85, 0xb5, 0xa7, 0x2d, 0xac, 0x3d, 0x6b, 0x61, 0x70, 0xd3, 0xc3,
-	0xe0, 0x96, 0x87, 0xb5, 0xbb, 0x1e, 0x06, 0xf7, 0x3c, 0xac, 0xdd, 0xf7, 0xb0, 0xf6, 0xc0, 0xc3,
-	0xda, 0x43, 0x0f, 0x83, 0x2d, 0x0f, 0x83, 0x47, 0x1e, 0xd6, 0x9e, 0x78, 0x18, 0x6c, 0x7b, 0x58,
-	0x7b, 0xea, 0x61, 0xf0, 0xcc, 0xc3, 0xda, 0xcd, 0x36, 0xd6, 0x6e, 0xb5, 0x31, 0xb8, 0xdd, 0xc6,
-	0xda, 0x9d, 0x36, 0x06, 0xbf, 0xb4, 0xb1, 0x76, 0xb7, 0x8d, 0xb5, 0x7b, 0x6d, 0x0c, 0xee, 0xb7,
-	0x31, 0x78, 0xd0, 0xc6, 0xe0, 0xca, 0x49, 0xdb, 0x35, 0xd4, 0x3a, 0x53, 0xeb, 0xdc, 0xb1, 0xa5,
-	0xe1, 0x30, 0x75, 0xdd, 0x15, 0xd5, 0xfc, 0xfe, 0x1f, 0xbe, 0x46, 0xd5, 0xce, 0x2b, 0xe5, 0x34,
-	0x56, 0x57, 0x13, 0x41, 0xc7, 0x9d, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x72, 0x67, 0xae, 0x65,
-	0x61, 0x0b, 0x00, 0x00,
+	0x14, 0xde, 0xb1, 0x1d, 0x3b, 0x9e, 0xfc, 0xe0, 0x0e, 0x2d, 0xdd, 0x86, 0x74, 0xe2, 0xba, 0x11,
+	0x32, 0x08, 0xdb, 0xc8, 0x08, 0xa9, 0xa5, 0x3f, 0xe0, 0x0d, 0x21, 0x44, 0x6d, 0xe3, 0x76, 0x92,
+	0xa8, 0x12, 0x94, 0x5a, 0x13, 0xef, 0x64, 0x33, 0xb2, 0xbd, 0xeb, 0xce, 0x8c, 0x43, 0x43, 0x55,
+	0x09, 0xf5, 0x84, 0xaa, 0x1e, 0x50, 0x4f, 0xa8, 0xa7, 0xaa, 0x3d, 0x94, 0xde, 0x38, 0x72, 0x44,
+	0x3d, 0x71, 0xcc, 0xad, 0x9c, 0x28, 0x5e, 0x5f, 0x38, 0x72, 0x44, 0x39, 0x55, 0xfb, 0xe3, 0xc4,
+	0xb1, 0x0d, 0x55, 0x0b, 0xf4, 0xb6, 0x6f, 0xde, 0xf7, 0x7e, 0xe6, 0x9b, 0xef, 0x3d, 0x1b, 0xe2,
+	0xba, 0x23, 0xe8, 0x15, 0x6a, 0xe7, 0xa4, 0xa2, 0xd5, 0x5a, 0x81, 0x36, 0x79, 0xa1, 0x5a, 0xe7,
+	0xcc, 0x56, 0xf9, 0xa6, 0x70, 0x94, 0x83, 0x26, 0x95, 0xb2, 0xf3, 0x21, 0x26, 0xbf, 0x79, 0x72,
+	0xaa, 0x64, 0x71, 0xb5, 0xd1, 0x5a, 0xcb, 0x57, 0x9d, 0x46, 0x81, 0xd9, 0x9b, 0xce, 0x56, 0x53,
+	0x38, 0x5f, 0x6f, 0x15, 0x7c, 0x70, 0x35, 0x67, 0x31, 0x3b, 0xb7, 0x49, 0xeb, 0xdc, 0xa4, 0x8a,
+	0x15, 0x06, 0x3e, 0x82, 0x94, 0x53, 0xb9, 0x9e, 0x14, 0x96, 0x63, 0x39, 0x41, 0xf0, 0x5a, 0x6b,
+	0xdd, 0xb7, 0x7c, 0xc3, 0xff, 0x0a, 0xe1, 0x69, 0xcb, 0x71, 0xac, 0x3a, 0xdb, 0x43, 0xad, 0x73,
+	0x56, 0x37, 0x2b, 0x0d, 0x2a, 0x6b, 0x21, 0x62, 0xa6, 0x1f, 0xa1, 0x78, 0x83, 0x49, 0x45, 0x1b,
+	0xcd, 0x10, 0x30, 0x3b, 0xe4, 0x92, 0x8e, 0xad, 0x68, 0x55, 0x55, 0xb8, 0xbd, 0xde, 0x2d, 0xf4,
+	0xe6, 0x20, 0x8a, 0xd9, 0xad, 0x86, 0x0c, 0xdd, 0x6f, 0x0f, 0xba, 0xb9, 0xc9, 0x6c, 0xc5, 0xd7,
+	0x39, 0x13, 0x5d, 0xd0, 0x10, 0x3a, 0x05, 0xb7, 0x36, 0x54, 0xe8, 0xcf, 0xfc, 0x9e, 0x80, 0xf1,
+	0x39, 0x9f, 0x5f, 0x34, 0x0f, 0xa3, 0xdc, 0x94, 0x3a, 0x48, 0x83, 0xec, 0x58, 0xf1, 0xad, 0xfc,
+	0x7e, 0x9e, 0xf3, 0x01, 0x68, 0x71, 0xaf, 0x80, 0x91, 0xda, 0x31, 0x46, 0xbe, 0x07, 0x91, 0x14,
+	0xb8, 0xf7, 0x60, 0x46, 0xdb, 0x7e, 0x30, 0x03, 0x88, 0x17, 0x8f, 0xe6, 0x20, 0xac, 0x0a, 0x46,
+	0x15, 0x33, 0x2b, 0x54, 0xe9, 0x11, 0x3f, 0xdb, 0x54, 0x3e, 0x60, 0x24, 0xdf, 0x65, 0x24, 0xbf,
+	0xd2, 0x65, 0xc4, 0x18, 0xf5, 0xc2, 0x6f, 0xfc, 0x35, 0x03, 0x48, 0x32, 0x8c, 0x2b, 0x29, 0x2f,
+	0x49, 0xab, 0x69, 0x76, 0x93, 0x44, 0xff, 0x4d, 0x92, 0x30, 0xae, 0xa4, 0xd0, 0x19, 0x08, 0x4d,
+	0x56, 0x67, 0x61, 0x92, 0x03, 0xff, 0x98, 0x24, 0x16, 0x24, 0x08, 0x63, 0x4a, 0x0a, 0x1d, 0x86,
+	0x31, 0x9b, 0x36, 0x98, 0x1e, 0x4b, 0x83, 0x6c, 0xd2, 0x48, 0xec, 0x18, 0x31, 0x11, 0xd1, 0x8b,
+	0xc4, 0x3f, 0x44, 0xc7, 0xe0, 0x98, 0xc9, 0x64, 0x55, 0xf0, 0xa6, 0xe2, 0x8e, 0xad, 0x8f, 0xf8,
+	0x98, 0xd1, 0x1d, 0x63, 0x44, 0x44, 0xf5, 0xed, 0x03, 0xa4, 0xd7, 0x89, 0x14, 0x84, 0x54, 0x29,
+	0xc1, 0xd7, 0x5a, 0x8a, 0x49, 0x3d, 0x9e, 0x8e, 0x66, 0xc7, 0x8a, 0x47, 0x86, 0x33, 0x9c, 0x2f,
+	0xed, 0x02, 0xe7, 0x6d, 0x25, 0xb6, 0x8c, 0xe3, 0x3b, 0xc6, 0xd1, 0x9f, 0xc0, 0x91, 0xcc, 0xac,
+	0xc8, 0xe8, 0xb3, 0x45, 0xfc, 0xe5, 0x25, 0x9a, 0xbb, 0x7a, 0x22, 0xf7, 0xee, 0xe5, 0xec, 0x99,
+	0xd3, 0x97, 0x72, 0x97, 0xcf, 0x74, 0xcd, 0xa3, 0xdf, 0x14, 0x8f, 0x7f, 0x3b, 0x4b, 0x7a, 0xea,
+	0xa0, 0x0f, 0xe1, 0x78, 0xaf, 0xaa, 0xf4, 0x84, 0x5f, 0xf7, 0xf0, 0x40, 0xdd, 0x00, 0xb3, 0x68,
+	0xaf, 0x3b, 0x64, 0xac, 0xba, 0x67, 0xa0, 0x43, 0x30, 0x2e, 0x59, 0x55, 0x30, 0xa5, 0x8f, 0x7a,
+	0x97, 0x23, 0xa1, 0x85, 0x4e, 0xc1, 0x09, 0xc1, 0x4c, 0x2e, 0x58, 0x55, 0x55, 0x5a, 0x82, 0x4b,
+	0x3d, 0x99, 0x8e, 0x66, 0x93, 0x46, 0xca, 0x7d, 0x30, 0x33, 0x4e, 0x42, 0xc7, 0x2a, 0x59, 0x94,
+	0x64, 0xbc, 0x0b, 0x5b, 0x15, 0x5c, 0xa2, 0x53, 0x70, 0x44, 0x2a, 0xaa, 0x98, 0x0e, 0xd3, 0x20,
+	0x3b, 0x59, 0x7c, 0xad, 0xbf, 0x8f, 0x65, 0xcf, 0xe9, 0x33, 0xf8, 0x9d, 0xa7, 0x2a, 0x12, 0xa0,
+	0x51, 0x0e, 0x22, 0x59, 0xe3, 0xcd, 0x0a, 0x6d, 0xa9, 0x0d, 0x47, 0xf0, 0xab, 0xd4, 0xa7, 0x7b,
+	0x2c, 0x0d, 0xb2, 0xa3, 0xe4, 0x15, 0xcf, 0x53, 0xea, 0x75, 0xa0, 0x29, 0x38, 0xca, 0x6c, 0xd3,
+	0x11, 0x92, 0x99, 0xfa, 0xb8, 0x0f, 0xda, 0xb5, 0xd1, 0x59, 0x18, 0xb7, 0x04, 0xb5, 0x95, 0xd4,
+	0x27, 0xd2, 0xd1, 0xec, 0x64, 0xf1, 0x8d, 0xfe, 0x16, 0x16, 0x3c, 0xef, 0xca, 0x56, 0x93, 0x19,
+	0x13, 0x3b, 0x06, 0xfc, 0x11, 0x24, 0x32, 0x61, 0x2f, 0x61, 0x1c, 0x7a, 0x1f, 0xc6, 0x83, 0xf1,
+	0xd1, 0x27, 0xd3, 0xd1, 0x61, 0x97, 0x20, 0x9e, 0x77, 0x20, 0x3a, 0x88, 0x99, 0xfa, 0x00, 0x1e,
+	0xe8, 0x7b, 0x5d, 0x94, 0x82, 0xd1, 0x1a, 0xdb, 0xf2, 0x87, 0x2e, 0x49, 0xbc, 0x4f, 0xf4, 0x2a,
+	0x1c, 0xd9, 0xa4, 0xf5, 0x16, 0xf3, 0x47, 0x27, 0x49, 0x02, 0xe3, 0x74, 0xe4, 0x1d, 0x90, 0x79,
+	0x0f, 0x26, 0x02, 0x8d, 0x48, 0x74, 0x02, 0x26, 0x82, 0xad, 0xe8, 0xcd, 0xab, 0xf7, 0xaa, 0x87,
+	0x86, 0xab, 0x89, 0x74, 0x61, 0x99, 0xdf, 0x00, 0x4c, 0x2d, 0x30, 0x15, 0x1e, 0xb3, 0xaf, 0x5a,
+	0x4c, 0x2a, 0x44, 0x20, 0x0c, 0xfc, 0x95, 0xe7, 0x9c, 0xfc, 0x64, 0x35, 0x04, 0x49, 0x6f, 0xea,
+	0xf6, 0x16, 0xe2, 0x53, 0xe7, 0xff, 0xbc, 0x07, 0xf9, 0x84, 0xca, 0x9a, 0x11, 0xf3, 0x92, 0x90,
+	0xe4, 0x7a, 0xf7, 0x20, 0xf3, 0x43, 0x04, 0xa2, 0x8f, 0xb9, 0x0c, 0x5b, 0x95, 0xdd, 0x5e, 0x3f,
+	0xf3, 0xd4, 0x5c, 0xaf, 0xd3, 0x35, 0x47, 0x50, 0xe5, 0x88, 0xb0, 0xdb, 0x5c, 0x7f, 0xb7, 0x65,
+	0x61, 0x51, 0x3b, 0x14, 0x43, 0x59, 0xac, 0x4a, 0x26, 0x7a, 0x3a, 0x27, 0xfb, 0x52, 0x3c, 0x77,
+	0xab, 0xde, 0x5b, 0x39, 0xc2, 0x64, 0xc2, 0xdf, 0x50, 0x49, 0x12, 0x18, 0x08, 0xc3, 0x91, 0x3a,
+	0x6f, 0x70, 0xe5, 0xef, 0x8d, 0x09, 0x5f, 0xd1, 0xc7, 0xa2, 0xfa, 0xa3, 0x04, 0x09, 0x8e, 0x11,
+	0x82, 0xb1, 0x26, 0xb5, 0x98, 0xbf, 0x32, 0x26, 0x88, 0xff, 0x8d, 0x74, 0x98, 0x08, 0xf7, 0x8e,
+	0x1e, 0xf7, 0x55, 0xdb, 0x35, 0x33, 0x77, 0x00, 0x3c, 0x38, 0xe7, 0x2f, 0xc6, 0xfd, 0x6f, 0x77,
+	0x16, 0xc6, 0x03, 0xd2, 0x43, 0x26, 0x9e, 0xa2, 0x80, 0x21, 0x8f, 0x15, 0xc6, 0xa1, 0x4a, 0x1f,
+	0xa3, 0x91, 0xff, 0xc0, 0xa8, 0x31, 0xde, 0x9b, 0x7e, 0x3f, 0xbf, 0x99, 0x9b, 0x00, 0x1e, 0x5c,
+	0xf5, 0xd7, 0xf1, 0x8b, 0x6e, 0xfd, 0xb9, 0x45, 0x76, 0x0b, 0x40, 0xbc, 0x27, 0xb2, 0xb9, 0x9e,
+	0xae, 0xe5, 0xcb, 0x1c, 0x8e, 0x5d, 0x69, 0x44, 0x9e, 0x2d, 0x8d, 0xe8, 0x9e, 0x34, 0x32, 0x7f,
+	0x02, 0x38, 0xbd, 0xc0, 0x86, 0x74, 0xfa, 0x32, 0x1b, 0xad, 0xbe, 0x08, 0x6d, 0x0c, 0x56, 0xd8,
+	0xaf, 0x8f, 0x3f, 0x00, 0x9c, 0x5e, 0xfe, 0xbf, 0x6f, 0xb6, 0x34, 0xf4, 0x66, 0xd3, 0x03, 0x59,
+	0x7b, 0x30, 0xcf, 0x12, 0xf9, 0xb1, 0x2f, 0x60, 0x72, 0xf7, 0x67, 0x03, 0x4d, 0x43, 0x7d, 0x81,
+	0x94, 0x96, 0x56, 0x2a, 0xa5, 0xd5, 0x95, 0x0b, 0x65, 0xb2, 0x78, 0xb1, 0xb4, 0xb2, 0x58, 0x5e,
+	0xaa, 0xcc, 0x95, 0xcf, 0xcd, 0xa7, 0x34, 0x84, 0xe0, 0x64, 0xe0, 0xfd, 0xb4, 0xb4, 0xbc, 0xfc,
+	0x79, 0x99, 0x9c, 0x4b, 0x01, 0xf4, 0x3a, 0x3c, 0x18, 0x9c, 0x91, 0xf9, 0xf3, 0x64, 0x7e, 0xf9,
+	0x42, 0x65, 0xa5, 0xfc, 0xd1, 0xfc, 0x52, 0x2a, 0x32, 0x15, 0xbb, 0xfe, 0x2b, 0xd6, 0x8c, 0x5f,
+	0xc0, 0xbd, 0x36, 0x06, 0xdb, 0x6d, 0x0c, 0xee, 0xb7, 0xb1, 0xf6, 0xb0, 0x8d, 0xb5, 0x47, 0x6d,
+	0xac, 0x3d, 0x6e, 0x63, 0xed, 0x49, 0x1b, 0x83, 0x6b, 0x2e, 0x06, 0xd7, 0x5d, 0xac, 0xdd, 0x72,
+	0x31, 0xb8, 0xed, 0x62, 0xed, 0x8e, 0x8b, 0xb5, 0xbb, 0x2e, 0xd6, 0xee, 0xb9, 0x18, 0x6c, 0xbb,
+	0x18, 0xdc, 0x77, 0xb1, 0xf6, 0xd0, 0xc5, 0xe0, 0x91, 0x8b, 0xb5, 0xc7, 0x2e, 0x06, 0x4f, 0x5c,
+	0xac, 0x5d, 0xeb, 0x60, 0xed, 0x7a, 0x07, 0x83, 0x1b, 0x1d, 0xac, 0xdd, 0xec, 0x60, 0xf0, 0x73,
+	0x07, 0x6b, 0xb7, 0x3a, 0x58, 0xbb, 0xdd, 0xc1, 0xe0, 0x4e, 0x07, 0x83, 0xbb, 0x1d, 0x0c, 0x2e,
+	0x1e, 0xb7, 0x9c, 0xbc, 0xda, 0x60, 0x6a, 0x83, 0xdb, 0x96, 0xcc, 0xdb, 0x4c, 0x5d, 0x71, 0x44,
+	0xad, 0xb0, 0xff, 0xbf, 0x64, 0xb3, 0x66, 0x15, 0x94, 0xb2, 0x9b, 0x6b, 0x6b, 0x71, 0x7f, 0xe2,
+	0x4e, 0xfe, 0x3d, 0x00, 0x36, 0xd4, 0x46, 0x83, 0xbc, 0x0b, 0x00, 0x00,
 }
 
 func (x GrantType) String() string {
@@ -770,6 +788,13 @@ func (this *Client) Equal(that interface{}) bool {
 	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
 	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
 	if this.Name != that1.Name {
 		return false
 	}
@@ -920,6 +945,9 @@ func (this *ListClientsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateClientRequest) Equal(that interface{}) bool {
@@ -1220,6 +1248,16 @@ func (m *Client) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintClient(dAtA, i, uint64(j6))
 		i += copy(dAtA[i:], dAtA7[:j6])
 	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintClient(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)))
+		n8, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	return i, nil
 }
 
@@ -1271,19 +1309,19 @@ func (m *GetClientRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.ClientIdentifiers.Size()))
-	n8, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
+	n9, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.FieldMask.Size()))
-	n9, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n10, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	return i, nil
 }
 
@@ -1306,20 +1344,20 @@ func (m *ListClientsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintClient(dAtA, i, uint64(m.Collaborator.Size()))
-		n10, err := m.Collaborator.MarshalTo(dAtA[i:])
+		n11, err := m.Collaborator.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.FieldMask.Size()))
-	n11, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n12, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -1336,6 +1374,16 @@ func (m *ListClientsRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintClient(dAtA, i, uint64(m.Page))
 	}
+	if m.Deleted {
+		dAtA[i] = 0x30
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1357,19 +1405,19 @@ func (m *CreateClientRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.Client.Size()))
-	n12, err := m.Client.MarshalTo(dAtA[i:])
+	n13, err := m.Client.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.Collaborator.Size()))
-	n13, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n14, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	return i, nil
 }
 
@@ -1391,19 +1439,19 @@ func (m *UpdateClientRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.Client.Size()))
-	n14, err := m.Client.MarshalTo(dAtA[i:])
+	n15, err := m.Client.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.FieldMask.Size()))
-	n15, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n16, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	return i, nil
 }
 
@@ -1425,11 +1473,11 @@ func (m *ListClientCollaboratorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.ClientIdentifiers.Size()))
-	n16, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
+	n17, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1461,19 +1509,19 @@ func (m *GetClientCollaboratorRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.ClientIdentifiers.Size()))
-	n17, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
+	n18, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n18, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	return i, nil
 }
 
@@ -1495,19 +1543,19 @@ func (m *SetClientCollaboratorRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.ClientIdentifiers.Size()))
-	n19, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
+	n20, err := m.ClientIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintClient(dAtA, i, uint64(m.Collaborator.Size()))
-	n20, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n21, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n21
 	return i, nil
 }
 
@@ -1563,6 +1611,9 @@ func NewPopulatedClient(r randyClient, easy bool) *Client {
 	for i := 0; i < v8; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1603,6 +1654,7 @@ func NewPopulatedListClientsRequest(r randyClient, easy bool) *ListClientsReques
 	this.Order = randStringClient(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1802,6 +1854,10 @@ func (m *Client) Size() (n int) {
 		}
 		n += 1 + sovClient(uint64(l)) + l
 	}
+	if m.DeletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)
+		n += 1 + l + sovClient(uint64(l))
+	}
 	return n
 }
 
@@ -1855,6 +1911,9 @@ func (m *ListClientsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovClient(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -1969,6 +2028,7 @@ func (this *Client) String() string {
 		`Endorsed:` + fmt.Sprintf("%v", this.Endorsed) + `,`,
 		`Grants:` + fmt.Sprintf("%v", this.Grants) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2004,6 +2064,7 @@ func (this *ListClientsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2686,6 +2747,42 @@ func (m *Client) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -3084,6 +3181,26 @@ func (m *ListClientsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ var ClientFieldPathsNested = []string{
 	"attributes",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"endorsed",
 	"grants",
@@ -24,6 +25,7 @@ var ClientFieldPathsTopLevel = []string{
 	"attributes",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"endorsed",
 	"grants",
@@ -61,6 +63,7 @@ var ListClientsRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -69,6 +72,7 @@ var ListClientsRequestFieldPathsNested = []string{
 
 var ListClientsRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -79,6 +83,7 @@ var CreateClientRequestFieldPathsNested = []string{
 	"client.attributes",
 	"client.contact_info",
 	"client.created_at",
+	"client.deleted_at",
 	"client.description",
 	"client.endorsed",
 	"client.grants",
@@ -109,6 +114,7 @@ var UpdateClientRequestFieldPathsNested = []string{
 	"client.attributes",
 	"client.contact_info",
 	"client.created_at",
+	"client.deleted_at",
 	"client.description",
 	"client.endorsed",
 	"client.grants",
@@ -50,6 +50,15 @@ func (dst *Client) SetFields(src *Client, paths ...string) error {
 				var zero time.Time
 				dst.UpdatedAt = zero
 			}
+		case "deleted_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeletedAt = src.DeletedAt
+			} else {
+				dst.DeletedAt = nil
+			}
 		case "name":
 			if len(subs) > 0 {
 				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
@@ -286,6 +295,16 @@ func (dst *ListClientsRequest) SetFields(src *ListClientsRequest, paths ...strin
 				var zero uint32
 				dst.Page = zero
 			}
+		case "deleted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deleted = src.Deleted
+			} else {
+				var zero bool
+				dst.Deleted = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -36,53 +36,56 @@ func init() {
 }
 
 var fileDescriptor_80815ba053239a77 = []byte{
-	// 731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x3f, 0x4c, 0x14, 0x4d,
-	0x14, 0x9f, 0xe1, 0xfb, 0xbe, 0xcb, 0x97, 0x8d, 0x81, 0x38, 0x31, 0x98, 0x1c, 0xf0, 0x94, 0xc5,
-	0x84, 0x84, 0xc0, 0xac, 0x81, 0x98, 0x18, 0x3b, 0x45, 0x45, 0xa3, 0x8d, 0x10, 0x9b, 0x6b, 0xc8,
-	0xde, 0x31, 0xec, 0x4d, 0xee, 0xd8, 0x39, 0x76, 0xe6, 0x20, 0x48, 0x48, 0x88, 0x85, 0xd2, 0x98,
-	0x48, 0x6c, 0xb4, 0x33, 0x56, 0x94, 0x94, 0x94, 0x94, 0x74, 0x92, 0xd0, 0x50, 0x72, 0xb3, 0x16,
-	0x94, 0x74, 0x52, 0x9a, 0x9b, 0xdd, 0xc5, 0xbd, 0x7f, 0xc2, 0x25, 0x76, 0xb3, 0xf3, 0x7e, 0xf3,
-	0x7b, 0xf3, 0x7e, 0xef, 0xf7, 0x66, 0xad, 0xd1, 0xb2, 0x08, 0xdc, 0x55, 0xd7, 0x9f, 0x90, 0xca,
-	0x2d, 0x94, 0x1c, 0xb7, 0xc2, 0x9d, 0x42, 0x99, 0x33, 0x5f, 0xcd, 0x4b, 0x16, 0xac, 0xf0, 0x02,
-	0x93, 0xb4, 0x12, 0x08, 0x25, 0x48, 0xaf, 0x52, 0x3e, 0x8d, 0xc1, 0x74, 0x65, 0x2a, 0x3b, 0xe1,
-	0x71, 0x55, 0xac, 0xe6, 0x69, 0x41, 0x2c, 0x39, 0x9e, 0xf0, 0x84, 0x63, 0x60, 0xf9, 0xea, 0xa2,
-	0xf9, 0x32, 0x1f, 0x66, 0x15, 0x1d, 0xcf, 0x0e, 0x7a, 0x42, 0x78, 0x65, 0x66, 0x12, 0xb8, 0xbe,
-	0x2f, 0x94, 0xab, 0xb8, 0xf0, 0x63, 0xf2, 0xec, 0x40, 0x1c, 0xbd, 0xe0, 0x60, 0x4b, 0x15, 0xb5,
-	0x16, 0x07, 0xa1, 0xd3, 0x15, 0xe3, 0xf8, 0x48, 0x6b, 0x9c, 0x2f, 0x30, 0x5f, 0xf1, 0x45, 0xce,
-	0x02, 0xd9, 0x99, 0x24, 0xe0, 0x5e, 0x51, 0xc5, 0xf1, 0xc9, 0xf3, 0xff, 0xac, 0xde, 0x69, 0xc3,
-	0x3a, 0xcb, 0x3c, 0x2e, 0x55, 0xb0, 0x46, 0xbe, 0x63, 0x2b, 0x33, 0x1d, 0x30, 0x57, 0x31, 0x32,
-	0x42, 0x1b, 0xab, 0xa7, 0xd1, 0x7e, 0x72, 0x60, 0xb9, 0xca, 0xa4, 0xca, 0xf6, 0xb7, 0x80, 0x4c,
-	0xd8, 0x7e, 0x8f, 0xdf, 0x1e, 0xfd, 0xf8, 0xd4, 0xb3, 0x89, 0x6d, 0xea, 0x54, 0x25, 0x0b, 0xa4,
-	0xb3, 0x5e, 0x10, 0xe5, 0xb2, 0x9b, 0x17, 0x81, 0xab, 0x44, 0x40, 0xeb, 0x7b, 0xf3, 0x7c, 0x41,
-	0x26, 0x8b, 0x8d, 0xb8, 0x3c, 0xf9, 0x00, 0x8f, 0xe5, 0x5e, 0xd8, 0x4f, 0x1d, 0x11, 0x78, 0xae,
-	0xcf, 0xdf, 0x44, 0x8a, 0x35, 0x1d, 0x4e, 0xc7, 0x0c, 0x49, 0xd3, 0x46, 0x9a, 0x8c, 0x14, 0xad,
-	0x7f, 0x66, 0x98, 0x22, 0xb7, 0x9b, 0x2f, 0x3a, 0xc3, 0xd4, 0xd5, 0x4a, 0x19, 0x35, 0x95, 0x0c,
-	0x93, 0x5b, 0x09, 0xab, 0xb3, 0x1e, 0xbb, 0xa5, 0x9e, 0xfa, 0x62, 0xb9, 0x41, 0x8e, 0xb0, 0xf5,
-	0xef, 0x4b, 0x2e, 0x15, 0xb1, 0x9b, 0x99, 0xea, 0xbb, 0x11, 0x9b, 0x4c, 0xb2, 0xdd, 0x6c, 0x9f,
-	0x4d, 0xda, 0x1f, 0x22, 0xe5, 0xde, 0x61, 0xf2, 0x7f, 0x92, 0x30, 0x77, 0x97, 0x74, 0xa9, 0x62,
-	0xee, 0x19, 0xf9, 0x4b, 0x12, 0x92, 0x65, 0x2b, 0xf3, 0xba, 0xb2, 0xd0, 0xd6, 0x10, 0xd1, 0xfe,
-	0xd5, 0x54, 0x1c, 0x33, 0x55, 0xdd, 0xc9, 0xb6, 0xa8, 0x48, 0x1b, 0x55, 0xac, 0xb7, 0xcc, 0xb5,
-	0x32, 0x8f, 0x59, 0x99, 0x29, 0x46, 0x86, 0xdb, 0xb3, 0x3d, 0xff, 0x6d, 0xf5, 0x6c, 0x3f, 0x8d,
-	0xe6, 0x88, 0x26, 0x73, 0x44, 0x9f, 0xd4, 0xe7, 0xc8, 0x1e, 0x34, 0x09, 0xfb, 0xc7, 0x6e, 0xb4,
-	0x69, 0xdb, 0xc6, 0xe4, 0x76, 0xc6, 0xba, 0x16, 0x71, 0x3d, 0x2c, 0x14, 0x98, 0x94, 0xa4, 0x6c,
-	0x59, 0xf5, 0x2e, 0xcd, 0x9a, 0xf9, 0xb8, 0x5a, 0xde, 0x26, 0x48, 0x74, 0xd4, 0x1e, 0x31, 0x79,
-	0x87, 0xc8, 0x40, 0xbb, 0xbc, 0xf1, 0xfc, 0x11, 0xdd, 0x63, 0xf5, 0xd5, 0x0d, 0x98, 0x6a, 0x09,
-	0x19, 0xef, 0xe8, 0xd0, 0x34, 0x2c, 0xd1, 0x79, 0xb4, 0x1d, 0xba, 0x01, 0x27, 0x2b, 0xc2, 0x97,
-	0xcc, 0xfe, 0x19, 0xf9, 0xe9, 0x0c, 0x93, 0xf1, 0x4b, 0x0c, 0xec, 0xa4, 0x1d, 0x92, 0x9b, 0x23,
-	0xaf, 0xba, 0xc1, 0x1b, 0x7f, 0x5e, 0x66, 0xcf, 0x5c, 0x89, 0xf0, 0xae, 0x48, 0xd3, 0xae, 0xec,
-	0xd6, 0xc1, 0x64, 0x1b, 0x5b, 0x7d, 0x73, 0x97, 0x89, 0x3c, 0xf7, 0x27, 0x91, 0x3b, 0x79, 0xeb,
-	0xbe, 0x91, 0x74, 0x32, 0x3b, 0xd1, 0x4d, 0x31, 0xe6, 0x35, 0xfa, 0x82, 0xad, 0xeb, 0xe6, 0x35,
-	0x48, 0x07, 0x08, 0xed, 0xfc, 0x60, 0x34, 0x00, 0x93, 0x7b, 0x0d, 0xb5, 0xd8, 0x33, 0x8d, 0xb2,
-	0xef, 0x99, 0xeb, 0x39, 0xa4, 0xbb, 0xeb, 0x3d, 0xfa, 0x86, 0x0f, 0x6a, 0x80, 0x0f, 0x6b, 0x80,
-	0x8f, 0x6b, 0x80, 0x4e, 0x6a, 0x80, 0x4e, 0x6b, 0x80, 0xce, 0x6a, 0x80, 0xce, 0x6b, 0x80, 0x37,
-	0x35, 0xe0, 0x2d, 0x0d, 0x68, 0x47, 0x03, 0xde, 0xd5, 0x80, 0xf6, 0x34, 0xa0, 0x7d, 0x0d, 0xe8,
-	0x40, 0x03, 0x3e, 0xd4, 0x80, 0x8f, 0x35, 0xa0, 0x13, 0x0d, 0xf8, 0x54, 0x03, 0x3a, 0xd3, 0x80,
-	0xcf, 0x35, 0xa0, 0xcd, 0x10, 0xd0, 0x56, 0x08, 0xf8, 0x63, 0x08, 0xe8, 0x73, 0x08, 0xf8, 0x6b,
-	0x08, 0x68, 0x27, 0x04, 0xb4, 0x1b, 0x02, 0xde, 0x0b, 0x01, 0xef, 0x87, 0x80, 0x73, 0xe3, 0x9e,
-	0xa0, 0xaa, 0xc8, 0x54, 0x91, 0xfb, 0x9e, 0xa4, 0x3e, 0x53, 0xab, 0x22, 0x28, 0x39, 0x8d, 0xbf,
-	0xae, 0x4a, 0xc9, 0x73, 0x94, 0xf2, 0x2b, 0xf9, 0x7c, 0xc6, 0xb4, 0x62, 0xea, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xc8, 0x1c, 0x22, 0x73, 0xc4, 0x07, 0x00, 0x00,
+	// 769 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x31, 0x4c, 0x14, 0x4d,
+	0x14, 0xde, 0xe1, 0xff, 0xff, 0xe3, 0xcf, 0xc6, 0x40, 0x9c, 0x18, 0x4c, 0x96, 0xe3, 0x29, 0x0b,
+	0x09, 0xc9, 0x05, 0x76, 0x0d, 0xc4, 0xc4, 0xd8, 0x29, 0x2a, 0x1a, 0x2d, 0x14, 0x62, 0x73, 0x0d,
+	0xd9, 0x3b, 0x86, 0xbd, 0xf5, 0x8e, 0x9d, 0x65, 0x66, 0x0e, 0x82, 0x84, 0x84, 0x58, 0x28, 0x8d,
+	0x89, 0xc4, 0x46, 0x3b, 0x63, 0x45, 0x49, 0x49, 0x49, 0x49, 0x61, 0x22, 0x09, 0x0d, 0x25, 0xb7,
+	0x6b, 0x41, 0x49, 0x27, 0xa5, 0xb9, 0xd9, 0x5d, 0xdc, 0xbb, 0xdb, 0x13, 0x2e, 0xd8, 0xcd, 0xce,
+	0x7b, 0xf3, 0x7d, 0xef, 0x7d, 0xf3, 0xbe, 0x59, 0x75, 0xa4, 0x42, 0x99, 0xb5, 0x6c, 0xb9, 0x63,
+	0x5c, 0x58, 0xc5, 0xb2, 0x69, 0x79, 0x8e, 0x59, 0xac, 0x38, 0xc4, 0x15, 0xb3, 0x9c, 0xb0, 0x25,
+	0xa7, 0x48, 0xb8, 0xe1, 0x31, 0x2a, 0x28, 0xee, 0x11, 0xc2, 0x35, 0xa2, 0x64, 0x63, 0x69, 0x42,
+	0x1b, 0xb3, 0x1d, 0x51, 0xaa, 0x16, 0x8c, 0x22, 0x5d, 0x30, 0x6d, 0x6a, 0x53, 0x53, 0xa6, 0x15,
+	0xaa, 0xf3, 0xf2, 0x4b, 0x7e, 0xc8, 0x55, 0x78, 0x5c, 0xcb, 0xda, 0x94, 0xda, 0x15, 0x22, 0x09,
+	0x2c, 0xd7, 0xa5, 0xc2, 0x12, 0x0e, 0x75, 0x23, 0x70, 0xad, 0x3f, 0x8a, 0x9e, 0x61, 0x90, 0x05,
+	0x4f, 0xac, 0x44, 0x41, 0x68, 0x57, 0x62, 0x14, 0x1f, 0x6a, 0x8d, 0x3b, 0x73, 0xc4, 0x15, 0xce,
+	0xbc, 0x43, 0x18, 0x6f, 0x0f, 0xc2, 0x1c, 0xbb, 0x24, 0xa2, 0xf8, 0xf8, 0xb7, 0x6e, 0xb5, 0x67,
+	0x52, 0xa2, 0x4e, 0x13, 0xdb, 0xe1, 0x82, 0xad, 0xe0, 0xef, 0x48, 0xcd, 0x4c, 0x32, 0x62, 0x09,
+	0x82, 0x87, 0x8c, 0xc6, 0xee, 0x8d, 0x70, 0x3f, 0x3e, 0xb0, 0x58, 0x25, 0x5c, 0x68, 0x7d, 0x2d,
+	0x49, 0x32, 0xac, 0xbf, 0x43, 0x6f, 0x0e, 0x7e, 0x7c, 0xec, 0x5a, 0x47, 0xba, 0x61, 0x56, 0x39,
+	0x61, 0xdc, 0x5c, 0x2d, 0xd2, 0x4a, 0xc5, 0x2a, 0x50, 0x66, 0x09, 0xca, 0x8c, 0xfa, 0xde, 0xac,
+	0x33, 0xc7, 0xe3, 0xc5, 0x5a, 0xd4, 0x1e, 0xbf, 0x8b, 0x72, 0xf9, 0xa7, 0xfa, 0x23, 0x93, 0x32,
+	0xdb, 0x72, 0x9d, 0xd7, 0xa1, 0x62, 0x4d, 0x87, 0x93, 0x31, 0x09, 0xd2, 0xb4, 0x91, 0x04, 0xc3,
+	0x25, 0xf5, 0x9f, 0x29, 0x22, 0xf0, 0xcd, 0xe6, 0x42, 0xa7, 0x88, 0xb8, 0x58, 0x2b, 0x23, 0xb2,
+	0x93, 0x41, 0x7c, 0x23, 0x46, 0x35, 0x57, 0xc3, 0x85, 0xa4, 0x3e, 0x5b, 0xae, 0xe1, 0x03, 0xa4,
+	0xfe, 0xfb, 0xcc, 0xe1, 0x02, 0xeb, 0xcd, 0x48, 0xf5, 0xdd, 0x10, 0x8d, 0xc7, 0x6c, 0xd7, 0xd3,
+	0xd9, 0xb8, 0xfe, 0x3e, 0x54, 0xee, 0x2d, 0xc2, 0xff, 0xc7, 0x84, 0xf9, 0x5b, 0xb8, 0x43, 0x15,
+	0xf3, 0x8f, 0xf1, 0x5f, 0x92, 0x10, 0x2f, 0xaa, 0x99, 0x97, 0xde, 0x5c, 0xea, 0x40, 0x84, 0xfb,
+	0x17, 0x53, 0x31, 0x27, 0xbb, 0x1a, 0xd6, 0x5a, 0x54, 0x34, 0x1a, 0x55, 0xac, 0x5f, 0x99, 0xa5,
+	0x66, 0x1e, 0x90, 0x0a, 0x11, 0x04, 0x0f, 0xa6, 0xa3, 0x3d, 0xf9, 0x3d, 0xea, 0x5a, 0x9f, 0x11,
+	0xfa, 0xc8, 0x88, 0x7d, 0x64, 0x3c, 0xac, 0xfb, 0x48, 0xcf, 0x4a, 0xc2, 0xbe, 0xdc, 0xb5, 0x94,
+	0x6b, 0x5b, 0xc3, 0xaf, 0xd4, 0xee, 0x69, 0xc2, 0x05, 0x65, 0x97, 0xe2, 0x18, 0x96, 0x1c, 0xa0,
+	0x67, 0xd3, 0x38, 0x4c, 0x16, 0x11, 0xcc, 0xab, 0xff, 0x3d, 0xaf, 0x32, 0xfb, 0x52, 0x4c, 0xba,
+	0x64, 0xca, 0xe6, 0xb4, 0x54, 0x26, 0xaf, 0x0e, 0x3f, 0xbe, 0x99, 0x51, 0xaf, 0x84, 0x88, 0xf7,
+	0x8a, 0x45, 0xc2, 0x39, 0xae, 0xa8, 0x6a, 0x7d, 0xf2, 0xa6, 0xa5, 0xe7, 0x2f, 0xc6, 0xde, 0x94,
+	0x12, 0x1e, 0xd5, 0x87, 0x24, 0xfb, 0x00, 0xee, 0x4f, 0xef, 0x33, 0xc4, 0xf7, 0xbb, 0xd4, 0xde,
+	0xba, 0xa9, 0x12, 0x63, 0x86, 0x47, 0xdb, 0xba, 0x2e, 0x99, 0x16, 0xcf, 0xce, 0x48, 0x5a, 0x76,
+	0x43, 0x1e, 0xf7, 0xa8, 0xcb, 0x89, 0xfe, 0x33, 0xf4, 0xc8, 0x09, 0xc2, 0xa3, 0xe7, 0x98, 0xd2,
+	0x4c, 0x4e, 0x7d, 0x7e, 0x06, 0xbf, 0xe8, 0x24, 0x5f, 0x7a, 0xee, 0x3c, 0xcb, 0xe5, 0xcb, 0xd8,
+	0xe9, 0x08, 0x34, 0xe9, 0xb4, 0x4e, 0x5d, 0x89, 0x37, 0x91, 0xda, 0x3b, 0x73, 0x9e, 0xc8, 0x33,
+	0x7f, 0x12, 0xb9, 0xdd, 0x84, 0xdd, 0x91, 0x92, 0x8e, 0x6b, 0x63, 0x9d, 0x34, 0x23, 0x5f, 0xd8,
+	0xcf, 0x48, 0xbd, 0x2a, 0x5f, 0xb8, 0x64, 0x00, 0x1b, 0xed, 0x1f, 0xc1, 0x86, 0xc4, 0xb8, 0xae,
+	0x81, 0x96, 0xf1, 0x4c, 0x66, 0xe9, 0xb7, 0x65, 0x79, 0x26, 0xee, 0xac, 0xbc, 0xfb, 0x5f, 0xd1,
+	0x5e, 0x0d, 0xd0, 0x7e, 0x0d, 0xd0, 0x61, 0x0d, 0x94, 0xa3, 0x1a, 0x28, 0xc7, 0x35, 0x50, 0x4e,
+	0x6a, 0xa0, 0x9c, 0xd6, 0x00, 0xad, 0xfb, 0x80, 0x36, 0x7c, 0x50, 0xb6, 0x7c, 0x40, 0xdb, 0x3e,
+	0x28, 0x3b, 0x3e, 0x28, 0xbb, 0x3e, 0x28, 0x7b, 0x3e, 0xa0, 0x7d, 0x1f, 0xd0, 0xa1, 0x0f, 0xca,
+	0x91, 0x0f, 0xe8, 0xd8, 0x07, 0xe5, 0xc4, 0x07, 0x74, 0xea, 0x83, 0xb2, 0x1e, 0x80, 0xb2, 0x11,
+	0x00, 0xfa, 0x10, 0x80, 0xf2, 0x29, 0x00, 0xf4, 0x25, 0x00, 0x65, 0x2b, 0x00, 0x65, 0x3b, 0x00,
+	0xb4, 0x13, 0x00, 0xda, 0x0d, 0x00, 0xe5, 0x47, 0x6d, 0x6a, 0x88, 0x12, 0x11, 0x25, 0xc7, 0xb5,
+	0xb9, 0xe1, 0x12, 0xb1, 0x4c, 0x59, 0xd9, 0x6c, 0xfc, 0x1d, 0x7b, 0x65, 0xdb, 0x14, 0xc2, 0xf5,
+	0x0a, 0x85, 0x8c, 0xbc, 0x8a, 0x89, 0x5f, 0x03, 0x00, 0xd9, 0xf0, 0x00, 0xc5, 0x98, 0x08, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -108,6 +111,11 @@ type ClientRegistryClient interface {
 	List(ctx context.Context, in *ListClientsRequest, opts ...grpc.CallOption) (*Clients, error)
 	Update(ctx context.Context, in *UpdateClientRequest, opts ...grpc.CallOption) (*Client, error)
 	Delete(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted client.
+	Restore(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the client. This permanently deletes the client and the data
+	// related to it, after which its ID can be reused.
+	Purge(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type clientRegistryClient struct {
@@ -163,6 +171,24 @@ func (c *clientRegistryClient) Delete(ctx context.Context, in *ClientIdentifiers
 	return out, nil
 }
 
+func (c *clientRegistryClient) Restore(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ClientRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientRegistryClient) Purge(ctx context.Context, in *ClientIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ClientRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientRegistryServer is the server API for ClientRegistry service.
 type ClientRegistryServer interface {
 	// Create a new OAuth client. This also sets the given organization or user as
@@ -176,6 +202,11 @@ type ClientRegistryServer interface {
 	List(context.Context, *ListClientsRequest) (*Clients, error)
 	Update(context.Context, *UpdateClientRequest) (*Client, error)
 	Delete(context.Context, *ClientIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted client.
+	Restore(context.Context, *ClientIdentifiers) (*types.Empty, error)
+	// Purge the client. This permanently deletes the client and the data
+	// related to it, after which its ID can be reused.
+	Purge(context.Context, *ClientIdentifiers) (*types.Empty, error)
 }
 
 func RegisterClientRegistryServer(s *grpc.Server, srv ClientRegistryServer) {
@@ -272,6 +303,42 @@ func _ClientRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ClientRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientRegistryServer).Restore(ctx, req.(*ClientIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ClientRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientRegistryServer).Purge(ctx, req.(*ClientIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClientRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ClientRegistry",
 	HandlerType: (*ClientRegistryServer)(nil),
@@ -296,6 +363,14 @@ var _ClientRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ClientRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ClientRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _ClientRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/client_services.proto",
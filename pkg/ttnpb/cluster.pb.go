@@ -4,17 +4,23 @@
 package ttnpb
 
 import (
+	context "context"
 	fmt "fmt"
 	io "io"
 	math "math"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -22,6 +28,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -103,11 +110,181 @@ func (m *PeerInfo) GetTags() map[string]string {
 	return nil
 }
 
+// ClusterPeer is a peer of the cluster, as seen by the peer that lists it.
+type ClusterPeer struct {
+	// Name of the peer.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Address of the gRPC server of the peer.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Roles of the peer.
+	Roles []ClusterRole `protobuf:"varint,3,rep,packed,name=roles,proto3,enum=ttn.lorawan.v3.ClusterRole" json:"roles,omitempty"`
+	// Tags of the peer.
+	Tags map[string]string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Source of the peer: static (configured), dns (discovered from DNS SRV records) or redis (discovered in the membership registry).
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// State of the connection to the peer: IDLE, CONNECTING, READY, TRANSIENT_FAILURE or SHUTDOWN.
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// Indicates whether the peer is available for routing requests to.
+	Healthy bool `protobuf:"varint,7,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Time when the peer was last discovered or last sent a heartbeat.
+	LastSeenAt *time.Time `protobuf:"bytes,8,opt,name=last_seen_at,json=lastSeenAt,proto3,stdtime" json:"last_seen_at,omitempty"`
+	// Indicates whether the peer is the peer that lists it.
+	Self                 bool     `protobuf:"varint,9,opt,name=self,proto3" json:"self,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterPeer) Reset()      { *m = ClusterPeer{} }
+func (*ClusterPeer) ProtoMessage() {}
+func (*ClusterPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5716c3fcd711eefd, []int{1}
+}
+func (m *ClusterPeer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterPeer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterPeer.Merge(m, src)
+}
+func (m *ClusterPeer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterPeer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterPeer proto.InternalMessageInfo
+
+func (m *ClusterPeer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterPeer) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ClusterPeer) GetRoles() []ClusterRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *ClusterPeer) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ClusterPeer) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ClusterPeer) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ClusterPeer) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *ClusterPeer) GetLastSeenAt() *time.Time {
+	if m != nil {
+		return m.LastSeenAt
+	}
+	return nil
+}
+
+func (m *ClusterPeer) GetSelf() bool {
+	if m != nil {
+		return m.Self
+	}
+	return false
+}
+
+type ClusterPeers struct {
+	Peers                []*ClusterPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ClusterPeers) Reset()      { *m = ClusterPeers{} }
+func (*ClusterPeers) ProtoMessage() {}
+func (*ClusterPeers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5716c3fcd711eefd, []int{2}
+}
+func (m *ClusterPeers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterPeers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterPeers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterPeers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterPeers.Merge(m, src)
+}
+func (m *ClusterPeers) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterPeers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterPeers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterPeers proto.InternalMessageInfo
+
+func (m *ClusterPeers) GetPeers() []*ClusterPeer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PeerInfo)(nil), "ttn.lorawan.v3.PeerInfo")
 	golang_proto.RegisterType((*PeerInfo)(nil), "ttn.lorawan.v3.PeerInfo")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.PeerInfo.TagsEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.PeerInfo.TagsEntry")
+	proto.RegisterType((*ClusterPeer)(nil), "ttn.lorawan.v3.ClusterPeer")
+	golang_proto.RegisterType((*ClusterPeer)(nil), "ttn.lorawan.v3.ClusterPeer")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ClusterPeer.TagsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ClusterPeer.TagsEntry")
+	proto.RegisterType((*ClusterPeers)(nil), "ttn.lorawan.v3.ClusterPeers")
+	golang_proto.RegisterType((*ClusterPeers)(nil), "ttn.lorawan.v3.ClusterPeers")
 }
 
 func init() { proto.RegisterFile("lorawan-stack/api/cluster.proto", fileDescriptor_5716c3fcd711eefd) }
@@ -116,36 +293,51 @@ func init() {
 }
 
 var fileDescriptor_5716c3fcd711eefd = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x31, 0x4c, 0x14, 0x41,
-	0x14, 0x86, 0xe7, 0xb1, 0xa0, 0x77, 0xa3, 0x12, 0xb3, 0xb1, 0x38, 0xcf, 0xf8, 0xee, 0x42, 0x75,
-	0x26, 0xde, 0x6c, 0x84, 0x44, 0x8d, 0x9d, 0x47, 0x8c, 0x31, 0xb1, 0xb8, 0xac, 0x54, 0x36, 0x66,
-	0xee, 0x1c, 0xe6, 0x2e, 0xb7, 0xcc, 0x6c, 0x66, 0x67, 0x0f, 0xaf, 0xa3, 0xa4, 0xb4, 0xb4, 0x34,
-	0x56, 0x94, 0x94, 0x94, 0x94, 0x94, 0x94, 0x54, 0x84, 0x9d, 0x69, 0x28, 0x29, 0x2c, 0x28, 0xcd,
-	0xed, 0xa2, 0x11, 0xe8, 0xfe, 0xff, 0xbd, 0xef, 0xbd, 0xfc, 0x79, 0x8f, 0xb6, 0x12, 0x6d, 0xf8,
-	0x36, 0x57, 0xdd, 0xcc, 0xf2, 0xe1, 0x24, 0xe2, 0xe9, 0x38, 0x1a, 0x26, 0x79, 0x66, 0x85, 0x61,
-	0xa9, 0xd1, 0x56, 0x87, 0xcb, 0xd6, 0x2a, 0x76, 0x05, 0xb1, 0xe9, 0x5a, 0xf3, 0xad, 0x1c, 0xdb,
-	0x51, 0x3e, 0x60, 0x43, 0xbd, 0x15, 0x09, 0x35, 0xd5, 0xb3, 0xd4, 0xe8, 0x6f, 0xb3, 0xa8, 0x84,
-	0x87, 0x5d, 0x29, 0x54, 0x77, 0xca, 0x93, 0xf1, 0x57, 0x6e, 0x45, 0x74, 0x4b, 0x54, 0x2b, 0x9b,
-	0xdd, 0xff, 0x56, 0x48, 0x2d, 0x75, 0x35, 0x3c, 0xc8, 0x37, 0x4b, 0x57, 0x9a, 0x52, 0x5d, 0xe1,
-	0x4f, 0x6f, 0x47, 0x14, 0x2a, 0xdf, 0xca, 0xaa, 0xf6, 0xca, 0x6f, 0xa0, 0xb5, 0xbe, 0x10, 0xe6,
-	0x83, 0xda, 0xd4, 0xe1, 0x33, 0x5a, 0x97, 0x26, 0x1d, 0x7e, 0x49, 0xb5, 0xb1, 0x0d, 0x68, 0x43,
-	0xe7, 0x41, 0xef, 0xbe, 0x3b, 0x6d, 0xd5, 0xde, 0xc7, 0xfd, 0xf5, 0xbe, 0x36, 0x36, 0xae, 0xcd,
-	0xdb, 0x73, 0x15, 0x3e, 0xa6, 0x81, 0x4d, 0xb2, 0xc6, 0x42, 0x1b, 0x3a, 0xb5, 0xde, 0x5d, 0x77,
-	0xda, 0x0a, 0x36, 0x3e, 0x7e, 0x8a, 0xe7, 0xb5, 0xf0, 0x05, 0x5d, 0x32, 0x3a, 0x11, 0x59, 0x23,
-	0x68, 0x07, 0x9d, 0xe5, 0xd5, 0x27, 0xec, 0xfa, 0x0d, 0xd8, 0x7a, 0x75, 0xa1, 0x58, 0x27, 0x22,
-	0xae, 0xc8, 0xf0, 0x25, 0x5d, 0xb4, 0x5c, 0x66, 0x8d, 0xc5, 0x76, 0xd0, 0xb9, 0xb7, 0xba, 0x72,
-	0x73, 0xe2, 0x6f, 0x40, 0xb6, 0xc1, 0x65, 0xf6, 0x4e, 0x59, 0x33, 0x8b, 0x4b, 0xbe, 0xf9, 0x8a,
-	0xd6, 0xff, 0x95, 0xc2, 0x87, 0x34, 0x98, 0x88, 0x59, 0x99, 0xbb, 0x1e, 0xcf, 0x65, 0xf8, 0x88,
-	0x2e, 0x4d, 0x79, 0x92, 0x8b, 0x32, 0x66, 0x3d, 0xae, 0xcc, 0x9b, 0x85, 0xd7, 0xd0, 0xfb, 0x05,
-	0x47, 0x05, 0xc2, 0x71, 0x81, 0x70, 0x52, 0x20, 0x39, 0x2b, 0x90, 0x9c, 0x17, 0x48, 0x2e, 0x0a,
-	0x24, 0x97, 0x05, 0xc2, 0x8e, 0x43, 0xd8, 0x75, 0x48, 0xf6, 0x1c, 0xc2, 0xbe, 0x43, 0x72, 0xe0,
-	0x90, 0x1c, 0x3a, 0x24, 0x47, 0x0e, 0xe1, 0xd8, 0x21, 0x9c, 0x38, 0x24, 0x67, 0x0e, 0xe1, 0xdc,
-	0x21, 0xb9, 0x70, 0x08, 0x97, 0x0e, 0xc9, 0x8e, 0x47, 0xb2, 0xeb, 0x11, 0xbe, 0x7b, 0x24, 0x3f,
-	0x3c, 0xc2, 0x4f, 0x8f, 0x64, 0xcf, 0x23, 0xd9, 0xf7, 0x08, 0x07, 0x1e, 0xe1, 0xd0, 0x23, 0x7c,
-	0x7e, 0x2e, 0x35, 0xb3, 0x23, 0x61, 0x47, 0x63, 0x25, 0x33, 0xa6, 0x84, 0xdd, 0xd6, 0x66, 0x12,
-	0x5d, 0xff, 0x50, 0x3a, 0x91, 0x91, 0xb5, 0x2a, 0x1d, 0x0c, 0xee, 0x94, 0x2f, 0x5a, 0xfb, 0x13,
-	0x00, 0x00, 0xff, 0xff, 0x06, 0x63, 0x70, 0x81, 0x66, 0x02, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x4c, 0x1b, 0x31,
+	0x18, 0xb5, 0x49, 0x20, 0x89, 0xa1, 0x08, 0x9d, 0x2a, 0x74, 0x0d, 0xd4, 0x89, 0x22, 0x55, 0x4a,
+	0xa5, 0xe6, 0x4e, 0x0d, 0x52, 0xff, 0x36, 0x82, 0x50, 0x55, 0x89, 0x01, 0x1d, 0x2c, 0xed, 0x82,
+	0x9c, 0xc4, 0x5c, 0xa2, 0x5c, 0xec, 0xd3, 0xd9, 0x09, 0xcd, 0x86, 0x98, 0x18, 0x91, 0xba, 0x74,
+	0xac, 0x3a, 0x31, 0x32, 0x32, 0x32, 0x32, 0x22, 0x75, 0x61, 0xa2, 0xc4, 0xd7, 0x81, 0x91, 0xa1,
+	0x03, 0x63, 0x75, 0xbe, 0x0b, 0xe2, 0x47, 0x65, 0x60, 0xfb, 0x9e, 0xbf, 0xf7, 0xbd, 0xbc, 0xf7,
+	0x9d, 0x1d, 0x54, 0xf0, 0x78, 0x40, 0xb6, 0x08, 0xab, 0x08, 0x49, 0x1a, 0x1d, 0x9b, 0xf8, 0x6d,
+	0xbb, 0xe1, 0xf5, 0x84, 0xa4, 0x81, 0xe5, 0x07, 0x5c, 0x72, 0x63, 0x5a, 0x4a, 0x66, 0x25, 0x24,
+	0xab, 0xbf, 0x90, 0x5f, 0x74, 0xdb, 0xb2, 0xd5, 0xab, 0x5b, 0x0d, 0xde, 0xb5, 0x29, 0xeb, 0xf3,
+	0x81, 0x1f, 0xf0, 0xaf, 0x03, 0x5b, 0x93, 0x1b, 0x15, 0x97, 0xb2, 0x4a, 0x9f, 0x78, 0xed, 0x26,
+	0x91, 0xd4, 0xbe, 0x57, 0xc4, 0x92, 0xf9, 0xca, 0x0d, 0x09, 0x97, 0xbb, 0x3c, 0x1e, 0xae, 0xf7,
+	0x36, 0x35, 0xd2, 0x40, 0x57, 0x09, 0x7d, 0xde, 0xe5, 0xdc, 0xf5, 0xa8, 0xf6, 0x46, 0x18, 0xe3,
+	0x92, 0xc8, 0x36, 0x67, 0x22, 0xe9, 0xce, 0x25, 0xdd, 0x6b, 0x0d, 0xda, 0xf5, 0xe5, 0x20, 0x69,
+	0x16, 0xee, 0x36, 0x65, 0xbb, 0x4b, 0x85, 0x24, 0x5d, 0x3f, 0x21, 0x3c, 0xbf, 0x1f, 0x9f, 0xb2,
+	0x5e, 0x37, 0x11, 0x2f, 0xfd, 0x85, 0x28, 0xbb, 0x4a, 0x69, 0xf0, 0x89, 0x6d, 0x72, 0xe3, 0x25,
+	0xca, 0xb9, 0x81, 0xdf, 0xd8, 0xf0, 0x79, 0x20, 0x4d, 0x58, 0x84, 0xe5, 0x27, 0xb5, 0x29, 0x75,
+	0x56, 0xc8, 0x7e, 0x74, 0x56, 0x97, 0x56, 0x79, 0x20, 0x9d, 0x6c, 0xd4, 0x8e, 0x2a, 0xe3, 0x19,
+	0x4a, 0x49, 0x4f, 0x98, 0x63, 0x45, 0x58, 0xce, 0xd6, 0x32, 0xea, 0xac, 0x90, 0x5a, 0x5f, 0x59,
+	0x73, 0xa2, 0x33, 0xe3, 0x35, 0x1a, 0x0f, 0xb8, 0x47, 0x85, 0x99, 0x2a, 0xa6, 0xca, 0xd3, 0xd5,
+	0x39, 0xeb, 0xf6, 0x7e, 0xad, 0xa5, 0x78, 0xfb, 0x0e, 0xf7, 0xa8, 0x13, 0x33, 0x8d, 0x37, 0x28,
+	0x2d, 0x89, 0x2b, 0xcc, 0x74, 0x31, 0x55, 0x9e, 0xac, 0x96, 0xee, 0x4e, 0x8c, 0x0c, 0x5a, 0xeb,
+	0xc4, 0x15, 0xcb, 0x4c, 0x06, 0x03, 0x47, 0xf3, 0xf3, 0x6f, 0x51, 0xee, 0xfa, 0xc8, 0x98, 0x41,
+	0xa9, 0x0e, 0x1d, 0x68, 0xdf, 0x39, 0x27, 0x2a, 0x8d, 0xa7, 0x68, 0xbc, 0x4f, 0xbc, 0x1e, 0xd5,
+	0x36, 0x73, 0x4e, 0x0c, 0x3e, 0x8c, 0xbd, 0x83, 0xa5, 0x9d, 0x14, 0x9a, 0x4c, 0x7c, 0x44, 0xe2,
+	0x86, 0x81, 0xd2, 0x8c, 0x74, 0x69, 0x32, 0xac, 0x6b, 0xc3, 0x44, 0x19, 0xd2, 0x6c, 0x06, 0x54,
+	0x88, 0x64, 0x7e, 0x04, 0x1f, 0x93, 0xf0, 0xfd, 0xad, 0x84, 0x2f, 0xfe, 0x33, 0x11, 0x79, 0xb9,
+	0x1b, 0xd2, 0x98, 0x45, 0x13, 0x82, 0xf7, 0x82, 0x06, 0x35, 0xc7, 0xb5, 0x8d, 0x04, 0x45, 0xe9,
+	0x84, 0x24, 0x92, 0x9a, 0x13, 0x71, 0x3a, 0x0d, 0x22, 0xd7, 0x2d, 0x4a, 0x3c, 0xd9, 0x1a, 0x98,
+	0x99, 0xe8, 0xe3, 0x38, 0x23, 0x68, 0xd4, 0xd0, 0x94, 0x47, 0x84, 0xdc, 0x10, 0x94, 0xb2, 0x0d,
+	0x22, 0xcd, 0x6c, 0x11, 0x96, 0x27, 0xab, 0x79, 0x2b, 0xbe, 0x41, 0xd6, 0xe8, 0x06, 0x59, 0xeb,
+	0xa3, 0x1b, 0x54, 0x4b, 0xef, 0xfd, 0x2e, 0x40, 0x07, 0x45, 0x53, 0x6b, 0x94, 0xb2, 0x45, 0x19,
+	0xed, 0x49, 0x50, 0x6f, 0xd3, 0xcc, 0x69, 0x69, 0x5d, 0x3f, 0xfe, 0x23, 0x2c, 0xa2, 0xa9, 0x1b,
+	0xb9, 0xf5, 0x5a, 0xfd, 0xa8, 0x30, 0xa1, 0x5e, 0xd2, 0xdc, 0x03, 0x4b, 0x72, 0x62, 0x66, 0xb5,
+	0x89, 0x32, 0xc9, 0xa9, 0xf1, 0x19, 0xe5, 0x56, 0xda, 0x42, 0xc6, 0x52, 0xb3, 0xf7, 0x52, 0x2d,
+	0x47, 0x8f, 0x26, 0x3f, 0xff, 0x80, 0xa6, 0x28, 0xcd, 0xee, 0xfc, 0xfa, 0xf3, 0x6d, 0x6c, 0xc6,
+	0x98, 0x1e, 0xfd, 0x43, 0xd8, 0xfa, 0x57, 0x6a, 0x3f, 0xe1, 0xf1, 0x10, 0xc3, 0x93, 0x21, 0x86,
+	0xa7, 0x43, 0x0c, 0xce, 0x87, 0x18, 0x5c, 0x0c, 0x31, 0xb8, 0x1c, 0x62, 0x70, 0x35, 0xc4, 0x70,
+	0x5b, 0x61, 0xb8, 0xab, 0x30, 0xd8, 0x57, 0x18, 0x1e, 0x28, 0x0c, 0x0e, 0x15, 0x06, 0x47, 0x0a,
+	0x83, 0x63, 0x85, 0xe1, 0x89, 0xc2, 0xf0, 0x54, 0x61, 0x70, 0xae, 0x30, 0xbc, 0x50, 0x18, 0x5c,
+	0x2a, 0x0c, 0xaf, 0x14, 0x06, 0xdb, 0x21, 0x06, 0xbb, 0x21, 0x86, 0x7b, 0x21, 0x06, 0xdf, 0x43,
+	0x0c, 0x7f, 0x84, 0x18, 0xec, 0x87, 0x18, 0x1c, 0x84, 0x18, 0x1e, 0x86, 0x18, 0x1e, 0x85, 0x18,
+	0x7e, 0x79, 0xe5, 0x72, 0x4b, 0xb6, 0xa8, 0x6c, 0xb5, 0x99, 0x2b, 0x2c, 0x46, 0xe5, 0x16, 0x0f,
+	0x3a, 0xf6, 0xed, 0xf7, 0xec, 0x77, 0x5c, 0x5b, 0x4a, 0xe6, 0xd7, 0xeb, 0x13, 0x3a, 0xea, 0xc2,
+	0xbf, 0x01, 0x00, 0x7f, 0x99, 0x01, 0x3a, 0xf0, 0x04, 0x00, 0x00,
 }
 
 func (this *PeerInfo) Equal(that interface{}) bool {
@@ -191,6 +383,174 @@ func (this *PeerInfo) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ClusterPeer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterPeer)
+	if !ok {
+		that2, ok := that.(ClusterPeer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if this.Roles[i] != that1.Roles[i] {
+			return false
+		}
+	}
+	if len(this.Tags) != len(that1.Tags) {
+		return false
+	}
+	for i := range this.Tags {
+		if this.Tags[i] != that1.Tags[i] {
+			return false
+		}
+	}
+	if this.Source != that1.Source {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Healthy != that1.Healthy {
+		return false
+	}
+	if that1.LastSeenAt == nil {
+		if this.LastSeenAt != nil {
+			return false
+		}
+	} else if !this.LastSeenAt.Equal(*that1.LastSeenAt) {
+		return false
+	}
+	if this.Self != that1.Self {
+		return false
+	}
+	return true
+}
+func (this *ClusterPeers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterPeers)
+	if !ok {
+		that2, ok := that.(ClusterPeers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Peers) != len(that1.Peers) {
+		return false
+	}
+	for i := range this.Peers {
+		if !this.Peers[i].Equal(that1.Peers[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ClusterClient is the client API for Cluster service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterClient interface {
+	// List the peers of the cluster, including their roles and health.
+	// This is only available to admins and cluster peers.
+	ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterPeers, error)
+}
+
+type clusterClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewClusterClient(cc *grpc.ClientConn) ClusterClient {
+	return &clusterClient{cc}
+}
+
+func (c *clusterClient) ListPeers(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterPeers, error) {
+	out := new(ClusterPeers)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Cluster/ListPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterServer is the server API for Cluster service.
+type ClusterServer interface {
+	// List the peers of the cluster, including their roles and health.
+	// This is only available to admins and cluster peers.
+	ListPeers(context.Context, *types.Empty) (*ClusterPeers, error)
+}
+
+func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
+	s.RegisterService(&_Cluster_serviceDesc, srv)
+}
+
+func _Cluster_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Cluster/ListPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).ListPeers(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Cluster_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Cluster",
+	HandlerType: (*ClusterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPeers",
+			Handler:    _Cluster_ListPeers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/cluster.proto",
+}
+
 func (m *PeerInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -258,37 +618,215 @@ func (m *PeerInfo) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ClusterPeer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func NewPopulatedPeerInfo(r randyCluster, easy bool) *PeerInfo {
-	this := &PeerInfo{}
-	this.GRPCPort = r.Uint32()
-	this.TLS = bool(r.Intn(2) == 0)
-	v1 := r.Intn(10)
-	this.Roles = make([]ClusterRole, v1)
-	for i := 0; i < v1; i++ {
-		this.Roles[i] = ClusterRole([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}[r.Intn(10)])
+
+func (m *ClusterPeer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if r.Intn(10) != 0 {
-		v2 := r.Intn(10)
-		this.Tags = make(map[string]string)
-		for i := 0; i < v2; i++ {
-			this.Tags[randStringCluster(r)] = randStringCluster(r)
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Roles) > 0 {
+		dAtA4 := make([]byte, len(m.Roles)*10)
+		var j3 int
+		for _, num := range m.Roles {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
 	}
-	if !easy && r.Intn(10) != 0 {
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			dAtA[i] = 0x22
+			i++
+			v := m.Tags[k]
+			mapSize := 1 + len(k) + sovCluster(uint64(len(k))) + 1 + len(v) + sovCluster(uint64(len(v)))
+			i = encodeVarintCluster(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCluster(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCluster(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
 	}
-	return this
-}
-
-type randyCluster interface {
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if m.Healthy {
+		dAtA[i] = 0x38
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.LastSeenAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCluster(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAt)))
+		n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.Self {
+		dAtA[i] = 0x48
+		i++
+		if m.Self {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ClusterPeers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterPeers) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, msg := range m.Peers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCluster(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintCluster(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedPeerInfo(r randyCluster, easy bool) *PeerInfo {
+	this := &PeerInfo{}
+	this.GRPCPort = r.Uint32()
+	this.TLS = bool(r.Intn(2) == 0)
+	v1 := r.Intn(10)
+	this.Roles = make([]ClusterRole, v1)
+	for i := 0; i < v1; i++ {
+		this.Roles[i] = ClusterRole([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}[r.Intn(10)])
+	}
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(10)
+		this.Tags = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.Tags[randStringCluster(r)] = randStringCluster(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClusterPeer(r randyCluster, easy bool) *ClusterPeer {
+	this := &ClusterPeer{}
+	this.Name = randStringCluster(r)
+	this.Address = randStringCluster(r)
+	v3 := r.Intn(10)
+	this.Roles = make([]ClusterRole, v3)
+	for i := 0; i < v3; i++ {
+		this.Roles[i] = ClusterRole([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}[r.Intn(10)])
+	}
+	if r.Intn(10) != 0 {
+		v4 := r.Intn(10)
+		this.Tags = make(map[string]string)
+		for i := 0; i < v4; i++ {
+			this.Tags[randStringCluster(r)] = randStringCluster(r)
+		}
+	}
+	this.Source = randStringCluster(r)
+	this.State = randStringCluster(r)
+	this.Healthy = bool(r.Intn(2) == 0)
+	if r.Intn(10) != 0 {
+		this.LastSeenAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.Self = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedClusterPeers(r randyCluster, easy bool) *ClusterPeers {
+	this := &ClusterPeers{}
+	if r.Intn(10) != 0 {
+		v5 := r.Intn(5)
+		this.Peers = make([]*ClusterPeer, v5)
+		for i := 0; i < v5; i++ {
+			this.Peers[i] = NewPopulatedClusterPeer(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyCluster interface {
 	Float32() float32
 	Float64() float64
 	Int63() int64
@@ -307,9 +845,9 @@ func randUTF8RuneCluster(r randyCluster) rune {
 	return rune(ru + 61)
 }
 func randStringCluster(r randyCluster) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
 		tmps[i] = randUTF8RuneCluster(r)
 	}
 	return string(tmps)
@@ -331,11 +869,11 @@ func randFieldCluster(dAtA []byte, r randyCluster, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateCluster(dAtA, uint64(key))
-		v4 := r.Int63()
+		v7 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v7 *= -1
 		}
-		dAtA = encodeVarintPopulateCluster(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateCluster(dAtA, uint64(v7))
 	case 1:
 		dAtA = encodeVarintPopulateCluster(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -390,6 +928,71 @@ func (m *PeerInfo) Size() (n int) {
 	return n
 }
 
+func (m *ClusterPeer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		l = 0
+		for _, e := range m.Roles {
+			l += sovCluster(uint64(e))
+		}
+		n += 1 + sovCluster(uint64(l)) + l
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCluster(uint64(len(k))) + 1 + len(v) + sovCluster(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCluster(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.LastSeenAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAt)
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Self {
+		n += 2
+	}
+	return n
+}
+
+func (m *ClusterPeers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovCluster(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovCluster(x uint64) (n int) {
 	for {
 		n++
@@ -416,25 +1019,351 @@ func (this *PeerInfo) String() string {
 	for _, k := range keysForTags {
 		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
 	}
-	mapStringForTags += "}"
-	s := strings.Join([]string{`&PeerInfo{`,
-		`GRPCPort:` + fmt.Sprintf("%v", this.GRPCPort) + `,`,
-		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
-		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
-		`Tags:` + mapStringForTags + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringCluster(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	mapStringForTags += "}"
+	s := strings.Join([]string{`&PeerInfo{`,
+		`GRPCPort:` + fmt.Sprintf("%v", this.GRPCPort) + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`Tags:` + mapStringForTags + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterPeer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTags := make([]string, 0, len(this.Tags))
+	for k := range this.Tags {
+		keysForTags = append(keysForTags, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTags)
+	mapStringForTags := "map[string]string{"
+	for _, k := range keysForTags {
+		mapStringForTags += fmt.Sprintf("%v: %v,", k, this.Tags[k])
+	}
+	mapStringForTags += "}"
+	s := strings.Join([]string{`&ClusterPeer{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`Tags:` + mapStringForTags + `,`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Healthy:` + fmt.Sprintf("%v", this.Healthy) + `,`,
+		`LastSeenAt:` + strings.Replace(fmt.Sprintf("%v", this.LastSeenAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`Self:` + fmt.Sprintf("%v", this.Self) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterPeers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterPeers{`,
+		`Peers:` + strings.Replace(fmt.Sprintf("%v", this.Peers), "ClusterPeer", "ClusterPeer", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCluster(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PeerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCPort", wireType)
+			}
+			m.GRPCPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GRPCPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		case 3:
+			if wireType == 0 {
+				var v ClusterRole
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCluster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClusterRole(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Roles = append(m.Roles, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCluster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCluster
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCluster
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Roles) == 0 {
+					m.Roles = make([]ClusterRole, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClusterRole
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClusterRole(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Roles = append(m.Roles, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCluster
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCluster
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCluster(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCluster
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *PeerInfo) Unmarshal(dAtA []byte) error {
+func (m *ClusterPeer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -457,17 +1386,17 @@ func (m *PeerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterPeer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterPeer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GRPCPort", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.GRPCPort = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -477,16 +1406,29 @@ func (m *PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GRPCPort |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCluster
@@ -496,12 +1438,24 @@ func (m *PeerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLS = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType == 0 {
 				var v ClusterRole
@@ -698,6 +1652,233 @@ func (m *PeerInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSeenAt == nil {
+				m.LastSeenAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Self", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Self = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterPeers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterPeers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterPeers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &ClusterPeer{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCluster(dAtA[iNdEx:])
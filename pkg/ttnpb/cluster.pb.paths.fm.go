@@ -15,3 +15,33 @@ var PeerInfoFieldPathsTopLevel = []string{
 	"tags",
 	"tls",
 }
+var ClusterPeerFieldPathsNested = []string{
+	"address",
+	"healthy",
+	"last_seen_at",
+	"name",
+	"roles",
+	"self",
+	"source",
+	"state",
+	"tags",
+}
+
+var ClusterPeerFieldPathsTopLevel = []string{
+	"address",
+	"healthy",
+	"last_seen_at",
+	"name",
+	"roles",
+	"self",
+	"source",
+	"state",
+	"tags",
+}
+var ClusterPeersFieldPathsNested = []string{
+	"peers",
+}
+
+var ClusterPeersFieldPathsTopLevel = []string{
+	"peers",
+}
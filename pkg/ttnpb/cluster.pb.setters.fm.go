@@ -52,3 +52,121 @@ func (dst *PeerInfo) SetFields(src *PeerInfo, paths ...string) error {
 	}
 	return nil
 }
+
+func (dst *ClusterPeer) SetFields(src *ClusterPeer, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "address":
+			if len(subs) > 0 {
+				return fmt.Errorf("'address' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Address = src.Address
+			} else {
+				var zero string
+				dst.Address = zero
+			}
+		case "roles":
+			if len(subs) > 0 {
+				return fmt.Errorf("'roles' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Roles = src.Roles
+			} else {
+				dst.Roles = nil
+			}
+		case "tags":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tags' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Tags = src.Tags
+			} else {
+				dst.Tags = nil
+			}
+		case "source":
+			if len(subs) > 0 {
+				return fmt.Errorf("'source' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Source = src.Source
+			} else {
+				var zero string
+				dst.Source = zero
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero string
+				dst.State = zero
+			}
+		case "healthy":
+			if len(subs) > 0 {
+				return fmt.Errorf("'healthy' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Healthy = src.Healthy
+			} else {
+				var zero bool
+				dst.Healthy = zero
+			}
+		case "last_seen_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_seen_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastSeenAt = src.LastSeenAt
+			} else {
+				dst.LastSeenAt = nil
+			}
+		case "self":
+			if len(subs) > 0 {
+				return fmt.Errorf("'self' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Self = src.Self
+			} else {
+				var zero bool
+				dst.Self = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ClusterPeers) SetFields(src *ClusterPeers, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "peers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'peers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Peers = src.Peers
+			} else {
+				dst.Peers = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
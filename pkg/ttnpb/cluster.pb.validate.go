@@ -121,3 +121,206 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PeerInfoValidationError{}
+
+// ValidateFields checks the field values on ClusterPeer with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *ClusterPeer) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ClusterPeerFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+			// no validation rules for Name
+		case "address":
+			// no validation rules for Address
+		case "roles":
+
+		case "tags":
+			// no validation rules for Tags
+		case "source":
+			// no validation rules for Source
+		case "state":
+			// no validation rules for State
+		case "healthy":
+			// no validation rules for Healthy
+		case "last_seen_at":
+
+			if v, ok := interface{}(m.GetLastSeenAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ClusterPeerValidationError{
+						field:  "last_seen_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "self":
+			// no validation rules for Self
+		default:
+			return ClusterPeerValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ClusterPeerValidationError is the validation error returned by
+// ClusterPeer.ValidateFields if the designated constraints aren't met.
+type ClusterPeerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterPeerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterPeerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterPeerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterPeerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterPeerValidationError) ErrorName() string { return "ClusterPeerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterPeerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterPeer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterPeerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterPeerValidationError{}
+
+// ValidateFields checks the field values on ClusterPeers with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ClusterPeers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ClusterPeersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "peers":
+
+			for idx, item := range m.GetPeers() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ClusterPeersValidationError{
+							field:  fmt.Sprintf("peers[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ClusterPeersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ClusterPeersValidationError is the validation error returned by
+// ClusterPeers.ValidateFields if the designated constraints aren't met.
+type ClusterPeersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterPeersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterPeersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterPeersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterPeersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterPeersValidationError) ErrorName() string { return "ClusterPeersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterPeersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterPeers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterPeersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterPeersValidationError{}
@@ -54,6 +54,40 @@ func (v *PowerState) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalText implements encoding.TextMarshaler interface.
+func (v ADRAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (v *ADRAlgorithm) UnmarshalText(b []byte) error {
+	s := string(b)
+	if i, ok := ADRAlgorithm_value[s]; ok {
+		*v = ADRAlgorithm(i)
+		return nil
+	}
+	if !strings.HasPrefix(s, "ADR_ALGORITHM_") {
+		if i, ok := ADRAlgorithm_value["ADR_ALGORITHM_"+s]; ok {
+			*v = ADRAlgorithm(i)
+			return nil
+		}
+	}
+	return errCouldNotParse("ADRAlgorithm")(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (v *ADRAlgorithm) UnmarshalJSON(b []byte) error {
+	if len(b) > 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return v.UnmarshalText(b[1 : len(b)-1])
+	}
+	i, err := strconv.Atoi(string(b))
+	if err != nil {
+		return errCouldNotParse("ADRAlgorithm")(string(b)).WithCause(err)
+	}
+	*v = ADRAlgorithm(i)
+	return nil
+}
+
 // ValidateContext wraps the generated validator with (optionally context-based) custom checks.
 func (m *UpdateEndDeviceRequest) ValidateContext(context.Context) error {
 	if len(m.FieldMask.Paths) == 0 {
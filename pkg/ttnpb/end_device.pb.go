@@ -38,6 +38,39 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// ADR algorithm used by the Network Server to compute the desired data rate, transmit power and number of transmissions.
+type ADRAlgorithm int32
+
+const (
+	// Use the margin between the SNR of recent uplinks and the demodulation floor. This is the default.
+	ADR_ALGORITHM_MARGIN ADRAlgorithm = 0
+	// Same as margin, but with an additional safety margin and at most one data rate step per adaptation.
+	ADR_ALGORITHM_CONSERVATIVE ADRAlgorithm = 1
+	// Blind ADR for mobile devices, where the SNR of recent uplinks is not representative.
+	// The data rate moves one step along the data rate ladder of the band based on the loss rate, at maximum transmit power.
+	ADR_ALGORITHM_BLIND ADRAlgorithm = 2
+	// Use a fixed data rate at maximum transmit power.
+	ADR_ALGORITHM_FIXED ADRAlgorithm = 3
+)
+
+var ADRAlgorithm_name = map[int32]string{
+	0: "ADR_ALGORITHM_MARGIN",
+	1: "ADR_ALGORITHM_CONSERVATIVE",
+	2: "ADR_ALGORITHM_BLIND",
+	3: "ADR_ALGORITHM_FIXED",
+}
+
+var ADRAlgorithm_value = map[string]int32{
+	"ADR_ALGORITHM_MARGIN":       0,
+	"ADR_ALGORITHM_CONSERVATIVE": 1,
+	"ADR_ALGORITHM_BLIND":        2,
+	"ADR_ALGORITHM_FIXED":        3,
+}
+
+func (ADRAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{0}
+}
+
 // Power state of the device.
 type PowerState int32
 
@@ -60,7 +93,7 @@ var PowerState_value = map[string]int32{
 }
 
 func (PowerState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{0}
+	return fileDescriptor_a656ee0551c94a80, []int{1}
 }
 
 type Session struct {
@@ -855,9 +888,15 @@ type MACSettings struct {
 	DesiredRx2DataRateIndex *MACSettings_DataRateIndexValue `protobuf:"bytes,20,opt,name=desired_rx2_data_rate_index,json=desiredRx2DataRateIndex,proto3" json:"desired_rx2_data_rate_index,omitempty"`
 	// The Rx2 frequency index Network Server should configure device to use via MAC commands.
 	// If unset, the default value from frequency plan, Network Server configuration or regional parameters specification will be used.
-	DesiredRx2Frequency  *types.UInt64Value `protobuf:"bytes,21,opt,name=desired_rx2_frequency,json=desiredRx2Frequency,proto3" json:"desired_rx2_frequency,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	DesiredRx2Frequency *types.UInt64Value `protobuf:"bytes,21,opt,name=desired_rx2_frequency,json=desiredRx2Frequency,proto3" json:"desired_rx2_frequency,omitempty"`
+	// The ADR algorithm Network Server should use for the device.
+	// If unset, the default value from band or Network Server configuration will be used.
+	ADRAlgorithm *MACSettings_ADRAlgorithmValue `protobuf:"bytes,22,opt,name=adr_algorithm,json=adrAlgorithm,proto3" json:"adr_algorithm,omitempty"`
+	// The data rate index Network Server should configure the device to use with the fixed ADR algorithm.
+	// If unset, the current data rate index is kept.
+	ADRFixedDataRateIndex *MACSettings_DataRateIndexValue `protobuf:"bytes,23,opt,name=adr_fixed_data_rate_index,json=adrFixedDataRateIndex,proto3" json:"adr_fixed_data_rate_index,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                        `json:"-"`
+	XXX_sizecache         int32                           `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -1039,6 +1078,20 @@ func (m *MACSettings) GetDesiredRx2Frequency() *types.UInt64Value {
 	return nil
 }
 
+func (m *MACSettings) GetADRAlgorithm() *MACSettings_ADRAlgorithmValue {
+	if m != nil {
+		return m.ADRAlgorithm
+	}
+	return nil
+}
+
+func (m *MACSettings) GetADRFixedDataRateIndex() *MACSettings_DataRateIndexValue {
+	if m != nil {
+		return m.ADRFixedDataRateIndex
+	}
+	return nil
+}
+
 type MACSettings_DataRateIndexValue struct {
 	Value                DataRateIndex `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -1219,6 +1272,51 @@ func (m *MACSettings_RxDelayValue) GetValue() RxDelay {
 	return RX_DELAY_0
 }
 
+type MACSettings_ADRAlgorithmValue struct {
+	Value                ADRAlgorithm `protobuf:"varint,1,opt,name=value,proto3,enum=ttn.lorawan.v3.ADRAlgorithm" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MACSettings_ADRAlgorithmValue) Reset()      { *m = MACSettings_ADRAlgorithmValue{} }
+func (*MACSettings_ADRAlgorithmValue) ProtoMessage() {}
+func (*MACSettings_ADRAlgorithmValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{6, 4}
+}
+func (m *MACSettings_ADRAlgorithmValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACSettings_ADRAlgorithmValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACSettings_ADRAlgorithmValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MACSettings_ADRAlgorithmValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACSettings_ADRAlgorithmValue.Merge(m, src)
+}
+func (m *MACSettings_ADRAlgorithmValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACSettings_ADRAlgorithmValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACSettings_ADRAlgorithmValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACSettings_ADRAlgorithmValue proto.InternalMessageInfo
+
+func (m *MACSettings_ADRAlgorithmValue) GetValue() ADRAlgorithm {
+	if m != nil {
+		return m.Value
+	}
+	return ADR_ALGORITHM_MARGIN
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server and is read only.
@@ -1257,9 +1355,15 @@ type MACState struct {
 	// Whether or not Rx windows are expected to be open.
 	// Set to true every time an uplink is received.
 	// Set to false every time a successful downlink scheduling attempt is made.
-	RxWindowsAvailable   bool     `protobuf:"varint,13,opt,name=rx_windows_available,json=rxWindowsAvailable,proto3" json:"rx_windows_available,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	RxWindowsAvailable bool `protobuf:"varint,13,opt,name=rx_windows_available,json=rxWindowsAvailable,proto3" json:"rx_windows_available,omitempty"`
+	// MAC commands queued by an operator, which are not generated by the Network Server itself.
+	// Removed each time a downlink containing them is scheduled, requests are then added to pending_requests.
+	QueuedOperatorCommands []*MACCommand `protobuf:"bytes,14,rep,name=queued_operator_commands,json=queuedOperatorCommands,proto3" json:"queued_operator_commands,omitempty"`
+	// Progress of the channel plan reconciliation.
+	// Set each time the frequency plan of the device is changed while the MAC state is present.
+	ChannelPlanReconciliation *MACState_ChannelPlanReconciliation `protobuf:"bytes,15,opt,name=channel_plan_reconciliation,json=channelPlanReconciliation,proto3" json:"channel_plan_reconciliation,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                            `json:"-"`
+	XXX_sizecache             int32                               `json:"-"`
 }
 
 func (m *MACState) Reset()      { *m = MACState{} }
@@ -1385,6 +1489,20 @@ func (m *MACState) GetRxWindowsAvailable() bool {
 	return false
 }
 
+func (m *MACState) GetQueuedOperatorCommands() []*MACCommand {
+	if m != nil {
+		return m.QueuedOperatorCommands
+	}
+	return nil
+}
+
+func (m *MACState) GetChannelPlanReconciliation() *MACState_ChannelPlanReconciliation {
+	if m != nil {
+		return m.ChannelPlanReconciliation
+	}
+	return nil
+}
+
 type MACState_JoinAccept struct {
 	// Payload of the join-accept received from Join Server.
 	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -1449,8 +1567,221 @@ func (m *MACState_JoinAccept) GetKeys() SessionKeys {
 	return SessionKeys{}
 }
 
+type MACState_ChannelPlanReconciliation struct {
+	// ID of the frequency plan the device channels are migrated to.
+	FrequencyPlanID string `protobuf:"bytes,1,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// Time when the reconciliation started.
+	StartedAt time.Time `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	// Number of channels, which are not yet configured on the device according to the frequency plan.
+	PendingChannels uint32 `protobuf:"varint,3,opt,name=pending_channels,json=pendingChannels,proto3" json:"pending_channels,omitempty"`
+	// Time when the reconciliation completed.
+	// Not set while the reconciliation is in progress.
+	CompletedAt          *time.Time `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3,stdtime" json:"completed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MACState_ChannelPlanReconciliation) Reset()      { *m = MACState_ChannelPlanReconciliation{} }
+func (*MACState_ChannelPlanReconciliation) ProtoMessage() {}
+func (*MACState_ChannelPlanReconciliation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{7, 1}
+}
+func (m *MACState_ChannelPlanReconciliation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACState_ChannelPlanReconciliation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACState_ChannelPlanReconciliation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MACState_ChannelPlanReconciliation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACState_ChannelPlanReconciliation.Merge(m, src)
+}
+func (m *MACState_ChannelPlanReconciliation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACState_ChannelPlanReconciliation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACState_ChannelPlanReconciliation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACState_ChannelPlanReconciliation proto.InternalMessageInfo
+
+func (m *MACState_ChannelPlanReconciliation) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
+	}
+	return ""
+}
+
+func (m *MACState_ChannelPlanReconciliation) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
+	}
+	return time.Time{}
+}
+
+func (m *MACState_ChannelPlanReconciliation) GetPendingChannels() uint32 {
+	if m != nil {
+		return m.PendingChannels
+	}
+	return 0
+}
+
+func (m *MACState_ChannelPlanReconciliation) GetCompletedAt() *time.Time {
+	if m != nil {
+		return m.CompletedAt
+	}
+	return nil
+}
+
+// Airtime used by an end device, accounted in hourly buckets over the last 24 hours.
+type EndDeviceAirtimeUsage struct {
+	// Buckets sorted by start time.
+	Buckets []*EndDeviceAirtimeUsage_Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// Time at which the end device last exceeded the fair-use limits.
+	ExceededAt           *time.Time `protobuf:"bytes,2,opt,name=exceeded_at,json=exceededAt,proto3,stdtime" json:"exceeded_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *EndDeviceAirtimeUsage) Reset()      { *m = EndDeviceAirtimeUsage{} }
+func (*EndDeviceAirtimeUsage) ProtoMessage() {}
+func (*EndDeviceAirtimeUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{8}
+}
+func (m *EndDeviceAirtimeUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceAirtimeUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceAirtimeUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceAirtimeUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceAirtimeUsage.Merge(m, src)
+}
+func (m *EndDeviceAirtimeUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceAirtimeUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceAirtimeUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceAirtimeUsage proto.InternalMessageInfo
+
+func (m *EndDeviceAirtimeUsage) GetBuckets() []*EndDeviceAirtimeUsage_Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *EndDeviceAirtimeUsage) GetExceededAt() *time.Time {
+	if m != nil {
+		return m.ExceededAt
+	}
+	return nil
+}
+
+type EndDeviceAirtimeUsage_Bucket struct {
+	// Start of the hour accounted by this bucket.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// Total airtime of uplink messages received in this hour.
+	UplinkAirtime time.Duration `protobuf:"bytes,2,opt,name=uplink_airtime,json=uplinkAirtime,proto3,stdduration" json:"uplink_airtime"`
+	// Total airtime of downlink messages scheduled in this hour.
+	DownlinkAirtime time.Duration `protobuf:"bytes,3,opt,name=downlink_airtime,json=downlinkAirtime,proto3,stdduration" json:"downlink_airtime"`
+	// Number of uplink messages received in this hour.
+	Uplinks uint32 `protobuf:"varint,4,opt,name=uplinks,proto3" json:"uplinks,omitempty"`
+	// Number of downlink messages scheduled in this hour.
+	Downlinks            uint32   `protobuf:"varint,5,opt,name=downlinks,proto3" json:"downlinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EndDeviceAirtimeUsage_Bucket) Reset()      { *m = EndDeviceAirtimeUsage_Bucket{} }
+func (*EndDeviceAirtimeUsage_Bucket) ProtoMessage() {}
+func (*EndDeviceAirtimeUsage_Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{8, 0}
+}
+func (m *EndDeviceAirtimeUsage_Bucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceAirtimeUsage_Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceAirtimeUsage_Bucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceAirtimeUsage_Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceAirtimeUsage_Bucket.Merge(m, src)
+}
+func (m *EndDeviceAirtimeUsage_Bucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceAirtimeUsage_Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceAirtimeUsage_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceAirtimeUsage_Bucket proto.InternalMessageInfo
+
+func (m *EndDeviceAirtimeUsage_Bucket) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+func (m *EndDeviceAirtimeUsage_Bucket) GetUplinkAirtime() time.Duration {
+	if m != nil {
+		return m.UplinkAirtime
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsage_Bucket) GetDownlinkAirtime() time.Duration {
+	if m != nil {
+		return m.DownlinkAirtime
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsage_Bucket) GetUplinks() uint32 {
+	if m != nil {
+		return m.Uplinks
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsage_Bucket) GetDownlinks() uint32 {
+	if m != nil {
+		return m.Downlinks
+	}
+	return 0
+}
+
 // Authentication code for end devices.
 type EndDeviceAuthenticationCode struct {
+	// The authentication code. If empty when set in the Join Server, a random code is generated.
 	Value                []byte     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	ValidFrom            *time.Time `protobuf:"bytes,2,opt,name=valid_from,json=validFrom,proto3,stdtime" json:"valid_from,omitempty"`
 	ValidTo              *time.Time `protobuf:"bytes,3,opt,name=valid_to,json=validTo,proto3,stdtime" json:"valid_to,omitempty"`
@@ -1461,7 +1792,7 @@ type EndDeviceAuthenticationCode struct {
 func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
 func (*EndDeviceAuthenticationCode) ProtoMessage() {}
 func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{8}
+	return fileDescriptor_a656ee0551c94a80, []int{9}
 }
 func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1657,14 +1988,16 @@ type EndDevice struct {
 	Multicast bool `protobuf:"varint,45,opt,name=multicast,proto3" json:"multicast,omitempty"`
 	// Authentication code to claim ownership of the end device. Stored in Join Server.
 	ClaimAuthenticationCode *EndDeviceAuthenticationCode `protobuf:"bytes,46,opt,name=claim_authentication_code,json=claimAuthenticationCode,proto3" json:"claim_authentication_code,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                     `json:"-"`
-	XXX_sizecache           int32                        `json:"-"`
+	// Airtime used by the end device over the last 24 hours. Stored in Network Server.
+	AirtimeUsage         *EndDeviceAirtimeUsage `protobuf:"bytes,50,opt,name=airtime_usage,json=airtimeUsage,proto3" json:"airtime_usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{9}
+	return fileDescriptor_a656ee0551c94a80, []int{10}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2022,6 +2355,13 @@ func (m *EndDevice) GetClaimAuthenticationCode() *EndDeviceAuthenticationCode {
 	return nil
 }
 
+func (m *EndDevice) GetAirtimeUsage() *EndDeviceAirtimeUsage {
+	if m != nil {
+		return m.AirtimeUsage
+	}
+	return nil
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -2031,7 +2371,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{10}
+	return fileDescriptor_a656ee0551c94a80, []int{11}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2076,7 +2416,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{11}
+	return fileDescriptor_a656ee0551c94a80, []int{12}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2115,7 +2455,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{12}
+	return fileDescriptor_a656ee0551c94a80, []int{13}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2161,7 +2501,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{13}
+	return fileDescriptor_a656ee0551c94a80, []int{14}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2207,7 +2547,7 @@ type GetEndDeviceIdentifiersForEUIsRequest struct {
 func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
 func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
 func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{14}
+	return fileDescriptor_a656ee0551c94a80, []int{15}
 }
 func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2253,7 +2593,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{15}
+	return fileDescriptor_a656ee0551c94a80, []int{16}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2320,7 +2660,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{16}
+	return fileDescriptor_a656ee0551c94a80, []int{17}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2374,7 +2714,7 @@ type EndDeviceTemplate struct {
 func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
 func (*EndDeviceTemplate) ProtoMessage() {}
 func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{17}
+	return fileDescriptor_a656ee0551c94a80, []int{18}
 }
 func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2434,7 +2774,7 @@ type EndDeviceTemplateFormat struct {
 func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
 func (*EndDeviceTemplateFormat) ProtoMessage() {}
 func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
+	return fileDescriptor_a656ee0551c94a80, []int{19}
 }
 func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2486,7 +2826,7 @@ type EndDeviceTemplateFormats struct {
 func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
 func (*EndDeviceTemplateFormats) ProtoMessage() {}
 func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+	return fileDescriptor_a656ee0551c94a80, []int{20}
 }
 func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2534,7 +2874,7 @@ type ConvertEndDeviceTemplateRequest struct {
 func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
 func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
 func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{20}
+	return fileDescriptor_a656ee0551c94a80, []int{21}
 }
 func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2578,6 +2918,8 @@ func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
 }
 
 func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.ADRAlgorithm", ADRAlgorithm_name, ADRAlgorithm_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ADRAlgorithm", ADRAlgorithm_name, ADRAlgorithm_value)
 	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
 	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
@@ -2604,10 +2946,18 @@ func init() {
 	golang_proto.RegisterType((*MACSettings_AggregatedDutyCycleValue)(nil), "ttn.lorawan.v3.MACSettings.AggregatedDutyCycleValue")
 	proto.RegisterType((*MACSettings_RxDelayValue)(nil), "ttn.lorawan.v3.MACSettings.RxDelayValue")
 	golang_proto.RegisterType((*MACSettings_RxDelayValue)(nil), "ttn.lorawan.v3.MACSettings.RxDelayValue")
+	proto.RegisterType((*MACSettings_ADRAlgorithmValue)(nil), "ttn.lorawan.v3.MACSettings.ADRAlgorithmValue")
+	golang_proto.RegisterType((*MACSettings_ADRAlgorithmValue)(nil), "ttn.lorawan.v3.MACSettings.ADRAlgorithmValue")
 	proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
 	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	proto.RegisterType((*MACState_ChannelPlanReconciliation)(nil), "ttn.lorawan.v3.MACState.ChannelPlanReconciliation")
+	golang_proto.RegisterType((*MACState_ChannelPlanReconciliation)(nil), "ttn.lorawan.v3.MACState.ChannelPlanReconciliation")
+	proto.RegisterType((*EndDeviceAirtimeUsage)(nil), "ttn.lorawan.v3.EndDeviceAirtimeUsage")
+	golang_proto.RegisterType((*EndDeviceAirtimeUsage)(nil), "ttn.lorawan.v3.EndDeviceAirtimeUsage")
+	proto.RegisterType((*EndDeviceAirtimeUsage_Bucket)(nil), "ttn.lorawan.v3.EndDeviceAirtimeUsage.Bucket")
+	golang_proto.RegisterType((*EndDeviceAirtimeUsage_Bucket)(nil), "ttn.lorawan.v3.EndDeviceAirtimeUsage.Bucket")
 	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
 	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
 	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
@@ -2648,291 +2998,325 @@ func init() {
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 4511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6c, 0x1c, 0x47,
-	0x7a, 0x9e, 0x1e, 0x8e, 0x38, 0x33, 0x3f, 0x1f, 0x33, 0x2c, 0x92, 0x52, 0x8b, 0x92, 0x67, 0x68,
-	0x4a, 0xb6, 0x47, 0x5a, 0x71, 0x64, 0x51, 0x7e, 0x45, 0x7e, 0x68, 0x67, 0x38, 0xa4, 0x3d, 0x7a,
-	0x99, 0x29, 0x8a, 0x52, 0x2c, 0x4b, 0xee, 0x14, 0xa7, 0x8b, 0x54, 0x9b, 0x33, 0xdd, 0xb3, 0xdd,
-	0x35, 0x7c, 0x78, 0x6d, 0x40, 0x08, 0x12, 0xec, 0x62, 0x81, 0x04, 0x9b, 0x5c, 0xb2, 0xc8, 0x21,
-	0x30, 0x02, 0x04, 0xd8, 0xe3, 0x22, 0xc8, 0x02, 0xbe, 0x65, 0x11, 0x20, 0x81, 0x2f, 0x01, 0x7c,
-	0xd8, 0xc3, 0x62, 0x0f, 0xcc, 0x6a, 0x74, 0xf1, 0x25, 0xc0, 0x1e, 0x17, 0x3a, 0x04, 0x41, 0x3d,
-	0xfa, 0x31, 0x0f, 0xbe, 0x64, 0x67, 0xe1, 0x0b, 0xd9, 0x53, 0xf5, 0xff, 0xdf, 0xff, 0xa8, 0xaa,
-	0xbf, 0xfe, 0xff, 0xef, 0x86, 0x99, 0xba, 0xe3, 0x92, 0x2d, 0x62, 0xcf, 0x7a, 0x8c, 0xd4, 0x36,
-	0x2e, 0x92, 0xa6, 0x75, 0x91, 0xda, 0xa6, 0x61, 0xd2, 0x4d, 0xab, 0x46, 0x8b, 0x4d, 0xd7, 0x61,
-	0x0e, 0x1a, 0x65, 0xcc, 0x2e, 0x2a, 0xba, 0xe2, 0xe6, 0xe5, 0xa9, 0xd2, 0xba, 0xc5, 0x1e, 0xb6,
-	0x56, 0x8b, 0x35, 0xa7, 0x71, 0x91, 0xda, 0x9b, 0xce, 0x4e, 0xd3, 0x75, 0xb6, 0x77, 0x2e, 0x0a,
-	0xe2, 0xda, 0xec, 0x3a, 0xb5, 0x67, 0x37, 0x49, 0xdd, 0x32, 0x09, 0xa3, 0x17, 0x7b, 0x1e, 0x24,
-	0xe4, 0xd4, 0x6c, 0x04, 0x62, 0xdd, 0x59, 0x77, 0x24, 0xf3, 0x6a, 0x6b, 0x4d, 0xfc, 0x12, 0x3f,
-	0xc4, 0x93, 0x22, 0x3f, 0xbd, 0xee, 0x38, 0xeb, 0x75, 0x2a, 0xd4, 0x23, 0xb6, 0xed, 0x30, 0xc2,
-	0x2c, 0xc7, 0xf6, 0xd4, 0x6c, 0x4e, 0xcd, 0x06, 0x18, 0x66, 0xcb, 0x15, 0x04, 0x6a, 0xfe, 0x54,
-	0xf7, 0x3c, 0x6d, 0x34, 0xd9, 0x8e, 0x9a, 0x9c, 0xee, 0x9e, 0x5c, 0xb3, 0x68, 0xdd, 0x34, 0x1a,
-	0xc4, 0xdb, 0xe8, 0x12, 0x1e, 0x50, 0x78, 0xcc, 0x6d, 0xd5, 0x98, 0x9a, 0xcd, 0x77, 0xcf, 0x32,
-	0xab, 0x41, 0x3d, 0x46, 0x1a, 0xcd, 0xbd, 0xb4, 0xdb, 0x72, 0x49, 0xb3, 0x49, 0x5d, 0x5f, 0xfb,
-	0x33, 0xbd, 0x2b, 0x60, 0x99, 0xd4, 0x66, 0xd6, 0x9a, 0x15, 0x12, 0x9d, 0xee, 0x25, 0xfa, 0xd8,
-	0xb1, 0xec, 0xbd, 0x67, 0x37, 0xe8, 0x8e, 0xcf, 0x9b, 0xef, 0x9d, 0xf5, 0x17, 0x53, 0xb9, 0xa0,
-	0x97, 0xa0, 0x41, 0x3d, 0x8f, 0xac, 0x53, 0x6f, 0x3f, 0x0a, 0x46, 0x4c, 0xc2, 0x88, 0xa4, 0x98,
-	0xf9, 0xfb, 0x01, 0x48, 0x2e, 0x53, 0xcf, 0xb3, 0x1c, 0x1b, 0xdd, 0x85, 0x94, 0x49, 0x37, 0x0d,
-	0x62, 0x9a, 0xae, 0x1e, 0x9f, 0xd6, 0x0a, 0xc3, 0xe5, 0xb7, 0xbe, 0xdc, 0xcd, 0xc7, 0x7e, 0xbb,
-	0x9b, 0x7f, 0x65, 0xdd, 0x29, 0xb2, 0x87, 0x94, 0x3d, 0xb4, 0xec, 0x75, 0xaf, 0x68, 0x53, 0xb6,
-	0xe5, 0xb8, 0x1b, 0x17, 0x3b, 0xc1, 0x9b, 0x1b, 0xeb, 0x17, 0xd9, 0x4e, 0x93, 0x7a, 0xc5, 0x0a,
-	0xdd, 0x2c, 0x99, 0xa6, 0x8b, 0x93, 0xa6, 0x7c, 0x40, 0x25, 0x48, 0x70, 0xbb, 0xf4, 0x81, 0x69,
-	0xad, 0x30, 0x34, 0x77, 0xaa, 0xd8, 0xb9, 0x2f, 0x8b, 0x4a, 0xfe, 0x75, 0xba, 0xe3, 0x95, 0xb3,
-	0x4f, 0xcb, 0xc7, 0x7e, 0xa2, 0xc5, 0xb3, 0x1a, 0x97, 0xfc, 0xd5, 0x6e, 0x5e, 0xc3, 0x82, 0x15,
-	0x3d, 0x0f, 0x23, 0x75, 0xe2, 0x31, 0x63, 0xcd, 0xa8, 0xd9, 0xcc, 0x68, 0x35, 0xf5, 0xc4, 0xb4,
-	0x56, 0x18, 0xc1, 0xc0, 0x07, 0x17, 0xe7, 0x6d, 0xb6, 0xd2, 0x44, 0x05, 0x18, 0x13, 0x24, 0xb6,
-	0x22, 0x32, 0x9d, 0x2d, 0x5b, 0x3f, 0x26, 0xc8, 0x04, 0xef, 0x2d, 0x4e, 0x57, 0x71, 0xb6, 0xec,
-	0x80, 0x92, 0x44, 0x29, 0x07, 0x43, 0xca, 0x52, 0x40, 0x59, 0x84, 0x09, 0x41, 0x59, 0x73, 0xec,
-	0xb5, 0x28, 0x71, 0x52, 0x10, 0x67, 0xf9, 0xdc, 0xbc, 0x63, 0xaf, 0x05, 0xf4, 0xf3, 0x00, 0x1e,
-	0x23, 0x2e, 0xa3, 0xa6, 0x41, 0x98, 0x9e, 0x12, 0xf6, 0x4e, 0x15, 0xe5, 0x4e, 0x2a, 0xfa, 0x3b,
-	0xa9, 0x78, 0xdb, 0xdf, 0x6a, 0xe5, 0x14, 0x37, 0xf3, 0xa7, 0xff, 0x9d, 0xd7, 0x70, 0x5a, 0xf1,
-	0x95, 0xd8, 0xb5, 0x44, 0x4a, 0xcb, 0xc6, 0x67, 0xfe, 0x67, 0x04, 0x46, 0x6e, 0x96, 0xe6, 0x97,
-	0x88, 0x4b, 0x1a, 0x94, 0x51, 0xd7, 0x43, 0x2f, 0x42, 0xaa, 0x41, 0xb6, 0x0d, 0x6a, 0xb9, 0x4d,
-	0x5d, 0x9b, 0xd6, 0x0a, 0xf1, 0xf2, 0x50, 0x7b, 0x37, 0x9f, 0xbc, 0x49, 0xb6, 0x17, 0xaa, 0x78,
-	0x09, 0x27, 0x1b, 0x64, 0x7b, 0xc1, 0x72, 0x9b, 0xe8, 0x63, 0x18, 0x27, 0xa6, 0x6b, 0xf0, 0x55,
-	0x36, 0x5c, 0xc2, 0xa8, 0x61, 0xd9, 0x26, 0xdd, 0x16, 0x1e, 0x1b, 0x9d, 0x7b, 0xae, 0xdb, 0xfb,
-	0x15, 0xc2, 0x08, 0x26, 0x8c, 0x56, 0x39, 0x51, 0xf9, 0xf4, 0xd3, 0xf2, 0xb1, 0xbf, 0xe0, 0xfe,
-	0x6f, 0xef, 0xe6, 0xb3, 0xa5, 0x0a, 0xee, 0x98, 0xc5, 0x59, 0x62, 0xba, 0x1d, 0x23, 0xe8, 0x5d,
-	0x40, 0x5c, 0x16, 0xdb, 0x36, 0x9a, 0xce, 0x16, 0x75, 0x95, 0x28, 0xe1, 0xf5, 0xf2, 0xd4, 0xd3,
-	0x72, 0xe2, 0x7c, 0x5c, 0xcf, 0xb4, 0x77, 0xf3, 0x99, 0x52, 0x05, 0xdf, 0xde, 0x5e, 0xe2, 0x24,
-	0x12, 0x29, 0x43, 0x4c, 0x37, 0x3a, 0x80, 0x5e, 0x87, 0x61, 0x0e, 0x64, 0xaf, 0x1a, 0xcc, 0x25,
-	0xb6, 0x27, 0x97, 0xa3, 0x3c, 0x19, 0x42, 0x40, 0xa9, 0x82, 0x6f, 0xad, 0xde, 0xe6, 0x93, 0x18,
-	0x88, 0xe9, 0xaa, 0x67, 0xf4, 0x0e, 0x8c, 0x70, 0x46, 0x52, 0xdb, 0x30, 0xea, 0x56, 0xc3, 0x62,
-	0x72, 0x6d, 0xb8, 0xf0, 0xd4, 0xf9, 0x41, 0xfd, 0xd1, 0xa3, 0x78, 0x81, 0xdb, 0x32, 0x54, 0xaa,
-	0xe0, 0x52, 0x6d, 0xe3, 0x06, 0xa7, 0xc0, 0x43, 0xc4, 0x74, 0xfd, 0x1f, 0x51, 0x7e, 0x93, 0xd6,
-	0xc9, 0x8e, 0x58, 0xb5, 0xbd, 0xf8, 0x2b, 0x9c, 0xc2, 0xe7, 0x17, 0x3f, 0xd0, 0x3b, 0x90, 0x76,
-	0xb7, 0x2f, 0x29, 0xde, 0xb4, 0xf0, 0xf1, 0x89, 0x6e, 0x1f, 0xe3, 0x6d, 0x41, 0x5b, 0x4e, 0xf9,
-	0xde, 0xc5, 0x29, 0x77, 0xfb, 0x92, 0xe4, 0x7f, 0x03, 0x26, 0x04, 0x7f, 0xb0, 0x5a, 0xce, 0xda,
-	0x9a, 0x47, 0x99, 0x0e, 0x42, 0x8d, 0xa4, 0x74, 0x40, 0x12, 0x8f, 0x71, 0x06, 0xe5, 0xfa, 0xf7,
-	0x05, 0x05, 0xba, 0x03, 0xe3, 0xee, 0xf6, 0x5c, 0xcf, 0x3a, 0x0f, 0x1d, 0x66, 0x9d, 0x43, 0x4d,
-	0xb2, 0xee, 0xf6, 0x5c, 0xe7, 0x9a, 0x16, 0x61, 0x84, 0xe3, 0xae, 0xb9, 0xf4, 0x07, 0x2d, 0x6a,
-	0xd7, 0x76, 0xf4, 0xe1, 0x69, 0xad, 0x90, 0x28, 0xa7, 0x9f, 0x96, 0x07, 0xe7, 0x12, 0x85, 0xcf,
-	0xff, 0x7a, 0x10, 0x0f, 0xbb, 0xdb, 0x73, 0x8b, 0xfe, 0x34, 0x5a, 0x86, 0x51, 0xbe, 0x2f, 0xcd,
-	0x16, 0xdb, 0x31, 0x6a, 0x3b, 0xb5, 0x3a, 0xd5, 0x47, 0x84, 0x0a, 0x67, 0xba, 0x55, 0x28, 0xad,
-	0xaf, 0xbb, 0x74, 0x9d, 0x30, 0x6a, 0x56, 0x5a, 0x6c, 0x67, 0x9e, 0x93, 0x46, 0x14, 0x19, 0x6e,
-	0x90, 0xed, 0x60, 0x1c, 0x99, 0x70, 0xc2, 0xa5, 0x3c, 0x56, 0x1a, 0x3c, 0x30, 0x1b, 0x4d, 0xea,
-	0x5a, 0x8e, 0x69, 0xd5, 0x2c, 0xb6, 0xa3, 0x8f, 0x0a, 0xf4, 0x99, 0x1e, 0x27, 0x0b, 0x72, 0x7e,
-	0xb6, 0x16, 0xb6, 0x9b, 0x8e, 0x4d, 0x6d, 0x16, 0x01, 0x9f, 0x74, 0x83, 0xd9, 0xa5, 0x10, 0x0a,
-	0xad, 0x83, 0xae, 0xa4, 0xd4, 0x9c, 0x96, 0xcd, 0x3a, 0xc4, 0x64, 0xfa, 0x1b, 0x21, 0xc5, 0xcc,
-	0x73, 0xf2, 0x3e, 0x72, 0x8e, 0xbb, 0xe1, 0x74, 0x54, 0xd0, 0x9b, 0x30, 0xde, 0xb4, 0xec, 0x75,
-	0xc3, 0xab, 0x3b, 0x2c, 0xe2, 0xd9, 0xac, 0xf0, 0xec, 0xd0, 0xd3, 0x72, 0x6a, 0x6e, 0x50, 0x8f,
-	0x09, 0xdf, 0x8e, 0x71, 0xba, 0xe5, 0xba, 0xc3, 0x42, 0x07, 0x13, 0x38, 0x19, 0x32, 0x77, 0x2f,
-	0xf7, 0xd8, 0xd1, 0x96, 0x7b, 0xd2, 0x87, 0xef, 0x5c, 0xf3, 0xd7, 0x20, 0xbb, 0x4a, 0x49, 0xcd,
-	0xb1, 0x23, 0xca, 0xa1, 0x5e, 0xe5, 0x32, 0x92, 0x28, 0x54, 0xed, 0x3a, 0xa4, 0x6a, 0x0f, 0x89,
-	0x6d, 0xd3, 0xba, 0xa7, 0x8f, 0x4f, 0x0f, 0x14, 0x86, 0xe6, 0x5e, 0xe8, 0xd6, 0xa4, 0x23, 0x88,
-	0x15, 0xe7, 0x25, 0xb5, 0xd0, 0xe8, 0xef, 0xb4, 0x78, 0x4a, 0xc3, 0x01, 0x00, 0x5a, 0x84, 0xb1,
-	0x56, 0xb3, 0x6e, 0xd9, 0x1b, 0x86, 0xb9, 0x45, 0xeb, 0x75, 0xb1, 0xf2, 0xfa, 0xc4, 0x1e, 0x41,
-	0xb4, 0xec, 0x38, 0xf5, 0x3b, 0xa4, 0xde, 0xa2, 0x38, 0x23, 0x99, 0x2a, 0x9c, 0x87, 0x2f, 0x30,
-	0xba, 0x06, 0xe3, 0x3c, 0x4a, 0x77, 0x23, 0x4d, 0x1e, 0x88, 0x34, 0xe6, 0xb3, 0x05, 0x58, 0x53,
-	0xbf, 0x8e, 0x43, 0x52, 0xe9, 0x8c, 0x5e, 0x81, 0xac, 0xd2, 0x2f, 0x74, 0x92, 0xd6, 0x7d, 0x36,
-	0x94, 0x36, 0xa1, 0x8b, 0xde, 0x00, 0x14, 0x68, 0x13, 0xf2, 0xc5, 0xbb, 0xf9, 0x02, 0xd9, 0x21,
-	0xe7, 0x1d, 0x18, 0x6f, 0x58, 0x76, 0xcf, 0x8a, 0x0f, 0x1c, 0xf1, 0x80, 0x37, 0x2c, 0xbb, 0x73,
-	0xb1, 0x39, 0x2e, 0x3f, 0xb0, 0xcf, 0x72, 0x41, 0x44, 0x71, 0xc9, 0x76, 0x27, 0xee, 0x19, 0x18,
-	0xa1, 0x36, 0x59, 0xad, 0x53, 0x43, 0xfa, 0x40, 0xdc, 0x03, 0x29, 0x3c, 0x2c, 0x07, 0x57, 0xc4,
-	0xd8, 0x95, 0xc4, 0x17, 0x9f, 0xe7, 0x63, 0xf2, 0xef, 0xb5, 0x44, 0x2a, 0x9e, 0x1d, 0xb8, 0x96,
-	0x48, 0x0d, 0x64, 0x13, 0x33, 0x0d, 0x18, 0x5d, 0xb0, 0xcd, 0x8a, 0x48, 0x60, 0xcb, 0x2e, 0xb1,
-	0x4d, 0x74, 0x1c, 0xe2, 0x96, 0x29, 0x1c, 0x9c, 0x2e, 0x0f, 0xb6, 0x77, 0xf3, 0xf1, 0x6a, 0x05,
-	0xc7, 0x2d, 0x13, 0x21, 0x48, 0xd8, 0xa4, 0x41, 0x85, 0x0b, 0xd3, 0x58, 0x3c, 0xa3, 0x93, 0x30,
-	0xd0, 0x72, 0xeb, 0xc2, 0x35, 0xe9, 0x72, 0xb2, 0xbd, 0x9b, 0x1f, 0x58, 0xc1, 0x37, 0x30, 0x1f,
-	0x43, 0x13, 0x70, 0xac, 0xee, 0xac, 0x3b, 0x9e, 0x9e, 0x98, 0x1e, 0x28, 0xa4, 0xb1, 0xfc, 0x31,
-	0xf3, 0x2f, 0x5a, 0x44, 0xde, 0x4d, 0xc7, 0xa4, 0x75, 0x74, 0x13, 0x52, 0xab, 0x5c, 0xb0, 0x11,
-	0x48, 0x9d, 0x7b, 0x5a, 0x3e, 0xeb, 0xce, 0xe8, 0x67, 0xe7, 0x72, 0x1f, 0x7d, 0x48, 0x66, 0x3f,
-	0x79, 0x79, 0xf6, 0x4f, 0x1e, 0x14, 0xae, 0x5e, 0xf9, 0x70, 0xf6, 0xc1, 0x55, 0xff, 0xe7, 0xb9,
-	0x1f, 0xce, 0x5d, 0xf8, 0xec, 0x2c, 0xbf, 0x86, 0x85, 0xce, 0xd5, 0x0a, 0x4e, 0x0a, 0x8c, 0xaa,
-	0x89, 0xde, 0x16, 0xea, 0x0b, 0x25, 0xcb, 0xb3, 0x87, 0x07, 0xea, 0xb6, 0x72, 0x20, 0xb4, 0x72,
-	0xe6, 0x6f, 0xe3, 0x70, 0x2a, 0x50, 0xfa, 0x0e, 0x75, 0x79, 0xda, 0x54, 0x0d, 0x93, 0xce, 0x6f,
-	0xdb, 0x82, 0x9b, 0x90, 0x6a, 0x70, 0xcf, 0x18, 0x81, 0x1d, 0x47, 0x81, 0x13, 0x4e, 0xe5, 0x70,
-	0x02, 0xa3, 0x6a, 0xa2, 0x73, 0x90, 0x7d, 0x48, 0x5c, 0x73, 0x8b, 0xb8, 0xd4, 0xd8, 0x94, 0xca,
-	0x2b, 0xeb, 0x32, 0xfe, 0xb8, 0xb2, 0x89, 0x93, 0xae, 0x59, 0x6e, 0xa3, 0x83, 0x34, 0x21, 0x49,
-	0xfd, 0x71, 0x45, 0x3a, 0xf3, 0xeb, 0x41, 0xc8, 0x76, 0xfb, 0x04, 0xbd, 0x0f, 0x03, 0x96, 0xe9,
-	0x09, 0x1f, 0x0c, 0xcd, 0x7d, 0xaf, 0x7b, 0x47, 0xef, 0xe3, 0xc2, 0x3e, 0x09, 0x28, 0x47, 0x42,
-	0x06, 0x64, 0x14, 0x40, 0xa0, 0x4f, 0x5c, 0x1c, 0x97, 0xa9, 0x3e, 0xe1, 0x4e, 0xc1, 0xf2, 0x1c,
-	0x22, 0x48, 0xa6, 0x46, 0x6f, 0x38, 0x98, 0xdc, 0x2d, 0xdd, 0x52, 0x73, 0x78, 0x54, 0xb1, 0xf8,
-	0x1a, 0x5b, 0x30, 0xee, 0x0b, 0x68, 0x3e, 0xdc, 0xe9, 0xf0, 0x4f, 0x1f, 0x21, 0x4b, 0xef, 0x7d,
-	0xe0, 0x0b, 0x79, 0x2e, 0x22, 0x64, 0x4c, 0x09, 0x09, 0xa7, 0xf1, 0x98, 0xe2, 0x5a, 0x7a, 0xb8,
-	0xe3, 0x8b, 0x5a, 0x84, 0xb1, 0x20, 0x0e, 0x19, 0xcd, 0x3a, 0xb1, 0xf9, 0xfa, 0x0a, 0xef, 0x8a,
-	0x94, 0xcd, 0x8d, 0xeb, 0xdf, 0xe7, 0x29, 0x5b, 0x10, 0x87, 0x96, 0xea, 0xc4, 0xae, 0x56, 0x70,
-	0x66, 0xad, 0x63, 0x80, 0x9f, 0xcf, 0xc1, 0xe6, 0x43, 0x87, 0x39, 0x9e, 0x7e, 0x4c, 0x9c, 0x2c,
-	0xf5, 0x0b, 0x15, 0x20, 0xeb, 0xb5, 0x9a, 0x4d, 0xc7, 0x65, 0x9e, 0x51, 0xab, 0x13, 0xcf, 0x33,
-	0x56, 0x45, 0x3a, 0x97, 0xc2, 0xa3, 0xfe, 0xf8, 0x3c, 0x1f, 0x2e, 0xf7, 0xa1, 0xac, 0x89, 0xf4,
-	0xad, 0x9b, 0x72, 0x1e, 0x51, 0x98, 0x30, 0xe9, 0x1a, 0x69, 0xd5, 0x99, 0xd1, 0x20, 0x35, 0xc3,
-	0xa3, 0x8c, 0xf1, 0x5a, 0x44, 0xa5, 0xd8, 0xa7, 0xfa, 0x2c, 0xc2, 0xb2, 0x22, 0x29, 0x1f, 0x6f,
-	0xef, 0xe6, 0x51, 0x45, 0x32, 0x47, 0xc6, 0x31, 0x52, 0x80, 0x37, 0x49, 0xcd, 0x1f, 0xe3, 0x11,
-	0x8c, 0x47, 0xdc, 0x30, 0x4c, 0xf3, 0x84, 0x2e, 0x81, 0x87, 0x1b, 0x56, 0xe4, 0xce, 0xe3, 0x44,
-	0x64, 0x3b, 0x42, 0x04, 0x8a, 0x88, 0x6c, 0x77, 0x10, 0x05, 0xa6, 0xf1, 0x8c, 0x40, 0xa4, 0x65,
-	0x29, 0x3c, 0xec, 0x0f, 0x5e, 0x73, 0x2c, 0x1b, 0x5d, 0x00, 0xe4, 0x52, 0x8f, 0x2a, 0x12, 0xc3,
-	0x76, 0xec, 0x1a, 0xf5, 0x44, 0xba, 0x95, 0xc2, 0x59, 0x39, 0xc3, 0xe9, 0x6e, 0x89, 0x71, 0x44,
-	0xc1, 0x57, 0xd9, 0x58, 0x73, 0xdc, 0x06, 0x61, 0xfc, 0x42, 0x15, 0xb9, 0xd6, 0xd0, 0x5c, 0xa1,
-	0xc7, 0x03, 0xb2, 0x12, 0x5c, 0x22, 0x3b, 0x75, 0x87, 0x98, 0x8b, 0x01, 0x7d, 0x79, 0x38, 0xba,
-	0xc1, 0xf1, 0x98, 0x42, 0x0c, 0x09, 0x64, 0x68, 0x9e, 0x69, 0x67, 0x61, 0x28, 0xe2, 0x2d, 0xf4,
-	0x2e, 0x64, 0xd4, 0x5a, 0x8a, 0xcb, 0xd4, 0x69, 0x31, 0x75, 0xba, 0x4e, 0xf6, 0xdc, 0xa7, 0x15,
-	0x55, 0xc6, 0x97, 0x13, 0x3f, 0xe3, 0x95, 0xcd, 0x88, 0xe0, 0x2b, 0xdf, 0x96, 0x5c, 0xa8, 0x06,
-	0x93, 0x61, 0x32, 0x13, 0xcd, 0xb7, 0xe2, 0x02, 0xee, 0xe2, 0x3e, 0x4b, 0x59, 0x5c, 0x52, 0xb9,
-	0x8b, 0xcc, 0xac, 0xe4, 0x9d, 0x3d, 0xde, 0xec, 0x18, 0x94, 0xe9, 0xd6, 0xc3, 0xfd, 0x32, 0x26,
-	0x59, 0x86, 0x16, 0xf7, 0x13, 0xd4, 0x71, 0xaf, 0x49, 0x39, 0x7b, 0x24, 0x4e, 0x77, 0xfb, 0x27,
-	0x76, 0x09, 0x21, 0xe3, 0x74, 0x8f, 0x6f, 0x56, 0xaa, 0x36, 0x7b, 0xed, 0x15, 0x81, 0xd8, 0x71,
-	0xf9, 0xf7, 0x26, 0x7d, 0x81, 0xc3, 0x6b, 0x81, 0xc3, 0x8f, 0x1d, 0xc5, 0xe1, 0xf3, 0xbe, 0xc3,
-	0x17, 0xa2, 0x05, 0xca, 0xe0, 0x1e, 0xbb, 0x25, 0x62, 0xbb, 0x2a, 0x56, 0xa4, 0xd5, 0x61, 0x9d,
-	0x72, 0x67, 0x8f, 0x3a, 0x25, 0xb9, 0x8f, 0xa5, 0x97, 0xe7, 0xa4, 0xa5, 0xfb, 0x55, 0x31, 0x0f,
-	0xfa, 0x57, 0x31, 0xa9, 0x67, 0x5a, 0xa4, 0xde, 0x62, 0xe6, 0x46, 0x77, 0x31, 0x93, 0x3e, 0xda,
-	0xca, 0x74, 0x96, 0x3a, 0x6f, 0xc1, 0xd4, 0x1a, 0xa9, 0x31, 0xc7, 0xdd, 0x31, 0x9a, 0xe2, 0x7c,
-	0x06, 0xc0, 0x16, 0xf5, 0x74, 0x98, 0x1e, 0x28, 0x24, 0xb0, 0xae, 0x28, 0x96, 0x04, 0xc1, 0x62,
-	0x38, 0x8f, 0xee, 0xf5, 0x14, 0x4a, 0x43, 0x42, 0x99, 0x57, 0xf6, 0xb3, 0xb2, 0x4f, 0xd1, 0x24,
-	0x6d, 0xed, 0xac, 0x97, 0x6a, 0x30, 0x19, 0xc4, 0x9b, 0xcb, 0x73, 0xc6, 0xaa, 0xa5, 0x7a, 0x25,
-	0x22, 0x9a, 0xec, 0x9b, 0xf5, 0x96, 0x27, 0xf9, 0xcd, 0xb1, 0xac, 0x98, 0x2f, 0xcf, 0x95, 0x2d,
-	0xd1, 0x51, 0xc1, 0x63, 0x5e, 0xf7, 0x10, 0xba, 0x0a, 0xc9, 0x96, 0x47, 0x0d, 0x62, 0xba, 0x2a,
-	0xec, 0xec, 0x07, 0x0b, 0xed, 0xdd, 0xfc, 0xe0, 0x8a, 0x47, 0x4b, 0x15, 0x8c, 0x07, 0x5b, 0x1e,
-	0x2d, 0x99, 0x2e, 0xaa, 0x02, 0x2f, 0xdd, 0x8d, 0x06, 0x71, 0xd7, 0x2d, 0x5b, 0x14, 0x72, 0x3c,
-	0x78, 0x77, 0x63, 0x2c, 0xd6, 0x1d, 0xc2, 0x24, 0xc8, 0x48, 0x7b, 0x37, 0x9f, 0x2e, 0x55, 0xf0,
-	0x4d, 0xc1, 0x81, 0xd3, 0xc4, 0x74, 0xe5, 0x23, 0x7a, 0x0b, 0x86, 0x55, 0xec, 0x94, 0x76, 0x66,
-	0x0e, 0xcc, 0xee, 0x41, 0xd2, 0x0b, 0x4b, 0xee, 0xc2, 0x09, 0x8f, 0x11, 0xd6, 0xf2, 0x7a, 0xcb,
-	0xcb, 0xec, 0xe1, 0x4e, 0xd9, 0xa4, 0xe4, 0xef, 0xae, 0x28, 0xef, 0x80, 0xae, 0x80, 0x7b, 0x2b,
-	0xca, 0xb1, 0x83, 0x8f, 0x0a, 0x3e, 0x2e, 0xb9, 0x7b, 0x0a, 0xc8, 0xdb, 0x30, 0x66, 0x52, 0xcf,
-	0x72, 0xa9, 0x69, 0x84, 0xa7, 0x19, 0x1d, 0xf1, 0x34, 0x67, 0x14, 0x04, 0xf6, 0x0f, 0xf5, 0x7d,
-	0x38, 0xdd, 0x81, 0xda, 0x7d, 0xb8, 0xc7, 0x0f, 0xa1, 0xb1, 0x1e, 0x01, 0xed, 0x3c, 0xda, 0x75,
-	0x38, 0x15, 0xa2, 0xf7, 0x1e, 0xf1, 0x89, 0x67, 0x3a, 0xe2, 0x27, 0x02, 0x71, 0x5d, 0x27, 0xfd,
-	0x43, 0x98, 0x8c, 0x4a, 0x0b, 0x4f, 0xfc, 0xe4, 0xd1, 0x4e, 0xfc, 0x78, 0x28, 0x20, 0x38, 0xf8,
-	0x53, 0xcb, 0x80, 0x7a, 0x75, 0x41, 0x6f, 0xc3, 0xb1, 0x4d, 0xfe, 0x20, 0xae, 0xc2, 0x23, 0x94,
-	0x4e, 0x92, 0x6b, 0x6a, 0x05, 0xc6, 0xfb, 0xdc, 0x68, 0xe8, 0x9d, 0x4e, 0xd4, 0x5c, 0x4f, 0xf2,
-	0xd7, 0xc1, 0xd3, 0x0b, 0x6b, 0x80, 0xbe, 0x57, 0xd0, 0x40, 0xf3, 0x9d, 0xd8, 0x47, 0x6c, 0xd1,
-	0x28, 0x01, 0xef, 0xc2, 0x70, 0x74, 0x5b, 0xa1, 0xd7, 0x3b, 0x41, 0x0f, 0xd1, 0xfe, 0x92, 0xf4,
-	0x33, 0xed, 0x34, 0xa4, 0xf8, 0x72, 0x33, 0xc2, 0x28, 0xba, 0x07, 0xa8, 0xd6, 0x72, 0x5d, 0xca,
-	0x0f, 0x4d, 0xd0, 0x2f, 0x50, 0x49, 0xc6, 0x73, 0xfb, 0x36, 0x15, 0xba, 0x73, 0x1a, 0x05, 0x13,
-	0x69, 0x9d, 0xde, 0xe3, 0xa9, 0x93, 0xdc, 0x1b, 0x11, 0xec, 0xf8, 0x33, 0x60, 0x2b, 0x98, 0x08,
-	0x76, 0x19, 0x86, 0xe5, 0x6b, 0x17, 0x99, 0xc2, 0xaa, 0x94, 0x7d, 0xb2, 0x1b, 0x55, 0xa6, 0xbc,
-	0xa1, 0x0b, 0x86, 0x24, 0x93, 0x18, 0xee, 0x57, 0x5e, 0x24, 0xbe, 0xd5, 0xf2, 0xe2, 0x01, 0x4c,
-	0x05, 0x8d, 0x6c, 0xcb, 0x6d, 0x50, 0xd3, 0x08, 0x7a, 0x12, 0xc4, 0x4f, 0x2c, 0xf6, 0x6b, 0x54,
-	0x27, 0x44, 0x93, 0xfa, 0x84, 0xdf, 0xf0, 0x16, 0x10, 0x15, 0x85, 0x50, 0x62, 0xe8, 0x55, 0xd0,
-	0x05, 0xbc, 0x49, 0x37, 0x0d, 0x15, 0xfe, 0x82, 0x4e, 0xbd, 0x6c, 0xac, 0x8f, 0xf3, 0xf9, 0x0a,
-	0xdd, 0x5c, 0x16, 0xb3, 0xaa, 0x65, 0x7f, 0x7f, 0xaf, 0x5c, 0x30, 0x79, 0xc4, 0x9d, 0xdf, 0x37,
-	0x09, 0xa4, 0x70, 0xba, 0x49, 0x6d, 0x93, 0x0b, 0x20, 0xcd, 0x66, 0xdd, 0xaa, 0x89, 0xf0, 0x1d,
-	0x18, 0xae, 0x52, 0x8c, 0xde, 0x23, 0x10, 0xd2, 0xfa, 0x16, 0xe2, 0x29, 0x05, 0xd4, 0x67, 0x0e,
-	0x2d, 0x40, 0xf6, 0x07, 0x2d, 0xda, 0xe2, 0x61, 0x87, 0x7a, 0x4d, 0xc7, 0xf6, 0xa8, 0xa7, 0xa7,
-	0x45, 0x2b, 0xac, 0xdf, 0xe2, 0xcd, 0x3b, 0x8d, 0x06, 0xb1, 0x4d, 0x9c, 0x91, 0x3c, 0xd8, 0x67,
-	0xe1, 0x30, 0xbe, 0xb6, 0x22, 0xea, 0x78, 0x4c, 0x26, 0x14, 0x07, 0xc0, 0x28, 0x1e, 0xac, 0x58,
-	0xd0, 0x9f, 0x02, 0x52, 0xda, 0x88, 0x92, 0x82, 0xd4, 0x6a, 0xb4, 0xc9, 0x54, 0x9e, 0x71, 0xa6,
-	0x5f, 0xa8, 0xe5, 0x67, 0xaf, 0xc8, 0xab, 0x8c, 0x92, 0x20, 0xc5, 0xca, 0x98, 0x70, 0x04, 0xdd,
-	0x84, 0x09, 0x5f, 0x33, 0x81, 0xa9, 0xd4, 0x53, 0x99, 0x45, 0x4f, 0xed, 0xc5, 0x39, 0x95, 0x3a,
-	0x18, 0x29, 0xc6, 0xc8, 0x18, 0x7a, 0x99, 0x27, 0x92, 0xc6, 0x96, 0x65, 0x9b, 0xce, 0x96, 0x67,
-	0x90, 0x4d, 0x62, 0xd5, 0xc9, 0xaa, 0x6a, 0x1a, 0xa7, 0x30, 0x72, 0xb7, 0xef, 0xca, 0xa9, 0x92,
-	0x3f, 0x33, 0xf5, 0x4b, 0x0d, 0x20, 0xa2, 0xcf, 0x19, 0x48, 0x36, 0x65, 0x59, 0x23, 0xa2, 0xc3,
-	0xb0, 0x08, 0xde, 0x9f, 0x24, 0xb2, 0x63, 0xfa, 0xf3, 0xd8, 0x9f, 0x41, 0xf3, 0x90, 0xf4, 0xf5,
-	0x8c, 0x1f, 0xa8, 0x67, 0xd7, 0x21, 0xf7, 0x39, 0xd1, 0xdb, 0x87, 0x7f, 0x71, 0xd5, 0x89, 0x20,
-	0xd8, 0x54, 0x25, 0xf5, 0xef, 0x5a, 0xa4, 0x69, 0x53, 0x6a, 0xb1, 0x87, 0xd4, 0x66, 0x6a, 0x0f,
-	0xcd, 0x3b, 0x26, 0x45, 0xf9, 0x68, 0xf4, 0x0c, 0x8c, 0xd1, 0xf4, 0x94, 0x8a, 0x92, 0xe8, 0x2a,
-	0x80, 0x78, 0x0d, 0x6b, 0xac, 0xb9, 0x4e, 0x43, 0x59, 0x73, 0xf0, 0x59, 0x4d, 0x0b, 0x9e, 0x45,
-	0xd7, 0x69, 0xa0, 0x37, 0x21, 0x25, 0x01, 0x98, 0xa3, 0x4c, 0x39, 0x98, 0x3d, 0x29, 0x38, 0x6e,
-	0x3b, 0xca, 0x88, 0x5f, 0xe6, 0x21, 0x1d, 0x18, 0x81, 0xde, 0x8b, 0xb6, 0x57, 0xce, 0xee, 0xd9,
-	0x5e, 0x39, 0x44, 0x5f, 0x65, 0x1e, 0xa0, 0xe6, 0x52, 0xa2, 0x5e, 0x98, 0xc5, 0x8f, 0xf2, 0xc2,
-	0x4c, 0xf1, 0x95, 0x18, 0x07, 0x69, 0x35, 0x4d, 0x1f, 0x64, 0xe0, 0x28, 0x20, 0x8a, 0xaf, 0xc4,
-	0xd0, 0x29, 0xd5, 0x6f, 0x93, 0x8d, 0x90, 0xa4, 0x6c, 0x84, 0xcc, 0xa9, 0xf6, 0xe2, 0x79, 0x18,
-	0x32, 0xa9, 0x57, 0x73, 0xad, 0x26, 0x5f, 0x36, 0x11, 0x2f, 0xd3, 0x22, 0xfc, 0xb8, 0x03, 0xfa,
-	0x57, 0x19, 0x1c, 0x9d, 0x44, 0x5b, 0x00, 0x84, 0x31, 0xd7, 0x5a, 0x6d, 0x31, 0xea, 0xe9, 0x83,
-	0xe2, 0x08, 0x9f, 0xdb, 0xd3, 0x47, 0xc5, 0x52, 0x40, 0xbb, 0x60, 0x33, 0x77, 0xa7, 0x7c, 0xe1,
-	0x69, 0xf9, 0xdc, 0x3f, 0x68, 0x2f, 0xce, 0x1c, 0xaa, 0xcf, 0x86, 0x23, 0xa2, 0xd0, 0x7d, 0x18,
-	0x52, 0x97, 0x87, 0xc1, 0x57, 0x27, 0x79, 0xf4, 0xe6, 0xd7, 0x68, 0x7b, 0x37, 0x0f, 0xfe, 0x78,
-	0xc5, 0xc3, 0xb0, 0xe9, 0xd3, 0x78, 0xa8, 0x0a, 0xc8, 0xa3, 0xae, 0xb8, 0xe7, 0x9a, 0xae, 0xb3,
-	0x66, 0xd5, 0xa9, 0x61, 0x99, 0x22, 0x86, 0xa6, 0xcb, 0xa7, 0xc2, 0xb6, 0x51, 0x76, 0x59, 0x12,
-	0x2d, 0x49, 0x9a, 0x6a, 0x05, 0x67, 0xbd, 0xce, 0x11, 0x13, 0xfd, 0x87, 0x06, 0xc7, 0xd5, 0x4b,
-	0x64, 0x83, 0x4f, 0x52, 0x57, 0xbc, 0x74, 0xa6, 0x9e, 0x27, 0xaa, 0xb3, 0x74, 0xf9, 0x6f, 0xb4,
-	0xa7, 0xe5, 0x9f, 0x68, 0xee, 0x8f, 0xb4, 0xb9, 0xbf, 0xd4, 0x3e, 0x2a, 0x5c, 0xbd, 0xc2, 0x6d,
-	0x27, 0xb3, 0x9f, 0x94, 0x66, 0xef, 0x71, 0xd3, 0x3f, 0x8d, 0x3c, 0x87, 0x8f, 0xf7, 0x67, 0x1f,
-	0x9c, 0x8f, 0x4c, 0x9c, 0xbb, 0x5f, 0x3c, 0x77, 0x9e, 0xf3, 0x95, 0x66, 0xef, 0x29, 0x97, 0x7d,
-	0x1a, 0x79, 0x0e, 0x1f, 0x05, 0x5f, 0x38, 0x71, 0xae, 0x70, 0xf5, 0xca, 0x95, 0x0f, 0xf9, 0xd3,
-	0x0f, 0x2f, 0x5d, 0x78, 0xf5, 0xb3, 0x73, 0x57, 0xcf, 0x7e, 0xfa, 0xd1, 0x59, 0x3c, 0xa1, 0xd4,
-	0x5d, 0x16, 0xda, 0x96, 0xa4, 0xb2, 0xe8, 0x1e, 0xe8, 0x5d, 0x66, 0x6c, 0xd0, 0x0d, 0xa3, 0x4e,
-	0x56, 0x69, 0x5d, 0xbf, 0x28, 0x0c, 0x79, 0x5e, 0x6e, 0x91, 0x47, 0xd9, 0xf6, 0x6e, 0x7e, 0xf2,
-	0x56, 0x14, 0xe3, 0xfa, 0xc2, 0xf5, 0x1b, 0x9c, 0x10, 0x4f, 0x76, 0x40, 0x5f, 0xa7, 0x1b, 0x62,
-	0x18, 0xfd, 0x97, 0x06, 0x53, 0xd1, 0x5b, 0xab, 0xcb, 0x4f, 0xf0, 0xdd, 0xf4, 0x93, 0x1e, 0x51,
-	0xb9, 0xd3, 0x57, 0x6b, 0x70, 0xba, 0x8f, 0x39, 0xa1, 0xbf, 0x5e, 0x16, 0x06, 0xbd, 0x10, 0xf1,
-	0xd7, 0xc9, 0x52, 0x37, 0x56, 0xe0, 0xb3, 0x93, 0x3d, 0x62, 0x02, 0xbf, 0x61, 0x98, 0xec, 0x23,
-	0xc7, 0x32, 0xf5, 0x4b, 0x42, 0x40, 0x4e, 0xee, 0x54, 0xb3, 0xbd, 0x9b, 0x1f, 0xef, 0xc1, 0xaf,
-	0x56, 0xf0, 0x78, 0x0f, 0x72, 0xd5, 0x44, 0xff, 0xa6, 0xc1, 0xb8, 0xb8, 0xf9, 0xba, 0x16, 0x61,
-	0xe8, 0xbb, 0xb9, 0x08, 0x63, 0x5c, 0xd7, 0x4e, 0xef, 0x33, 0x48, 0xd7, 0x1d, 0x69, 0x95, 0xa7,
-	0x0f, 0x8b, 0x90, 0x54, 0xd8, 0x3b, 0x24, 0xdd, 0xf0, 0x49, 0x9f, 0x25, 0x22, 0x85, 0x82, 0xfa,
-	0x36, 0x82, 0x47, 0x0e, 0xdd, 0x08, 0x1e, 0xed, 0xdb, 0x08, 0xee, 0x93, 0x29, 0x67, 0xfe, 0x18,
-	0x8d, 0xf8, 0xec, 0x1f, 0xab, 0x11, 0x3f, 0x76, 0xf4, 0x46, 0x7c, 0x4f, 0xd7, 0x1a, 0x1d, 0xa6,
-	0x6b, 0x3d, 0x7e, 0x98, 0xae, 0xf5, 0xc4, 0xa1, 0xbb, 0xd6, 0x93, 0x7b, 0x74, 0xad, 0x5f, 0x85,
-	0xb4, 0xeb, 0x38, 0xcc, 0x10, 0x89, 0xd4, 0x71, 0x71, 0x27, 0xe9, 0x3d, 0x05, 0xa2, 0xe3, 0x30,
-	0x9e, 0x45, 0xe1, 0x94, 0xab, 0x9e, 0xd0, 0x1d, 0x18, 0xb4, 0x29, 0xe3, 0x0e, 0x39, 0x21, 0xd2,
-	0xa2, 0xab, 0xbf, 0xdd, 0xcd, 0xcf, 0x1d, 0xe9, 0x33, 0xa4, 0x5b, 0x94, 0x55, 0x2b, 0xed, 0xdd,
-	0xfc, 0x31, 0xf1, 0x80, 0x8f, 0xd9, 0x94, 0x55, 0x4d, 0xf4, 0x3e, 0x0c, 0x77, 0xbc, 0x40, 0xd0,
-	0x0f, 0x7e, 0x81, 0x90, 0x69, 0xef, 0xe6, 0xa3, 0xbd, 0x70, 0x3c, 0xd4, 0x88, 0xbc, 0x32, 0x98,
-	0x87, 0xb4, 0x00, 0xe4, 0x79, 0xb4, 0x7e, 0xb2, 0xbf, 0x7d, 0x7e, 0x9e, 0x5d, 0x1e, 0x6e, 0xef,
-	0xe6, 0x83, 0x8a, 0x17, 0xa7, 0x38, 0x8e, 0xa8, 0x7d, 0x3f, 0x80, 0x31, 0x3f, 0xc5, 0x0e, 0xc1,
-	0x2e, 0x1c, 0x00, 0x36, 0xce, 0x37, 0xc7, 0x92, 0x64, 0x0b, 0x30, 0xfd, 0x82, 0xe0, 0xa6, 0x0f,
-	0x7d, 0x09, 0x92, 0x9e, 0xcc, 0x53, 0xf5, 0x29, 0x01, 0x78, 0x62, 0x8f, 0x34, 0x16, 0xfb, 0x74,
-	0xe8, 0xfb, 0xe0, 0xa3, 0x18, 0x3e, 0xeb, 0xa9, 0xfd, 0x59, 0x47, 0x15, 0xbd, 0xff, 0x29, 0xd9,
-	0x59, 0x18, 0x0d, 0xea, 0x41, 0xb1, 0x3f, 0xf4, 0xd3, 0xa2, 0x0a, 0x1c, 0x56, 0x55, 0xa0, 0xd8,
-	0x1b, 0xe8, 0x45, 0xc8, 0xb4, 0x3c, 0x6a, 0x86, 0x54, 0x9e, 0xfe, 0xdc, 0xf4, 0x40, 0x61, 0x04,
-	0x8f, 0xf0, 0x61, 0x9f, 0xcc, 0xe3, 0x74, 0x02, 0x2d, 0xdc, 0x6e, 0x7a, 0x2e, 0xfc, 0x5a, 0x2b,
-	0xd8, 0x6b, 0xe8, 0x75, 0x45, 0xe7, 0x7e, 0xac, 0x9a, 0x6f, 0x2f, 0xeb, 0x79, 0xf1, 0x15, 0x0d,
-	0xbf, 0x4e, 0x86, 0x6f, 0x10, 0x8f, 0xe1, 0x6b, 0xa2, 0xb1, 0xf6, 0xb2, 0x54, 0x04, 0x7f, 0x2c,
-	0x7f, 0xf5, 0x32, 0x5e, 0xd2, 0xa7, 0xfb, 0x32, 0x5e, 0xea, 0x60, 0xbc, 0x84, 0x3e, 0x82, 0x53,
-	0xdd, 0x75, 0xaf, 0x4b, 0x6b, 0xd4, 0xda, 0x94, 0xa9, 0xe8, 0xf3, 0x47, 0xa9, 0xab, 0x83, 0xe2,
-	0x18, 0x2b, 0x84, 0x12, 0x43, 0x0b, 0x30, 0x24, 0xbf, 0xab, 0x92, 0x3b, 0x62, 0x66, 0x8f, 0x20,
-	0xc4, 0x49, 0xe4, 0x9e, 0x08, 0x4b, 0x62, 0x68, 0x06, 0xa3, 0xe8, 0x43, 0x40, 0xab, 0xe2, 0xed,
-	0xce, 0x0e, 0xaf, 0xb2, 0x6b, 0xd4, 0x66, 0x64, 0x9d, 0xea, 0x67, 0x0e, 0x6e, 0xbf, 0x66, 0x9e,
-	0x96, 0x87, 0x01, 0x9e, 0x8b, 0xc5, 0x1e, 0x5d, 0x9d, 0x8d, 0xc5, 0x62, 0x31, 0x3c, 0xa6, 0x70,
-	0x96, 0x02, 0x18, 0xf4, 0x12, 0x64, 0x82, 0x5e, 0x82, 0x6a, 0xec, 0x9e, 0x9d, 0xd6, 0x0a, 0xc7,
-	0xf0, 0xa8, 0x3f, 0xac, 0x3a, 0xb6, 0x84, 0xc7, 0x0d, 0xce, 0x65, 0x10, 0xd3, 0x55, 0x9f, 0x08,
-	0x78, 0xfa, 0x0b, 0xe2, 0x36, 0xea, 0x69, 0xc2, 0xc8, 0xaf, 0x05, 0xd4, 0x5b, 0xac, 0xf2, 0x04,
-	0xcf, 0x2c, 0xb1, 0x60, 0x2e, 0x55, 0xb0, 0x9c, 0xf3, 0x78, 0xb0, 0x11, 0x23, 0xa6, 0xab, 0x46,
-	0x50, 0x05, 0x46, 0x95, 0x08, 0x1f, 0xfe, 0xc5, 0x43, 0xc0, 0xe3, 0x11, 0xc9, 0xe4, 0xa3, 0x5c,
-	0x03, 0x85, 0x1c, 0xf4, 0x0a, 0x3c, 0xfd, 0x25, 0x81, 0x93, 0xef, 0xe9, 0xf0, 0xf9, 0x26, 0x2a,
-	0xa4, 0x8c, 0x64, 0xf4, 0x87, 0x3d, 0x44, 0xe1, 0xb4, 0xaa, 0xc7, 0xfb, 0xf5, 0x20, 0x3c, 0xbd,
-	0x20, 0x70, 0x0f, 0xd7, 0x84, 0x90, 0x40, 0x7d, 0xa6, 0x3c, 0xf4, 0x1e, 0x40, 0xe4, 0x9d, 0xe0,
-	0xb9, 0xa3, 0xbd, 0x13, 0xc4, 0x11, 0x5e, 0xb4, 0x0a, 0xa3, 0x4d, 0xd7, 0xd9, 0xb4, 0xf8, 0x39,
-	0x96, 0x99, 0xd3, 0x79, 0x71, 0x23, 0xbd, 0xf9, 0xb4, 0xfc, 0x92, 0xfb, 0x82, 0x7e, 0x76, 0xee,
-	0xf9, 0xfd, 0x13, 0x80, 0x4f, 0x3f, 0x3a, 0xdb, 0xde, 0xcd, 0x8f, 0x2c, 0x85, 0x18, 0xd5, 0x0a,
-	0x1e, 0x89, 0x40, 0x56, 0x4d, 0x54, 0x81, 0xb1, 0x60, 0x80, 0x47, 0x19, 0x93, 0x30, 0xa2, 0x7f,
-	0x4f, 0x85, 0x98, 0xee, 0xed, 0xb8, 0x2c, 0x3e, 0xdb, 0xc5, 0xd9, 0x28, 0x47, 0x85, 0x30, 0x82,
-	0x4e, 0x43, 0xba, 0xd1, 0xaa, 0xf3, 0x5a, 0xda, 0x63, 0xfa, 0xac, 0xb8, 0x7e, 0xc2, 0x01, 0xb4,
-	0x0e, 0x27, 0x6b, 0x75, 0x62, 0x35, 0x0c, 0xd2, 0x51, 0x72, 0x1b, 0x35, 0xc7, 0xa4, 0x7a, 0xf1,
-	0x80, 0xda, 0xa8, 0xb7, 0x4c, 0xc7, 0x27, 0x04, 0x5a, 0xef, 0xc4, 0xd4, 0xdb, 0x90, 0xe9, 0xaa,
-	0xe1, 0x50, 0x16, 0x06, 0x36, 0xa8, 0xfc, 0x36, 0x28, 0x8d, 0xf9, 0x23, 0x9a, 0xf0, 0x8b, 0x7c,
-	0xf9, 0xd1, 0x8a, 0xfc, 0x71, 0x25, 0xfe, 0x86, 0x36, 0x75, 0x07, 0x46, 0x3b, 0xf3, 0xad, 0x3e,
-	0xdc, 0xc5, 0x28, 0x77, 0x9f, 0x2b, 0xc1, 0x07, 0x88, 0xe0, 0xaa, 0xba, 0xfd, 0x3d, 0x80, 0xc0,
-	0x28, 0x0f, 0x5d, 0x81, 0xa1, 0xf0, 0x2b, 0x71, 0x5e, 0xbf, 0x0f, 0x88, 0x37, 0x1d, 0x7b, 0x79,
-	0x01, 0x03, 0x0d, 0x78, 0x67, 0x4c, 0x38, 0x3e, 0x2f, 0x2a, 0xee, 0x70, 0x5a, 0x75, 0x49, 0xae,
-	0x01, 0x84, 0xa8, 0xc1, 0x5b, 0xe1, 0xbd, 0x40, 0xfb, 0x74, 0x02, 0xd2, 0x81, 0x98, 0x99, 0x7f,
-	0xd6, 0xe0, 0xf8, 0x8a, 0xa8, 0xc9, 0xff, 0x3f, 0xc5, 0xa0, 0xab, 0x00, 0xe1, 0xf7, 0xe2, 0x7b,
-	0xb6, 0x1d, 0x16, 0x39, 0xc9, 0x4d, 0xe2, 0x6d, 0x94, 0x13, 0xa2, 0xab, 0x93, 0x5e, 0xf3, 0x07,
-	0x66, 0xfe, 0x55, 0x83, 0xf1, 0x77, 0x29, 0xeb, 0x51, 0xf2, 0x3e, 0x8c, 0x86, 0x4a, 0x1a, 0xdf,
-	0xbc, 0x49, 0x32, 0x4c, 0x43, 0x3a, 0xef, 0x9b, 0xab, 0xfd, 0xb5, 0x06, 0x2f, 0x44, 0xd5, 0x8e,
-	0x08, 0x5f, 0x74, 0xdc, 0x85, 0x95, 0xaa, 0xe7, 0x1b, 0xf2, 0xe7, 0x90, 0x12, 0xd7, 0x2d, 0x6d,
-	0x59, 0xaa, 0x31, 0xb5, 0xa0, 0x3e, 0x06, 0x3f, 0x5a, 0x16, 0xb6, 0xb0, 0x52, 0x7d, 0xed, 0x95,
-	0xf6, 0x6e, 0x3e, 0xc9, 0xaf, 0xe9, 0x85, 0x95, 0x2a, 0x4e, 0x72, 0xd8, 0x85, 0x96, 0x85, 0x1e,
-	0x40, 0x92, 0x5f, 0x9b, 0x5c, 0x80, 0xfc, 0xda, 0xbc, 0xf2, 0x8d, 0x04, 0x0c, 0x56, 0xe8, 0x26,
-	0xc7, 0x1f, 0x34, 0xe9, 0xe6, 0x42, 0xcb, 0x9a, 0xf9, 0xab, 0x38, 0x4c, 0xde, 0xb0, 0xbc, 0xd0,
-	0xd6, 0xc0, 0x34, 0x02, 0x99, 0x68, 0x2c, 0x0e, 0x17, 0xe9, 0xc5, 0x7d, 0xa2, 0xf0, 0xfe, 0xcb,
-	0x34, 0x4a, 0xa2, 0x94, 0xdf, 0x7c, 0xa1, 0x78, 0xbc, 0x70, 0x5c, 0x93, 0xba, 0xea, 0x03, 0x29,
-	0xf9, 0x03, 0xe5, 0xe0, 0x98, 0xfc, 0xc6, 0x59, 0x7c, 0xfd, 0x2e, 0x2e, 0xfb, 0xf3, 0x03, 0xfa,
-	0xd7, 0x49, 0x2c, 0x87, 0x11, 0x82, 0x44, 0x93, 0xdf, 0xec, 0xf2, 0xab, 0x77, 0xf1, 0x3c, 0xf3,
-	0x8f, 0x1a, 0x8c, 0x2f, 0xf7, 0xd9, 0xa9, 0x8b, 0x47, 0x3b, 0x4e, 0x9d, 0xfd, 0xcd, 0x6f, 0xf3,
-	0x28, 0xfd, 0xa7, 0x06, 0x63, 0x81, 0x9c, 0xdb, 0xb4, 0xd1, 0xac, 0xf3, 0x94, 0xe5, 0xbb, 0xa2,
-	0x1e, 0x2a, 0xc0, 0x50, 0x83, 0x34, 0xc5, 0x6b, 0x0a, 0x1e, 0x95, 0x07, 0xa2, 0xed, 0x41, 0x13,
-	0x83, 0x9a, 0xbb, 0x4e, 0x77, 0x66, 0x56, 0xe1, 0x44, 0x8f, 0x1d, 0xf2, 0x92, 0x0d, 0x9a, 0x8b,
-	0x5a, 0x27, 0x77, 0xdf, 0xe6, 0x62, 0x3c, 0xda, 0x5c, 0xfc, 0x52, 0xeb, 0x68, 0x2e, 0xce, 0xfc,
-	0xaf, 0x06, 0xfa, 0x1e, 0x42, 0x3c, 0xf4, 0x19, 0x24, 0xe5, 0x45, 0xee, 0x87, 0xf6, 0x57, 0xf7,
-	0x74, 0x58, 0x17, 0x6b, 0x51, 0xfd, 0x7f, 0x96, 0x82, 0xdf, 0x97, 0x39, 0x55, 0x83, 0xe1, 0x28,
-	0x4c, 0x9f, 0x7b, 0xec, 0xed, 0xce, 0x7b, 0xec, 0xa5, 0x43, 0xaa, 0x17, 0xb9, 0xd6, 0x66, 0x7e,
-	0xa4, 0x41, 0x7e, 0xde, 0xb1, 0x37, 0xa9, 0xcb, 0x7a, 0xa8, 0xfd, 0xad, 0xbd, 0x04, 0x69, 0xa9,
-	0x53, 0xf8, 0x1d, 0xe4, 0xe5, 0xc3, 0x7f, 0xb8, 0x98, 0x92, 0x42, 0xab, 0x15, 0x9c, 0x92, 0x28,
-	0x55, 0xf1, 0x31, 0xa6, 0xc8, 0x51, 0x44, 0xa0, 0xc2, 0xe2, 0xf9, 0xfc, 0x22, 0x40, 0x98, 0x78,
-	0xa3, 0x31, 0x18, 0x59, 0x7a, 0xff, 0xee, 0x02, 0x36, 0x56, 0x6e, 0x5d, 0xbf, 0xf5, 0xfe, 0xdd,
-	0x5b, 0xd9, 0x58, 0x38, 0x54, 0x2e, 0xdd, 0xbe, 0xbd, 0x80, 0x3f, 0xc8, 0x6a, 0x08, 0xc1, 0xa8,
-	0x1c, 0x5a, 0xf8, 0xb3, 0xdb, 0x0b, 0xf8, 0x56, 0xe9, 0x46, 0x36, 0x5e, 0xfe, 0x27, 0xed, 0xcb,
-	0xc7, 0x39, 0xed, 0xab, 0xc7, 0x39, 0xed, 0x37, 0x8f, 0x73, 0xb1, 0xdf, 0x3d, 0xce, 0xc5, 0xbe,
-	0x7e, 0x9c, 0x8b, 0xfd, 0xfe, 0x71, 0x2e, 0xf6, 0x87, 0xc7, 0x39, 0xed, 0x51, 0x3b, 0xa7, 0xfd,
-	0xb8, 0x9d, 0x8b, 0xfd, 0xbc, 0x9d, 0xd3, 0x7e, 0xd1, 0xce, 0xc5, 0xbe, 0x68, 0xe7, 0x62, 0xbf,
-	0x6a, 0xe7, 0x62, 0x5f, 0xb6, 0x73, 0xda, 0x57, 0xed, 0x9c, 0xf6, 0x9b, 0x76, 0x2e, 0xf6, 0xbb,
-	0x76, 0x4e, 0xfb, 0xba, 0x9d, 0x8b, 0xfd, 0xbe, 0x9d, 0xd3, 0xfe, 0xd0, 0xce, 0xc5, 0x1e, 0x3d,
-	0xc9, 0xc5, 0x7e, 0xfc, 0x24, 0xa7, 0xfd, 0xf4, 0x49, 0x2e, 0xf6, 0xb3, 0x27, 0x39, 0xed, 0xf3,
-	0x27, 0xb9, 0xd8, 0xcf, 0x9f, 0xe4, 0x62, 0xbf, 0x78, 0x92, 0xd3, 0xbe, 0x78, 0x92, 0xd3, 0x7e,
-	0xf5, 0x24, 0xa7, 0xdd, 0xbb, 0x70, 0xd8, 0x28, 0xcb, 0xec, 0xe6, 0xea, 0xea, 0xa0, 0x38, 0x2a,
-	0x97, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xda, 0x7c, 0xfa, 0x78, 0x36, 0x00, 0x00,
+	// 4930 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4d, 0x6c, 0x5b, 0x57,
+	0x76, 0xe6, 0x23, 0x29, 0x91, 0x3c, 0xa2, 0x24, 0xea, 0xca, 0xb2, 0x9f, 0x64, 0x9b, 0x54, 0x14,
+	0x27, 0x91, 0x3d, 0x16, 0x1d, 0xcb, 0xc9, 0xcc, 0xd4, 0x93, 0x8c, 0x87, 0x14, 0x25, 0x87, 0xb6,
+	0x25, 0x6b, 0xae, 0x24, 0x7b, 0xc6, 0xb1, 0xf3, 0x7a, 0xc5, 0x77, 0x25, 0xbf, 0x88, 0x7c, 0x8f,
+	0xf3, 0xde, 0xa3, 0x2c, 0x65, 0x12, 0xc0, 0xfd, 0xc3, 0x04, 0x03, 0xb4, 0x98, 0x76, 0xd3, 0xa0,
+	0x8b, 0x22, 0x6d, 0x51, 0x60, 0x96, 0x83, 0xa2, 0x05, 0xb2, 0x29, 0x9a, 0x4d, 0xdb, 0x6c, 0x0a,
+	0x64, 0x31, 0x8b, 0xc1, 0x2c, 0xd4, 0x31, 0xbd, 0xc9, 0xa6, 0xc0, 0x2c, 0x07, 0x5e, 0x14, 0xc5,
+	0xfd, 0x79, 0x7f, 0x24, 0xf5, 0xe7, 0xa4, 0x83, 0x6c, 0xec, 0xc7, 0x7b, 0xcf, 0xf9, 0xce, 0xb9,
+	0xe7, 0xfe, 0x9d, 0x9f, 0x2b, 0x98, 0xaa, 0x5b, 0x36, 0x79, 0x44, 0xcc, 0x19, 0xc7, 0x25, 0xb5,
+	0xad, 0x4b, 0xa4, 0x69, 0x5c, 0xa2, 0xa6, 0xae, 0xe9, 0x74, 0xdb, 0xa8, 0xd1, 0x62, 0xd3, 0xb6,
+	0x5c, 0x0b, 0x0d, 0xb9, 0xae, 0x59, 0x94, 0x74, 0xc5, 0xed, 0x2b, 0x13, 0xa5, 0x4d, 0xc3, 0x7d,
+	0xd8, 0x5a, 0x2f, 0xd6, 0xac, 0xc6, 0x25, 0x6a, 0x6e, 0x5b, 0xbb, 0x4d, 0xdb, 0xda, 0xd9, 0xbd,
+	0xc4, 0x89, 0x6b, 0x33, 0x9b, 0xd4, 0x9c, 0xd9, 0x26, 0x75, 0x43, 0x27, 0x2e, 0xbd, 0xd4, 0xf5,
+	0x21, 0x20, 0x27, 0x66, 0x42, 0x10, 0x9b, 0xd6, 0xa6, 0x25, 0x98, 0xd7, 0x5b, 0x1b, 0xfc, 0x17,
+	0xff, 0xc1, 0xbf, 0x24, 0xf9, 0x99, 0x4d, 0xcb, 0xda, 0xac, 0x53, 0xae, 0x1e, 0x31, 0x4d, 0xcb,
+	0x25, 0xae, 0x61, 0x99, 0x8e, 0xec, 0xcd, 0xcb, 0x5e, 0x1f, 0x43, 0x6f, 0xd9, 0x9c, 0x40, 0xf6,
+	0x9f, 0xee, 0xec, 0xa7, 0x8d, 0xa6, 0xbb, 0x2b, 0x3b, 0x27, 0x3b, 0x3b, 0x37, 0x0c, 0x5a, 0xd7,
+	0xb5, 0x06, 0x71, 0xb6, 0x3a, 0x84, 0xfb, 0x14, 0x8e, 0x6b, 0xb7, 0x6a, 0xae, 0xec, 0x2d, 0x74,
+	0xf6, 0xba, 0x46, 0x83, 0x3a, 0x2e, 0x69, 0x34, 0xf7, 0xd3, 0xee, 0x91, 0x4d, 0x9a, 0x4d, 0x6a,
+	0x7b, 0xda, 0xbf, 0xd8, 0x3d, 0x03, 0x86, 0x4e, 0x4d, 0xd7, 0xd8, 0x30, 0x02, 0xa2, 0x33, 0xdd,
+	0x44, 0xef, 0x5a, 0x86, 0xb9, 0x7f, 0xef, 0x16, 0xdd, 0xf5, 0x78, 0x0b, 0xdd, 0xbd, 0xde, 0x64,
+	0x4a, 0x13, 0x74, 0x13, 0x34, 0xa8, 0xe3, 0x90, 0x4d, 0xea, 0x1c, 0x44, 0xe1, 0x12, 0x9d, 0xb8,
+	0x44, 0x50, 0x4c, 0xfd, 0x75, 0x02, 0x52, 0x2b, 0xd4, 0x71, 0x0c, 0xcb, 0x44, 0x77, 0x21, 0xad,
+	0xd3, 0x6d, 0x8d, 0xe8, 0xba, 0xad, 0xc6, 0x27, 0x95, 0xe9, 0x6c, 0xf9, 0x8d, 0xcf, 0xf6, 0x0a,
+	0xb1, 0x5f, 0xef, 0x15, 0x5e, 0xdb, 0xb4, 0x8a, 0xee, 0x43, 0xea, 0x3e, 0x34, 0xcc, 0x4d, 0xa7,
+	0x68, 0x52, 0xf7, 0x91, 0x65, 0x6f, 0x5d, 0x8a, 0x82, 0x37, 0xb7, 0x36, 0x2f, 0xb9, 0xbb, 0x4d,
+	0xea, 0x14, 0x2b, 0x74, 0xbb, 0xa4, 0xeb, 0x36, 0x4e, 0xe9, 0xe2, 0x03, 0x95, 0x20, 0xc9, 0xc6,
+	0xa5, 0x26, 0x26, 0x95, 0xe9, 0x81, 0xd9, 0xd3, 0xc5, 0xe8, 0xba, 0x2c, 0x4a, 0xf9, 0x37, 0xe9,
+	0xae, 0x53, 0xce, 0x3d, 0x2b, 0xf7, 0xfd, 0x54, 0x89, 0xe7, 0x14, 0x26, 0xf9, 0xf3, 0xbd, 0x82,
+	0x82, 0x39, 0x2b, 0x7a, 0x01, 0x06, 0xeb, 0xc4, 0x71, 0xb5, 0x0d, 0xad, 0x66, 0xba, 0x5a, 0xab,
+	0xa9, 0x26, 0x27, 0x95, 0xe9, 0x41, 0x0c, 0xac, 0x71, 0x61, 0xce, 0x74, 0xd7, 0x9a, 0x68, 0x1a,
+	0x46, 0x38, 0x89, 0x29, 0x89, 0x74, 0xeb, 0x91, 0xa9, 0xf6, 0x71, 0x32, 0xce, 0xbb, 0xc4, 0xe8,
+	0x2a, 0xd6, 0x23, 0xd3, 0xa7, 0x24, 0x61, 0xca, 0xfe, 0x80, 0xb2, 0xe4, 0x53, 0x16, 0xe1, 0x04,
+	0xa7, 0xac, 0x59, 0xe6, 0x46, 0x98, 0x38, 0xc5, 0x89, 0x73, 0xac, 0x6f, 0xce, 0x32, 0x37, 0x7c,
+	0xfa, 0x39, 0x00, 0xc7, 0x25, 0xb6, 0x4b, 0x75, 0x8d, 0xb8, 0x6a, 0x9a, 0x8f, 0x77, 0xa2, 0x28,
+	0x56, 0x52, 0xd1, 0x5b, 0x49, 0xc5, 0x55, 0x6f, 0xa9, 0x95, 0xd3, 0x6c, 0x98, 0x3f, 0xfb, 0xef,
+	0x82, 0x82, 0x33, 0x92, 0xaf, 0xe4, 0xde, 0x48, 0xa6, 0x95, 0x5c, 0x7c, 0xea, 0x7f, 0x06, 0x61,
+	0x70, 0xb1, 0x34, 0xb7, 0x4c, 0x6c, 0xd2, 0xa0, 0x2e, 0xb5, 0x1d, 0xf4, 0x32, 0xa4, 0x1b, 0x64,
+	0x47, 0xa3, 0x86, 0xdd, 0x54, 0x95, 0x49, 0x65, 0x3a, 0x5e, 0x1e, 0x68, 0xef, 0x15, 0x52, 0x8b,
+	0x64, 0x67, 0xbe, 0x8a, 0x97, 0x71, 0xaa, 0x41, 0x76, 0xe6, 0x0d, 0xbb, 0x89, 0xde, 0x85, 0x51,
+	0xa2, 0xdb, 0x1a, 0x9b, 0x65, 0xcd, 0x26, 0x2e, 0xd5, 0x0c, 0x53, 0xa7, 0x3b, 0xdc, 0x62, 0x43,
+	0xb3, 0x67, 0x3b, 0xad, 0x5f, 0x21, 0x2e, 0xc1, 0xc4, 0xa5, 0x55, 0x46, 0x54, 0x3e, 0xf3, 0xac,
+	0xdc, 0xf7, 0xc7, 0xcc, 0xfe, 0xed, 0xbd, 0x42, 0xae, 0x54, 0xc1, 0x91, 0x5e, 0x9c, 0x23, 0xba,
+	0x1d, 0x69, 0x41, 0xd7, 0x01, 0x31, 0x59, 0xee, 0x8e, 0xd6, 0xb4, 0x1e, 0x51, 0x5b, 0x8a, 0xe2,
+	0x56, 0x2f, 0x4f, 0x3c, 0x2b, 0x27, 0x2f, 0xc4, 0xd5, 0xe1, 0xf6, 0x5e, 0x61, 0xb8, 0x54, 0xc1,
+	0xab, 0x3b, 0xcb, 0x8c, 0x44, 0x20, 0x0d, 0x13, 0xdd, 0x0e, 0x37, 0xa0, 0x6f, 0x41, 0x96, 0x01,
+	0x99, 0xeb, 0x9a, 0x6b, 0x13, 0xd3, 0x11, 0xd3, 0x51, 0x1e, 0x0b, 0x20, 0xa0, 0x54, 0xc1, 0x4b,
+	0xeb, 0xab, 0xac, 0x13, 0x03, 0xd1, 0x6d, 0xf9, 0x8d, 0xbe, 0x0b, 0x83, 0x8c, 0x91, 0xd4, 0xb6,
+	0xb4, 0xba, 0xd1, 0x30, 0x5c, 0x35, 0xe5, 0x09, 0x4f, 0x5f, 0xe8, 0x57, 0x1f, 0x3f, 0x8e, 0x4f,
+	0xb3, 0xb1, 0x0c, 0x94, 0x2a, 0xb8, 0x54, 0xdb, 0xba, 0xc5, 0x28, 0xf0, 0x00, 0xd1, 0x6d, 0xef,
+	0x47, 0x98, 0x5f, 0xa7, 0x75, 0xb2, 0xab, 0xa6, 0x0f, 0xe0, 0xaf, 0x30, 0x0a, 0x8f, 0x9f, 0xff,
+	0x40, 0xdf, 0x85, 0x8c, 0xbd, 0x73, 0x59, 0xf2, 0x66, 0xb8, 0x8d, 0x4f, 0x75, 0xda, 0x18, 0xef,
+	0x70, 0xda, 0x72, 0xda, 0xb3, 0x2e, 0x4e, 0xdb, 0x3b, 0x97, 0x05, 0xff, 0xb7, 0xe1, 0x04, 0xe7,
+	0xf7, 0x67, 0xcb, 0xda, 0xd8, 0x70, 0xa8, 0xab, 0x02, 0x57, 0x23, 0x25, 0x0c, 0x90, 0xc2, 0x23,
+	0x8c, 0x41, 0x9a, 0xfe, 0x36, 0xa7, 0x40, 0x77, 0x60, 0xd4, 0xde, 0x99, 0xed, 0x9a, 0xe7, 0x81,
+	0xa3, 0xcc, 0x73, 0xa0, 0x49, 0xce, 0xde, 0x99, 0x8d, 0xce, 0x69, 0x11, 0x06, 0x19, 0xee, 0x86,
+	0x4d, 0x7f, 0xd4, 0xa2, 0x66, 0x6d, 0x57, 0xcd, 0x4e, 0x2a, 0xd3, 0xc9, 0x72, 0xe6, 0x59, 0xb9,
+	0x7f, 0x36, 0x39, 0xfd, 0xf1, 0x9f, 0xf7, 0xe3, 0xac, 0xbd, 0x33, 0xbb, 0xe0, 0x75, 0xa3, 0x15,
+	0x18, 0x62, 0xeb, 0x52, 0x6f, 0xb9, 0xbb, 0x5a, 0x6d, 0xb7, 0x56, 0xa7, 0xea, 0x20, 0x57, 0xe1,
+	0xc5, 0x4e, 0x15, 0x4a, 0x9b, 0x9b, 0x36, 0xdd, 0x24, 0x2e, 0xd5, 0x2b, 0x2d, 0x77, 0x77, 0x8e,
+	0x91, 0x86, 0x14, 0xc9, 0x36, 0xc8, 0x8e, 0xdf, 0x8e, 0x74, 0x38, 0x65, 0x53, 0x76, 0x56, 0x6a,
+	0xec, 0x60, 0xd6, 0x9a, 0xd4, 0x36, 0x2c, 0xdd, 0xa8, 0x19, 0xee, 0xae, 0x3a, 0xc4, 0xd1, 0xa7,
+	0xba, 0x8c, 0xcc, 0xc9, 0xd9, 0xde, 0x9a, 0xdf, 0x69, 0x5a, 0x26, 0x35, 0xdd, 0x10, 0xf8, 0x98,
+	0xed, 0xf7, 0x2e, 0x07, 0x50, 0x68, 0x13, 0x54, 0x29, 0xa5, 0x66, 0xb5, 0x4c, 0x37, 0x22, 0x66,
+	0xb8, 0xf7, 0x20, 0x84, 0x98, 0x39, 0x46, 0xde, 0x43, 0xce, 0x49, 0x3b, 0xe8, 0x0e, 0x0b, 0xfa,
+	0x0e, 0x8c, 0x36, 0x0d, 0x73, 0x53, 0x73, 0xea, 0x96, 0x1b, 0xb2, 0x6c, 0x8e, 0x5b, 0x76, 0xe0,
+	0x59, 0x39, 0x3d, 0xdb, 0xaf, 0xc6, 0xb8, 0x6d, 0x47, 0x18, 0xdd, 0x4a, 0xdd, 0x72, 0x03, 0x03,
+	0x13, 0x18, 0x0f, 0x98, 0x3b, 0xa7, 0x7b, 0xe4, 0x78, 0xd3, 0x3d, 0xe6, 0xc1, 0x47, 0xe7, 0xfc,
+	0x9b, 0x90, 0x5b, 0xa7, 0xa4, 0x66, 0x99, 0x21, 0xe5, 0x50, 0xb7, 0x72, 0xc3, 0x82, 0x28, 0x50,
+	0xed, 0x26, 0xa4, 0x6b, 0x0f, 0x89, 0x69, 0xd2, 0xba, 0xa3, 0x8e, 0x4e, 0x26, 0xa6, 0x07, 0x66,
+	0x5f, 0xea, 0xd4, 0x24, 0x72, 0x88, 0x15, 0xe7, 0x04, 0x35, 0xd7, 0xe8, 0xaf, 0x94, 0x78, 0x5a,
+	0xc1, 0x3e, 0x00, 0x5a, 0x80, 0x91, 0x56, 0xb3, 0x6e, 0x98, 0x5b, 0x9a, 0xfe, 0x88, 0xd6, 0xeb,
+	0x7c, 0xe6, 0xd5, 0x13, 0xfb, 0x1c, 0xa2, 0x65, 0xcb, 0xaa, 0xdf, 0x21, 0xf5, 0x16, 0xc5, 0xc3,
+	0x82, 0xa9, 0xc2, 0x78, 0xd8, 0x04, 0xa3, 0x1b, 0x30, 0xca, 0x4e, 0xe9, 0x4e, 0xa4, 0xb1, 0x43,
+	0x91, 0x46, 0x3c, 0x36, 0x1f, 0x6b, 0xe2, 0x97, 0x71, 0x48, 0x49, 0x9d, 0xd1, 0x6b, 0x90, 0x93,
+	0xfa, 0x05, 0x46, 0x52, 0x3a, 0xf7, 0x86, 0xd4, 0x26, 0x30, 0xd1, 0xb7, 0x01, 0xf9, 0xda, 0x04,
+	0x7c, 0xf1, 0x4e, 0x3e, 0x5f, 0x76, 0xc0, 0x79, 0x07, 0x46, 0x1b, 0x86, 0xd9, 0x35, 0xe3, 0x89,
+	0x63, 0x6e, 0xf0, 0x86, 0x61, 0x46, 0x27, 0x9b, 0xe1, 0x92, 0x9d, 0x2e, 0xdc, 0xe4, 0x71, 0x71,
+	0xc9, 0x4e, 0x14, 0xf7, 0x45, 0x18, 0xa4, 0x26, 0x59, 0xaf, 0x53, 0x4d, 0xd8, 0x80, 0xdf, 0x03,
+	0x69, 0x9c, 0x15, 0x8d, 0x6b, 0xbc, 0xed, 0x6a, 0xf2, 0x93, 0x8f, 0x0b, 0x31, 0xf1, 0xef, 0x8d,
+	0x64, 0x3a, 0x9e, 0x4b, 0xdc, 0x48, 0xa6, 0x13, 0xb9, 0xe4, 0x54, 0x03, 0x86, 0xe6, 0x4d, 0xbd,
+	0xc2, 0x1d, 0xd8, 0xb2, 0x4d, 0x4c, 0x1d, 0x9d, 0x84, 0xb8, 0xa1, 0x73, 0x03, 0x67, 0xca, 0xfd,
+	0xed, 0xbd, 0x42, 0xbc, 0x5a, 0xc1, 0x71, 0x43, 0x47, 0x08, 0x92, 0x26, 0x69, 0x50, 0x6e, 0xc2,
+	0x0c, 0xe6, 0xdf, 0x68, 0x1c, 0x12, 0x2d, 0xbb, 0xce, 0x4d, 0x93, 0x29, 0xa7, 0xda, 0x7b, 0x85,
+	0xc4, 0x1a, 0xbe, 0x85, 0x59, 0x1b, 0x3a, 0x01, 0x7d, 0x75, 0x6b, 0xd3, 0x72, 0xd4, 0xe4, 0x64,
+	0x62, 0x3a, 0x83, 0xc5, 0x8f, 0xa9, 0x7f, 0x52, 0x42, 0xf2, 0x16, 0x2d, 0x9d, 0xd6, 0xd1, 0x22,
+	0xa4, 0xd7, 0x99, 0x60, 0xcd, 0x97, 0x3a, 0xfb, 0xac, 0x7c, 0xce, 0x9e, 0x52, 0xcf, 0xcd, 0xe6,
+	0xdf, 0x79, 0x9b, 0xcc, 0xbc, 0xf7, 0xea, 0xcc, 0x1f, 0x3c, 0x98, 0xbe, 0x76, 0xf5, 0xed, 0x99,
+	0x07, 0xd7, 0xbc, 0x9f, 0xe7, 0x7f, 0x3c, 0x7b, 0xf1, 0x83, 0x73, 0xec, 0x1a, 0xe6, 0x3a, 0x57,
+	0x2b, 0x38, 0xc5, 0x31, 0xaa, 0x3a, 0x7a, 0x93, 0xab, 0xcf, 0x95, 0x2c, 0xcf, 0x1c, 0x1d, 0xa8,
+	0x73, 0x94, 0x89, 0x60, 0x94, 0x53, 0x7f, 0x19, 0x87, 0xd3, 0xbe, 0xd2, 0x77, 0xa8, 0xcd, 0xdc,
+	0xa6, 0x6a, 0xe0, 0x74, 0x7e, 0xd5, 0x23, 0x58, 0x84, 0x74, 0x83, 0x59, 0x46, 0xf3, 0xc7, 0x71,
+	0x1c, 0x38, 0x6e, 0x54, 0x06, 0xc7, 0x31, 0xaa, 0x3a, 0x3a, 0x0f, 0xb9, 0x87, 0xc4, 0xd6, 0x1f,
+	0x11, 0x9b, 0x6a, 0xdb, 0x42, 0x79, 0x39, 0xba, 0x61, 0xaf, 0x5d, 0x8e, 0x89, 0x91, 0x6e, 0x18,
+	0x76, 0x23, 0x42, 0x9a, 0x14, 0xa4, 0x5e, 0xbb, 0x24, 0x9d, 0xfa, 0x65, 0x3f, 0xe4, 0x3a, 0x6d,
+	0x82, 0x6e, 0x43, 0xc2, 0xd0, 0x1d, 0x6e, 0x83, 0x81, 0xd9, 0x6f, 0x74, 0xae, 0xe8, 0x03, 0x4c,
+	0xd8, 0xc3, 0x01, 0x65, 0x48, 0x48, 0x83, 0x61, 0x09, 0xe0, 0xeb, 0x13, 0xe7, 0xdb, 0x65, 0xa2,
+	0xc7, 0x71, 0x27, 0x61, 0xcb, 0x13, 0xde, 0x5e, 0x69, 0xef, 0x15, 0x86, 0x6e, 0x59, 0x98, 0xdc,
+	0x2d, 0x2d, 0xc9, 0x3e, 0x3c, 0x24, 0x59, 0x3c, 0x8d, 0x0d, 0x18, 0xf5, 0x04, 0x34, 0x1f, 0xee,
+	0x46, 0xec, 0xd3, 0x43, 0xc8, 0xf2, 0x5b, 0x3f, 0xf4, 0x84, 0x9c, 0x0d, 0x09, 0x19, 0x91, 0x42,
+	0x82, 0x6e, 0x3c, 0x22, 0xb9, 0x96, 0x1f, 0xee, 0x7a, 0xa2, 0x16, 0x60, 0xc4, 0x3f, 0x87, 0xb4,
+	0x66, 0x9d, 0x98, 0x6c, 0x7e, 0xb9, 0x75, 0xb9, 0xcb, 0x66, 0xc7, 0xd5, 0xef, 0x31, 0x97, 0xcd,
+	0x3f, 0x87, 0x96, 0xeb, 0xc4, 0xac, 0x56, 0xf0, 0xf0, 0x46, 0xa4, 0x81, 0xed, 0xcf, 0xfe, 0xe6,
+	0x43, 0xcb, 0xb5, 0x1c, 0xb5, 0x8f, 0xef, 0x2c, 0xf9, 0x0b, 0x4d, 0x43, 0xce, 0x69, 0x35, 0x9b,
+	0x96, 0xed, 0x3a, 0x5a, 0xad, 0x4e, 0x1c, 0x47, 0x5b, 0xe7, 0xee, 0x5c, 0x1a, 0x0f, 0x79, 0xed,
+	0x73, 0xac, 0xb9, 0xdc, 0x83, 0xb2, 0xa6, 0xa6, 0x7a, 0x50, 0xce, 0x21, 0x0a, 0x27, 0x74, 0xba,
+	0x41, 0x5a, 0x75, 0x57, 0x6b, 0x90, 0x9a, 0xe6, 0x50, 0xd7, 0x65, 0xb1, 0x88, 0x9a, 0xee, 0x1d,
+	0x52, 0x2c, 0x96, 0xe6, 0x56, 0x24, 0x49, 0xf9, 0x64, 0x7b, 0xaf, 0x80, 0x2a, 0x82, 0x39, 0xd4,
+	0x8e, 0x91, 0x04, 0x5c, 0x24, 0x35, 0xaf, 0x8d, 0x9d, 0x60, 0xec, 0xc4, 0x0d, 0x8e, 0x69, 0xe6,
+	0xd0, 0x25, 0x71, 0xb6, 0x61, 0x84, 0xee, 0x3c, 0x46, 0x44, 0x76, 0x42, 0x44, 0x20, 0x89, 0xc8,
+	0x4e, 0x84, 0xc8, 0x1f, 0x1a, 0xf3, 0x08, 0xb8, 0x5b, 0x96, 0xc6, 0x59, 0xaf, 0xf1, 0x86, 0x65,
+	0x98, 0xe8, 0x22, 0x20, 0x9b, 0x3a, 0x54, 0x92, 0x68, 0xa6, 0x65, 0xd6, 0xa8, 0xc3, 0xdd, 0xad,
+	0x34, 0xce, 0x89, 0x1e, 0x46, 0xb7, 0xc4, 0xdb, 0x11, 0x05, 0x4f, 0x65, 0x6d, 0xc3, 0xb2, 0x1b,
+	0xc4, 0x65, 0x17, 0x2a, 0xf7, 0xb5, 0x06, 0x66, 0xa7, 0xbb, 0x2c, 0x20, 0x22, 0xc1, 0x65, 0xb2,
+	0x5b, 0xb7, 0x88, 0xbe, 0xe0, 0xd3, 0x97, 0xb3, 0xe1, 0x05, 0x8e, 0x47, 0x24, 0x62, 0x40, 0x20,
+	0x8e, 0xe6, 0xa9, 0xbf, 0x1b, 0x85, 0x81, 0x90, 0xb5, 0xd0, 0x75, 0x18, 0x96, 0x73, 0xc9, 0x2f,
+	0x53, 0xab, 0xe5, 0xca, 0xdd, 0x35, 0xde, 0x75, 0x9f, 0x56, 0x64, 0x18, 0x5f, 0x4e, 0x7e, 0xc4,
+	0x22, 0x9b, 0x41, 0xce, 0x57, 0x5e, 0x15, 0x5c, 0xa8, 0x06, 0x63, 0x81, 0x33, 0x13, 0xf6, 0xb7,
+	0xe2, 0x1c, 0xee, 0xd2, 0x01, 0x53, 0x59, 0x5c, 0x96, 0xbe, 0x8b, 0xf0, 0xac, 0xc4, 0x9d, 0x3d,
+	0xda, 0x8c, 0x34, 0x0a, 0x77, 0xeb, 0xe1, 0x41, 0x1e, 0x93, 0x08, 0x43, 0x8b, 0x07, 0x09, 0x8a,
+	0xdc, 0x6b, 0x42, 0xce, 0x3e, 0x8e, 0xd3, 0xdd, 0xde, 0x8e, 0x5d, 0x92, 0xcb, 0x38, 0xd3, 0x65,
+	0x9b, 0xb5, 0xaa, 0xe9, 0x7e, 0xf3, 0x35, 0x8e, 0x18, 0xb9, 0xfc, 0xbb, 0x9d, 0x3e, 0xdf, 0xe0,
+	0x35, 0xdf, 0xe0, 0x7d, 0xc7, 0x31, 0xf8, 0x9c, 0x67, 0xf0, 0xf9, 0x70, 0x80, 0xd2, 0xbf, 0xcf,
+	0x6a, 0x09, 0x8d, 0x5d, 0x06, 0x2b, 0x62, 0xd4, 0x41, 0x9c, 0x72, 0x67, 0x9f, 0x38, 0x25, 0x75,
+	0xc0, 0x48, 0xaf, 0xcc, 0x8a, 0x91, 0x1e, 0x14, 0xc5, 0x3c, 0xe8, 0x1d, 0xc5, 0xa4, 0x9f, 0x6b,
+	0x92, 0xba, 0x83, 0x99, 0x5b, 0x9d, 0xc1, 0x4c, 0xe6, 0x78, 0x33, 0x13, 0x0d, 0x75, 0xde, 0x80,
+	0x89, 0x0d, 0x52, 0x73, 0x2d, 0x7b, 0x57, 0x6b, 0xf2, 0xfd, 0xe9, 0x03, 0x1b, 0xd4, 0x51, 0x61,
+	0x32, 0x31, 0x9d, 0xc4, 0xaa, 0xa4, 0x58, 0xe6, 0x04, 0x0b, 0x41, 0x3f, 0xba, 0xd7, 0x15, 0x28,
+	0x0d, 0x70, 0x65, 0x5e, 0x3b, 0x68, 0x94, 0x3d, 0x82, 0x26, 0x31, 0xd6, 0x68, 0xbc, 0x54, 0x83,
+	0x31, 0xff, 0xbc, 0xb9, 0x32, 0xab, 0xad, 0x1b, 0x32, 0x57, 0xa2, 0x66, 0x0f, 0xf3, 0x7a, 0xcb,
+	0x63, 0xec, 0xe6, 0x58, 0x91, 0xcc, 0x57, 0x66, 0xcb, 0x06, 0xcf, 0xa8, 0xe0, 0x11, 0xa7, 0xb3,
+	0x09, 0x5d, 0x83, 0x54, 0xcb, 0xa1, 0x1a, 0xd1, 0x6d, 0x75, 0xf0, 0x50, 0x58, 0x68, 0xef, 0x15,
+	0xfa, 0xd7, 0x1c, 0x5a, 0xaa, 0x60, 0xdc, 0xdf, 0x72, 0x68, 0x49, 0xb7, 0x51, 0x15, 0x58, 0xe8,
+	0xae, 0x35, 0x88, 0xbd, 0x69, 0x98, 0xea, 0x90, 0x3c, 0xbc, 0x3b, 0x31, 0x16, 0xea, 0x16, 0x71,
+	0x05, 0xc8, 0x60, 0x7b, 0xaf, 0x90, 0x29, 0x55, 0xf0, 0x22, 0xe7, 0xc0, 0x19, 0xa2, 0xdb, 0xe2,
+	0x13, 0xbd, 0x01, 0x59, 0x79, 0x76, 0x8a, 0x71, 0x0e, 0x1f, 0xea, 0xdd, 0x83, 0xa0, 0xe7, 0x23,
+	0xb9, 0x0b, 0xa7, 0x1c, 0x97, 0xb8, 0x2d, 0xa7, 0x3b, 0xbc, 0xcc, 0x1d, 0x6d, 0x97, 0x8d, 0x09,
+	0xfe, 0xce, 0x88, 0xf2, 0x0e, 0xa8, 0x12, 0xb8, 0x3b, 0xa2, 0x1c, 0x39, 0x7c, 0xab, 0xe0, 0x93,
+	0x82, 0xbb, 0x2b, 0x80, 0x5c, 0x85, 0x11, 0x9d, 0x3a, 0x86, 0x4d, 0x75, 0x2d, 0xd8, 0xcd, 0xe8,
+	0x98, 0xbb, 0x79, 0x58, 0x42, 0x60, 0x6f, 0x53, 0xdf, 0x87, 0x33, 0x11, 0xd4, 0xce, 0xcd, 0x3d,
+	0x7a, 0x04, 0x8d, 0xd5, 0x10, 0x68, 0x74, 0x6b, 0xd7, 0xe1, 0x74, 0x80, 0xde, 0xbd, 0xc5, 0x4f,
+	0x3c, 0xd7, 0x16, 0x3f, 0xe5, 0x8b, 0xeb, 0xd8, 0xe9, 0x6f, 0xc3, 0x58, 0x58, 0x5a, 0xb0, 0xe3,
+	0xc7, 0x8e, 0xb7, 0xe3, 0x47, 0x03, 0x01, 0xc1, 0xc6, 0xd7, 0x65, 0x96, 0xa8, 0xbe, 0x69, 0xd9,
+	0x86, 0xfb, 0xb0, 0xa1, 0x9e, 0xe4, 0xa0, 0x33, 0x07, 0xee, 0xdc, 0x0a, 0x2e, 0x79, 0xf4, 0x42,
+	0x4a, 0xae, 0xbd, 0x57, 0xc8, 0x86, 0x9b, 0x31, 0x4b, 0x7a, 0xf9, 0xbf, 0xd0, 0x9f, 0x28, 0x30,
+	0xce, 0xc4, 0x6c, 0x18, 0x3b, 0x54, 0xef, 0xb2, 0xd7, 0xa9, 0xe7, 0xb1, 0x57, 0x79, 0xbc, 0xbd,
+	0x57, 0x18, 0x2b, 0x55, 0xf0, 0x02, 0xc3, 0x8c, 0xf4, 0xe3, 0x31, 0xa2, 0xdb, 0xdd, 0xcd, 0x13,
+	0x2b, 0x80, 0xba, 0x71, 0xd0, 0x9b, 0xd0, 0xb7, 0xcd, 0x3e, 0x54, 0xe5, 0x78, 0x61, 0xa2, 0xe0,
+	0x9a, 0x58, 0x83, 0xd1, 0x1e, 0xb7, 0x37, 0xfa, 0x6e, 0x14, 0x35, 0xdf, 0xe5, 0xe8, 0x46, 0x78,
+	0xba, 0x61, 0x35, 0x50, 0xf7, 0x3b, 0x20, 0xd1, 0x5c, 0x14, 0xfb, 0x98, 0xe9, 0x28, 0x29, 0xe0,
+	0x3a, 0x64, 0xc3, 0x5b, 0x08, 0x7d, 0x2b, 0x0a, 0x7a, 0x84, 0x54, 0x9f, 0x04, 0xfa, 0x3e, 0x8c,
+	0x74, 0x2d, 0x08, 0xf4, 0x46, 0x14, 0xed, 0x4c, 0x97, 0x8a, 0x21, 0x8e, 0x2e, 0xc8, 0xa9, 0xff,
+	0x1c, 0x84, 0x34, 0x9b, 0x7d, 0x97, 0xb8, 0x14, 0xdd, 0x03, 0x54, 0x6b, 0xd9, 0x36, 0x65, 0x67,
+	0x8e, 0x9f, 0x6e, 0x91, 0x3e, 0xda, 0xd9, 0x03, 0x73, 0x32, 0x9d, 0x2e, 0xa1, 0x84, 0x09, 0x08,
+	0x18, 0xb6, 0xb7, 0xb5, 0x42, 0xd8, 0xf1, 0xe7, 0xc0, 0x96, 0x30, 0x21, 0xec, 0x32, 0x64, 0x45,
+	0xd5, 0x4a, 0x44, 0x00, 0x32, 0xe2, 0x19, 0xeb, 0x44, 0x15, 0x11, 0x43, 0x60, 0x82, 0x01, 0xc1,
+	0xc4, 0x9b, 0x7b, 0x45, 0x67, 0xc9, 0xaf, 0x34, 0x3a, 0x7b, 0x00, 0x13, 0x7e, 0x1d, 0xc0, 0xb0,
+	0x1b, 0x6c, 0x73, 0x7a, 0x29, 0x1d, 0xe2, 0xf9, 0x65, 0x07, 0xe5, 0xf9, 0x93, 0x3c, 0xc7, 0x7f,
+	0xca, 0xab, 0x17, 0x70, 0x88, 0x8a, 0x44, 0x28, 0xb9, 0xe8, 0x75, 0x50, 0x39, 0x3c, 0x2b, 0xbf,
+	0xc8, 0xdb, 0xc3, 0x2f, 0x74, 0x88, 0xba, 0xc4, 0x28, 0xeb, 0xaf, 0xd0, 0xed, 0x15, 0xde, 0x2b,
+	0x2b, 0x1e, 0xf7, 0xf7, 0x73, 0xa5, 0x53, 0xc7, 0xdc, 0x4c, 0x3d, 0x7d, 0x68, 0x0a, 0x67, 0x9a,
+	0xd4, 0xd4, 0x99, 0x00, 0xd2, 0x6c, 0xd6, 0x8d, 0x1a, 0xbf, 0xfd, 0xfc, 0x81, 0x4b, 0x0f, 0xad,
+	0x7b, 0x57, 0x05, 0xb4, 0xde, 0x08, 0xf1, 0x84, 0x04, 0xea, 0xd1, 0x87, 0xe6, 0x21, 0xf7, 0xa3,
+	0x16, 0x6d, 0xb1, 0x53, 0x9b, 0x3a, 0x4d, 0xcb, 0x74, 0xa8, 0xa3, 0x66, 0x78, 0x26, 0xb1, 0xd7,
+	0xe4, 0xcd, 0x59, 0x8d, 0x06, 0x31, 0x75, 0x3c, 0x2c, 0x78, 0xb0, 0xc7, 0xc2, 0x60, 0x3c, 0x6d,
+	0xf9, 0xa1, 0xed, 0xb8, 0xc2, 0x1f, 0x3b, 0x04, 0x46, 0xf2, 0x60, 0xc9, 0x82, 0xbe, 0x0f, 0x48,
+	0x6a, 0xc3, 0x23, 0x32, 0x52, 0xab, 0xd1, 0xa6, 0xab, 0x0e, 0xf4, 0x1e, 0xaa, 0xb7, 0xf7, 0x8a,
+	0x2c, 0x48, 0x2b, 0x71, 0x52, 0x2c, 0x07, 0x13, 0xb4, 0xa0, 0x45, 0x38, 0xe1, 0x69, 0xc6, 0x31,
+	0xa5, 0x7a, 0x6a, 0xb6, 0x77, 0xe8, 0xca, 0x38, 0xa5, 0x3a, 0x18, 0x49, 0xc6, 0x50, 0x1b, 0x7a,
+	0x95, 0xf9, 0xe1, 0xda, 0x23, 0xc3, 0xd4, 0xad, 0x47, 0x8e, 0x46, 0xb6, 0x89, 0x51, 0x67, 0xd9,
+	0x35, 0xee, 0x90, 0xa5, 0x31, 0xb2, 0x77, 0xee, 0x8a, 0xae, 0x92, 0xd7, 0x83, 0x56, 0x41, 0x95,
+	0x63, 0xb2, 0x9a, 0xd4, 0x26, 0xae, 0x65, 0x6b, 0x35, 0x31, 0x7e, 0x47, 0x1d, 0x3a, 0xd4, 0x44,
+	0x27, 0x05, 0xef, 0x6d, 0xc9, 0x2a, 0x9b, 0x1d, 0x64, 0xc3, 0x69, 0x99, 0xb8, 0x15, 0x39, 0x04,
+	0x9b, 0xd6, 0x2c, 0xb3, 0x66, 0xd4, 0x0d, 0x3e, 0xbd, 0xd2, 0x1d, 0x9b, 0xdd, 0xd7, 0x64, 0x32,
+	0xa7, 0xca, 0x52, 0x09, 0x38, 0xc2, 0x89, 0xc7, 0x6b, 0xfb, 0x75, 0x4d, 0xfc, 0x8b, 0x02, 0x10,
+	0xb2, 0xec, 0x8b, 0x90, 0x6a, 0x8a, 0xf8, 0x96, 0x9f, 0x73, 0x59, 0x7e, 0x8b, 0xbf, 0x97, 0xcc,
+	0x8d, 0xa8, 0x2f, 0x60, 0xaf, 0x07, 0xcd, 0x41, 0xca, 0xb3, 0x78, 0xfc, 0x50, 0x8b, 0x77, 0x1c,
+	0x57, 0x1e, 0x27, 0x7a, 0xf3, 0xe8, 0x15, 0xcc, 0x28, 0x02, 0x67, 0x9b, 0xf8, 0x28, 0x0e, 0xe3,
+	0xfb, 0x0e, 0xb8, 0x77, 0x3e, 0x46, 0x39, 0x7e, 0x3e, 0x26, 0x5a, 0x7c, 0x8c, 0x3f, 0x57, 0xf1,
+	0x91, 0x65, 0xde, 0xbc, 0xd5, 0xea, 0x27, 0xf6, 0x13, 0xfc, 0x08, 0xf2, 0xf6, 0x8a, 0x1c, 0x88,
+	0x83, 0xe6, 0x20, 0x5b, 0xb3, 0x1a, 0xcd, 0x3a, 0x95, 0x12, 0x93, 0x47, 0x3c, 0x06, 0x07, 0x7c,
+	0xae, 0x92, 0x2b, 0xb3, 0x0d, 0xff, 0x9a, 0x80, 0x31, 0x3f, 0x2b, 0x57, 0x32, 0x6c, 0xe6, 0x94,
+	0xaf, 0xb1, 0xfc, 0x05, 0x5a, 0x80, 0xd4, 0x7a, 0xab, 0xb6, 0x45, 0x5d, 0x76, 0x97, 0xb1, 0xb5,
+	0x7a, 0x71, 0xdf, 0x6c, 0x5e, 0x98, 0xaf, 0x58, 0xe6, 0x4c, 0xd8, 0x63, 0x46, 0x25, 0x18, 0xa0,
+	0x3b, 0x35, 0x4a, 0xf5, 0xa3, 0x5a, 0x47, 0xe8, 0x0a, 0x1e, 0x53, 0xc9, 0x65, 0xb3, 0xd8, 0x2f,
+	0x60, 0xd1, 0x55, 0xe8, 0xe3, 0x26, 0x53, 0x95, 0x43, 0x71, 0x02, 0x2b, 0x0b, 0x16, 0x74, 0x03,
+	0x86, 0x64, 0x15, 0x81, 0x08, 0x85, 0xd5, 0xf8, 0x61, 0x11, 0x07, 0xc7, 0x10, 0xb1, 0xbd, 0x60,
+	0x95, 0x43, 0x45, 0x4b, 0x90, 0x0b, 0x2e, 0x22, 0x89, 0x96, 0x38, 0x3a, 0xda, 0xb0, 0xc7, 0xec,
+	0xe1, 0xa9, 0x90, 0x12, 0x02, 0x1c, 0x59, 0x60, 0xf7, 0x7e, 0xa2, 0x33, 0x90, 0xf1, 0x88, 0x1d,
+	0x59, 0x55, 0x0f, 0x1a, 0xa6, 0x3e, 0x55, 0x42, 0x89, 0xe9, 0x52, 0xcb, 0x7d, 0x48, 0x4d, 0x57,
+	0x1e, 0xf4, 0x73, 0x96, 0x4e, 0xd1, 0xd9, 0xb0, 0x9f, 0x93, 0xe5, 0xf9, 0x80, 0xf7, 0xe2, 0x6a,
+	0x5a, 0x3a, 0x32, 0xe8, 0x1a, 0x00, 0x7f, 0x68, 0xa2, 0x6d, 0xd8, 0x56, 0xe3, 0xc8, 0x73, 0x93,
+	0xe1, 0x3c, 0x0b, 0xb6, 0xd5, 0x40, 0xdf, 0x81, 0xb4, 0x00, 0x70, 0x2d, 0x35, 0x71, 0x44, 0xf6,
+	0x14, 0xe7, 0x58, 0xb5, 0xe4, 0x12, 0xfc, 0xa3, 0x49, 0xc8, 0xf8, 0x43, 0x40, 0x6f, 0x85, 0x13,
+	0xc8, 0xe7, 0xf6, 0x5d, 0x72, 0x47, 0xc8, 0x1c, 0xcf, 0x01, 0xd4, 0x6c, 0x4a, 0x9e, 0x67, 0x57,
+	0x4a, 0xbe, 0x92, 0xcb, 0x40, 0x5a, 0x4d, 0xdd, 0x03, 0x49, 0x1c, 0x07, 0x44, 0xf2, 0x95, 0x5c,
+	0x74, 0x5a, 0x56, 0x14, 0x44, 0xaa, 0x37, 0x25, 0x8e, 0x96, 0x59, 0x59, 0x40, 0xb9, 0x00, 0x03,
+	0x3a, 0x75, 0x6a, 0xb6, 0xd1, 0xe4, 0xc7, 0x77, 0x1f, 0xa7, 0x61, 0x1e, 0x82, 0x9d, 0x50, 0x3f,
+	0x1f, 0xc6, 0xe1, 0x4e, 0xf4, 0x08, 0x80, 0xb8, 0xae, 0x6d, 0xac, 0xb7, 0x5c, 0xca, 0x2a, 0xf5,
+	0x6c, 0x5b, 0x9e, 0xdf, 0xd7, 0x46, 0xc5, 0x92, 0x4f, 0x3b, 0x6f, 0xba, 0xf6, 0x6e, 0xf9, 0xe2,
+	0xb3, 0xf2, 0xf9, 0xbf, 0x51, 0x5e, 0x9e, 0x3a, 0x52, 0x25, 0x01, 0x87, 0x44, 0xa1, 0xfb, 0x30,
+	0x20, 0xfd, 0x3b, 0x8d, 0xcd, 0x4e, 0xea, 0xf8, 0xe9, 0xfd, 0x21, 0xf6, 0x92, 0xc0, 0x6b, 0xaf,
+	0x38, 0x18, 0xb6, 0x3d, 0x1a, 0x07, 0x55, 0x01, 0x39, 0xd4, 0x66, 0x8c, 0x5a, 0xd3, 0xb6, 0x36,
+	0x8c, 0x3a, 0x65, 0x07, 0x71, 0x9a, 0x5b, 0xe2, 0x74, 0x70, 0x10, 0xe7, 0x56, 0x04, 0xd1, 0xb2,
+	0xa0, 0xa9, 0x56, 0x70, 0xce, 0x89, 0xb6, 0xe8, 0xe8, 0xdf, 0x15, 0x38, 0x29, 0x9f, 0xc9, 0x68,
+	0xac, 0x93, 0xda, 0xfc, 0x59, 0x0d, 0x75, 0x1c, 0x9e, 0x7f, 0xca, 0x94, 0xff, 0x42, 0x79, 0x56,
+	0xfe, 0xa9, 0x62, 0xff, 0x44, 0x99, 0xfd, 0x53, 0xe5, 0x9d, 0xe9, 0x6b, 0x57, 0xd9, 0xd8, 0xc9,
+	0xcc, 0x7b, 0xa5, 0x99, 0x7b, 0x6c, 0xe8, 0xef, 0x87, 0xbe, 0x83, 0xcf, 0xfb, 0x33, 0x0f, 0x2e,
+	0x84, 0x3a, 0xce, 0xdf, 0x2f, 0x9e, 0xbf, 0xc0, 0xf8, 0x4a, 0x33, 0xf7, 0xa4, 0xc9, 0xde, 0x0f,
+	0x7d, 0x07, 0x9f, 0x9c, 0x2f, 0xe8, 0x38, 0x3f, 0x7d, 0xed, 0xea, 0xd5, 0xb7, 0xd9, 0xd7, 0x8f,
+	0x2f, 0x5f, 0x7c, 0xfd, 0x83, 0xf3, 0xd7, 0xce, 0xbd, 0xff, 0xce, 0x39, 0x7c, 0x42, 0xaa, 0xbb,
+	0xc2, 0xb5, 0x2d, 0x09, 0x65, 0xd1, 0x3d, 0x50, 0x3b, 0x86, 0xb1, 0x45, 0xb7, 0xb4, 0x3a, 0x59,
+	0xa7, 0x75, 0xf5, 0x12, 0x1f, 0xc8, 0x0b, 0x62, 0x89, 0x3c, 0x66, 0xa1, 0xed, 0xd8, 0x52, 0x18,
+	0xe3, 0xe6, 0xfc, 0xcd, 0x5b, 0x8c, 0x10, 0x8f, 0x45, 0xa0, 0x6f, 0xd2, 0x2d, 0xde, 0x8c, 0xfe,
+	0x4b, 0x81, 0x89, 0xb0, 0x63, 0xd9, 0x61, 0x27, 0xf8, 0x7a, 0xda, 0x49, 0x0d, 0xa9, 0x1c, 0xb5,
+	0xd5, 0x06, 0x9c, 0xe9, 0x31, 0x9c, 0xc0, 0x5e, 0xaf, 0xf2, 0x01, 0xbd, 0x14, 0xb2, 0xd7, 0x78,
+	0xa9, 0x13, 0xcb, 0xb7, 0xd9, 0x78, 0x97, 0x18, 0xdf, 0x6e, 0x18, 0xc6, 0x7a, 0xc8, 0x31, 0x74,
+	0xf5, 0x32, 0x17, 0x90, 0x17, 0x2b, 0x55, 0x6f, 0xef, 0x15, 0x46, 0xbb, 0xf0, 0xab, 0x15, 0x3c,
+	0xda, 0x85, 0x5c, 0xd5, 0xd1, 0xbf, 0x29, 0x30, 0xca, 0x9d, 0xd3, 0x8e, 0x49, 0x18, 0xf8, 0x7a,
+	0x4e, 0xc2, 0x08, 0xd3, 0x35, 0x6a, 0x7d, 0x17, 0x32, 0x75, 0x4b, 0x8c, 0x8a, 0x55, 0x50, 0x12,
+	0xbd, 0xf2, 0x62, 0xc1, 0x91, 0x74, 0xcb, 0x23, 0x7d, 0x9e, 0x13, 0x29, 0x10, 0xd4, 0xb3, 0xd4,
+	0x35, 0x78, 0xe4, 0x52, 0xd7, 0x50, 0xcf, 0x52, 0x57, 0x8f, 0x60, 0x76, 0xf8, 0xf7, 0x51, 0x6a,
+	0xcc, 0xfd, 0xbe, 0x4a, 0x8d, 0x23, 0xc7, 0x77, 0x6d, 0xbb, 0xea, 0x72, 0xe8, 0x28, 0x75, 0xb9,
+	0xd1, 0xa3, 0xd4, 0xe5, 0x4e, 0x1c, 0xb9, 0x2e, 0x37, 0xb6, 0x4f, 0x5d, 0xee, 0x75, 0xc8, 0xd8,
+	0x96, 0xe5, 0x6a, 0x3c, 0x42, 0x10, 0x79, 0x41, 0xb5, 0x2b, 0x2d, 0x64, 0x59, 0x2e, 0x0b, 0x0f,
+	0x70, 0xda, 0x96, 0x5f, 0xe8, 0x0e, 0xf4, 0x9b, 0xd4, 0x65, 0x06, 0x39, 0xc5, 0x9d, 0xa2, 0x6b,
+	0xbf, 0xde, 0x2b, 0xcc, 0x1e, 0xeb, 0xa1, 0xe5, 0x12, 0x75, 0xab, 0x95, 0xf6, 0x5e, 0xa1, 0x8f,
+	0x7f, 0xe0, 0x3e, 0x93, 0xba, 0x55, 0x1d, 0xdd, 0x86, 0x6c, 0xa4, 0x44, 0xaa, 0x1e, 0x5e, 0x22,
+	0x65, 0xef, 0xeb, 0xc2, 0xd5, 0x3e, 0x3c, 0xd0, 0x08, 0x15, 0x45, 0xe7, 0x20, 0xc3, 0x01, 0x5d,
+	0xe2, 0x52, 0x75, 0xbc, 0xf7, 0xf8, 0xbc, 0xb8, 0xae, 0x9c, 0x6d, 0xef, 0x15, 0xfc, 0xa4, 0x14,
+	0x4e, 0x33, 0x1c, 0xf6, 0x85, 0x7e, 0x08, 0x23, 0x5e, 0x5c, 0x11, 0x80, 0x5d, 0x3c, 0x04, 0x6c,
+	0x94, 0x2d, 0x8e, 0x65, 0xc1, 0xe6, 0x63, 0x7a, 0x71, 0xc8, 0xa2, 0x07, 0x7d, 0x19, 0x52, 0x8e,
+	0x08, 0xc0, 0xd4, 0x09, 0x0e, 0x78, 0x6a, 0x9f, 0xf8, 0x0c, 0x7b, 0x74, 0xe8, 0x7b, 0xe0, 0xa1,
+	0x68, 0x1e, 0xeb, 0xe9, 0x83, 0x59, 0x87, 0x24, 0xbd, 0xfc, 0x8d, 0xce, 0xc1, 0x90, 0x9f, 0xb2,
+	0xe1, 0xeb, 0x43, 0x3d, 0xc3, 0x9d, 0xe2, 0xac, 0x4c, 0xd4, 0xf0, 0xb5, 0x81, 0x5e, 0x86, 0xe1,
+	0x96, 0x43, 0xf5, 0x80, 0xca, 0x51, 0xcf, 0x4e, 0x26, 0xd8, 0x3b, 0x53, 0xd6, 0xec, 0x91, 0xb1,
+	0xa7, 0x9d, 0xc3, 0x1c, 0x2d, 0x58, 0x6e, 0x6a, 0x3e, 0x78, 0x8f, 0xea, 0xaf, 0x35, 0xf4, 0x2d,
+	0x49, 0x67, 0xbf, 0x2b, 0xcb, 0x0b, 0xaf, 0xaa, 0x05, 0x46, 0x27, 0x32, 0xcb, 0xb7, 0x88, 0xe3,
+	0xe2, 0x1b, 0xbc, 0x74, 0xf0, 0xaa, 0x50, 0x04, 0xbf, 0x2b, 0x7e, 0x75, 0x33, 0x5e, 0x56, 0x27,
+	0x7b, 0x32, 0x5e, 0x8e, 0x30, 0x5e, 0x46, 0xef, 0xc0, 0xe9, 0xce, 0xd4, 0x94, 0x4d, 0x6b, 0xd4,
+	0xd8, 0x16, 0xae, 0xe8, 0x0b, 0xc7, 0x49, 0x7d, 0xf9, 0xf9, 0x2b, 0x2c, 0x11, 0x4a, 0xac, 0x3a,
+	0x39, 0x20, 0x5e, 0x8e, 0x8a, 0x15, 0x31, 0xb5, 0xcf, 0x21, 0xc4, 0x48, 0xc4, 0x9a, 0x08, 0xb2,
+	0x56, 0xd0, 0xf4, 0x5b, 0xd1, 0xdb, 0x80, 0xd6, 0x79, 0xfd, 0x7a, 0x97, 0x25, 0xc2, 0x6a, 0xd4,
+	0x74, 0xc9, 0x26, 0x55, 0x5f, 0x3c, 0xbc, 0xc0, 0x34, 0xfc, 0xac, 0x9c, 0x05, 0x38, 0x1b, 0x8b,
+	0x3d, 0xbe, 0x36, 0x13, 0x8b, 0xc5, 0x62, 0x78, 0x44, 0xe2, 0x2c, 0xfb, 0x30, 0xe8, 0x15, 0xf0,
+	0x03, 0x25, 0xaf, 0x74, 0x75, 0x6e, 0x52, 0x99, 0xee, 0xc3, 0x43, 0x5e, 0xb3, 0xac, 0x49, 0x11,
+	0x76, 0x6e, 0x30, 0x2e, 0x56, 0x22, 0xd3, 0xbc, 0x48, 0xea, 0xa5, 0xc9, 0x44, 0xaf, 0x3c, 0xa9,
+	0x78, 0x0f, 0x25, 0xeb, 0xf4, 0xe5, 0x13, 0xcc, 0xb3, 0xc4, 0x9c, 0xb9, 0x54, 0xc1, 0xa2, 0xcf,
+	0x61, 0x87, 0x0d, 0x6f, 0xd1, 0x6d, 0xd9, 0x82, 0x2a, 0x30, 0x24, 0x45, 0x78, 0xf0, 0x2f, 0x1f,
+	0x01, 0x1e, 0x0f, 0x0a, 0x26, 0x0f, 0xe5, 0x06, 0x48, 0x64, 0x2d, 0x08, 0xea, 0x5e, 0xe1, 0x38,
+	0x85, 0xae, 0xbc, 0xbe, 0x37, 0x44, 0x89, 0x34, 0x2c, 0x18, 0xbd, 0x66, 0xf6, 0x2c, 0xe1, 0x8c,
+	0x4c, 0x2f, 0xf5, 0x4a, 0x13, 0x3a, 0xea, 0xf4, 0x64, 0xa2, 0x57, 0xf2, 0xac, 0x67, 0x9e, 0x50,
+	0x00, 0xf5, 0xe8, 0x72, 0xd0, 0x5b, 0x00, 0xa1, 0x57, 0x0f, 0xe7, 0x8f, 0xf7, 0xea, 0x01, 0x87,
+	0x78, 0xd1, 0x3a, 0x0c, 0x35, 0x6d, 0x6b, 0xdb, 0x60, 0xfb, 0x58, 0x78, 0x4e, 0x17, 0xf8, 0x8d,
+	0xf4, 0x9d, 0x67, 0xe5, 0x57, 0xec, 0x97, 0xd4, 0x73, 0xb3, 0x2f, 0x1c, 0xec, 0x00, 0xbc, 0xff,
+	0x0e, 0x7b, 0xdf, 0x34, 0xb8, 0x1c, 0x60, 0x54, 0x2b, 0x78, 0x30, 0x04, 0x59, 0xd5, 0x51, 0x05,
+	0x46, 0xfc, 0x06, 0x76, 0xca, 0xe8, 0xc4, 0x25, 0xea, 0x37, 0xe4, 0x11, 0xd3, 0xb9, 0x1c, 0x57,
+	0xf8, 0x1f, 0x26, 0xe0, 0x5c, 0x98, 0x83, 0x95, 0x53, 0x58, 0xd0, 0xdd, 0x68, 0xd5, 0x59, 0x24,
+	0xed, 0xb8, 0xea, 0x0c, 0xbf, 0x7e, 0x82, 0x06, 0xb4, 0x09, 0xe3, 0xb5, 0x3a, 0x31, 0x1a, 0x1a,
+	0x89, 0x04, 0xdc, 0x5a, 0xcd, 0xd2, 0xa9, 0x5a, 0x3c, 0x24, 0x36, 0xea, 0x0e, 0xd2, 0xf1, 0x29,
+	0x8e, 0xd6, 0xdd, 0x81, 0x6e, 0xc0, 0xa0, 0x4c, 0x2e, 0x68, 0x2d, 0x66, 0x5e, 0x75, 0x76, 0x52,
+	0xe9, 0xf5, 0xd2, 0xb3, 0x67, 0x26, 0x06, 0x67, 0x49, 0xe8, 0xd7, 0xc4, 0x9b, 0x30, 0xdc, 0x11,
+	0x0f, 0xa2, 0x1c, 0x24, 0xb6, 0xa8, 0x78, 0x49, 0x99, 0xc1, 0xec, 0x93, 0x3d, 0xd9, 0x13, 0xe9,
+	0x02, 0xf1, 0xc4, 0x4f, 0xfc, 0xb8, 0x1a, 0xff, 0xb6, 0x32, 0x71, 0x07, 0x86, 0xa2, 0xbe, 0x5b,
+	0x0f, 0xee, 0x62, 0x98, 0xbb, 0xc7, 0xf5, 0xe2, 0x01, 0x84, 0x70, 0x65, 0x0e, 0xe0, 0x2d, 0x00,
+	0x7f, 0x0c, 0x0e, 0xba, 0x0a, 0x03, 0xc1, 0xdf, 0xd4, 0x78, 0xe9, 0xa7, 0xf1, 0x7d, 0x07, 0x8d,
+	0x81, 0xfa, 0xbc, 0x53, 0x3a, 0x9c, 0x9c, 0xe3, 0xd1, 0x7b, 0xd0, 0x2d, 0x53, 0x89, 0x37, 0x00,
+	0x02, 0x54, 0xff, 0x0d, 0xcd, 0x7e, 0xa0, 0x3d, 0xb2, 0x0a, 0x19, 0x5f, 0xcc, 0xd4, 0x3f, 0x2a,
+	0x70, 0x72, 0x8d, 0xc7, 0xf7, 0xff, 0x9f, 0x62, 0x58, 0x7a, 0x26, 0xf8, 0xeb, 0x9a, 0x7d, 0x53,
+	0x18, 0x0b, 0x8c, 0x64, 0x91, 0x38, 0x5b, 0xe5, 0x24, 0x03, 0xc1, 0x99, 0x0d, 0xaf, 0x61, 0xea,
+	0x9f, 0x15, 0x18, 0xbd, 0x4e, 0xdd, 0x2e, 0x25, 0xef, 0xc3, 0x50, 0xa0, 0xa4, 0xf6, 0xe5, 0x13,
+	0x2e, 0x59, 0x1a, 0xd0, 0x39, 0x5f, 0x5e, 0xed, 0x2f, 0x14, 0x78, 0x29, 0xac, 0x76, 0x48, 0xf8,
+	0x82, 0x65, 0xcf, 0xaf, 0x55, 0x1d, 0x6f, 0x20, 0x7f, 0x08, 0x69, 0x7e, 0x75, 0xd3, 0x96, 0x21,
+	0x53, 0x5c, 0xf3, 0xf2, 0x4f, 0x67, 0x8e, 0xe7, 0xd1, 0xcd, 0xaf, 0x55, 0xbf, 0xf9, 0x1a, 0x7b,
+	0x3c, 0xc9, 0xae, 0xfc, 0xf9, 0xb5, 0x2a, 0x4e, 0x31, 0xd8, 0xf9, 0x96, 0x81, 0x1e, 0x00, 0xfb,
+	0x73, 0x1a, 0x2e, 0x40, 0xfc, 0x6d, 0x4e, 0xe5, 0x4b, 0x09, 0xe8, 0xaf, 0xd0, 0x6d, 0x86, 0xdf,
+	0xaf, 0xd3, 0xed, 0xf9, 0x96, 0x31, 0xf5, 0x67, 0x71, 0x18, 0xbb, 0x65, 0x38, 0xc1, 0x58, 0xfd,
+	0xa1, 0x11, 0x18, 0x0e, 0x9f, 0xeb, 0xc1, 0x24, 0xbd, 0x7c, 0xc0, 0x89, 0x7e, 0xf0, 0x34, 0x0d,
+	0x91, 0x30, 0xe5, 0x97, 0x9f, 0x28, 0x76, 0x5e, 0x58, 0xb6, 0x4e, 0x6d, 0xf9, 0x9c, 0x54, 0xfc,
+	0x40, 0x79, 0xe8, 0x13, 0x7f, 0x11, 0xc2, 0x53, 0x99, 0xdc, 0x71, 0xb8, 0x90, 0x50, 0xbf, 0x48,
+	0x61, 0xd1, 0xcc, 0x5e, 0xd8, 0x36, 0xd9, 0x69, 0x26, 0xb2, 0x99, 0xfc, 0x7b, 0xea, 0x6f, 0x15,
+	0x18, 0x5d, 0xe9, 0xb1, 0x52, 0x17, 0x8e, 0xb7, 0x9d, 0xa2, 0x45, 0x80, 0xaf, 0x72, 0x2b, 0xfd,
+	0x87, 0x02, 0x23, 0xbe, 0x9c, 0x55, 0xda, 0x68, 0xd6, 0x99, 0xfb, 0xf3, 0x75, 0x51, 0x0f, 0x4d,
+	0xc3, 0x40, 0x83, 0x34, 0x79, 0x55, 0x92, 0x9d, 0xca, 0x89, 0x70, 0xaa, 0x51, 0xc7, 0x20, 0xfb,
+	0x6e, 0xd2, 0xdd, 0xa9, 0x75, 0x38, 0xd5, 0x35, 0x0e, 0x71, 0x61, 0xfb, 0x89, 0x4a, 0x25, 0xca,
+	0xdd, 0x33, 0x51, 0x19, 0x0f, 0x27, 0x2a, 0x3f, 0x53, 0x22, 0x89, 0xca, 0xa9, 0xff, 0x55, 0x40,
+	0xdd, 0x47, 0x88, 0x83, 0x3e, 0x80, 0x94, 0x70, 0x0a, 0xbc, 0xa3, 0xfd, 0xf5, 0x7d, 0x0d, 0xd6,
+	0xc1, 0x5a, 0x94, 0xff, 0x3f, 0x4f, 0xf2, 0xc0, 0x93, 0x39, 0x51, 0x83, 0x6c, 0x18, 0xa6, 0xc7,
+	0x3d, 0xf6, 0x66, 0xf4, 0x1e, 0x7b, 0xe5, 0x88, 0xea, 0x85, 0xae, 0xb5, 0xa9, 0x9f, 0x28, 0x50,
+	0x98, 0xb3, 0xcc, 0x6d, 0x6a, 0xbb, 0x5d, 0xd4, 0xde, 0xd2, 0x5e, 0x86, 0x8c, 0xd0, 0x29, 0x28,
+	0x3b, 0x5d, 0x39, 0xfa, 0x33, 0xef, 0xb4, 0x10, 0x5a, 0xad, 0xe0, 0xb4, 0x40, 0xa9, 0xf2, 0xa7,
+	0xeb, 0xdc, 0xdf, 0xe1, 0x07, 0x15, 0xe6, 0xdf, 0x17, 0x1e, 0x2b, 0x10, 0x79, 0xf9, 0x82, 0x54,
+	0x38, 0x51, 0xaa, 0x60, 0xad, 0x74, 0xeb, 0xfa, 0x6d, 0x5c, 0x5d, 0x7d, 0x6b, 0x51, 0x5b, 0x2c,
+	0xe1, 0xeb, 0xd5, 0xa5, 0x5c, 0x0c, 0xe5, 0x61, 0x22, 0xda, 0x33, 0x77, 0x7b, 0x69, 0x65, 0x1e,
+	0xdf, 0x29, 0xad, 0x56, 0xef, 0xcc, 0xe7, 0x14, 0x74, 0x0a, 0x46, 0xa3, 0xfd, 0xe5, 0x5b, 0xd5,
+	0xa5, 0x4a, 0x2e, 0xde, 0xdd, 0xb1, 0x50, 0xfd, 0xc1, 0x7c, 0x25, 0x97, 0x98, 0x48, 0x7e, 0xf8,
+	0x0f, 0xf9, 0xd8, 0x85, 0x05, 0x80, 0x20, 0x8e, 0x40, 0x23, 0x30, 0xb8, 0x7c, 0xfb, 0xee, 0x3c,
+	0xd6, 0xd6, 0x96, 0x6e, 0x2e, 0xdd, 0xbe, 0xcb, 0x04, 0xfb, 0x4d, 0xe5, 0xd2, 0xea, 0xea, 0x3c,
+	0xfe, 0x61, 0x4e, 0x41, 0x08, 0x86, 0x44, 0xd3, 0xfc, 0x0f, 0x56, 0xe7, 0xf1, 0x52, 0xe9, 0x56,
+	0x2e, 0x5e, 0xfe, 0x7b, 0xe5, 0xb3, 0x27, 0x79, 0xe5, 0xf3, 0x27, 0x79, 0xe5, 0x57, 0x4f, 0xf2,
+	0xb1, 0xdf, 0x3c, 0xc9, 0xc7, 0xbe, 0x78, 0x92, 0x8f, 0xfd, 0xf6, 0x49, 0x3e, 0xf6, 0xbb, 0x27,
+	0x79, 0xe5, 0x71, 0x3b, 0xaf, 0x7c, 0xd8, 0xce, 0xc7, 0x7e, 0xde, 0xce, 0x2b, 0xbf, 0x68, 0xe7,
+	0x63, 0x9f, 0xb4, 0xf3, 0xb1, 0x4f, 0xdb, 0xf9, 0xd8, 0x67, 0xed, 0xbc, 0xf2, 0x79, 0x3b, 0xaf,
+	0xfc, 0xaa, 0x9d, 0x8f, 0xfd, 0xa6, 0x9d, 0x57, 0xbe, 0x68, 0xe7, 0x63, 0xbf, 0x6d, 0xe7, 0x95,
+	0xdf, 0xb5, 0xf3, 0xb1, 0xc7, 0x4f, 0xf3, 0xb1, 0x0f, 0x9f, 0xe6, 0x95, 0x9f, 0x3d, 0xcd, 0xc7,
+	0x3e, 0x7a, 0x9a, 0x57, 0x3e, 0x7e, 0x9a, 0x8f, 0xfd, 0xfc, 0x69, 0x3e, 0xf6, 0x8b, 0xa7, 0x79,
+	0xe5, 0x93, 0xa7, 0x79, 0xe5, 0xd3, 0xa7, 0x79, 0xe5, 0xde, 0xc5, 0xa3, 0x1e, 0xf4, 0xae, 0xd9,
+	0x5c, 0x5f, 0xef, 0xe7, 0xbb, 0xf5, 0xca, 0xff, 0x0d, 0x00, 0x57, 0x0f, 0x58, 0xeb, 0x29, 0x3c,
+	0x00, 0x00,
+}
+
+func (x ADRAlgorithm) String() string {
+	s, ok := ADRAlgorithm_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
 func (x PowerState) String() string {
 	s, ok := PowerState_name[int32(x)]
 	if ok {
@@ -3372,6 +3756,12 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if !this.DesiredRx2Frequency.Equal(that1.DesiredRx2Frequency) {
 		return false
 	}
+	if !this.ADRAlgorithm.Equal(that1.ADRAlgorithm) {
+		return false
+	}
+	if !this.ADRFixedDataRateIndex.Equal(that1.ADRFixedDataRateIndex) {
+		return false
+	}
 	return true
 }
 func (this *MACSettings_DataRateIndexValue) Equal(that interface{}) bool {
@@ -3470,6 +3860,30 @@ func (this *MACSettings_RxDelayValue) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MACSettings_ADRAlgorithmValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACSettings_ADRAlgorithmValue)
+	if !ok {
+		that2, ok := that.(MACSettings_ADRAlgorithmValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
 func (this *MACState) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3542,11 +3956,22 @@ func (this *MACState) Equal(that interface{}) bool {
 	if this.RxWindowsAvailable != that1.RxWindowsAvailable {
 		return false
 	}
-	return true
-}
-func (this *MACState_JoinAccept) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if len(this.QueuedOperatorCommands) != len(that1.QueuedOperatorCommands) {
+		return false
+	}
+	for i := range this.QueuedOperatorCommands {
+		if !this.QueuedOperatorCommands[i].Equal(that1.QueuedOperatorCommands[i]) {
+			return false
+		}
+	}
+	if !this.ChannelPlanReconciliation.Equal(that1.ChannelPlanReconciliation) {
+		return false
+	}
+	return true
+}
+func (this *MACState_JoinAccept) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
 	that1, ok := that.(*MACState_JoinAccept)
@@ -3574,6 +3999,115 @@ func (this *MACState_JoinAccept) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MACState_ChannelPlanReconciliation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACState_ChannelPlanReconciliation)
+	if !ok {
+		that2, ok := that.(MACState_ChannelPlanReconciliation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if !this.StartedAt.Equal(that1.StartedAt) {
+		return false
+	}
+	if this.PendingChannels != that1.PendingChannels {
+		return false
+	}
+	if that1.CompletedAt == nil {
+		if this.CompletedAt != nil {
+			return false
+		}
+	} else if !this.CompletedAt.Equal(*that1.CompletedAt) {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceAirtimeUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceAirtimeUsage)
+	if !ok {
+		that2, ok := that.(EndDeviceAirtimeUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Buckets) != len(that1.Buckets) {
+		return false
+	}
+	for i := range this.Buckets {
+		if !this.Buckets[i].Equal(that1.Buckets[i]) {
+			return false
+		}
+	}
+	if that1.ExceededAt == nil {
+		if this.ExceededAt != nil {
+			return false
+		}
+	} else if !this.ExceededAt.Equal(*that1.ExceededAt) {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceAirtimeUsage_Bucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceAirtimeUsage_Bucket)
+	if !ok {
+		that2, ok := that.(EndDeviceAirtimeUsage_Bucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Start.Equal(that1.Start) {
+		return false
+	}
+	if this.UplinkAirtime != that1.UplinkAirtime {
+		return false
+	}
+	if this.DownlinkAirtime != that1.DownlinkAirtime {
+		return false
+	}
+	if this.Uplinks != that1.Uplinks {
+		return false
+	}
+	if this.Downlinks != that1.Downlinks {
+		return false
+	}
+	return true
+}
 func (this *EndDeviceAuthenticationCode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3821,6 +4355,9 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if !this.ClaimAuthenticationCode.Equal(that1.ClaimAuthenticationCode) {
 		return false
 	}
+	if !this.AirtimeUsage.Equal(that1.AirtimeUsage) {
+		return false
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -4884,6 +5421,30 @@ func (m *MACSettings) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n30
 	}
+	if m.ADRAlgorithm != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRAlgorithm.Size()))
+		n31, err := m.ADRAlgorithm.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
+	}
+	if m.ADRFixedDataRateIndex != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ADRFixedDataRateIndex.Size()))
+		n32, err := m.ADRFixedDataRateIndex.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
 	return i, nil
 }
 
@@ -4979,6 +5540,29 @@ func (m *MACSettings_RxDelayValue) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *MACSettings_ADRAlgorithmValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACSettings_ADRAlgorithmValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Value))
+	}
+	return i, nil
+}
+
 func (m *MACState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4997,19 +5581,19 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.CurrentParameters.Size()))
-	n31, err := m.CurrentParameters.MarshalTo(dAtA[i:])
+	n33, err := m.CurrentParameters.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n33
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.DesiredParameters.Size()))
-	n32, err := m.DesiredParameters.MarshalTo(dAtA[i:])
+	n34, err := m.DesiredParameters.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n34
 	if m.DeviceClass != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -5024,11 +5608,11 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt)))
-		n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i:])
+		n35, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n35
 	}
 	if m.LastDevStatusFCntUp != 0 {
 		dAtA[i] = 0x30
@@ -5044,11 +5628,11 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingApplicationDownlink.Size()))
-		n34, err := m.PendingApplicationDownlink.MarshalTo(dAtA[i:])
+		n36, err := m.PendingApplicationDownlink.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n36
 	}
 	if len(m.QueuedResponses) > 0 {
 		for _, msg := range m.QueuedResponses {
@@ -5078,21 +5662,21 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.QueuedJoinAccept.Size()))
-		n35, err := m.QueuedJoinAccept.MarshalTo(dAtA[i:])
+		n37, err := m.QueuedJoinAccept.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n37
 	}
 	if m.PendingJoinRequest != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingJoinRequest.Size()))
-		n36, err := m.PendingJoinRequest.MarshalTo(dAtA[i:])
+		n38, err := m.PendingJoinRequest.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n38
 	}
 	if m.RxWindowsAvailable {
 		dAtA[i] = 0x68
@@ -5104,6 +5688,28 @@ func (m *MACState) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.QueuedOperatorCommands) > 0 {
+		for _, msg := range m.QueuedOperatorCommands {
+			dAtA[i] = 0x72
+			i++
+			i = encodeVarintEndDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ChannelPlanReconciliation != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.ChannelPlanReconciliation.Size()))
+		n39, err := m.ChannelPlanReconciliation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
 	return i, nil
 }
 
@@ -5131,23 +5737,23 @@ func (m *MACState_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.Request.Size()))
-	n37, err := m.Request.MarshalTo(dAtA[i:])
+	n40, err := m.Request.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n40
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.Keys.Size()))
-	n38, err := m.Keys.MarshalTo(dAtA[i:])
+	n41, err := m.Keys.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n41
 	return i, nil
 }
 
-func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
+func (m *MACState_ChannelPlanReconciliation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5157,41 +5763,84 @@ func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDeviceAuthenticationCode) MarshalTo(dAtA []byte) (int, error) {
+func (m *MACState_ChannelPlanReconciliation) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
+	if len(m.FrequencyPlanID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i += copy(dAtA[i:], m.FrequencyPlanID)
 	}
-	if m.ValidFrom != nil {
-		dAtA[i] = 0x12
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)))
+	n42, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n42
+	if m.PendingChannels != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom)))
-		n39, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i:])
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingChannels))
+	}
+	if m.CompletedAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletedAt)))
+		n43, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.CompletedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n43
 	}
-	if m.ValidTo != nil {
-		dAtA[i] = 0x1a
+	return i, nil
+}
+
+func (m *EndDeviceAirtimeUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceAirtimeUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, msg := range m.Buckets {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEndDevice(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ExceededAt != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo)))
-		n40, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i:])
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExceededAt)))
+		n44, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExceededAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n44
 	}
 	return i, nil
 }
 
-func (m *EndDevice) Marshal() (dAtA []byte, err error) {
+func (m *EndDeviceAirtimeUsage_Bucket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5201,73 +5850,169 @@ func (m *EndDevice) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
+func (m *EndDeviceAirtimeUsage_Bucket) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n41, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)))
+	n45, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n45
 	dAtA[i] = 0x12
 	i++
-	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n42, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)))
+	n46, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtime, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n46
 	dAtA[i] = 0x1a
 	i++
-	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
-	n43, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)))
+	n47, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DownlinkAirtime, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x22
+	i += n47
+	if m.Uplinks != 0 {
+		dAtA[i] = 0x20
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Uplinks))
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x2a
+	if m.Downlinks != 0 {
+		dAtA[i] = 0x28
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Downlinks))
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			dAtA[i] = 0x32
-			i++
-			v := m.Attributes[k]
-			mapSize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
-			i = encodeVarintEndDevice(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
-		}
+	return i, nil
+}
+
+func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.VersionIDs != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(m.VersionIDs.Size()))
-		n44, err := m.VersionIDs.MarshalTo(dAtA[i:])
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceAuthenticationCode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.ValidFrom != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom)))
+		n48, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n48
+	}
+	if m.ValidTo != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo)))
+		n49, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
+}
+
+func (m *EndDevice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
+	n50, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n50
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
+	n51, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n51
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
+	n52, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n52
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			dAtA[i] = 0x32
+			i++
+			v := m.Attributes[k]
+			mapSize := 1 + len(k) + sovEndDevice(uint64(len(k))) + 1 + len(v) + sovEndDevice(uint64(len(v)))
+			i = encodeVarintEndDevice(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintEndDevice(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.VersionIDs != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.VersionIDs.Size()))
+		n53, err := m.VersionIDs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
 	}
 	if len(m.ServiceProfileID) > 0 {
 		dAtA[i] = 0x42
@@ -5313,11 +6058,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintEndDevice(dAtA, i, uint64(v.Size()))
-				n45, err := v.MarshalTo(dAtA[i:])
+				n54, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n45
+				i += n54
 			}
 		}
 	}
@@ -5405,11 +6150,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.RootKeys.Size()))
-		n46, err := m.RootKeys.MarshalTo(dAtA[i:])
+		n55, err := m.RootKeys.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n55
 	}
 	if m.NetID != nil {
 		dAtA[i] = 0xba
@@ -5417,11 +6162,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.NetID.Size()))
-		n47, err := m.NetID.MarshalTo(dAtA[i:])
+		n56, err := m.NetID.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n56
 	}
 	if m.MACSettings != nil {
 		dAtA[i] = 0xc2
@@ -5429,11 +6174,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.MACSettings.Size()))
-		n48, err := m.MACSettings.MarshalTo(dAtA[i:])
+		n57, err := m.MACSettings.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n57
 	}
 	if m.MACState != nil {
 		dAtA[i] = 0xca
@@ -5441,11 +6186,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.MACState.Size()))
-		n49, err := m.MACState.MarshalTo(dAtA[i:])
+		n58, err := m.MACState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n58
 	}
 	if m.Session != nil {
 		dAtA[i] = 0xd2
@@ -5453,11 +6198,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.Session.Size()))
-		n50, err := m.Session.MarshalTo(dAtA[i:])
+		n59, err := m.Session.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n59
 	}
 	if m.PendingSession != nil {
 		dAtA[i] = 0xda
@@ -5465,11 +6210,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingSession.Size()))
-		n51, err := m.PendingSession.MarshalTo(dAtA[i:])
+		n60, err := m.PendingSession.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n60
 	}
 	if m.LastDevNonce != 0 {
 		dAtA[i] = 0xe0
@@ -5479,23 +6224,23 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.LastDevNonce))
 	}
 	if len(m.UsedDevNonces) > 0 {
-		dAtA53 := make([]byte, len(m.UsedDevNonces)*10)
-		var j52 int
+		dAtA62 := make([]byte, len(m.UsedDevNonces)*10)
+		var j61 int
 		for _, num := range m.UsedDevNonces {
 			for num >= 1<<7 {
-				dAtA53[j52] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA62[j61] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j52++
+				j61++
 			}
-			dAtA53[j52] = uint8(num)
-			j52++
+			dAtA62[j61] = uint8(num)
+			j61++
 		}
 		dAtA[i] = 0xea
 		i++
 		dAtA[i] = 0x1
 		i++
-		i = encodeVarintEndDevice(dAtA, i, uint64(j52))
-		i += copy(dAtA[i:], dAtA53[:j52])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j61))
+		i += copy(dAtA[i:], dAtA62[:j61])
 	}
 	if m.LastJoinNonce != 0 {
 		dAtA[i] = 0xf0
@@ -5524,11 +6269,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt)))
-		n54, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
+		n63, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n63
 	}
 	if m.PowerState != 0 {
 		dAtA[i] = 0x90
@@ -5543,11 +6288,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.BatteryPercentage.Size()))
-		n55, err := m.BatteryPercentage.MarshalTo(dAtA[i:])
+		n64, err := m.BatteryPercentage.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n64
 	}
 	if m.DownlinkMargin != 0 {
 		dAtA[i] = 0xa0
@@ -5618,11 +6363,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.Formatters.Size()))
-		n56, err := m.Formatters.MarshalTo(dAtA[i:])
+		n65, err := m.Formatters.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n65
 	}
 	if len(m.ProvisionerID) > 0 {
 		dAtA[i] = 0xd2
@@ -5638,11 +6383,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.ProvisioningData.Size()))
-		n57, err := m.ProvisioningData.MarshalTo(dAtA[i:])
+		n66, err := m.ProvisioningData.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n66
 	}
 	if m.PendingMACState != nil {
 		dAtA[i] = 0xe2
@@ -5650,11 +6395,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.PendingMACState.Size()))
-		n58, err := m.PendingMACState.MarshalTo(dAtA[i:])
+		n67, err := m.PendingMACState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n67
 	}
 	if m.Multicast {
 		dAtA[i] = 0xe8
@@ -5674,11 +6419,11 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintEndDevice(dAtA, i, uint64(m.ClaimAuthenticationCode.Size()))
-		n59, err := m.ClaimAuthenticationCode.MarshalTo(dAtA[i:])
+		n68, err := m.ClaimAuthenticationCode.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n68
 	}
 	if len(m.NetworkServerKEKLabel) > 0 {
 		dAtA[i] = 0xfa
@@ -5704,6 +6449,18 @@ func (m *EndDevice) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.ApplicationServerID)))
 		i += copy(dAtA[i:], m.ApplicationServerID)
 	}
+	if m.AirtimeUsage != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.AirtimeUsage.Size()))
+		n69, err := m.AirtimeUsage.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
 	return i, nil
 }
 
@@ -5755,11 +6512,11 @@ func (m *CreateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n60, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n70, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n60
+	i += n70
 	return i, nil
 }
 
@@ -5781,19 +6538,19 @@ func (m *UpdateEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n61, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n71, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n61
+	i += n71
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n62, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n72, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n62
+	i += n72
 	return i, nil
 }
 
@@ -5815,19 +6572,19 @@ func (m *GetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n63, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n73, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n63
+	i += n73
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n64, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n74, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n64
+	i += n74
 	return i, nil
 }
 
@@ -5849,19 +6606,19 @@ func (m *GetEndDeviceIdentifiersForEUIsRequest) MarshalTo(dAtA []byte) (int, err
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.JoinEUI.Size()))
-	n65, err := m.JoinEUI.MarshalTo(dAtA[i:])
+	n75, err := m.JoinEUI.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n65
+	i += n75
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.DevEUI.Size()))
-	n66, err := m.DevEUI.MarshalTo(dAtA[i:])
+	n76, err := m.DevEUI.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n66
+	i += n76
 	return i, nil
 }
 
@@ -5883,19 +6640,19 @@ func (m *ListEndDevicesRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.ApplicationIdentifiers.Size()))
-	n67, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
+	n77, err := m.ApplicationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n67
+	i += n77
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n68, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n78, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n68
+	i += n78
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -5933,19 +6690,19 @@ func (m *SetEndDeviceRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n69, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n79, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n69
+	i += n79
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n70, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n80, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n70
+	i += n80
 	return i, nil
 }
 
@@ -5967,19 +6724,19 @@ func (m *EndDeviceTemplate) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.EndDevice.Size()))
-	n71, err := m.EndDevice.MarshalTo(dAtA[i:])
+	n81, err := m.EndDevice.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n71
+	i += n81
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintEndDevice(dAtA, i, uint64(m.FieldMask.Size()))
-	n72, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n82, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n72
+	i += n82
 	if len(m.MappingKey) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -6054,11 +6811,11 @@ func (m *EndDeviceTemplateFormats) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintEndDevice(dAtA, i, uint64(v.Size()))
-				n73, err := v.MarshalTo(dAtA[i:])
+				n83, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n73
+				i += n83
 			}
 		}
 	}
@@ -6224,6 +6981,12 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(10) != 0 {
 		this.DesiredRx2Frequency = types.NewPopulatedUInt64Value(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		this.ADRAlgorithm = NewPopulatedMACSettings_ADRAlgorithmValue(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.ADRFixedDataRateIndex = NewPopulatedMACSettings_DataRateIndexValue(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6261,6 +7024,14 @@ func NewPopulatedMACSettings_RxDelayValue(r randyEndDevice, easy bool) *MACSetti
 	return this
 }
 
+func NewPopulatedMACSettings_ADRAlgorithmValue(r randyEndDevice, easy bool) *MACSettings_ADRAlgorithmValue {
+	this := &MACSettings_ADRAlgorithmValue{}
+	this.Value = ADRAlgorithm([]int32{0, 1, 2, 3}[r.Intn(4)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_JoinAccept {
 	this := &MACState_JoinAccept{}
 	v6 := r.Intn(100)
@@ -6277,12 +7048,58 @@ func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_Join
 	return this
 }
 
+func NewPopulatedMACState_ChannelPlanReconciliation(r randyEndDevice, easy bool) *MACState_ChannelPlanReconciliation {
+	this := &MACState_ChannelPlanReconciliation{}
+	this.FrequencyPlanID = randStringEndDevice(r)
+	v9 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v9
+	this.PendingChannels = r.Uint32()
+	if r.Intn(10) != 0 {
+		this.CompletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceAirtimeUsage(r randyEndDevice, easy bool) *EndDeviceAirtimeUsage {
+	this := &EndDeviceAirtimeUsage{}
+	if r.Intn(10) != 0 {
+		v10 := r.Intn(5)
+		this.Buckets = make([]*EndDeviceAirtimeUsage_Bucket, v10)
+		for i := 0; i < v10; i++ {
+			this.Buckets[i] = NewPopulatedEndDeviceAirtimeUsage_Bucket(r, easy)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.ExceededAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceAirtimeUsage_Bucket(r randyEndDevice, easy bool) *EndDeviceAirtimeUsage_Bucket {
+	this := &EndDeviceAirtimeUsage_Bucket{}
+	v11 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Start = *v11
+	v12 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.UplinkAirtime = *v12
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.DownlinkAirtime = *v13
+	this.Uplinks = r.Uint32()
+	this.Downlinks = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 	this := &EndDevices{}
 	if r.Intn(10) != 0 {
-		v9 := r.Intn(5)
-		this.EndDevices = make([]*EndDevice, v9)
-		for i := 0; i < v9; i++ {
+		v14 := r.Intn(5)
+		this.EndDevices = make([]*EndDevice, v14)
+		for i := 0; i < v14; i++ {
 			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
 		}
 	}
@@ -6293,8 +7110,8 @@ func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 
 func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
 	this := &CreateEndDeviceRequest{}
-	v10 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v10
+	v15 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v15
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6302,10 +7119,10 @@ func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndD
 
 func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
 	this := &UpdateEndDeviceRequest{}
-	v11 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v16 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6313,10 +7130,10 @@ func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndD
 
 func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
 	this := &GetEndDeviceRequest{}
-	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v18 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6324,10 +7141,10 @@ func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceR
 
 func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
 	this := &GetEndDeviceIdentifiersForEUIsRequest{}
-	v15 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v15
-	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v16
+	v20 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v20
+	v21 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6335,10 +7152,10 @@ func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bo
 
 func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
 	this := &ListEndDevicesRequest{}
-	v17 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	this.Order = randStringEndDevice(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -6349,10 +7166,10 @@ func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevi
 
 func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
 	this := &SetEndDeviceRequest{}
-	v19 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v24 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v24
+	v25 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6360,10 +7177,10 @@ func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceR
 
 func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
 	this := &EndDeviceTemplate{}
-	v21 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v21
-	v22 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v22
+	v26 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v26
+	v27 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v27
 	this.MappingKey = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -6382,9 +7199,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
 	this := &EndDeviceTemplateFormats{}
 	if r.Intn(10) != 0 {
-		v23 := r.Intn(10)
+		v28 := r.Intn(10)
 		this.Formats = make(map[string]*EndDeviceTemplateFormat)
-		for i := 0; i < v23; i++ {
+		for i := 0; i < v28; i++ {
 			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
 		}
 	}
@@ -6396,9 +7213,9 @@ func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDevic
 func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
 	this := &ConvertEndDeviceTemplateRequest{}
 	this.FormatID = randStringEndDevice(r)
-	v24 := r.Intn(100)
-	this.Data = make([]byte, v24)
-	for i := 0; i < v24; i++ {
+	v29 := r.Intn(100)
+	this.Data = make([]byte, v29)
+	for i := 0; i < v29; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -6425,9 +7242,9 @@ func randUTF8RuneEndDevice(r randyEndDevice) rune {
 	return rune(ru + 61)
 }
 func randStringEndDevice(r randyEndDevice) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v30 := r.Intn(100)
+	tmps := make([]rune, v30)
+	for i := 0; i < v30; i++ {
 		tmps[i] = randUTF8RuneEndDevice(r)
 	}
 	return string(tmps)
@@ -6449,11 +7266,11 @@ func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v26 := r.Int63()
+		v31 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v31 *= -1
 		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v31))
 	case 1:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -6817,6 +7634,14 @@ func (m *MACSettings) Size() (n int) {
 		l = m.DesiredRx2Frequency.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.ADRAlgorithm != nil {
+		l = m.ADRAlgorithm.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRFixedDataRateIndex != nil {
+		l = m.ADRFixedDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -6868,6 +7693,18 @@ func (m *MACSettings_RxDelayValue) Size() (n int) {
 	return n
 }
 
+func (m *MACSettings_ADRAlgorithmValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovEndDevice(uint64(m.Value))
+	}
+	return n
+}
+
 func (m *MACState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6921,6 +7758,16 @@ func (m *MACState) Size() (n int) {
 	if m.RxWindowsAvailable {
 		n += 2
 	}
+	if len(m.QueuedOperatorCommands) > 0 {
+		for _, e := range m.QueuedOperatorCommands {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.ChannelPlanReconciliation != nil {
+		l = m.ChannelPlanReconciliation.Size()
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -6941,6 +7788,68 @@ func (m *MACState_JoinAccept) Size() (n int) {
 	return n
 }
 
+func (m *MACState_ChannelPlanReconciliation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.PendingChannels != 0 {
+		n += 1 + sovEndDevice(uint64(m.PendingChannels))
+	}
+	if m.CompletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.CompletedAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceAirtimeUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.ExceededAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExceededAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceAirtimeUsage_Bucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)
+	n += 1 + l + sovEndDevice(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.Uplinks != 0 {
+		n += 1 + sovEndDevice(uint64(m.Uplinks))
+	}
+	if m.Downlinks != 0 {
+		n += 1 + sovEndDevice(uint64(m.Downlinks))
+	}
+	return n
+}
+
 func (m *EndDeviceAuthenticationCode) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7167,6 +8076,10 @@ func (m *EndDevice) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.AirtimeUsage != nil {
+		l = m.AirtimeUsage.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -7501,6 +8414,8 @@ func (this *MACSettings) String() string {
 		`DesiredRx1DataRateOffset:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx1DataRateOffset), "UInt32Value", "types.UInt32Value", 1) + `,`,
 		`DesiredRx2DataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2DataRateIndex), "MACSettings_DataRateIndexValue", "MACSettings_DataRateIndexValue", 1) + `,`,
 		`DesiredRx2Frequency:` + strings.Replace(fmt.Sprintf("%v", this.DesiredRx2Frequency), "UInt64Value", "types.UInt64Value", 1) + `,`,
+		`ADRAlgorithm:` + strings.Replace(fmt.Sprintf("%v", this.ADRAlgorithm), "MACSettings_ADRAlgorithmValue", "MACSettings_ADRAlgorithmValue", 1) + `,`,
+		`ADRFixedDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.ADRFixedDataRateIndex), "MACSettings_DataRateIndexValue", "MACSettings_DataRateIndexValue", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7545,6 +8460,16 @@ func (this *MACSettings_RxDelayValue) String() string {
 	}, "")
 	return s
 }
+func (this *MACSettings_ADRAlgorithmValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MACSettings_ADRAlgorithmValue{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MACState) String() string {
 	if this == nil {
 		return "nil"
@@ -7563,6 +8488,8 @@ func (this *MACState) String() string {
 		`QueuedJoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.QueuedJoinAccept), "MACState_JoinAccept", "MACState_JoinAccept", 1) + `,`,
 		`PendingJoinRequest:` + strings.Replace(fmt.Sprintf("%v", this.PendingJoinRequest), "JoinRequest", "JoinRequest", 1) + `,`,
 		`RxWindowsAvailable:` + fmt.Sprintf("%v", this.RxWindowsAvailable) + `,`,
+		`QueuedOperatorCommands:` + strings.Replace(fmt.Sprintf("%v", this.QueuedOperatorCommands), "MACCommand", "MACCommand", 1) + `,`,
+		`ChannelPlanReconciliation:` + strings.Replace(fmt.Sprintf("%v", this.ChannelPlanReconciliation), "MACState_ChannelPlanReconciliation", "MACState_ChannelPlanReconciliation", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7579,27 +8506,65 @@ func (this *MACState_JoinAccept) String() string {
 	}, "")
 	return s
 }
-func (this *EndDeviceAuthenticationCode) String() string {
+func (this *MACState_ChannelPlanReconciliation) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&EndDeviceAuthenticationCode{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`ValidFrom:` + strings.Replace(fmt.Sprintf("%v", this.ValidFrom), "Timestamp", "types.Timestamp", 1) + `,`,
-		`ValidTo:` + strings.Replace(fmt.Sprintf("%v", this.ValidTo), "Timestamp", "types.Timestamp", 1) + `,`,
+	s := strings.Join([]string{`&MACState_ChannelPlanReconciliation{`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(this.StartedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`PendingChannels:` + fmt.Sprintf("%v", this.PendingChannels) + `,`,
+		`CompletedAt:` + strings.Replace(fmt.Sprintf("%v", this.CompletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *EndDevice) String() string {
+func (this *EndDeviceAirtimeUsage) String() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForAttributes := make([]string, 0, len(this.Attributes))
-	for k := range this.Attributes {
-		keysForAttributes = append(keysForAttributes, k)
+	s := strings.Join([]string{`&EndDeviceAirtimeUsage{`,
+		`Buckets:` + strings.Replace(fmt.Sprintf("%v", this.Buckets), "EndDeviceAirtimeUsage_Bucket", "EndDeviceAirtimeUsage_Bucket", 1) + `,`,
+		`ExceededAt:` + strings.Replace(fmt.Sprintf("%v", this.ExceededAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceAirtimeUsage_Bucket) String() string {
+	if this == nil {
+		return "nil"
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+	s := strings.Join([]string{`&EndDeviceAirtimeUsage_Bucket{`,
+		`Start:` + strings.Replace(strings.Replace(this.Start.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UplinkAirtime:` + strings.Replace(strings.Replace(this.UplinkAirtime.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`DownlinkAirtime:` + strings.Replace(strings.Replace(this.DownlinkAirtime.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Uplinks:` + fmt.Sprintf("%v", this.Uplinks) + `,`,
+		`Downlinks:` + fmt.Sprintf("%v", this.Downlinks) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceAuthenticationCode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceAuthenticationCode{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValidFrom:` + strings.Replace(fmt.Sprintf("%v", this.ValidFrom), "Timestamp", "types.Timestamp", 1) + `,`,
+		`ValidTo:` + strings.Replace(fmt.Sprintf("%v", this.ValidTo), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDevice) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAttributes := make([]string, 0, len(this.Attributes))
+	for k := range this.Attributes {
+		keysForAttributes = append(keysForAttributes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
 	mapStringForAttributes := "map[string]string{"
 	for _, k := range keysForAttributes {
 		mapStringForAttributes += fmt.Sprintf("%v: %v,", k, this.Attributes[k])
@@ -7665,6 +8630,7 @@ func (this *EndDevice) String() string {
 		`NetworkServerKEKLabel:` + fmt.Sprintf("%v", this.NetworkServerKEKLabel) + `,`,
 		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
 		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
+		`AirtimeUsage:` + strings.Replace(fmt.Sprintf("%v", this.AirtimeUsage), "EndDeviceAirtimeUsage", "EndDeviceAirtimeUsage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10352,6 +11318,78 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRAlgorithm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRAlgorithm == nil {
+				m.ADRAlgorithm = &MACSettings_ADRAlgorithmValue{}
+			}
+			if err := m.ADRAlgorithm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRFixedDataRateIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRFixedDataRateIndex == nil {
+				m.ADRFixedDataRateIndex = &MACSettings_DataRateIndexValue{}
+			}
+			if err := m.ADRFixedDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -10664,6 +11702,78 @@ func (m *MACSettings_RxDelayValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MACSettings_ADRAlgorithmValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ADRAlgorithmValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ADRAlgorithmValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= ADRAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MACState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10811,69 +11921,573 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastConfirmedDownlinkAt == nil {
+				m.LastConfirmedDownlinkAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastConfirmedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusFCntUp", wireType)
+			}
+			m.LastDevStatusFCntUp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDevStatusFCntUp |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
+			}
+			m.PingSlotPeriodicity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PingSlotPeriodicity |= PingSlotPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingApplicationDownlink == nil {
+				m.PendingApplicationDownlink = &ApplicationDownlink{}
+			}
+			if err := m.PendingApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedResponses = append(m.QueuedResponses, &MACCommand{})
+			if err := m.QueuedResponses[len(m.QueuedResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRequests = append(m.PendingRequests, &MACCommand{})
+			if err := m.PendingRequests[len(m.PendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJoinAccept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueuedJoinAccept == nil {
+				m.QueuedJoinAccept = &MACState_JoinAccept{}
+			}
+			if err := m.QueuedJoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingJoinRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingJoinRequest == nil {
+				m.PendingJoinRequest = &JoinRequest{}
+			}
+			if err := m.PendingJoinRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RxWindowsAvailable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RxWindowsAvailable = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedOperatorCommands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedOperatorCommands = append(m.QueuedOperatorCommands, &MACCommand{})
+			if err := m.QueuedOperatorCommands[len(m.QueuedOperatorCommands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPlanReconciliation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChannelPlanReconciliation == nil {
+				m.ChannelPlanReconciliation = &MACState_ChannelPlanReconciliation{}
+			}
+			if err := m.ChannelPlanReconciliation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JoinAccept: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JoinAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Keys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACState_ChannelPlanReconciliation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPlanReconciliation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPlanReconciliation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastConfirmedDownlinkAt == nil {
-				m.LastConfirmedDownlinkAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastConfirmedDownlinkAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDevStatusFCntUp", wireType)
-			}
-			m.LastDevStatusFCntUp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastDevStatusFCntUp |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingSlotPeriodicity", wireType)
-			}
-			m.PingSlotPeriodicity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PingSlotPeriodicity |= PingSlotPeriod(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplicationDownlink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10900,18 +12514,15 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingApplicationDownlink == nil {
-				m.PendingApplicationDownlink = &ApplicationDownlink{}
-			}
-			if err := m.PendingApplicationDownlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedResponses", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChannels", wireType)
 			}
-			var msglen int
+			m.PendingChannels = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -10921,29 +12532,14 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PendingChannels |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.QueuedResponses = append(m.QueuedResponses, &MACCommand{})
-			if err := m.QueuedResponses[len(m.QueuedResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10970,14 +12566,69 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingRequests = append(m.PendingRequests, &MACCommand{})
-			if err := m.PendingRequests[len(m.PendingRequests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CompletedAt == nil {
+				m.CompletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.CompletedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceAirtimeUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceAirtimeUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceAirtimeUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueuedJoinAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11004,16 +12655,14 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueuedJoinAccept == nil {
-				m.QueuedJoinAccept = &MACState_JoinAccept{}
-			}
-			if err := m.QueuedJoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, &EndDeviceAirtimeUsage_Bucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingJoinRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExceededAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11040,33 +12689,13 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingJoinRequest == nil {
-				m.PendingJoinRequest = &JoinRequest{}
+			if m.ExceededAt == nil {
+				m.ExceededAt = new(time.Time)
 			}
-			if err := m.PendingJoinRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExceededAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RxWindowsAvailable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEndDevice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RxWindowsAvailable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -11091,7 +12720,7 @@ func (m *MACState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
+func (m *EndDeviceAirtimeUsage_Bucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11114,17 +12743,17 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JoinAccept: wiretype end group for non-group")
+			return fmt.Errorf("proto: Bucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JoinAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Bucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11134,29 +12763,28 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11183,13 +12811,13 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11216,10 +12844,48 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Keys.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DownlinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
+			}
+			m.Uplinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uplinks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+			}
+			m.Downlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downlinks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -13108,6 +14774,42 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			}
 			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AirtimeUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AirtimeUsage == nil {
+				m.AirtimeUsage = &EndDeviceAirtimeUsage{}
+			}
+			if err := m.AirtimeUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
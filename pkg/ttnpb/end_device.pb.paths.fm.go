@@ -125,6 +125,10 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
+	"default_mac_settings.adr_algorithm",
+	"default_mac_settings.adr_algorithm.value",
+	"default_mac_settings.adr_fixed_data_rate_index",
+	"default_mac_settings.adr_fixed_data_rate_index.value",
 	"default_mac_settings.adr_margin",
 	"default_mac_settings.class_b_timeout",
 	"default_mac_settings.class_c_timeout",
@@ -186,6 +190,10 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 	"supports_join",
 }
 var MACSettingsFieldPathsNested = []string{
+	"adr_algorithm",
+	"adr_algorithm.value",
+	"adr_fixed_data_rate_index",
+	"adr_fixed_data_rate_index.value",
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
@@ -217,6 +225,8 @@ var MACSettingsFieldPathsNested = []string{
 }
 
 var MACSettingsFieldPathsTopLevel = []string{
+	"adr_algorithm",
+	"adr_fixed_data_rate_index",
 	"adr_margin",
 	"class_b_timeout",
 	"class_c_timeout",
@@ -240,6 +250,11 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"use_adr",
 }
 var MACStateFieldPathsNested = []string{
+	"channel_plan_reconciliation",
+	"channel_plan_reconciliation.completed_at",
+	"channel_plan_reconciliation.frequency_plan_id",
+	"channel_plan_reconciliation.pending_channels",
+	"channel_plan_reconciliation.started_at",
 	"current_parameters",
 	"current_parameters.adr_ack_delay",
 	"current_parameters.adr_ack_limit",
@@ -291,10 +306,12 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.confirmed",
 	"pending_application_downlink.correlation_ids",
 	"pending_application_downlink.decoded_payload",
+	"pending_application_downlink.expires_at",
 	"pending_application_downlink.f_cnt",
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
 	"pending_application_downlink.priority",
+	"pending_application_downlink.queue_at",
 	"pending_application_downlink.session_key_id",
 	"pending_join_request",
 	"pending_join_request.cf_list",
@@ -436,11 +453,13 @@ var MACStateFieldPathsNested = []string{
 	"queued_join_accept.request.raw_payload",
 	"queued_join_accept.request.rx_delay",
 	"queued_join_accept.request.selected_mac_version",
+	"queued_operator_commands",
 	"queued_responses",
 	"rx_windows_available",
 }
 
 var MACStateFieldPathsTopLevel = []string{
+	"channel_plan_reconciliation",
 	"current_parameters",
 	"desired_parameters",
 	"device_class",
@@ -452,9 +471,19 @@ var MACStateFieldPathsTopLevel = []string{
 	"pending_requests",
 	"ping_slot_periodicity",
 	"queued_join_accept",
+	"queued_operator_commands",
 	"queued_responses",
 	"rx_windows_available",
 }
+var EndDeviceAirtimeUsageFieldPathsNested = []string{
+	"buckets",
+	"exceeded_at",
+}
+
+var EndDeviceAirtimeUsageFieldPathsTopLevel = []string{
+	"buckets",
+	"exceeded_at",
+}
 var EndDeviceAuthenticationCodeFieldPathsNested = []string{
 	"valid_from",
 	"valid_to",
@@ -467,6 +496,9 @@ var EndDeviceAuthenticationCodeFieldPathsTopLevel = []string{
 	"value",
 }
 var EndDeviceFieldPathsNested = []string{
+	"airtime_usage",
+	"airtime_usage.buckets",
+	"airtime_usage.exceeded_at",
 	"application_server_address",
 	"application_server_id",
 	"application_server_kek_label",
@@ -502,6 +534,10 @@ var EndDeviceFieldPathsNested = []string{
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
+	"mac_settings.adr_algorithm",
+	"mac_settings.adr_algorithm.value",
+	"mac_settings.adr_fixed_data_rate_index",
+	"mac_settings.adr_fixed_data_rate_index.value",
 	"mac_settings.adr_margin",
 	"mac_settings.class_b_timeout",
 	"mac_settings.class_c_timeout",
@@ -531,6 +567,11 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.supports_32_bit_f_cnt",
 	"mac_settings.use_adr",
 	"mac_state",
+	"mac_state.channel_plan_reconciliation",
+	"mac_state.channel_plan_reconciliation.completed_at",
+	"mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"mac_state.channel_plan_reconciliation.pending_channels",
+	"mac_state.channel_plan_reconciliation.started_at",
 	"mac_state.current_parameters",
 	"mac_state.current_parameters.adr_ack_delay",
 	"mac_state.current_parameters.adr_ack_limit",
@@ -582,10 +623,12 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.confirmed",
 	"mac_state.pending_application_downlink.correlation_ids",
 	"mac_state.pending_application_downlink.decoded_payload",
+	"mac_state.pending_application_downlink.expires_at",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
+	"mac_state.pending_application_downlink.queue_at",
 	"mac_state.pending_application_downlink.session_key_id",
 	"mac_state.pending_join_request",
 	"mac_state.pending_join_request.cf_list",
@@ -727,6 +770,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.queued_join_accept.request.raw_payload",
 	"mac_state.queued_join_accept.request.rx_delay",
 	"mac_state.queued_join_accept.request.selected_mac_version",
+	"mac_state.queued_operator_commands",
 	"mac_state.queued_responses",
 	"mac_state.rx_windows_available",
 	"max_frequency",
@@ -737,6 +781,11 @@ var EndDeviceFieldPathsNested = []string{
 	"network_server_address",
 	"network_server_kek_label",
 	"pending_mac_state",
+	"pending_mac_state.channel_plan_reconciliation",
+	"pending_mac_state.channel_plan_reconciliation.completed_at",
+	"pending_mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"pending_mac_state.channel_plan_reconciliation.pending_channels",
+	"pending_mac_state.channel_plan_reconciliation.started_at",
 	"pending_mac_state.current_parameters",
 	"pending_mac_state.current_parameters.adr_ack_delay",
 	"pending_mac_state.current_parameters.adr_ack_limit",
@@ -788,10 +837,12 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_application_downlink.confirmed",
 	"pending_mac_state.pending_application_downlink.correlation_ids",
 	"pending_mac_state.pending_application_downlink.decoded_payload",
+	"pending_mac_state.pending_application_downlink.expires_at",
 	"pending_mac_state.pending_application_downlink.f_cnt",
 	"pending_mac_state.pending_application_downlink.f_port",
 	"pending_mac_state.pending_application_downlink.frm_payload",
 	"pending_mac_state.pending_application_downlink.priority",
+	"pending_mac_state.pending_application_downlink.queue_at",
 	"pending_mac_state.pending_application_downlink.session_key_id",
 	"pending_mac_state.pending_join_request",
 	"pending_mac_state.pending_join_request.cf_list",
@@ -933,6 +984,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.queued_join_accept.request.raw_payload",
 	"pending_mac_state.queued_join_accept.request.rx_delay",
 	"pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"pending_mac_state.queued_operator_commands",
 	"pending_mac_state.queued_responses",
 	"pending_mac_state.rx_windows_available",
 	"pending_session",
@@ -1017,6 +1069,7 @@ var EndDeviceFieldPathsNested = []string{
 }
 
 var EndDeviceFieldPathsTopLevel = []string{
+	"airtime_usage",
 	"application_server_address",
 	"application_server_id",
 	"application_server_kek_label",
@@ -1076,6 +1129,9 @@ var EndDevicesFieldPathsTopLevel = []string{
 }
 var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
+	"end_device.airtime_usage",
+	"end_device.airtime_usage.buckets",
+	"end_device.airtime_usage.exceeded_at",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -1111,6 +1167,10 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
+	"end_device.mac_settings.adr_fixed_data_rate_index",
+	"end_device.mac_settings.adr_fixed_data_rate_index.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
@@ -1140,6 +1200,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.supports_32_bit_f_cnt",
 	"end_device.mac_settings.use_adr",
 	"end_device.mac_state",
+	"end_device.mac_state.channel_plan_reconciliation",
+	"end_device.mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.mac_state.channel_plan_reconciliation.started_at",
 	"end_device.mac_state.current_parameters",
 	"end_device.mac_state.current_parameters.adr_ack_delay",
 	"end_device.mac_state.current_parameters.adr_ack_limit",
@@ -1191,10 +1256,12 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.queue_at",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -1336,6 +1403,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.raw_payload",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
 	"end_device.max_frequency",
@@ -1346,6 +1414,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
 	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.channel_plan_reconciliation",
+	"end_device.pending_mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.pending_mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.pending_mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.pending_mac_state.channel_plan_reconciliation.started_at",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
 	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
@@ -1397,10 +1470,12 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.queue_at",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -1542,6 +1617,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.raw_payload",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
 	"end_device.pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.rx_windows_available",
 	"end_device.pending_session",
@@ -1630,6 +1706,9 @@ var CreateEndDeviceRequestFieldPathsTopLevel = []string{
 }
 var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
+	"end_device.airtime_usage",
+	"end_device.airtime_usage.buckets",
+	"end_device.airtime_usage.exceeded_at",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -1665,6 +1744,10 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
+	"end_device.mac_settings.adr_fixed_data_rate_index",
+	"end_device.mac_settings.adr_fixed_data_rate_index.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
@@ -1694,6 +1777,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.supports_32_bit_f_cnt",
 	"end_device.mac_settings.use_adr",
 	"end_device.mac_state",
+	"end_device.mac_state.channel_plan_reconciliation",
+	"end_device.mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.mac_state.channel_plan_reconciliation.started_at",
 	"end_device.mac_state.current_parameters",
 	"end_device.mac_state.current_parameters.adr_ack_delay",
 	"end_device.mac_state.current_parameters.adr_ack_limit",
@@ -1745,10 +1833,12 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.queue_at",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -1890,6 +1980,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.raw_payload",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
 	"end_device.max_frequency",
@@ -1900,6 +1991,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
 	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.channel_plan_reconciliation",
+	"end_device.pending_mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.pending_mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.pending_mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.pending_mac_state.channel_plan_reconciliation.started_at",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
 	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
@@ -1951,10 +2047,12 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.queue_at",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -2096,6 +2194,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.raw_payload",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
 	"end_device.pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.rx_windows_available",
 	"end_device.pending_session",
@@ -2226,6 +2325,9 @@ var ListEndDevicesRequestFieldPathsTopLevel = []string{
 }
 var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
+	"end_device.airtime_usage",
+	"end_device.airtime_usage.buckets",
+	"end_device.airtime_usage.exceeded_at",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -2261,6 +2363,10 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
+	"end_device.mac_settings.adr_fixed_data_rate_index",
+	"end_device.mac_settings.adr_fixed_data_rate_index.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
@@ -2290,6 +2396,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.supports_32_bit_f_cnt",
 	"end_device.mac_settings.use_adr",
 	"end_device.mac_state",
+	"end_device.mac_state.channel_plan_reconciliation",
+	"end_device.mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.mac_state.channel_plan_reconciliation.started_at",
 	"end_device.mac_state.current_parameters",
 	"end_device.mac_state.current_parameters.adr_ack_delay",
 	"end_device.mac_state.current_parameters.adr_ack_limit",
@@ -2341,10 +2452,12 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.queue_at",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -2486,6 +2599,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.raw_payload",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
 	"end_device.max_frequency",
@@ -2496,6 +2610,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
 	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.channel_plan_reconciliation",
+	"end_device.pending_mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.pending_mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.pending_mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.pending_mac_state.channel_plan_reconciliation.started_at",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
 	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
@@ -2547,10 +2666,12 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.queue_at",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -2692,6 +2813,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.raw_payload",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
 	"end_device.pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.rx_windows_available",
 	"end_device.pending_session",
@@ -2782,6 +2904,9 @@ var SetEndDeviceRequestFieldPathsTopLevel = []string{
 }
 var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device",
+	"end_device.airtime_usage",
+	"end_device.airtime_usage.buckets",
+	"end_device.airtime_usage.exceeded_at",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -2817,6 +2942,10 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
+	"end_device.mac_settings.adr_algorithm",
+	"end_device.mac_settings.adr_algorithm.value",
+	"end_device.mac_settings.adr_fixed_data_rate_index",
+	"end_device.mac_settings.adr_fixed_data_rate_index.value",
 	"end_device.mac_settings.adr_margin",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
@@ -2846,6 +2975,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.supports_32_bit_f_cnt",
 	"end_device.mac_settings.use_adr",
 	"end_device.mac_state",
+	"end_device.mac_state.channel_plan_reconciliation",
+	"end_device.mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.mac_state.channel_plan_reconciliation.started_at",
 	"end_device.mac_state.current_parameters",
 	"end_device.mac_state.current_parameters.adr_ack_delay",
 	"end_device.mac_state.current_parameters.adr_ack_limit",
@@ -2897,10 +3031,12 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.queue_at",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -3042,6 +3178,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.raw_payload",
 	"end_device.mac_state.queued_join_accept.request.rx_delay",
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.mac_state.queued_operator_commands",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
 	"end_device.max_frequency",
@@ -3052,6 +3189,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.network_server_address",
 	"end_device.network_server_kek_label",
 	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.channel_plan_reconciliation",
+	"end_device.pending_mac_state.channel_plan_reconciliation.completed_at",
+	"end_device.pending_mac_state.channel_plan_reconciliation.frequency_plan_id",
+	"end_device.pending_mac_state.channel_plan_reconciliation.pending_channels",
+	"end_device.pending_mac_state.channel_plan_reconciliation.started_at",
 	"end_device.pending_mac_state.current_parameters",
 	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
 	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
@@ -3103,10 +3245,12 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.queue_at",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -3248,6 +3392,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.queued_join_accept.request.raw_payload",
 	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
 	"end_device.pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.pending_mac_state.queued_operator_commands",
 	"end_device.pending_mac_state.queued_responses",
 	"end_device.pending_mac_state.rx_windows_available",
 	"end_device.pending_session",
@@ -3406,6 +3551,13 @@ var MACSettings_RxDelayValueFieldPathsNested = []string{
 var MACSettings_RxDelayValueFieldPathsTopLevel = []string{
 	"value",
 }
+var MACSettings_ADRAlgorithmValueFieldPathsNested = []string{
+	"value",
+}
+
+var MACSettings_ADRAlgorithmValueFieldPathsTopLevel = []string{
+	"value",
+}
 var MACState_JoinAcceptFieldPathsNested = []string{
 	"keys",
 	"keys.app_s_key",
@@ -3492,3 +3644,31 @@ var MACState_JoinAcceptFieldPathsTopLevel = []string{
 	"payload",
 	"request",
 }
+var MACState_ChannelPlanReconciliationFieldPathsNested = []string{
+	"completed_at",
+	"frequency_plan_id",
+	"pending_channels",
+	"started_at",
+}
+
+var MACState_ChannelPlanReconciliationFieldPathsTopLevel = []string{
+	"completed_at",
+	"frequency_plan_id",
+	"pending_channels",
+	"started_at",
+}
+var EndDeviceAirtimeUsage_BucketFieldPathsNested = []string{
+	"downlink_airtime",
+	"downlinks",
+	"start",
+	"uplink_airtime",
+	"uplinks",
+}
+
+var EndDeviceAirtimeUsage_BucketFieldPathsTopLevel = []string{
+	"downlink_airtime",
+	"downlinks",
+	"start",
+	"uplink_airtime",
+	"uplinks",
+}
@@ -882,6 +882,48 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 			} else {
 				dst.DesiredRx2Frequency = nil
 			}
+		case "adr_algorithm":
+			if len(subs) > 0 {
+				newDst := dst.ADRAlgorithm
+				if newDst == nil {
+					newDst = &MACSettings_ADRAlgorithmValue{}
+					dst.ADRAlgorithm = newDst
+				}
+				var newSrc *MACSettings_ADRAlgorithmValue
+				if src != nil {
+					newSrc = src.ADRAlgorithm
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ADRAlgorithm = src.ADRAlgorithm
+				} else {
+					dst.ADRAlgorithm = nil
+				}
+			}
+		case "adr_fixed_data_rate_index":
+			if len(subs) > 0 {
+				newDst := dst.ADRFixedDataRateIndex
+				if newDst == nil {
+					newDst = &MACSettings_DataRateIndexValue{}
+					dst.ADRFixedDataRateIndex = newDst
+				}
+				var newSrc *MACSettings_DataRateIndexValue
+				if src != nil {
+					newSrc = src.ADRFixedDataRateIndex
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ADRFixedDataRateIndex = src.ADRFixedDataRateIndex
+				} else {
+					dst.ADRFixedDataRateIndex = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1069,6 +1111,65 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 				var zero bool
 				dst.RxWindowsAvailable = zero
 			}
+		case "queued_operator_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queued_operator_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueuedOperatorCommands = src.QueuedOperatorCommands
+			} else {
+				dst.QueuedOperatorCommands = nil
+			}
+		case "channel_plan_reconciliation":
+			if len(subs) > 0 {
+				newDst := dst.ChannelPlanReconciliation
+				if newDst == nil {
+					newDst = &MACState_ChannelPlanReconciliation{}
+					dst.ChannelPlanReconciliation = newDst
+				}
+				var newSrc *MACState_ChannelPlanReconciliation
+				if src != nil {
+					newSrc = src.ChannelPlanReconciliation
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ChannelPlanReconciliation = src.ChannelPlanReconciliation
+				} else {
+					dst.ChannelPlanReconciliation = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceAirtimeUsage) SetFields(src *EndDeviceAirtimeUsage, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "buckets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'buckets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Buckets = src.Buckets
+			} else {
+				dst.Buckets = nil
+			}
+		case "exceeded_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exceeded_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExceededAt = src.ExceededAt
+			} else {
+				dst.ExceededAt = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1704,6 +1805,27 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 					dst.ClaimAuthenticationCode = nil
 				}
 			}
+		case "airtime_usage":
+			if len(subs) > 0 {
+				newDst := dst.AirtimeUsage
+				if newDst == nil {
+					newDst = &EndDeviceAirtimeUsage{}
+					dst.AirtimeUsage = newDst
+				}
+				var newSrc *EndDeviceAirtimeUsage
+				if src != nil {
+					newSrc = src.AirtimeUsage
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.AirtimeUsage = src.AirtimeUsage
+				} else {
+					dst.AirtimeUsage = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2253,6 +2375,27 @@ func (dst *MACSettings_RxDelayValue) SetFields(src *MACSettings_RxDelayValue, pa
 	return nil
 }
 
+func (dst *MACSettings_ADRAlgorithmValue) SetFields(src *MACSettings_ADRAlgorithmValue, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero ADRAlgorithm
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MACState_JoinAccept) SetFields(src *MACState_JoinAccept, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
@@ -2308,3 +2451,114 @@ func (dst *MACState_JoinAccept) SetFields(src *MACState_JoinAccept, paths ...str
 	}
 	return nil
 }
+
+func (dst *MACState_ChannelPlanReconciliation) SetFields(src *MACState_ChannelPlanReconciliation, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				var zero time.Time
+				dst.StartedAt = zero
+			}
+		case "pending_channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending_channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PendingChannels = src.PendingChannels
+			} else {
+				var zero uint32
+				dst.PendingChannels = zero
+			}
+		case "completed_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'completed_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CompletedAt = src.CompletedAt
+			} else {
+				dst.CompletedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceAirtimeUsage_Bucket) SetFields(src *EndDeviceAirtimeUsage_Bucket, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Start = src.Start
+			} else {
+				var zero time.Time
+				dst.Start = zero
+			}
+		case "uplink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtime = src.UplinkAirtime
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtime = zero
+			}
+		case "downlink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkAirtime = src.DownlinkAirtime
+			} else {
+				var zero time.Duration
+				dst.DownlinkAirtime = zero
+			}
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				var zero uint32
+				dst.Uplinks = zero
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				var zero uint32
+				dst.Downlinks = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
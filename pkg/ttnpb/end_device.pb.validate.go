@@ -1155,6 +1155,30 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 
 			}
 
+		case "adr_algorithm":
+
+			if v, ok := interface{}(m.GetADRAlgorithm()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr_algorithm",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "adr_fixed_data_rate_index":
+
+			if v, ok := interface{}(m.GetADRFixedDataRateIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr_fixed_data_rate_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1371,6 +1395,35 @@ func (m *MACState) ValidateFields(paths ...string) error {
 
 		case "rx_windows_available":
 			// no validation rules for RxWindowsAvailable
+		case "queued_operator_commands":
+
+			for idx, item := range m.GetQueuedOperatorCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACStateValidationError{
+							field:  fmt.Sprintf("queued_operator_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "channel_plan_reconciliation":
+
+			if v, ok := interface{}(m.GetChannelPlanReconciliation()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACStateValidationError{
+						field:  "channel_plan_reconciliation",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACStateValidationError{
 				field:  name,
@@ -1435,6 +1488,116 @@ var _ interface {
 	ErrorName() string
 } = MACStateValidationError{}
 
+// ValidateFields checks the field values on EndDeviceAirtimeUsage with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceAirtimeUsage) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceAirtimeUsageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "buckets":
+
+			for idx, item := range m.GetBuckets() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceAirtimeUsageValidationError{
+							field:  fmt.Sprintf("buckets[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "exceeded_at":
+
+			if v, ok := interface{}(m.GetExceededAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsageValidationError{
+						field:  "exceeded_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceAirtimeUsageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceAirtimeUsageValidationError is the validation error returned by
+// EndDeviceAirtimeUsage.ValidateFields if the designated constraints aren't met.
+type EndDeviceAirtimeUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceAirtimeUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceAirtimeUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceAirtimeUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceAirtimeUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceAirtimeUsageValidationError) ErrorName() string {
+	return "EndDeviceAirtimeUsageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceAirtimeUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceAirtimeUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceAirtimeUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceAirtimeUsageValidationError{}
+
 // ValidateFields checks the field values on EndDeviceAuthenticationCode with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -1452,10 +1615,10 @@ func (m *EndDeviceAuthenticationCode) ValidateFields(paths ...string) error {
 		switch name {
 		case "value":
 
-			if l := len(m.GetValue()); l < 1 || l > 8 {
+			if len(m.GetValue()) > 8 {
 				return EndDeviceAuthenticationCodeValidationError{
 					field:  "value",
-					reason: "value length must be between 1 and 8 bytes, inclusive",
+					reason: "value length must be at most 8 bytes",
 				}
 			}
 
@@ -2028,6 +2191,18 @@ func (m *EndDevice) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "airtime_usage":
+
+			if v, ok := interface{}(m.GetAirtimeUsage()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceValidationError{
+						field:  "airtime_usage",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return EndDeviceValidationError{
 				field:  name,
@@ -3725,6 +3900,97 @@ var _ interface {
 	ErrorName() string
 } = MACSettings_RxDelayValueValidationError{}
 
+// ValidateFields checks the field values on MACSettings_ADRAlgorithmValue with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *MACSettings_ADRAlgorithmValue) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACSettings_ADRAlgorithmValueFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "value":
+
+			if _, ok := ADRAlgorithm_name[int32(m.GetValue())]; !ok {
+				return MACSettings_ADRAlgorithmValueValidationError{
+					field:  "value",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return MACSettings_ADRAlgorithmValueValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACSettings_ADRAlgorithmValueValidationError is the validation error
+// returned by MACSettings_ADRAlgorithmValue.ValidateFields if the designated
+// constraints aren't met.
+type MACSettings_ADRAlgorithmValueValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACSettings_ADRAlgorithmValueValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACSettings_ADRAlgorithmValueValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACSettings_ADRAlgorithmValueValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACSettings_ADRAlgorithmValueValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACSettings_ADRAlgorithmValueValidationError) ErrorName() string {
+	return "MACSettings_ADRAlgorithmValueValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACSettings_ADRAlgorithmValueValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACSettings_ADRAlgorithmValue.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACSettings_ADRAlgorithmValueValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACSettings_ADRAlgorithmValueValidationError{}
+
 // ValidateFields checks the field values on MACState_JoinAccept with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -3838,3 +4104,242 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MACState_JoinAcceptValidationError{}
+
+// ValidateFields checks the field values on MACState_ChannelPlanReconciliation
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MACState_ChannelPlanReconciliation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACState_ChannelPlanReconciliationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency_plan_id":
+
+			if utf8.RuneCountInString(m.GetFrequencyPlanID()) > 64 {
+				return MACState_ChannelPlanReconciliationValidationError{
+					field:  "frequency_plan_id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "started_at":
+
+			if v, ok := interface{}(&m.StartedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_ChannelPlanReconciliationValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "pending_channels":
+			// no validation rules for PendingChannels
+		case "completed_at":
+
+			if v, ok := interface{}(m.GetCompletedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACState_ChannelPlanReconciliationValidationError{
+						field:  "completed_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACState_ChannelPlanReconciliationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACState_ChannelPlanReconciliationValidationError is the validation error
+// returned by MACState_ChannelPlanReconciliation.ValidateFields if the
+// designated constraints aren't met.
+type MACState_ChannelPlanReconciliationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACState_ChannelPlanReconciliationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACState_ChannelPlanReconciliationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACState_ChannelPlanReconciliationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACState_ChannelPlanReconciliationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACState_ChannelPlanReconciliationValidationError) ErrorName() string {
+	return "MACState_ChannelPlanReconciliationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MACState_ChannelPlanReconciliationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACState_ChannelPlanReconciliation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACState_ChannelPlanReconciliationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACState_ChannelPlanReconciliationValidationError{}
+
+// ValidateFields checks the field values on EndDeviceAirtimeUsage_Bucket with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *EndDeviceAirtimeUsage_Bucket) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceAirtimeUsage_BucketFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start":
+
+			if v, ok := interface{}(&m.Start).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsage_BucketValidationError{
+						field:  "start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_airtime":
+
+			if v, ok := interface{}(&m.UplinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsage_BucketValidationError{
+						field:  "uplink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_airtime":
+
+			if v, ok := interface{}(&m.DownlinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsage_BucketValidationError{
+						field:  "downlink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplinks":
+			// no validation rules for Uplinks
+		case "downlinks":
+			// no validation rules for Downlinks
+		default:
+			return EndDeviceAirtimeUsage_BucketValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceAirtimeUsage_BucketValidationError is the validation error returned
+// by EndDeviceAirtimeUsage_Bucket.ValidateFields if the designated
+// constraints aren't met.
+type EndDeviceAirtimeUsage_BucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceAirtimeUsage_BucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceAirtimeUsage_BucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceAirtimeUsage_BucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceAirtimeUsage_BucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceAirtimeUsage_BucketValidationError) ErrorName() string {
+	return "EndDeviceAirtimeUsage_BucketValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceAirtimeUsage_BucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceAirtimeUsage_Bucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceAirtimeUsage_BucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceAirtimeUsage_BucketValidationError{}
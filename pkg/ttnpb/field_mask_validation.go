@@ -57,6 +57,13 @@ var isEndDeviceWriteFieldPaths = []string{
 	"version_ids.model_id",
 }
 
+var apiKeyUpdateFieldPaths = []string{
+	"allowed_cidrs",
+	"expires_at",
+	"name",
+	"rights",
+}
+
 // AllowedFieldMaskPathsForRPC lists the allowed field mask paths for each RPC in this API.
 var AllowedFieldMaskPathsForRPC = map[string][]string{
 	// Applications:
@@ -83,6 +90,12 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 	"/ttn.lorawan.v3.As/GetLink": ApplicationLinkFieldPathsNested,
 	"/ttn.lorawan.v3.As/SetLink": ApplicationLinkFieldPathsNested,
 
+	// API Keys:
+	"/ttn.lorawan.v3.ApplicationAccess/UpdateAPIKey":  apiKeyUpdateFieldPaths,
+	"/ttn.lorawan.v3.GatewayAccess/UpdateAPIKey":      apiKeyUpdateFieldPaths,
+	"/ttn.lorawan.v3.OrganizationAccess/UpdateAPIKey": apiKeyUpdateFieldPaths,
+	"/ttn.lorawan.v3.UserAccess/UpdateAPIKey":         apiKeyUpdateFieldPaths,
+
 	// Clients:
 	"/ttn.lorawan.v3.ClientRegistry/Get":                 omitFields(ClientFieldPathsNested, "secret"),
 	"/ttn.lorawan.v3.ClientRegistry/List":                omitFields(ClientFieldPathsNested, "secret"),
@@ -214,6 +227,9 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"used_dev_nonces",
 	},
 	"/ttn.lorawan.v3.NsEndDeviceRegistry/Get": {
+		"airtime_usage",
+		"airtime_usage.buckets",
+		"airtime_usage.exceeded_at",
 		"battery_percentage",
 		"created_at",
 		"downlink_margin",
@@ -228,6 +244,10 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
+		"mac_settings.adr_algorithm",
+		"mac_settings.adr_algorithm.value",
+		"mac_settings.adr_fixed_data_rate_index",
+		"mac_settings.adr_fixed_data_rate_index.value",
 		"mac_settings.adr_margin",
 		"mac_settings.class_b_timeout",
 		"mac_settings.class_c_timeout",
@@ -257,6 +277,11 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_settings.supports_32_bit_f_cnt",
 		"mac_settings.use_adr",
 		"mac_state",
+		"mac_state.channel_plan_reconciliation",
+		"mac_state.channel_plan_reconciliation.completed_at",
+		"mac_state.channel_plan_reconciliation.frequency_plan_id",
+		"mac_state.channel_plan_reconciliation.pending_channels",
+		"mac_state.channel_plan_reconciliation.started_at",
 		"mac_state.current_parameters",
 		"mac_state.current_parameters.adr_ack_delay",
 		"mac_state.current_parameters.adr_ack_limit",
@@ -445,6 +470,7 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"mac_state.queued_join_accept.request.raw_payload",
 		"mac_state.queued_join_accept.request.rx_delay",
 		"mac_state.queued_join_accept.request.selected_mac_version",
+		"mac_state.queued_operator_commands",
 		"mac_state.queued_responses",
 		"mac_state.rx_windows_available",
 		"max_frequency",
@@ -503,6 +529,10 @@ var AllowedFieldMaskPathsForRPC = map[string][]string{
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
+		"mac_settings.adr_algorithm",
+		"mac_settings.adr_algorithm.value",
+		"mac_settings.adr_fixed_data_rate_index",
+		"mac_settings.adr_fixed_data_rate_index.value",
 		"mac_settings.adr_margin",
 		"mac_settings.class_b_timeout",
 		"mac_settings.class_c_timeout",
@@ -444,6 +444,7 @@ type Gateway struct {
 	GatewayIdentifiers        `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt                 time.Time         `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	UpdatedAt                 time.Time         `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	DeletedAt                 *time.Time        `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
 	Name                      string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Description               string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes                map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -454,11 +455,18 @@ type Gateway struct {
 	// the normal port inference (with DNS lookup, otherwise defaults) is used.
 	// The connection shall be established with transport layer security (TLS).
 	// Custom certificate authorities may be configured out-of-band.
-	GatewayServerAddress string           `protobuf:"bytes,9,opt,name=gateway_server_address,json=gatewayServerAddress,proto3" json:"gateway_server_address,omitempty"`
-	AutoUpdate           bool             `protobuf:"varint,10,opt,name=auto_update,json=autoUpdate,proto3" json:"auto_update,omitempty"`
-	UpdateChannel        string           `protobuf:"bytes,11,opt,name=update_channel,json=updateChannel,proto3" json:"update_channel,omitempty"`
-	FrequencyPlanID      string           `protobuf:"bytes,12,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
-	Antennas             []GatewayAntenna `protobuf:"bytes,13,rep,name=antennas,proto3" json:"antennas"`
+	GatewayServerAddress string `protobuf:"bytes,9,opt,name=gateway_server_address,json=gatewayServerAddress,proto3" json:"gateway_server_address,omitempty"`
+	AutoUpdate           bool   `protobuf:"varint,10,opt,name=auto_update,json=autoUpdate,proto3" json:"auto_update,omitempty"`
+	UpdateChannel        string `protobuf:"bytes,11,opt,name=update_channel,json=updateChannel,proto3" json:"update_channel,omitempty"`
+	// Frequency plan ID of the gateway.
+	// This equals the first element of the frequency_plan_ids field.
+	FrequencyPlanID string `protobuf:"bytes,12,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// Frequency plan IDs of the gateway.
+	// The first element equals the frequency_plan_id field.
+	// Gateways with multiple frequency plans, i.e. gateways with multiple concentrators, combine the channels of all
+	// frequency plans. All frequency plans must use the same band.
+	FrequencyPlanIDs []string         `protobuf:"bytes,19,rep,name=frequency_plan_ids,json=frequencyPlanIds,proto3" json:"frequency_plan_ids,omitempty"`
+	Antennas         []GatewayAntenna `protobuf:"bytes,13,rep,name=antennas,proto3" json:"antennas"`
 	// The status of this gateway may be publicly displayed.
 	StatusPublic bool `protobuf:"varint,14,opt,name=status_public,json=statusPublic,proto3" json:"status_public,omitempty"`
 	// The location of this gateway may be publicly displayed.
@@ -522,6 +530,13 @@ func (m *Gateway) GetUpdatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Gateway) GetDeletedAt() *time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
 func (m *Gateway) GetName() string {
 	if m != nil {
 		return m.Name
@@ -578,6 +593,13 @@ func (m *Gateway) GetFrequencyPlanID() string {
 	return ""
 }
 
+func (m *Gateway) GetFrequencyPlanIDs() []string {
+	if m != nil {
+		return m.FrequencyPlanIDs
+	}
+	return nil
+}
+
 func (m *Gateway) GetAntennas() []GatewayAntenna {
 	if m != nil {
 		return m.Antennas
@@ -758,7 +780,9 @@ type ListGatewaysRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted gateways.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -830,6 +854,13 @@ func (m *ListGatewaysRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListGatewaysRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateGatewayRequest struct {
 	Gateway `protobuf:"bytes,1,opt,name=gateway,proto3,embedded=gateway" json:"gateway"`
 	// Collaborator to grant all rights on the newly created gateway.
@@ -1028,10 +1059,12 @@ func (m *GetGatewayAPIKeyRequest) GetKeyID() string {
 
 type CreateGatewayAPIKeyRequest struct {
 	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs         []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateGatewayAPIKeyRequest) Reset()      { *m = CreateGatewayAPIKeyRequest{} }
@@ -1080,11 +1113,28 @@ func (m *CreateGatewayAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateGatewayAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateGatewayAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateGatewayAPIKeyRequest struct {
-	GatewayIdentifiers   `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
-	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
+	APIKey             `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
+	// The names of the API key fields that should be updated.
+	// If this is not set, the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateGatewayAPIKeyRequest) Reset()      { *m = UpdateGatewayAPIKeyRequest{} }
@@ -1119,6 +1169,13 @@ func (m *UpdateGatewayAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateGatewayAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateGatewayAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListGatewayCollaboratorsRequest struct {
 	GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3,embedded=gateway_ids" json:"gateway_ids"`
 	// Limit the number of results per page.
@@ -1683,160 +1740,168 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2447 bytes of a gzipped FileDescriptorProto
+	// 2565 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xe7, 0x90, 0x92, 0x48, 0x0d, 0x25, 0x4a, 0x9e, 0xbf, 0xfe, 0xca, 0x9a, 0xb6, 0x97, 0x0a,
-	0xa3, 0x24, 0x92, 0x2b, 0x52, 0x2d, 0x93, 0x14, 0xad, 0xda, 0x44, 0x21, 0x29, 0x3b, 0x20, 0x6c,
-	0xd5, 0xee, 0xca, 0x6a, 0x00, 0x7f, 0x2d, 0x46, 0xbb, 0x43, 0x72, 0xab, 0xe5, 0x2e, 0xbb, 0x3b,
-	0x94, 0x45, 0xc7, 0x01, 0x82, 0x22, 0x40, 0x83, 0xa0, 0x68, 0x83, 0x9c, 0x82, 0xa2, 0x87, 0xa0,
-	0x40, 0x8b, 0xa0, 0xed, 0xc1, 0xe8, 0xa1, 0xf0, 0xa1, 0x87, 0x5c, 0x5a, 0xf8, 0x54, 0xf8, 0x54,
-	0x04, 0x2d, 0xa0, 0x44, 0xd4, 0xc5, 0xbd, 0x05, 0xed, 0x25, 0xd0, 0xa9, 0x98, 0xd9, 0xd9, 0xe5,
-	0x92, 0xb2, 0x14, 0xc9, 0x1f, 0x69, 0x6f, 0x33, 0x6f, 0x7e, 0xef, 0x73, 0x1e, 0xdf, 0xbe, 0x37,
-	0x84, 0x19, 0xd3, 0x76, 0xf0, 0x0d, 0x6c, 0xe5, 0x5c, 0x8a, 0xb5, 0xf5, 0x79, 0xdc, 0x34, 0xe6,
-	0x6b, 0x98, 0x92, 0x1b, 0xb8, 0x9d, 0x6f, 0x3a, 0x36, 0xb5, 0x51, 0x8a, 0x52, 0x2b, 0x2f, 0x40,
-	0xf9, 0x8d, 0x17, 0xd2, 0xc5, 0x9a, 0x41, 0xeb, 0xad, 0xb5, 0xbc, 0x66, 0x37, 0xe6, 0x89, 0xb5,
-	0x61, 0xb7, 0x9b, 0x8e, 0xbd, 0xd9, 0x9e, 0xe7, 0x60, 0x2d, 0x57, 0x23, 0x56, 0x6e, 0x03, 0x9b,
-	0x86, 0x8e, 0x29, 0x99, 0xdf, 0xb3, 0xf0, 0x44, 0xa6, 0x73, 0x21, 0x11, 0x35, 0xbb, 0x66, 0x7b,
-	0xcc, 0x6b, 0xad, 0x2a, 0xdf, 0xf1, 0x0d, 0x5f, 0x09, 0xb8, 0x5c, 0xb3, 0xed, 0x9a, 0x49, 0xba,
-	0x28, 0xbd, 0xe5, 0x60, 0x6a, 0xd8, 0x96, 0x38, 0x9f, 0xea, 0x3f, 0xaf, 0x1a, 0xc4, 0xd4, 0xd5,
-	0x06, 0x76, 0xd7, 0x05, 0xe2, 0x64, 0x3f, 0xc2, 0xa5, 0x4e, 0x4b, 0xa3, 0xe2, 0x34, 0xd3, 0x7f,
-	0x4a, 0x8d, 0x06, 0x71, 0x29, 0x6e, 0x34, 0x05, 0x60, 0x7a, 0x6f, 0x8c, 0x34, 0xdb, 0xa2, 0x58,
-	0xa3, 0xaa, 0x61, 0x55, 0x7d, 0x33, 0x4f, 0xed, 0x45, 0x11, 0xab, 0xd5, 0x70, 0xc5, 0xf1, 0x33,
-	0x7b, 0x8f, 0x0d, 0x9d, 0x58, 0xd4, 0xa8, 0x1a, 0xc4, 0xf1, 0x41, 0x53, 0x7b, 0x41, 0x0d, 0x42,
-	0xb1, 0x8e, 0x29, 0xf6, 0x83, 0xb1, 0x17, 0xe1, 0x18, 0xb5, 0x3a, 0x15, 0x12, 0xb2, 0xeb, 0x70,
-	0xe4, 0x35, 0xef, 0xfe, 0x4a, 0x0e, 0xb6, 0x74, 0x34, 0x09, 0xa3, 0x86, 0x2e, 0x81, 0x29, 0x30,
-	0x33, 0x5c, 0x1a, 0xea, 0x6c, 0x65, 0xa2, 0x95, 0x25, 0x25, 0x6a, 0xe8, 0x08, 0xc1, 0x01, 0x0b,
-	0x37, 0x88, 0x14, 0x65, 0x27, 0x0a, 0x5f, 0xa3, 0xe3, 0x30, 0xd6, 0x72, 0x4c, 0x29, 0xc6, 0xc1,
-	0xf1, 0xce, 0x56, 0x26, 0xb6, 0xaa, 0x9c, 0x57, 0x18, 0x0d, 0x4d, 0xc0, 0x41, 0xd3, 0xae, 0xd9,
-	0xae, 0x34, 0x30, 0x15, 0x9b, 0x19, 0x56, 0xbc, 0x4d, 0xf6, 0x36, 0x08, 0xb4, 0x2d, 0xdb, 0x3a,
-	0x31, 0xd1, 0x32, 0x4c, 0xac, 0x31, 0xb5, 0x6a, 0xa0, 0xb3, 0xb0, 0x5b, 0x9a, 0x76, 0xb2, 0xd2,
-	0x74, 0x41, 0xbe, 0x7e, 0x05, 0xe7, 0x6e, 0x7e, 0x3d, 0xf7, 0xed, 0x6b, 0x33, 0x8b, 0x0b, 0x57,
-	0x72, 0xd7, 0x16, 0xfd, 0xed, 0xec, 0x1b, 0x85, 0xb9, 0x37, 0xa7, 0x3b, 0x5b, 0x99, 0x38, 0xb7,
-	0xb8, 0xb2, 0xa4, 0xc4, 0xb9, 0x8c, 0x8a, 0x8e, 0x5e, 0xe6, 0xc6, 0x73, 0x13, 0x4b, 0xb9, 0xc3,
-	0x0b, 0xea, 0xf7, 0x31, 0xd6, 0xf5, 0x31, 0xfb, 0xf3, 0x28, 0x3c, 0x2e, 0x4c, 0xfe, 0x01, 0x71,
-	0x5c, 0xc3, 0xb6, 0x2a, 0xdd, 0x5b, 0x78, 0xdc, 0xf6, 0x2f, 0xc3, 0x44, 0x83, 0xc5, 0x45, 0x0d,
-	0xbc, 0x38, 0x8a, 0x38, 0x1e, 0x52, 0x26, 0x8e, 0xcb, 0xa8, 0xe8, 0x68, 0x16, 0x8e, 0xd7, 0xb1,
-	0xa3, 0xdf, 0xc0, 0x0e, 0x51, 0x37, 0x3c, 0xe3, 0x85, 0x6f, 0x63, 0x3e, 0x5d, 0xf8, 0xc4, 0xa0,
-	0x55, 0xc3, 0x69, 0xf4, 0x40, 0x07, 0x3c, 0xa8, 0x4f, 0x17, 0xd0, 0xec, 0xbf, 0xa2, 0xc1, 0x25,
-	0x2a, 0x58, 0x37, 0x6c, 0x34, 0x09, 0x87, 0x88, 0x85, 0xd7, 0x4c, 0xc2, 0x43, 0x90, 0x50, 0xc4,
-	0x0e, 0x9d, 0x80, 0xc3, 0x5a, 0xdd, 0x68, 0xaa, 0xb4, 0xdd, 0xf4, 0xf3, 0x26, 0xc1, 0x08, 0x97,
-	0xda, 0x4d, 0x82, 0x4e, 0xc2, 0xe1, 0xaa, 0x43, 0x7e, 0xd4, 0x22, 0x96, 0xd6, 0xe6, 0x46, 0x0d,
-	0x28, 0x5d, 0x02, 0x9a, 0x87, 0x49, 0xc7, 0x75, 0x0d, 0xd5, 0xae, 0x56, 0x5d, 0x42, 0xb9, 0x25,
-	0xd1, 0x52, 0xaa, 0xb3, 0x95, 0x81, 0xca, 0xca, 0x4a, 0xe5, 0x02, 0xa7, 0x2a, 0x90, 0x41, 0xbc,
-	0x35, 0x7a, 0x1d, 0x8e, 0xd3, 0x4d, 0x55, 0xb3, 0xad, 0xaa, 0x51, 0x13, 0xbf, 0x76, 0x69, 0x70,
-	0x0a, 0xcc, 0x24, 0x0b, 0x73, 0xf9, 0xde, 0x82, 0x94, 0x0f, 0xdb, 0x9e, 0xbf, 0xb4, 0x59, 0x0e,
-	0xf3, 0x28, 0x63, 0xb4, 0x97, 0x90, 0x7e, 0x1b, 0xc0, 0xb1, 0x3e, 0x10, 0x7a, 0x06, 0x8e, 0x36,
-	0x0c, 0x4b, 0xed, 0xda, 0x0f, 0xb8, 0xfd, 0x23, 0x0d, 0xc3, 0x3a, 0x1b, 0xb8, 0xc0, 0x40, 0x78,
-	0x33, 0x04, 0x8a, 0x0a, 0x10, 0xde, 0xec, 0x82, 0x9e, 0x87, 0x63, 0x96, 0x4d, 0xb5, 0xba, 0xda,
-	0x1f, 0x8b, 0x14, 0x27, 0x07, 0xc0, 0xec, 0xdf, 0x00, 0x4c, 0xf5, 0xa6, 0x21, 0x5a, 0x86, 0x31,
-	0x43, 0x77, 0xb9, 0xee, 0x64, 0x61, 0x76, 0x1f, 0x2f, 0xf7, 0xe6, 0x6c, 0x69, 0x7c, 0xb7, 0x34,
-	0xf8, 0x2e, 0x88, 0x8e, 0x83, 0xbb, 0x5b, 0x99, 0xc8, 0xbd, 0xad, 0x0c, 0x50, 0x98, 0x1c, 0x76,
-	0x8b, 0xcd, 0xba, 0x4d, 0x6d, 0x57, 0x8a, 0xf2, 0x9f, 0xac, 0xd8, 0xa1, 0x17, 0xe1, 0x90, 0xc3,
-	0x42, 0xe5, 0x4a, 0xb1, 0xa9, 0xd8, 0x4c, 0xb2, 0x70, 0xf2, 0xa0, 0x78, 0x2a, 0x02, 0x8b, 0x9e,
-	0x86, 0x23, 0x9a, 0x69, 0x6b, 0xeb, 0xaa, 0x6b, 0xb7, 0x1c, 0x8d, 0x48, 0xf1, 0x29, 0x30, 0x33,
-	0xaa, 0x24, 0x39, 0x6d, 0x85, 0x93, 0x16, 0x06, 0xee, 0x7c, 0x98, 0x89, 0x64, 0x6f, 0x43, 0x18,
-	0x17, 0x12, 0xd0, 0xd9, 0xb0, 0x47, 0xd9, 0x7d, 0xf4, 0x1c, 0xc2, 0x95, 0x32, 0x84, 0x9a, 0x43,
-	0x30, 0x25, 0xba, 0x8a, 0x29, 0x8f, 0x7b, 0xb2, 0x90, 0xce, 0x7b, 0x55, 0x3b, 0xef, 0x57, 0xed,
-	0xfc, 0x25, 0xbf, 0x6a, 0x97, 0x12, 0x8c, 0xfd, 0xbd, 0x4f, 0x33, 0x40, 0x19, 0x16, 0x7c, 0x45,
-	0xca, 0x84, 0xb4, 0x9a, 0xba, 0x2f, 0x24, 0x76, 0x14, 0x21, 0x82, 0xaf, 0x48, 0xd1, 0x09, 0x51,
-	0x51, 0x06, 0xbc, 0x12, 0xb9, 0x5b, 0x1a, 0x70, 0xa2, 0x52, 0x41, 0x94, 0xcf, 0xd3, 0x30, 0xa9,
-	0x13, 0x57, 0x73, 0x8c, 0x66, 0x90, 0xae, 0xc3, 0xa5, 0xc4, 0x6e, 0x69, 0xd0, 0x89, 0x49, 0xf7,
-	0xc6, 0x94, 0xf0, 0x21, 0x6a, 0x41, 0x88, 0x29, 0x75, 0x8c, 0xb5, 0x16, 0x25, 0xae, 0x34, 0xc4,
-	0x6f, 0xe2, 0xf9, 0x7d, 0x22, 0x94, 0x2f, 0x06, 0xc8, 0x33, 0x16, 0x75, 0xda, 0xa5, 0xb9, 0xdd,
-	0xd2, 0xec, 0x2f, 0xc0, 0x73, 0xd9, 0x43, 0x55, 0x12, 0x25, 0xa4, 0x08, 0xbd, 0x02, 0x47, 0xc2,
-	0x5f, 0x2e, 0x29, 0xce, 0x15, 0x9f, 0xe8, 0x57, 0x5c, 0xf6, 0x30, 0x15, 0xab, 0x6a, 0x2b, 0x49,
-	0xad, 0xbb, 0x41, 0x57, 0x61, 0x52, 0x54, 0x13, 0x95, 0xdd, 0x6c, 0xe2, 0xd1, 0x73, 0x15, 0x6e,
-	0xf8, 0x28, 0x17, 0xfd, 0x19, 0xc0, 0x49, 0xd1, 0x7c, 0xa8, 0x2e, 0x71, 0x36, 0x88, 0xa3, 0x62,
-	0x5d, 0x77, 0x88, 0xeb, 0x4a, 0xc3, 0x3c, 0x98, 0x3f, 0x03, 0xbb, 0xa5, 0x77, 0x81, 0xf3, 0x13,
-	0x50, 0x78, 0x1b, 0x5c, 0x9f, 0x59, 0x5c, 0x60, 0x0e, 0xe3, 0xdc, 0xcd, 0x62, 0xee, 0x32, 0xf3,
-	0xf7, 0x56, 0x68, 0xdd, 0x5d, 0x5e, 0xcd, 0x5d, 0x3b, 0x1d, 0x3a, 0x98, 0xbd, 0x9a, 0x9f, 0x3d,
-	0xcd, 0xf8, 0x8a, 0xb9, 0xcb, 0x22, 0x4e, 0xb7, 0x42, 0xeb, 0xee, 0x92, 0xf3, 0x75, 0x0f, 0x66,
-	0x67, 0x16, 0x17, 0x16, 0xae, 0xb0, 0xd5, 0x1b, 0xdf, 0x98, 0x7b, 0xe9, 0xcd, 0xd9, 0xc5, 0xe9,
-	0x5b, 0xd7, 0xa7, 0x95, 0x09, 0x61, 0xee, 0x0a, 0xb7, 0xb6, 0xe8, 0x19, 0x8b, 0x32, 0x30, 0x89,
-	0x5b, 0xd4, 0x56, 0xbd, 0xbc, 0x91, 0x20, 0xaf, 0xa2, 0x90, 0x91, 0x56, 0x39, 0x05, 0x3d, 0x0b,
-	0x53, 0xde, 0x99, 0xaa, 0xd5, 0xb1, 0x65, 0x11, 0x53, 0x4a, 0xf2, 0x72, 0x3a, 0xea, 0x51, 0xcb,
-	0x1e, 0x11, 0x9d, 0x85, 0xc7, 0x82, 0x3a, 0xa2, 0x36, 0x4d, 0xcc, 0x82, 0x2e, 0x8d, 0xf0, 0x48,
-	0xa4, 0xbd, 0xd4, 0x7b, 0xb5, 0xb3, 0x95, 0x19, 0x0b, 0xaa, 0xca, 0x45, 0x13, 0x5b, 0x95, 0x25,
-	0x65, 0xac, 0xda, 0x43, 0xd0, 0xd1, 0xab, 0x30, 0x81, 0x2d, 0x4a, 0x2c, 0x0b, 0xbb, 0xd2, 0x28,
-	0xbf, 0x71, 0x79, 0x9f, 0x2b, 0x2b, 0x7a, 0xb0, 0xd2, 0x00, 0xbb, 0x1f, 0x25, 0xe0, 0x62, 0xc5,
-	0xcf, 0xa5, 0x98, 0xb6, 0x5c, 0xb5, 0xd9, 0x5a, 0x33, 0x0d, 0x4d, 0x4a, 0x71, 0x9f, 0x46, 0x3c,
-	0xe2, 0x45, 0x4e, 0x63, 0xc5, 0xcf, 0xb4, 0x35, 0x5e, 0x52, 0x7d, 0xd8, 0x18, 0x87, 0xa5, 0x7c,
-	0xb2, 0x00, 0xbe, 0x08, 0x27, 0x5d, 0xad, 0x4e, 0xf4, 0x96, 0x49, 0x54, 0xdd, 0xbe, 0x61, 0x99,
-	0x86, 0xb5, 0xae, 0x9a, 0x2c, 0x54, 0xe3, 0x1c, 0x3f, 0xe1, 0x9f, 0x2e, 0x89, 0xc3, 0xf3, 0x2c,
-	0x68, 0x73, 0x10, 0x11, 0xab, 0x6a, 0x3b, 0x1a, 0x51, 0xf5, 0x16, 0x6d, 0xab, 0x5a, 0x5b, 0x33,
-	0x89, 0x74, 0x8c, 0x73, 0x8c, 0x8b, 0x93, 0xa5, 0x16, 0x6d, 0x97, 0x19, 0x1d, 0xfd, 0x10, 0x4a,
-	0x81, 0xe8, 0x26, 0xa6, 0x75, 0xf6, 0x2d, 0x71, 0xa9, 0x83, 0x0d, 0x8b, 0x4a, 0x68, 0x0a, 0xcc,
-	0xa4, 0x0a, 0xcf, 0xf5, 0xc7, 0xc0, 0xd7, 0x76, 0x11, 0xd3, 0x7a, 0x39, 0x40, 0xf3, 0x5f, 0xf0,
-	0x8f, 0x59, 0xce, 0x2a, 0x93, 0xfa, 0x03, 0x11, 0xe9, 0x97, 0xe1, 0x58, 0xdf, 0x4f, 0x14, 0x8d,
-	0xc3, 0xd8, 0x3a, 0xf1, 0x3e, 0x24, 0xc3, 0x0a, 0x5b, 0xb2, 0x0e, 0x6a, 0x03, 0x9b, 0x2d, 0xff,
-	0xcb, 0xe9, 0x6d, 0x16, 0xa2, 0xdf, 0x02, 0xd9, 0x45, 0x98, 0x10, 0xe1, 0x77, 0xd1, 0x0b, 0x30,
-	0x21, 0x52, 0x8a, 0xd5, 0x4d, 0x76, 0x55, 0x4f, 0xed, 0x57, 0x9f, 0x03, 0x60, 0xf6, 0x77, 0x00,
-	0x1e, 0x7b, 0x8d, 0x50, 0xff, 0x80, 0x5d, 0xbe, 0x4b, 0xd1, 0x2a, 0x4c, 0xfa, 0x3f, 0xa6, 0x47,
-	0xad, 0xc2, 0xb0, 0xe6, 0xa3, 0x5c, 0xb4, 0x08, 0x61, 0xb7, 0xbf, 0xde, 0xb7, 0x18, 0x9f, 0x65,
-	0x90, 0x65, 0xec, 0xae, 0x8b, 0x54, 0x1a, 0xae, 0xfa, 0x84, 0x6c, 0x1b, 0x66, 0xbb, 0xc6, 0x86,
-	0xf4, 0x9e, 0xb5, 0x9d, 0x33, 0xab, 0x15, 0xdf, 0xfa, 0x15, 0x18, 0x23, 0x2d, 0x83, 0x5b, 0x3d,
-	0x52, 0x2a, 0x32, 0x19, 0x7f, 0xdf, 0xca, 0x14, 0x6a, 0x76, 0x9e, 0xd6, 0x09, 0xad, 0x1b, 0x56,
-	0xcd, 0xcd, 0x5b, 0x84, 0xde, 0xb0, 0x9d, 0xf5, 0xf9, 0xde, 0x8e, 0xb8, 0xb9, 0x5e, 0x9b, 0x67,
-	0x1d, 0x8a, 0x9b, 0x3f, 0xb3, 0x5a, 0xf9, 0xe6, 0x8b, 0xac, 0x8b, 0x65, 0x62, 0x99, 0xb4, 0xec,
-	0xbf, 0x01, 0xfc, 0xbf, 0xf3, 0x86, 0xeb, 0x2b, 0x77, 0x7d, 0x65, 0xdf, 0x67, 0x65, 0xd1, 0x34,
-	0xf1, 0x9a, 0xed, 0x60, 0x6a, 0x3b, 0x22, 0x56, 0xb9, 0xfe, 0x58, 0x5d, 0x70, 0x6a, 0xd8, 0x32,
-	0x6e, 0xf2, 0x54, 0xbe, 0xe0, 0xac, 0xba, 0xc4, 0x09, 0x99, 0xaf, 0xf4, 0x88, 0x78, 0xe4, 0x30,
-	0xb1, 0x7c, 0xb1, 0x1d, 0x9d, 0x38, 0xa2, 0xc3, 0xf3, 0x36, 0x48, 0x86, 0x83, 0xa6, 0xd1, 0x30,
-	0xbc, 0x16, 0x6a, 0x94, 0xe7, 0xe6, 0xe9, 0x98, 0x74, 0x3f, 0xae, 0x78, 0x64, 0xd6, 0xf2, 0x36,
-	0x71, 0x8d, 0xf0, 0x8f, 0xcf, 0xa8, 0xc2, 0xd7, 0xd9, 0x3f, 0x01, 0x38, 0x51, 0xe6, 0xdf, 0xc1,
-	0xbe, 0x0c, 0x29, 0xc3, 0xb8, 0xb8, 0x58, 0xe1, 0xf1, 0x7e, 0xb9, 0xf6, 0x80, 0x94, 0xf0, 0x39,
-	0x91, 0xda, 0x17, 0xbb, 0xe8, 0x43, 0xc4, 0xae, 0x34, 0x12, 0x96, 0xdf, 0x1b, 0xc9, 0xec, 0x2f,
-	0x01, 0x9c, 0xf0, 0xea, 0xe6, 0x93, 0x30, 0xff, 0x91, 0xd3, 0xf9, 0x37, 0x00, 0x1e, 0x0f, 0xe5,
-	0x54, 0xf1, 0x62, 0xe5, 0x1c, 0xe9, 0x66, 0xd6, 0x13, 0xfa, 0x11, 0x06, 0x69, 0x10, 0x3d, 0x38,
-	0x0d, 0x62, 0xa1, 0x34, 0x78, 0x1f, 0xc0, 0xa7, 0xba, 0x3f, 0x3c, 0xcf, 0xce, 0x27, 0x6c, 0xe6,
-	0x14, 0x1c, 0x5a, 0x27, 0xed, 0xee, 0xf4, 0x33, 0xdc, 0xd9, 0xca, 0x0c, 0x9e, 0x23, 0xed, 0xca,
-	0x92, 0x32, 0xb8, 0x4e, 0xda, 0x15, 0x3d, 0xfb, 0x57, 0x00, 0xd3, 0x3d, 0xb9, 0xf9, 0x95, 0xd8,
-	0x75, 0x22, 0x3c, 0xfc, 0xf6, 0xb7, 0x71, 0xdf, 0x85, 0x43, 0xde, 0x44, 0xcd, 0x1b, 0xe4, 0x54,
-	0xe1, 0xff, 0xfb, 0xd5, 0x29, 0xec, 0xb4, 0x34, 0xba, 0x5b, 0x82, 0xef, 0x83, 0x78, 0x56, 0x7c,
-	0x1b, 0x04, 0x4f, 0xf6, 0x8f, 0x00, 0xa6, 0x7b, 0xb2, 0xf5, 0x2b, 0x71, 0xa8, 0x08, 0xe3, 0xb8,
-	0x69, 0xa8, 0xec, 0x93, 0xe3, 0xa5, 0xf0, 0x64, 0xbf, 0x48, 0xcf, 0x8c, 0x07, 0x88, 0x19, 0xc2,
-	0x4d, 0xe3, 0x1c, 0x69, 0x67, 0x7f, 0x0f, 0x60, 0x26, 0x94, 0xc7, 0xe5, 0xd0, 0x4f, 0xf0, 0x7f,
-	0x31, 0x9b, 0xff, 0x01, 0xe0, 0xa9, 0x6e, 0x36, 0x87, 0xad, 0x7d, 0xc2, 0xc6, 0x6a, 0x8f, 0xa3,
-	0xde, 0xed, 0x55, 0xd1, 0x5b, 0xf3, 0xfe, 0x02, 0xe0, 0xa9, 0x95, 0xff, 0x86, 0x77, 0xdf, 0x7b,
-	0xa0, 0x77, 0x27, 0xf7, 0x0e, 0x08, 0x5d, 0xcc, 0x81, 0xc5, 0xfb, 0xd7, 0xd1, 0x60, 0xce, 0x15,
-	0xbd, 0x25, 0xbb, 0xcd, 0x1a, 0x36, 0x2c, 0x6e, 0x72, 0x54, 0xe1, 0x6b, 0x54, 0x82, 0x09, 0xbf,
-	0x47, 0x14, 0x2a, 0xa5, 0x7e, 0x95, 0xe7, 0xc5, 0x79, 0x9f, 0xba, 0x80, 0x0f, 0xdd, 0xea, 0x19,
-	0xa9, 0xbc, 0xe1, 0x36, 0x7f, 0x70, 0x9f, 0xfb, 0xf8, 0x26, 0xab, 0x47, 0xed, 0x01, 0x7f, 0x3a,
-	0x08, 0x47, 0x85, 0x6d, 0x2b, 0xbc, 0xa7, 0x46, 0xaf, 0xc2, 0x01, 0x6a, 0x34, 0x88, 0xb8, 0xd9,
-	0x83, 0x26, 0x55, 0x76, 0xa3, 0x7f, 0x00, 0xd1, 0x04, 0x08, 0x26, 0x56, 0xce, 0x89, 0x8a, 0x70,
-	0x78, 0xcd, 0xb6, 0xa9, 0xca, 0xc5, 0x1c, 0x65, 0x6a, 0x4e, 0x30, 0x36, 0x76, 0x80, 0x5a, 0x30,
-	0x21, 0xe6, 0x33, 0x3f, 0xa2, 0x5f, 0xdb, 0x27, 0xa2, 0x9e, 0xd5, 0x79, 0x31, 0xf3, 0x3d, 0x54,
-	0x38, 0x03, 0x55, 0xe8, 0x0c, 0x3c, 0x26, 0x46, 0x0f, 0xd5, 0xbf, 0x5e, 0xef, 0xe5, 0xf1, 0x80,
-	0xbc, 0x50, 0xc6, 0x05, 0x8b, 0x4f, 0x70, 0xf9, 0xdb, 0x67, 0x53, 0x1a, 0x9c, 0x8a, 0x05, 0x6f,
-	0x9f, 0x17, 0x95, 0xa8, 0xd1, 0x44, 0x0e, 0x8c, 0x37, 0x08, 0x75, 0x0c, 0xcd, 0x9f, 0xbc, 0x4f,
-	0x1f, 0xec, 0xd4, 0xb2, 0x07, 0x7e, 0x18, 0x9f, 0x7c, 0x45, 0xac, 0xb1, 0xc7, 0xfa, 0x06, 0xb6,
-	0x34, 0xa2, 0x4b, 0x9a, 0xe8, 0x56, 0xfa, 0xef, 0x62, 0x85, 0xbf, 0x4a, 0x2b, 0x01, 0x30, 0xfd,
-	0x1d, 0x38, 0xda, 0x13, 0xd0, 0xa3, 0xa4, 0x54, 0x7a, 0x01, 0x8e, 0x84, 0x0d, 0xff, 0x32, 0xde,
-	0x68, 0x38, 0x1d, 0x3f, 0x1d, 0x82, 0x93, 0x41, 0xf1, 0xb1, 0x2c, 0xa2, 0xb1, 0x80, 0xb2, 0x68,
-	0xb8, 0xa8, 0xcc, 0x9f, 0x10, 0x18, 0xc9, 0x7b, 0x49, 0xf9, 0xf2, 0xfc, 0x1c, 0xe0, 0x49, 0x95,
-	0x0c, 0xb8, 0x8a, 0x14, 0xa5, 0x61, 0xc2, 0xfb, 0xc3, 0xc0, 0x36, 0xfd, 0x97, 0x44, 0x7f, 0x8f,
-	0x5e, 0x87, 0x4f, 0x99, 0xd8, 0xa5, 0xaa, 0x18, 0x38, 0x1d, 0xa2, 0x11, 0x63, 0xe3, 0xb0, 0xaf,
-	0x36, 0x9e, 0xae, 0x09, 0x26, 0xc0, 0xbb, 0x3c, 0x45, 0xb0, 0x17, 0x29, 0x7a, 0x05, 0x26, 0x43,
-	0x82, 0x79, 0x07, 0x9d, 0x2c, 0x9c, 0x3a, 0xf0, 0xea, 0x15, 0xd8, 0x95, 0x14, 0x18, 0xd6, 0x6a,
-	0xf2, 0xa9, 0x32, 0x6c, 0xd8, 0xe0, 0x51, 0x0c, 0x5b, 0xe5, 0xfc, 0x21, 0xc3, 0x9e, 0x86, 0x23,
-	0x42, 0xa6, 0x66, 0xb7, 0x2c, 0x2a, 0x0d, 0xf1, 0x27, 0xc3, 0xa4, 0x47, 0x2b, 0x33, 0x12, 0xba,
-	0x02, 0x8f, 0x73, 0xdd, 0xc1, 0x4c, 0x1b, 0xd6, 0x1e, 0x3f, 0xa4, 0xf6, 0x49, 0x26, 0xc2, 0x9f,
-	0x72, 0x43, 0xfa, 0x9f, 0x85, 0xa9, 0x40, 0xae, 0x67, 0x41, 0x82, 0x5b, 0x30, 0xea, 0x53, 0x3d,
-	0x1b, 0x54, 0x38, 0xee, 0xd8, 0x2d, 0x4b, 0x57, 0xa9, 0x63, 0x34, 0x79, 0x55, 0xf1, 0xde, 0x65,
-	0x92, 0x85, 0x97, 0xf6, 0x09, 0x62, 0x5f, 0xee, 0xe4, 0x15, 0xc6, 0x7e, 0xc9, 0x31, 0x9a, 0xdc,
-	0x32, 0x25, 0xe5, 0xf4, 0xec, 0xd3, 0xff, 0x04, 0x30, 0xd5, 0x0b, 0x41, 0x2f, 0xc3, 0x58, 0x43,
-	0x7c, 0x2b, 0x92, 0x85, 0xe3, 0x7b, 0x3c, 0x5c, 0x12, 0x4f, 0xb8, 0xbc, 0x06, 0xfe, 0xd6, 0xaf,
-	0x81, 0x1f, 0x30, 0x67, 0x19, 0x1f, 0x67, 0xc7, 0x9b, 0xa2, 0xf8, 0x1d, 0x91, 0x1d, 0x6f, 0xa2,
-	0x32, 0x1c, 0x6a, 0x10, 0xdd, 0xc0, 0x96, 0xc8, 0xbc, 0x23, 0x49, 0x10, 0xac, 0xec, 0x57, 0xe6,
-	0x05, 0x95, 0x8f, 0x6c, 0x8a, 0xb7, 0x29, 0xfd, 0x0a, 0xdc, 0xdd, 0x96, 0xc1, 0xbd, 0x6d, 0x19,
-	0x7c, 0xb2, 0x2d, 0x47, 0x3e, 0xdb, 0x96, 0x23, 0xf7, 0xb7, 0xe5, 0xc8, 0xe7, 0xdb, 0x72, 0xe4,
-	0x8b, 0x6d, 0x19, 0xbc, 0xd5, 0x91, 0xc1, 0x3b, 0x1d, 0x39, 0xf2, 0x51, 0x47, 0x06, 0xb7, 0x3b,
-	0x72, 0xe4, 0x4e, 0x47, 0x8e, 0x7c, 0xdc, 0x91, 0x23, 0x77, 0x3b, 0x32, 0xb8, 0xd7, 0x91, 0xc1,
-	0x27, 0x1d, 0x39, 0xf2, 0x59, 0x47, 0x06, 0xf7, 0x3b, 0x72, 0xe4, 0xf3, 0x8e, 0x0c, 0xbe, 0xe8,
-	0xc8, 0x91, 0xb7, 0x76, 0xe4, 0xc8, 0x3b, 0x3b, 0x32, 0x78, 0x6f, 0x47, 0x8e, 0x7c, 0xb0, 0x23,
-	0x83, 0x0f, 0x77, 0xe4, 0xc8, 0x47, 0x3b, 0x72, 0xe4, 0xf6, 0x8e, 0x0c, 0xee, 0xec, 0xc8, 0xe0,
-	0xe3, 0x1d, 0x19, 0x5c, 0x9e, 0x3b, 0xec, 0x04, 0x4d, 0xad, 0xe6, 0xda, 0xda, 0x10, 0xf7, 0xf3,
-	0x85, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x74, 0x8c, 0xcf, 0x38, 0x25, 0x1c, 0x00, 0x00,
+	0xf5, 0xe7, 0x90, 0x92, 0x48, 0x0d, 0x29, 0x8a, 0x9e, 0xe8, 0xaf, 0xac, 0x65, 0x7b, 0xa9, 0x30,
+	0x4e, 0x22, 0xf9, 0x6f, 0x52, 0x2d, 0x93, 0x14, 0xad, 0x5a, 0x47, 0x21, 0x29, 0xdb, 0x20, 0x62,
+	0x37, 0xee, 0xca, 0x6a, 0x80, 0x38, 0xc9, 0x62, 0xb4, 0x3b, 0x24, 0xb7, 0x5a, 0xee, 0xb2, 0xb3,
+	0x43, 0x49, 0x4c, 0x1c, 0x20, 0x28, 0x02, 0x34, 0x08, 0xfa, 0x11, 0xf8, 0x14, 0x14, 0x3d, 0x04,
+	0x05, 0x5a, 0x04, 0x6d, 0x0f, 0x41, 0x4f, 0x39, 0xf4, 0x10, 0xa0, 0x68, 0x91, 0x53, 0xe1, 0x53,
+	0x11, 0xb4, 0x80, 0x12, 0x51, 0x97, 0xf4, 0x16, 0xf4, 0xd2, 0x40, 0xa7, 0x62, 0x66, 0x67, 0xc9,
+	0x25, 0x65, 0x29, 0x92, 0x1d, 0xa7, 0xbd, 0xed, 0xbc, 0xf9, 0xbd, 0xf7, 0x7e, 0xf3, 0xe6, 0xcd,
+	0x9b, 0x8f, 0x85, 0x59, 0xdb, 0xa5, 0x78, 0x13, 0x3b, 0x79, 0x8f, 0x61, 0x63, 0x7d, 0x01, 0xb7,
+	0xac, 0x85, 0x3a, 0x66, 0x64, 0x13, 0x77, 0x0a, 0x2d, 0xea, 0x32, 0x17, 0xa5, 0x19, 0x73, 0x0a,
+	0x12, 0x54, 0xd8, 0x78, 0x7c, 0xa6, 0x54, 0xb7, 0x58, 0xa3, 0xbd, 0x56, 0x30, 0xdc, 0xe6, 0x02,
+	0x71, 0x36, 0xdc, 0x4e, 0x8b, 0xba, 0x5b, 0x9d, 0x05, 0x01, 0x36, 0xf2, 0x75, 0xe2, 0xe4, 0x37,
+	0xb0, 0x6d, 0x99, 0x98, 0x91, 0x85, 0x7d, 0x1f, 0xbe, 0xc9, 0x99, 0x7c, 0xc8, 0x44, 0xdd, 0xad,
+	0xbb, 0xbe, 0xf2, 0x5a, 0xbb, 0x26, 0x5a, 0xa2, 0x21, 0xbe, 0x24, 0x5c, 0xad, 0xbb, 0x6e, 0xdd,
+	0x26, 0x7d, 0x94, 0xd9, 0xa6, 0x98, 0x59, 0xae, 0x23, 0xfb, 0x67, 0x87, 0xfb, 0x6b, 0x16, 0xb1,
+	0x4d, 0xbd, 0x89, 0xbd, 0x75, 0x89, 0x38, 0x3d, 0x8c, 0xf0, 0x18, 0x6d, 0x1b, 0x4c, 0xf6, 0x66,
+	0x87, 0x7b, 0x99, 0xd5, 0x24, 0x1e, 0xc3, 0xcd, 0x96, 0x04, 0x9c, 0xdd, 0x1f, 0x23, 0xc3, 0x75,
+	0x18, 0x36, 0x98, 0x6e, 0x39, 0xb5, 0x80, 0xe6, 0x99, 0xfd, 0x28, 0xe2, 0xb4, 0x9b, 0x9e, 0xec,
+	0x7e, 0x78, 0x7f, 0xb7, 0x65, 0x12, 0x87, 0x59, 0x35, 0x8b, 0xd0, 0x00, 0x34, 0xbb, 0x1f, 0xd4,
+	0x24, 0x0c, 0x9b, 0x98, 0xe1, 0x20, 0x18, 0xfb, 0x11, 0xd4, 0xaa, 0x37, 0x98, 0xb4, 0x90, 0x5b,
+	0x87, 0xa9, 0xcb, 0xfe, 0xfc, 0x95, 0x29, 0x76, 0x4c, 0x34, 0x0d, 0xa3, 0x96, 0xa9, 0x80, 0x59,
+	0x30, 0x37, 0x5e, 0x1e, 0xeb, 0x6e, 0x67, 0xa3, 0xd5, 0x65, 0x2d, 0x6a, 0x99, 0x08, 0xc1, 0x11,
+	0x07, 0x37, 0x89, 0x12, 0xe5, 0x3d, 0x9a, 0xf8, 0x46, 0x27, 0x61, 0xac, 0x4d, 0x6d, 0x25, 0x26,
+	0xc0, 0xf1, 0xee, 0x76, 0x36, 0xb6, 0xaa, 0x5d, 0xd1, 0xb8, 0x0c, 0x4d, 0xc1, 0x51, 0xdb, 0xad,
+	0xbb, 0x9e, 0x32, 0x32, 0x1b, 0x9b, 0x1b, 0xd7, 0xfc, 0x46, 0xee, 0x3d, 0xd0, 0xf3, 0x76, 0xd5,
+	0x35, 0x89, 0x8d, 0xae, 0xc2, 0xc4, 0x1a, 0x77, 0xab, 0xf7, 0x7c, 0x16, 0xf7, 0xca, 0x67, 0x69,
+	0x4e, 0x39, 0x5b, 0x54, 0x5f, 0xba, 0x81, 0xf3, 0x2f, 0x7f, 0x2d, 0xff, 0xad, 0x17, 0xe7, 0x96,
+	0x16, 0x6f, 0xe4, 0x5f, 0x5c, 0x0a, 0x9a, 0xf3, 0xaf, 0x14, 0xcf, 0xbf, 0x7a, 0xb6, 0xbb, 0x9d,
+	0x8d, 0x0b, 0xc6, 0xd5, 0x65, 0x2d, 0x2e, 0x6c, 0x54, 0x4d, 0x74, 0x41, 0x90, 0x17, 0x14, 0xcb,
+	0xf9, 0xa3, 0x1b, 0x1a, 0x1e, 0x63, 0xac, 0x3f, 0xc6, 0xdc, 0xcf, 0xa3, 0xf0, 0xa4, 0xa4, 0xfc,
+	0x7d, 0x42, 0x3d, 0xcb, 0x75, 0xaa, 0xfd, 0x59, 0xf8, 0xb2, 0xf9, 0x5f, 0x85, 0x89, 0x26, 0x8f,
+	0x8b, 0xde, 0x1b, 0xc5, 0x71, 0xcc, 0x89, 0x90, 0x72, 0x73, 0xc2, 0x46, 0xd5, 0x44, 0xf3, 0x30,
+	0xd3, 0xc0, 0xd4, 0xdc, 0xc4, 0x94, 0xe8, 0x1b, 0x3e, 0x79, 0x39, 0xb6, 0xc9, 0x40, 0x2e, 0xc7,
+	0xc4, 0xa1, 0x35, 0x8b, 0x36, 0x07, 0xa0, 0x23, 0x3e, 0x34, 0x90, 0x4b, 0x68, 0xee, 0x5f, 0xd1,
+	0xde, 0x24, 0x6a, 0xd8, 0xb4, 0x5c, 0x34, 0x0d, 0xc7, 0x88, 0x83, 0xd7, 0x6c, 0x22, 0x42, 0x90,
+	0xd0, 0x64, 0x0b, 0x9d, 0x82, 0xe3, 0x46, 0xc3, 0x6a, 0xe9, 0xac, 0xd3, 0x0a, 0xf2, 0x26, 0xc1,
+	0x05, 0xd7, 0x3b, 0x2d, 0x82, 0x4e, 0xc3, 0xf1, 0x1a, 0x25, 0x3f, 0x6c, 0x13, 0xc7, 0xe8, 0x08,
+	0x52, 0x23, 0x5a, 0x5f, 0x80, 0x16, 0x60, 0x92, 0x7a, 0x9e, 0xa5, 0xbb, 0xb5, 0x9a, 0x47, 0x98,
+	0x60, 0x12, 0x2d, 0xa7, 0xbb, 0xdb, 0x59, 0xa8, 0xad, 0xac, 0x54, 0x9f, 0x15, 0x52, 0x0d, 0x72,
+	0x88, 0xff, 0x8d, 0x9e, 0x83, 0x19, 0xb6, 0xa5, 0x1b, 0xae, 0x53, 0xb3, 0xea, 0x72, 0xb5, 0x2b,
+	0xa3, 0xb3, 0x60, 0x2e, 0x59, 0x3c, 0x5f, 0x18, 0x2c, 0x48, 0x85, 0x30, 0xf7, 0xc2, 0xf5, 0xad,
+	0x4a, 0x58, 0x47, 0x9b, 0x64, 0x83, 0x82, 0x99, 0xd7, 0x01, 0x9c, 0x1c, 0x02, 0xa1, 0x87, 0xe1,
+	0x44, 0xd3, 0x72, 0xf4, 0x3e, 0x7f, 0x20, 0xf8, 0xa7, 0x9a, 0x96, 0x73, 0xa9, 0x37, 0x04, 0x0e,
+	0xc2, 0x5b, 0x21, 0x50, 0x54, 0x82, 0xf0, 0x56, 0x1f, 0xf4, 0x18, 0x9c, 0x74, 0x5c, 0x66, 0x34,
+	0xf4, 0xe1, 0x58, 0xa4, 0x85, 0xb8, 0x07, 0xcc, 0xfd, 0x0d, 0xc0, 0xf4, 0x60, 0x1a, 0xa2, 0xab,
+	0x30, 0x66, 0x99, 0x9e, 0xf0, 0x9d, 0x2c, 0xce, 0x1f, 0x30, 0xca, 0xfd, 0x39, 0x5b, 0xce, 0xec,
+	0x95, 0x47, 0xdf, 0x04, 0xd1, 0x0c, 0xf8, 0x70, 0x3b, 0x1b, 0xb9, 0xbd, 0x9d, 0x05, 0x1a, 0xb7,
+	0xc3, 0x67, 0xb1, 0xd5, 0x70, 0x99, 0xeb, 0x29, 0x51, 0xb1, 0x64, 0x65, 0x0b, 0x3d, 0x01, 0xc7,
+	0x28, 0x0f, 0x95, 0xa7, 0xc4, 0x66, 0x63, 0x73, 0xc9, 0xe2, 0xe9, 0xc3, 0xe2, 0xa9, 0x49, 0x2c,
+	0x7a, 0x08, 0xa6, 0x0c, 0xdb, 0x35, 0xd6, 0x75, 0xcf, 0x6d, 0x53, 0x83, 0x28, 0xf1, 0x59, 0x30,
+	0x37, 0xa1, 0x25, 0x85, 0x6c, 0x45, 0x88, 0x16, 0x47, 0xde, 0x7f, 0x27, 0x1b, 0xc9, 0xfd, 0x29,
+	0x09, 0xe3, 0xd2, 0x02, 0xba, 0x14, 0x1e, 0x51, 0xee, 0x00, 0x3f, 0x47, 0x18, 0x4a, 0x05, 0x42,
+	0x83, 0x12, 0xcc, 0x88, 0xa9, 0x63, 0x26, 0xe2, 0x9e, 0x2c, 0xce, 0x14, 0xfc, 0xaa, 0x5d, 0x08,
+	0xaa, 0x76, 0xe1, 0x7a, 0x50, 0xb5, 0xcb, 0x09, 0xae, 0xfe, 0xd6, 0xc7, 0x59, 0xa0, 0x8d, 0x4b,
+	0xbd, 0x12, 0xe3, 0x46, 0xda, 0x2d, 0x33, 0x30, 0x12, 0x3b, 0x8e, 0x11, 0xa9, 0x57, 0x62, 0x68,
+	0x09, 0x42, 0x93, 0xd8, 0x44, 0x1a, 0x99, 0xfa, 0x42, 0x23, 0x23, 0xbe, 0x01, 0xa9, 0x53, 0x62,
+	0xe8, 0x94, 0x2c, 0x49, 0x23, 0x7e, 0x8d, 0xdd, 0x2b, 0x8f, 0xd0, 0xa8, 0x52, 0x94, 0xf5, 0xf7,
+	0x1c, 0x4c, 0x9a, 0xc4, 0x33, 0xa8, 0xd5, 0xea, 0xe5, 0xfb, 0x78, 0x39, 0xb1, 0x57, 0x1e, 0xa5,
+	0x31, 0xe5, 0xf6, 0xa4, 0x16, 0xee, 0x44, 0x6d, 0x08, 0x31, 0x63, 0xd4, 0x5a, 0x6b, 0x33, 0xe2,
+	0x29, 0x63, 0x62, 0x2a, 0x1f, 0x3b, 0x20, 0xc4, 0x85, 0x52, 0x0f, 0x79, 0xd1, 0x61, 0xb4, 0x53,
+	0x3e, 0xbf, 0x57, 0x9e, 0xff, 0x05, 0x78, 0x34, 0x77, 0xa4, 0x52, 0xa4, 0x85, 0x1c, 0xa1, 0xa7,
+	0x60, 0x2a, 0xbc, 0xf5, 0x29, 0x71, 0xe1, 0xf8, 0xd4, 0xb0, 0xe3, 0x8a, 0x8f, 0xa9, 0x3a, 0x35,
+	0x57, 0x4b, 0x1a, 0xfd, 0x06, 0x7a, 0x01, 0x26, 0x65, 0x39, 0xd2, 0x79, 0x6a, 0x24, 0xee, 0x3d,
+	0xd9, 0xe1, 0x46, 0x80, 0xf2, 0xd0, 0x9f, 0x01, 0x9c, 0x96, 0xa7, 0x17, 0xdd, 0x23, 0x74, 0x83,
+	0x50, 0x1d, 0x9b, 0x26, 0x25, 0x9e, 0xa7, 0x8c, 0x8b, 0x60, 0xfe, 0x0c, 0xec, 0x95, 0xdf, 0x04,
+	0xf4, 0xc7, 0xa0, 0xf8, 0x3a, 0x78, 0x69, 0x6e, 0x69, 0x91, 0x0f, 0x18, 0xe7, 0x5f, 0x2e, 0xe5,
+	0x9f, 0xe7, 0xe3, 0xbd, 0x19, 0xfa, 0xee, 0x7f, 0xbe, 0x90, 0x7f, 0xf1, 0x5c, 0xa8, 0x63, 0xfe,
+	0x85, 0xc2, 0xfc, 0x39, 0xae, 0x57, 0xca, 0x3f, 0x2f, 0xe3, 0x74, 0x33, 0xf4, 0xdd, 0xff, 0x14,
+	0x7a, 0xfd, 0x8e, 0xf9, 0xb9, 0xa5, 0xc5, 0xc5, 0x1b, 0xfc, 0xeb, 0x95, 0xaf, 0x9f, 0x7f, 0xf2,
+	0xd5, 0xf9, 0xa5, 0xb3, 0x37, 0x5f, 0x3a, 0xab, 0x4d, 0x49, 0xba, 0x2b, 0x82, 0x6d, 0xc9, 0x27,
+	0x8b, 0xb2, 0x30, 0x89, 0xdb, 0xcc, 0xd5, 0xfd, 0xc4, 0x53, 0xa0, 0x28, 0xc3, 0x90, 0x8b, 0x56,
+	0x85, 0x04, 0x3d, 0x02, 0xd3, 0x7e, 0x9f, 0x6e, 0x34, 0xb0, 0xe3, 0x10, 0x5b, 0x49, 0x8a, 0x7a,
+	0x3c, 0xe1, 0x4b, 0x2b, 0xbe, 0x10, 0x5d, 0x82, 0x27, 0x7a, 0x85, 0x48, 0x6f, 0xd9, 0x98, 0x07,
+	0x5d, 0x49, 0x89, 0x48, 0xcc, 0xf8, 0xa9, 0xf7, 0x74, 0x77, 0x3b, 0x3b, 0xd9, 0x2b, 0x4b, 0xd7,
+	0x6c, 0xec, 0x54, 0x97, 0xb5, 0xc9, 0xda, 0x80, 0xc0, 0x44, 0xd7, 0x20, 0xda, 0x67, 0xc7, 0x53,
+	0x1e, 0xe0, 0x75, 0xa5, 0x9c, 0xdb, 0x2b, 0x27, 0x6f, 0x81, 0x44, 0x26, 0x91, 0x0b, 0xec, 0x65,
+	0x86, 0xec, 0x79, 0x5a, 0x66, 0xc8, 0xa0, 0x87, 0x9e, 0x86, 0x09, 0xec, 0x30, 0xe2, 0x38, 0xd8,
+	0x53, 0x26, 0x44, 0x0e, 0xa9, 0x07, 0x24, 0x41, 0xc9, 0x87, 0x95, 0x47, 0xf8, 0x8c, 0x6b, 0x3d,
+	0x2d, 0x5e, 0x8f, 0x3d, 0x86, 0x59, 0xdb, 0xd3, 0x5b, 0xed, 0x35, 0xdb, 0x32, 0x94, 0xb4, 0x88,
+	0x52, 0xca, 0x17, 0x5e, 0x13, 0x32, 0x5e, 0x8f, 0x6d, 0xd7, 0x10, 0x55, 0x3e, 0x80, 0x4d, 0x0a,
+	0x58, 0x3a, 0x10, 0x4b, 0xe0, 0x13, 0x70, 0xda, 0x33, 0x1a, 0xc4, 0x6c, 0xdb, 0x44, 0x37, 0xdd,
+	0x4d, 0xc7, 0xb6, 0x9c, 0x75, 0xdd, 0xe6, 0xc1, 0xcf, 0x08, 0xfc, 0x54, 0xd0, 0xbb, 0x2c, 0x3b,
+	0xaf, 0xf0, 0x69, 0x38, 0x0f, 0x11, 0x71, 0x6a, 0x2e, 0x35, 0x88, 0x6e, 0xb6, 0x59, 0x47, 0x37,
+	0x3a, 0x86, 0x4d, 0x94, 0x13, 0x42, 0x23, 0x23, 0x7b, 0x96, 0xdb, 0xac, 0x53, 0xe1, 0x72, 0xf4,
+	0x03, 0xa8, 0xf4, 0x4c, 0xb7, 0x30, 0x6b, 0xf0, 0xed, 0xcd, 0x63, 0x14, 0x5b, 0x0e, 0x53, 0xd0,
+	0x2c, 0x98, 0x4b, 0x17, 0x1f, 0x1d, 0x8e, 0x41, 0xe0, 0xed, 0x1a, 0x66, 0x8d, 0x4a, 0x0f, 0x2d,
+	0x6a, 0xc2, 0x8f, 0xf8, 0x2a, 0xd0, 0xa6, 0xcd, 0x3b, 0x22, 0x66, 0x2e, 0xc0, 0xc9, 0xa1, 0x45,
+	0x8f, 0x32, 0x30, 0xb6, 0x4e, 0xfc, 0xbd, 0x6d, 0x5c, 0xe3, 0x9f, 0xfc, 0x50, 0xb7, 0x81, 0xed,
+	0x76, 0xb0, 0x99, 0xfb, 0x8d, 0xc5, 0xe8, 0x37, 0x41, 0x6e, 0x09, 0x26, 0x64, 0xf8, 0x3d, 0xf4,
+	0x38, 0x4c, 0xc8, 0x24, 0xe5, 0xa5, 0x9c, 0x4f, 0xd5, 0x83, 0x07, 0x6d, 0x19, 0x3d, 0x60, 0xee,
+	0x77, 0x00, 0x9e, 0xb8, 0x4c, 0x58, 0xd0, 0xc1, 0x67, 0xdf, 0x63, 0x68, 0x15, 0x26, 0x83, 0xe5,
+	0x79, 0xaf, 0x1b, 0x03, 0xac, 0x07, 0x28, 0x8f, 0x57, 0xe5, 0xfe, 0x91, 0xff, 0xc0, 0xfd, 0xe1,
+	0x12, 0x87, 0x5c, 0xc5, 0xde, 0xba, 0x4c, 0xa5, 0xf1, 0x5a, 0x20, 0xc8, 0x75, 0x60, 0xae, 0x4f,
+	0x36, 0xe4, 0xf7, 0x92, 0x4b, 0x2f, 0xae, 0x56, 0x03, 0xf6, 0x2b, 0x30, 0x46, 0xda, 0x96, 0x60,
+	0x9d, 0x2a, 0x97, 0xb8, 0x8d, 0xbf, 0x6f, 0x67, 0x8b, 0x75, 0xb7, 0xc0, 0x1a, 0x84, 0x35, 0x2c,
+	0xa7, 0xee, 0x15, 0x1c, 0xc2, 0x36, 0x5d, 0xba, 0xbe, 0x30, 0x78, 0x48, 0x6f, 0xad, 0xd7, 0x17,
+	0xf8, 0xa1, 0xc9, 0x2b, 0x5c, 0x5c, 0xad, 0x7e, 0xe3, 0x09, 0x7e, 0xb0, 0xe6, 0x66, 0xb9, 0xb5,
+	0xdc, 0x4f, 0xa3, 0xf0, 0x81, 0x2b, 0x96, 0x17, 0x38, 0xf7, 0x02, 0x67, 0xdf, 0xe3, 0x85, 0xd6,
+	0xb6, 0xf1, 0x9a, 0x4b, 0x31, 0x73, 0xa9, 0x8c, 0x55, 0x7e, 0x38, 0x56, 0xcf, 0xd2, 0x3a, 0x76,
+	0xac, 0x97, 0x45, 0x2a, 0x3f, 0x4b, 0x57, 0x3d, 0x42, 0x43, 0xf4, 0xb5, 0x01, 0x13, 0xf7, 0x1c,
+	0x26, 0x9e, 0x2f, 0x2e, 0x35, 0x09, 0x95, 0x87, 0x4e, 0xbf, 0x81, 0x54, 0x38, 0x6a, 0x5b, 0x4d,
+	0xcb, 0x3f, 0xd5, 0x4d, 0x88, 0xdc, 0x3c, 0x17, 0x53, 0x3e, 0x8d, 0x6b, 0xbe, 0x98, 0x9f, 0xc2,
+	0x5b, 0xb8, 0x4e, 0xc4, 0x76, 0x36, 0xa1, 0x89, 0x6f, 0xa4, 0xc0, 0xb8, 0xdc, 0x13, 0x95, 0x31,
+	0xb1, 0x5a, 0x82, 0x66, 0xee, 0x8f, 0x00, 0x4e, 0x55, 0xc4, 0xa6, 0x3d, 0x94, 0x3b, 0x15, 0x18,
+	0x97, 0x53, 0x2e, 0x63, 0x71, 0x50, 0x16, 0xde, 0x21, 0x59, 0x02, 0x4d, 0xa4, 0x0f, 0x45, 0x35,
+	0x7a, 0x17, 0x51, 0x2d, 0xa7, 0xc2, 0xf6, 0x07, 0x63, 0x9c, 0xfb, 0x25, 0x80, 0x53, 0x7e, 0x8d,
+	0xbe, 0x1f, 0xf4, 0xef, 0x39, 0xd1, 0x7f, 0x03, 0xe0, 0xc9, 0x50, 0xb6, 0x95, 0xae, 0x55, 0x9f,
+	0x21, 0x1d, 0xef, 0x3e, 0x2f, 0xcf, 0x5e, 0x82, 0x44, 0x0f, 0x4f, 0x90, 0x58, 0x3f, 0x41, 0x72,
+	0xb7, 0x00, 0x7c, 0xf0, 0x32, 0x19, 0xe4, 0x79, 0x9f, 0x69, 0xce, 0xc2, 0xb1, 0x75, 0xd2, 0xe9,
+	0x5f, 0xd5, 0xc6, 0xbb, 0xdb, 0xd9, 0xd1, 0x67, 0x48, 0xa7, 0xba, 0xac, 0x8d, 0xae, 0x93, 0x4e,
+	0xd5, 0xcc, 0xfd, 0x35, 0x0a, 0x67, 0x06, 0x72, 0xf3, 0x2b, 0xe1, 0x75, 0x2a, 0x7c, 0x53, 0x1f,
+	0x3e, 0x32, 0x7e, 0x07, 0x8e, 0xf9, 0xd7, 0x7f, 0x71, 0x9a, 0x4f, 0x17, 0xff, 0x6f, 0xd8, 0x9d,
+	0xc6, 0x7b, 0xcb, 0x13, 0x7b, 0x65, 0x78, 0x0b, 0xc4, 0x73, 0x72, 0xd7, 0x90, 0x3a, 0x3c, 0x9f,
+	0xc8, 0x56, 0xcb, 0xa2, 0xc4, 0xd3, 0xb1, 0xbf, 0x7e, 0x8f, 0x74, 0x9c, 0x95, 0x3a, 0x25, 0x86,
+	0x2e, 0xc0, 0x09, 0x6c, 0xdb, 0xee, 0x26, 0x31, 0x75, 0xc3, 0x32, 0xa9, 0xa7, 0x8c, 0x8a, 0x33,
+	0x81, 0xb2, 0x57, 0x1e, 0xbd, 0x05, 0xa2, 0x99, 0xd9, 0xee, 0x76, 0x36, 0x55, 0xf2, 0x01, 0x95,
+	0xea, 0xb2, 0xe6, 0x69, 0x29, 0x09, 0xaf, 0x70, 0x74, 0xee, 0xdf, 0x00, 0xce, 0x0c, 0xac, 0x96,
+	0xaf, 0x24, 0xa0, 0x25, 0x18, 0xc7, 0x2d, 0x4b, 0xe7, 0x9b, 0xa1, 0xbf, 0x84, 0xa6, 0x87, 0x4d,
+	0xfa, 0x34, 0xee, 0x60, 0x66, 0x0c, 0xb7, 0xac, 0x67, 0xc8, 0xf0, 0x42, 0x8c, 0x1d, 0x7f, 0x21,
+	0xfe, 0x1e, 0xc0, 0x6c, 0x68, 0x21, 0x56, 0x42, 0x35, 0xe4, 0x7f, 0x71, 0x39, 0xfe, 0x03, 0xc0,
+	0x33, 0x97, 0xc9, 0x9d, 0xd8, 0xde, 0x67, 0xb2, 0xc6, 0x97, 0x51, 0xb0, 0xf7, 0xbb, 0x18, 0x2c,
+	0xda, 0x7f, 0x01, 0xf0, 0xcc, 0xca, 0x7f, 0x63, 0x74, 0xdf, 0xbd, 0xe3, 0xe8, 0x4e, 0xef, 0xbf,
+	0x4d, 0xf5, 0x31, 0x87, 0xee, 0x3e, 0xbf, 0x8e, 0xc2, 0xf4, 0xe0, 0xb1, 0x99, 0xcf, 0x66, 0x1d,
+	0x5b, 0x8e, 0xa0, 0x1c, 0xd5, 0xc4, 0x37, 0x2a, 0xc3, 0x44, 0x70, 0xfc, 0x95, 0x2e, 0x95, 0x61,
+	0x97, 0x57, 0x64, 0xff, 0x90, 0xbb, 0x9e, 0x1e, 0xba, 0x39, 0x70, 0xff, 0xf4, 0x9f, 0x12, 0x0a,
+	0x87, 0x1f, 0xe1, 0xbf, 0xbc, 0x6b, 0xe8, 0xbd, 0x1e, 0x6f, 0x7f, 0x32, 0x0a, 0x27, 0x24, 0xb7,
+	0x15, 0x71, 0x5d, 0x40, 0x4f, 0xc3, 0x11, 0xfe, 0xea, 0xab, 0x80, 0x03, 0x96, 0x72, 0xbf, 0x06,
+	0xf2, 0x19, 0xfd, 0x03, 0x88, 0x26, 0x40, 0xef, 0x7d, 0x40, 0x68, 0xa2, 0x12, 0x1c, 0x5f, 0x73,
+	0x5d, 0xa6, 0x0b, 0x33, 0xc7, 0x79, 0xa3, 0x48, 0x70, 0x35, 0xde, 0x81, 0xda, 0x30, 0x21, 0x2f,
+	0xb3, 0x41, 0x44, 0xff, 0xff, 0x80, 0x88, 0xfa, 0xac, 0x0b, 0xf2, 0x82, 0x7c, 0x57, 0xe1, 0xec,
+	0xb9, 0x42, 0x17, 0xe1, 0x09, 0x79, 0xab, 0xd2, 0x83, 0xe9, 0xf5, 0xdf, 0x79, 0x0f, 0xc9, 0x0b,
+	0x2d, 0x23, 0x55, 0x02, 0x81, 0x27, 0x5e, 0x9a, 0x5b, 0x72, 0x03, 0xf0, 0x5f, 0x9a, 0xaf, 0x69,
+	0x51, 0xab, 0x85, 0x28, 0x8c, 0x37, 0x09, 0xa3, 0x96, 0x11, 0x3c, 0x53, 0x9c, 0x3b, 0x7c, 0x50,
+	0x57, 0x7d, 0xf0, 0xdd, 0x8c, 0x29, 0x70, 0xc4, 0xef, 0x2c, 0xd8, 0xdc, 0xc0, 0x8e, 0x41, 0x4c,
+	0xc5, 0x90, 0xc7, 0xad, 0xe1, 0xb9, 0x58, 0x11, 0xff, 0x00, 0xb4, 0x1e, 0x70, 0xe6, 0xdb, 0x70,
+	0x62, 0x20, 0xa0, 0xc7, 0x49, 0xa9, 0x99, 0x45, 0x98, 0x0a, 0x13, 0xff, 0x22, 0xdd, 0x68, 0x38,
+	0x1d, 0x3f, 0x1e, 0x83, 0xd3, 0xbd, 0xe2, 0xe3, 0x38, 0xc4, 0xe0, 0x01, 0xe5, 0xd1, 0xe0, 0x4f,
+	0x5f, 0x29, 0xc3, 0x17, 0xf9, 0x4f, 0x4e, 0xe0, 0x88, 0x7b, 0x74, 0xb2, 0xa7, 0x55, 0x62, 0x68,
+	0x06, 0x26, 0x04, 0xd0, 0x70, 0xed, 0xe0, 0xdd, 0x36, 0x68, 0xa3, 0xe7, 0xe0, 0x83, 0x36, 0xf6,
+	0x98, 0x2e, 0xef, 0xd2, 0x94, 0x18, 0xc4, 0xda, 0x38, 0xea, 0x1b, 0x99, 0xef, 0x6b, 0x8a, 0x1b,
+	0xf0, 0x27, 0x4f, 0x93, 0xea, 0x25, 0x86, 0x9e, 0x82, 0xc9, 0x90, 0x61, 0x79, 0xb8, 0x38, 0x73,
+	0xe8, 0xd4, 0x6b, 0xb0, 0x6f, 0xa9, 0x47, 0xac, 0xdd, 0x12, 0x17, 0xe6, 0x30, 0xb1, 0xd1, 0xe3,
+	0x10, 0x5b, 0x15, 0xfa, 0x21, 0x62, 0x0f, 0xc1, 0x94, 0xb4, 0x69, 0xb8, 0x6d, 0x87, 0x89, 0x0b,
+	0xc8, 0x88, 0x96, 0xf4, 0x65, 0x15, 0x2e, 0x42, 0x37, 0xe0, 0x49, 0xe1, 0xbb, 0x77, 0x5d, 0x0f,
+	0x7b, 0x8f, 0x1f, 0xd1, 0xfb, 0x34, 0x37, 0x11, 0x5c, 0xe0, 0x43, 0xfe, 0x1f, 0x81, 0xe9, 0x9e,
+	0x5d, 0x9f, 0x41, 0x42, 0x30, 0x98, 0x08, 0xa4, 0x3e, 0x07, 0x1d, 0x66, 0xa8, 0xdb, 0x76, 0x4c,
+	0x9d, 0x51, 0xfe, 0xe6, 0xce, 0x8d, 0x8b, 0x47, 0xac, 0x64, 0xf1, 0xc9, 0x03, 0x82, 0x38, 0x94,
+	0x3b, 0x05, 0x8d, 0xab, 0x5f, 0xa7, 0x56, 0x4b, 0x30, 0xd3, 0xd2, 0x74, 0xa0, 0x3d, 0xf3, 0x4f,
+	0x00, 0xd3, 0x83, 0x10, 0x74, 0x01, 0xc6, 0x9a, 0x72, 0xaf, 0x48, 0x16, 0x4f, 0xee, 0x1b, 0xe1,
+	0xb2, 0x7c, 0x30, 0x17, 0x35, 0xf0, 0xb7, 0x41, 0x0d, 0x7c, 0x9b, 0x0f, 0x96, 0xeb, 0x09, 0x75,
+	0xbc, 0xa5, 0x44, 0xef, 0x46, 0x1d, 0x6f, 0xa1, 0x0a, 0x1c, 0x6b, 0x12, 0xd3, 0xc2, 0x8e, 0x12,
+	0x3b, 0xbe, 0x05, 0xa9, 0xca, 0x57, 0x99, 0x1f, 0x54, 0x71, 0x1b, 0xd5, 0xfc, 0x46, 0xf9, 0x57,
+	0xe0, 0xc3, 0x1d, 0x15, 0xdc, 0xde, 0x51, 0xc1, 0x47, 0x3b, 0x6a, 0xe4, 0x93, 0x1d, 0x35, 0xf2,
+	0xe9, 0x8e, 0x1a, 0xf9, 0x6c, 0x47, 0x8d, 0x7c, 0xbe, 0xa3, 0x82, 0xd7, 0xba, 0x2a, 0x78, 0xa3,
+	0xab, 0x46, 0xde, 0xed, 0xaa, 0xe0, 0xbd, 0xae, 0x1a, 0x79, 0xbf, 0xab, 0x46, 0x3e, 0xe8, 0xaa,
+	0x91, 0x0f, 0xbb, 0x2a, 0xb8, 0xdd, 0x55, 0xc1, 0x47, 0x5d, 0x35, 0xf2, 0x49, 0x57, 0x05, 0x9f,
+	0x76, 0xd5, 0xc8, 0x67, 0x5d, 0x15, 0x7c, 0xde, 0x55, 0x23, 0xaf, 0xed, 0xaa, 0x91, 0x37, 0x76,
+	0x55, 0xf0, 0xd6, 0xae, 0x1a, 0x79, 0x7b, 0x57, 0x05, 0xef, 0xec, 0xaa, 0x91, 0x77, 0x77, 0xd5,
+	0xc8, 0x7b, 0xbb, 0x2a, 0x78, 0x7f, 0x57, 0x05, 0x1f, 0xec, 0xaa, 0xe0, 0xf9, 0xf3, 0x47, 0x7d,
+	0x1c, 0x60, 0x4e, 0x6b, 0x6d, 0x6d, 0x4c, 0x8c, 0xf3, 0xf1, 0xff, 0x0c, 0x00, 0x2c, 0x5a, 0xab,
+	0xb2, 0x93, 0x1d, 0x00, 0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2077,6 +2142,13 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
 	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
 	if this.Name != that1.Name {
 		return false
 	}
@@ -2114,6 +2186,14 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if this.FrequencyPlanID != that1.FrequencyPlanID {
 		return false
 	}
+	if len(this.FrequencyPlanIDs) != len(that1.FrequencyPlanIDs) {
+		return false
+	}
+	for i := range this.FrequencyPlanIDs {
+		if this.FrequencyPlanIDs[i] != that1.FrequencyPlanIDs[i] {
+			return false
+		}
+	}
 	if len(this.Antennas) != len(that1.Antennas) {
 		return false
 	}
@@ -2253,6 +2333,9 @@ func (this *ListGatewaysRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateGatewayRequest) Equal(that interface{}) bool {
@@ -2399,6 +2482,21 @@ func (this *CreateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
@@ -2426,6 +2524,9 @@ func (this *UpdateGatewayAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListGatewayCollaboratorsRequest) Equal(that interface{}) bool {
@@ -3160,6 +3261,35 @@ func (m *Gateway) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.DownlinkPathConstraint))
 	}
+	if len(m.FrequencyPlanIDs) > 0 {
+		for _, s := range m.FrequencyPlanIDs {
+			dAtA[i] = 0x9a
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)))
+		n7, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
 	return i, nil
 }
 
@@ -3211,19 +3341,19 @@ func (m *GetGatewayRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n7, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n8, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.FieldMask.Size()))
-	n8, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n9, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	return i, nil
 }
 
@@ -3245,11 +3375,11 @@ func (m *GetGatewayIdentifiersForEUIRequest) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.EUI.Size()))
-	n9, err := m.EUI.MarshalTo(dAtA[i:])
+	n10, err := m.EUI.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	return i, nil
 }
 
@@ -3272,20 +3402,20 @@ func (m *ListGatewaysRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.Collaborator.Size()))
-		n10, err := m.Collaborator.MarshalTo(dAtA[i:])
+		n11, err := m.Collaborator.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.FieldMask.Size()))
-	n11, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n12, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -3302,6 +3432,16 @@ func (m *ListGatewaysRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.Page))
 	}
+	if m.Deleted {
+		dAtA[i] = 0x30
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -3323,19 +3463,19 @@ func (m *CreateGatewayRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.Gateway.Size()))
-	n12, err := m.Gateway.MarshalTo(dAtA[i:])
+	n13, err := m.Gateway.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.Collaborator.Size()))
-	n13, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n14, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	return i, nil
 }
 
@@ -3357,19 +3497,19 @@ func (m *UpdateGatewayRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.Gateway.Size()))
-	n14, err := m.Gateway.MarshalTo(dAtA[i:])
+	n15, err := m.Gateway.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.FieldMask.Size()))
-	n15, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n16, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	return i, nil
 }
 
@@ -3391,11 +3531,11 @@ func (m *ListGatewayAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n16, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n17, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -3427,11 +3567,11 @@ func (m *GetGatewayAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n17, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n18, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	if len(m.KeyID) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -3459,11 +3599,11 @@ func (m *CreateGatewayAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n18, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -3471,21 +3611,46 @@ func (m *CreateGatewayAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.Name)
 	}
 	if len(m.Rights) > 0 {
-		dAtA20 := make([]byte, len(m.Rights)*10)
-		var j19 int
+		dAtA21 := make([]byte, len(m.Rights)*10)
+		var j20 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j20++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA21[j20] = uint8(num)
+			j20++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintGateway(dAtA, i, uint64(j19))
-		i += copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintGateway(dAtA, i, uint64(j20))
+		i += copy(dAtA[i:], dAtA21[:j20])
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
@@ -3508,19 +3673,27 @@ func (m *UpdateGatewayAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n21, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n23, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n23
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.APIKey.Size()))
-	n22, err := m.APIKey.MarshalTo(dAtA[i:])
+	n24, err := m.APIKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintGateway(dAtA, i, uint64(m.FieldMask.Size()))
+	n25, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	return i, nil
 }
 
@@ -3542,11 +3715,11 @@ func (m *ListGatewayCollaboratorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n23, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n26, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -3578,19 +3751,19 @@ func (m *GetGatewayCollaboratorRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n24, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n27, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n27
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n25, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n28, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n28
 	return i, nil
 }
 
@@ -3612,19 +3785,19 @@ func (m *SetGatewayCollaboratorRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.GatewayIdentifiers.Size()))
-	n26, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
+	n29, err := m.GatewayIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n29
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.Collaborator.Size()))
-	n27, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n30, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n30
 	return i, nil
 }
 
@@ -3652,11 +3825,11 @@ func (m *GatewayAntenna) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(m.Location.Size()))
-	n28, err := m.Location.MarshalTo(dAtA[i:])
+	n31, err := m.Location.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n31
 	if len(m.Attributes) > 0 {
 		for k := range m.Attributes {
 			dAtA[i] = 0x1a
@@ -3695,19 +3868,19 @@ func (m *GatewayStatus) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)))
-	n29, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
+	n32, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n32
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.BootTime)))
-	n30, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i:])
+	n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.BootTime, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n33
 	if len(m.Versions) > 0 {
 		for k := range m.Versions {
 			dAtA[i] = 0x1a
@@ -3775,11 +3948,11 @@ func (m *GatewayStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.Advanced.Size()))
-		n31, err := m.Advanced.MarshalTo(dAtA[i:])
+		n34, err := m.Advanced.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n34
 	}
 	return i, nil
 }
@@ -3803,11 +3976,11 @@ func (m *GatewayConnectionStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ConnectedAt)))
-		n32, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i:])
+		n35, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ConnectedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n35
 	}
 	if len(m.Protocol) > 0 {
 		dAtA[i] = 0x12
@@ -3819,31 +3992,31 @@ func (m *GatewayConnectionStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastStatusReceivedAt)))
-		n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i:])
+		n36, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastStatusReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n36
 	}
 	if m.LastStatus != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.LastStatus.Size()))
-		n34, err := m.LastStatus.MarshalTo(dAtA[i:])
+		n37, err := m.LastStatus.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n37
 	}
 	if m.LastUplinkReceivedAt != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUplinkReceivedAt)))
-		n35, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i:])
+		n38, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUplinkReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n38
 	}
 	if m.UplinkCount != 0 {
 		dAtA[i] = 0x30
@@ -3854,11 +4027,11 @@ func (m *GatewayConnectionStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkReceivedAt)))
-		n36, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i:])
+		n39, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n39
 	}
 	if m.DownlinkCount != 0 {
 		dAtA[i] = 0x40
@@ -3869,11 +4042,11 @@ func (m *GatewayConnectionStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintGateway(dAtA, i, uint64(m.RoundTripTimes.Size()))
-		n37, err := m.RoundTripTimes.MarshalTo(dAtA[i:])
+		n40, err := m.RoundTripTimes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n40
 	}
 	return i, nil
 }
@@ -3896,27 +4069,27 @@ func (m *GatewayConnectionStats_RoundTripTimes) MarshalTo(dAtA []byte) (int, err
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Min)))
-	n38, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i:])
+	n41, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Min, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n41
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Max)))
-	n39, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i:])
+	n42, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Max, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n42
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintGateway(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.Median)))
-	n40, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i:])
+	n43, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Median, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n43
 	if m.Count != 0 {
 		dAtA[i] = 0x20
 		i++
@@ -4040,6 +4213,14 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	this.ScheduleDownlinkLate = bool(r.Intn(2) == 0)
 	this.EnforceDutyCycle = bool(r.Intn(2) == 0)
 	this.DownlinkPathConstraint = DownlinkPathConstraint([]int32{0, 1, 2}[r.Intn(3)])
+	v10 := r.Intn(10)
+	this.FrequencyPlanIDs = make([]string, v10)
+	for i := 0; i < v10; i++ {
+		this.FrequencyPlanIDs[i] = randStringGateway(r)
+	}
+	if r.Intn(10) != 0 {
+		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4048,9 +4229,9 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 	this := &Gateways{}
 	if r.Intn(10) != 0 {
-		v10 := r.Intn(5)
-		this.Gateways = make([]*Gateway, v10)
-		for i := 0; i < v10; i++ {
+		v11 := r.Intn(5)
+		this.Gateways = make([]*Gateway, v11)
+		for i := 0; i < v11; i++ {
 			this.Gateways[i] = NewPopulatedGateway(r, easy)
 		}
 	}
@@ -4061,10 +4242,10 @@ func NewPopulatedGateways(r randyGateway, easy bool) *Gateways {
 
 func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest {
 	this := &GetGatewayRequest{}
-	v11 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v12 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v12
+	v13 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v13
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4072,8 +4253,8 @@ func NewPopulatedGetGatewayRequest(r randyGateway, easy bool) *GetGatewayRequest
 
 func NewPopulatedGetGatewayIdentifiersForEUIRequest(r randyGateway, easy bool) *GetGatewayIdentifiersForEUIRequest {
 	this := &GetGatewayIdentifiersForEUIRequest{}
-	v13 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.EUI = *v13
+	v14 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.EUI = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4084,11 +4265,12 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 	if r.Intn(10) != 0 {
 		this.Collaborator = NewPopulatedOrganizationOrUserIdentifiers(r, easy)
 	}
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v15 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v15
 	this.Order = randStringGateway(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4096,10 +4278,10 @@ func NewPopulatedListGatewaysRequest(r randyGateway, easy bool) *ListGatewaysReq
 
 func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayRequest {
 	this := &CreateGatewayRequest{}
-	v15 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v15
-	v16 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.Collaborator = *v16
+	v16 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v16
+	v17 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.Collaborator = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4107,10 +4289,10 @@ func NewPopulatedCreateGatewayRequest(r randyGateway, easy bool) *CreateGatewayR
 
 func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayRequest {
 	this := &UpdateGatewayRequest{}
-	v17 := NewPopulatedGateway(r, easy)
-	this.Gateway = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v18 := NewPopulatedGateway(r, easy)
+	this.Gateway = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4118,8 +4300,8 @@ func NewPopulatedUpdateGatewayRequest(r randyGateway, easy bool) *UpdateGatewayR
 
 func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatewayAPIKeysRequest {
 	this := &ListGatewayAPIKeysRequest{}
-	v19 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v19
+	v20 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v20
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4129,8 +4311,8 @@ func NewPopulatedListGatewayAPIKeysRequest(r randyGateway, easy bool) *ListGatew
 
 func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayAPIKeyRequest {
 	this := &GetGatewayAPIKeyRequest{}
-	v20 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v20
+	v21 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v21
 	this.KeyID = randStringGateway(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4139,14 +4321,22 @@ func NewPopulatedGetGatewayAPIKeyRequest(r randyGateway, easy bool) *GetGatewayA
 
 func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGatewayAPIKeyRequest {
 	this := &CreateGatewayAPIKeyRequest{}
-	v21 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v21
+	v22 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v22
 	this.Name = randStringGateway(r)
-	v22 := r.Intn(10)
-	this.Rights = make([]Right, v22)
-	for i := 0; i < v22; i++ {
+	v23 := r.Intn(10)
+	this.Rights = make([]Right, v23)
+	for i := 0; i < v23; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v24 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v24)
+	for i := 0; i < v24; i++ {
+		this.AllowedCIDRs[i] = randStringGateway(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4154,10 +4344,12 @@ func NewPopulatedCreateGatewayAPIKeyRequest(r randyGateway, easy bool) *CreateGa
 
 func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGatewayAPIKeyRequest {
 	this := &UpdateGatewayAPIKeyRequest{}
-	v23 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v23
-	v24 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v24
+	v25 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v25
+	v26 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v26
+	v27 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v27
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4165,8 +4357,8 @@ func NewPopulatedUpdateGatewayAPIKeyRequest(r randyGateway, easy bool) *UpdateGa
 
 func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *ListGatewayCollaboratorsRequest {
 	this := &ListGatewayCollaboratorsRequest{}
-	v25 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v25
+	v28 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v28
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -4176,10 +4368,10 @@ func NewPopulatedListGatewayCollaboratorsRequest(r randyGateway, easy bool) *Lis
 
 func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGatewayCollaboratorRequest {
 	this := &GetGatewayCollaboratorRequest{}
-	v26 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v26
-	v27 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v27
+	v29 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v29
+	v30 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v30
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4187,10 +4379,10 @@ func NewPopulatedGetGatewayCollaboratorRequest(r randyGateway, easy bool) *GetGa
 
 func NewPopulatedSetGatewayCollaboratorRequest(r randyGateway, easy bool) *SetGatewayCollaboratorRequest {
 	this := &SetGatewayCollaboratorRequest{}
-	v28 := NewPopulatedGatewayIdentifiers(r, easy)
-	this.GatewayIdentifiers = *v28
-	v29 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v29
+	v31 := NewPopulatedGatewayIdentifiers(r, easy)
+	this.GatewayIdentifiers = *v31
+	v32 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v32
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4202,12 +4394,12 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 	if r.Intn(2) == 0 {
 		this.Gain *= -1
 	}
-	v30 := NewPopulatedLocation(r, easy)
-	this.Location = *v30
+	v33 := NewPopulatedLocation(r, easy)
+	this.Location = *v33
 	if r.Intn(10) != 0 {
-		v31 := r.Intn(10)
+		v34 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v31; i++ {
+		for i := 0; i < v34; i++ {
 			this.Attributes[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
@@ -4218,37 +4410,37 @@ func NewPopulatedGatewayAntenna(r randyGateway, easy bool) *GatewayAntenna {
 
 func NewPopulatedGatewayStatus(r randyGateway, easy bool) *GatewayStatus {
 	this := &GatewayStatus{}
-	v32 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.Time = *v32
-	v33 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.BootTime = *v33
+	v35 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v35
+	v36 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.BootTime = *v36
 	if r.Intn(10) != 0 {
-		v34 := r.Intn(10)
+		v37 := r.Intn(10)
 		this.Versions = make(map[string]string)
-		for i := 0; i < v34; i++ {
+		for i := 0; i < v37; i++ {
 			this.Versions[randStringGateway(r)] = randStringGateway(r)
 		}
 	}
 	if r.Intn(10) != 0 {
-		v35 := r.Intn(5)
-		this.AntennaLocations = make([]*Location, v35)
-		for i := 0; i < v35; i++ {
+		v38 := r.Intn(5)
+		this.AntennaLocations = make([]*Location, v38)
+		for i := 0; i < v38; i++ {
 			this.AntennaLocations[i] = NewPopulatedLocation(r, easy)
 		}
 	}
-	v36 := r.Intn(10)
-	this.IP = make([]string, v36)
-	for i := 0; i < v36; i++ {
+	v39 := r.Intn(10)
+	this.IP = make([]string, v39)
+	for i := 0; i < v39; i++ {
 		this.IP[i] = randStringGateway(r)
 	}
 	if r.Intn(10) != 0 {
-		v37 := r.Intn(10)
+		v40 := r.Intn(10)
 		this.Metrics = make(map[string]float32)
-		for i := 0; i < v37; i++ {
-			v38 := randStringGateway(r)
-			this.Metrics[v38] = float32(r.Float32())
+		for i := 0; i < v40; i++ {
+			v41 := randStringGateway(r)
+			this.Metrics[v41] = float32(r.Float32())
 			if r.Intn(2) == 0 {
-				this.Metrics[v38] *= -1
+				this.Metrics[v41] *= -1
 			}
 		}
 	}
@@ -4290,12 +4482,12 @@ func NewPopulatedGatewayConnectionStats(r randyGateway, easy bool) *GatewayConne
 
 func NewPopulatedGatewayConnectionStats_RoundTripTimes(r randyGateway, easy bool) *GatewayConnectionStats_RoundTripTimes {
 	this := &GatewayConnectionStats_RoundTripTimes{}
-	v39 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Min = *v39
-	v40 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Max = *v40
-	v41 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
-	this.Median = *v41
+	v42 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Min = *v42
+	v43 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Max = *v43
+	v44 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Median = *v44
 	this.Count = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -4321,9 +4513,9 @@ func randUTF8RuneGateway(r randyGateway) rune {
 	return rune(ru + 61)
 }
 func randStringGateway(r randyGateway) string {
-	v42 := r.Intn(100)
-	tmps := make([]rune, v42)
-	for i := 0; i < v42; i++ {
+	v45 := r.Intn(100)
+	tmps := make([]rune, v45)
+	for i := 0; i < v45; i++ {
 		tmps[i] = randUTF8RuneGateway(r)
 	}
 	return string(tmps)
@@ -4345,11 +4537,11 @@ func randFieldGateway(dAtA []byte, r randyGateway, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
-		v43 := r.Int63()
+		v46 := r.Int63()
 		if r.Intn(2) == 0 {
-			v43 *= -1
+			v46 *= -1
 		}
-		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v43))
+		dAtA = encodeVarintPopulateGateway(dAtA, uint64(v46))
 	case 1:
 		dAtA = encodeVarintPopulateGateway(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -4589,6 +4781,16 @@ func (m *Gateway) Size() (n int) {
 	if m.DownlinkPathConstraint != 0 {
 		n += 2 + sovGateway(uint64(m.DownlinkPathConstraint))
 	}
+	if len(m.FrequencyPlanIDs) > 0 {
+		for _, s := range m.FrequencyPlanIDs {
+			l = len(s)
+			n += 2 + l + sovGateway(uint64(l))
+		}
+	}
+	if m.DeletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)
+		n += 2 + l + sovGateway(uint64(l))
+	}
 	return n
 }
 
@@ -4653,6 +4855,9 @@ func (m *ListGatewaysRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovGateway(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -4733,6 +4938,16 @@ func (m *CreateGatewayAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovGateway(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovGateway(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovGateway(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4746,6 +4961,8 @@ func (m *UpdateGatewayAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovGateway(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovGateway(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovGateway(uint64(l))
 	return n
 }
 
@@ -5043,6 +5260,8 @@ func (this *Gateway) String() string {
 		`ScheduleDownlinkLate:` + fmt.Sprintf("%v", this.ScheduleDownlinkLate) + `,`,
 		`EnforceDutyCycle:` + fmt.Sprintf("%v", this.EnforceDutyCycle) + `,`,
 		`DownlinkPathConstraint:` + fmt.Sprintf("%v", this.DownlinkPathConstraint) + `,`,
+		`FrequencyPlanIDs:` + fmt.Sprintf("%v", this.FrequencyPlanIDs) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5088,6 +5307,7 @@ func (this *ListGatewaysRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5145,6 +5365,8 @@ func (this *CreateGatewayAPIKeyRequest) String() string {
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5156,6 +5378,7 @@ func (this *UpdateGatewayAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateGatewayAPIKeyRequest{`,
 		`GatewayIdentifiers:` + strings.Replace(strings.Replace(this.GatewayIdentifiers.String(), "GatewayIdentifiers", "GatewayIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6889,6 +7112,74 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanIDs = append(m.FrequencyPlanIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -7373,6 +7664,26 @@ func (m *ListGatewaysRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8040,6 +8351,74 @@ func (m *CreateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -8159,6 +8538,39 @@ func (m *UpdateGatewayAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGateway
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGateway
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -80,10 +80,12 @@ var GatewayFieldPathsNested = []string{
 	"auto_update",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"downlink_path_constraint",
 	"enforce_duty_cycle",
 	"frequency_plan_id",
+	"frequency_plan_ids",
 	"gateway_server_address",
 	"ids",
 	"ids.eui",
@@ -107,10 +109,12 @@ var GatewayFieldPathsTopLevel = []string{
 	"auto_update",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"downlink_path_constraint",
 	"enforce_duty_cycle",
 	"frequency_plan_id",
+	"frequency_plan_ids",
 	"gateway_server_address",
 	"ids",
 	"location_public",
@@ -154,6 +158,7 @@ var ListGatewaysRequestFieldPathsNested = []string{
 	"collaborator.ids.user_ids",
 	"collaborator.ids.user_ids.email",
 	"collaborator.ids.user_ids.user_id",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -162,6 +167,7 @@ var ListGatewaysRequestFieldPathsNested = []string{
 
 var ListGatewaysRequestFieldPathsTopLevel = []string{
 	"collaborator",
+	"deleted",
 	"field_mask",
 	"limit",
 	"order",
@@ -181,10 +187,12 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.auto_update",
 	"gateway.contact_info",
 	"gateway.created_at",
+	"gateway.deleted_at",
 	"gateway.description",
 	"gateway.downlink_path_constraint",
 	"gateway.enforce_duty_cycle",
 	"gateway.frequency_plan_id",
+	"gateway.frequency_plan_ids",
 	"gateway.gateway_server_address",
 	"gateway.ids",
 	"gateway.ids.eui",
@@ -214,10 +222,12 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.auto_update",
 	"gateway.contact_info",
 	"gateway.created_at",
+	"gateway.deleted_at",
 	"gateway.description",
 	"gateway.downlink_path_constraint",
 	"gateway.enforce_duty_cycle",
 	"gateway.frequency_plan_id",
+	"gateway.frequency_plan_ids",
 	"gateway.gateway_server_address",
 	"gateway.ids",
 	"gateway.ids.eui",
@@ -264,6 +274,8 @@ var GetGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"key_id",
 }
 var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -272,16 +284,22 @@ var CreateGatewayAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"gateway_ids",
 	"name",
 	"rights",
 }
 var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -289,6 +307,7 @@ var UpdateGatewayAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateGatewayAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"gateway_ids",
 }
 var ListGatewayCollaboratorsRequestFieldPathsNested = []string{
@@ -623,6 +623,18 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "deleted_at":
+
+			if v, ok := interface{}(m.GetDeletedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "deleted_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "name":
 
 			if utf8.RuneCountInString(m.GetName()) > 50 {
@@ -714,6 +726,27 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "frequency_plan_ids":
+
+			if len(m.GetFrequencyPlanIDs()) > 8 {
+				return GatewayValidationError{
+					field:  "frequency_plan_ids",
+					reason: "value must contain no more than 8 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetFrequencyPlanIDs() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 64 {
+					return GatewayValidationError{
+						field:  fmt.Sprintf("frequency_plan_ids[%v]", idx),
+						reason: "value length must be at most 64 runes",
+					}
+				}
+
+			}
+
 		case "antennas":
 
 			for idx, item := range m.Antennas {
@@ -1153,6 +1186,8 @@ func (m *ListGatewaysRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListGatewaysRequestValidationError{
 				field:  name,
@@ -1679,6 +1714,27 @@ func (m *CreateGatewayAPIKeyRequest) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateGatewayAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			if len(m.GetAllowedCIDRs()) > 32 {
+				return CreateGatewayAPIKeyRequestValidationError{
+					field:  "allowed_cidrs",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
 		default:
 			return CreateGatewayAPIKeyRequestValidationError{
 				field:  name,
@@ -1785,6 +1841,18 @@ func (m *UpdateGatewayAPIKeyRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateGatewayAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateGatewayAPIKeyRequestValidationError{
 				field:  name,
@@ -6,8 +6,11 @@ var AuthInfoResponseFieldPathsNested = []string{
 	"access_method",
 	"access_method.api_key",
 	"access_method.api_key.api_key",
+	"access_method.api_key.api_key.allowed_cidrs",
+	"access_method.api_key.api_key.expires_at",
 	"access_method.api_key.api_key.id",
 	"access_method.api_key.api_key.key",
+	"access_method.api_key.api_key.last_used_at",
 	"access_method.api_key.api_key.name",
 	"access_method.api_key.api_key.rights",
 	"access_method.api_key.entity_ids",
@@ -55,8 +58,11 @@ var AuthInfoResponseFieldPathsTopLevel = []string{
 }
 var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
 	"entity_ids",
@@ -809,6 +809,54 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ProvisionEndDevicesRequest_IdentifiersFromData proto.InternalMessageInfo
 
+type VerifyClaimAuthenticationCodeRequest struct {
+	JoinEUI go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui"`
+	DevEUI  go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,2,opt,name=dev_eui,json=devEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"dev_eui"`
+	// The claim authentication code to verify.
+	AuthenticationCode   []byte   `protobuf:"bytes,3,opt,name=authentication_code,json=authenticationCode,proto3" json:"authentication_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyClaimAuthenticationCodeRequest) Reset()      { *m = VerifyClaimAuthenticationCodeRequest{} }
+func (*VerifyClaimAuthenticationCodeRequest) ProtoMessage() {}
+func (*VerifyClaimAuthenticationCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b695d5f526759a7, []int{9}
+}
+func (m *VerifyClaimAuthenticationCodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyClaimAuthenticationCodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyClaimAuthenticationCodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VerifyClaimAuthenticationCodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyClaimAuthenticationCodeRequest.Merge(m, src)
+}
+func (m *VerifyClaimAuthenticationCodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyClaimAuthenticationCodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyClaimAuthenticationCodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyClaimAuthenticationCodeRequest proto.InternalMessageInfo
+
+func (m *VerifyClaimAuthenticationCodeRequest) GetAuthenticationCode() []byte {
+	if m != nil {
+		return m.AuthenticationCode
+	}
+	return nil
+}
+
 type JoinEUIPrefix struct {
 	JoinEUI              go_thethings_network_lorawan_stack_pkg_types.EUI64 `protobuf:"bytes,1,opt,name=join_eui,json=joinEui,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.EUI64" json:"join_eui"`
 	Length               uint32                                             `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
@@ -819,7 +867,7 @@ type JoinEUIPrefix struct {
 func (m *JoinEUIPrefix) Reset()      { *m = JoinEUIPrefix{} }
 func (*JoinEUIPrefix) ProtoMessage() {}
 func (*JoinEUIPrefix) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b695d5f526759a7, []int{9}
+	return fileDescriptor_1b695d5f526759a7, []int{10}
 }
 func (m *JoinEUIPrefix) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -864,7 +912,7 @@ type JoinEUIPrefixes struct {
 func (m *JoinEUIPrefixes) Reset()      { *m = JoinEUIPrefixes{} }
 func (*JoinEUIPrefixes) ProtoMessage() {}
 func (*JoinEUIPrefixes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b695d5f526759a7, []int{10}
+	return fileDescriptor_1b695d5f526759a7, []int{11}
 }
 func (m *JoinEUIPrefixes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,6 +973,8 @@ func init() {
 	golang_proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersRange)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersRange")
 	proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersFromData)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersFromData")
 	golang_proto.RegisterType((*ProvisionEndDevicesRequest_IdentifiersFromData)(nil), "ttn.lorawan.v3.ProvisionEndDevicesRequest.IdentifiersFromData")
+	proto.RegisterType((*VerifyClaimAuthenticationCodeRequest)(nil), "ttn.lorawan.v3.VerifyClaimAuthenticationCodeRequest")
+	golang_proto.RegisterType((*VerifyClaimAuthenticationCodeRequest)(nil), "ttn.lorawan.v3.VerifyClaimAuthenticationCodeRequest")
 	proto.RegisterType((*JoinEUIPrefix)(nil), "ttn.lorawan.v3.JoinEUIPrefix")
 	golang_proto.RegisterType((*JoinEUIPrefix)(nil), "ttn.lorawan.v3.JoinEUIPrefix")
 	proto.RegisterType((*JoinEUIPrefixes)(nil), "ttn.lorawan.v3.JoinEUIPrefixes")
@@ -937,122 +987,128 @@ func init() {
 }
 
 var fileDescriptor_1b695d5f526759a7 = []byte{
-	// 1829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6c, 0x1b, 0xc7,
-	0x15, 0xdd, 0x21, 0x45, 0x4a, 0x1a, 0x49, 0x94, 0x3c, 0x76, 0x13, 0x96, 0x76, 0x96, 0x0e, 0xa3,
-	0xb6, 0xae, 0x63, 0x91, 0x01, 0xd3, 0x06, 0xa9, 0x82, 0xda, 0x20, 0x45, 0x56, 0xa2, 0x65, 0xa9,
-	0xea, 0xb2, 0x69, 0x53, 0x25, 0x0a, 0xb3, 0x22, 0x47, 0xf4, 0x9a, 0xd4, 0xec, 0x76, 0x67, 0x44,
-	0x85, 0x49, 0x0d, 0x18, 0x3e, 0x04, 0x6e, 0xd1, 0x43, 0x81, 0x36, 0x40, 0x8f, 0x45, 0x7b, 0x68,
-	0x0e, 0x3d, 0x04, 0xbd, 0x34, 0xa7, 0x22, 0x87, 0x1e, 0xdc, 0x9b, 0x8b, 0x5e, 0x82, 0x1e, 0xd4,
-	0x68, 0xd9, 0x43, 0x8e, 0x39, 0x15, 0x81, 0x4e, 0xc5, 0xcc, 0x0e, 0xc9, 0xe5, 0x92, 0x92, 0x45,
-	0x45, 0x32, 0xd0, 0xdb, 0xcc, 0xce, 0xff, 0x6f, 0xfe, 0x7f, 0xff, 0xff, 0xd9, 0xff, 0x61, 0xa2,
-	0x6e, 0xda, 0xfa, 0xae, 0x4e, 0xe6, 0x28, 0xd3, 0xcb, 0xb5, 0x94, 0x6e, 0x19, 0xa9, 0x3b, 0xa6,
-	0x41, 0x28, 0xb6, 0x1b, 0xd8, 0x4e, 0x5a, 0xb6, 0xc9, 0x4c, 0x14, 0x61, 0x8c, 0x24, 0xa5, 0x5c,
-	0xb2, 0xf1, 0x62, 0x2c, 0x53, 0x35, 0xd8, 0xed, 0x9d, 0xcd, 0x64, 0xd9, 0xdc, 0x4e, 0x61, 0xd2,
-	0x30, 0x9b, 0x96, 0x6d, 0xbe, 0xdd, 0x4c, 0x09, 0xe1, 0xf2, 0x5c, 0x15, 0x93, 0xb9, 0x86, 0x5e,
-	0x37, 0x2a, 0x3a, 0xc3, 0xa9, 0xbe, 0x85, 0x0b, 0x19, 0x9b, 0xf3, 0x40, 0x54, 0xcd, 0xaa, 0xe9,
-	0x2a, 0x6f, 0xee, 0x6c, 0x89, 0x9d, 0xd8, 0x88, 0x95, 0x14, 0xbf, 0x54, 0x35, 0xcd, 0x6a, 0x1d,
-	0x0b, 0xf3, 0x74, 0x42, 0x4c, 0xa6, 0x33, 0xc3, 0x24, 0x54, 0x9e, 0x5e, 0x94, 0xa7, 0x1d, 0x0c,
-	0xbc, 0x6d, 0xb1, 0xa6, 0x4f, 0xb5, 0x73, 0x48, 0x99, 0xbd, 0x53, 0x66, 0xf2, 0x74, 0x80, 0xfb,
-	0x98, 0x54, 0x4a, 0x15, 0xdc, 0x30, 0xca, 0x6d, 0x5b, 0x9f, 0xeb, 0x97, 0x31, 0x2a, 0x98, 0x30,
-	0x63, 0xcb, 0xc0, 0x76, 0xdb, 0x86, 0x4b, 0x83, 0x79, 0x3c, 0xfc, 0xb4, 0x86, 0x9b, 0x6d, 0xdd,
-	0x78, 0xff, 0x69, 0x9b, 0x6d, 0x21, 0x90, 0xf8, 0x4d, 0x00, 0x9e, 0x2b, 0x62, 0x4a, 0x0d, 0x93,
-	0x2c, 0xe3, 0xa6, 0x86, 0x7f, 0xba, 0x83, 0x29, 0x43, 0xd7, 0x61, 0x84, 0xba, 0x1f, 0x4b, 0x35,
-	0xdc, 0x2c, 0x19, 0x95, 0x28, 0xb8, 0x0c, 0xae, 0x4c, 0x66, 0xa3, 0x07, 0xd9, 0xd0, 0x3b, 0xc1,
-	0xe8, 0xbd, 0x19, 0x67, 0x2f, 0x3e, 0xd9, 0x55, 0x2b, 0xe4, 0xb4, 0x49, 0xda, 0xdd, 0x55, 0xd0,
-	0x06, 0x1c, 0xad, 0xe0, 0x46, 0x09, 0xef, 0x18, 0xd1, 0x80, 0x50, 0xcc, 0x3d, 0xdc, 0x8b, 0x2b,
-	0xff, 0xda, 0x8b, 0xa7, 0xab, 0x66, 0x92, 0xdd, 0xc6, 0xec, 0xb6, 0x41, 0xaa, 0x34, 0x49, 0x30,
-	0xdb, 0x35, 0xed, 0x5a, 0xaa, 0xd7, 0x48, 0xab, 0x56, 0x4d, 0xb1, 0xa6, 0x85, 0x69, 0x32, 0xff,
-	0x6a, 0xe1, 0xa5, 0x6f, 0x39, 0x7b, 0xf1, 0x70, 0x0e, 0x37, 0xf2, 0xaf, 0x16, 0xb4, 0x70, 0x05,
-	0x37, 0xf2, 0x3b, 0x06, 0x7a, 0x0b, 0x8e, 0x71, 0x06, 0x04, 0x7e, 0x50, 0xe0, 0xe7, 0xbf, 0x14,
-	0xfe, 0xe8, 0x4d, 0xd3, 0x20, 0xfc, 0x82, 0x51, 0x0e, 0x9b, 0xdf, 0x31, 0x12, 0xf7, 0x03, 0x70,
-	0x66, 0x75, 0xb7, 0x56, 0x5c, 0xc6, 0x4d, 0xaa, 0x61, 0x6a, 0x99, 0x84, 0x62, 0xf4, 0x7d, 0x38,
-	0xbd, 0x55, 0x22, 0xbb, 0xb5, 0x12, 0x2d, 0x19, 0x84, 0x71, 0x66, 0x04, 0x2d, 0x13, 0xe9, 0x8b,
-	0xc9, 0xde, 0x34, 0x4e, 0x2e, 0xe3, 0x66, 0x9e, 0x34, 0x70, 0xdd, 0xb4, 0x70, 0x76, 0xf2, 0x20,
-	0x1b, 0xfa, 0x05, 0x08, 0xcc, 0x00, 0x6e, 0xa2, 0x36, 0xb1, 0xc5, 0x61, 0x0b, 0x84, 0x2d, 0xe3,
-	0x26, 0x07, 0xa4, 0x3e, 0xc0, 0xc0, 0xd0, 0x80, 0xd4, 0x03, 0x78, 0x0b, 0x4e, 0xb9, 0x70, 0x98,
-	0x94, 0x05, 0x5c, 0x70, 0x58, 0x38, 0x48, 0x76, 0x6b, 0xc5, 0x3c, 0x29, 0x2f, 0xe3, 0x66, 0xe2,
-	0x35, 0x38, 0x9d, 0xb1, 0xac, 0xa2, 0xc8, 0x0b, 0x49, 0x41, 0x1e, 0x8e, 0xeb, 0x96, 0x55, 0xa2,
-	0x27, 0x73, 0x7e, 0x54, 0x77, 0xe1, 0x12, 0xbf, 0x0c, 0xc2, 0x8b, 0x0b, 0x76, 0xd3, 0x62, 0x66,
-	0x11, 0xdb, 0xbc, 0x1e, 0xd6, 0xf4, 0x66, 0xdd, 0xd4, 0x2b, 0xed, 0xfc, 0x5b, 0x82, 0x41, 0xa3,
-	0x42, 0xe5, 0x05, 0xb3, 0xfe, 0x0b, 0xf2, 0xa4, 0x92, 0x13, 0x55, 0x54, 0xe8, 0xd6, 0x4a, 0x76,
-	0xc6, 0x7b, 0xd3, 0xa3, 0xbd, 0x38, 0xd0, 0x38, 0x04, 0x2a, 0xc1, 0x69, 0xa9, 0x59, 0x6a, 0x60,
-	0x9b, 0x67, 0xa8, 0xa0, 0x38, 0x92, 0x8e, 0xf9, 0x51, 0x57, 0x32, 0x0b, 0x3f, 0x72, 0x25, 0xb2,
-	0xb1, 0x83, 0x6c, 0xe8, 0x3e, 0xc7, 0x72, 0xf6, 0xe2, 0x91, 0x5b, 0xa6, 0xa6, 0xff, 0x38, 0xb3,
-	0x2a, 0xcf, 0xb4, 0x88, 0x54, 0x91, 0x7b, 0x14, 0x85, 0xa3, 0x96, 0x6b, 0xbc, 0x9b, 0x8a, 0x5a,
-	0x7b, 0x8b, 0x36, 0x61, 0xc4, 0xb2, 0xcd, 0x86, 0xc1, 0xc5, 0xb0, 0xcd, 0x8b, 0x68, 0xe4, 0x32,
-	0xb8, 0x32, 0x9e, 0x7d, 0xe5, 0x20, 0xfb, 0x0d, 0xfb, 0x6b, 0xd1, 0xd9, 0xf4, 0xb3, 0x6f, 0xbe,
-	0xae, 0xcf, 0xbd, 0xf3, 0xc2, 0xdc, 0x77, 0x36, 0xae, 0xdc, 0x98, 0x7f, 0x7d, 0x6e, 0xe3, 0x46,
-	0x7b, 0xfb, 0xcd, 0x77, 0xd3, 0xd7, 0xee, 0xce, 0xfe, 0xec, 0xcd, 0x59, 0x67, 0x2f, 0x3e, 0xb5,
-	0xd6, 0xc5, 0x28, 0xe4, 0xb4, 0x29, 0x0f, 0x64, 0xa1, 0x82, 0x72, 0xf0, 0x5c, 0xe7, 0x83, 0x41,
-	0xaa, 0xa5, 0x8a, 0xce, 0xf4, 0x68, 0x48, 0xd0, 0xf6, 0x74, 0xd2, 0x7d, 0x9e, 0x92, 0xed, 0xe7,
-	0x29, 0x59, 0x14, 0xcf, 0x93, 0x36, 0xe3, 0xd5, 0xc8, 0xe9, 0x4c, 0x4f, 0xbc, 0x0c, 0x2f, 0x0d,
-	0x8e, 0x86, 0x8c, 0xba, 0xc7, 0x47, 0xd0, 0xe3, 0x63, 0xe2, 0x4f, 0x01, 0x78, 0x81, 0x17, 0x4f,
-	0xa6, 0x5c, 0xc6, 0x16, 0x5b, 0x29, 0x2c, 0xb4, 0x23, 0xb8, 0x05, 0xa7, 0xa5, 0x4c, 0xc9, 0x76,
-	0x3f, 0xc9, 0x68, 0x3e, 0xef, 0xe7, 0xfd, 0x88, 0x3c, 0x18, 0x10, 0xd4, 0x88, 0xd5, 0x9b, 0x29,
-	0x6b, 0xf0, 0x9c, 0x78, 0x0a, 0xe4, 0x25, 0x25, 0x5e, 0xd8, 0x87, 0x45, 0x58, 0xc3, 0x5c, 0xf4,
-	0x87, 0x4d, 0x0b, 0x67, 0xc7, 0xda, 0x11, 0xd6, 0xa6, 0xf9, 0x37, 0x89, 0xc6, 0x8f, 0xd0, 0x3a,
-	0x1c, 0xe7, 0x6f, 0x17, 0x31, 0x49, 0x19, 0xcb, 0xd7, 0xe5, 0xbb, 0xf2, 0x75, 0xf9, 0xf6, 0x50,
-	0xaf, 0x4b, 0x0e, 0x37, 0x56, 0x39, 0x88, 0x36, 0x56, 0x91, 0xab, 0xc4, 0x7b, 0x21, 0x18, 0xcd,
-	0x61, 0xdb, 0x68, 0xe0, 0xee, 0xe3, 0x49, 0xff, 0x0f, 0x93, 0x7e, 0x03, 0x42, 0xc1, 0xba, 0x97,
-	0xa4, 0xeb, 0x92, 0xa4, 0x97, 0x86, 0x22, 0x89, 0x27, 0x8f, 0xcb, 0xd2, 0xf8, 0x9d, 0xf6, 0xb2,
-	0x37, 0x04, 0x23, 0xa7, 0x1a, 0x02, 0xb4, 0x0e, 0xc3, 0x04, 0x33, 0x5e, 0x8d, 0x21, 0x01, 0xbc,
-	0x70, 0xa2, 0x3f, 0xc7, 0x2a, 0x66, 0x85, 0x9c, 0xb3, 0x17, 0x0f, 0x89, 0x85, 0x16, 0x22, 0x98,
-	0x15, 0x06, 0x55, 0x7c, 0xf8, 0xc9, 0x54, 0xfc, 0xe8, 0xb0, 0x15, 0xff, 0x20, 0x00, 0xd1, 0x22,
-	0x66, 0x9a, 0x69, 0xb2, 0xb3, 0x49, 0xc1, 0x7e, 0x2a, 0x02, 0x4f, 0x86, 0x8a, 0xe0, 0xb0, 0x54,
-	0xfc, 0x7d, 0x0c, 0xc6, 0x3a, 0xd7, 0x74, 0x5c, 0xec, 0x50, 0xf2, 0x13, 0x38, 0xad, 0x5b, 0x56,
-	0xdd, 0x28, 0x8b, 0xbe, 0xb0, 0xd4, 0xa5, 0xe7, 0xeb, 0x7e, 0x7a, 0x32, 0x5d, 0x31, 0x2f, 0x41,
-	0x63, 0xdd, 0xb7, 0x4b, 0xf7, 0x4a, 0xf0, 0x32, 0x1d, 0xcc, 0xd1, 0xcb, 0x07, 0xd9, 0x59, 0x3b,
-	0x11, 0x9d, 0x4d, 0xab, 0x47, 0x73, 0xf4, 0x58, 0x82, 0x9e, 0x3f, 0x8c, 0xa0, 0xc9, 0x7e, 0x1e,
-	0xd0, 0x1a, 0x1c, 0xa9, 0x1b, 0x94, 0x89, 0x7a, 0x9b, 0x48, 0xcf, 0xfb, 0xbd, 0x3b, 0x9c, 0xa2,
-	0xa4, 0xc7, 0xdb, 0x5b, 0x06, 0x65, 0x4b, 0x8a, 0x26, 0x90, 0x50, 0x11, 0x86, 0x6c, 0x9d, 0x54,
-	0xb1, 0xfc, 0x21, 0xbd, 0x72, 0x32, 0x48, 0x8d, 0x43, 0x2c, 0x29, 0x9a, 0x8b, 0x85, 0x36, 0xe0,
-	0xf8, 0x96, 0x6d, 0x6e, 0xbb, 0xbe, 0x84, 0x05, 0xf0, 0xf5, 0x93, 0x01, 0x7f, 0xcf, 0x36, 0xb7,
-	0xb9, 0xe7, 0x4b, 0x8a, 0x36, 0xb6, 0x25, 0xd7, 0xb1, 0x7f, 0x00, 0x38, 0xed, 0xf3, 0x07, 0xbd,
-	0xe1, 0x69, 0x37, 0xdd, 0x3e, 0x38, 0x73, 0x7a, 0xad, 0x26, 0x7a, 0x0b, 0x46, 0xba, 0x73, 0x81,
-	0xc8, 0xaf, 0xc0, 0xe5, 0xe0, 0xb1, 0xcb, 0xef, 0x02, 0xcf, 0x2e, 0xde, 0x8d, 0x77, 0x4f, 0x73,
-	0x54, 0x9b, 0xc4, 0x5d, 0x59, 0x1a, 0xfb, 0x37, 0x80, 0x33, 0x7e, 0x42, 0xcf, 0xd8, 0xa9, 0x6d,
-	0x38, 0x45, 0x99, 0x6e, 0xb3, 0x52, 0xef, 0x18, 0x50, 0xf8, 0x52, 0x6d, 0xfa, 0x44, 0x91, 0x43,
-	0xca, 0x59, 0x60, 0x82, 0xb6, 0x37, 0x3b, 0x46, 0x8c, 0xc2, 0xf3, 0x03, 0x02, 0x7b, 0xb6, 0x3e,
-	0xce, 0x07, 0xa2, 0x20, 0x3b, 0x05, 0x27, 0xba, 0xc1, 0xa3, 0x89, 0x9f, 0x03, 0x38, 0x25, 0xe5,
-	0xd6, 0x6c, 0xbc, 0x65, 0xbc, 0xdd, 0x33, 0xaa, 0x80, 0xb3, 0x18, 0x55, 0xd0, 0x53, 0x30, 0x5c,
-	0xc7, 0xa4, 0xca, 0x6e, 0x0b, 0x8e, 0xa7, 0x34, 0xb9, 0x4b, 0x68, 0x70, 0xba, 0xc7, 0x14, 0x4c,
-	0xd1, 0x0d, 0x38, 0x66, 0xc9, 0x75, 0x14, 0x88, 0x24, 0x7b, 0xc6, 0x9f, 0x64, 0x3d, 0x2a, 0xd9,
-	0x11, 0xd1, 0xb6, 0x77, 0x94, 0xd2, 0x7f, 0x00, 0x70, 0x64, 0x95, 0xde, 0xa4, 0x68, 0x11, 0xc2,
-	0x25, 0x9d, 0x54, 0xea, 0x98, 0xcb, 0xa3, 0x8b, 0x83, 0x50, 0x64, 0xc5, 0xc5, 0x2e, 0x0d, 0x3e,
-	0x94, 0xad, 0xa5, 0x06, 0x27, 0x16, 0x31, 0x6b, 0x8f, 0x5a, 0xe8, 0x59, 0xbf, 0x70, 0xdf, 0x6c,
-	0x1a, 0xbb, 0xec, 0x17, 0xf1, 0xcf, 0x69, 0xe9, 0xd7, 0xe0, 0x48, 0x86, 0x1b, 0xb9, 0x06, 0xe1,
-	0x22, 0x66, 0x72, 0x84, 0x39, 0x0e, 0x74, 0x7c, 0xc0, 0x93, 0xee, 0x1d, 0x7f, 0xd2, 0xff, 0x1d,
-	0x81, 0x17, 0x56, 0xdd, 0x48, 0xf5, 0xb4, 0xad, 0xa8, 0x06, 0x23, 0x1e, 0x9f, 0x57, 0x0a, 0x0b,
-	0x68, 0x98, 0x3e, 0x37, 0x76, 0xed, 0x78, 0xc2, 0x92, 0xb3, 0xb2, 0x9b, 0x64, 0x9d, 0x9e, 0x1b,
-	0xcd, 0x0e, 0xa2, 0xd8, 0xdf, 0x92, 0x0f, 0x79, 0x09, 0x81, 0xe7, 0xf2, 0xa4, 0xcc, 0x25, 0xba,
-	0x60, 0x67, 0xe9, 0x94, 0x05, 0xcf, 0xcb, 0xfb, 0xdc, 0x36, 0xfd, 0xec, 0x6f, 0x7c, 0x03, 0x46,
-	0xdc, 0x5e, 0xbc, 0x93, 0x7d, 0x57, 0xfc, 0xfa, 0x87, 0xf5, 0xea, 0x8f, 0x4f, 0x42, 0x74, 0x0b,
-	0x8e, 0xbb, 0x89, 0xcd, 0x73, 0x2f, 0xe1, 0x17, 0xef, 0xef, 0xbd, 0x62, 0x47, 0xcd, 0xd1, 0xe9,
-	0xbf, 0x01, 0x18, 0xf5, 0xf4, 0x17, 0xbd, 0xc9, 0xb7, 0x0e, 0xa7, 0x5c, 0x43, 0xdb, 0xa9, 0x7e,
-	0x7c, 0x3f, 0x1e, 0x97, 0xf1, 0xd2, 0x8d, 0x8c, 0x65, 0x9d, 0x8a, 0x1b, 0xef, 0x87, 0xe1, 0xf9,
-	0x9b, 0xb4, 0xf3, 0xab, 0xd2, 0x70, 0xd5, 0xa0, 0xcc, 0x6e, 0xa2, 0x3f, 0x03, 0x18, 0x5c, 0xc4,
-	0x0c, 0x3d, 0x37, 0xe0, 0x02, 0x8f, 0xb4, 0x7b, 0xc3, 0x57, 0x0f, 0xfd, 0x31, 0x26, 0x6a, 0xf7,
-	0xff, 0xf9, 0x9f, 0x5f, 0x07, 0x30, 0x2a, 0xa7, 0xee, 0xd0, 0x94, 0xa7, 0xdb, 0xa2, 0xa9, 0x77,
-	0x7b, 0xff, 0xb1, 0x49, 0x5f, 0x4f, 0xe7, 0xdb, 0xdf, 0x4d, 0xc9, 0x17, 0xbd, 0x4f, 0xaf, 0xb3,
-	0xbc, 0x8b, 0xde, 0x0b, 0xc0, 0x60, 0x71, 0x90, 0xd1, 0xc5, 0xe1, 0x8c, 0xfe, 0x2b, 0x10, 0x56,
-	0xff, 0x05, 0xc4, 0x8e, 0x34, 0x3b, 0x79, 0x42, 0xb3, 0x93, 0xbd, 0x66, 0xcf, 0x83, 0xab, 0xeb,
-	0x2b, 0x89, 0xa5, 0xd3, 0xba, 0x69, 0x1e, 0x5c, 0x45, 0x7f, 0x04, 0x70, 0xbc, 0xd3, 0x72, 0xa1,
-	0xab, 0xc7, 0xef, 0xc6, 0x8e, 0x62, 0xe5, 0x07, 0x82, 0x94, 0xa5, 0xd8, 0x42, 0xbf, 0xa5, 0x8f,
-	0x33, 0xad, 0xd3, 0xda, 0xce, 0x75, 0x8d, 0x7c, 0x10, 0x00, 0x2f, 0x00, 0xf4, 0x3e, 0x80, 0xe1,
-	0x1c, 0xae, 0x63, 0x86, 0xd1, 0xb1, 0xda, 0xab, 0xd8, 0x53, 0x7d, 0x73, 0x44, 0x7e, 0xdb, 0x62,
-	0xcd, 0xc4, 0x8a, 0xb0, 0x6e, 0xf1, 0x6a, 0x7e, 0x78, 0xeb, 0x3a, 0x21, 0xea, 0xc6, 0x24, 0x6d,
-	0xc3, 0xc0, 0x4d, 0x8a, 0xea, 0x62, 0x26, 0xf3, 0xff, 0xb4, 0x0f, 0x31, 0xa1, 0xbf, 0x74, 0x7d,
-	0x8a, 0x89, 0x67, 0x84, 0x8d, 0x4f, 0xa3, 0xaf, 0x70, 0x1b, 0xdb, 0x4d, 0x48, 0xa9, 0xfd, 0x2f,
-	0xcf, 0xfe, 0x1e, 0x3c, 0xdc, 0x57, 0xc1, 0xa3, 0x7d, 0x15, 0x7c, 0xb2, 0xaf, 0x2a, 0x9f, 0xee,
-	0xab, 0xca, 0x67, 0xfb, 0xaa, 0xf2, 0xf9, 0xbe, 0xaa, 0x7c, 0xb1, 0xaf, 0x82, 0x7b, 0x8e, 0x0a,
-	0x1e, 0x38, 0xaa, 0xf2, 0x81, 0xa3, 0x82, 0x0f, 0x1d, 0x55, 0xf9, 0xc8, 0x51, 0x95, 0x8f, 0x1d,
-	0x55, 0x79, 0xe8, 0xa8, 0xe0, 0x91, 0xa3, 0x82, 0x4f, 0x1c, 0x55, 0xf9, 0xd4, 0x51, 0xc1, 0x67,
-	0x8e, 0xaa, 0x7c, 0xee, 0xa8, 0xe0, 0x0b, 0x47, 0x55, 0xee, 0xb5, 0x54, 0xe5, 0x41, 0x4b, 0x05,
-	0xbf, 0x6a, 0xa9, 0xca, 0x6f, 0x5b, 0x2a, 0xf8, 0x5d, 0x4b, 0x55, 0x3e, 0x68, 0xa9, 0xca, 0x87,
-	0x2d, 0x15, 0x7c, 0xd4, 0x52, 0xc1, 0xc7, 0x2d, 0x15, 0xac, 0x5f, 0x3b, 0x6e, 0xdb, 0xc3, 0x88,
-	0xb5, 0xb9, 0x19, 0x16, 0x4e, 0xbf, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x0e, 0xb9,
-	0xea, 0x4c, 0x18, 0x00, 0x00,
+	// 1923 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xde, 0xa1, 0x44, 0x4a, 0x1a, 0x89, 0x94, 0x34, 0x76, 0x13, 0x96, 0xb6, 0x97, 0x0e, 0xa3,
+	0xb6, 0xae, 0x63, 0x91, 0x01, 0x93, 0x06, 0xa9, 0x82, 0xda, 0x20, 0x45, 0x56, 0xa2, 0x65, 0xa9,
+	0xea, 0x32, 0x49, 0x53, 0x25, 0x0a, 0xbd, 0xe2, 0x0e, 0xa9, 0x35, 0xa9, 0xd9, 0xed, 0xce, 0x90,
+	0x0a, 0xed, 0x1a, 0x30, 0x7c, 0x08, 0xdc, 0xa2, 0x87, 0x02, 0x6d, 0x80, 0x1e, 0x8b, 0xe6, 0xd0,
+	0x1c, 0x7a, 0x08, 0x7a, 0x69, 0x4e, 0x45, 0x0e, 0x3d, 0xb8, 0x37, 0x17, 0xbd, 0xa4, 0x3d, 0xa8,
+	0xd1, 0xb2, 0x87, 0x1c, 0x73, 0x2a, 0x02, 0x9d, 0x8a, 0xd9, 0x1f, 0xfe, 0x2c, 0x29, 0x99, 0x94,
+	0x25, 0x03, 0xb9, 0xcd, 0x70, 0xde, 0xfb, 0xe6, 0xbd, 0xef, 0xbd, 0x37, 0xfb, 0x1e, 0x61, 0xac,
+	0xaa, 0x19, 0xf2, 0xae, 0x4c, 0xe6, 0x29, 0x93, 0x8b, 0x95, 0x84, 0xac, 0xab, 0x89, 0x5b, 0x9a,
+	0x4a, 0x28, 0x36, 0xea, 0xd8, 0x88, 0xeb, 0x86, 0xc6, 0x34, 0x14, 0x62, 0x8c, 0xc4, 0x1d, 0xb9,
+	0x78, 0xfd, 0xa5, 0x48, 0xaa, 0xac, 0xb2, 0xed, 0xda, 0x56, 0xbc, 0xa8, 0xed, 0x24, 0x30, 0xa9,
+	0x6b, 0x0d, 0xdd, 0xd0, 0xde, 0x6b, 0x24, 0x2c, 0xe1, 0xe2, 0x7c, 0x19, 0x93, 0xf9, 0xba, 0x5c,
+	0x55, 0x15, 0x99, 0xe1, 0x44, 0xcf, 0xc2, 0x86, 0x8c, 0xcc, 0x77, 0x40, 0x94, 0xb5, 0xb2, 0x66,
+	0x2b, 0x6f, 0xd5, 0x4a, 0xd6, 0xce, 0xda, 0x58, 0x2b, 0x47, 0xfc, 0x7c, 0x59, 0xd3, 0xca, 0x55,
+	0x6c, 0x99, 0x27, 0x13, 0xa2, 0x31, 0x99, 0xa9, 0x1a, 0xa1, 0xce, 0xe9, 0x39, 0xe7, 0xb4, 0x85,
+	0x81, 0x77, 0x74, 0xd6, 0xf0, 0xa8, 0xb6, 0x0e, 0x29, 0x33, 0x6a, 0x45, 0xe6, 0x9c, 0xf6, 0x71,
+	0x1f, 0x13, 0xa5, 0xa0, 0xe0, 0xba, 0x5a, 0x74, 0x6d, 0x7d, 0xbe, 0x57, 0x46, 0x55, 0x30, 0x61,
+	0x6a, 0x49, 0xc5, 0x86, 0x6b, 0xc3, 0xf9, 0xfe, 0x3c, 0x1e, 0x7e, 0x5a, 0xc1, 0x0d, 0x57, 0x37,
+	0xda, 0x7b, 0xea, 0xb2, 0x6d, 0x09, 0xc4, 0x7e, 0xeb, 0x83, 0xb3, 0x79, 0x4c, 0xa9, 0xaa, 0x91,
+	0x15, 0xdc, 0x90, 0xf0, 0xcf, 0x6a, 0x98, 0x32, 0x74, 0x15, 0x86, 0xa8, 0xfd, 0x63, 0xa1, 0x82,
+	0x1b, 0x05, 0x55, 0x09, 0x83, 0x8b, 0xe0, 0xd2, 0x54, 0x3a, 0x7c, 0x90, 0xf6, 0xdf, 0x1e, 0x09,
+	0xdf, 0x9b, 0x31, 0xf7, 0xa2, 0x53, 0x6d, 0xb5, 0x5c, 0x46, 0x9a, 0xa2, 0xed, 0x9d, 0x82, 0x36,
+	0xe1, 0x98, 0x82, 0xeb, 0x05, 0x5c, 0x53, 0xc3, 0x3e, 0x4b, 0x31, 0xf3, 0x70, 0x2f, 0x2a, 0xfc,
+	0x7b, 0x2f, 0x9a, 0x2c, 0x6b, 0x71, 0xb6, 0x8d, 0xd9, 0xb6, 0x4a, 0xca, 0x34, 0x4e, 0x30, 0xdb,
+	0xd5, 0x8c, 0x4a, 0xa2, 0xdb, 0x48, 0xbd, 0x52, 0x4e, 0xb0, 0x86, 0x8e, 0x69, 0x3c, 0xfb, 0x46,
+	0xee, 0x95, 0x97, 0xcd, 0xbd, 0x68, 0x20, 0x83, 0xeb, 0xd9, 0x37, 0x72, 0x52, 0x40, 0xc1, 0xf5,
+	0x6c, 0x4d, 0x45, 0x37, 0xe1, 0x38, 0x67, 0xc0, 0xc2, 0x1f, 0xb1, 0xf0, 0xb3, 0x4f, 0x84, 0x3f,
+	0x76, 0x5d, 0x53, 0x09, 0xbf, 0x60, 0x8c, 0xc3, 0x66, 0x6b, 0x6a, 0xec, 0xbe, 0x0f, 0xce, 0xac,
+	0xed, 0x56, 0xf2, 0x2b, 0xb8, 0x41, 0x25, 0x4c, 0x75, 0x8d, 0x50, 0x8c, 0x7e, 0x04, 0xa7, 0x4b,
+	0x05, 0xb2, 0x5b, 0x29, 0xd0, 0x82, 0x4a, 0x18, 0x67, 0xc6, 0xa2, 0x65, 0x32, 0x79, 0x2e, 0xde,
+	0x9d, 0xc6, 0xf1, 0x15, 0xdc, 0xc8, 0x92, 0x3a, 0xae, 0x6a, 0x3a, 0x4e, 0x4f, 0x1d, 0xa4, 0xfd,
+	0xbf, 0x04, 0xbe, 0x19, 0xc0, 0x4d, 0x94, 0x26, 0x4b, 0x1c, 0x36, 0x47, 0xd8, 0x0a, 0x6e, 0x70,
+	0x40, 0xea, 0x01, 0xf4, 0x0d, 0x0d, 0x48, 0x3b, 0x00, 0x6f, 0xc0, 0xa0, 0x0d, 0x87, 0x49, 0xd1,
+	0x82, 0x1b, 0x19, 0x16, 0x0e, 0x92, 0xdd, 0x4a, 0x3e, 0x4b, 0x8a, 0x2b, 0xb8, 0x11, 0x7b, 0x0b,
+	0x4e, 0xa7, 0x74, 0x3d, 0x6f, 0xe5, 0x85, 0x43, 0x41, 0x16, 0x4e, 0xc8, 0xba, 0x5e, 0xa0, 0xc7,
+	0x73, 0x7e, 0x4c, 0xb6, 0xe1, 0x62, 0xbf, 0x1a, 0x81, 0xe7, 0x16, 0x8d, 0x86, 0xce, 0xb4, 0x3c,
+	0x36, 0x78, 0x3d, 0xac, 0xcb, 0x8d, 0xaa, 0x26, 0x2b, 0x6e, 0xfe, 0x2d, 0xc3, 0x11, 0x55, 0xa1,
+	0xce, 0x05, 0x73, 0xde, 0x0b, 0xb2, 0x44, 0xc9, 0x58, 0x55, 0x94, 0x6b, 0xd7, 0x4a, 0x7a, 0xa6,
+	0xf3, 0xa6, 0x47, 0x7b, 0x51, 0x20, 0x71, 0x08, 0x54, 0x80, 0xd3, 0x8e, 0x66, 0xa1, 0x8e, 0x0d,
+	0x9e, 0xa1, 0x16, 0xc5, 0xa1, 0x64, 0xc4, 0x8b, 0xba, 0x9a, 0x5a, 0x7c, 0xd3, 0x96, 0x48, 0x47,
+	0x0e, 0xd2, 0xfe, 0xfb, 0x1c, 0xcb, 0xdc, 0x8b, 0x86, 0x6e, 0x68, 0x92, 0xfc, 0x93, 0xd4, 0x9a,
+	0x73, 0x26, 0x85, 0x1c, 0x15, 0x67, 0x8f, 0xc2, 0x70, 0x4c, 0xb7, 0x8d, 0xb7, 0x53, 0x51, 0x72,
+	0xb7, 0x68, 0x0b, 0x86, 0x74, 0x43, 0xab, 0xab, 0x5c, 0x0c, 0x1b, 0xbc, 0x88, 0x46, 0x2f, 0x82,
+	0x4b, 0x13, 0xe9, 0xd7, 0x0e, 0xd2, 0xdf, 0x31, 0xbe, 0x15, 0x9e, 0x4b, 0x3e, 0xf7, 0xee, 0xdb,
+	0xf2, 0xfc, 0xed, 0x17, 0xe7, 0xbf, 0xbf, 0x79, 0xe9, 0xda, 0xc2, 0xdb, 0xf3, 0x9b, 0xd7, 0xdc,
+	0xed, 0x77, 0xef, 0x24, 0xaf, 0xdc, 0x9d, 0xfb, 0xf9, 0xbb, 0x73, 0xe6, 0x5e, 0x34, 0xb8, 0xde,
+	0xc6, 0xc8, 0x65, 0xa4, 0x60, 0x07, 0x64, 0x4e, 0x41, 0x19, 0x38, 0xdb, 0xfa, 0x41, 0x25, 0xe5,
+	0x82, 0x22, 0x33, 0x39, 0xec, 0xb7, 0x68, 0x7b, 0x36, 0x6e, 0x3f, 0x4f, 0x71, 0xf7, 0x79, 0x8a,
+	0xe7, 0xad, 0xe7, 0x49, 0x9a, 0xe9, 0xd4, 0xc8, 0xc8, 0x4c, 0x8e, 0xbd, 0x0a, 0xcf, 0xf7, 0x8f,
+	0x86, 0x13, 0xf5, 0x0e, 0x1f, 0x41, 0x97, 0x8f, 0xb1, 0x3f, 0xf9, 0xe0, 0x59, 0x5e, 0x3c, 0xa9,
+	0x62, 0x11, 0xeb, 0x6c, 0x35, 0xb7, 0xe8, 0x46, 0xb0, 0x04, 0xa7, 0x1d, 0x99, 0x82, 0x61, 0xff,
+	0xe4, 0x44, 0xf3, 0x05, 0x2f, 0xef, 0x47, 0xe4, 0x41, 0x9f, 0xa0, 0x86, 0xf4, 0xee, 0x4c, 0x59,
+	0x87, 0xb3, 0xd6, 0x53, 0xe0, 0x5c, 0x52, 0xe0, 0x85, 0x7d, 0x58, 0x84, 0x25, 0xcc, 0x45, 0x5f,
+	0x6f, 0xe8, 0x38, 0x3d, 0xee, 0x46, 0x58, 0x9a, 0xe6, 0xbf, 0x39, 0x68, 0xfc, 0x08, 0x6d, 0xc0,
+	0x09, 0xfe, 0x76, 0x11, 0x8d, 0x14, 0xb1, 0xf3, 0xba, 0xfc, 0xc0, 0x79, 0x5d, 0xbe, 0x37, 0xd4,
+	0xeb, 0x92, 0xc1, 0xf5, 0x35, 0x0e, 0x22, 0x8d, 0x2b, 0xce, 0x2a, 0xf6, 0xbe, 0x1f, 0x86, 0x33,
+	0xd8, 0x50, 0xeb, 0xb8, 0xfd, 0x78, 0xd2, 0xaf, 0x61, 0xd2, 0x6f, 0x42, 0x68, 0xb1, 0xde, 0x49,
+	0xd2, 0x55, 0x87, 0xa4, 0x57, 0x86, 0x22, 0x89, 0x27, 0x8f, 0xcd, 0xd2, 0xc4, 0x2d, 0x77, 0xd9,
+	0x1d, 0x82, 0xd1, 0x13, 0x0d, 0x01, 0xda, 0x80, 0x01, 0x82, 0x19, 0xaf, 0x46, 0xbf, 0x05, 0xbc,
+	0x78, 0xac, 0x2f, 0xc7, 0x1a, 0x66, 0xb9, 0x8c, 0xb9, 0x17, 0xf5, 0x5b, 0x0b, 0xc9, 0x4f, 0x30,
+	0xcb, 0xf5, 0xab, 0xf8, 0xc0, 0xd3, 0xa9, 0xf8, 0xb1, 0x61, 0x2b, 0xfe, 0x81, 0x0f, 0xa2, 0x25,
+	0xcc, 0x24, 0x4d, 0x63, 0xa7, 0x93, 0x82, 0xbd, 0x54, 0xf8, 0x9e, 0x0e, 0x15, 0x23, 0xc3, 0x52,
+	0xf1, 0xf7, 0x71, 0x18, 0x69, 0x5d, 0xd3, 0x72, 0xb1, 0x45, 0xc9, 0x4f, 0xe1, 0xb4, 0xac, 0xeb,
+	0x55, 0xb5, 0x68, 0xf5, 0x85, 0x85, 0x36, 0x3d, 0xdf, 0xf6, 0xd2, 0x93, 0x6a, 0x8b, 0x75, 0x12,
+	0x34, 0xde, 0x7e, 0xbb, 0xe4, 0x4e, 0x09, 0x5e, 0xa6, 0xfd, 0x39, 0x7a, 0xf5, 0x20, 0x3d, 0x67,
+	0xc4, 0xc2, 0x73, 0x49, 0xf1, 0x68, 0x8e, 0x1e, 0x4b, 0xd0, 0x0b, 0x87, 0x11, 0x34, 0xd5, 0xcb,
+	0x03, 0x5a, 0x87, 0xa3, 0x55, 0x95, 0x32, 0xab, 0xde, 0x26, 0x93, 0x0b, 0x5e, 0xef, 0x0e, 0xa7,
+	0x28, 0xde, 0xe1, 0xed, 0x0d, 0x95, 0xb2, 0x65, 0x41, 0xb2, 0x90, 0x50, 0x1e, 0xfa, 0x0d, 0x99,
+	0x94, 0xb1, 0xf3, 0x41, 0x7a, 0xed, 0x78, 0x90, 0x12, 0x87, 0x58, 0x16, 0x24, 0x1b, 0x0b, 0x6d,
+	0xc2, 0x89, 0x92, 0xa1, 0xed, 0xd8, 0xbe, 0x04, 0x2c, 0xe0, 0xab, 0xc7, 0x03, 0xfe, 0xa1, 0xa1,
+	0xed, 0x70, 0xcf, 0x97, 0x05, 0x69, 0xbc, 0xe4, 0xac, 0x23, 0xff, 0x00, 0x70, 0xda, 0xe3, 0x0f,
+	0x7a, 0xa7, 0xa3, 0xdd, 0xb4, 0xfb, 0xe0, 0xd4, 0xc9, 0xb5, 0x9a, 0xe8, 0x26, 0x0c, 0xb5, 0xe7,
+	0x02, 0x2b, 0xbf, 0x7c, 0x17, 0x47, 0x06, 0x2e, 0xbf, 0xb3, 0x3c, 0xbb, 0x78, 0x37, 0xde, 0x3e,
+	0xcd, 0x50, 0x69, 0x0a, 0xb7, 0x65, 0x69, 0xe4, 0x3f, 0x00, 0xce, 0x78, 0x09, 0x3d, 0x65, 0xa7,
+	0x76, 0x60, 0x90, 0x32, 0xd9, 0x60, 0x85, 0xee, 0x31, 0x20, 0xf7, 0x44, 0x6d, 0xfa, 0x64, 0x9e,
+	0x43, 0x3a, 0xb3, 0xc0, 0x24, 0x75, 0x37, 0x35, 0x35, 0x42, 0xe1, 0x99, 0x3e, 0x81, 0x3d, 0x5d,
+	0x1f, 0x17, 0x7c, 0x61, 0x90, 0x0e, 0xc2, 0xc9, 0x76, 0xf0, 0x68, 0xec, 0x43, 0x1f, 0x9c, 0x7b,
+	0x13, 0x1b, 0x6a, 0xa9, 0xb1, 0x58, 0x95, 0xd5, 0x9d, 0x54, 0x8d, 0x6d, 0x73, 0xab, 0xec, 0x92,
+	0x5f, 0xd4, 0x14, 0xec, 0xbe, 0x2a, 0x37, 0x7b, 0x2c, 0x3b, 0xe1, 0x09, 0xe6, 0xb4, 0x47, 0xb0,
+	0x05, 0x78, 0x46, 0xee, 0xf2, 0xae, 0x50, 0xd4, 0x14, 0xb7, 0x15, 0x98, 0x38, 0x48, 0x07, 0x6e,
+	0x8f, 0xce, 0x80, 0xf0, 0xb8, 0x84, 0xe4, 0x1e, 0x0e, 0x62, 0xbf, 0x00, 0x30, 0xe8, 0xd8, 0xbb,
+	0x6e, 0xe0, 0x92, 0xfa, 0xde, 0x53, 0xa0, 0xe3, 0x19, 0x18, 0xa8, 0x62, 0x52, 0x66, 0xdb, 0x16,
+	0x1b, 0x41, 0xc9, 0xd9, 0xc5, 0x24, 0x38, 0xdd, 0x65, 0x0a, 0xa6, 0xe8, 0x1a, 0x1c, 0xd7, 0x9d,
+	0x75, 0x18, 0x58, 0xa5, 0x78, 0xc1, 0x5b, 0x8a, 0x5d, 0x2a, 0xe9, 0x51, 0x6b, 0xb8, 0x69, 0x29,
+	0x25, 0x3f, 0x04, 0x70, 0x74, 0x8d, 0x5e, 0xa7, 0x68, 0x09, 0xc2, 0x65, 0x99, 0x28, 0x55, 0xcc,
+	0xe5, 0xd1, 0xb9, 0x7e, 0x28, 0x4e, 0x42, 0x44, 0xce, 0xf7, 0x3f, 0x74, 0x1a, 0x70, 0x09, 0x4e,
+	0x2e, 0x61, 0xe6, 0x0e, 0xa4, 0xe8, 0x39, 0xaf, 0x70, 0xcf, 0x04, 0x1f, 0xb9, 0xe8, 0x15, 0xf1,
+	0x4e, 0xb3, 0xc9, 0xb7, 0xe0, 0x68, 0x8a, 0x1b, 0xb9, 0x0e, 0xe1, 0x12, 0x66, 0xce, 0xa0, 0x37,
+	0x08, 0x74, 0xb4, 0xcf, 0x87, 0xaf, 0x73, 0x48, 0x4c, 0xfe, 0x6f, 0x14, 0x9e, 0x5d, 0xb3, 0x23,
+	0xd5, 0xd5, 0xdc, 0xa3, 0x0a, 0x0c, 0x75, 0xf8, 0xbc, 0x9a, 0x5b, 0x44, 0xc3, 0x4c, 0x03, 0x91,
+	0x2b, 0x83, 0x09, 0x3b, 0x9c, 0x15, 0x61, 0xb0, 0x6b, 0x32, 0x41, 0x73, 0xfd, 0x28, 0xf6, 0x0e,
+	0x2e, 0x43, 0x5e, 0x42, 0xe0, 0x6c, 0x96, 0x14, 0xb9, 0x44, 0x1b, 0xec, 0x34, 0x9d, 0xd2, 0xe1,
+	0x19, 0xe7, 0x3e, 0x7b, 0x98, 0x39, 0xfd, 0x1b, 0xdf, 0x81, 0x21, 0x7b, 0x62, 0x69, 0x65, 0xdf,
+	0x25, 0xaf, 0xfe, 0x61, 0x13, 0xcd, 0xe3, 0x93, 0x10, 0xdd, 0x80, 0x13, 0x76, 0x62, 0xf3, 0xdc,
+	0x8b, 0x79, 0xc5, 0x7b, 0x3b, 0xd4, 0xc8, 0x51, 0xff, 0x36, 0x24, 0xff, 0x06, 0x60, 0xb8, 0xa3,
+	0x0b, 0xeb, 0x4e, 0xbe, 0x0d, 0x18, 0xb4, 0x0d, 0x75, 0x53, 0x7d, 0x70, 0x3f, 0x1e, 0x97, 0xf1,
+	0x8e, 0x1b, 0x29, 0x5d, 0x3f, 0x11, 0x37, 0x3e, 0x08, 0xc0, 0x33, 0xd7, 0x69, 0xeb, 0x83, 0x2e,
+	0xe1, 0xb2, 0x4a, 0x99, 0xd1, 0x40, 0x7f, 0x06, 0x70, 0x64, 0x09, 0x33, 0xf4, 0x7c, 0x9f, 0x0b,
+	0x3a, 0xa4, 0xed, 0x1b, 0xbe, 0x79, 0x68, 0xfb, 0x10, 0xab, 0xdc, 0xff, 0xe7, 0x7f, 0x7f, 0xe3,
+	0xc3, 0xa8, 0x98, 0xb8, 0x45, 0x13, 0x1d, 0x3d, 0x29, 0x4d, 0xdc, 0xe9, 0xee, 0x44, 0xe2, 0x9e,
+	0xce, 0xd7, 0xb3, 0xbf, 0x9b, 0xb0, 0x45, 0x7b, 0xf5, 0x5a, 0xcb, 0xbb, 0xe8, 0x7d, 0x1f, 0x1c,
+	0xc9, 0xf7, 0x33, 0x3a, 0x3f, 0x9c, 0xd1, 0x7f, 0x05, 0x96, 0xd5, 0x7f, 0x01, 0x91, 0x23, 0xcd,
+	0x8e, 0x1f, 0xd3, 0xec, 0x78, 0xb7, 0xd9, 0x0b, 0xe0, 0xf2, 0xc6, 0x6a, 0x6c, 0xf9, 0xa4, 0x6e,
+	0x5a, 0x00, 0x97, 0xd1, 0x1f, 0x01, 0x9c, 0x68, 0x35, 0xa6, 0xe8, 0xf2, 0xe0, 0x3d, 0xeb, 0x51,
+	0xac, 0xfc, 0xd8, 0x22, 0x65, 0x39, 0xb2, 0xd8, 0x6b, 0xe9, 0xe3, 0x4c, 0x6b, 0x0d, 0x00, 0xf3,
+	0x6d, 0x23, 0x1f, 0xf8, 0xc0, 0x8b, 0x00, 0x7d, 0x00, 0x60, 0x20, 0x83, 0xab, 0x98, 0x61, 0x34,
+	0x50, 0x13, 0x1a, 0x79, 0xa6, 0x67, 0xda, 0xca, 0xf2, 0xbf, 0xc9, 0x63, 0xab, 0x96, 0x75, 0x4b,
+	0x97, 0xb3, 0xc3, 0x5b, 0xd7, 0x0a, 0x51, 0x3b, 0x26, 0xc9, 0x7f, 0x01, 0xe8, 0xcf, 0x14, 0xf9,
+	0x37, 0xeb, 0x0e, 0xbc, 0x70, 0x64, 0x9b, 0x85, 0x5e, 0xf6, 0xda, 0x3d, 0x48, 0x57, 0x16, 0x19,
+	0xc8, 0x5b, 0x94, 0x87, 0xb3, 0xaf, 0x1b, 0x32, 0xa1, 0x25, 0x6c, 0xb4, 0xce, 0x9f, 0x34, 0xbd,
+	0x93, 0x06, 0xf4, 0x5d, 0xa7, 0xa8, 0x6a, 0x4d, 0xe5, 0xde, 0x86, 0xe4, 0x10, 0x7a, 0x7b, 0x9f,
+	0x25, 0x8f, 0x62, 0xec, 0x82, 0xc5, 0xff, 0xb3, 0xe8, 0x1b, 0x9c, 0x7f, 0xb7, 0xc1, 0x2a, 0xb8,
+	0x7d, 0x4a, 0xfa, 0x0f, 0xe0, 0xe1, 0xbe, 0x08, 0x1e, 0xed, 0x8b, 0xe0, 0xb3, 0x7d, 0x51, 0xf8,
+	0x7c, 0x5f, 0x14, 0xbe, 0xd8, 0x17, 0x85, 0x2f, 0xf7, 0x45, 0xe1, 0xab, 0x7d, 0x11, 0xdc, 0x33,
+	0x45, 0xf0, 0xc0, 0x14, 0x85, 0x8f, 0x4c, 0x11, 0x7c, 0x6c, 0x8a, 0xc2, 0x27, 0xa6, 0x28, 0x7c,
+	0x6a, 0x8a, 0xc2, 0x43, 0x53, 0x04, 0x8f, 0x4c, 0x11, 0x7c, 0x66, 0x8a, 0xc2, 0xe7, 0xa6, 0x08,
+	0xbe, 0x30, 0x45, 0xe1, 0x4b, 0x53, 0x04, 0x5f, 0x99, 0xa2, 0x70, 0xaf, 0x29, 0x0a, 0x0f, 0x9a,
+	0x22, 0xf8, 0x75, 0x53, 0x14, 0x7e, 0xd7, 0x14, 0xc1, 0xef, 0x9b, 0xa2, 0xf0, 0x51, 0x53, 0x14,
+	0x3e, 0x6e, 0x8a, 0xe0, 0x93, 0xa6, 0x08, 0x3e, 0x6d, 0x8a, 0x60, 0xe3, 0xca, 0xa0, 0x2d, 0x1d,
+	0x23, 0xfa, 0xd6, 0x56, 0xc0, 0x72, 0xfa, 0xa5, 0xff, 0x0f, 0x00, 0x47, 0x97, 0x65, 0x7e, 0x4e,
+	0x1a, 0x00, 0x00,
 }
 
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
@@ -1507,6 +1563,36 @@ func (this *ProvisionEndDevicesRequest_IdentifiersFromData) Equal(that interface
 	}
 	return true
 }
+func (this *VerifyClaimAuthenticationCodeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VerifyClaimAuthenticationCodeRequest)
+	if !ok {
+		that2, ok := that.(VerifyClaimAuthenticationCodeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.JoinEUI.Equal(that1.JoinEUI) {
+		return false
+	}
+	if !this.DevEUI.Equal(that1.DevEUI) {
+		return false
+	}
+	if !bytes.Equal(this.AuthenticationCode, that1.AuthenticationCode) {
+		return false
+	}
+	return true
+}
 func (this *JoinEUIPrefix) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -2269,6 +2355,111 @@ var _JsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/joinserver.proto",
 }
 
+// DcsJsClient is the client API for DcsJs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DcsJsClient interface {
+	// VerifyClaimAuthenticationCode verifies the claim authentication code of the end device identified by the JoinEUI and DevEUI.
+	// If the code is valid, the identifiers of the end device are returned.
+	VerifyClaimAuthenticationCode(ctx context.Context, in *VerifyClaimAuthenticationCodeRequest, opts ...grpc.CallOption) (*EndDeviceIdentifiers, error)
+	// TransferEndDevice transfers the end device identified by the JoinEUI and DevEUI to the identifiers of the given end device.
+	// The root keys are transferred within the Join Server and are not returned. The fields in the field mask are set on the transferred end device.
+	TransferEndDevice(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+}
+
+type dcsJsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDcsJsClient(cc *grpc.ClientConn) DcsJsClient {
+	return &dcsJsClient{cc}
+}
+
+func (c *dcsJsClient) VerifyClaimAuthenticationCode(ctx context.Context, in *VerifyClaimAuthenticationCodeRequest, opts ...grpc.CallOption) (*EndDeviceIdentifiers, error) {
+	out := new(EndDeviceIdentifiers)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.DcsJs/VerifyClaimAuthenticationCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dcsJsClient) TransferEndDevice(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.DcsJs/TransferEndDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DcsJsServer is the server API for DcsJs service.
+type DcsJsServer interface {
+	// VerifyClaimAuthenticationCode verifies the claim authentication code of the end device identified by the JoinEUI and DevEUI.
+	// If the code is valid, the identifiers of the end device are returned.
+	VerifyClaimAuthenticationCode(context.Context, *VerifyClaimAuthenticationCodeRequest) (*EndDeviceIdentifiers, error)
+	// TransferEndDevice transfers the end device identified by the JoinEUI and DevEUI to the identifiers of the given end device.
+	// The root keys are transferred within the Join Server and are not returned. The fields in the field mask are set on the transferred end device.
+	TransferEndDevice(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
+}
+
+func RegisterDcsJsServer(s *grpc.Server, srv DcsJsServer) {
+	s.RegisterService(&_DcsJs_serviceDesc, srv)
+}
+
+func _DcsJs_VerifyClaimAuthenticationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyClaimAuthenticationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DcsJsServer).VerifyClaimAuthenticationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.DcsJs/VerifyClaimAuthenticationCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DcsJsServer).VerifyClaimAuthenticationCode(ctx, req.(*VerifyClaimAuthenticationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DcsJs_TransferEndDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DcsJsServer).TransferEndDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.DcsJs/TransferEndDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DcsJsServer).TransferEndDevice(ctx, req.(*SetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DcsJs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.DcsJs",
+	HandlerType: (*DcsJsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VerifyClaimAuthenticationCode",
+			Handler:    _DcsJs_VerifyClaimAuthenticationCode_Handler,
+		},
+		{
+			MethodName: "TransferEndDevice",
+			Handler:    _DcsJs_TransferEndDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/joinserver.proto",
+}
+
 // JsClient is the client API for Js service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
@@ -2861,7 +3052,7 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) MarshalTo(dAtA []byte)
 	return i, nil
 }
 
-func (m *JoinEUIPrefix) Marshal() (dAtA []byte, err error) {
+func (m *VerifyClaimAuthenticationCodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2871,7 +3062,7 @@ func (m *JoinEUIPrefix) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JoinEUIPrefix) MarshalTo(dAtA []byte) (int, error) {
+func (m *VerifyClaimAuthenticationCodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2884,6 +3075,46 @@ func (m *JoinEUIPrefix) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n27
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.DevEUI.Size()))
+	n28, err := m.DevEUI.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n28
+	if len(m.AuthenticationCode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintJoinserver(dAtA, i, uint64(len(m.AuthenticationCode)))
+		i += copy(dAtA[i:], m.AuthenticationCode)
+	}
+	return i, nil
+}
+
+func (m *JoinEUIPrefix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JoinEUIPrefix) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintJoinserver(dAtA, i, uint64(m.JoinEUI.Size()))
+	n29, err := m.JoinEUI.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n29
 	if m.Length != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -3118,10 +3349,26 @@ func NewPopulatedProvisionEndDevicesRequest_IdentifiersFromData(r randyJoinserve
 	return this
 }
 
-func NewPopulatedJoinEUIPrefix(r randyJoinserver, easy bool) *JoinEUIPrefix {
-	this := &JoinEUIPrefix{}
+func NewPopulatedVerifyClaimAuthenticationCodeRequest(r randyJoinserver, easy bool) *VerifyClaimAuthenticationCodeRequest {
+	this := &VerifyClaimAuthenticationCodeRequest{}
 	v23 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
 	this.JoinEUI = *v23
+	v24 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v24
+	v25 := r.Intn(100)
+	this.AuthenticationCode = make([]byte, v25)
+	for i := 0; i < v25; i++ {
+		this.AuthenticationCode[i] = byte(r.Intn(256))
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedJoinEUIPrefix(r randyJoinserver, easy bool) *JoinEUIPrefix {
+	this := &JoinEUIPrefix{}
+	v26 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v26
 	this.Length = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3131,11 +3378,11 @@ func NewPopulatedJoinEUIPrefix(r randyJoinserver, easy bool) *JoinEUIPrefix {
 func NewPopulatedJoinEUIPrefixes(r randyJoinserver, easy bool) *JoinEUIPrefixes {
 	this := &JoinEUIPrefixes{}
 	if r.Intn(10) != 0 {
-		v24 := r.Intn(5)
-		this.Prefixes = make([]JoinEUIPrefix, v24)
-		for i := 0; i < v24; i++ {
-			v25 := NewPopulatedJoinEUIPrefix(r, easy)
-			this.Prefixes[i] = *v25
+		v27 := r.Intn(5)
+		this.Prefixes = make([]JoinEUIPrefix, v27)
+		for i := 0; i < v27; i++ {
+			v28 := NewPopulatedJoinEUIPrefix(r, easy)
+			this.Prefixes[i] = *v28
 		}
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -3162,9 +3409,9 @@ func randUTF8RuneJoinserver(r randyJoinserver) rune {
 	return rune(ru + 61)
 }
 func randStringJoinserver(r randyJoinserver) string {
-	v26 := r.Intn(100)
-	tmps := make([]rune, v26)
-	for i := 0; i < v26; i++ {
+	v29 := r.Intn(100)
+	tmps := make([]rune, v29)
+	for i := 0; i < v29; i++ {
 		tmps[i] = randUTF8RuneJoinserver(r)
 	}
 	return string(tmps)
@@ -3186,11 +3433,11 @@ func randFieldJoinserver(dAtA []byte, r randyJoinserver, fieldNumber int, wire i
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
-		v27 := r.Int63()
+		v30 := r.Int63()
 		if r.Intn(2) == 0 {
-			v27 *= -1
+			v30 *= -1
 		}
-		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(v27))
+		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(v30))
 	case 1:
 		dAtA = encodeVarintPopulateJoinserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3465,6 +3712,23 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Size() (n int) {
 	return n
 }
 
+func (m *VerifyClaimAuthenticationCodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.JoinEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = m.DevEUI.Size()
+	n += 1 + l + sovJoinserver(uint64(l))
+	l = len(m.AuthenticationCode)
+	if l > 0 {
+		n += 1 + l + sovJoinserver(uint64(l))
+	}
+	return n
+}
+
 func (m *JoinEUIPrefix) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3680,6 +3944,18 @@ func (this *ProvisionEndDevicesRequest_IdentifiersFromData) String() string {
 	}, "")
 	return s
 }
+func (this *VerifyClaimAuthenticationCodeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifyClaimAuthenticationCodeRequest{`,
+		`JoinEUI:` + fmt.Sprintf("%v", this.JoinEUI) + `,`,
+		`DevEUI:` + fmt.Sprintf("%v", this.DevEUI) + `,`,
+		`AuthenticationCode:` + fmt.Sprintf("%v", this.AuthenticationCode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *JoinEUIPrefix) String() string {
 	if this == nil {
 		return "nil"
@@ -5546,6 +5822,159 @@ func (m *ProvisionEndDevicesRequest_IdentifiersFromData) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
+func (m *VerifyClaimAuthenticationCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJoinserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyClaimAuthenticationCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyClaimAuthenticationCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.JoinEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevEUI", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DevEUI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationCode", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJoinserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticationCode = append(m.AuthenticationCode[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthenticationCode == nil {
+				m.AuthenticationCode = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJoinserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthJoinserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *JoinEUIPrefix) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -157,6 +157,17 @@ var ProvisionEndDevicesRequestFieldPathsTopLevel = []string{
 	"provisioner_id",
 	"provisioning_data",
 }
+var VerifyClaimAuthenticationCodeRequestFieldPathsNested = []string{
+	"authentication_code",
+	"dev_eui",
+	"join_eui",
+}
+
+var VerifyClaimAuthenticationCodeRequestFieldPathsTopLevel = []string{
+	"authentication_code",
+	"dev_eui",
+	"join_eui",
+}
 var JoinEUIPrefixFieldPathsNested = []string{
 	"join_eui",
 	"length",
@@ -555,6 +555,46 @@ func (dst *ProvisionEndDevicesRequest) SetFields(src *ProvisionEndDevicesRequest
 	return nil
 }
 
+func (dst *VerifyClaimAuthenticationCodeRequest) SetFields(src *VerifyClaimAuthenticationCodeRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "join_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEUI = src.JoinEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.JoinEUI = zero
+			}
+		case "dev_eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevEUI = src.DevEUI
+			} else {
+				var zero go_thethings_network_lorawan_stack_pkg_types.EUI64
+				dst.DevEUI = zero
+			}
+		case "authentication_code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'authentication_code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AuthenticationCode = src.AuthenticationCode
+			} else {
+				dst.AuthenticationCode = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *JoinEUIPrefix) SetFields(src *JoinEUIPrefix, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
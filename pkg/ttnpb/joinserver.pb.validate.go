@@ -1081,6 +1081,101 @@ var _ interface {
 
 var _ProvisionEndDevicesRequest_ProvisionerID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
 
+// ValidateFields checks the field values on
+// VerifyClaimAuthenticationCodeRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *VerifyClaimAuthenticationCodeRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = VerifyClaimAuthenticationCodeRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "join_eui":
+			// no validation rules for JoinEUI
+		case "dev_eui":
+			// no validation rules for DevEUI
+		case "authentication_code":
+
+			if l := len(m.GetAuthenticationCode()); l < 1 || l > 8 {
+				return VerifyClaimAuthenticationCodeRequestValidationError{
+					field:  "authentication_code",
+					reason: "value length must be between 1 and 8 bytes, inclusive",
+				}
+			}
+
+		default:
+			return VerifyClaimAuthenticationCodeRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// VerifyClaimAuthenticationCodeRequestValidationError is the validation error
+// returned by VerifyClaimAuthenticationCodeRequest.ValidateFields if the
+// designated constraints aren't met.
+type VerifyClaimAuthenticationCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyClaimAuthenticationCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyClaimAuthenticationCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyClaimAuthenticationCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyClaimAuthenticationCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyClaimAuthenticationCodeRequestValidationError) ErrorName() string {
+	return "VerifyClaimAuthenticationCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyClaimAuthenticationCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyClaimAuthenticationCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyClaimAuthenticationCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyClaimAuthenticationCodeRequestValidationError{}
+
 // ValidateFields checks the field values on JoinEUIPrefix with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -684,10 +684,18 @@ type ApplicationDownlink struct {
 	// If not set, this downlink message may be transmitted in class A, B and C.
 	ClassBC *ApplicationDownlink_ClassBC `protobuf:"bytes,7,opt,name=class_b_c,json=classBC,proto3" json:"class_b_c,omitempty"`
 	// Priority for scheduling the downlink message.
-	Priority             TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
-	CorrelationIDs       []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Priority       TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	CorrelationIDs []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Time after which the downlink message is dropped from the queue.
+	// If the downlink message is not transmitted before this time, the downlink message fails.
+	// If null, the downlink message does not expire.
+	ExpiresAt *time.Time `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Time at which the Application Server pushes the downlink message to the Network Server queue.
+	// The downlink message is encrypted when it is pushed, so that it uses the frame counter at that time.
+	// If null or in the past, the downlink message is queued immediately.
+	QueueAt              *time.Time `protobuf:"bytes,11,opt,name=queue_at,json=queueAt,proto3,stdtime" json:"queue_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ApplicationDownlink) Reset()      { *m = ApplicationDownlink{} }
@@ -785,6 +793,20 @@ func (m *ApplicationDownlink) GetCorrelationIDs() []string {
 	return nil
 }
 
+func (m *ApplicationDownlink) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *ApplicationDownlink) GetQueueAt() *time.Time {
+	if m != nil {
+		return m.QueueAt
+	}
+	return nil
+}
+
 type ApplicationDownlink_ClassBC struct {
 	// Possible gateway identifiers and antenna index to use for this downlink message.
 	// The Network Server selects one of these gateways for downlink, based on connectivity, signal quality, channel utilization and an available slot.
@@ -1539,135 +1561,137 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2047 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0xdb, 0xd6,
-	0x19, 0xe7, 0x93, 0x2c, 0x4b, 0x7a, 0x96, 0x64, 0xf6, 0xd5, 0xcd, 0x58, 0x2f, 0xa3, 0x3c, 0x35,
-	0x5d, 0x9d, 0x2c, 0x96, 0x37, 0x67, 0xc3, 0xb2, 0x00, 0x5b, 0x2b, 0xca, 0x74, 0xac, 0xd8, 0x91,
-	0x94, 0x27, 0xa5, 0x4d, 0xd6, 0x75, 0x04, 0x4d, 0x3e, 0x29, 0xac, 0x65, 0x92, 0x25, 0x9f, 0x6c,
-	0xab, 0xc3, 0x80, 0x6c, 0xa7, 0x62, 0x87, 0x21, 0xe8, 0xb0, 0x3f, 0x18, 0xb0, 0xa1, 0xd8, 0xa9,
-	0x87, 0x01, 0xcb, 0x31, 0xd8, 0xa9, 0xb7, 0xe5, 0x98, 0x63, 0x4f, 0x59, 0x2c, 0x5f, 0x7a, 0xec,
-	0x31, 0xf0, 0xa5, 0x03, 0x1f, 0x49, 0x89, 0x92, 0xb5, 0xd4, 0x71, 0xb1, 0x53, 0x4f, 0x16, 0xdf,
-	0xf7, 0xfb, 0x7e, 0xef, 0x7b, 0xdf, 0xfb, 0xfe, 0x3d, 0xc3, 0x85, 0x8e, 0xe5, 0xa8, 0x7b, 0xaa,
-	0xb9, 0xe4, 0x52, 0x55, 0xdb, 0x5e, 0x56, 0x6d, 0x63, 0x79, 0x87, 0xb8, 0xae, 0xda, 0x26, 0x6e,
-	0xd1, 0x76, 0x2c, 0x6a, 0xa1, 0x1c, 0xa5, 0x66, 0x31, 0x40, 0x15, 0x77, 0x2f, 0xcd, 0x97, 0xda,
-	0x06, 0xbd, 0xd3, 0xdd, 0x2a, 0x6a, 0xd6, 0xce, 0x32, 0x31, 0x77, 0xad, 0x9e, 0xed, 0x58, 0xfb,
-	0xbd, 0x65, 0x06, 0xd6, 0x96, 0xda, 0xc4, 0x5c, 0xda, 0x55, 0x3b, 0x86, 0xae, 0x52, 0xb2, 0x7c,
-	0xec, 0x87, 0x4f, 0x39, 0xbf, 0x14, 0xa1, 0x68, 0x5b, 0x6d, 0xcb, 0x57, 0xde, 0xea, 0xb6, 0xd8,
-	0x17, 0xfb, 0x60, 0xbf, 0x02, 0xf8, 0xd9, 0xb6, 0x65, 0xb5, 0x3b, 0x64, 0x88, 0x72, 0xa9, 0xd3,
-	0xd5, 0x68, 0x20, 0xcd, 0x8f, 0x4b, 0xa9, 0xb1, 0x43, 0x5c, 0xaa, 0xee, 0xd8, 0x01, 0xe0, 0x5b,
-	0xc7, 0x8f, 0x48, 0x1c, 0xc7, 0x72, 0x02, 0xf1, 0x2b, 0xc7, 0xc5, 0x86, 0x4e, 0x4c, 0x6a, 0xb4,
-	0x0c, 0xe2, 0xb8, 0xa1, 0x09, 0xc7, 0x41, 0xdb, 0xa4, 0x17, 0x4a, 0xf3, 0xc7, 0xa5, 0xa1, 0xc3,
-	0x7c, 0xc0, 0x44, 0x2f, 0x53, 0x55, 0x57, 0xa9, 0xea, 0x23, 0x0a, 0x0f, 0xe3, 0x30, 0x7b, 0xd3,
-	0xee, 0x18, 0xe6, 0xf6, 0x75, 0xdf, 0xfd, 0x28, 0x0f, 0x67, 0x1c, 0x75, 0x4f, 0xb1, 0xd5, 0x5e,
-	0xc7, 0x52, 0x75, 0x01, 0x2c, 0x80, 0xc5, 0x0c, 0x86, 0x8e, 0xba, 0x57, 0xf7, 0x57, 0xd0, 0xf7,
-	0x61, 0x32, 0x14, 0xc6, 0x16, 0xc0, 0xe2, 0xcc, 0xca, 0x37, 0x8a, 0xa3, 0x57, 0x55, 0x0c, 0xa8,
-	0x70, 0x88, 0x43, 0xab, 0x30, 0xe5, 0x12, 0x4a, 0x0d, 0xb3, 0xed, 0x0a, 0x53, 0x4c, 0x67, 0x7e,
-	0x5c, 0xa7, 0xb9, 0xdf, 0x08, 0x10, 0x52, 0xe6, 0x48, 0x4a, 0xfc, 0x16, 0xc4, 0x78, 0xf0, 0xf0,
-	0x71, 0x9e, 0xc3, 0x03, 0x4d, 0x24, 0xc3, 0x19, 0x67, 0x5f, 0x09, 0x0f, 0x20, 0x24, 0x16, 0xe2,
-	0x93, 0x88, 0xf0, 0xfe, 0xf5, 0x00, 0x21, 0xa5, 0x8e, 0xa4, 0xc4, 0x87, 0x20, 0x96, 0x02, 0x18,
-	0x3a, 0x83, 0x55, 0x46, 0x43, 0x34, 0x62, 0xec, 0x12, 0x5d, 0x51, 0xa9, 0x30, 0x1d, 0xd8, 0xe3,
-	0x5f, 0x67, 0x31, 0xbc, 0xce, 0x62, 0x33, 0xbc, 0x4e, 0x29, 0xe5, 0xd9, 0x71, 0xef, 0x3f, 0x79,
-	0x8f, 0x26, 0x50, 0x2c, 0x51, 0x74, 0x15, 0xce, 0x6a, 0x96, 0xe3, 0x90, 0x8e, 0x4a, 0x0d, 0xcb,
-	0x54, 0x0c, 0xdd, 0x15, 0x92, 0x0b, 0xf1, 0xc5, 0xb4, 0x24, 0x1e, 0x49, 0xe9, 0x0f, 0xc1, 0x74,
-	0x61, 0xca, 0x89, 0x09, 0x7a, 0xff, 0x71, 0x3e, 0x57, 0x1e, 0xc2, 0x2a, 0xab, 0x2e, 0xce, 0x45,
-	0xd4, 0x2a, 0xba, 0x8b, 0xae, 0xc0, 0x39, 0x9d, 0xec, 0x1a, 0x1a, 0x51, 0xb4, 0x3b, 0xaa, 0x69,
-	0x92, 0x8e, 0x62, 0x98, 0x3a, 0xd9, 0x17, 0xd2, 0x0b, 0x60, 0x31, 0xcb, 0xce, 0x70, 0x21, 0x2e,
-	0x7c, 0x01, 0x30, 0xf2, 0x51, 0x65, 0x1f, 0x54, 0xf1, 0x30, 0x57, 0xa6, 0x1e, 0x7c, 0x94, 0xe7,
-	0xae, 0x4d, 0xa5, 0x52, 0x7c, 0xba, 0xf0, 0xc7, 0x38, 0x9c, 0x5d, 0xb5, 0xf6, 0xcc, 0xff, 0xf7,
-	0x65, 0xfe, 0x1c, 0xe6, 0x88, 0xa9, 0x2b, 0x81, 0xcd, 0xde, 0xb9, 0xe3, 0x4c, 0xf3, 0xdc, 0xb8,
-	0xa6, 0x6c, 0xea, 0xab, 0x0c, 0x54, 0x19, 0xc6, 0xb5, 0xc4, 0xf7, 0x1f, 0xe7, 0x33, 0x43, 0xc9,
-	0xaa, 0x8b, 0x33, 0x64, 0x88, 0x73, 0xd1, 0x0f, 0x61, 0xd2, 0x21, 0xef, 0x75, 0x89, 0x4b, 0x83,
-	0x48, 0x79, 0xf9, 0x78, 0xa4, 0x60, 0x1f, 0xb0, 0xce, 0xe1, 0x10, 0x8b, 0xae, 0xc0, 0xb4, 0xab,
-	0xdd, 0x21, 0x7a, 0xb7, 0x43, 0x74, 0x21, 0xf1, 0x65, 0x21, 0xb6, 0xce, 0xe1, 0x21, 0x7c, 0xd2,
-	0x4d, 0x4e, 0x9f, 0xe6, 0x26, 0xfd, 0xdb, 0x90, 0x66, 0x87, 0xc1, 0x8e, 0xe2, 0x4f, 0x25, 0x50,
-	0xf8, 0x77, 0x0c, 0xf2, 0xcd, 0xfd, 0x92, 0xb6, 0x6d, 0x5a, 0x7b, 0x1d, 0xa2, 0xb7, 0x77, 0x88,
-	0x39, 0x31, 0x7c, 0xc0, 0xa9, 0xc2, 0xa7, 0x02, 0xa7, 0x1d, 0xe2, 0x76, 0x3b, 0x94, 0x5d, 0x60,
-	0x6e, 0xe5, 0xb5, 0xe3, 0xc7, 0x1e, 0xdd, 0xba, 0x88, 0x19, 0x9c, 0x45, 0xd6, 0x6f, 0xbc, 0x34,
-	0xc3, 0x01, 0x41, 0xe1, 0x6f, 0x00, 0x4e, 0xfb, 0x42, 0x34, 0x03, 0x93, 0x8d, 0x9b, 0xe5, 0xb2,
-	0xdc, 0x68, 0xf0, 0x1c, 0x7a, 0x01, 0x66, 0x6f, 0x56, 0x37, 0xaa, 0xb5, 0xb7, 0xaa, 0x8a, 0x8c,
-	0x71, 0x0d, 0xf3, 0x00, 0x65, 0x60, 0xaa, 0x59, 0xab, 0x29, 0x9b, 0xa5, 0xa6, 0xcc, 0xc7, 0x50,
-	0x16, 0xa6, 0xbd, 0x2f, 0xb9, 0x84, 0x37, 0x6f, 0xf3, 0x71, 0x34, 0x07, 0xf9, 0x72, 0x6d, 0x73,
-	0xb3, 0xd2, 0xa8, 0xd4, 0xaa, 0x4a, 0xbd, 0x54, 0xde, 0x90, 0x9b, 0xfc, 0xd4, 0xe8, 0xaa, 0x24,
-	0x97, 0xca, 0xb5, 0x2a, 0x9f, 0xf0, 0x36, 0x6a, 0xde, 0x52, 0xd6, 0xb0, 0x7c, 0x83, 0x9f, 0x66,
-	0xac, 0xb7, 0x94, 0x7a, 0xed, 0x2d, 0x19, 0xf3, 0x49, 0xc4, 0xc3, 0xcc, 0xd5, 0x7a, 0x43, 0xb9,
-	0x59, 0xdd, 0xac, 0x95, 0x37, 0xe4, 0x55, 0x3e, 0x55, 0xf8, 0x5d, 0x1c, 0xbe, 0x50, 0xb2, 0xed,
-	0x8e, 0xa1, 0xb1, 0xe3, 0xfb, 0x85, 0x0b, 0xfd, 0x14, 0xe6, 0x5c, 0xe2, 0xba, 0x9e, 0x1b, 0xb7,
-	0x49, 0x4f, 0x31, 0x82, 0x38, 0x97, 0x84, 0x23, 0x29, 0xf1, 0x7e, 0x5c, 0xb8, 0xcb, 0x42, 0xae,
-	0xe1, 0x23, 0x36, 0x48, 0xaf, 0xb2, 0x8a, 0x33, 0xee, 0xf0, 0x4b, 0x47, 0xe7, 0xe0, 0x74, 0x4b,
-	0xb1, 0x2d, 0xc7, 0xf7, 0x60, 0x56, 0xca, 0x1e, 0x49, 0xf0, 0x42, 0x4a, 0xf8, 0x02, 0x2c, 0x82,
-	0xcb, 0x4f, 0x00, 0x4e, 0xb4, 0xea, 0x96, 0x43, 0xd1, 0x8b, 0x30, 0xd1, 0x52, 0x34, 0x93, 0xb2,
-	0x68, 0xcf, 0xe2, 0xa9, 0x56, 0xd9, 0xa4, 0x68, 0x19, 0xce, 0xb4, 0x9c, 0x9d, 0x41, 0x7e, 0x4d,
-	0xb1, 0x7d, 0x73, 0xfd, 0xc7, 0x79, 0xb8, 0x86, 0xaf, 0x07, 0x39, 0x86, 0x61, 0xcb, 0xd9, 0x09,
-	0xf3, 0xed, 0x0d, 0x38, 0xab, 0x13, 0xcd, 0xd2, 0x89, 0x3e, 0x50, 0x4a, 0x04, 0x79, 0x37, 0x5e,
-	0x80, 0x1a, 0xac, 0xdb, 0xe0, 0x5c, 0x80, 0x0f, 0x19, 0xc6, 0xaa, 0xe0, 0xf4, 0x29, 0xab, 0x60,
-	0xb4, 0x24, 0x27, 0x4f, 0x5b, 0x92, 0x0b, 0xff, 0x8a, 0xc1, 0x17, 0x23, 0x17, 0xb2, 0x69, 0xf9,
-	0x7f, 0x91, 0x00, 0x93, 0x2e, 0x71, 0xbc, 0x9c, 0x66, 0x77, 0x91, 0xc6, 0xe1, 0x27, 0x5a, 0x83,
-	0xa9, 0x4e, 0x80, 0x0a, 0x2a, 0x8e, 0x30, 0xbe, 0x6f, 0xc8, 0x22, 0xf1, 0xd1, 0x5d, 0x1f, 0x3d,
-	0xce, 0x03, 0x3c, 0xd0, 0x45, 0xbf, 0x06, 0x10, 0xaa, 0x94, 0x3a, 0xc6, 0x56, 0x97, 0x12, 0xaf,
-	0x04, 0x79, 0x6e, 0xb8, 0x34, 0x4e, 0x35, 0xc1, 0xb6, 0x62, 0x69, 0xa0, 0x25, 0x9b, 0xd4, 0xe9,
-	0x49, 0x17, 0x8f, 0xa4, 0xf3, 0x7f, 0x01, 0xdf, 0x29, 0x9c, 0x73, 0x0a, 0xc2, 0xb9, 0x15, 0xf1,
-	0x17, 0x6f, 0xab, 0x4b, 0xef, 0x7f, 0x6f, 0xe9, 0xc7, 0xef, 0x2c, 0xbe, 0x7e, 0xe5, 0xed, 0xa5,
-	0x77, 0x5e, 0x0f, 0x3f, 0xcf, 0xff, 0x72, 0xe5, 0xe2, 0xaf, 0xce, 0xe1, 0xc8, 0xa6, 0xf3, 0x3f,
-	0x81, 0xb3, 0x63, 0x64, 0x88, 0x87, 0xf1, 0x6d, 0xd2, 0x0b, 0x0e, 0xed, 0xfd, 0x44, 0x73, 0x30,
-	0xb1, 0xab, 0x76, 0xba, 0x84, 0x9d, 0x36, 0x8d, 0xfd, 0x8f, 0x2b, 0xb1, 0xcb, 0xa0, 0xf0, 0xfb,
-	0x18, 0x7c, 0x29, 0x62, 0xe0, 0x35, 0xcb, 0x30, 0x4b, 0x9a, 0x46, 0x6c, 0xfa, 0x95, 0x23, 0xfa,
-	0x47, 0x30, 0xad, 0xda, 0xb6, 0xe2, 0x7a, 0xda, 0x81, 0x97, 0xbf, 0x39, 0xee, 0x9a, 0x0d, 0xd2,
-	0x93, 0xcd, 0x5d, 0xd2, 0xb1, 0x6c, 0x82, 0x93, 0xaa, 0x6d, 0x37, 0x36, 0x48, 0x0f, 0xdd, 0x82,
-	0x2f, 0x19, 0x66, 0x38, 0x35, 0xe9, 0x8a, 0x1e, 0xb4, 0x93, 0xd0, 0xbf, 0xaf, 0x3c, 0xc3, 0xbf,
-	0x61, 0xeb, 0xc1, 0x73, 0x11, 0x86, 0x70, 0xd1, 0x45, 0xaf, 0xc1, 0x59, 0x9b, 0x98, 0xba, 0x61,
-	0xb6, 0x95, 0xc0, 0x54, 0x96, 0x2d, 0x29, 0x9c, 0x0b, 0x96, 0x83, 0xe3, 0x14, 0xfe, 0x9a, 0x18,
-	0x09, 0xa9, 0x90, 0xe1, 0x6b, 0x96, 0xe5, 0x67, 0x61, 0x5a, 0xb3, 0xcc, 0x96, 0xe1, 0xec, 0x10,
-	0x9d, 0x8d, 0x28, 0x29, 0x3c, 0x5c, 0x40, 0x57, 0x61, 0x5a, 0xeb, 0xa8, 0xae, 0xab, 0x6c, 0x29,
-	0x5a, 0x90, 0xbd, 0xdf, 0x3d, 0xc1, 0xd5, 0x14, 0xcb, 0x9e, 0x92, 0x54, 0xc6, 0x49, 0xcd, 0xff,
-	0x81, 0xd6, 0x61, 0xca, 0x76, 0x0c, 0xcb, 0x31, 0x68, 0x4f, 0x48, 0xb1, 0xf6, 0x51, 0x98, 0x50,
-	0x05, 0x82, 0x4e, 0x59, 0x0f, 0x90, 0x91, 0xce, 0x31, 0xd0, 0x9e, 0xd4, 0xcf, 0xd2, 0xa7, 0xe9,
-	0x67, 0xf3, 0x7f, 0x02, 0x30, 0x19, 0xd8, 0x89, 0x64, 0x98, 0x6a, 0xab, 0x94, 0xec, 0xa9, 0x3d,
-	0x7f, 0xb8, 0x9a, 0x59, 0x39, 0x3f, 0x6e, 0xde, 0x55, 0x5f, 0x5e, 0x32, 0x29, 0x31, 0x4d, 0x35,
-	0x32, 0x69, 0xe0, 0x81, 0x2a, 0x92, 0x61, 0x56, 0xdd, 0x72, 0xad, 0x4e, 0x97, 0x12, 0xc5, 0x9b,
-	0xd2, 0xd9, 0x51, 0x9f, 0x3d, 0xf3, 0x4d, 0xb1, 0x79, 0x2f, 0x13, 0xaa, 0x79, 0x02, 0xbf, 0xbd,
-	0x17, 0x6e, 0xc3, 0xb9, 0x09, 0xae, 0x75, 0x51, 0x09, 0xa6, 0x87, 0xe9, 0x02, 0x4e, 0x9e, 0x2e,
-	0x43, 0xad, 0xc2, 0x7d, 0x00, 0x5f, 0x9e, 0x00, 0x59, 0x53, 0x0d, 0x6f, 0x4c, 0xb9, 0x01, 0x53,
-	0x21, 0x94, 0x85, 0xfe, 0xc9, 0xf8, 0x27, 0x15, 0xd1, 0x90, 0x06, 0xbd, 0x01, 0x13, 0xec, 0x49,
-	0x12, 0xd4, 0x88, 0xb3, 0xc7, 0x26, 0x38, 0x4f, 0xb8, 0x4a, 0xa8, 0x6a, 0x74, 0xc6, 0x7b, 0x80,
-	0xaf, 0x58, 0xf8, 0x03, 0x80, 0xf9, 0xc8, 0xae, 0x95, 0x49, 0xa9, 0xbf, 0x71, 0x3a, 0xcf, 0x44,
-	0x1a, 0xd7, 0x50, 0x1f, 0xbd, 0x0a, 0x67, 0x3b, 0xaa, 0x4b, 0x15, 0x96, 0xa5, 0xac, 0x40, 0xf9,
-	0xf9, 0x8c, 0x33, 0xde, 0xf2, 0x5a, 0xd9, 0xa4, 0x9e, 0x7e, 0xe1, 0x30, 0x09, 0xb3, 0x23, 0x93,
-	0xc2, 0x84, 0xb1, 0x15, 0x3c, 0xcf, 0xd8, 0x7a, 0xcc, 0x8b, 0xa3, 0x63, 0xeb, 0x84, 0xf0, 0x8f,
-	0x9d, 0x6a, 0x9c, 0x2b, 0x8d, 0xbe, 0x4e, 0x32, 0x27, 0x8c, 0xd4, 0xe8, 0xcb, 0xe4, 0x1a, 0xcc,
-	0x75, 0xd9, 0x64, 0xa4, 0x04, 0x4f, 0xea, 0x60, 0x40, 0xff, 0xf6, 0x33, 0x9c, 0xee, 0x8f, 0x52,
-	0xeb, 0x1c, 0xce, 0x76, 0x47, 0x5e, 0x83, 0xeb, 0x70, 0xe6, 0x5d, 0xcb, 0x30, 0x15, 0x95, 0x35,
-	0xa6, 0x60, 0x24, 0x7f, 0xf5, 0x19, 0x44, 0xc3, 0x2e, 0xb6, 0xce, 0x61, 0xf8, 0xee, 0xb0, 0xa7,
-	0xad, 0xc3, 0x4c, 0x78, 0x8b, 0x8a, 0xaa, 0x6d, 0x07, 0x05, 0xf1, 0x24, 0x81, 0xb0, 0xce, 0xe1,
-	0x99, 0x50, 0xb5, 0xa4, 0x6d, 0xa3, 0x6b, 0x30, 0x3b, 0x60, 0x32, 0x3d, 0xaa, 0xe9, 0xe7, 0xa1,
-	0x1a, 0x58, 0x51, 0x55, 0xc7, 0xb8, 0x5c, 0x62, 0xd2, 0xa0, 0x9a, 0x3e, 0x2f, 0x57, 0xc3, 0x1b,
-	0xe9, 0x9b, 0x70, 0x76, 0xc0, 0xd5, 0x62, 0x39, 0x1b, 0x14, 0x9a, 0xf3, 0x27, 0x60, 0xf3, 0x93,
-	0x7c, 0x9d, 0xc3, 0x39, 0x7d, 0x34, 0xed, 0xab, 0x11, 0xd6, 0xf7, 0xba, 0xa4, 0x4b, 0x74, 0xf6,
-	0x32, 0x3c, 0xb1, 0x8d, 0x03, 0xbe, 0x1b, 0x4c, 0x19, 0x59, 0x70, 0x7e, 0x94, 0x4f, 0x89, 0xf4,
-	0x6b, 0x01, 0x32, 0xea, 0xe5, 0x67, 0x50, 0x4f, 0x4a, 0xf1, 0x75, 0x0e, 0x0b, 0x23, 0xdb, 0x44,
-	0x40, 0xde, 0x01, 0xc2, 0xa9, 0x4d, 0x71, 0xad, 0xce, 0x2e, 0xd1, 0x85, 0x99, 0x2f, 0x3d, 0x40,
-	0x38, 0xad, 0x79, 0x07, 0x08, 0xb5, 0x1b, 0x4c, 0x59, 0x4a, 0xc3, 0x58, 0xd7, 0xf6, 0x5f, 0x56,
-	0xff, 0x88, 0x41, 0x21, 0x88, 0xd4, 0xa0, 0x71, 0xae, 0x59, 0xce, 0x8e, 0x4a, 0x29, 0x71, 0x5c,
-	0x74, 0x1d, 0x66, 0xba, 0xb6, 0xd2, 0x0a, 0x17, 0x58, 0xba, 0xe7, 0x56, 0x16, 0xc6, 0x37, 0x1d,
-	0x57, 0x8c, 0x74, 0xb7, 0x99, 0xae, 0x3d, 0x58, 0x46, 0x3f, 0x80, 0x67, 0xa2, 0x74, 0x8a, 0xad,
-	0x3a, 0xea, 0x0e, 0xf1, 0x88, 0xfd, 0xc1, 0x6e, 0x2e, 0x02, 0xae, 0x87, 0x32, 0x74, 0x03, 0x32,
-	0xff, 0x47, 0xcc, 0x88, 0x3f, 0xb7, 0x19, 0x2c, 0x42, 0x87, 0x86, 0x5c, 0x86, 0xc2, 0x28, 0x65,
-	0xc4, 0x94, 0x29, 0x66, 0xca, 0x99, 0x11, 0x85, 0x81, 0x31, 0x85, 0x7f, 0x02, 0x38, 0xb7, 0x1a,
-	0xbd, 0xa6, 0xe0, 0x21, 0x8d, 0x9a, 0x5f, 0xa9, 0x36, 0xa6, 0xfe, 0x47, 0x4d, 0x1c, 0xe9, 0x88,
-	0xb1, 0xd3, 0x74, 0xc4, 0x0b, 0xf7, 0x00, 0xe4, 0xc7, 0x3d, 0x83, 0x10, 0xcc, 0xad, 0xd5, 0xf0,
-	0xf5, 0x52, 0xb3, 0x29, 0x63, 0xa5, 0x5a, 0xab, 0xca, 0x3c, 0x87, 0x04, 0x38, 0x37, 0x5c, 0xc3,
-	0x72, 0xbd, 0xd6, 0xa8, 0x34, 0x6b, 0xf8, 0x36, 0x0f, 0xd0, 0x3c, 0x3c, 0x33, 0x94, 0x5c, 0xc5,
-	0xf5, 0xb2, 0xd2, 0x90, 0xf1, 0x9b, 0x95, 0xb2, 0xf7, 0x6e, 0x1d, 0xd1, 0xba, 0x56, 0x7a, 0xb3,
-	0xd4, 0x28, 0xe3, 0x4a, 0xbd, 0xc9, 0xc7, 0x47, 0x25, 0xe5, 0xd2, 0x6d, 0xb9, 0x5a, 0x95, 0x37,
-	0xeb, 0x75, 0x7e, 0x4a, 0xfa, 0x3b, 0x78, 0x78, 0x20, 0x82, 0x47, 0x07, 0x22, 0xf8, 0xf4, 0x40,
-	0xe4, 0x9e, 0x1c, 0x88, 0xdc, 0x67, 0x07, 0x22, 0xf7, 0xf9, 0x81, 0xc8, 0x3d, 0x3d, 0x10, 0xc1,
-	0xdd, 0xbe, 0x08, 0x3e, 0xe8, 0x8b, 0xdc, 0xc7, 0x7d, 0x11, 0xdc, 0xef, 0x8b, 0xdc, 0x83, 0xbe,
-	0xc8, 0x7d, 0xd2, 0x17, 0xb9, 0x87, 0x7d, 0x11, 0x3c, 0xea, 0x8b, 0xe0, 0xd3, 0xbe, 0xc8, 0x3d,
-	0xe9, 0x8b, 0xe0, 0xb3, 0xbe, 0xc8, 0x7d, 0xde, 0x17, 0xc1, 0xd3, 0xbe, 0xc8, 0xdd, 0x3d, 0x14,
-	0xb9, 0x0f, 0x0e, 0x45, 0x70, 0xef, 0x50, 0xe4, 0xfe, 0x7c, 0x28, 0x82, 0x8f, 0x0e, 0x45, 0xee,
-	0xe3, 0x43, 0x91, 0xbb, 0x7f, 0x28, 0x82, 0x07, 0x87, 0x22, 0xf8, 0xe4, 0x50, 0x04, 0x3f, 0xbb,
-	0xd8, 0xb6, 0x8a, 0xf4, 0x0e, 0xa1, 0x77, 0xbc, 0x77, 0x57, 0xd1, 0x24, 0x74, 0xcf, 0x72, 0xb6,
-	0x97, 0x47, 0xff, 0xbf, 0x67, 0x6f, 0xb7, 0x97, 0x29, 0x35, 0xed, 0xad, 0xad, 0x69, 0xd6, 0x28,
-	0x2e, 0xfd, 0x37, 0x00, 0x00, 0xff, 0xff, 0x92, 0x68, 0x46, 0x3a, 0x67, 0x15, 0x00, 0x00,
+	// 2072 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x19, 0xde, 0x21, 0xc5, 0xd7, 0xf0, 0xa1, 0xcd, 0x44, 0x71, 0x37, 0xaa, 0xbb, 0x54, 0x19, 0xa7,
+	0x91, 0x5d, 0x8b, 0x6a, 0xe5, 0x16, 0x75, 0x5d, 0xb4, 0x0e, 0x97, 0x5a, 0x59, 0xb4, 0x64, 0x92,
+	0x1e, 0xd2, 0x89, 0xdd, 0x34, 0x5d, 0xac, 0x76, 0x87, 0xf4, 0x46, 0xd4, 0xee, 0x66, 0x77, 0x28,
+	0x89, 0x29, 0x0a, 0xb8, 0x3d, 0x05, 0x3d, 0x14, 0x46, 0x8a, 0x3e, 0xd0, 0x02, 0x45, 0xd0, 0x53,
+	0x0e, 0x05, 0xea, 0xa3, 0xd1, 0x53, 0x6e, 0xf5, 0xd1, 0xc7, 0x9c, 0x54, 0x8b, 0xbc, 0xe4, 0x98,
+	0xa3, 0xa1, 0x4b, 0x8a, 0x7d, 0x91, 0x4b, 0x8a, 0xb5, 0x65, 0x05, 0x3d, 0xf5, 0x24, 0xee, 0xcc,
+	0xff, 0x7d, 0xf3, 0xcf, 0xff, 0x1e, 0xc1, 0x85, 0x8e, 0x61, 0xc9, 0x7b, 0xb2, 0xbe, 0x64, 0x53,
+	0x59, 0xd9, 0x5e, 0x96, 0x4d, 0x6d, 0x79, 0x87, 0xd8, 0xb6, 0xdc, 0x26, 0x76, 0xd1, 0xb4, 0x0c,
+	0x6a, 0xa0, 0x1c, 0xa5, 0x7a, 0xd1, 0x97, 0x2a, 0xee, 0x5e, 0x9a, 0x2f, 0xb5, 0x35, 0x7a, 0xb7,
+	0xbb, 0x55, 0x54, 0x8c, 0x9d, 0x65, 0xa2, 0xef, 0x1a, 0x3d, 0xd3, 0x32, 0xf6, 0x7b, 0xcb, 0xae,
+	0xb0, 0xb2, 0xd4, 0x26, 0xfa, 0xd2, 0xae, 0xdc, 0xd1, 0x54, 0x99, 0x92, 0xe5, 0x63, 0x3f, 0x3c,
+	0xca, 0xf9, 0xa5, 0x10, 0x45, 0xdb, 0x68, 0x1b, 0x1e, 0x78, 0xab, 0xdb, 0x72, 0xbf, 0xdc, 0x0f,
+	0xf7, 0x97, 0x2f, 0x7e, 0xb6, 0x6d, 0x18, 0xed, 0x0e, 0x19, 0x49, 0xd9, 0xd4, 0xea, 0x2a, 0xd4,
+	0xdf, 0xcd, 0x4f, 0xee, 0x52, 0x6d, 0x87, 0xd8, 0x54, 0xde, 0x31, 0x7d, 0x81, 0x6f, 0x1c, 0xbf,
+	0x22, 0xb1, 0x2c, 0xc3, 0xf2, 0xb7, 0x5f, 0x3b, 0xbe, 0xad, 0xa9, 0x44, 0xa7, 0x5a, 0x4b, 0x23,
+	0x96, 0x1d, 0xa8, 0x70, 0x5c, 0x68, 0x9b, 0xf4, 0x82, 0xdd, 0xfc, 0xf1, 0xdd, 0xc0, 0x60, 0x9e,
+	0xc0, 0x54, 0x2b, 0x53, 0x59, 0x95, 0xa9, 0xec, 0x49, 0x14, 0x1e, 0x45, 0x61, 0xf6, 0x96, 0xd9,
+	0xd1, 0xf4, 0xed, 0x1b, 0x9e, 0xf9, 0x51, 0x1e, 0xa6, 0x2d, 0x79, 0x4f, 0x32, 0xe5, 0x5e, 0xc7,
+	0x90, 0x55, 0x0e, 0x2c, 0x80, 0xc5, 0x0c, 0x86, 0x96, 0xbc, 0x57, 0xf7, 0x56, 0xd0, 0x77, 0x61,
+	0x22, 0xd8, 0x8c, 0x2c, 0x80, 0xc5, 0xf4, 0xca, 0xd7, 0x8a, 0xe3, 0xae, 0x2a, 0xfa, 0x54, 0x38,
+	0x90, 0x43, 0xab, 0x30, 0x69, 0x13, 0x4a, 0x35, 0xbd, 0x6d, 0x73, 0x33, 0x2e, 0x66, 0x7e, 0x12,
+	0xd3, 0xdc, 0x6f, 0xf8, 0x12, 0x42, 0xe6, 0x48, 0x88, 0xfd, 0x06, 0x44, 0x58, 0xf0, 0xe8, 0x20,
+	0xcf, 0xe0, 0x21, 0x12, 0x89, 0x30, 0x6d, 0xed, 0x4b, 0xc1, 0x05, 0xb8, 0xd8, 0x42, 0x74, 0x1a,
+	0x11, 0xde, 0xbf, 0xe1, 0x4b, 0x08, 0xc9, 0x23, 0x21, 0xf6, 0x11, 0x88, 0x24, 0x01, 0x86, 0xd6,
+	0x70, 0xd5, 0xa5, 0x21, 0x0a, 0xd1, 0x76, 0x89, 0x2a, 0xc9, 0x94, 0x8b, 0xfb, 0xfa, 0x78, 0xee,
+	0x2c, 0x06, 0xee, 0x2c, 0x36, 0x03, 0x77, 0x0a, 0x49, 0x47, 0x8f, 0xfb, 0xff, 0xce, 0x3b, 0x34,
+	0x3e, 0xb0, 0x44, 0xd1, 0x35, 0x38, 0xab, 0x18, 0x96, 0x45, 0x3a, 0x32, 0xd5, 0x0c, 0x5d, 0xd2,
+	0x54, 0x9b, 0x4b, 0x2c, 0x44, 0x17, 0x53, 0x02, 0x7f, 0x24, 0xa4, 0x3e, 0x02, 0xf1, 0xc2, 0x8c,
+	0x15, 0xe1, 0xd4, 0xfe, 0x41, 0x3e, 0x57, 0x1e, 0x89, 0x55, 0x56, 0x6d, 0x9c, 0x0b, 0xc1, 0x2a,
+	0xaa, 0x8d, 0xae, 0xc0, 0x39, 0x95, 0xec, 0x6a, 0x0a, 0x91, 0x94, 0xbb, 0xb2, 0xae, 0x93, 0x8e,
+	0xa4, 0xe9, 0x2a, 0xd9, 0xe7, 0x52, 0x0b, 0x60, 0x31, 0xeb, 0xde, 0xe1, 0x42, 0x94, 0xfb, 0x12,
+	0x60, 0xe4, 0x49, 0x95, 0x3d, 0xa1, 0x8a, 0x23, 0x73, 0x65, 0xe6, 0xe1, 0xc7, 0x79, 0xe6, 0xfa,
+	0x4c, 0x32, 0xc9, 0xa6, 0x0a, 0x7f, 0x88, 0xc2, 0xd9, 0x55, 0x63, 0x4f, 0xff, 0x5f, 0x3b, 0xf3,
+	0x67, 0x30, 0x47, 0x74, 0x55, 0xf2, 0x75, 0x76, 0xee, 0x1d, 0x75, 0x91, 0xe7, 0x26, 0x91, 0xa2,
+	0xae, 0xae, 0xba, 0x42, 0x95, 0x51, 0x5c, 0x0b, 0x6c, 0xff, 0x20, 0x9f, 0x19, 0xed, 0xac, 0xda,
+	0x38, 0x43, 0x46, 0x72, 0x36, 0xfa, 0x3e, 0x4c, 0x58, 0xe4, 0xfd, 0x2e, 0xb1, 0xa9, 0x1f, 0x29,
+	0xaf, 0x1e, 0x8f, 0x14, 0xec, 0x09, 0xac, 0x33, 0x38, 0x90, 0x45, 0x57, 0x60, 0xca, 0x56, 0xee,
+	0x12, 0xb5, 0xdb, 0x21, 0x2a, 0x17, 0x7b, 0x5e, 0x88, 0xad, 0x33, 0x78, 0x24, 0x3e, 0xcd, 0x93,
+	0xf1, 0xd3, 0x78, 0xd2, 0xf3, 0x86, 0x30, 0x3b, 0x0a, 0x76, 0x14, 0x7d, 0x2a, 0x80, 0xc2, 0xbf,
+	0x22, 0x90, 0x6d, 0xee, 0x97, 0x94, 0x6d, 0xdd, 0xd8, 0xeb, 0x10, 0xb5, 0xbd, 0x43, 0xf4, 0xa9,
+	0xe1, 0x03, 0x4e, 0x15, 0x3e, 0x15, 0x18, 0xb7, 0x88, 0xdd, 0xed, 0x50, 0xd7, 0x81, 0xb9, 0x95,
+	0x37, 0x8e, 0x5f, 0x7b, 0xfc, 0xe8, 0x22, 0x76, 0xc5, 0xdd, 0xc8, 0xfa, 0xb5, 0x93, 0x66, 0xd8,
+	0x27, 0x28, 0xfc, 0x15, 0xc0, 0xb8, 0xb7, 0x89, 0xd2, 0x30, 0xd1, 0xb8, 0x55, 0x2e, 0x8b, 0x8d,
+	0x06, 0xcb, 0xa0, 0x97, 0x60, 0xf6, 0x56, 0x75, 0xa3, 0x5a, 0x7b, 0xbb, 0x2a, 0x89, 0x18, 0xd7,
+	0x30, 0x0b, 0x50, 0x06, 0x26, 0x9b, 0xb5, 0x9a, 0xb4, 0x59, 0x6a, 0x8a, 0x6c, 0x04, 0x65, 0x61,
+	0xca, 0xf9, 0x12, 0x4b, 0x78, 0xf3, 0x0e, 0x1b, 0x45, 0x73, 0x90, 0x2d, 0xd7, 0x36, 0x37, 0x2b,
+	0x8d, 0x4a, 0xad, 0x2a, 0xd5, 0x4b, 0xe5, 0x0d, 0xb1, 0xc9, 0xce, 0x8c, 0xaf, 0x0a, 0x62, 0xa9,
+	0x5c, 0xab, 0xb2, 0x31, 0xe7, 0xa0, 0xe6, 0x6d, 0x69, 0x0d, 0x8b, 0x37, 0xd9, 0xb8, 0xcb, 0x7a,
+	0x5b, 0xaa, 0xd7, 0xde, 0x16, 0x31, 0x9b, 0x40, 0x2c, 0xcc, 0x5c, 0xab, 0x37, 0xa4, 0x5b, 0xd5,
+	0xcd, 0x5a, 0x79, 0x43, 0x5c, 0x65, 0x93, 0x85, 0xdf, 0x46, 0xe1, 0x4b, 0x25, 0xd3, 0xec, 0x68,
+	0x8a, 0x7b, 0x7d, 0xaf, 0x70, 0xa1, 0x9f, 0xc0, 0x9c, 0x4d, 0x6c, 0xdb, 0x31, 0xe3, 0x36, 0xe9,
+	0x49, 0x9a, 0x1f, 0xe7, 0x02, 0x77, 0x24, 0xc4, 0x3e, 0x88, 0x72, 0xf7, 0xdc, 0x90, 0x6b, 0x78,
+	0x12, 0x1b, 0xa4, 0x57, 0x59, 0xc5, 0x19, 0x7b, 0xf4, 0xa5, 0xa2, 0x73, 0x30, 0xde, 0x92, 0x4c,
+	0xc3, 0xf2, 0x2c, 0x98, 0x15, 0xb2, 0x47, 0x02, 0xbc, 0x90, 0xe4, 0xbe, 0x04, 0x8b, 0xe0, 0xf2,
+	0x13, 0x80, 0x63, 0xad, 0xba, 0x61, 0x51, 0xf4, 0x32, 0x8c, 0xb5, 0x24, 0x45, 0xa7, 0x6e, 0xb4,
+	0x67, 0xf1, 0x4c, 0xab, 0xac, 0x53, 0xb4, 0x0c, 0xd3, 0x2d, 0x6b, 0x67, 0x98, 0x5f, 0x33, 0xee,
+	0xb9, 0xb9, 0xfe, 0x41, 0x1e, 0xae, 0xe1, 0x1b, 0x7e, 0x8e, 0x61, 0xd8, 0xb2, 0x76, 0xfc, 0xdf,
+	0xe8, 0x4d, 0x38, 0xab, 0x12, 0xc5, 0x50, 0x89, 0x3a, 0x04, 0xc5, 0xfc, 0xbc, 0x9b, 0x2c, 0x40,
+	0x0d, 0xb7, 0xdb, 0xe0, 0x9c, 0x2f, 0x1f, 0x30, 0x4c, 0x54, 0xc1, 0xf8, 0x29, 0xab, 0x60, 0xb8,
+	0x24, 0x27, 0x4e, 0x5b, 0x92, 0x0b, 0xff, 0x8c, 0xc0, 0x97, 0x43, 0x0e, 0xd9, 0x34, 0xbc, 0xbf,
+	0x88, 0x83, 0x09, 0x9b, 0x58, 0x4e, 0x4e, 0xbb, 0xbe, 0x48, 0xe1, 0xe0, 0x13, 0xad, 0xc1, 0x64,
+	0xc7, 0x97, 0xf2, 0x2b, 0x0e, 0x37, 0x79, 0x6e, 0xc0, 0x22, 0xb0, 0xe1, 0x53, 0x1f, 0x1f, 0xe4,
+	0x01, 0x1e, 0x62, 0xd1, 0xaf, 0x00, 0x84, 0x32, 0xa5, 0x96, 0xb6, 0xd5, 0xa5, 0xc4, 0x29, 0x41,
+	0x8e, 0x19, 0x2e, 0x4d, 0x52, 0x4d, 0xd1, 0xad, 0x58, 0x1a, 0xa2, 0x44, 0x9d, 0x5a, 0x3d, 0xe1,
+	0xe2, 0x91, 0x70, 0xfe, 0xcf, 0xe0, 0x5b, 0x85, 0x73, 0x56, 0x81, 0x3b, 0xb7, 0xc2, 0xff, 0xfc,
+	0x1d, 0x79, 0xe9, 0x83, 0xef, 0x2c, 0xfd, 0xf0, 0xdd, 0xc5, 0xab, 0x57, 0xde, 0x59, 0x7a, 0xf7,
+	0x6a, 0xf0, 0x79, 0xfe, 0x17, 0x2b, 0x17, 0x7f, 0x79, 0x0e, 0x87, 0x0e, 0x9d, 0xff, 0x31, 0x9c,
+	0x9d, 0x20, 0x43, 0x2c, 0x8c, 0x6e, 0x93, 0x9e, 0x7f, 0x69, 0xe7, 0x27, 0x9a, 0x83, 0xb1, 0x5d,
+	0xb9, 0xd3, 0x25, 0xee, 0x6d, 0x53, 0xd8, 0xfb, 0xb8, 0x12, 0xb9, 0x0c, 0x0a, 0xbf, 0x8b, 0xc0,
+	0x57, 0x42, 0x0a, 0x5e, 0x37, 0x34, 0xbd, 0xa4, 0x28, 0xc4, 0xa4, 0x5f, 0x39, 0xa2, 0x7f, 0x00,
+	0x53, 0xb2, 0x69, 0x4a, 0xb6, 0x83, 0xf6, 0xad, 0xfc, 0xf5, 0x49, 0xd3, 0x6c, 0x90, 0x9e, 0xa8,
+	0xef, 0x92, 0x8e, 0x61, 0x12, 0x9c, 0x90, 0x4d, 0xb3, 0xb1, 0x41, 0x7a, 0xe8, 0x36, 0x7c, 0x45,
+	0xd3, 0x83, 0xa9, 0x49, 0x95, 0x54, 0xbf, 0x9d, 0x04, 0xf6, 0x7d, 0xed, 0x19, 0xf6, 0x0d, 0x5a,
+	0x0f, 0x9e, 0x0b, 0x31, 0x04, 0x8b, 0x36, 0x7a, 0x03, 0xce, 0x9a, 0x44, 0x57, 0x35, 0xbd, 0x2d,
+	0xf9, 0xaa, 0xba, 0xd9, 0x92, 0xc4, 0x39, 0x7f, 0xd9, 0xbf, 0x4e, 0xe1, 0x2f, 0xf1, 0xb1, 0x90,
+	0x0a, 0x18, 0xfe, 0xcf, 0xb2, 0xfc, 0x2c, 0x4c, 0x29, 0x86, 0xde, 0xd2, 0xac, 0x1d, 0xa2, 0xba,
+	0x23, 0x4a, 0x12, 0x8f, 0x16, 0xd0, 0x35, 0x98, 0x52, 0x3a, 0xb2, 0x6d, 0x4b, 0x5b, 0x92, 0xe2,
+	0x67, 0xef, 0xb7, 0x4f, 0xe0, 0x9a, 0x62, 0xd9, 0x01, 0x09, 0x65, 0x9c, 0x50, 0xbc, 0x1f, 0x68,
+	0x1d, 0x26, 0x4d, 0x4b, 0x33, 0x2c, 0x8d, 0xf6, 0xb8, 0xa4, 0xdb, 0x3e, 0x0a, 0x53, 0xaa, 0x80,
+	0xdf, 0x29, 0xeb, 0xbe, 0x64, 0xa8, 0x73, 0x0c, 0xd1, 0xd3, 0xfa, 0x59, 0xea, 0x54, 0xfd, 0xec,
+	0x2a, 0x84, 0x64, 0xdf, 0xd4, 0x2c, 0x62, 0x3b, 0xd3, 0x19, 0x7c, 0xee, 0x74, 0x36, 0xe3, 0x4e,
+	0x66, 0x29, 0x1f, 0x53, 0xa2, 0xe8, 0x47, 0x30, 0xf9, 0x7e, 0x97, 0x74, 0x89, 0x03, 0x4f, 0x9f,
+	0x10, 0x9e, 0x70, 0x11, 0x25, 0x3a, 0xff, 0x47, 0x00, 0x13, 0xbe, 0x95, 0x90, 0x08, 0x93, 0x6d,
+	0x99, 0x92, 0x3d, 0xb9, 0xe7, 0x8d, 0x76, 0xe9, 0x95, 0xf3, 0x93, 0xc6, 0xb9, 0xe6, 0xed, 0x97,
+	0x74, 0x4a, 0x74, 0x5d, 0x0e, 0xcd, 0x39, 0x78, 0x08, 0x45, 0x22, 0xcc, 0xca, 0x5b, 0xb6, 0xd1,
+	0xe9, 0x52, 0x22, 0x39, 0x6f, 0x04, 0x2e, 0x79, 0x42, 0xa5, 0x32, 0x01, 0xcc, 0xd9, 0xf0, 0x86,
+	0x8b, 0xc2, 0x1d, 0x38, 0x37, 0xc5, 0xb1, 0x36, 0x2a, 0xc1, 0xd4, 0x28, 0x59, 0xc1, 0xc9, 0x93,
+	0x75, 0x84, 0x2a, 0x3c, 0x00, 0xf0, 0xd5, 0x29, 0x22, 0x6b, 0xb2, 0xe6, 0x0c, 0x49, 0x37, 0x61,
+	0x32, 0x10, 0x75, 0x13, 0xef, 0x64, 0xfc, 0xd3, 0x4a, 0x78, 0x40, 0x83, 0xde, 0x84, 0x31, 0xf7,
+	0x41, 0xe4, 0x57, 0xa8, 0xb3, 0xc7, 0xe6, 0x47, 0x67, 0x73, 0x95, 0x50, 0x59, 0xeb, 0x4c, 0x76,
+	0x20, 0x0f, 0x58, 0xf8, 0x3d, 0x80, 0xf9, 0xd0, 0xa9, 0x95, 0x69, 0x85, 0x67, 0xe3, 0x74, 0x96,
+	0x09, 0xb5, 0xcd, 0x11, 0x1e, 0xbd, 0x0e, 0x67, 0x3b, 0xb2, 0x4d, 0x25, 0xb7, 0x46, 0xb8, 0xe5,
+	0xd1, 0xab, 0x26, 0x38, 0xe3, 0x2c, 0xaf, 0x95, 0x75, 0xea, 0xe0, 0x0b, 0x83, 0x04, 0xcc, 0x8e,
+	0xcd, 0x29, 0x53, 0x86, 0x66, 0xf0, 0x22, 0x43, 0xf3, 0x31, 0x2b, 0x8e, 0x0f, 0xcd, 0x53, 0x92,
+	0x2f, 0x72, 0xaa, 0xe4, 0x2b, 0x8d, 0xbf, 0x8d, 0x32, 0x27, 0x8c, 0xd4, 0xf0, 0xbb, 0xe8, 0x3a,
+	0xcc, 0x75, 0xdd, 0xb9, 0x4c, 0xf2, 0x1f, 0xf4, 0xfe, 0xf3, 0xe0, 0x9b, 0xcf, 0x30, 0xba, 0x37,
+	0xc8, 0xad, 0x33, 0x38, 0xdb, 0x1d, 0x7b, 0x8b, 0xae, 0xc3, 0xf4, 0x7b, 0x86, 0xa6, 0x4b, 0xb2,
+	0xdb, 0x16, 0xfd, 0x07, 0xc1, 0xeb, 0xcf, 0x20, 0x1a, 0xf5, 0xd0, 0x75, 0x06, 0xc3, 0xf7, 0x86,
+	0x5f, 0x68, 0x1d, 0x66, 0x02, 0x2f, 0x4a, 0xb2, 0xb2, 0xed, 0x97, 0xe3, 0x93, 0x04, 0xc2, 0x3a,
+	0x83, 0xd3, 0x01, 0xb4, 0xa4, 0x6c, 0xa3, 0xeb, 0x30, 0x3b, 0x64, 0xd2, 0x1d, 0xaa, 0xf8, 0x8b,
+	0x50, 0x0d, 0xb5, 0xa8, 0xca, 0x13, 0x5c, 0x36, 0xd1, 0x29, 0x97, 0x38, 0x15, 0x57, 0xc3, 0x79,
+	0x50, 0x34, 0xe1, 0xec, 0x90, 0xab, 0xe5, 0xe6, 0xac, 0x5f, 0x68, 0xce, 0x9f, 0x80, 0xcd, 0x4b,
+	0xf2, 0x75, 0x06, 0xe7, 0xd4, 0xf1, 0xb4, 0xaf, 0x86, 0x58, 0xdd, 0x1a, 0xa9, 0x72, 0xa9, 0x17,
+	0xd1, 0x71, 0xc8, 0x77, 0xd3, 0x05, 0x23, 0x03, 0xce, 0x8f, 0xf3, 0x49, 0xa1, 0x69, 0xc1, 0xaf,
+	0xf6, 0xcb, 0xcf, 0xa0, 0x9e, 0x96, 0xe2, 0xeb, 0x0c, 0xe6, 0xc6, 0x8e, 0x09, 0x09, 0x39, 0x17,
+	0x08, 0x66, 0x46, 0xc9, 0x36, 0x3a, 0xbb, 0x44, 0xe5, 0xd2, 0xcf, 0xbd, 0x40, 0x30, 0x2b, 0x3a,
+	0x17, 0x08, 0xd0, 0x0d, 0x17, 0x2c, 0xa4, 0x60, 0xa4, 0x6b, 0x7a, 0xef, 0xba, 0xbf, 0x47, 0x20,
+	0xe7, 0x47, 0xaa, 0xdf, 0xb6, 0xd7, 0x0c, 0x6b, 0x47, 0xa6, 0x94, 0x58, 0x36, 0xba, 0x01, 0x33,
+	0x5d, 0x53, 0x6a, 0x05, 0x0b, 0x6e, 0xba, 0xe7, 0x56, 0x16, 0x26, 0x0f, 0x9d, 0x04, 0x86, 0x7a,
+	0x6b, 0xba, 0x6b, 0x0e, 0x97, 0xd1, 0xf7, 0xe0, 0x99, 0x30, 0x9d, 0x64, 0xca, 0x96, 0xbc, 0x43,
+	0x1c, 0x62, 0x6f, 0xac, 0x9c, 0x0b, 0x09, 0xd7, 0x83, 0x3d, 0x74, 0x13, 0xba, 0xf6, 0x0f, 0xa9,
+	0x11, 0x7d, 0x61, 0x35, 0xdc, 0x08, 0x1d, 0x29, 0x72, 0x19, 0x72, 0xe3, 0x94, 0x21, 0x55, 0x66,
+	0x5c, 0x55, 0xce, 0x8c, 0x01, 0x86, 0xca, 0x14, 0xfe, 0x01, 0xe0, 0xdc, 0x6a, 0xd8, 0x4d, 0xfe,
+	0x33, 0x1e, 0x35, 0xbf, 0x52, 0x6d, 0x4c, 0xfe, 0x97, 0x9a, 0x38, 0xd6, 0x11, 0x23, 0xa7, 0xe9,
+	0x88, 0x17, 0xee, 0x03, 0xc8, 0x4e, 0x5a, 0x06, 0x21, 0x98, 0x5b, 0xab, 0xe1, 0x1b, 0xa5, 0x66,
+	0x53, 0xc4, 0x52, 0xb5, 0x56, 0x15, 0x59, 0x06, 0x71, 0x70, 0x6e, 0xb4, 0x86, 0xc5, 0x7a, 0xad,
+	0x51, 0x69, 0xd6, 0xf0, 0x1d, 0x16, 0xa0, 0x79, 0x78, 0x66, 0xb4, 0x73, 0x0d, 0xd7, 0xcb, 0x52,
+	0x43, 0xc4, 0x6f, 0x55, 0xca, 0xce, 0xab, 0x79, 0x0c, 0x75, 0xbd, 0xf4, 0x56, 0xa9, 0x51, 0xc6,
+	0x95, 0x7a, 0x93, 0x8d, 0x8e, 0xef, 0x94, 0x4b, 0x77, 0xc4, 0x6a, 0x55, 0xdc, 0xac, 0xd7, 0xd9,
+	0x19, 0xe1, 0x6f, 0xe0, 0xd1, 0x21, 0x0f, 0x1e, 0x1f, 0xf2, 0xe0, 0xb3, 0x43, 0x9e, 0x79, 0x72,
+	0xc8, 0x33, 0x9f, 0x1f, 0xf2, 0xcc, 0x17, 0x87, 0x3c, 0xf3, 0xf4, 0x90, 0x07, 0xf7, 0xfa, 0x3c,
+	0xf8, 0xb0, 0xcf, 0x33, 0x9f, 0xf4, 0x79, 0xf0, 0xa0, 0xcf, 0x33, 0x0f, 0xfb, 0x3c, 0xf3, 0x69,
+	0x9f, 0x67, 0x1e, 0xf5, 0x79, 0xf0, 0xb8, 0xcf, 0x83, 0xcf, 0xfa, 0x3c, 0xf3, 0xa4, 0xcf, 0x83,
+	0xcf, 0xfb, 0x3c, 0xf3, 0x45, 0x9f, 0x07, 0x4f, 0xfb, 0x3c, 0x73, 0x6f, 0xc0, 0x33, 0x1f, 0x0e,
+	0x78, 0x70, 0x7f, 0xc0, 0x33, 0x7f, 0x1a, 0xf0, 0xe0, 0xe3, 0x01, 0xcf, 0x7c, 0x32, 0xe0, 0x99,
+	0x07, 0x03, 0x1e, 0x3c, 0x1c, 0xf0, 0xe0, 0xd3, 0x01, 0x0f, 0x7e, 0x7a, 0xb1, 0x6d, 0x14, 0xe9,
+	0x5d, 0x42, 0xef, 0x3a, 0xaf, 0xbe, 0xa2, 0x4e, 0xe8, 0x9e, 0x61, 0x6d, 0x2f, 0x8f, 0xff, 0x77,
+	0xd1, 0xdc, 0x6e, 0x2f, 0x53, 0xaa, 0x9b, 0x5b, 0x5b, 0x71, 0xb7, 0x51, 0x5c, 0xfa, 0xcf, 0x00,
+	0x4d, 0x0d, 0xbb, 0x1f, 0xe5, 0x15, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -2034,6 +2058,20 @@ func (this *ApplicationDownlink) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if that1.QueueAt == nil {
+		if this.QueueAt != nil {
+			return false
+		}
+	} else if !this.QueueAt.Equal(*that1.QueueAt) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationDownlink_ClassBC) Equal(that interface{}) bool {
@@ -2965,6 +3003,26 @@ func (m *ApplicationDownlink) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n15, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.QueueAt != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.QueueAt)))
+		n16, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.QueueAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
 	return i, nil
 }
 
@@ -2999,11 +3057,11 @@ func (m *ApplicationDownlink_ClassBC) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime)))
-		n15, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i:])
+		n17, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	return i, nil
 }
@@ -3056,19 +3114,19 @@ func (m *ApplicationDownlinkFailed) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.ApplicationDownlink.Size()))
-	n16, err := m.ApplicationDownlink.MarshalTo(dAtA[i:])
+	n18, err := m.ApplicationDownlink.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n18
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.Error.Size()))
-	n17, err := m.Error.MarshalTo(dAtA[i:])
+	n19, err := m.Error.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	return i, nil
 }
 
@@ -3125,11 +3183,11 @@ func (m *ApplicationUp) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n18, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n20, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
 	if len(m.CorrelationIDs) > 0 {
 		for _, s := range m.CorrelationIDs {
 			dAtA[i] = 0x12
@@ -3146,21 +3204,21 @@ func (m *ApplicationUp) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.Up != nil {
-		nn19, err := m.Up.MarshalTo(dAtA[i:])
+		nn21, err := m.Up.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn19
+		i += nn21
 	}
 	if m.ReceivedAt != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt)))
-		n20, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i:])
+		n22, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
 	}
 	return i, nil
 }
@@ -3171,11 +3229,11 @@ func (m *ApplicationUp_UplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.UplinkMessage.Size()))
-		n21, err := m.UplinkMessage.MarshalTo(dAtA[i:])
+		n23, err := m.UplinkMessage.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	return i, nil
 }
@@ -3185,11 +3243,11 @@ func (m *ApplicationUp_JoinAccept) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.JoinAccept.Size()))
-		n22, err := m.JoinAccept.MarshalTo(dAtA[i:])
+		n24, err := m.JoinAccept.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n24
 	}
 	return i, nil
 }
@@ -3199,11 +3257,11 @@ func (m *ApplicationUp_DownlinkAck) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkAck.Size()))
-		n23, err := m.DownlinkAck.MarshalTo(dAtA[i:])
+		n25, err := m.DownlinkAck.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	return i, nil
 }
@@ -3213,11 +3271,11 @@ func (m *ApplicationUp_DownlinkNack) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkNack.Size()))
-		n24, err := m.DownlinkNack.MarshalTo(dAtA[i:])
+		n26, err := m.DownlinkNack.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	return i, nil
 }
@@ -3227,11 +3285,11 @@ func (m *ApplicationUp_DownlinkSent) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkSent.Size()))
-		n25, err := m.DownlinkSent.MarshalTo(dAtA[i:])
+		n27, err := m.DownlinkSent.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	return i, nil
 }
@@ -3241,11 +3299,11 @@ func (m *ApplicationUp_DownlinkFailed) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkFailed.Size()))
-		n26, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
+		n28, err := m.DownlinkFailed.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n28
 	}
 	return i, nil
 }
@@ -3255,11 +3313,11 @@ func (m *ApplicationUp_DownlinkQueued) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkQueued.Size()))
-		n27, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
+		n29, err := m.DownlinkQueued.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n29
 	}
 	return i, nil
 }
@@ -3269,11 +3327,11 @@ func (m *ApplicationUp_DownlinkQueueInvalidated) MarshalTo(dAtA []byte) (int, er
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.DownlinkQueueInvalidated.Size()))
-		n28, err := m.DownlinkQueueInvalidated.MarshalTo(dAtA[i:])
+		n30, err := m.DownlinkQueueInvalidated.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n30
 	}
 	return i, nil
 }
@@ -3283,11 +3341,11 @@ func (m *ApplicationUp_LocationSolved) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.LocationSolved.Size()))
-		n29, err := m.LocationSolved.MarshalTo(dAtA[i:])
+		n31, err := m.LocationSolved.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n31
 	}
 	return i, nil
 }
@@ -3349,11 +3407,11 @@ func (m *DownlinkQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintMessages(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
-	n30, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	n32, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n32
 	if len(m.Downlinks) > 0 {
 		for _, msg := range m.Downlinks {
 			dAtA[i] = 0x12
@@ -3933,6 +3991,14 @@ func (m *ApplicationDownlink) Size() (n int) {
 			n += 1 + l + sovMessages(uint64(l))
 		}
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.QueueAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.QueueAt)
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4312,6 +4378,8 @@ func (this *ApplicationDownlink) String() string {
 		`ClassBC:` + strings.Replace(fmt.Sprintf("%v", this.ClassBC), "ApplicationDownlink_ClassBC", "ApplicationDownlink_ClassBC", 1) + `,`,
 		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`QueueAt:` + strings.Replace(fmt.Sprintf("%v", this.QueueAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -6095,6 +6163,78 @@ func (m *ApplicationDownlink) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QueueAt == nil {
+				m.QueueAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.QueueAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -258,10 +258,12 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
 	"priority",
+	"queue_at",
 	"session_key_id",
 }
 
@@ -270,10 +272,12 @@ var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
 	"priority",
+	"queue_at",
 	"session_key_id",
 }
 var ApplicationDownlinksFieldPathsNested = []string{
@@ -291,10 +295,12 @@ var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink.confirmed",
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
+	"downlink.queue_at",
 	"downlink.session_key_id",
 	"error",
 	"error.attributes",
@@ -343,10 +349,12 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_ack.confirmed",
 	"up.downlink_ack.correlation_ids",
 	"up.downlink_ack.decoded_payload",
+	"up.downlink_ack.expires_at",
 	"up.downlink_ack.f_cnt",
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
 	"up.downlink_ack.priority",
+	"up.downlink_ack.queue_at",
 	"up.downlink_ack.session_key_id",
 	"up.downlink_failed",
 	"up.downlink_failed.downlink",
@@ -356,10 +364,12 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.confirmed",
 	"up.downlink_failed.downlink.correlation_ids",
 	"up.downlink_failed.downlink.decoded_payload",
+	"up.downlink_failed.downlink.expires_at",
 	"up.downlink_failed.downlink.f_cnt",
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
 	"up.downlink_failed.downlink.priority",
+	"up.downlink_failed.downlink.queue_at",
 	"up.downlink_failed.downlink.session_key_id",
 	"up.downlink_failed.error",
 	"up.downlink_failed.error.attributes",
@@ -382,10 +392,12 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.confirmed",
 	"up.downlink_nack.correlation_ids",
 	"up.downlink_nack.decoded_payload",
+	"up.downlink_nack.expires_at",
 	"up.downlink_nack.f_cnt",
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
 	"up.downlink_nack.priority",
+	"up.downlink_nack.queue_at",
 	"up.downlink_nack.session_key_id",
 	"up.downlink_queue_invalidated",
 	"up.downlink_queue_invalidated.downlinks",
@@ -397,10 +409,12 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.confirmed",
 	"up.downlink_queued.correlation_ids",
 	"up.downlink_queued.decoded_payload",
+	"up.downlink_queued.expires_at",
 	"up.downlink_queued.f_cnt",
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
 	"up.downlink_queued.priority",
+	"up.downlink_queued.queue_at",
 	"up.downlink_queued.session_key_id",
 	"up.downlink_sent",
 	"up.downlink_sent.class_b_c",
@@ -409,10 +423,12 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.confirmed",
 	"up.downlink_sent.correlation_ids",
 	"up.downlink_sent.decoded_payload",
+	"up.downlink_sent.expires_at",
 	"up.downlink_sent.f_cnt",
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
 	"up.downlink_sent.priority",
+	"up.downlink_sent.queue_at",
 	"up.downlink_sent.session_key_id",
 	"up.join_accept",
 	"up.join_accept.app_s_key",
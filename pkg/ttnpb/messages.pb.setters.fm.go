@@ -567,6 +567,24 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "queue_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queue_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueueAt = src.QueueAt
+			} else {
+				dst.QueueAt = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -12,6 +12,7 @@ var RxMetadataFieldPathsNested = []string{
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
 	"frequency_offset",
+	"frequency_plan_id",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -40,6 +41,7 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
 	"frequency_offset",
+	"frequency_plan_id",
 	"gateway_ids",
 	"location",
 	"rssi",
@@ -138,6 +138,15 @@ func (m *RxMetadata) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "frequency_plan_id":
+
+			if utf8.RuneCountInString(m.GetFrequencyPlanID()) > 64 {
+				return RxMetadataValidationError{
+					field:  "frequency_plan_id",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
 		case "advanced":
 
 			if v, ok := interface{}(m.GetAdvanced()).(interface{ ValidateFields(...string) error }); ok {
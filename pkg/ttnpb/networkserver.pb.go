@@ -10,9 +10,12 @@ import (
 	math "math"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
@@ -25,6 +28,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +36,170 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type QueueMACCommandsRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	MACCommands          []*MACCommand `protobuf:"bytes,2,rep,name=mac_commands,json=macCommands,proto3" json:"mac_commands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QueueMACCommandsRequest) Reset()      { *m = QueueMACCommandsRequest{} }
+func (*QueueMACCommandsRequest) ProtoMessage() {}
+func (*QueueMACCommandsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{0}
+}
+func (m *QueueMACCommandsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueueMACCommandsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueueMACCommandsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueueMACCommandsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueMACCommandsRequest.Merge(m, src)
+}
+func (m *QueueMACCommandsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueueMACCommandsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueMACCommandsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueMACCommandsRequest proto.InternalMessageInfo
+
+func (m *QueueMACCommandsRequest) GetMACCommands() []*MACCommand {
+	if m != nil {
+		return m.MACCommands
+	}
+	return nil
+}
+
+type EndDeviceAirtimeUsageSummary struct {
+	// Start of the window the usage is accounted in.
+	WindowStart time.Time `protobuf:"bytes,1,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	// Total airtime of uplink messages received in the window.
+	UplinkAirtime time.Duration `protobuf:"bytes,2,opt,name=uplink_airtime,json=uplinkAirtime,proto3,stdduration" json:"uplink_airtime"`
+	// Total airtime of downlink messages scheduled in the window.
+	DownlinkAirtime time.Duration `protobuf:"bytes,3,opt,name=downlink_airtime,json=downlinkAirtime,proto3,stdduration" json:"downlink_airtime"`
+	// Number of uplink messages received in the window.
+	Uplinks uint32 `protobuf:"varint,4,opt,name=uplinks,proto3" json:"uplinks,omitempty"`
+	// Number of downlink messages scheduled in the window.
+	Downlinks uint32 `protobuf:"varint,5,opt,name=downlinks,proto3" json:"downlinks,omitempty"`
+	// Maximum uplink airtime in the window. Zero means unlimited.
+	UplinkAirtimeLimit time.Duration `protobuf:"bytes,6,opt,name=uplink_airtime_limit,json=uplinkAirtimeLimit,proto3,stdduration" json:"uplink_airtime_limit"`
+	// Maximum number of downlink messages in the window. Zero means unlimited.
+	DownlinksLimit uint32 `protobuf:"varint,7,opt,name=downlinks_limit,json=downlinksLimit,proto3" json:"downlinks_limit,omitempty"`
+	// Whether the device exceeds the fair-use limits.
+	Exceeded bool `protobuf:"varint,8,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	// Time at which the end device last exceeded the fair-use limits.
+	ExceededAt           *time.Time `protobuf:"bytes,9,opt,name=exceeded_at,json=exceededAt,proto3,stdtime" json:"exceeded_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *EndDeviceAirtimeUsageSummary) Reset()      { *m = EndDeviceAirtimeUsageSummary{} }
+func (*EndDeviceAirtimeUsageSummary) ProtoMessage() {}
+func (*EndDeviceAirtimeUsageSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1}
+}
+func (m *EndDeviceAirtimeUsageSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceAirtimeUsageSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceAirtimeUsageSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EndDeviceAirtimeUsageSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceAirtimeUsageSummary.Merge(m, src)
+}
+func (m *EndDeviceAirtimeUsageSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceAirtimeUsageSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceAirtimeUsageSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EndDeviceAirtimeUsageSummary proto.InternalMessageInfo
+
+func (m *EndDeviceAirtimeUsageSummary) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetUplinkAirtime() time.Duration {
+	if m != nil {
+		return m.UplinkAirtime
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetDownlinkAirtime() time.Duration {
+	if m != nil {
+		return m.DownlinkAirtime
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetUplinks() uint32 {
+	if m != nil {
+		return m.Uplinks
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetDownlinks() uint32 {
+	if m != nil {
+		return m.Downlinks
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetUplinkAirtimeLimit() time.Duration {
+	if m != nil {
+		return m.UplinkAirtimeLimit
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetDownlinksLimit() uint32 {
+	if m != nil {
+		return m.DownlinksLimit
+	}
+	return 0
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetExceeded() bool {
+	if m != nil {
+		return m.Exceeded
+	}
+	return false
+}
+
+func (m *EndDeviceAirtimeUsageSummary) GetExceededAt() *time.Time {
+	if m != nil {
+		return m.ExceededAt
+	}
+	return nil
+}
+
 type GenerateDevAddrResponse struct {
 	DevAddr              *go_thethings_network_lorawan_stack_pkg_types.DevAddr `protobuf:"bytes,1,opt,name=dev_addr,json=devAddr,proto3,customtype=go.thethings.network/lorawan-stack/pkg/types.DevAddr" json:"dev_addr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                              `json:"-"`
@@ -41,7 +209,7 @@ type GenerateDevAddrResponse struct {
 func (m *GenerateDevAddrResponse) Reset()      { *m = GenerateDevAddrResponse{} }
 func (*GenerateDevAddrResponse) ProtoMessage() {}
 func (*GenerateDevAddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c77e7504ad1081b8, []int{0}
+	return fileDescriptor_c77e7504ad1081b8, []int{2}
 }
 func (m *GenerateDevAddrResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -71,6 +239,10 @@ func (m *GenerateDevAddrResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_GenerateDevAddrResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*QueueMACCommandsRequest)(nil), "ttn.lorawan.v3.QueueMACCommandsRequest")
+	golang_proto.RegisterType((*QueueMACCommandsRequest)(nil), "ttn.lorawan.v3.QueueMACCommandsRequest")
+	proto.RegisterType((*EndDeviceAirtimeUsageSummary)(nil), "ttn.lorawan.v3.EndDeviceAirtimeUsageSummary")
+	golang_proto.RegisterType((*EndDeviceAirtimeUsageSummary)(nil), "ttn.lorawan.v3.EndDeviceAirtimeUsageSummary")
 	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
 	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
 }
@@ -83,54 +255,164 @@ func init() {
 }
 
 var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x48, 0x1b, 0x4f,
-	0x18, 0xc6, 0x67, 0xa2, 0xf8, 0xff, 0x33, 0x88, 0xd2, 0xa9, 0xf4, 0x23, 0x6d, 0xa7, 0x12, 0x2d,
-	0x15, 0xa9, 0xb3, 0x25, 0xf6, 0x50, 0xbc, 0x29, 0x09, 0xb1, 0x60, 0xa4, 0x6a, 0xbd, 0x78, 0x09,
-	0x9b, 0xec, 0xeb, 0x66, 0x49, 0x9c, 0xdd, 0xee, 0x4c, 0x22, 0x52, 0x04, 0xe9, 0xa1, 0x78, 0x2c,
-	0x94, 0x42, 0x8f, 0xa5, 0x27, 0x8f, 0xd2, 0x4b, 0x3d, 0x15, 0x8f, 0x1e, 0x85, 0x5e, 0xa4, 0x07,
-	0x31, 0xbb, 0x3d, 0x08, 0xbd, 0x78, 0xf4, 0x58, 0xb2, 0x49, 0x8c, 0xc9, 0x1a, 0xb1, 0x1f, 0xb7,
-	0x99, 0x9d, 0xe7, 0x7d, 0xe6, 0x37, 0x0f, 0xef, 0xbe, 0xe4, 0x41, 0xd1, 0x76, 0xf5, 0x55, 0x5d,
-	0x8c, 0x49, 0xa5, 0xe7, 0x0a, 0x9a, 0xee, 0x58, 0x9a, 0x00, 0xb5, 0x6a, 0xbb, 0x05, 0x09, 0x6e,
-	0x19, 0x5c, 0xee, 0xb8, 0xb6, 0xb2, 0x69, 0x9f, 0x52, 0x82, 0xd7, 0xa5, 0xbc, 0x3c, 0x1e, 0x1d,
-	0x33, 0x2d, 0x95, 0x2f, 0x65, 0x79, 0xce, 0x5e, 0xd1, 0x4c, 0xdb, 0xb4, 0xb5, 0x40, 0x96, 0x2d,
-	0x2d, 0x07, 0xbb, 0x60, 0x13, 0xac, 0x6a, 0xe5, 0xd1, 0xbb, 0xa6, 0x6d, 0x9b, 0x45, 0x08, 0xec,
-	0x75, 0x21, 0x6c, 0xa5, 0x2b, 0xcb, 0x16, 0xb2, 0x7e, 0x7a, 0xa7, 0x7e, 0x7a, 0xe6, 0x01, 0x2b,
-	0x8e, 0x5a, 0xab, 0x1f, 0xc6, 0xc2, 0x80, 0x20, 0x8c, 0x8c, 0x01, 0x65, 0x2b, 0x07, 0x75, 0xcd,
-	0x50, 0x58, 0x63, 0x19, 0x20, 0x94, 0xb5, 0x6c, 0x81, 0xdb, 0xb8, 0x65, 0x30, 0x2c, 0x5a, 0x01,
-	0x29, 0x75, 0x13, 0xea, 0x8a, 0x98, 0x20, 0x37, 0x53, 0x20, 0xc0, 0xd5, 0x15, 0x24, 0xa0, 0x3c,
-	0x69, 0x18, 0xee, 0x3c, 0x48, 0xc7, 0x16, 0x12, 0xe8, 0x02, 0xf9, 0xdf, 0x80, 0x72, 0x46, 0x37,
-	0x0c, 0xf7, 0x16, 0x1e, 0xc4, 0x23, 0xbd, 0x53, 0x4f, 0xbf, 0x1f, 0xde, 0x7f, 0x62, 0xda, 0x5c,
-	0xe5, 0x41, 0xe5, 0x2d, 0x61, 0x4a, 0x5e, 0xcf, 0x4d, 0x6b, 0xbd, 0xc7, 0x29, 0x98, 0x9a, 0x5a,
-	0x73, 0x40, 0xf2, 0x86, 0xe7, 0x7f, 0x46, 0x6d, 0x11, 0x4f, 0x93, 0xee, 0x94, 0x9c, 0x95, 0x34,
-	0x49, 0x7a, 0xa7, 0x75, 0x61, 0x14, 0x61, 0xd1, 0x29, 0x5a, 0xa2, 0x40, 0xef, 0xf1, 0xd6, 0xb4,
-	0x79, 0xed, 0x7b, 0xba, 0x46, 0x1b, 0xbd, 0xc1, 0x6b, 0x79, 0xf1, 0x46, 0x5e, 0x3c, 0x59, 0xcd,
-	0x2b, 0x7e, 0x18, 0x21, 0xdd, 0x93, 0x55, 0xbf, 0x19, 0xd2, 0x3f, 0x63, 0x89, 0xc2, 0xa4, 0xe3,
-	0x14, 0xad, 0x5c, 0x90, 0x34, 0xed, 0x50, 0x13, 0x0d, 0x5d, 0x75, 0xae, 0x68, 0xd1, 0x19, 0xc1,
-	0x8f, 0x31, 0x7d, 0x41, 0x06, 0x12, 0xf6, 0xaa, 0xa8, 0x12, 0xcc, 0x95, 0xa0, 0x04, 0xf3, 0xe0,
-	0x14, 0xf5, 0x1c, 0xd0, 0xe1, 0xf6, 0xd2, 0x36, 0xd5, 0xcb, 0x12, 0x48, 0xd5, 0x09, 0x96, 0xce,
-	0x91, 0x6b, 0x2d, 0xfa, 0xe7, 0x25, 0x99, 0xff, 0x4b, 0xcb, 0x4c, 0x9b, 0xe5, 0x8c, 0x25, 0x55,
-	0xd8, 0x32, 0x29, 0x8c, 0x44, 0xd0, 0x3b, 0xcf, 0x9a, 0x1d, 0x12, 0x1d, 0xbe, 0x24, 0x86, 0x86,
-	0xa7, 0x8c, 0xff, 0xec, 0x26, 0xd7, 0x67, 0xe5, 0x99, 0xc1, 0x3c, 0x98, 0x96, 0x54, 0xee, 0x1a,
-	0xfd, 0x8c, 0x49, 0x57, 0x0a, 0x14, 0x1d, 0x6a, 0x77, 0x49, 0x81, 0x3a, 0xa7, 0xae, 0xd1, 0xdf,
-	0xee, 0x08, 0x14, 0x2b, 0xbc, 0xfe, 0xf6, 0xe3, 0x5d, 0x04, 0x68, 0x4e, 0x13, 0x52, 0xd3, 0x9b,
-	0x04, 0x52, 0x7b, 0xd5, 0x6c, 0xf9, 0x8c, 0x65, 0x48, 0x7e, 0xee, 0xf0, 0x82, 0xfd, 0xba, 0x56,
-	0x93, 0x86, 0xeb, 0xce, 0x96, 0xeb, 0xf4, 0x4d, 0x84, 0x74, 0x2d, 0x5c, 0x04, 0xbd, 0xf0, 0x7b,
-	0xd0, 0x5f, 0x71, 0x40, 0xfd, 0x05, 0x47, 0x2f, 0xc5, 0xe6, 0x7f, 0x88, 0xcd, 0x5b, 0xb1, 0x27,
-	0xf0, 0xe8, 0x52, 0x3a, 0x36, 0xfd, 0xaf, 0x6e, 0x9a, 0xc0, 0xa3, 0xf4, 0x3d, 0x26, 0x3d, 0x09,
-	0x28, 0x82, 0x82, 0x2b, 0x36, 0x4b, 0x87, 0xfe, 0x8b, 0xa5, 0x83, 0x20, 0x52, 0xa3, 0xc9, 0x30,
-	0xdd, 0x95, 0x1f, 0xde, 0x7c, 0x69, 0x5c, 0x90, 0xc8, 0xac, 0xa4, 0x79, 0xd2, 0xdf, 0x36, 0x93,
-	0x3a, 0xfe, 0xcb, 0x0f, 0xc3, 0xed, 0x77, 0xe1, 0x30, 0x8b, 0x0d, 0x04, 0xa0, 0x7d, 0xb4, 0xb7,
-	0x0a, 0xda, 0x18, 0x6b, 0x53, 0x9f, 0xf0, 0x5e, 0x85, 0xe1, 0xfd, 0x0a, 0xc3, 0x07, 0x15, 0x86,
-	0x8e, 0x2a, 0x0c, 0x1d, 0x57, 0x18, 0x3a, 0xa9, 0x30, 0x74, 0x5a, 0x61, 0x78, 0xc3, 0x63, 0x78,
-	0xd3, 0x63, 0x68, 0xcb, 0x63, 0x78, 0xdb, 0x63, 0x68, 0xc7, 0x63, 0x68, 0xd7, 0x63, 0x68, 0xcf,
-	0x63, 0x78, 0xdf, 0x63, 0xf8, 0xc0, 0x63, 0xe8, 0xc8, 0x63, 0xf8, 0xd8, 0x63, 0xe8, 0xc4, 0x63,
-	0xf8, 0xd4, 0x63, 0x68, 0xc3, 0x67, 0x68, 0xd3, 0x67, 0xf8, 0xad, 0xcf, 0xd0, 0x07, 0x9f, 0xe1,
-	0x8f, 0x3e, 0x43, 0x5b, 0x3e, 0x43, 0xdb, 0x3e, 0xc3, 0x3b, 0x3e, 0xc3, 0xbb, 0x3e, 0xc3, 0x4b,
-	0x8f, 0xae, 0x3a, 0x43, 0x95, 0x70, 0xb2, 0xd9, 0x9e, 0xe0, 0xcd, 0xe3, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x99, 0xbd, 0xad, 0xb8, 0xb7, 0x06, 0x00, 0x00,
+	// 1135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x68, 0x1b, 0xc7,
+	0x17, 0xde, 0x91, 0x15, 0x5b, 0x19, 0x29, 0xb6, 0x7e, 0xf3, 0x33, 0x8d, 0xac, 0xba, 0x23, 0xa3,
+	0xa4, 0xd8, 0x98, 0x78, 0xb7, 0x28, 0x3d, 0x94, 0xdc, 0xa4, 0xd8, 0x28, 0x2d, 0xb6, 0x69, 0xa4,
+	0x18, 0x4a, 0x5a, 0x10, 0x6b, 0xed, 0x44, 0x5e, 0xac, 0x9d, 0xdd, 0xee, 0xcc, 0xca, 0x11, 0x21,
+	0x10, 0x5a, 0x28, 0xa1, 0xa7, 0xd0, 0x52, 0x08, 0x3d, 0x95, 0x9e, 0x72, 0x0c, 0xbd, 0x34, 0xa7,
+	0x12, 0x7a, 0xf2, 0xd1, 0xa5, 0x97, 0xd0, 0x83, 0x1b, 0xad, 0x7a, 0xc8, 0x31, 0xc7, 0x90, 0x53,
+	0xd9, 0xd9, 0x5d, 0xfd, 0x5b, 0x2b, 0x55, 0xdb, 0xd0, 0xdb, 0xcc, 0xbe, 0xef, 0x7d, 0xef, 0x7b,
+	0xef, 0xcd, 0xbe, 0x07, 0xdf, 0x6e, 0x9a, 0xb6, 0x7a, 0xa0, 0xd2, 0x35, 0xc6, 0xd5, 0xfa, 0xbe,
+	0xa2, 0x5a, 0xba, 0x42, 0x09, 0x3f, 0x30, 0xed, 0x7d, 0x46, 0xec, 0x16, 0xb1, 0x65, 0xcb, 0x36,
+	0xb9, 0x89, 0x66, 0x39, 0xa7, 0x72, 0x00, 0x95, 0x5b, 0x17, 0xb3, 0xc5, 0x86, 0xce, 0xf7, 0x9c,
+	0x5d, 0xb9, 0x6e, 0x1a, 0x0a, 0xa1, 0x2d, 0xb3, 0x6d, 0xd9, 0xe6, 0xcd, 0xb6, 0x22, 0xc0, 0xf5,
+	0xb5, 0x06, 0xa1, 0x6b, 0x2d, 0xb5, 0xa9, 0x6b, 0x2a, 0x27, 0x4a, 0xe4, 0xe0, 0x53, 0x66, 0xd7,
+	0x06, 0x28, 0x1a, 0x66, 0xc3, 0xf4, 0x9d, 0x77, 0x9d, 0x1b, 0xe2, 0x26, 0x2e, 0xe2, 0x14, 0xc0,
+	0x17, 0x1b, 0xa6, 0xd9, 0x68, 0x12, 0xa1, 0x50, 0xa5, 0xd4, 0xe4, 0x2a, 0xd7, 0x4d, 0xca, 0x02,
+	0x2b, 0x0e, 0xac, 0x3d, 0x0e, 0xcd, 0xb1, 0x05, 0x20, 0xb0, 0xbf, 0x39, 0x6a, 0x27, 0x86, 0xc5,
+	0xdb, 0x81, 0x31, 0x37, 0x6a, 0xe4, 0xba, 0x41, 0x18, 0x57, 0x0d, 0x2b, 0x00, 0xe4, 0xa3, 0x45,
+	0x22, 0x54, 0xab, 0x69, 0xa4, 0xa5, 0xd7, 0xc3, 0x74, 0xce, 0x45, 0x31, 0xba, 0x46, 0x28, 0xd7,
+	0x6f, 0xe8, 0xc4, 0x0e, 0x65, 0xe6, 0xa2, 0xa0, 0xb0, 0xa8, 0x3e, 0x60, 0x29, 0x0a, 0x30, 0x08,
+	0x63, 0x6a, 0x83, 0x04, 0x14, 0xf9, 0x5f, 0x00, 0x3c, 0x7b, 0xd5, 0x21, 0x0e, 0xd9, 0x2a, 0x5e,
+	0xbe, 0x6c, 0x1a, 0x86, 0x4a, 0x35, 0x56, 0x21, 0x9f, 0x3a, 0x84, 0x71, 0xf4, 0x09, 0x9c, 0xed,
+	0xeb, 0xaa, 0xe9, 0x1a, 0xcb, 0x80, 0x25, 0xb0, 0x92, 0x2c, 0x9c, 0x97, 0x87, 0xdb, 0x27, 0x6f,
+	0x50, 0x6d, 0x5d, 0x80, 0xde, 0xef, 0x4b, 0x2c, 0xa5, 0x5f, 0x96, 0x4e, 0x7d, 0x09, 0x62, 0x69,
+	0x70, 0x78, 0x9c, 0x93, 0x8e, 0x8e, 0x73, 0xa0, 0x92, 0x22, 0x7d, 0x1c, 0x43, 0x1f, 0xc1, 0x94,
+	0xa1, 0xd6, 0x6b, 0xf5, 0x20, 0x68, 0x26, 0xb6, 0x34, 0xb5, 0x92, 0x2c, 0x64, 0x47, 0xb9, 0xfb,
+	0xba, 0x4a, 0x0b, 0x2f, 0x4b, 0x33, 0x5f, 0x81, 0x78, 0x02, 0xa4, 0xd3, 0xee, 0x71, 0x2e, 0x39,
+	0xa8, 0x38, 0x69, 0xa8, 0xf5, 0xf0, 0x92, 0xff, 0x36, 0x0e, 0x17, 0x7b, 0x92, 0x8a, 0xba, 0xed,
+	0xd5, 0x7f, 0xc7, 0x4b, 0xba, 0xea, 0x18, 0x86, 0x6a, 0xb7, 0x51, 0x19, 0xa6, 0x0e, 0x74, 0xaa,
+	0x99, 0x07, 0x35, 0xc6, 0x55, 0x9b, 0x07, 0x69, 0x65, 0x65, 0xbf, 0x71, 0x72, 0xd8, 0x38, 0xf9,
+	0x5a, 0xd8, 0xb8, 0x52, 0xc2, 0x4b, 0xe2, 0xde, 0xef, 0x39, 0x50, 0x49, 0xfa, 0x9e, 0x55, 0xcf,
+	0x11, 0x7d, 0x00, 0x67, 0x1d, 0xab, 0xa9, 0xd3, 0xfd, 0x9a, 0xea, 0x87, 0xc9, 0xc4, 0x04, 0xd5,
+	0x42, 0x84, 0x6a, 0x3d, 0x78, 0x40, 0x3e, 0xd3, 0x7d, 0x8f, 0xe9, 0x8c, 0xef, 0x1a, 0x08, 0x44,
+	0xdb, 0x30, 0xad, 0x99, 0x07, 0x74, 0x88, 0x6d, 0x6a, 0x72, 0xb6, 0xb9, 0xd0, 0x39, 0xe4, 0xcb,
+	0xc0, 0x19, 0x3f, 0x00, 0xcb, 0xc4, 0x97, 0xc0, 0xca, 0x99, 0x4a, 0x78, 0x45, 0x8b, 0xf0, 0x74,
+	0x08, 0x66, 0x99, 0x53, 0xc2, 0xd6, 0xff, 0x80, 0x76, 0xe0, 0xfc, 0x70, 0x4e, 0xb5, 0xa6, 0x6e,
+	0xe8, 0x3c, 0x33, 0x3d, 0xb9, 0x16, 0x34, 0x94, 0xd9, 0xa6, 0xe7, 0x8e, 0x96, 0x61, 0x4f, 0x21,
+	0x0b, 0x18, 0x67, 0x44, 0xe8, 0xd9, 0xde, 0x67, 0x1f, 0x98, 0x85, 0x09, 0x72, 0xb3, 0x4e, 0x88,
+	0x46, 0xb4, 0x4c, 0x62, 0x09, 0xac, 0x24, 0x2a, 0xbd, 0x3b, 0x2a, 0xc2, 0x64, 0x78, 0xae, 0xa9,
+	0x3c, 0x73, 0xfa, 0x2f, 0xfb, 0x16, 0x17, 0x3d, 0x83, 0xa1, 0x53, 0x91, 0xe7, 0x29, 0x3c, 0x5b,
+	0x26, 0x94, 0xd8, 0x2a, 0x27, 0xeb, 0xa4, 0x55, 0xd4, 0x34, 0xbb, 0x42, 0x98, 0x65, 0x52, 0x46,
+	0x50, 0x15, 0x26, 0x34, 0xd2, 0xaa, 0xa9, 0x9a, 0x66, 0x8b, 0x27, 0x91, 0x2a, 0xbd, 0xf7, 0xdb,
+	0x71, 0xee, 0xdd, 0x86, 0x29, 0xf3, 0x3d, 0xc2, 0xf7, 0x74, 0xda, 0x60, 0x72, 0x30, 0xcd, 0x94,
+	0xe1, 0x1f, 0xcb, 0xda, 0x6f, 0x28, 0xbc, 0x6d, 0x11, 0x26, 0x87, 0x9c, 0x33, 0x9a, 0x7f, 0x28,
+	0x6c, 0xc1, 0x78, 0x99, 0x6d, 0x33, 0xb4, 0x01, 0x53, 0x57, 0x54, 0xaa, 0x35, 0xc9, 0x8e, 0xa8,
+	0x0d, 0x7a, 0x6b, 0xf4, 0xa1, 0xfb, 0xdf, 0xb7, 0xfc, 0xdf, 0x33, 0xfb, 0x46, 0x24, 0xa9, 0x0d,
+	0x6f, 0xc4, 0x14, 0x8e, 0x63, 0x30, 0x5e, 0xf4, 0xf8, 0x36, 0xe1, 0xdc, 0xa6, 0x57, 0x63, 0xcb,
+	0x6a, 0xea, 0x75, 0xd1, 0x00, 0x34, 0xc6, 0x27, 0x1b, 0x09, 0x35, 0xe0, 0xb4, 0x63, 0xad, 0x80,
+	0x77, 0x00, 0xba, 0x06, 0xe7, 0xd7, 0x83, 0x36, 0x88, 0x69, 0x50, 0x21, 0x56, 0x53, 0xad, 0x13,
+	0x14, 0xf9, 0xd5, 0x47, 0x50, 0x62, 0x50, 0x8c, 0x13, 0x8b, 0xae, 0xc2, 0xff, 0x0d, 0xe1, 0x3f,
+	0x74, 0xd8, 0xde, 0xbf, 0xa4, 0xac, 0x8d, 0x50, 0x6e, 0xea, 0x8c, 0xa3, 0x89, 0x06, 0x52, 0xf6,
+	0xfc, 0x2b, 0xca, 0x10, 0x72, 0xb2, 0xc2, 0xe7, 0x09, 0xf8, 0xff, 0x6d, 0xd6, 0x23, 0xa8, 0x90,
+	0x86, 0xce, 0xb8, 0xdd, 0x46, 0x3f, 0x00, 0x38, 0x55, 0x26, 0x1c, 0x9d, 0x1b, 0x65, 0x29, 0x13,
+	0x3e, 0x80, 0xf6, 0xd5, 0x2f, 0x8c, 0x15, 0x94, 0xdf, 0xff, 0xec, 0xd7, 0x3f, 0xbe, 0x8e, 0x11,
+	0x54, 0x57, 0x28, 0x53, 0xd4, 0xbe, 0x02, 0xa6, 0xdc, 0x1a, 0x1e, 0xb6, 0xf2, 0x80, 0xf1, 0x84,
+	0xfb, 0x6d, 0xc5, 0x87, 0x46, 0xfd, 0x7a, 0xc7, 0xdb, 0xe8, 0x8b, 0x18, 0x9c, 0xaa, 0x9e, 0x24,
+	0xba, 0xfa, 0xf7, 0x44, 0xff, 0x04, 0x84, 0xea, 0x1f, 0x41, 0xf6, 0x95, 0xb2, 0xe5, 0x7f, 0x28,
+	0x5b, 0x1e, 0x96, 0x7d, 0x09, 0xac, 0x5e, 0xdf, 0xca, 0x5f, 0x79, 0x5d, 0x91, 0x2e, 0x81, 0x55,
+	0xf4, 0x0d, 0x80, 0xd3, 0xeb, 0xa4, 0x49, 0x38, 0x99, 0xf0, 0xb1, 0x8c, 0x79, 0x7f, 0xf9, 0x2d,
+	0x51, 0x88, 0xf2, 0xea, 0x46, 0x54, 0xdd, 0xc4, 0x89, 0x0f, 0x34, 0xe8, 0x08, 0xc0, 0xf4, 0xe8,
+	0xfa, 0x45, 0xcb, 0xa3, 0x0a, 0xc7, 0x2c, 0xe8, 0xb1, 0x22, 0x6f, 0x09, 0x91, 0x4e, 0xde, 0xfa,
+	0x0f, 0xde, 0x98, 0x32, 0xb8, 0xc4, 0xbd, 0x52, 0xff, 0x0c, 0xe0, 0x5c, 0x99, 0xf0, 0xc1, 0xbd,
+	0x3b, 0x61, 0xcd, 0x2f, 0x8c, 0x45, 0x9d, 0xb0, 0xc4, 0xf3, 0x1f, 0x8b, 0x24, 0x77, 0x50, 0xf5,
+	0xb5, 0x74, 0x42, 0x09, 0x97, 0x9d, 0xe3, 0xc5, 0x28, 0x50, 0x18, 0xdb, 0x66, 0x68, 0x0f, 0xce,
+	0x8d, 0xec, 0x8a, 0xb1, 0x33, 0x76, 0x39, 0x3a, 0x16, 0x4e, 0x5c, 0x32, 0xf9, 0x79, 0x21, 0x7b,
+	0x16, 0xa5, 0x3c, 0xd9, 0xe1, 0xba, 0x29, 0x7d, 0x0f, 0x0e, 0x3b, 0x18, 0x1c, 0x75, 0x30, 0x78,
+	0xd2, 0xc1, 0xd2, 0xd3, 0x0e, 0x96, 0x9e, 0x75, 0xb0, 0xf4, 0xbc, 0x83, 0xa5, 0x17, 0x1d, 0x0c,
+	0xee, 0xb8, 0x18, 0xdc, 0x75, 0xb1, 0xf4, 0xc0, 0xc5, 0xe0, 0xa1, 0x8b, 0xa5, 0x47, 0x2e, 0x96,
+	0x1e, 0xbb, 0x58, 0x3a, 0x74, 0x31, 0x38, 0x72, 0x31, 0x78, 0xe2, 0x62, 0xe9, 0xa9, 0x8b, 0xc1,
+	0x33, 0x17, 0x4b, 0xcf, 0x5d, 0x0c, 0x5e, 0xb8, 0x58, 0xba, 0xd3, 0xc5, 0xd2, 0xdd, 0x2e, 0x06,
+	0xf7, 0xba, 0x58, 0xba, 0xdf, 0xc5, 0xe0, 0xbb, 0x2e, 0x96, 0x1e, 0x74, 0xb1, 0xf4, 0xb0, 0x8b,
+	0xc1, 0xa3, 0x2e, 0x06, 0x8f, 0xbb, 0x18, 0x5c, 0xbf, 0x30, 0xe9, 0x6e, 0xe3, 0xd4, 0xda, 0xdd,
+	0x9d, 0x16, 0x39, 0x5f, 0xfc, 0x73, 0x00, 0xf8, 0xde, 0x07, 0xa5, 0xe5, 0x0b, 0x00, 0x00,
+}
+
+func (this *QueueMACCommandsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueueMACCommandsRequest)
+	if !ok {
+		that2, ok := that.(QueueMACCommandsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if len(this.MACCommands) != len(that1.MACCommands) {
+		return false
+	}
+	for i := range this.MACCommands {
+		if !this.MACCommands[i].Equal(that1.MACCommands[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *EndDeviceAirtimeUsageSummary) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*EndDeviceAirtimeUsageSummary)
+	if !ok {
+		that2, ok := that.(EndDeviceAirtimeUsageSummary)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WindowStart.Equal(that1.WindowStart) {
+		return false
+	}
+	if this.UplinkAirtime != that1.UplinkAirtime {
+		return false
+	}
+	if this.DownlinkAirtime != that1.DownlinkAirtime {
+		return false
+	}
+	if this.Uplinks != that1.Uplinks {
+		return false
+	}
+	if this.Downlinks != that1.Downlinks {
+		return false
+	}
+	if this.UplinkAirtimeLimit != that1.UplinkAirtimeLimit {
+		return false
+	}
+	if this.DownlinksLimit != that1.DownlinksLimit {
+		return false
+	}
+	if this.Exceeded != that1.Exceeded {
+		return false
+	}
+	if that1.ExceededAt == nil {
+		if this.ExceededAt != nil {
+			return false
+		}
+	} else if !this.ExceededAt.Equal(*that1.ExceededAt) {
+		return false
+	}
+	return true
+}
 func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -440,6 +722,11 @@ type NsEndDeviceRegistryClient interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// QueueMACCommands queues MAC commands to be sent to the device.
+	// Only DevStatusReq, LinkCheckAns, RxParamSetupReq, NewChannelReq, DeviceTimeAns and ForceRejoinReq may be queued.
+	QueueMACCommands(ctx context.Context, in *QueueMACCommandsRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetAirtimeUsage returns the airtime used by the device over the last 24 hours and the fair-use limits applied to it.
+	GetAirtimeUsage(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceAirtimeUsageSummary, error)
 }
 
 type nsEndDeviceRegistryClient struct {
@@ -477,6 +764,24 @@ func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIde
 	return out, nil
 }
 
+func (c *nsEndDeviceRegistryClient) QueueMACCommands(ctx context.Context, in *QueueMACCommandsRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/QueueMACCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetAirtimeUsage(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceAirtimeUsageSummary, error) {
+	out := new(EndDeviceAirtimeUsageSummary)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetAirtimeUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
 type NsEndDeviceRegistryServer interface {
 	// Get returns the device that matches the given identifiers.
@@ -487,6 +792,11 @@ type NsEndDeviceRegistryServer interface {
 	// Delete deletes the device that matches the given identifiers.
 	// If there are multiple matches, an error will be returned.
 	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// QueueMACCommands queues MAC commands to be sent to the device.
+	// Only DevStatusReq, LinkCheckAns, RxParamSetupReq, NewChannelReq, DeviceTimeAns and ForceRejoinReq may be queued.
+	QueueMACCommands(context.Context, *QueueMACCommandsRequest) (*types.Empty, error)
+	// GetAirtimeUsage returns the airtime used by the device over the last 24 hours and the fair-use limits applied to it.
+	GetAirtimeUsage(context.Context, *EndDeviceIdentifiers) (*EndDeviceAirtimeUsageSummary, error)
 }
 
 func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
@@ -547,6 +857,42 @@ func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceRegistry_QueueMACCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueMACCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).QueueMACCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/QueueMACCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).QueueMACCommands(ctx, req.(*QueueMACCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetAirtimeUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetAirtimeUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetAirtimeUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetAirtimeUsage(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
 	HandlerType: (*NsEndDeviceRegistryServer)(nil),
@@ -563,6 +909,14 @@ var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _NsEndDeviceRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "QueueMACCommands",
+			Handler:    _NsEndDeviceRegistry_QueueMACCommands_Handler,
+		},
+		{
+			MethodName: "GetAirtimeUsage",
+			Handler:    _NsEndDeviceRegistry_GetAirtimeUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -634,6 +988,129 @@ var _Ns_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/networkserver.proto",
 }
 
+func (m *QueueMACCommandsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueueMACCommandsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintNetworkserver(dAtA, i, uint64(m.EndDeviceIdentifiers.Size()))
+	n1, err := m.EndDeviceIdentifiers.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.MACCommands) > 0 {
+		for _, msg := range m.MACCommands {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintNetworkserver(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *EndDeviceAirtimeUsageSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceAirtimeUsageSummary) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintNetworkserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)))
+	n2, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintNetworkserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)))
+	n3, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtime, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintNetworkserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)))
+	n4, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DownlinkAirtime, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.Uplinks != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Uplinks))
+	}
+	if m.Downlinks != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.Downlinks))
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintNetworkserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtimeLimit)))
+	n5, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UplinkAirtimeLimit, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.DownlinksLimit != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.DownlinksLimit))
+	}
+	if m.Exceeded {
+		dAtA[i] = 0x40
+		i++
+		if m.Exceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExceededAt != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintNetworkserver(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExceededAt)))
+		n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExceededAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	return i, nil
+}
+
 func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -653,11 +1130,11 @@ func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintNetworkserver(dAtA, i, uint64(m.DevAddr.Size()))
-		n1, err := m.DevAddr.MarshalTo(dAtA[i:])
+		n7, err := m.DevAddr.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n7
 	}
 	return i, nil
 }
@@ -671,6 +1148,44 @@ func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
+func NewPopulatedQueueMACCommandsRequest(r randyNetworkserver, easy bool) *QueueMACCommandsRequest {
+	this := &QueueMACCommandsRequest{}
+	v1 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v1
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(5)
+		this.MACCommands = make([]*MACCommand, v2)
+		for i := 0; i < v2; i++ {
+			this.MACCommands[i] = NewPopulatedMACCommand(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceAirtimeUsageSummary(r randyNetworkserver, easy bool) *EndDeviceAirtimeUsageSummary {
+	this := &EndDeviceAirtimeUsageSummary{}
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.WindowStart = *v3
+	v4 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.UplinkAirtime = *v4
+	v5 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.DownlinkAirtime = *v5
+	this.Uplinks = r.Uint32()
+	this.Downlinks = r.Uint32()
+	v6 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.UplinkAirtimeLimit = *v6
+	this.DownlinksLimit = r.Uint32()
+	this.Exceeded = bool(r.Intn(2) == 0)
+	if r.Intn(10) != 0 {
+		this.ExceededAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *GenerateDevAddrResponse {
 	this := &GenerateDevAddrResponse{}
 	this.DevAddr = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddr(r)
@@ -698,9 +1213,9 @@ func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
 	return rune(ru + 61)
 }
 func randStringNetworkserver(r randyNetworkserver) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
 		tmps[i] = randUTF8RuneNetworkserver(r)
 	}
 	return string(tmps)
@@ -722,11 +1237,11 @@ func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int,
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		v2 := r.Int63()
+		v8 := r.Int63()
 		if r.Intn(2) == 0 {
-			v2 *= -1
+			v8 *= -1
 		}
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v2))
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v8))
 	case 1:
 		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -751,15 +1266,65 @@ func encodeVarintPopulateNetworkserver(dAtA []byte, v uint64) []byte {
 	dAtA = append(dAtA, uint8(v))
 	return dAtA
 }
-func (m *GenerateDevAddrResponse) Size() (n int) {
+func (m *QueueMACCommandsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DevAddr != nil {
-		l = m.DevAddr.Size()
-		n += 1 + l + sovNetworkserver(uint64(l))
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if len(m.MACCommands) > 0 {
+		for _, e := range m.MACCommands {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndDeviceAirtimeUsageSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DownlinkAirtime)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.Uplinks != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Uplinks))
+	}
+	if m.Downlinks != 0 {
+		n += 1 + sovNetworkserver(uint64(m.Downlinks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UplinkAirtimeLimit)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.DownlinksLimit != 0 {
+		n += 1 + sovNetworkserver(uint64(m.DownlinksLimit))
+	}
+	if m.Exceeded {
+		n += 2
+	}
+	if m.ExceededAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExceededAt)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *GenerateDevAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevAddr != nil {
+		l = m.DevAddr.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
 	}
 	return n
 }
@@ -777,6 +1342,35 @@ func sovNetworkserver(x uint64) (n int) {
 func sozNetworkserver(x uint64) (n int) {
 	return sovNetworkserver((x << 1) ^ uint64((int64(x) >> 63)))
 }
+func (this *QueueMACCommandsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QueueMACCommandsRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(this.EndDeviceIdentifiers.String(), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`MACCommands:` + strings.Replace(fmt.Sprintf("%v", this.MACCommands), "MACCommand", "MACCommand", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceAirtimeUsageSummary) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceAirtimeUsageSummary{`,
+		`WindowStart:` + strings.Replace(strings.Replace(this.WindowStart.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UplinkAirtime:` + strings.Replace(strings.Replace(this.UplinkAirtime.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`DownlinkAirtime:` + strings.Replace(strings.Replace(this.DownlinkAirtime.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Uplinks:` + fmt.Sprintf("%v", this.Uplinks) + `,`,
+		`Downlinks:` + fmt.Sprintf("%v", this.Downlinks) + `,`,
+		`UplinkAirtimeLimit:` + strings.Replace(strings.Replace(this.UplinkAirtimeLimit.String(), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`DownlinksLimit:` + fmt.Sprintf("%v", this.DownlinksLimit) + `,`,
+		`Exceeded:` + fmt.Sprintf("%v", this.Exceeded) + `,`,
+		`ExceededAt:` + strings.Replace(fmt.Sprintf("%v", this.ExceededAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GenerateDevAddrResponse) String() string {
 	if this == nil {
 		return "nil"
@@ -795,6 +1389,424 @@ func valueToStringNetworkserver(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *QueueMACCommandsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueueMACCommandsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueueMACCommandsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MACCommands", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MACCommands = append(m.MACCommands, &MACCommand{})
+			if err := m.MACCommands[len(m.MACCommands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceAirtimeUsageSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceAirtimeUsageSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceAirtimeUsageSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAirtime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DownlinkAirtime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplinks", wireType)
+			}
+			m.Uplinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uplinks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlinks", wireType)
+			}
+			m.Downlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Downlinks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkAirtimeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UplinkAirtimeLimit, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinksLimit", wireType)
+			}
+			m.DownlinksLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinksLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exceeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exceeded = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExceededAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExceededAt == nil {
+				m.ExceededAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExceededAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
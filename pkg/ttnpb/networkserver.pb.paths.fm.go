@@ -2,6 +2,44 @@
 
 package ttnpb
 
+var QueueMACCommandsRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"mac_commands",
+}
+
+var QueueMACCommandsRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"mac_commands",
+}
+var EndDeviceAirtimeUsageSummaryFieldPathsNested = []string{
+	"downlink_airtime",
+	"downlinks",
+	"downlinks_limit",
+	"exceeded",
+	"exceeded_at",
+	"uplink_airtime",
+	"uplink_airtime_limit",
+	"uplinks",
+	"window_start",
+}
+
+var EndDeviceAirtimeUsageSummaryFieldPathsTopLevel = []string{
+	"downlink_airtime",
+	"downlinks",
+	"downlinks_limit",
+	"exceeded",
+	"exceeded_at",
+	"uplink_airtime",
+	"uplink_airtime_limit",
+	"uplinks",
+	"window_start",
+}
 var GenerateDevAddrResponseFieldPathsNested = []string{
 	"dev_addr",
 }
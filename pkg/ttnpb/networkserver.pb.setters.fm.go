@@ -2,7 +2,148 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+)
+
+func (dst *QueueMACCommandsRequest) SetFields(src *QueueMACCommandsRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIdentifiers
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "mac_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mac_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MACCommands = src.MACCommands
+			} else {
+				dst.MACCommands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceAirtimeUsageSummary) SetFields(src *EndDeviceAirtimeUsageSummary, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "window_start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window_start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.WindowStart = src.WindowStart
+			} else {
+				var zero time.Time
+				dst.WindowStart = zero
+			}
+		case "uplink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtime = src.UplinkAirtime
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtime = zero
+			}
+		case "downlink_airtime":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_airtime' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkAirtime = src.DownlinkAirtime
+			} else {
+				var zero time.Duration
+				dst.DownlinkAirtime = zero
+			}
+		case "uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplinks = src.Uplinks
+			} else {
+				var zero uint32
+				dst.Uplinks = zero
+			}
+		case "downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Downlinks = src.Downlinks
+			} else {
+				var zero uint32
+				dst.Downlinks = zero
+			}
+		case "uplink_airtime_limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_airtime_limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkAirtimeLimit = src.UplinkAirtimeLimit
+			} else {
+				var zero time.Duration
+				dst.UplinkAirtimeLimit = zero
+			}
+		case "downlinks_limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlinks_limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinksLimit = src.DownlinksLimit
+			} else {
+				var zero uint32
+				dst.DownlinksLimit = zero
+			}
+		case "exceeded":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exceeded' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Exceeded = src.Exceeded
+			} else {
+				var zero bool
+				dst.Exceeded = zero
+			}
+		case "exceeded_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exceeded_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExceededAt = src.ExceededAt
+			} else {
+				dst.ExceededAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
 
 func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
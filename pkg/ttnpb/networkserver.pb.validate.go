@@ -35,6 +35,273 @@ var (
 // define the regex for a UUID once up-front
 var _networkserver_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
 
+// ValidateFields checks the field values on QueueMACCommandsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *QueueMACCommandsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = QueueMACCommandsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return QueueMACCommandsRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "mac_commands":
+
+			if l := len(m.GetMACCommands()); l < 1 || l > 16 {
+				return QueueMACCommandsRequestValidationError{
+					field:  "mac_commands",
+					reason: "value must contain between 1 and 16 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetMACCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return QueueMACCommandsRequestValidationError{
+							field:  fmt.Sprintf("mac_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return QueueMACCommandsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// QueueMACCommandsRequestValidationError is the validation error returned by
+// QueueMACCommandsRequest.ValidateFields if the designated constraints aren't met.
+type QueueMACCommandsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueueMACCommandsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueueMACCommandsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueueMACCommandsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueueMACCommandsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueueMACCommandsRequestValidationError) ErrorName() string {
+	return "QueueMACCommandsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueueMACCommandsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueueMACCommandsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueueMACCommandsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueueMACCommandsRequestValidationError{}
+
+// ValidateFields checks the field values on EndDeviceAirtimeUsageSummary with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *EndDeviceAirtimeUsageSummary) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceAirtimeUsageSummaryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "window_start":
+
+			if v, ok := interface{}(&m.WindowStart).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsageSummaryValidationError{
+						field:  "window_start",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_airtime":
+
+			if v, ok := interface{}(&m.UplinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsageSummaryValidationError{
+						field:  "uplink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink_airtime":
+
+			if v, ok := interface{}(&m.DownlinkAirtime).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsageSummaryValidationError{
+						field:  "downlink_airtime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplinks":
+			// no validation rules for Uplinks
+		case "downlinks":
+			// no validation rules for Downlinks
+		case "uplink_airtime_limit":
+
+			if v, ok := interface{}(&m.UplinkAirtimeLimit).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsageSummaryValidationError{
+						field:  "uplink_airtime_limit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlinks_limit":
+			// no validation rules for DownlinksLimit
+		case "exceeded":
+			// no validation rules for Exceeded
+		case "exceeded_at":
+
+			if v, ok := interface{}(m.GetExceededAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceAirtimeUsageSummaryValidationError{
+						field:  "exceeded_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceAirtimeUsageSummaryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceAirtimeUsageSummaryValidationError is the validation error returned
+// by EndDeviceAirtimeUsageSummary.ValidateFields if the designated
+// constraints aren't met.
+type EndDeviceAirtimeUsageSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceAirtimeUsageSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceAirtimeUsageSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceAirtimeUsageSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceAirtimeUsageSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceAirtimeUsageSummaryValidationError) ErrorName() string {
+	return "EndDeviceAirtimeUsageSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceAirtimeUsageSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceAirtimeUsageSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceAirtimeUsageSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceAirtimeUsageSummaryValidationError{}
+
 // ValidateFields checks the field values on GenerateDevAddrResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
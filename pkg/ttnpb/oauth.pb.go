@@ -274,16 +274,20 @@ func (m *ListOAuthClientAuthorizationsRequest) GetPage() uint32 {
 }
 
 type OAuthAuthorizationCode struct {
-	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
-	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
-	Rights               []Right           `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	Code                 string            `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	RedirectURI          string            `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
-	State                string            `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
-	CreatedAt            time.Time         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
-	ExpiresAt            time.Time         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	UserIDs     UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
+	ClientIDs   ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
+	Rights      []Right           `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	Code        string            `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	RedirectURI string            `protobuf:"bytes,5,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	State       string            `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	CreatedAt   time.Time         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt   time.Time         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// OpenID Connect scopes (openid, profile, email) requested by the client.
+	OpenIDScopes []string `protobuf:"bytes,9,rep,name=openid_scopes,json=openidScopes,proto3" json:"openid_scopes,omitempty"`
+	// OpenID Connect nonce requested by the client; included in the ID token.
+	Nonce                string   `protobuf:"bytes,10,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *OAuthAuthorizationCode) Reset()      { *m = OAuthAuthorizationCode{} }
@@ -374,6 +378,20 @@ func (m *OAuthAuthorizationCode) GetExpiresAt() time.Time {
 	return time.Time{}
 }
 
+func (m *OAuthAuthorizationCode) GetOpenIDScopes() []string {
+	if m != nil {
+		return m.OpenIDScopes
+	}
+	return nil
+}
+
+func (m *OAuthAuthorizationCode) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 type OAuthAccessTokenIdentifiers struct {
 	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
 	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
@@ -436,16 +454,18 @@ func (m *OAuthAccessTokenIdentifiers) GetID() string {
 }
 
 type OAuthAccessToken struct {
-	UserIDs              UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
-	ClientIDs            ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
-	ID                   string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	AccessToken          string            `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken         string            `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	Rights               []Right           `protobuf:"varint,6,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	CreatedAt            time.Time         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
-	ExpiresAt            time.Time         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	UserIDs      UserIdentifiers   `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3" json:"user_ids"`
+	ClientIDs    ClientIdentifiers `protobuf:"bytes,2,opt,name=client_ids,json=clientIds,proto3" json:"client_ids"`
+	ID           string            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	AccessToken  string            `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken string            `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Rights       []Right           `protobuf:"varint,6,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	CreatedAt    time.Time         `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	ExpiresAt    time.Time         `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// OpenID Connect scopes (openid, profile, email) granted to the client.
+	OpenIDScopes         []string `protobuf:"bytes,9,rep,name=openid_scopes,json=openidScopes,proto3" json:"openid_scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *OAuthAccessToken) Reset()      { *m = OAuthAccessToken{} }
@@ -536,6 +556,13 @@ func (m *OAuthAccessToken) GetExpiresAt() time.Time {
 	return time.Time{}
 }
 
+func (m *OAuthAccessToken) GetOpenIDScopes() []string {
+	if m != nil {
+		return m.OpenIDScopes
+	}
+	return nil
+}
+
 type OAuthAccessTokens struct {
 	Tokens               []*OAuthAccessToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -689,62 +716,66 @@ func init() {
 }
 
 var fileDescriptor_1454904971eaa7d7 = []byte{
-	// 875 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x31, 0x6c, 0x1b, 0x37,
-	0x14, 0x3d, 0xea, 0x2c, 0xd9, 0xa2, 0x6c, 0xc3, 0x3d, 0xb4, 0xa9, 0xe2, 0xb6, 0x94, 0x22, 0x77,
-	0x10, 0x8a, 0xea, 0x04, 0x38, 0x4b, 0xd1, 0x4d, 0xb2, 0x17, 0x03, 0x29, 0x5a, 0xb0, 0xf1, 0xd2,
-	0x0e, 0xc6, 0xf9, 0x8e, 0x3e, 0x11, 0x96, 0x8e, 0x57, 0x92, 0xe7, 0x24, 0x9d, 0xb2, 0x14, 0x08,
-	0x3a, 0x79, 0x2c, 0x3a, 0x15, 0x9d, 0x32, 0x66, 0xcc, 0x54, 0x64, 0xf4, 0xe8, 0xad, 0x59, 0xaa,
-	0x46, 0xbc, 0xa1, 0xee, 0x96, 0x31, 0xc8, 0x54, 0x88, 0x47, 0x45, 0xf2, 0x39, 0x2e, 0xa0, 0x2e,
-	0x85, 0xbd, 0x7d, 0x92, 0xef, 0x3f, 0xf2, 0xdd, 0x7f, 0xfc, 0x3c, 0xf8, 0x51, 0x9f, 0x71, 0xef,
-	0x9e, 0x17, 0xb5, 0x84, 0xf4, 0xfc, 0xc3, 0xb6, 0x17, 0xd3, 0x36, 0xf3, 0x12, 0xd9, 0x73, 0x63,
-	0xce, 0x24, 0x73, 0x56, 0xa5, 0x8c, 0x5c, 0x03, 0x71, 0x8f, 0x6e, 0xaf, 0x77, 0x42, 0x2a, 0x7b,
-	0xc9, 0xbe, 0xeb, 0xb3, 0x41, 0x9b, 0x44, 0x47, 0xec, 0x41, 0xcc, 0xd9, 0xfd, 0x07, 0x6d, 0x0d,
-	0xf6, 0x5b, 0x21, 0x89, 0x5a, 0x47, 0x5e, 0x9f, 0x06, 0x9e, 0x24, 0xed, 0x0b, 0x41, 0x46, 0xb9,
-	0xde, 0x9a, 0xa1, 0x08, 0x59, 0xc8, 0xb2, 0xe4, 0xfd, 0xe4, 0x40, 0x8f, 0xf4, 0x40, 0x47, 0x06,
-	0x5e, 0x0b, 0x19, 0x0b, 0xfb, 0x64, 0x8a, 0x92, 0x74, 0x40, 0x84, 0xf4, 0x06, 0xb1, 0x01, 0x6c,
-	0x5c, 0x54, 0x40, 0x03, 0x12, 0x49, 0x7a, 0x40, 0x09, 0x17, 0x06, 0x84, 0x2e, 0x82, 0x38, 0x0d,
-	0x7b, 0xd2, 0xac, 0x37, 0x7e, 0x07, 0x70, 0xe3, 0xcb, 0x4e, 0x22, 0x7b, 0x5b, 0x7d, 0x4a, 0x22,
-	0x39, 0x8e, 0x18, 0xa7, 0xdf, 0x7b, 0x92, 0xb2, 0x68, 0x67, 0xca, 0xe6, 0x7c, 0x0d, 0x97, 0x12,
-	0x41, 0xf8, 0x1e, 0x0d, 0x44, 0x15, 0xd4, 0x41, 0xb3, 0xb2, 0x59, 0x73, 0xcf, 0x7f, 0x22, 0x77,
-	0x57, 0x10, 0x3e, 0x93, 0xd2, 0x7d, 0xff, 0x75, 0xb7, 0xf8, 0x23, 0x28, 0xac, 0x81, 0x93, 0x61,
-	0xcd, 0x52, 0xc3, 0xda, 0xa2, 0x06, 0x6c, 0x0b, 0xbc, 0x98, 0x68, 0xa4, 0x70, 0xbe, 0x85, 0xd0,
-	0xd7, 0xdb, 0x6a, 0xda, 0x82, 0xa6, 0xbd, 0x95, 0xa7, 0xcd, 0x0e, 0x36, 0x4b, 0x7c, 0x33, 0x47,
-	0x5c, 0x36, 0x90, 0x6d, 0x81, 0xcb, 0xbe, 0x41, 0x8b, 0xc6, 0x0f, 0x36, 0xac, 0x5e, 0xa6, 0xec,
-	0xea, 0xc9, 0x71, 0x5a, 0xb0, 0x94, 0x15, 0xae, 0x6a, 0xd7, 0xed, 0xe6, 0xea, 0xe6, 0x7b, 0x79,
-	0x62, 0x3c, 0x5e, 0xc5, 0x06, 0xe4, 0x6c, 0x41, 0xe8, 0x73, 0xe2, 0x49, 0x12, 0xec, 0x79, 0xb2,
-	0xba, 0xa0, 0xcf, 0xb2, 0xee, 0x66, 0x96, 0x72, 0x27, 0x96, 0x72, 0xef, 0x4e, 0x2c, 0xd5, 0x5d,
-	0x1a, 0x6f, 0x7e, 0xfc, 0x67, 0x0d, 0xe0, 0xb2, 0xc9, 0xeb, 0xc8, 0x31, 0x49, 0x12, 0x07, 0x13,
-	0x92, 0xe2, 0x3c, 0x24, 0x26, 0xaf, 0x23, 0x1b, 0x03, 0x78, 0xf3, 0xb2, 0x32, 0x08, 0xe7, 0x2b,
-	0xb8, 0xea, 0x9d, 0x9b, 0xa9, 0x82, 0xba, 0xdd, 0xac, 0x6c, 0x36, 0xf3, 0xea, 0x2e, 0xa3, 0xc0,
-	0xb9, 0xfc, 0xc6, 0x6f, 0x00, 0x7e, 0x7c, 0x87, 0x0a, 0x79, 0xe9, 0x9e, 0x98, 0x7c, 0x97, 0x10,
-	0x21, 0x9d, 0x3b, 0xf3, 0x5b, 0x60, 0x6d, 0xb6, 0x52, 0xa7, 0xc3, 0x1a, 0x98, 0xd6, 0xfe, 0x5d,
-	0x58, 0x64, 0x3c, 0x20, 0x5c, 0x97, 0xbd, 0x8c, 0xb3, 0x81, 0x83, 0x60, 0xb1, 0x4f, 0x07, 0x54,
-	0x56, 0xed, 0x3a, 0x68, 0xae, 0x74, 0x97, 0x5e, 0x77, 0x8b, 0x9f, 0xd8, 0xd5, 0xb3, 0x45, 0x9c,
-	0x4d, 0x3b, 0x0e, 0x5c, 0x88, 0xbd, 0x90, 0xe8, 0xfa, 0xac, 0x60, 0x1d, 0x37, 0xfe, 0xb6, 0xe1,
-	0x0d, 0x7d, 0xf8, 0x73, 0xc7, 0xde, 0x62, 0x01, 0xb9, 0xfe, 0xae, 0x75, 0xe0, 0x82, 0xcf, 0x82,
-	0xec, 0x7b, 0x94, 0xb1, 0x8e, 0x9d, 0xcf, 0xe1, 0x32, 0x27, 0x01, 0xe5, 0xc4, 0x97, 0x7b, 0x09,
-	0xa7, 0xda, 0x86, 0x65, 0xad, 0x8b, 0xdb, 0xc7, 0x00, 0xa8, 0x61, 0xad, 0x82, 0xcd, 0xfa, 0x2e,
-	0xde, 0xc1, 0x95, 0x09, 0x78, 0x97, 0xd3, 0x71, 0x55, 0x84, 0xf4, 0x24, 0xa9, 0x96, 0xb2, 0xaa,
-	0xe8, 0x41, 0xee, 0x6e, 0x2c, 0xfe, 0xe7, 0xbb, 0x41, 0xee, 0xc7, 0x94, 0x13, 0x31, 0x26, 0x59,
-	0x9a, 0x87, 0xc4, 0xe4, 0x75, 0x64, 0xe3, 0x2f, 0x00, 0x3f, 0xc8, 0x6a, 0xed, 0xfb, 0x44, 0x88,
-	0xbb, 0xec, 0x90, 0x5c, 0xed, 0xae, 0xeb, 0xdc, 0x80, 0x05, 0x1a, 0x68, 0xbb, 0x97, 0xbb, 0x25,
-	0x35, 0xac, 0x15, 0x76, 0xb6, 0x71, 0x81, 0x06, 0x8d, 0x3f, 0x6c, 0xb8, 0x96, 0x57, 0x7a, 0x7d,
-	0xe4, 0x39, 0xb7, 0xe0, 0xb2, 0xa7, 0x85, 0xed, 0xc9, 0xb1, 0x32, 0x63, 0xe0, 0x8a, 0x37, 0x23,
-	0x76, 0x03, 0xae, 0x70, 0x72, 0xc0, 0x89, 0xe8, 0x19, 0x8c, 0x36, 0x32, 0x5e, 0x36, 0x93, 0x19,
-	0x68, 0x7a, 0x5f, 0x4a, 0xf3, 0x77, 0xf9, 0xff, 0xd3, 0xc9, 0x5f, 0xc0, 0x77, 0xf2, 0xe5, 0x15,
-	0xce, 0x67, 0xb0, 0xa4, 0xa5, 0x4e, 0xba, 0x7a, 0xfd, 0xad, 0x5d, 0x7d, 0x26, 0x05, 0x1b, 0x7c,
-	0xe3, 0xe7, 0x02, 0xfc, 0xf0, 0x4d, 0x17, 0x9f, 0xe5, 0x9c, 0x74, 0xef, 0xab, 0x67, 0x9d, 0x37,
-	0x2f, 0x84, 0xfd, 0xd6, 0x17, 0x62, 0xe1, 0xdf, 0x5f, 0x88, 0xe2, 0xf4, 0x85, 0xe8, 0xfe, 0x0a,
-	0x4e, 0x46, 0x08, 0x9c, 0x8e, 0x10, 0x78, 0x3e, 0x42, 0xd6, 0x8b, 0x11, 0xb2, 0xce, 0x46, 0xc8,
-	0x7a, 0x39, 0x42, 0xd6, 0xab, 0x11, 0x02, 0x0f, 0x15, 0x02, 0x8f, 0x14, 0xb2, 0x1e, 0x2b, 0x04,
-	0x9e, 0x28, 0x64, 0x3d, 0x55, 0xc8, 0x7a, 0xa6, 0x90, 0x75, 0xa2, 0x10, 0x38, 0x55, 0x08, 0x3c,
-	0x57, 0xc8, 0x7a, 0xa1, 0x10, 0x38, 0x53, 0xc8, 0x7a, 0xa9, 0x10, 0x78, 0xa5, 0x90, 0xf5, 0x30,
-	0x45, 0xd6, 0xa3, 0x14, 0x81, 0xe3, 0x14, 0x59, 0x3f, 0xa5, 0x08, 0xfc, 0x92, 0x22, 0xeb, 0x71,
-	0x8a, 0xac, 0x27, 0x29, 0x02, 0x4f, 0x53, 0x04, 0x9e, 0xa5, 0x08, 0x7c, 0xf3, 0x69, 0xc8, 0x5c,
-	0xd9, 0x23, 0xb2, 0x47, 0xa3, 0x50, 0xb8, 0x11, 0x91, 0xf7, 0x18, 0x3f, 0x6c, 0x9f, 0xff, 0xbf,
-	0x8c, 0x0f, 0xc3, 0xb6, 0x94, 0x51, 0xbc, 0xbf, 0x5f, 0xd2, 0xce, 0xb9, 0xfd, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x77, 0x68, 0xc9, 0x68, 0x0b, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x3d, 0x6c, 0xdb, 0x46,
+	0x14, 0xe6, 0xe9, 0xcf, 0xe2, 0xc9, 0x36, 0x5c, 0xa2, 0x4d, 0x19, 0xb7, 0x3d, 0x2a, 0x74, 0x07,
+	0xa1, 0xa8, 0x28, 0xc0, 0x59, 0x8a, 0x6e, 0xa6, 0xbd, 0x18, 0x48, 0x91, 0xe2, 0x12, 0x2f, 0xed,
+	0x20, 0xd0, 0xe4, 0x99, 0x3a, 0x58, 0xe2, 0xb1, 0x77, 0x47, 0x27, 0xe9, 0xe4, 0xa5, 0x40, 0xd0,
+	0xc9, 0xe8, 0x54, 0x74, 0x2a, 0x32, 0x65, 0xcc, 0x98, 0xa9, 0xc8, 0xe8, 0xa9, 0xf0, 0xd6, 0x4c,
+	0x6a, 0x44, 0x0e, 0xf5, 0x98, 0x31, 0xf0, 0x54, 0xe8, 0x48, 0x45, 0xb2, 0x1c, 0x17, 0x70, 0x37,
+	0x67, 0xbb, 0x3b, 0x7e, 0xef, 0xbb, 0xfb, 0xde, 0xfb, 0x9e, 0x9e, 0xe0, 0x67, 0x7d, 0xc6, 0xbd,
+	0x07, 0x5e, 0xd4, 0x16, 0xd2, 0xf3, 0xf7, 0x3b, 0x5e, 0x4c, 0x3b, 0xcc, 0x4b, 0x64, 0xcf, 0x89,
+	0x39, 0x93, 0xcc, 0x58, 0x96, 0x32, 0x72, 0x0a, 0x88, 0x73, 0x70, 0x7b, 0x75, 0x23, 0xa4, 0xb2,
+	0x97, 0xec, 0x3a, 0x3e, 0x1b, 0x74, 0x48, 0x74, 0xc0, 0x1e, 0xc5, 0x9c, 0x3d, 0x7c, 0xd4, 0x51,
+	0x60, 0xbf, 0x1d, 0x92, 0xa8, 0x7d, 0xe0, 0xf5, 0x69, 0xe0, 0x49, 0xd2, 0xb9, 0xb0, 0xc8, 0x29,
+	0x57, 0xdb, 0x33, 0x14, 0x21, 0x0b, 0x59, 0x1e, 0xbc, 0x9b, 0xec, 0xa9, 0x9d, 0xda, 0xa8, 0x55,
+	0x01, 0xb7, 0x42, 0xc6, 0xc2, 0x3e, 0x99, 0xa2, 0x24, 0x1d, 0x10, 0x21, 0xbd, 0x41, 0x5c, 0x00,
+	0xd6, 0x2e, 0x2a, 0xa0, 0x01, 0x89, 0x24, 0xdd, 0xa3, 0x84, 0x8b, 0x02, 0x84, 0x2e, 0x82, 0x38,
+	0x0d, 0x7b, 0xb2, 0xf8, 0x6e, 0xff, 0x05, 0xe0, 0xda, 0xdd, 0x8d, 0x44, 0xf6, 0x36, 0xfb, 0x94,
+	0x44, 0x72, 0xbc, 0x62, 0x9c, 0xfe, 0xe8, 0x49, 0xca, 0xa2, 0xed, 0x29, 0x9b, 0x71, 0x0f, 0xd6,
+	0x13, 0x41, 0x78, 0x97, 0x06, 0xc2, 0x04, 0x4d, 0xd0, 0x6a, 0xac, 0x5b, 0xce, 0xf9, 0x14, 0x39,
+	0x3b, 0x82, 0xf0, 0x99, 0x10, 0xf7, 0xe3, 0x33, 0xb7, 0xfa, 0x33, 0x28, 0xad, 0x80, 0xe3, 0xa1,
+	0xa5, 0xa5, 0x43, 0x6b, 0x41, 0x01, 0xb6, 0x04, 0x5e, 0x48, 0x14, 0x52, 0x18, 0xdf, 0x43, 0xe8,
+	0xab, 0x6b, 0x15, 0x6d, 0x49, 0xd1, 0xde, 0x9a, 0xa7, 0xcd, 0x1f, 0x36, 0x4b, 0x7c, 0x73, 0x8e,
+	0x58, 0x2f, 0x20, 0x5b, 0x02, 0xeb, 0x7e, 0x81, 0x16, 0xf6, 0x4f, 0x65, 0x68, 0x5e, 0xa6, 0xec,
+	0xfa, 0xc9, 0x31, 0xda, 0xb0, 0x96, 0x17, 0xce, 0x2c, 0x37, 0xcb, 0xad, 0xe5, 0xf5, 0x8f, 0xe6,
+	0x89, 0xf1, 0xf8, 0x2b, 0x2e, 0x40, 0xc6, 0x26, 0x84, 0x3e, 0x27, 0x9e, 0x24, 0x41, 0xd7, 0x93,
+	0x66, 0x45, 0xbd, 0x65, 0xd5, 0xc9, 0x2d, 0xe5, 0x4c, 0x2c, 0xe5, 0xdc, 0x9f, 0x58, 0xca, 0xad,
+	0x8f, 0x2f, 0x3f, 0xfa, 0xdb, 0x02, 0x58, 0x2f, 0xe2, 0x36, 0xe4, 0x98, 0x24, 0x89, 0x83, 0x09,
+	0x49, 0xf5, 0x2a, 0x24, 0x45, 0xdc, 0x86, 0xb4, 0x07, 0xf0, 0xe6, 0x65, 0x65, 0x10, 0xc6, 0xb7,
+	0x70, 0xd9, 0x3b, 0x77, 0x62, 0x82, 0x66, 0xb9, 0xd5, 0x58, 0x6f, 0xcd, 0xab, 0xbb, 0x8c, 0x02,
+	0xcf, 0xc5, 0xdb, 0x7f, 0x00, 0xf8, 0xf9, 0x1d, 0x2a, 0xe4, 0xa5, 0x77, 0x62, 0xf2, 0x43, 0x42,
+	0x84, 0x34, 0xee, 0x5c, 0xdd, 0x02, 0x2b, 0xb3, 0x95, 0x3a, 0x19, 0x5a, 0x60, 0x5a, 0xfb, 0x0f,
+	0x61, 0x95, 0xf1, 0x80, 0x70, 0x55, 0x76, 0x1d, 0xe7, 0x1b, 0x03, 0xc1, 0x6a, 0x9f, 0x0e, 0xa8,
+	0x34, 0xcb, 0x4d, 0xd0, 0x5a, 0x72, 0xeb, 0x67, 0x6e, 0xf5, 0x8b, 0xb2, 0x79, 0xba, 0x80, 0xf3,
+	0x63, 0xc3, 0x80, 0x95, 0xd8, 0x0b, 0x89, 0xaa, 0xcf, 0x12, 0x56, 0x6b, 0xfb, 0xcf, 0x0a, 0xbc,
+	0xa1, 0x1e, 0x7f, 0xee, 0xd9, 0x9b, 0x2c, 0x20, 0xef, 0xbf, 0x6b, 0x0d, 0x58, 0xf1, 0x59, 0x90,
+	0xe7, 0x43, 0xc7, 0x6a, 0x6d, 0x7c, 0x0d, 0x17, 0x39, 0x09, 0x28, 0x27, 0xbe, 0xec, 0x26, 0x9c,
+	0x2a, 0x1b, 0xea, 0x4a, 0x17, 0x2f, 0x1f, 0x01, 0x90, 0x0e, 0xad, 0x06, 0x2e, 0xbe, 0xef, 0xe0,
+	0x6d, 0xdc, 0x98, 0x80, 0x77, 0x38, 0x1d, 0x57, 0x45, 0x48, 0x4f, 0x12, 0xb3, 0x96, 0x57, 0x45,
+	0x6d, 0xe6, 0x7a, 0x63, 0xe1, 0x7f, 0xf7, 0x06, 0x79, 0x18, 0x53, 0x4e, 0xc4, 0x98, 0xa4, 0x7e,
+	0x15, 0x92, 0x22, 0x4e, 0x91, 0x2c, 0xb1, 0x98, 0x44, 0x34, 0xe8, 0x0a, 0x9f, 0xc5, 0x44, 0x98,
+	0x7a, 0xb3, 0xdc, 0xd2, 0x5d, 0x74, 0xe6, 0x36, 0x7e, 0x01, 0xf5, 0x95, 0xba, 0x5d, 0xe1, 0x25,
+	0xb3, 0x99, 0x0e, 0xad, 0xc5, 0xbb, 0x31, 0x89, 0xb6, 0xb7, 0xee, 0x29, 0x14, 0x5e, 0xcc, 0x83,
+	0xf2, 0xdd, 0xd8, 0x64, 0x11, 0x8b, 0x7c, 0x62, 0x42, 0x95, 0x99, 0xba, 0xca, 0x8c, 0x79, 0x58,
+	0xc2, 0xf9, 0xb1, 0xfd, 0x0f, 0x80, 0x9f, 0xe4, 0x86, 0xf2, 0x7d, 0x22, 0xc4, 0x7d, 0xb6, 0x4f,
+	0xae, 0xf7, 0x4f, 0xbb, 0x71, 0x03, 0x96, 0x68, 0xa0, 0x7a, 0x4a, 0x77, 0x6b, 0xe9, 0xd0, 0x2a,
+	0x6d, 0x6f, 0xe1, 0x12, 0x0d, 0xec, 0x27, 0x15, 0xb8, 0x32, 0xaf, 0xf4, 0xfd, 0x91, 0x67, 0xdc,
+	0x82, 0x8b, 0x9e, 0x12, 0xd6, 0x95, 0x63, 0x65, 0x45, 0x97, 0x34, 0xbc, 0x19, 0xb1, 0x6b, 0x70,
+	0x89, 0x93, 0x3d, 0x4e, 0x44, 0xaf, 0xc0, 0xa8, 0x6e, 0xc1, 0x8b, 0xc5, 0x61, 0x0e, 0x9a, 0x36,
+	0x65, 0xed, 0xea, 0xa3, 0xe4, 0xba, 0xb7, 0x8b, 0xfd, 0x0d, 0xfc, 0x60, 0xde, 0x23, 0xc2, 0xf8,
+	0x0a, 0xd6, 0x54, 0xbe, 0x26, 0xf3, 0xa7, 0xf9, 0xce, 0xf9, 0x33, 0x13, 0x82, 0x0b, 0xbc, 0xfd,
+	0x5b, 0x09, 0x7e, 0xfa, 0x76, 0xde, 0xcc, 0x72, 0x4e, 0xe6, 0xcc, 0xf5, 0xf3, 0xdf, 0xdb, 0x59,
+	0x56, 0x7e, 0xe7, 0x2c, 0xab, 0xfc, 0xf7, 0x2c, 0xab, 0x4e, 0x67, 0x99, 0xfb, 0x04, 0x1c, 0x8f,
+	0x10, 0x38, 0x19, 0x21, 0xf0, 0x72, 0x84, 0xb4, 0x57, 0x23, 0xa4, 0x9d, 0x8e, 0x90, 0xf6, 0x7a,
+	0x84, 0xb4, 0x37, 0x23, 0x04, 0x0e, 0x53, 0x04, 0x1e, 0xa7, 0x48, 0x7b, 0x9a, 0x22, 0xf0, 0x2c,
+	0x45, 0xda, 0xf3, 0x14, 0x69, 0x2f, 0x52, 0xa4, 0x1d, 0xa7, 0x08, 0x9c, 0xa4, 0x08, 0xbc, 0x4c,
+	0x91, 0xf6, 0x2a, 0x45, 0xe0, 0x34, 0x45, 0xda, 0xeb, 0x14, 0x81, 0x37, 0x29, 0xd2, 0x0e, 0x33,
+	0xa4, 0x3d, 0xce, 0x10, 0x38, 0xca, 0x90, 0xf6, 0x6b, 0x86, 0xc0, 0xef, 0x19, 0xd2, 0x9e, 0x66,
+	0x48, 0x7b, 0x96, 0x21, 0xf0, 0x3c, 0x43, 0xe0, 0x45, 0x86, 0xc0, 0x77, 0x5f, 0x86, 0xcc, 0x91,
+	0x3d, 0x22, 0x7b, 0x34, 0x0a, 0x85, 0x13, 0x11, 0xf9, 0x80, 0xf1, 0xfd, 0xce, 0xf9, 0x7f, 0xc2,
+	0xf1, 0x7e, 0xd8, 0x91, 0x32, 0x8a, 0x77, 0x77, 0x6b, 0xca, 0x7e, 0xb7, 0xff, 0x1d, 0x00, 0xb0,
+	0x20, 0x15, 0xf3, 0x12, 0x0c, 0x00, 0x00,
 }
 
 func (this *OAuthClientAuthorizationIdentifiers) Equal(that interface{}) bool {
@@ -925,6 +956,17 @@ func (this *OAuthAuthorizationCode) Equal(that interface{}) bool {
 	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
 		return false
 	}
+	if len(this.OpenIDScopes) != len(that1.OpenIDScopes) {
+		return false
+	}
+	for i := range this.OpenIDScopes {
+		if this.OpenIDScopes[i] != that1.OpenIDScopes[i] {
+			return false
+		}
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
 	return true
 }
 func (this *OAuthAccessTokenIdentifiers) Equal(that interface{}) bool {
@@ -1005,6 +1047,14 @@ func (this *OAuthAccessToken) Equal(that interface{}) bool {
 	if !this.ExpiresAt.Equal(that1.ExpiresAt) {
 		return false
 	}
+	if len(this.OpenIDScopes) != len(that1.OpenIDScopes) {
+		return false
+	}
+	for i := range this.OpenIDScopes {
+		if this.OpenIDScopes[i] != that1.OpenIDScopes[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *OAuthAccessTokens) Equal(that interface{}) bool {
@@ -1327,6 +1377,27 @@ func (m *OAuthAuthorizationCode) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n15
+	if len(m.OpenIDScopes) > 0 {
+		for _, s := range m.OpenIDScopes {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Nonce) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintOauth(dAtA, i, uint64(len(m.Nonce)))
+		i += copy(dAtA[i:], m.Nonce)
+	}
 	return i, nil
 }
 
@@ -1452,6 +1523,21 @@ func (m *OAuthAccessToken) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n23
+	if len(m.OpenIDScopes) > 0 {
+		for _, s := range m.OpenIDScopes {
+			dAtA[i] = 0x4a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1619,6 +1705,12 @@ func NewPopulatedOAuthAuthorizationCode(r randyOauth, easy bool) *OAuthAuthoriza
 	this.CreatedAt = *v13
 	v14 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	this.ExpiresAt = *v14
+	v15 := r.Intn(10)
+	this.OpenIDScopes = make([]string, v15)
+	for i := 0; i < v15; i++ {
+		this.OpenIDScopes[i] = randStringOauth(r)
+	}
+	this.Nonce = randStringOauth(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1626,10 +1718,10 @@ func NewPopulatedOAuthAuthorizationCode(r randyOauth, easy bool) *OAuthAuthoriza
 
 func NewPopulatedOAuthAccessTokenIdentifiers(r randyOauth, easy bool) *OAuthAccessTokenIdentifiers {
 	this := &OAuthAccessTokenIdentifiers{}
-	v15 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v15
-	v16 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v16
+	v16 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v16
+	v17 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v17
 	this.ID = randStringOauth(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1638,22 +1730,27 @@ func NewPopulatedOAuthAccessTokenIdentifiers(r randyOauth, easy bool) *OAuthAcce
 
 func NewPopulatedOAuthAccessToken(r randyOauth, easy bool) *OAuthAccessToken {
 	this := &OAuthAccessToken{}
-	v17 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v17
-	v18 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v18
+	v18 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v18
+	v19 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v19
 	this.ID = randStringOauth(r)
 	this.AccessToken = randStringOauth(r)
 	this.RefreshToken = randStringOauth(r)
-	v19 := r.Intn(10)
-	this.Rights = make([]Right, v19)
-	for i := 0; i < v19; i++ {
+	v20 := r.Intn(10)
+	this.Rights = make([]Right, v20)
+	for i := 0; i < v20; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
-	v20 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v20
 	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v21
+	this.CreatedAt = *v21
+	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ExpiresAt = *v22
+	v23 := r.Intn(10)
+	this.OpenIDScopes = make([]string, v23)
+	for i := 0; i < v23; i++ {
+		this.OpenIDScopes[i] = randStringOauth(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1662,9 +1759,9 @@ func NewPopulatedOAuthAccessToken(r randyOauth, easy bool) *OAuthAccessToken {
 func NewPopulatedOAuthAccessTokens(r randyOauth, easy bool) *OAuthAccessTokens {
 	this := &OAuthAccessTokens{}
 	if r.Intn(10) != 0 {
-		v22 := r.Intn(5)
-		this.Tokens = make([]*OAuthAccessToken, v22)
-		for i := 0; i < v22; i++ {
+		v24 := r.Intn(5)
+		this.Tokens = make([]*OAuthAccessToken, v24)
+		for i := 0; i < v24; i++ {
 			this.Tokens[i] = NewPopulatedOAuthAccessToken(r, easy)
 		}
 	}
@@ -1675,10 +1772,10 @@ func NewPopulatedOAuthAccessTokens(r randyOauth, easy bool) *OAuthAccessTokens {
 
 func NewPopulatedListOAuthAccessTokensRequest(r randyOauth, easy bool) *ListOAuthAccessTokensRequest {
 	this := &ListOAuthAccessTokensRequest{}
-	v23 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIDs = *v23
-	v24 := NewPopulatedClientIdentifiers(r, easy)
-	this.ClientIDs = *v24
+	v25 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIDs = *v25
+	v26 := NewPopulatedClientIdentifiers(r, easy)
+	this.ClientIDs = *v26
 	this.Order = randStringOauth(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -1706,9 +1803,9 @@ func randUTF8RuneOauth(r randyOauth) rune {
 	return rune(ru + 61)
 }
 func randStringOauth(r randyOauth) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
 		tmps[i] = randUTF8RuneOauth(r)
 	}
 	return string(tmps)
@@ -1730,11 +1827,11 @@ func randFieldOauth(dAtA []byte, r randyOauth, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOauth(dAtA, uint64(key))
-		v26 := r.Int63()
+		v27 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v27 *= -1
 		}
-		dAtA = encodeVarintPopulateOauth(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateOauth(dAtA, uint64(v27))
 	case 1:
 		dAtA = encodeVarintPopulateOauth(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1865,6 +1962,16 @@ func (m *OAuthAuthorizationCode) Size() (n int) {
 	n += 1 + l + sovOauth(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
 	n += 1 + l + sovOauth(uint64(l))
+	if len(m.OpenIDScopes) > 0 {
+		for _, s := range m.OpenIDScopes {
+			l = len(s)
+			n += 1 + l + sovOauth(uint64(l))
+		}
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovOauth(uint64(l))
+	}
 	return n
 }
 
@@ -1918,6 +2025,12 @@ func (m *OAuthAccessToken) Size() (n int) {
 	n += 1 + l + sovOauth(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)
 	n += 1 + l + sovOauth(uint64(l))
+	if len(m.OpenIDScopes) > 0 {
+		for _, s := range m.OpenIDScopes {
+			l = len(s)
+			n += 1 + l + sovOauth(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2033,6 +2146,8 @@ func (this *OAuthAuthorizationCode) String() string {
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`ExpiresAt:` + strings.Replace(strings.Replace(this.ExpiresAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`OpenIDScopes:` + fmt.Sprintf("%v", this.OpenIDScopes) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2062,6 +2177,7 @@ func (this *OAuthAccessToken) String() string {
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
 		`CreatedAt:` + strings.Replace(strings.Replace(this.CreatedAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`ExpiresAt:` + strings.Replace(strings.Replace(this.ExpiresAt.String(), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`OpenIDScopes:` + fmt.Sprintf("%v", this.OpenIDScopes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3040,6 +3156,70 @@ func (m *OAuthAuthorizationCode) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenIDScopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenIDScopes = append(m.OpenIDScopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOauth(dAtA[iNdEx:])
@@ -3541,6 +3721,38 @@ func (m *OAuthAccessToken) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenIDScopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOauth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOauth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOauth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenIDScopes = append(m.OpenIDScopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOauth(dAtA[iNdEx:])
@@ -60,6 +60,8 @@ var OAuthAuthorizationCodeFieldPathsNested = []string{
 	"code",
 	"created_at",
 	"expires_at",
+	"nonce",
+	"openid_scopes",
 	"redirect_uri",
 	"rights",
 	"state",
@@ -73,6 +75,8 @@ var OAuthAuthorizationCodeFieldPathsTopLevel = []string{
 	"code",
 	"created_at",
 	"expires_at",
+	"nonce",
+	"openid_scopes",
 	"redirect_uri",
 	"rights",
 	"state",
@@ -99,6 +103,7 @@ var OAuthAccessTokenFieldPathsNested = []string{
 	"created_at",
 	"expires_at",
 	"id",
+	"openid_scopes",
 	"refresh_token",
 	"rights",
 	"user_ids",
@@ -112,6 +117,7 @@ var OAuthAccessTokenFieldPathsTopLevel = []string{
 	"created_at",
 	"expires_at",
 	"id",
+	"openid_scopes",
 	"refresh_token",
 	"rights",
 	"user_ids",
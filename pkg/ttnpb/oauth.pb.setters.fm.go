@@ -307,6 +307,25 @@ func (dst *OAuthAuthorizationCode) SetFields(src *OAuthAuthorizationCode, paths
 				var zero time.Time
 				dst.ExpiresAt = zero
 			}
+		case "openid_scopes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'openid_scopes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OpenIDScopes = src.OpenIDScopes
+			} else {
+				dst.OpenIDScopes = nil
+			}
+		case "nonce":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nonce' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Nonce = src.Nonce
+			} else {
+				var zero string
+				dst.Nonce = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -470,6 +489,15 @@ func (dst *OAuthAccessToken) SetFields(src *OAuthAccessToken, paths ...string) e
 				var zero time.Time
 				dst.ExpiresAt = zero
 			}
+		case "openid_scopes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'openid_scopes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OpenIDScopes = src.OpenIDScopes
+			} else {
+				dst.OpenIDScopes = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
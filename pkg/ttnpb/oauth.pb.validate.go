@@ -558,6 +558,36 @@ func (m *OAuthAuthorizationCode) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "openid_scopes":
+
+			if len(m.GetOpenIDScopes()) > 8 {
+				return OAuthAuthorizationCodeValidationError{
+					field:  "openid_scopes",
+					reason: "value must contain no more than 8 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetOpenIDScopes() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 32 {
+					return OAuthAuthorizationCodeValidationError{
+						field:  fmt.Sprintf("openid_scopes[%v]", idx),
+						reason: "value length must be at most 32 runes",
+					}
+				}
+
+			}
+
+		case "nonce":
+
+			if utf8.RuneCountInString(m.GetNonce()) > 256 {
+				return OAuthAuthorizationCodeValidationError{
+					field:  "nonce",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
 		default:
 			return OAuthAuthorizationCodeValidationError{
 				field:  name,
@@ -803,6 +833,27 @@ func (m *OAuthAccessToken) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "openid_scopes":
+
+			if len(m.GetOpenIDScopes()) > 8 {
+				return OAuthAccessTokenValidationError{
+					field:  "openid_scopes",
+					reason: "value must contain no more than 8 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetOpenIDScopes() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 32 {
+					return OAuthAccessTokenValidationError{
+						field:  fmt.Sprintf("openid_scopes[%v]", idx),
+						reason: "value length must be at most 32 runes",
+					}
+				}
+
+			}
+
 		default:
 			return OAuthAccessTokenValidationError{
 				field:  name,
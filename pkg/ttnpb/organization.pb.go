@@ -37,6 +37,7 @@ type Organization struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt               time.Time         `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	UpdatedAt               time.Time         `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	DeletedAt               *time.Time        `protobuf:"bytes,8,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
 	Name                    string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Description             string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes              map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -91,6 +92,13 @@ func (m *Organization) GetUpdatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Organization) GetDeletedAt() *time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
 func (m *Organization) GetName() string {
 	if m != nil {
 		return m.Name
@@ -221,7 +229,9 @@ type ListOrganizationsRequest struct {
 	// Limit the number of results per page.
 	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	// Page number for pagination. 0 is interpreted as 1.
-	Page                 uint32   `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Page uint32 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	// Only return recently deleted organizations.
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -293,6 +303,13 @@ func (m *ListOrganizationsRequest) GetPage() uint32 {
 	return 0
 }
 
+func (m *ListOrganizationsRequest) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
 type CreateOrganizationRequest struct {
 	Organization `protobuf:"bytes,1,opt,name=organization,proto3,embedded=organization" json:"organization"`
 	// Collaborator to grant all rights on the newly created application.
@@ -493,10 +510,12 @@ func (m *GetOrganizationAPIKeyRequest) GetKeyID() string {
 
 type CreateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
-	Name                    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights                  []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	Name                    string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights                  []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt               *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs            []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}   `json:"-"`
+	XXX_sizecache           int32      `json:"-"`
 }
 
 func (m *CreateOrganizationAPIKeyRequest) Reset()      { *m = CreateOrganizationAPIKeyRequest{} }
@@ -545,11 +564,28 @@ func (m *CreateOrganizationAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateOrganizationAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateOrganizationAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateOrganizationAPIKeyRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	APIKey                  `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	// The names of the API key fields that should be updated.
+	// If this is not set, the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateOrganizationAPIKeyRequest) Reset()      { *m = UpdateOrganizationAPIKeyRequest{} }
@@ -584,6 +620,13 @@ func (m *UpdateOrganizationAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateOrganizationAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateOrganizationAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type ListOrganizationCollaboratorsRequest struct {
 	OrganizationIdentifiers `protobuf:"bytes,1,opt,name=organization_ids,json=organizationIds,proto3,embedded=organization_ids" json:"organization_ids"`
 	// Limit the number of results per page.
@@ -766,74 +809,80 @@ func init() {
 }
 
 var fileDescriptor_312da2e2e650bd3b = []byte{
-	// 1070 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xde, 0xf1, 0x4f, 0x52, 0x4f, 0x92, 0x36, 0x5a, 0x41, 0xb5, 0x4d, 0xa3, 0xb1, 0xb5, 0x44,
-	0x90, 0x56, 0xf1, 0x1a, 0xb9, 0x17, 0xa8, 0x80, 0xc8, 0x1b, 0x7e, 0x14, 0x05, 0x28, 0x6c, 0xe9,
-	0x85, 0xaa, 0x58, 0x63, 0xef, 0x78, 0x33, 0xb2, 0xbd, 0xb3, 0xcc, 0x8e, 0x53, 0x1c, 0x84, 0x54,
-	0x71, 0xaa, 0x38, 0x55, 0x9c, 0x10, 0x27, 0xc4, 0x01, 0x95, 0x5b, 0x8e, 0x15, 0x17, 0x72, 0x8c,
-	0x38, 0xe5, 0x58, 0x71, 0x08, 0xf5, 0xfa, 0x92, 0x1b, 0x3d, 0x46, 0x39, 0xa1, 0xfd, 0x71, 0xb3,
-	0x5e, 0x1b, 0x4b, 0xd0, 0x2a, 0x85, 0xdb, 0xcc, 0xce, 0x37, 0xef, 0xbd, 0xef, 0xcd, 0xf7, 0xcd,
-	0xd8, 0x70, 0xa9, 0xc5, 0x38, 0xbe, 0x8d, 0xed, 0xa2, 0x2b, 0x70, 0xbd, 0x59, 0xc2, 0x0e, 0x2d,
-	0x31, 0x6e, 0x61, 0x9b, 0x6e, 0x63, 0x41, 0x99, 0xad, 0x39, 0x9c, 0x09, 0x26, 0x9f, 0x15, 0xc2,
-	0xd6, 0x22, 0xa4, 0xb6, 0x75, 0x65, 0xa1, 0x62, 0x51, 0xb1, 0xd9, 0xa9, 0x69, 0x75, 0xd6, 0x2e,
-	0x11, 0x7b, 0x8b, 0x75, 0x1d, 0xce, 0xbe, 0xe8, 0x96, 0x02, 0x70, 0xbd, 0x68, 0x11, 0xbb, 0xb8,
-	0x85, 0x5b, 0xd4, 0xc4, 0x82, 0x94, 0x46, 0x06, 0x61, 0xc8, 0x85, 0x62, 0x2c, 0x84, 0xc5, 0x2c,
-	0x16, 0x6e, 0xae, 0x75, 0x1a, 0xc1, 0x2c, 0x98, 0x04, 0xa3, 0x08, 0x5e, 0xb0, 0x18, 0xb3, 0x5a,
-	0xe4, 0x04, 0xd5, 0xa0, 0xa4, 0x65, 0x56, 0xdb, 0xd8, 0x6d, 0x46, 0x88, 0x7c, 0x12, 0x21, 0x68,
-	0x9b, 0xb8, 0x02, 0xb7, 0x9d, 0x08, 0x30, 0x86, 0x6a, 0x9d, 0xd9, 0x02, 0xd7, 0x45, 0x95, 0xda,
-	0x8d, 0x41, 0xa2, 0x97, 0x46, 0x51, 0xd4, 0x24, 0xb6, 0xa0, 0x0d, 0x4a, 0xb8, 0x1b, 0x81, 0xd0,
-	0x28, 0x88, 0x53, 0x6b, 0x53, 0x44, 0xeb, 0xea, 0xcf, 0x19, 0x38, 0x7b, 0x2d, 0xd6, 0x46, 0x79,
-	0x03, 0xa6, 0xa9, 0xe9, 0x2a, 0xa0, 0x00, 0x96, 0x67, 0xca, 0xaf, 0x68, 0xc3, 0xed, 0xd4, 0xe2,
-	0xd0, 0xf5, 0x93, 0x64, 0xfa, 0xfc, 0xb1, 0x9e, 0xfd, 0x06, 0xa4, 0xe6, 0xc1, 0xde, 0x41, 0x5e,
-	0xda, 0x3f, 0xc8, 0x03, 0xc3, 0x8f, 0x22, 0xaf, 0x41, 0x58, 0xe7, 0x04, 0x0b, 0x62, 0x56, 0xb1,
-	0x50, 0x52, 0x41, 0xcc, 0x05, 0x2d, 0xa4, 0xaf, 0x0d, 0xe8, 0x6b, 0x9f, 0x0c, 0xe8, 0xeb, 0x67,
-	0xfc, 0xed, 0xf7, 0xfe, 0xc8, 0x03, 0x23, 0x17, 0xed, 0xab, 0x08, 0x3f, 0x48, 0xc7, 0x31, 0x07,
-	0x41, 0xd2, 0xff, 0x24, 0x48, 0xb4, 0xaf, 0x22, 0xe4, 0x8b, 0x30, 0x63, 0xe3, 0x36, 0x51, 0x32,
-	0x05, 0xb0, 0x9c, 0xd3, 0xa7, 0x8f, 0xf5, 0x0c, 0x4f, 0x29, 0x65, 0x23, 0xf8, 0x28, 0x5f, 0x86,
-	0x33, 0x26, 0x71, 0xeb, 0x9c, 0x3a, 0x3e, 0x2f, 0x25, 0x1b, 0x60, 0xce, 0x1c, 0xeb, 0x59, 0x9e,
-	0x56, 0xf6, 0xcf, 0x19, 0xf1, 0x45, 0x79, 0x1b, 0x42, 0x2c, 0x04, 0xa7, 0xb5, 0x8e, 0x20, 0xae,
-	0x32, 0x55, 0x48, 0x2f, 0xcf, 0x94, 0x57, 0x26, 0xb5, 0x49, 0xab, 0x3c, 0x81, 0xbf, 0x63, 0x0b,
-	0xde, 0xd5, 0x57, 0x8e, 0xf5, 0x4b, 0xdf, 0x83, 0x97, 0xd5, 0x25, 0xae, 0x2a, 0x4b, 0x65, 0xf4,
-	0xd9, 0x4d, 0x5c, 0xdc, 0x7e, 0xb5, 0xf8, 0xfa, 0xad, 0xe5, 0xd5, 0xab, 0x37, 0x8b, 0xb7, 0x56,
-	0x07, 0xd3, 0x4b, 0x5f, 0x96, 0x57, 0xbe, 0x5a, 0x32, 0x62, 0xd9, 0xe4, 0xb7, 0xe0, 0x6c, 0x5c,
-	0x07, 0xca, 0x74, 0x90, 0xfd, 0x62, 0x32, 0xfb, 0x5a, 0x88, 0x59, 0xb7, 0x1b, 0xcc, 0x98, 0xa9,
-	0x9f, 0x4c, 0x16, 0xde, 0x84, 0xe7, 0x12, 0xc5, 0xc8, 0xf3, 0x30, 0xdd, 0x24, 0xdd, 0xe0, 0xb8,
-	0x73, 0x86, 0x3f, 0x94, 0x5f, 0x80, 0xd9, 0x2d, 0xdc, 0xea, 0x90, 0xe0, 0xb8, 0x72, 0x46, 0x38,
-	0xb9, 0x9a, 0x7a, 0x0d, 0xa8, 0xd7, 0xe1, 0x5c, 0x9c, 0x98, 0x2b, 0xeb, 0x70, 0x2e, 0x6e, 0x41,
-	0x5f, 0x35, 0x7e, 0x41, 0x8b, 0x93, 0xda, 0x61, 0x0c, 0x6f, 0x51, 0x7f, 0x05, 0xf0, 0xfc, 0x7b,
-	0x44, 0x0c, 0x41, 0xc8, 0xe7, 0x1d, 0xe2, 0x0a, 0xd9, 0x84, 0xf3, 0x71, 0x6c, 0xf5, 0x99, 0xe8,
-	0xf2, 0x1c, 0x1b, 0x82, 0xba, 0xf2, 0x2a, 0x84, 0x27, 0x0e, 0xfd, 0x5b, 0x8d, 0xbe, 0xeb, 0x43,
-	0x3e, 0xc0, 0x6e, 0x53, 0xcf, 0xf8, 0xa1, 0x8c, 0x5c, 0x63, 0xf0, 0x41, 0x3d, 0x02, 0x50, 0x79,
-	0x9f, 0xba, 0x43, 0x14, 0xdc, 0x01, 0x87, 0x8f, 0xfd, 0x23, 0x6b, 0xb5, 0x70, 0x8d, 0x71, 0x2c,
-	0x18, 0x8f, 0xea, 0x2f, 0x4e, 0xaa, 0xff, 0x1a, 0xbf, 0xe1, 0x12, 0x1e, 0x63, 0x61, 0x0c, 0x85,
-	0x78, 0xea, 0x82, 0xfd, 0x13, 0x66, 0xdc, 0x24, 0x3c, 0xf0, 0x52, 0xce, 0x08, 0x27, 0x32, 0x82,
-	0xd9, 0x16, 0x6d, 0x53, 0x11, 0x58, 0x64, 0x2e, 0x90, 0xff, 0xe5, 0xb4, 0x72, 0x38, 0x6d, 0x84,
-	0x9f, 0x65, 0x19, 0x66, 0x1c, 0x6c, 0x91, 0xc0, 0x1d, 0x73, 0x46, 0x30, 0x56, 0xf7, 0x01, 0xbc,
-	0xb0, 0x16, 0x18, 0x75, 0xdc, 0xf9, 0x19, 0x70, 0x36, 0xde, 0xec, 0x88, 0xfb, 0x44, 0x75, 0x8c,
-	0x39, 0xb0, 0xa1, 0x18, 0x72, 0x35, 0xd1, 0xcf, 0xd4, 0xbf, 0xe8, 0xa7, 0x3e, 0x1b, 0x4f, 0x32,
-	0xdc, 0x5d, 0x75, 0x07, 0xc0, 0x0b, 0x37, 0x82, 0x6b, 0xe3, 0xb4, 0x28, 0x3d, 0xb5, 0x00, 0x7f,
-	0x01, 0x10, 0x25, 0x05, 0x58, 0xf9, 0x68, 0x7d, 0x83, 0x74, 0xdd, 0xd3, 0xb5, 0xd2, 0x13, 0x09,
-	0xa5, 0x26, 0x4b, 0x28, 0x1d, 0x93, 0xd0, 0x4f, 0x00, 0x2e, 0x26, 0xfc, 0x1f, 0xd6, 0x7e, 0xba,
-	0xa5, 0x17, 0xe0, 0x54, 0x93, 0x74, 0xab, 0xd4, 0x0c, 0xaf, 0x3d, 0x3d, 0xe7, 0x1d, 0xe4, 0xb3,
-	0x1b, 0xa4, 0xbb, 0xfe, 0xb6, 0x91, 0x6d, 0x92, 0xee, 0xba, 0xa9, 0x7a, 0x00, 0xe6, 0x47, 0xb5,
-	0xfe, 0x3c, 0x6a, 0x1d, 0xbc, 0x65, 0xa9, 0x71, 0x6f, 0xd9, 0x1b, 0x70, 0x2a, 0x7c, 0xe0, 0x95,
-	0x74, 0x21, 0xbd, 0x7c, 0xb6, 0xfc, 0x62, 0x32, 0xb1, 0xe1, 0xaf, 0xea, 0x73, 0xc7, 0x3a, 0xfc,
-	0x16, 0x4c, 0xab, 0xd9, 0xaf, 0xfd, 0x5c, 0x46, 0xb4, 0x47, 0xfd, 0x0d, 0xc0, 0xfc, 0xa8, 0xfa,
-	0x9f, 0x07, 0xc9, 0x0a, 0x9c, 0xc6, 0x0e, 0xad, 0xfa, 0x8f, 0x53, 0x68, 0x89, 0xf3, 0xc9, 0xe0,
-	0x61, 0x55, 0x63, 0x62, 0x4d, 0x61, 0x87, 0x6e, 0x90, 0xae, 0xba, 0x0b, 0xe0, 0x52, 0xd2, 0x17,
-	0x6b, 0x31, 0xaf, 0xff, 0x0f, 0xdc, 0xf1, 0x27, 0x80, 0x6a, 0xc2, 0x1d, 0x71, 0x06, 0xa7, 0x4b,
-	0xa0, 0xfe, 0x2c, 0xee, 0xde, 0x31, 0xb7, 0xe1, 0xd0, 0xfd, 0xfb, 0x3b, 0x80, 0xea, 0xf5, 0xff,
-	0x0a, 0xe3, 0x0f, 0xc7, 0x32, 0x5e, 0x1c, 0xfd, 0xc1, 0x75, 0x82, 0x99, 0xf4, 0xb8, 0xe8, 0x3f,
-	0x82, 0xbd, 0x1e, 0x02, 0xfb, 0x3d, 0x04, 0x1e, 0xf6, 0x90, 0xf4, 0xa8, 0x87, 0xa4, 0xc3, 0x1e,
-	0x92, 0x1e, 0xf7, 0x90, 0x74, 0xd4, 0x43, 0xe0, 0x8e, 0x87, 0xc0, 0x5d, 0x0f, 0x49, 0xf7, 0x3d,
-	0x04, 0x76, 0x3c, 0x24, 0x3d, 0xf0, 0x90, 0xb4, 0xeb, 0x21, 0x69, 0xcf, 0x43, 0x60, 0xdf, 0x43,
-	0xe0, 0xa1, 0x87, 0xa4, 0x47, 0x1e, 0x02, 0x87, 0x1e, 0x92, 0x1e, 0x7b, 0x08, 0x1c, 0x79, 0x48,
-	0xba, 0xd3, 0x47, 0xd2, 0xdd, 0x3e, 0x02, 0xf7, 0xfa, 0x48, 0xfa, 0xae, 0x8f, 0xc0, 0x0f, 0x7d,
-	0x24, 0xdd, 0xef, 0x23, 0x69, 0xa7, 0x8f, 0xc0, 0x83, 0x3e, 0x02, 0xbb, 0x7d, 0x04, 0x3e, 0x5d,
-	0xb1, 0x98, 0x26, 0x36, 0x89, 0xd8, 0xa4, 0xb6, 0xe5, 0x6a, 0x36, 0x11, 0xb7, 0x19, 0x6f, 0x96,
-	0x86, 0xff, 0x19, 0x38, 0x4d, 0xab, 0x24, 0x84, 0xed, 0xd4, 0x6a, 0x53, 0xc1, 0x9b, 0x73, 0xe5,
-	0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x27, 0xdc, 0xf1, 0x71, 0x0d, 0x00, 0x00,
+	// 1166 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4b, 0x8c, 0xdb, 0xc4,
+	0x1f, 0xf6, 0xe4, 0xb9, 0x99, 0x4d, 0xda, 0x95, 0xf5, 0xff, 0x57, 0xee, 0xb6, 0x9a, 0x44, 0x66,
+	0x05, 0x69, 0xb5, 0x71, 0x50, 0x7a, 0x81, 0x8a, 0x52, 0xc5, 0x29, 0x54, 0xd1, 0x02, 0x05, 0x97,
+	0x5e, 0xa8, 0x4a, 0x34, 0x89, 0x27, 0xde, 0x51, 0x12, 0xdb, 0xd8, 0x93, 0x6d, 0x53, 0x84, 0x54,
+	0x71, 0xaa, 0xe0, 0x52, 0xed, 0x09, 0x71, 0x42, 0x1c, 0x50, 0x8f, 0x7b, 0xac, 0xb8, 0xb0, 0xc7,
+	0x3d, 0xee, 0xb1, 0xe2, 0xb0, 0x34, 0xce, 0x65, 0xc5, 0x85, 0x1e, 0xab, 0x9c, 0x90, 0x1f, 0x69,
+	0x9c, 0x47, 0x03, 0xa5, 0xd5, 0x16, 0x6e, 0x1e, 0xfb, 0xfb, 0x7d, 0xbf, 0xc7, 0x7c, 0xdf, 0x4c,
+	0x02, 0xd7, 0xda, 0x86, 0x85, 0x6f, 0x62, 0xbd, 0x60, 0x33, 0xdc, 0x68, 0x15, 0xb1, 0x49, 0x8b,
+	0x86, 0xa5, 0x61, 0x9d, 0xde, 0xc6, 0x8c, 0x1a, 0xba, 0x64, 0x5a, 0x06, 0x33, 0xf8, 0x63, 0x8c,
+	0xe9, 0x52, 0x80, 0x94, 0xb6, 0xce, 0xad, 0x96, 0x35, 0xca, 0x36, 0xbb, 0x75, 0xa9, 0x61, 0x74,
+	0x8a, 0x44, 0xdf, 0x32, 0x7a, 0xa6, 0x65, 0xdc, 0xea, 0x15, 0x3d, 0x70, 0xa3, 0xa0, 0x11, 0xbd,
+	0xb0, 0x85, 0xdb, 0x54, 0xc5, 0x8c, 0x14, 0x67, 0x1e, 0x7c, 0xca, 0xd5, 0x42, 0x88, 0x42, 0x33,
+	0x34, 0xc3, 0x0f, 0xae, 0x77, 0x9b, 0xde, 0xca, 0x5b, 0x78, 0x4f, 0x01, 0x3c, 0xa7, 0x19, 0x86,
+	0xd6, 0x26, 0x63, 0x54, 0x93, 0x92, 0xb6, 0x5a, 0xeb, 0x60, 0xbb, 0x15, 0x20, 0xb2, 0xd3, 0x08,
+	0x46, 0x3b, 0xc4, 0x66, 0xb8, 0x63, 0x06, 0x80, 0x39, 0xad, 0x36, 0x0c, 0x9d, 0xe1, 0x06, 0xab,
+	0x51, 0xbd, 0x39, 0x4a, 0xf4, 0xda, 0x2c, 0x8a, 0xaa, 0x44, 0x67, 0xb4, 0x49, 0x89, 0x65, 0x07,
+	0x20, 0x34, 0x0b, 0xb2, 0xa8, 0xb6, 0xc9, 0x82, 0xef, 0xe2, 0xef, 0x31, 0x98, 0xbe, 0x12, 0x1a,
+	0x23, 0xbf, 0x01, 0xa3, 0x54, 0xb5, 0x05, 0x90, 0x03, 0xf9, 0xe5, 0xd2, 0x1b, 0xd2, 0xe4, 0x38,
+	0xa5, 0x30, 0xb4, 0x3a, 0x4e, 0x26, 0xaf, 0x0c, 0xe5, 0xf8, 0x37, 0x20, 0xb2, 0x02, 0xf6, 0x0e,
+	0xb2, 0xdc, 0xfe, 0x41, 0x16, 0x28, 0x2e, 0x0b, 0x5f, 0x81, 0xb0, 0x61, 0x11, 0xcc, 0x88, 0x5a,
+	0xc3, 0x4c, 0x88, 0x78, 0x9c, 0xab, 0x92, 0xdf, 0xbe, 0x34, 0x6a, 0x5f, 0xfa, 0x74, 0xd4, 0xbe,
+	0xbc, 0xe4, 0x86, 0xdf, 0xfb, 0x2d, 0x0b, 0x94, 0x54, 0x10, 0x57, 0x66, 0x2e, 0x49, 0xd7, 0x54,
+	0x47, 0x24, 0xd1, 0xe7, 0x21, 0x09, 0xe2, 0xca, 0x8c, 0xbf, 0x08, 0xa1, 0x4a, 0xda, 0x24, 0x20,
+	0x59, 0xfa, 0x4b, 0x92, 0x98, 0x4f, 0x10, 0xc4, 0x94, 0x19, 0x7f, 0x0a, 0xc6, 0x74, 0xdc, 0x21,
+	0x42, 0x2c, 0x07, 0xf2, 0x29, 0x39, 0x39, 0x94, 0x63, 0x56, 0x44, 0x28, 0x29, 0xde, 0x4b, 0xfe,
+	0x2c, 0x5c, 0x56, 0x89, 0xdd, 0xb0, 0xa8, 0xe9, 0x0e, 0x46, 0x88, 0x7b, 0x98, 0xa5, 0xa1, 0x1c,
+	0xb7, 0xa2, 0xc2, 0xfe, 0x71, 0x25, 0xfc, 0x91, 0xbf, 0x0d, 0x21, 0x66, 0xcc, 0xa2, 0xf5, 0x2e,
+	0x23, 0xb6, 0x90, 0xc8, 0x45, 0xf3, 0xcb, 0xa5, 0xf5, 0x45, 0x73, 0x96, 0xca, 0x4f, 0xe1, 0xef,
+	0xe9, 0xcc, 0xea, 0xc9, 0xeb, 0x43, 0xf9, 0xcc, 0xf7, 0xe0, 0x75, 0x71, 0xcd, 0x12, 0x85, 0xb5,
+	0x12, 0xfa, 0xfc, 0x3a, 0x2e, 0xdc, 0x7e, 0xb3, 0xf0, 0xf6, 0x8d, 0xfc, 0xc5, 0xf3, 0xd7, 0x0b,
+	0x37, 0x2e, 0x8e, 0x96, 0x67, 0xbe, 0x2c, 0xad, 0x7f, 0xb5, 0xa6, 0x84, 0xb2, 0xf1, 0xef, 0xc2,
+	0x74, 0x58, 0x48, 0x42, 0xd2, 0xcb, 0x7e, 0x6a, 0x3a, 0x7b, 0xc5, 0xc7, 0x54, 0xf5, 0xa6, 0xa1,
+	0x2c, 0x37, 0xc6, 0x8b, 0xd5, 0x0b, 0xf0, 0xf8, 0x54, 0x31, 0xfc, 0x0a, 0x8c, 0xb6, 0x48, 0xcf,
+	0xd3, 0x4b, 0x4a, 0x71, 0x1f, 0xf9, 0xff, 0xc1, 0xf8, 0x16, 0x6e, 0x77, 0x89, 0xb7, 0xdf, 0x29,
+	0xc5, 0x5f, 0x9c, 0x8f, 0xbc, 0x05, 0xc4, 0xab, 0x30, 0x13, 0x6e, 0xcc, 0xe6, 0x65, 0x98, 0x09,
+	0x7b, 0xd8, 0x95, 0x9d, 0x5b, 0xd0, 0xe9, 0x45, 0xe3, 0x50, 0x26, 0x43, 0xc4, 0x5f, 0x00, 0x3c,
+	0x71, 0x99, 0xb0, 0x09, 0x08, 0xf9, 0xa2, 0x4b, 0x6c, 0xc6, 0xab, 0x70, 0x25, 0x8c, 0xad, 0xbd,
+	0x14, 0x61, 0x1f, 0x37, 0x26, 0xa0, 0xb6, 0x2b, 0xad, 0xb1, 0xc5, 0x9f, 0x29, 0xf2, 0xf7, 0x5d,
+	0xc8, 0x87, 0xd8, 0x6e, 0xc9, 0x31, 0x97, 0x4a, 0x49, 0x35, 0x47, 0x2f, 0xc4, 0xed, 0x08, 0x14,
+	0x3e, 0xa0, 0xf6, 0x44, 0x0b, 0xf6, 0xa8, 0x87, 0x4f, 0xdc, 0x2d, 0x6b, 0xb7, 0x71, 0xdd, 0xb0,
+	0x30, 0x33, 0xac, 0xa0, 0xfe, 0xc2, 0xa2, 0xfa, 0xaf, 0x58, 0xd7, 0x6c, 0x62, 0x85, 0xba, 0x50,
+	0x26, 0x28, 0x5e, 0xb8, 0x60, 0x77, 0x87, 0x0d, 0x4b, 0x25, 0x96, 0x67, 0xc6, 0x94, 0xe2, 0x2f,
+	0x78, 0x04, 0xe3, 0x6d, 0xda, 0xa1, 0xcc, 0xb3, 0x48, 0xc6, 0x93, 0xff, 0xd9, 0xa8, 0x70, 0x98,
+	0x54, 0xfc, 0xd7, 0x3c, 0x0f, 0x63, 0x26, 0xd6, 0x88, 0xe7, 0x8e, 0x8c, 0xe2, 0x3d, 0xf3, 0x02,
+	0x4c, 0x06, 0x16, 0x13, 0x12, 0x39, 0x90, 0x5f, 0x52, 0x46, 0x4b, 0x71, 0x1f, 0xc0, 0x93, 0x15,
+	0xef, 0x0c, 0x98, 0xb7, 0xb3, 0x0a, 0x4c, 0x87, 0xb7, 0x21, 0x98, 0xca, 0x42, 0xdd, 0xcc, 0xd9,
+	0xca, 0x09, 0x0e, 0xbe, 0x36, 0x35, 0xe9, 0xc8, 0x3f, 0x98, 0xb4, 0x9c, 0x0e, 0x27, 0x99, 0x9c,
+	0xbb, 0xb8, 0x03, 0xe0, 0xc9, 0x6b, 0xde, 0x89, 0x74, 0x54, 0x2d, 0xbd, 0xb0, 0x34, 0x7f, 0x06,
+	0x10, 0x4d, 0x4b, 0xb3, 0xfc, 0x71, 0x75, 0x83, 0xf4, 0xec, 0xa3, 0x35, 0xd9, 0x53, 0x71, 0x45,
+	0x16, 0x8b, 0x2b, 0x3a, 0x16, 0x97, 0xf8, 0x13, 0x80, 0xa7, 0x2f, 0x93, 0x39, 0xb5, 0x1f, 0x6d,
+	0xe9, 0x39, 0x98, 0x68, 0x91, 0x5e, 0x8d, 0xaa, 0xfe, 0x81, 0x28, 0xa7, 0x9c, 0x83, 0x6c, 0x7c,
+	0x83, 0xf4, 0xaa, 0x97, 0x94, 0x78, 0x8b, 0xf4, 0xaa, 0xaa, 0xe8, 0x44, 0x60, 0x76, 0x56, 0xeb,
+	0xaf, 0xa2, 0xd6, 0xd1, 0x2d, 0x17, 0x99, 0x77, 0xcb, 0xbd, 0x03, 0x13, 0xfe, 0x6f, 0x07, 0x21,
+	0x9a, 0x8b, 0xe6, 0x8f, 0x95, 0xfe, 0x3f, 0x9d, 0x58, 0x71, 0xbf, 0xca, 0x99, 0xa1, 0x0c, 0xb7,
+	0x41, 0x52, 0x8c, 0x7f, 0xed, 0xe6, 0x52, 0x82, 0x18, 0x57, 0x8b, 0xe4, 0x96, 0x49, 0x2d, 0x62,
+	0xd7, 0xb0, 0x7f, 0x46, 0xfc, 0xad, 0x1b, 0x38, 0x88, 0x29, 0x33, 0xfe, 0x02, 0xcc, 0xe0, 0x76,
+	0xdb, 0xb8, 0x49, 0xd4, 0x5a, 0x83, 0xaa, 0x96, 0x2d, 0xc4, 0x73, 0xd1, 0x7c, 0x4a, 0x16, 0x86,
+	0x72, 0x7c, 0x1b, 0x44, 0x56, 0x72, 0xce, 0x41, 0x36, 0x5d, 0xf6, 0x01, 0x95, 0xea, 0x25, 0xc5,
+	0x56, 0xd2, 0x01, 0xbc, 0xe2, 0xa2, 0xc5, 0x6f, 0x23, 0x30, 0x3b, 0xeb, 0xbe, 0x57, 0x31, 0xe4,
+	0x32, 0x4c, 0x62, 0x93, 0xd6, 0xdc, 0x6b, 0xd3, 0xb7, 0xe4, 0x89, 0x69, 0x72, 0xbf, 0xaa, 0x39,
+	0x5c, 0x09, 0x6c, 0xd2, 0x0d, 0xd2, 0x9b, 0x32, 0x76, 0xf4, 0xf9, 0x8d, 0xbd, 0x0b, 0xe0, 0xda,
+	0xb4, 0xb1, 0x2b, 0xa1, 0xc3, 0xea, 0x3f, 0x60, 0xef, 0x3f, 0x00, 0x14, 0x2f, 0x93, 0x67, 0x76,
+	0x70, 0xb4, 0x0d, 0x34, 0x5e, 0xc6, 0xe5, 0x31, 0xe7, 0x38, 0x9f, 0xb8, 0x40, 0x7e, 0x05, 0x50,
+	0xbc, 0xfa, 0x6f, 0xe9, 0xf8, 0xa3, 0xb9, 0x1d, 0x9f, 0x9e, 0xfd, 0x2d, 0x39, 0xc6, 0x2c, 0xba,
+	0x1d, 0xe5, 0x1f, 0xc1, 0x5e, 0x1f, 0x81, 0xfd, 0x3e, 0x02, 0x0f, 0xfb, 0x88, 0x7b, 0xd4, 0x47,
+	0xdc, 0x61, 0x1f, 0x71, 0x8f, 0xfb, 0x88, 0x7b, 0xd2, 0x47, 0xe0, 0x8e, 0x83, 0xc0, 0x5d, 0x07,
+	0x71, 0xf7, 0x1d, 0x04, 0x76, 0x1c, 0xc4, 0x3d, 0x70, 0x10, 0xb7, 0xeb, 0x20, 0x6e, 0xcf, 0x41,
+	0x60, 0xdf, 0x41, 0xe0, 0xa1, 0x83, 0xb8, 0x47, 0x0e, 0x02, 0x87, 0x0e, 0xe2, 0x1e, 0x3b, 0x08,
+	0x3c, 0x71, 0x10, 0x77, 0x67, 0x80, 0xb8, 0xbb, 0x03, 0x04, 0xee, 0x0d, 0x10, 0xf7, 0xdd, 0x00,
+	0x81, 0x1f, 0x06, 0x88, 0xbb, 0x3f, 0x40, 0xdc, 0xce, 0x00, 0x81, 0x07, 0x03, 0x04, 0x76, 0x07,
+	0x08, 0x7c, 0xb6, 0xae, 0x19, 0x12, 0xdb, 0x24, 0x6c, 0x93, 0xea, 0x9a, 0x2d, 0xe9, 0x84, 0xdd,
+	0x34, 0xac, 0x56, 0x71, 0xf2, 0x5f, 0x93, 0xd9, 0xd2, 0x8a, 0x8c, 0xe9, 0x66, 0xbd, 0x9e, 0xf0,
+	0xbc, 0x75, 0xee, 0xcf, 0x01, 0x00, 0xe6, 0x32, 0x04, 0x3e, 0x8d, 0x0e, 0x00, 0x00,
 }
 
 func (this *Organization) Equal(that interface{}) bool {
@@ -864,6 +913,13 @@ func (this *Organization) Equal(that interface{}) bool {
 	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
 	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
 	if this.Name != that1.Name {
 		return false
 	}
@@ -978,6 +1034,9 @@ func (this *ListOrganizationsRequest) Equal(that interface{}) bool {
 	if this.Page != that1.Page {
 		return false
 	}
+	if this.Deleted != that1.Deleted {
+		return false
+	}
 	return true
 }
 func (this *CreateOrganizationRequest) Equal(that interface{}) bool {
@@ -1124,6 +1183,21 @@ func (this *CreateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
@@ -1151,6 +1225,9 @@ func (this *UpdateOrganizationAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *ListOrganizationCollaboratorsRequest) Equal(that interface{}) bool {
@@ -1317,6 +1394,16 @@ func (m *Organization) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintOrganization(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)))
+		n4, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
 	return i, nil
 }
 
@@ -1368,19 +1455,19 @@ func (m *GetOrganizationRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n4, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n5, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n4
+	i += n5
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.FieldMask.Size()))
-	n5, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n6, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n6
 	return i, nil
 }
 
@@ -1403,20 +1490,20 @@ func (m *ListOrganizationsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintOrganization(dAtA, i, uint64(m.Collaborator.Size()))
-		n6, err := m.Collaborator.MarshalTo(dAtA[i:])
+		n7, err := m.Collaborator.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n7
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.FieldMask.Size()))
-	n7, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n8, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n8
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x1a
 		i++
@@ -1433,6 +1520,16 @@ func (m *ListOrganizationsRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintOrganization(dAtA, i, uint64(m.Page))
 	}
+	if m.Deleted {
+		dAtA[i] = 0x30
+		i++
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -1454,19 +1551,19 @@ func (m *CreateOrganizationRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.Organization.Size()))
-	n8, err := m.Organization.MarshalTo(dAtA[i:])
+	n9, err := m.Organization.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.Collaborator.Size()))
-	n9, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n10, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	return i, nil
 }
 
@@ -1488,19 +1585,19 @@ func (m *UpdateOrganizationRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.Organization.Size()))
-	n10, err := m.Organization.MarshalTo(dAtA[i:])
+	n11, err := m.Organization.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.FieldMask.Size()))
-	n11, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n12, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	return i, nil
 }
 
@@ -1522,11 +1619,11 @@ func (m *ListOrganizationAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n12, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n13, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1558,11 +1655,11 @@ func (m *GetOrganizationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n13, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n14, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	if len(m.KeyID) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1590,11 +1687,11 @@ func (m *CreateOrganizationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n14, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n15, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -1602,21 +1699,46 @@ func (m *CreateOrganizationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.Name)
 	}
 	if len(m.Rights) > 0 {
-		dAtA16 := make([]byte, len(m.Rights)*10)
-		var j15 int
+		dAtA17 := make([]byte, len(m.Rights)*10)
+		var j16 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j15++
+				j16++
 			}
-			dAtA16[j15] = uint8(num)
-			j15++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintOrganization(dAtA, i, uint64(j15))
-		i += copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintOrganization(dAtA, i, uint64(j16))
+		i += copy(dAtA[i:], dAtA17[:j16])
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintOrganization(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n18, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
@@ -1639,19 +1761,27 @@ func (m *UpdateOrganizationAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n17, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.APIKey.Size()))
-	n18, err := m.APIKey.MarshalTo(dAtA[i:])
+	n20, err := m.APIKey.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintOrganization(dAtA, i, uint64(m.FieldMask.Size()))
+	n21, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n21
 	return i, nil
 }
 
@@ -1673,11 +1803,11 @@ func (m *ListOrganizationCollaboratorsRequest) MarshalTo(dAtA []byte) (int, erro
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n19, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n22, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -1709,19 +1839,19 @@ func (m *GetOrganizationCollaboratorRequest) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n20, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n23, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n21, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n24, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n24
 	return i, nil
 }
 
@@ -1743,19 +1873,19 @@ func (m *SetOrganizationCollaboratorRequest) MarshalTo(dAtA []byte) (int, error)
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.OrganizationIdentifiers.Size()))
-	n22, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
+	n25, err := m.OrganizationIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintOrganization(dAtA, i, uint64(m.Collaborator.Size()))
-	n23, err := m.Collaborator.MarshalTo(dAtA[i:])
+	n26, err := m.Collaborator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
 	return i, nil
 }
 
@@ -1792,6 +1922,9 @@ func NewPopulatedOrganization(r randyOrganization, easy bool) *Organization {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
+	if r.Intn(10) != 0 {
+		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1832,6 +1965,7 @@ func NewPopulatedListOrganizationsRequest(r randyOrganization, easy bool) *ListO
 	this.Order = randStringOrganization(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
+	this.Deleted = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1890,6 +2024,14 @@ func NewPopulatedCreateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 	for i := 0; i < v17; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v18 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v18)
+	for i := 0; i < v18; i++ {
+		this.AllowedCIDRs[i] = randStringOrganization(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1897,10 +2039,12 @@ func NewPopulatedCreateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 
 func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool) *UpdateOrganizationAPIKeyRequest {
 	this := &UpdateOrganizationAPIKeyRequest{}
-	v18 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v18
-	v19 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v19
+	v19 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v19
+	v20 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1908,8 +2052,8 @@ func NewPopulatedUpdateOrganizationAPIKeyRequest(r randyOrganization, easy bool)
 
 func NewPopulatedListOrganizationCollaboratorsRequest(r randyOrganization, easy bool) *ListOrganizationCollaboratorsRequest {
 	this := &ListOrganizationCollaboratorsRequest{}
-	v20 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v20
+	v22 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v22
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -1919,10 +2063,10 @@ func NewPopulatedListOrganizationCollaboratorsRequest(r randyOrganization, easy
 
 func NewPopulatedGetOrganizationCollaboratorRequest(r randyOrganization, easy bool) *GetOrganizationCollaboratorRequest {
 	this := &GetOrganizationCollaboratorRequest{}
-	v21 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v23 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v23
+	v24 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1930,10 +2074,10 @@ func NewPopulatedGetOrganizationCollaboratorRequest(r randyOrganization, easy bo
 
 func NewPopulatedSetOrganizationCollaboratorRequest(r randyOrganization, easy bool) *SetOrganizationCollaboratorRequest {
 	this := &SetOrganizationCollaboratorRequest{}
-	v23 := NewPopulatedOrganizationIdentifiers(r, easy)
-	this.OrganizationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v25 := NewPopulatedOrganizationIdentifiers(r, easy)
+	this.OrganizationIdentifiers = *v25
+	v26 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v26
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1958,9 +2102,9 @@ func randUTF8RuneOrganization(r randyOrganization) rune {
 	return rune(ru + 61)
 }
 func randStringOrganization(r randyOrganization) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v27 := r.Intn(100)
+	tmps := make([]rune, v27)
+	for i := 0; i < v27; i++ {
 		tmps[i] = randUTF8RuneOrganization(r)
 	}
 	return string(tmps)
@@ -1982,11 +2126,11 @@ func randFieldOrganization(dAtA []byte, r randyOrganization, fieldNumber int, wi
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
-		v26 := r.Int63()
+		v28 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v28 *= -1
 		}
-		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(v28))
 	case 1:
 		dAtA = encodeVarintPopulateOrganization(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2045,6 +2189,10 @@ func (m *Organization) Size() (n int) {
 			n += 1 + l + sovOrganization(uint64(l))
 		}
 	}
+	if m.DeletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)
+		n += 1 + l + sovOrganization(uint64(l))
+	}
 	return n
 }
 
@@ -2098,6 +2246,9 @@ func (m *ListOrganizationsRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovOrganization(uint64(m.Page))
 	}
+	if m.Deleted {
+		n += 2
+	}
 	return n
 }
 
@@ -2178,6 +2329,16 @@ func (m *CreateOrganizationAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovOrganization(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovOrganization(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovOrganization(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2191,6 +2352,8 @@ func (m *UpdateOrganizationAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovOrganization(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovOrganization(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovOrganization(uint64(l))
 	return n
 }
 
@@ -2272,6 +2435,7 @@ func (this *Organization) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
 		`ContactInfo:` + strings.Replace(fmt.Sprintf("%v", this.ContactInfo), "ContactInfo", "ContactInfo", 1) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2307,6 +2471,7 @@ func (this *ListOrganizationsRequest) String() string {
 		`Order:` + fmt.Sprintf("%v", this.Order) + `,`,
 		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
 		`Page:` + fmt.Sprintf("%v", this.Page) + `,`,
+		`Deleted:` + fmt.Sprintf("%v", this.Deleted) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2364,6 +2529,8 @@ func (this *CreateOrganizationAPIKeyRequest) String() string {
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(this.OrganizationIdentifiers.String(), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2375,6 +2542,7 @@ func (this *UpdateOrganizationAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateOrganizationAPIKeyRequest{`,
 		`OrganizationIdentifiers:` + strings.Replace(strings.Replace(this.OrganizationIdentifiers.String(), "OrganizationIdentifiers", "OrganizationIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2774,6 +2942,42 @@ func (m *Organization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -3172,6 +3376,26 @@ func (m *ListOrganizationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -3839,6 +4063,74 @@ func (m *CreateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
@@ -3958,6 +4250,39 @@ func (m *UpdateOrganizationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrganization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrganization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrganization(dAtA[iNdEx:])
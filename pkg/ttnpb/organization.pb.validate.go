@@ -86,6 +86,18 @@ func (m *Organization) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "deleted_at":
+
+			if v, ok := interface{}(m.GetDeletedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OrganizationValidationError{
+						field:  "deleted_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "name":
 
 			if utf8.RuneCountInString(m.GetName()) > 50 {
@@ -462,6 +474,8 @@ func (m *ListOrganizationsRequest) ValidateFields(paths ...string) error {
 
 		case "page":
 			// no validation rules for Page
+		case "deleted":
+			// no validation rules for Deleted
 		default:
 			return ListOrganizationsRequestValidationError{
 				field:  name,
@@ -992,6 +1006,27 @@ func (m *CreateOrganizationAPIKeyRequest) ValidateFields(paths ...string) error
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateOrganizationAPIKeyRequestValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			if len(m.GetAllowedCIDRs()) > 32 {
+				return CreateOrganizationAPIKeyRequestValidationError{
+					field:  "allowed_cidrs",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
 		default:
 			return CreateOrganizationAPIKeyRequestValidationError{
 				field:  name,
@@ -1098,6 +1133,18 @@ func (m *UpdateOrganizationAPIKeyRequest) ValidateFields(paths ...string) error
 				}
 			}
 
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return UpdateOrganizationAPIKeyRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return UpdateOrganizationAPIKeyRequestValidationError{
 				field:  name,
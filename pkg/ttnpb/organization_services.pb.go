@@ -36,60 +36,62 @@ func init() {
 }
 
 var fileDescriptor_1a990e3af7846fd3 = []byte{
-	// 840 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x4d, 0x48, 0x24, 0x47,
-	0x14, 0xc7, 0xbb, 0x4c, 0x32, 0x90, 0x52, 0x94, 0x14, 0x21, 0x81, 0x8e, 0x29, 0x42, 0x27, 0xc4,
-	0x0f, 0x9c, 0x6e, 0xa2, 0x22, 0x28, 0x12, 0xf1, 0x8b, 0x89, 0x51, 0x92, 0xa0, 0xe4, 0xe2, 0x45,
-	0x7a, 0xc6, 0xb2, 0xa7, 0x99, 0xb1, 0xab, 0xd3, 0x55, 0xa3, 0x4c, 0xc4, 0x20, 0x39, 0x04, 0x6f,
-	0x59, 0x76, 0x2f, 0xcb, 0x1e, 0xd6, 0x65, 0xf7, 0xb0, 0x2e, 0x0b, 0x8b, 0x7b, 0xf3, 0xe8, 0xd1,
-	0xa3, 0xb0, 0x17, 0x2f, 0x0b, 0x4e, 0xf7, 0x1e, 0x3c, 0x7a, 0xf4, 0xb8, 0x74, 0x75, 0xcf, 0x6e,
-	0xf7, 0xcc, 0x38, 0xed, 0x38, 0xb7, 0xee, 0xea, 0x57, 0xef, 0xfd, 0xea, 0xff, 0xea, 0xff, 0x66,
-	0x60, 0xba, 0x48, 0x1d, 0x7d, 0x5b, 0xb7, 0xd2, 0x8c, 0xeb, 0xb9, 0x82, 0xa6, 0xdb, 0xa6, 0x46,
-	0x1d, 0x43, 0xb7, 0xcc, 0xbf, 0x75, 0x6e, 0x52, 0x6b, 0x8d, 0x11, 0x67, 0xcb, 0xcc, 0x11, 0xa6,
-	0xda, 0x0e, 0xe5, 0x14, 0x75, 0x73, 0x6e, 0xa9, 0xe1, 0x16, 0x75, 0x6b, 0x44, 0x4e, 0x1b, 0x26,
-	0xcf, 0x97, 0xb2, 0x6a, 0x8e, 0x6e, 0x6a, 0x06, 0x35, 0xa8, 0x26, 0xc2, 0xb2, 0xa5, 0x0d, 0xf1,
-	0x26, 0x5e, 0xc4, 0x53, 0xb0, 0x5d, 0xee, 0x35, 0x28, 0x35, 0x8a, 0x44, 0x94, 0xd1, 0x2d, 0x8b,
-	0x72, 0x51, 0x24, 0x4c, 0x2e, 0x7f, 0x13, 0x7e, 0xfd, 0x90, 0x83, 0x6c, 0xda, 0xbc, 0x1c, 0x7e,
-	0xfc, 0xbe, 0x1e, 0xd4, 0x5c, 0x27, 0x16, 0x37, 0x37, 0x4c, 0xe2, 0x54, 0x33, 0xfc, 0xd0, 0xfc,
-	0x34, 0x61, 0x14, 0xae, 0x8f, 0x72, 0x4c, 0x23, 0xcf, 0xc3, 0x2c, 0xc3, 0x6f, 0x3f, 0x83, 0x5f,
-	0xfe, 0x1e, 0xd9, 0xb6, 0x4c, 0x0c, 0x93, 0x71, 0xa7, 0x8c, 0xee, 0x03, 0x98, 0x9a, 0x75, 0x88,
-	0xce, 0x09, 0x1a, 0x50, 0xe3, 0x4a, 0xa8, 0xc1, 0x7a, 0x7c, 0xdb, 0x5f, 0x25, 0xc2, 0xb8, 0xdc,
-	0x5b, 0x1b, 0x1a, 0x0d, 0x52, 0xa6, 0xfe, 0x7d, 0xf3, 0xee, 0x41, 0xc7, 0xb8, 0x32, 0xaa, 0x95,
-	0x18, 0x71, 0x98, 0xb6, 0x93, 0xa3, 0xc5, 0xa2, 0x9e, 0xa5, 0x8e, 0xce, 0xa9, 0xa3, 0xfa, 0x6b,
-	0x6b, 0xe6, 0x3a, 0xab, 0x3e, 0xec, 0xc6, 0xce, 0xc3, 0x26, 0xc0, 0x20, 0xfa, 0x0f, 0xc0, 0x4f,
-	0x32, 0x84, 0xa3, 0x1f, 0x6b, 0xcb, 0x64, 0x08, 0x6f, 0x1d, 0x67, 0x5c, 0xe0, 0x8c, 0xa0, 0x9f,
-	0xe2, 0x85, 0xb4, 0x9d, 0xd8, 0xad, 0xf0, 0x89, 0x6a, 0x16, 0x76, 0xd1, 0x01, 0x80, 0x9f, 0x2e,
-	0x99, 0x8c, 0xa3, 0xfe, 0xda, 0x0a, 0xfe, 0x6a, 0xb4, 0x0a, 0xab, 0xb2, 0x7c, 0xdb, 0x8c, 0x85,
-	0x29, 0xbf, 0x09, 0x98, 0x5f, 0x50, 0x77, 0x1c, 0x66, 0x75, 0x0c, 0xdd, 0x49, 0x2d, 0xf4, 0x3f,
-	0x80, 0xa9, 0x3f, 0xed, 0xf5, 0x86, 0xfd, 0x0b, 0xd6, 0x5b, 0x17, 0x6c, 0x52, 0x30, 0x8e, 0xc9,
-	0x4d, 0x05, 0x53, 0x1b, 0x09, 0xe6, 0x37, 0x8f, 0xc1, 0xd4, 0x1c, 0x29, 0x12, 0x4e, 0x50, 0x5f,
-	0xb3, 0x2a, 0x0b, 0x1f, 0x6f, 0xba, 0xfc, 0x95, 0x1a, 0xd8, 0x44, 0xad, 0xda, 0x44, 0x9d, 0xf7,
-	0x6d, 0xa2, 0xf4, 0x0b, 0x10, 0x65, 0xf0, 0xbb, 0x84, 0xce, 0xed, 0x0e, 0x3f, 0xeb, 0x84, 0x28,
-	0x9a, 0x7d, 0x3a, 0x97, 0x23, 0x8c, 0xa1, 0x7f, 0x20, 0xf4, 0x1b, 0xb5, 0x2c, 0xac, 0xd0, 0x0a,
-	0x4f, 0x4d, 0x60, 0x90, 0x40, 0xd1, 0x04, 0xcf, 0x00, 0xea, 0x4b, 0xe2, 0x09, 0xcd, 0x87, 0x1e,
-	0x03, 0xd8, 0x15, 0xb8, 0x68, 0xfa, 0x8f, 0x85, 0x45, 0x52, 0x46, 0x5a, 0xb2, 0xc7, 0x82, 0xc8,
-	0x6a, 0xa7, 0xea, 0x50, 0x82, 0xcf, 0xca, 0xbc, 0x40, 0x99, 0x52, 0x26, 0x5a, 0xbe, 0xd4, 0xfe,
-	0x70, 0x48, 0x17, 0x48, 0x59, 0x38, 0xed, 0x11, 0x80, 0x9d, 0xbe, 0x42, 0x41, 0x56, 0x86, 0xd4,
-	0xa4, 0x7b, 0x1e, 0x06, 0x56, 0xf1, 0xbe, 0x6e, 0x8c, 0xc7, 0x94, 0x19, 0xc1, 0x37, 0x89, 0xda,
-	0xe0, 0xf3, 0xd5, 0xfb, 0x3c, 0x43, 0x42, 0x36, 0x34, 0x94, 0x30, 0x0c, 0x6e, 0xa7, 0xdb, 0xa2,
-	0xe0, 0x9a, 0x47, 0xb3, 0x77, 0xe7, 0xd2, 0x76, 0x0a, 0xa4, 0x2c, 0xc6, 0xc3, 0x4b, 0x00, 0xbb,
-	0x02, 0x93, 0xdd, 0xd4, 0xde, 0x7a, 0x0b, 0xde, 0x0e, 0x73, 0x59, 0x60, 0x2e, 0xc9, 0x99, 0x76,
-	0x30, 0x75, 0xdb, 0x5c, 0x2b, 0x90, 0xb2, 0x1a, 0x1a, 0xf3, 0xa0, 0x03, 0xf6, 0x64, 0x08, 0x9f,
-	0x8d, 0x8c, 0x17, 0x34, 0x9c, 0x20, 0x6a, 0x34, 0xb8, 0xca, 0xdc, 0xd7, 0x60, 0x4f, 0x3c, 0x8e,
-	0xd9, 0xd4, 0x62, 0x44, 0x79, 0x05, 0xc4, 0x29, 0x9e, 0x03, 0xf4, 0x73, 0xeb, 0xc7, 0x88, 0x4e,
-	0xc1, 0x55, 0x82, 0x72, 0xed, 0x65, 0x10, 0xb3, 0x35, 0x69, 0xb4, 0xa2, 0x17, 0x00, 0xf6, 0xac,
-	0x24, 0x29, 0xb4, 0x92, 0xac, 0xd0, 0x4d, 0xf3, 0xec, 0x57, 0xa1, 0xc7, 0x9c, 0x3c, 0xd5, 0xde,
-	0x61, 0x84, 0x73, 0x5f, 0x03, 0xf8, 0x85, 0x6f, 0xce, 0x68, 0x7d, 0x86, 0x46, 0x93, 0xfc, 0x1b,
-	0x0b, 0xbf, 0xf1, 0x37, 0x2b, 0x16, 0xa5, 0x64, 0x04, 0xf6, 0x34, 0x6a, 0x17, 0x7b, 0xe6, 0x29,
-	0x38, 0xad, 0x60, 0x70, 0x56, 0xc1, 0xe0, 0xbc, 0x82, 0xa5, 0x8b, 0x0a, 0x96, 0x2e, 0x2b, 0x58,
-	0xba, 0xaa, 0x60, 0xe9, 0xba, 0x82, 0xc1, 0x9e, 0x8b, 0xc1, 0xbe, 0x8b, 0xa5, 0x43, 0x17, 0x83,
-	0x23, 0x17, 0x4b, 0xc7, 0x2e, 0x96, 0x4e, 0x5c, 0x2c, 0x9d, 0xba, 0x18, 0x9c, 0xb9, 0x18, 0x9c,
-	0xbb, 0x58, 0xba, 0x70, 0x31, 0xb8, 0x74, 0xb1, 0x74, 0xe5, 0x62, 0x70, 0xed, 0x62, 0x69, 0xcf,
-	0xc3, 0xd2, 0xbe, 0x87, 0xc1, 0x3d, 0x0f, 0x4b, 0x0f, 0x3d, 0x0c, 0x9e, 0x78, 0x58, 0x3a, 0xf4,
-	0xb0, 0x74, 0xe4, 0x61, 0x70, 0xec, 0x61, 0x70, 0xe2, 0x61, 0xb0, 0x3a, 0x64, 0x50, 0x95, 0xe7,
-	0x09, 0xcf, 0x9b, 0x96, 0xc1, 0x54, 0x8b, 0xf0, 0x6d, 0xea, 0x14, 0xb4, 0xf8, 0x3f, 0x26, 0xbb,
-	0x60, 0x68, 0x9c, 0x5b, 0x76, 0x36, 0x9b, 0x12, 0x4d, 0x1b, 0x79, 0x1f, 0x00, 0x00, 0xff, 0xff,
-	0xea, 0x58, 0xaf, 0x6e, 0x47, 0x0a, 0x00, 0x00,
+	// 878 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4f, 0x6c, 0xe3, 0x44,
+	0x14, 0xc6, 0x3d, 0x0b, 0x64, 0xc5, 0xec, 0x6a, 0x57, 0x8c, 0x10, 0x48, 0x66, 0x19, 0x21, 0x83,
+	0xb6, 0xd9, 0x68, 0x33, 0x86, 0x76, 0xb5, 0xd2, 0x56, 0x15, 0x55, 0xff, 0x29, 0x94, 0x56, 0x50,
+	0xa5, 0xe2, 0xd2, 0x4b, 0xe5, 0xa4, 0x53, 0xc7, 0x4a, 0xea, 0x31, 0x9e, 0x49, 0x4b, 0x28, 0x45,
+	0x15, 0x07, 0xd4, 0x1b, 0x08, 0x2e, 0x88, 0x03, 0x45, 0x70, 0xa0, 0x08, 0x09, 0x95, 0x5b, 0x8f,
+	0x3d, 0xf6, 0x58, 0x09, 0x21, 0xf5, 0xd8, 0xd8, 0x1c, 0x7a, 0xec, 0xb1, 0x47, 0xe4, 0xb1, 0xc3,
+	0xda, 0x49, 0x1a, 0x37, 0x4d, 0x6f, 0xf1, 0xcc, 0x9b, 0xf7, 0xfd, 0xe6, 0x9b, 0x79, 0x6f, 0x02,
+	0xf3, 0x35, 0xe6, 0x1a, 0x1b, 0x86, 0x9d, 0xe7, 0xc2, 0x28, 0x57, 0x75, 0xc3, 0xb1, 0x74, 0xe6,
+	0x9a, 0x86, 0x6d, 0x7d, 0x6e, 0x08, 0x8b, 0xd9, 0xcb, 0x9c, 0xba, 0xeb, 0x56, 0x99, 0x72, 0xe2,
+	0xb8, 0x4c, 0x30, 0x74, 0x4f, 0x08, 0x9b, 0x44, 0x4b, 0xc8, 0xfa, 0x88, 0x9a, 0x37, 0x2d, 0x51,
+	0xa9, 0x97, 0x48, 0x99, 0xad, 0xe9, 0x26, 0x33, 0x99, 0x2e, 0xc3, 0x4a, 0xf5, 0x55, 0xf9, 0x25,
+	0x3f, 0xe4, 0xaf, 0x70, 0xb9, 0xfa, 0xc0, 0x64, 0xcc, 0xac, 0x51, 0x29, 0x63, 0xd8, 0x36, 0x13,
+	0x52, 0x24, 0x4a, 0xae, 0xbe, 0x11, 0xcd, 0xfe, 0x9f, 0x83, 0xae, 0x39, 0xa2, 0x11, 0x4d, 0xbe,
+	0xdd, 0x09, 0x6a, 0xad, 0x50, 0x5b, 0x58, 0xab, 0x16, 0x75, 0x5b, 0x19, 0xde, 0xe9, 0xbd, 0x9b,
+	0x28, 0x0a, 0x77, 0x46, 0xb9, 0x96, 0x59, 0x11, 0x51, 0x96, 0xe1, 0x7f, 0x6e, 0xc3, 0x57, 0x3f,
+	0x8e, 0x2d, 0x2b, 0x52, 0xd3, 0xe2, 0xc2, 0x6d, 0xa0, 0xef, 0x00, 0xcc, 0x4c, 0xb9, 0xd4, 0x10,
+	0x14, 0x3d, 0x22, 0x49, 0x27, 0x48, 0x38, 0x9e, 0x5c, 0xf6, 0x69, 0x9d, 0x72, 0xa1, 0x3e, 0x68,
+	0x0f, 0x8d, 0x07, 0x69, 0xe3, 0x5f, 0xfd, 0xfd, 0xef, 0xf7, 0xb7, 0x9e, 0x69, 0x4f, 0xf4, 0x3a,
+	0xa7, 0x2e, 0xd7, 0x37, 0xcb, 0xac, 0x56, 0x33, 0x4a, 0xcc, 0x35, 0x04, 0x73, 0x49, 0x30, 0xb6,
+	0x6c, 0xad, 0xf0, 0xd6, 0x8f, 0xad, 0xc4, 0x7e, 0xf8, 0x28, 0xc8, 0xa1, 0xaf, 0x01, 0x7c, 0xa1,
+	0x40, 0x05, 0x7a, 0xd8, 0x2e, 0x53, 0xa0, 0xa2, 0x7f, 0x9c, 0x67, 0x12, 0x67, 0x04, 0xbd, 0x97,
+	0x14, 0xd2, 0x37, 0xe3, 0x9f, 0x92, 0xa8, 0x6d, 0x60, 0x0b, 0xed, 0x02, 0xf8, 0xe2, 0xbc, 0xc5,
+	0x05, 0xca, 0xb6, 0x2b, 0x04, 0xa3, 0x71, 0x15, 0xde, 0x62, 0x79, 0xb3, 0x17, 0x0b, 0xd7, 0x3e,
+	0x92, 0x30, 0x1f, 0xa0, 0x7b, 0x49, 0x98, 0xa5, 0xa7, 0xe8, 0x5a, 0x6e, 0xa1, 0x6f, 0x00, 0xcc,
+	0x7c, 0xe2, 0xac, 0x74, 0x3d, 0xbf, 0x70, 0xbc, 0x7f, 0xc3, 0xc6, 0x24, 0xe3, 0x53, 0xb5, 0xa7,
+	0x61, 0xa4, 0x9b, 0x61, 0xc1, 0xe1, 0x71, 0x98, 0x99, 0xa6, 0x35, 0x2a, 0x28, 0x1a, 0xea, 0xa5,
+	0x32, 0xfb, 0xfc, 0xa6, 0xab, 0xaf, 0x91, 0xb0, 0x4c, 0x48, 0xab, 0x4c, 0xc8, 0x4c, 0x50, 0x26,
+	0x5a, 0x56, 0x82, 0x68, 0xb9, 0xb7, 0x52, 0x4e, 0x6e, 0x0b, 0x7d, 0x01, 0x6f, 0x17, 0x29, 0x17,
+	0xcc, 0xbd, 0x01, 0xd5, 0x77, 0xa5, 0x6a, 0x4e, 0xcb, 0xa6, 0xa9, 0xea, 0x6e, 0x24, 0xf9, 0x19,
+	0x7c, 0x69, 0xa1, 0xee, 0x9a, 0x37, 0xa0, 0x4d, 0xa4, 0x76, 0x36, 0xf7, 0x30, 0x55, 0xdb, 0x09,
+	0x04, 0x87, 0x7f, 0xbd, 0x03, 0x51, 0x5c, 0x63, 0xa2, 0x5c, 0xa6, 0x9c, 0xa3, 0x2f, 0x21, 0x0c,
+	0x2e, 0x68, 0x51, 0xb6, 0x80, 0x7e, 0xa8, 0xda, 0x02, 0xc3, 0x04, 0x9a, 0x2e, 0xa9, 0x1e, 0xa1,
+	0xa1, 0x74, 0x47, 0x42, 0xc5, 0x9f, 0x00, 0xbc, 0x1b, 0x76, 0x8f, 0x89, 0x85, 0xd9, 0x39, 0xda,
+	0x40, 0x7a, 0x7a, 0x6f, 0x09, 0x23, 0x5b, 0x37, 0xb4, 0x03, 0x25, 0x9c, 0xd6, 0x66, 0x24, 0xca,
+	0xb8, 0x36, 0xda, 0x77, 0x31, 0x07, 0x4d, 0x31, 0x5f, 0xa5, 0x0d, 0xd9, 0x61, 0x7e, 0x04, 0xf0,
+	0x4e, 0xe0, 0x50, 0x98, 0x95, 0x23, 0x92, 0x56, 0xdf, 0x51, 0x60, 0x0b, 0xef, 0xf5, 0xee, 0x78,
+	0x5c, 0x9b, 0x94, 0x7c, 0x63, 0x68, 0x00, 0xbe, 0xc0, 0xbd, 0x97, 0x0b, 0x34, 0x62, 0x43, 0x8f,
+	0x53, 0x9a, 0xe0, 0xd5, 0x7c, 0x9b, 0x93, 0x5c, 0x33, 0x68, 0xea, 0xfa, 0x5c, 0xfa, 0x66, 0x95,
+	0x36, 0x64, 0xb5, 0xfd, 0x01, 0xe0, 0xdd, 0xb0, 0xb9, 0x5c, 0x76, 0xbc, 0x9d, 0xad, 0xe7, 0x6a,
+	0x98, 0x45, 0x89, 0x39, 0xaf, 0x16, 0x06, 0xc1, 0x34, 0x1c, 0x6b, 0xb9, 0x4a, 0x1b, 0x24, 0x6a,
+	0x48, 0xbb, 0xb7, 0xe0, 0xfd, 0x02, 0x15, 0x53, 0xb1, 0xb6, 0x8a, 0x86, 0x53, 0x4c, 0x8d, 0x07,
+	0xb7, 0x98, 0x87, 0xba, 0xac, 0x49, 0xc6, 0x71, 0x87, 0xd9, 0x9c, 0x6a, 0x7f, 0x02, 0xb9, 0x8b,
+	0xdf, 0x00, 0x7a, 0xbf, 0xff, 0x6d, 0xc4, 0xbb, 0xff, 0x12, 0x45, 0xe5, 0xc1, 0x32, 0xc8, 0x37,
+	0x25, 0xed, 0x49, 0x41, 0xbf, 0x03, 0x78, 0x7f, 0x31, 0xcd, 0xa1, 0xc5, 0x74, 0x87, 0x2e, 0xeb,
+	0x6a, 0x1f, 0x4a, 0x3f, 0xa6, 0xd5, 0xf1, 0xc1, 0x36, 0x23, 0x2b, 0xf7, 0x2f, 0x00, 0x5f, 0x09,
+	0x8a, 0x33, 0xae, 0xcf, 0xd1, 0x93, 0xb4, 0xfa, 0x4d, 0x84, 0x5f, 0xfa, 0x56, 0x27, 0xa2, 0xb4,
+	0x82, 0xc4, 0x9e, 0x40, 0x83, 0x62, 0x4f, 0xfe, 0x02, 0x8e, 0x9a, 0x18, 0x1c, 0x37, 0x31, 0x38,
+	0x69, 0x62, 0xe5, 0xb4, 0x89, 0x95, 0xb3, 0x26, 0x56, 0xce, 0x9b, 0x58, 0xb9, 0x68, 0x62, 0xb0,
+	0xed, 0x61, 0xb0, 0xe3, 0x61, 0x65, 0xcf, 0xc3, 0x60, 0xdf, 0xc3, 0xca, 0x81, 0x87, 0x95, 0x43,
+	0x0f, 0x2b, 0x47, 0x1e, 0x06, 0xc7, 0x1e, 0x06, 0x27, 0x1e, 0x56, 0x4e, 0x3d, 0x0c, 0xce, 0x3c,
+	0xac, 0x9c, 0x7b, 0x18, 0x5c, 0x78, 0x58, 0xd9, 0xf6, 0xb1, 0xb2, 0xe3, 0x63, 0xf0, 0xad, 0x8f,
+	0x95, 0x1f, 0x7c, 0x0c, 0x7e, 0xf6, 0xb1, 0xb2, 0xe7, 0x63, 0x65, 0xdf, 0xc7, 0xe0, 0xc0, 0xc7,
+	0xe0, 0xd0, 0xc7, 0x60, 0xe9, 0xb1, 0xc9, 0x88, 0xa8, 0x50, 0x51, 0xb1, 0x6c, 0x93, 0x13, 0x9b,
+	0x8a, 0x0d, 0xe6, 0x56, 0xf5, 0xe4, 0x3f, 0x45, 0xa7, 0x6a, 0xea, 0x42, 0xd8, 0x4e, 0xa9, 0x94,
+	0x91, 0x87, 0x36, 0xf2, 0xdf, 0x00, 0x1e, 0x72, 0x58, 0x96, 0x3f, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -115,6 +117,11 @@ type OrganizationRegistryClient interface {
 	List(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*Organizations, error)
 	Update(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
 	Delete(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted organization.
+	Restore(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the organization. This permanently deletes the organization and the data
+	// related to it, after which its ID can be reused.
+	Purge(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type organizationRegistryClient struct {
@@ -170,6 +177,24 @@ func (c *organizationRegistryClient) Delete(ctx context.Context, in *Organizatio
 	return out, nil
 }
 
+func (c *organizationRegistryClient) Restore(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.OrganizationRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationRegistryClient) Purge(ctx context.Context, in *OrganizationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.OrganizationRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrganizationRegistryServer is the server API for OrganizationRegistry service.
 type OrganizationRegistryServer interface {
 	// Create a new organization. This also sets the given user as
@@ -183,6 +208,11 @@ type OrganizationRegistryServer interface {
 	List(context.Context, *ListOrganizationsRequest) (*Organizations, error)
 	Update(context.Context, *UpdateOrganizationRequest) (*Organization, error)
 	Delete(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted organization.
+	Restore(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
+	// Purge the organization. This permanently deletes the organization and the data
+	// related to it, after which its ID can be reused.
+	Purge(context.Context, *OrganizationIdentifiers) (*types.Empty, error)
 }
 
 func RegisterOrganizationRegistryServer(s *grpc.Server, srv OrganizationRegistryServer) {
@@ -279,6 +309,42 @@ func _OrganizationRegistry_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrganizationRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.OrganizationRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationRegistryServer).Restore(ctx, req.(*OrganizationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrganizationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.OrganizationRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationRegistryServer).Purge(ctx, req.(*OrganizationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _OrganizationRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.OrganizationRegistry",
 	HandlerType: (*OrganizationRegistryServer)(nil),
@@ -303,6 +369,14 @@ var _OrganizationRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _OrganizationRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _OrganizationRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _OrganizationRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/organization_services.proto",
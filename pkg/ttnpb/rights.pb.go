@@ -10,10 +10,13 @@ import (
 	reflect "reflect"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 )
 
@@ -22,6 +25,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -331,7 +335,16 @@ type APIKey struct {
 	// User-defined (friendly) name for the API key.
 	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	// Rights that are granted to this API key.
-	Rights               []Right  `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	Rights []Right `protobuf:"varint,4,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	// Time after which the API key can no longer be used.
+	// The API key does not expire if this is not set.
+	ExpiresAt *time.Time `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	// Time when the API key was last used.
+	// This is recorded by the Identity Server and updated periodically.
+	LastUsedAt *time.Time `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3,stdtime" json:"last_used_at,omitempty"`
+	// Source IP address ranges (in CIDR notation) from which the API key can be used.
+	// The API key can be used from any source if this is empty.
+	AllowedCIDRs         []string `protobuf:"bytes,7,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -396,6 +409,27 @@ func (m *APIKey) GetRights() []Right {
 	return nil
 }
 
+func (m *APIKey) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetLastUsedAt() *time.Time {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return nil
+}
+
+func (m *APIKey) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type APIKeys struct {
 	APIKeys              []*APIKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -601,82 +635,90 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x3b, 0x6c, 0xdb, 0x56,
-	0x14, 0xe5, 0xd3, 0x3f, 0xcf, 0x91, 0xf3, 0xf2, 0x12, 0x3b, 0x8a, 0xec, 0x3c, 0x39, 0x72, 0x3e,
-	0xae, 0x6b, 0x49, 0xad, 0xdd, 0xcf, 0x52, 0x14, 0x20, 0x25, 0xda, 0xa1, 0xcd, 0x48, 0x2e, 0x49,
-	0xc7, 0x88, 0x17, 0x81, 0xb6, 0x19, 0x99, 0xb0, 0x43, 0x0a, 0x14, 0xe3, 0xd4, 0x9d, 0x82, 0x4e,
-	0x46, 0x81, 0x02, 0x45, 0xa7, 0x8e, 0x45, 0xbb, 0x04, 0xe8, 0x92, 0xad, 0x19, 0x33, 0x7a, 0xab,
-	0xc7, 0x4c, 0x46, 0x44, 0x2d, 0x19, 0x33, 0x06, 0x9e, 0x0a, 0x91, 0x94, 0xf9, 0x91, 0x14, 0xf7,
-	0xb3, 0x3d, 0xbd, 0x7b, 0xee, 0xe5, 0x39, 0xe7, 0xde, 0xfb, 0x20, 0x48, 0xf6, 0x74, 0x43, 0x7e,
-	0x2a, 0x6b, 0x85, 0x96, 0x29, 0x6f, 0xed, 0x96, 0xe4, 0xa6, 0x5a, 0x32, 0xd4, 0xc6, 0x8e, 0xd9,
-	0x2a, 0x36, 0x0d, 0xdd, 0xd4, 0xf1, 0xa8, 0x69, 0x6a, 0x45, 0x17, 0x53, 0xdc, 0x5f, 0xc8, 0xd2,
-	0x0d, 0xd5, 0xdc, 0x79, 0xb2, 0x59, 0xdc, 0xd2, 0x1f, 0x97, 0x14, 0x6d, 0x5f, 0x3f, 0x68, 0x1a,
-	0xfa, 0xb7, 0x07, 0x25, 0x1b, 0xbc, 0x55, 0x68, 0x28, 0x5a, 0x61, 0x5f, 0xde, 0x53, 0xb7, 0x65,
-	0x53, 0x29, 0xf5, 0x1d, 0x9c, 0x92, 0xd9, 0x82, 0xaf, 0x44, 0x43, 0x6f, 0xe8, 0x4e, 0xf2, 0xe6,
-	0x93, 0x47, 0xf6, 0x2f, 0xfb, 0x87, 0x7d, 0x72, 0xe1, 0xd3, 0xfd, 0x0c, 0xd5, 0x6d, 0x45, 0x33,
-	0xd5, 0x47, 0xaa, 0x62, 0xb8, 0x34, 0xf3, 0x8b, 0x30, 0x21, 0xd8, 0xb4, 0xf1, 0x57, 0x30, 0xe1,
-	0x08, 0xc8, 0x80, 0xa9, 0xe8, 0xcc, 0xe8, 0xfc, 0x58, 0x31, 0xa8, 0xa0, 0x68, 0xe3, 0x98, 0xf4,
-	0x29, 0x03, 0x7f, 0x06, 0xc9, 0x7c, 0xfc, 0x7b, 0x10, 0x41, 0x40, 0x70, 0x73, 0xf2, 0x3f, 0x02,
-	0x98, 0xa0, 0x57, 0xb9, 0x15, 0xe5, 0x00, 0x8f, 0xc3, 0x88, 0xba, 0x9d, 0x01, 0x53, 0x60, 0xe6,
-	0x02, 0x93, 0xb0, 0x4e, 0x72, 0x11, 0xae, 0x22, 0x44, 0xd4, 0x6d, 0x8c, 0x60, 0x74, 0x57, 0x39,
-	0xc8, 0x44, 0xba, 0x01, 0xa1, 0x7b, 0xc4, 0x13, 0x30, 0xa6, 0xc9, 0x8f, 0x95, 0x4c, 0xd4, 0xc6,
-	0x26, 0x4f, 0x99, 0x98, 0x11, 0xc9, 0xcc, 0x0b, 0xf6, 0xa5, 0x8f, 0x4f, 0xec, 0x3f, 0xf0, 0xe1,
-	0x60, 0xd2, 0xa1, 0xd3, 0xc2, 0x5f, 0xc3, 0x94, 0xdc, 0x54, 0xeb, 0xbb, 0xca, 0x81, 0x23, 0x6d,
-	0x64, 0x7e, 0x3c, 0x5c, 0xca, 0x81, 0x32, 0x23, 0xd6, 0x49, 0xae, 0x97, 0x26, 0x24, 0xe5, 0xa6,
-	0xda, 0x3d, 0xe4, 0xff, 0x04, 0xf0, 0x62, 0x59, 0xdf, 0xdb, 0x93, 0x37, 0x75, 0x43, 0x36, 0x75,
-	0x03, 0x7f, 0x03, 0xa3, 0xea, 0x76, 0xcb, 0x56, 0x38, 0x32, 0x5f, 0x08, 0xd7, 0xaa, 0x19, 0x0d,
-	0x59, 0x53, 0xbf, 0x93, 0x4d, 0x55, 0xd7, 0x6a, 0xc6, 0x5a, 0x4b, 0x31, 0x38, 0xcf, 0x75, 0x06,
-	0x9d, 0x32, 0xf1, 0x1f, 0xba, 0x4c, 0x8f, 0x4e, 0x72, 0xd4, 0xf1, 0x49, 0x0e, 0x08, 0xdd, 0x5a,
-	0x3e, 0xb1, 0x91, 0x7f, 0x2f, 0x76, 0x39, 0x96, 0x8a, 0xa2, 0xd8, 0x72, 0x2c, 0x15, 0x43, 0xf1,
-	0xe5, 0x58, 0x2a, 0x8e, 0x12, 0xcb, 0xb1, 0x54, 0x02, 0x25, 0xf3, 0x7f, 0x00, 0x78, 0x6d, 0x49,
-	0x31, 0xfd, 0xe4, 0x05, 0xa5, 0xd5, 0xd4, 0xb5, 0x96, 0x82, 0xb9, 0xff, 0x21, 0x22, 0x15, 0x24,
-	0x5f, 0xf8, 0x47, 0xe4, 0xcf, 0x65, 0x2b, 0xc2, 0xb4, 0x9f, 0x69, 0x0b, 0x33, 0x30, 0xbd, 0xe5,
-	0xbf, 0x70, 0xbb, 0x37, 0x19, 0x2e, 0x1f, 0xd0, 0x17, 0x4c, 0x99, 0xfd, 0x6b, 0x14, 0xc6, 0xed,
-	0xcf, 0xe3, 0xcb, 0x30, 0x6d, 0x13, 0xa8, 0xab, 0x9a, 0xbd, 0x57, 0x88, 0xc2, 0x57, 0xe0, 0x25,
-	0x81, 0x5b, 0xba, 0x27, 0xd5, 0xd7, 0x44, 0x56, 0xa8, 0x73, 0xd5, 0xc5, 0x1a, 0x02, 0xf8, 0x06,
-	0xbc, 0xee, 0xbb, 0x14, 0x59, 0x49, 0xe2, 0xaa, 0x4b, 0x62, 0x9d, 0xa1, 0x45, 0xae, 0x8c, 0x22,
-	0x78, 0x0a, 0x4e, 0x0e, 0x0a, 0xd3, 0xab, 0x5c, 0x7d, 0x85, 0x7d, 0x28, 0xa2, 0x28, 0x1e, 0x83,
-	0x97, 0x7d, 0x88, 0x0a, 0xcb, 0xb3, 0x12, 0x8b, 0x62, 0xf8, 0x26, 0xbc, 0xe1, 0xbb, 0xa6, 0xd7,
-	0xa4, 0x7b, 0x35, 0x81, 0xdb, 0x60, 0x2b, 0xf5, 0x32, 0xcf, 0xb1, 0x55, 0x49, 0x44, 0xf1, 0x50,
-	0x6d, 0x7a, 0x75, 0x95, 0xe7, 0xca, 0xb4, 0xc4, 0xd5, 0xaa, 0x62, 0x9d, 0xe7, 0x44, 0x09, 0x25,
-	0x70, 0x1e, 0x92, 0x61, 0x88, 0xb2, 0xc0, 0xd2, 0x12, 0x8b, 0x92, 0x78, 0x12, 0x66, 0x7c, 0x98,
-	0x25, 0x5a, 0x62, 0xd7, 0xe9, 0x87, 0x6e, 0x85, 0x14, 0x26, 0x30, 0x3b, 0x28, 0xea, 0x66, 0x5f,
-	0xc0, 0x13, 0xf0, 0x9a, 0x2f, 0xee, 0x72, 0x73, 0x92, 0x61, 0xc8, 0x9b, 0x5e, 0xd0, 0xcd, 0x1d,
-	0x09, 0x49, 0xac, 0x09, 0x4b, 0x74, 0x95, 0xdb, 0xf0, 0x0b, 0xb8, 0x88, 0xa7, 0x61, 0x6e, 0x28,
-	0xc4, 0xad, 0x93, 0xc6, 0x18, 0x8e, 0xfa, 0x55, 0xf2, 0x3c, 0x1a, 0xc5, 0x59, 0x38, 0xee, 0xdc,
-	0xf9, 0x44, 0x3b, 0x2d, 0xbb, 0x84, 0x6f, 0xc1, 0xa9, 0xfe, 0x58, 0xa8, 0x73, 0x08, 0xdf, 0x85,
-	0xd3, 0x1f, 0x40, 0x9d, 0x35, 0xf0, 0x32, 0x9e, 0x83, 0x33, 0x1f, 0x00, 0x96, 0x6b, 0x3c, 0x4f,
-	0x33, 0x35, 0x81, 0x96, 0x6a, 0x82, 0x88, 0xb0, 0x67, 0xb7, 0x1f, 0xed, 0x76, 0xfd, 0x8a, 0xd7,
-	0xb0, 0x60, 0xf4, 0x01, 0x57, 0x66, 0xc5, 0xba, 0xc0, 0xd2, 0x15, 0x74, 0xd5, 0xf3, 0x64, 0x10,
-	0x66, 0x5d, 0xe0, 0x24, 0x16, 0x8d, 0x0d, 0x66, 0xef, 0x2f, 0xe4, 0xb0, 0x1f, 0xc7, 0x33, 0xf0,
-	0xd6, 0x39, 0xd5, 0x1c, 0xe4, 0xb5, 0xc1, 0xdc, 0x24, 0x81, 0x5e, 0x5c, 0xe4, 0xca, 0x0e, 0xb7,
-	0x0c, 0xbe, 0x03, 0xf3, 0xc3, 0x31, 0x6b, 0xab, 0x2e, 0xbd, 0xeb, 0x83, 0xbf, 0xda, 0xc3, 0x55,
-	0x6a, 0xeb, 0x55, 0x17, 0x99, 0x1d, 0xdc, 0x48, 0x9e, 0xab, 0xae, 0xa0, 0x09, 0x7c, 0x1d, 0x8e,
-	0xf5, 0xc7, 0xba, 0xfd, 0x9f, 0xc4, 0x57, 0x21, 0x72, 0x42, 0xce, 0xd4, 0xd9, 0xb7, 0x37, 0xf0,
-	0x38, 0xc4, 0xce, 0xad, 0x3b, 0xc8, 0xce, 0x44, 0x10, 0x6f, 0x93, 0x7a, 0xf7, 0xa1, 0x69, 0xc8,
-	0x79, 0xa6, 0xf7, 0x21, 0xce, 0x26, 0x61, 0xca, 0x53, 0xd5, 0x07, 0x0a, 0x4e, 0xc1, 0x4d, 0x9c,
-	0x81, 0x57, 0x83, 0x48, 0x77, 0x02, 0xf2, 0xde, 0xc2, 0xf5, 0x22, 0x01, 0x87, 0xa7, 0xbd, 0xe1,
-	0x0d, 0xc7, 0x7d, 0xae, 0xdd, 0xea, 0x17, 0x6a, 0x3b, 0x76, 0xdb, 0xdb, 0xc8, 0x33, 0x86, 0x12,
-	0x2d, 0xad, 0xb9, 0xa3, 0x75, 0x07, 0xe7, 0xe0, 0x44, 0x28, 0xad, 0xe6, 0xba, 0x6a, 0x03, 0xee,
-	0x7a, 0x8f, 0x55, 0x0f, 0xd0, 0xf5, 0x75, 0xc6, 0x7b, 0x05, 0xfc, 0x1b, 0xea, 0x98, 0xfb, 0x11,
-	0xbe, 0x0d, 0x6f, 0x0e, 0x08, 0x86, 0x1c, 0x9e, 0xf5, 0xcc, 0x1b, 0x0c, 0x3b, 0xb3, 0xf9, 0x63,
-	0x6f, 0xb6, 0x07, 0x23, 0xef, 0xb3, 0xf7, 0x19, 0x56, 0x10, 0xd1, 0x9c, 0xa7, 0x36, 0x00, 0x74,
-	0xad, 0x2e, 0x0c, 0xf9, 0x62, 0xff, 0x3b, 0x5a, 0xc4, 0xb3, 0xf0, 0xce, 0x79, 0x48, 0xf7, 0x35,
-	0x2a, 0x79, 0x0d, 0x0a, 0x60, 0x83, 0xef, 0xea, 0x27, 0xde, 0xa2, 0x0c, 0x46, 0xb9, 0xd5, 0x3e,
-	0xf5, 0xe6, 0x2e, 0x80, 0x0b, 0xbc, 0xb3, 0xf3, 0x43, 0x1c, 0x0e, 0xbd, 0xb7, 0x0b, 0xc3, 0x54,
-	0x54, 0x2a, 0x75, 0x3a, 0x38, 0xa1, 0xe8, 0x33, 0x6f, 0xed, 0x82, 0x58, 0x9e, 0x47, 0x9f, 0x7b,
-	0xc3, 0x25, 0xb2, 0xd5, 0x4a, 0x9d, 0xab, 0x3e, 0xe0, 0x24, 0x56, 0x44, 0x5f, 0xe0, 0x34, 0xbc,
-	0xe0, 0xae, 0x23, 0xcf, 0xa3, 0x2f, 0xb3, 0xb1, 0xc3, 0xdf, 0x09, 0xc5, 0xfc, 0x06, 0x8e, 0xda,
-	0x04, 0x1c, 0xb7, 0x09, 0x78, 0xdd, 0x26, 0xd4, 0x9b, 0x36, 0xa1, 0xde, 0xb6, 0x09, 0xf5, 0xae,
-	0x4d, 0xa8, 0xf7, 0x6d, 0x02, 0x9e, 0x59, 0x04, 0x1c, 0x5a, 0x84, 0x7a, 0x6e, 0x11, 0xf0, 0xc2,
-	0x22, 0xd4, 0x4b, 0x8b, 0x50, 0xaf, 0x2c, 0x42, 0x1d, 0x59, 0x04, 0x1c, 0x5b, 0x04, 0xbc, 0xb6,
-	0x08, 0xf5, 0xc6, 0x22, 0xe0, 0xad, 0x45, 0xa8, 0x77, 0x16, 0x01, 0xef, 0x2d, 0x42, 0x3d, 0xeb,
-	0x10, 0xea, 0xb0, 0x43, 0xc0, 0x4f, 0x1d, 0x42, 0xfd, 0xd2, 0x21, 0xe0, 0xd7, 0x0e, 0xa1, 0x9e,
-	0x77, 0x08, 0xf5, 0xa2, 0x43, 0xc0, 0xcb, 0x0e, 0x01, 0xaf, 0x3a, 0x04, 0x6c, 0xcc, 0x35, 0xf4,
-	0xa2, 0xb9, 0xa3, 0x98, 0x3b, 0xaa, 0xd6, 0x68, 0x15, 0x35, 0xc5, 0x7c, 0xaa, 0x1b, 0xbb, 0xa5,
-	0xe0, 0x5f, 0xdc, 0xe6, 0x6e, 0xa3, 0x64, 0x9a, 0x5a, 0x73, 0x73, 0x33, 0x61, 0xff, 0xbb, 0x5d,
-	0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x75, 0xa5, 0xe0, 0x9b, 0xa6, 0x0b, 0x00, 0x00,
+	// 1322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3d, 0x70, 0xd3, 0xd8,
+	0x16, 0xc7, 0x75, 0xfd, 0x9d, 0x9b, 0x38, 0x5c, 0x2e, 0x24, 0x18, 0x27, 0x5c, 0x1b, 0x87, 0x0f,
+	0x3f, 0x1e, 0xb6, 0xdf, 0x0b, 0xef, 0xed, 0x36, 0xfb, 0x31, 0x92, 0xad, 0x04, 0x05, 0x63, 0x67,
+	0x25, 0x05, 0x06, 0x1a, 0x8d, 0x12, 0x0b, 0x47, 0x13, 0x47, 0xf2, 0x48, 0xe2, 0x23, 0x5b, 0x31,
+	0x5b, 0x31, 0x5b, 0x31, 0x54, 0x5b, 0xee, 0xec, 0x36, 0xcc, 0x6c, 0x43, 0xb7, 0x94, 0x94, 0x74,
+	0xcb, 0x76, 0x54, 0x59, 0x2c, 0x37, 0x94, 0x94, 0x4c, 0xaa, 0x1d, 0x4b, 0x72, 0x24, 0xd9, 0x0e,
+	0x1f, 0xb3, 0xdd, 0xf5, 0x39, 0xbf, 0x73, 0x74, 0xce, 0xff, 0x9c, 0x7b, 0xc7, 0x90, 0x74, 0x74,
+	0x43, 0xbe, 0x2f, 0x6b, 0x25, 0xd3, 0x92, 0xb7, 0x76, 0x2a, 0x72, 0x57, 0xad, 0x18, 0x6a, 0x7b,
+	0xdb, 0x32, 0xcb, 0x5d, 0x43, 0xb7, 0x74, 0x3c, 0x6b, 0x59, 0x5a, 0xd9, 0x63, 0xca, 0xf7, 0xae,
+	0x64, 0xe9, 0xb6, 0x6a, 0x6d, 0xdf, 0xdd, 0x2c, 0x6f, 0xe9, 0xbb, 0x15, 0x45, 0xbb, 0xa7, 0xef,
+	0x75, 0x0d, 0xfd, 0xc1, 0x5e, 0xc5, 0x81, 0xb7, 0x4a, 0x6d, 0x45, 0x2b, 0xdd, 0x93, 0x3b, 0x6a,
+	0x4b, 0xb6, 0x94, 0xca, 0xd8, 0xc1, 0x4d, 0x99, 0x2d, 0x05, 0x52, 0xb4, 0xf5, 0xb6, 0xee, 0x06,
+	0x6f, 0xde, 0xbd, 0xe3, 0xfc, 0x72, 0x7e, 0x38, 0x27, 0x0f, 0xcf, 0xb5, 0x75, 0xbd, 0xdd, 0x51,
+	0x7c, 0xca, 0x52, 0x77, 0x15, 0xd3, 0x92, 0x77, 0xbb, 0x1e, 0xb0, 0x34, 0xde, 0x82, 0xda, 0x52,
+	0x34, 0x4b, 0xbd, 0xa3, 0x2a, 0x86, 0xd7, 0x47, 0x61, 0x05, 0x26, 0x78, 0xa7, 0x2f, 0xfc, 0x15,
+	0x4c, 0xb8, 0x1d, 0x66, 0x40, 0x3e, 0x5a, 0x9c, 0x5d, 0x9e, 0x2b, 0x87, 0x5b, 0x2c, 0x3b, 0x1c,
+	0x93, 0x3e, 0x60, 0xe0, 0x13, 0x90, 0x2c, 0xc4, 0x7f, 0x00, 0x11, 0x04, 0x78, 0x2f, 0xa6, 0xf0,
+	0x67, 0x04, 0x26, 0xe8, 0x75, 0xee, 0x9a, 0xb2, 0x87, 0xe7, 0x61, 0x44, 0x6d, 0x65, 0x40, 0x1e,
+	0x14, 0xa7, 0x98, 0x84, 0xbd, 0x9f, 0x8b, 0x70, 0x35, 0x3e, 0xa2, 0xb6, 0x30, 0x82, 0xd1, 0x1d,
+	0x65, 0x2f, 0x13, 0x19, 0x38, 0xf8, 0xc1, 0x11, 0x2f, 0xc0, 0x98, 0x26, 0xef, 0x2a, 0x99, 0xa8,
+	0xc3, 0x26, 0x0f, 0x98, 0x98, 0x11, 0xc9, 0x2c, 0xf3, 0x8e, 0x31, 0x50, 0x4f, 0xec, 0xf3, 0xeb,
+	0xc1, 0xdf, 0x42, 0xa8, 0x3c, 0xe8, 0xaa, 0x86, 0x62, 0x4a, 0xb2, 0x95, 0x89, 0xe7, 0x41, 0x71,
+	0x7a, 0x39, 0x5b, 0x76, 0x25, 0x2b, 0x0f, 0x25, 0x2b, 0x8b, 0x43, 0xc9, 0x98, 0xd8, 0xe3, 0xbf,
+	0x72, 0x80, 0x9f, 0xf2, 0x62, 0x68, 0x0b, 0x33, 0x70, 0xa6, 0x23, 0x9b, 0x96, 0x74, 0xd7, 0x54,
+	0x5a, 0x83, 0x14, 0x89, 0x4f, 0x4c, 0x01, 0x07, 0x51, 0x1b, 0xa6, 0xd2, 0xa2, 0x2d, 0xfc, 0x35,
+	0x4c, 0xcb, 0x9d, 0x8e, 0x7e, 0x5f, 0x69, 0x49, 0x5b, 0x6a, 0xcb, 0x30, 0x33, 0xc9, 0x7c, 0xb4,
+	0x38, 0xc5, 0x64, 0x0e, 0x98, 0xf8, 0x13, 0x10, 0x41, 0x79, 0x7b, 0x3f, 0x37, 0x43, 0xbb, 0x40,
+	0x95, 0xab, 0xf1, 0x26, 0x3f, 0xe3, 0xe1, 0xd5, 0x01, 0x5d, 0xe0, 0x60, 0xd2, 0x95, 0xd4, 0xc4,
+	0xdf, 0xc0, 0x94, 0xdc, 0x55, 0xa5, 0x1d, 0x65, 0xcf, 0x1d, 0xcf, 0xf4, 0xf2, 0xfc, 0xa8, 0x1c,
+	0x2e, 0xca, 0x4c, 0xdb, 0xfb, 0xb9, 0x61, 0x18, 0x9f, 0x94, 0xbb, 0xea, 0xe0, 0x50, 0xf8, 0x1d,
+	0xc0, 0x99, 0xaa, 0xde, 0xe9, 0xc8, 0x9b, 0xba, 0x21, 0x5b, 0xba, 0x81, 0xbf, 0x83, 0x51, 0xb5,
+	0x65, 0x3a, 0x53, 0x9a, 0x5e, 0x2e, 0x8d, 0xe6, 0x6a, 0x1a, 0x6d, 0x59, 0x53, 0xbf, 0x97, 0x2d,
+	0x55, 0xd7, 0x9a, 0xc6, 0x86, 0xa9, 0x18, 0x9c, 0xbf, 0x39, 0x0c, 0x3a, 0x60, 0xe2, 0x3f, 0x0e,
+	0xd4, 0x7e, 0xb9, 0x9f, 0xa3, 0x5e, 0xed, 0xe7, 0x00, 0x3f, 0xc8, 0x15, 0x18, 0x58, 0xe4, 0xf3,
+	0x07, 0xb6, 0x16, 0x4b, 0x45, 0x51, 0x6c, 0x2d, 0x96, 0x8a, 0xa1, 0xf8, 0x5a, 0x2c, 0x15, 0x47,
+	0x89, 0xb5, 0x58, 0x2a, 0x81, 0x92, 0x85, 0xdf, 0x00, 0x3c, 0xb5, 0xaa, 0x58, 0xc1, 0xe2, 0x79,
+	0xc5, 0xec, 0xea, 0x9a, 0xa9, 0x60, 0xee, 0x1f, 0x34, 0x91, 0x0a, 0x17, 0x5f, 0xfa, 0xa4, 0xe2,
+	0x3f, 0x5a, 0xad, 0x00, 0xd3, 0xc1, 0x4a, 0x4d, 0xcc, 0xc0, 0xf4, 0x56, 0xd0, 0xe0, 0x4d, 0x6f,
+	0x71, 0x34, 0x7d, 0xa8, 0xbf, 0x70, 0xc8, 0xa5, 0x3f, 0x66, 0x61, 0xdc, 0xf9, 0x3c, 0x3e, 0x0e,
+	0xd3, 0x4e, 0x01, 0x92, 0xaa, 0x39, 0x8f, 0x07, 0xa2, 0xf0, 0x09, 0x78, 0x8c, 0xe7, 0x56, 0xaf,
+	0x8a, 0xd2, 0x86, 0xc0, 0xf2, 0x12, 0xd7, 0x58, 0x69, 0x22, 0x80, 0xcf, 0xc0, 0xd3, 0x01, 0xa3,
+	0xc0, 0x8a, 0x22, 0xd7, 0x58, 0x15, 0x24, 0x86, 0x16, 0xb8, 0x2a, 0x8a, 0xe0, 0x3c, 0x5c, 0x9c,
+	0xe4, 0xa6, 0xd7, 0x39, 0xe9, 0x1a, 0x7b, 0x4b, 0x40, 0x51, 0x3c, 0x07, 0x8f, 0x07, 0x88, 0x1a,
+	0x5b, 0x67, 0x45, 0x16, 0xc5, 0xf0, 0x59, 0x78, 0x26, 0x60, 0xa6, 0x37, 0xc4, 0xab, 0x4d, 0x9e,
+	0xbb, 0xcd, 0xd6, 0xa4, 0x6a, 0x9d, 0x63, 0x1b, 0xa2, 0x80, 0xe2, 0x23, 0xb9, 0xe9, 0xf5, 0xf5,
+	0x3a, 0x57, 0xa5, 0x45, 0xae, 0xd9, 0x10, 0xa4, 0x3a, 0x27, 0x88, 0x28, 0x81, 0x0b, 0x90, 0x1c,
+	0x45, 0x54, 0x79, 0x96, 0x16, 0x59, 0x94, 0xc4, 0x8b, 0x30, 0x13, 0x60, 0x56, 0x69, 0x91, 0xbd,
+	0x49, 0xdf, 0xf2, 0x32, 0xa4, 0x30, 0x81, 0xd9, 0x49, 0x5e, 0x2f, 0x7a, 0x0a, 0x2f, 0xc0, 0x53,
+	0x01, 0xbf, 0x57, 0x9b, 0x1b, 0x0c, 0x47, 0xb4, 0x19, 0x3a, 0xbd, 0xd8, 0xe9, 0x91, 0x16, 0x9b,
+	0xfc, 0x2a, 0xdd, 0xe0, 0x6e, 0x07, 0x1b, 0x98, 0xc1, 0x4b, 0x30, 0x77, 0x24, 0xe2, 0xe5, 0x49,
+	0x63, 0x0c, 0x67, 0x83, 0x5d, 0xd6, 0xeb, 0x68, 0x16, 0x67, 0xe1, 0xbc, 0x6b, 0x0b, 0x34, 0xed,
+	0x8e, 0xec, 0x18, 0x3e, 0x07, 0xf3, 0xe3, 0xbe, 0x91, 0xc9, 0x21, 0x7c, 0x11, 0x2e, 0x7d, 0x80,
+	0x3a, 0x1c, 0xe0, 0x71, 0x7c, 0x19, 0x16, 0x3f, 0x00, 0x56, 0x9b, 0xf5, 0x3a, 0xcd, 0x34, 0x79,
+	0x5a, 0x6c, 0xf2, 0x02, 0xc2, 0xbe, 0xdc, 0x41, 0xda, 0x9b, 0xfa, 0x09, 0x7f, 0x60, 0x61, 0xef,
+	0x0d, 0xae, 0xca, 0x0a, 0x12, 0xcf, 0xd2, 0x35, 0x74, 0xd2, 0xd7, 0x64, 0x12, 0x73, 0x93, 0xe7,
+	0x44, 0x16, 0xcd, 0x4d, 0xae, 0x3e, 0x98, 0xc8, 0xad, 0x7e, 0x1e, 0x17, 0xe1, 0xb9, 0x8f, 0x64,
+	0x73, 0xc9, 0x53, 0x93, 0x6b, 0x13, 0x79, 0x7a, 0x65, 0x85, 0xab, 0xba, 0xb5, 0x65, 0xf0, 0x05,
+	0x58, 0x38, 0x9a, 0xd9, 0x58, 0xf7, 0xca, 0x3b, 0x3d, 0xf9, 0xab, 0x43, 0xae, 0xd6, 0xbc, 0xd9,
+	0xf0, 0xc8, 0xec, 0xe4, 0x41, 0xd6, 0xb9, 0xc6, 0x35, 0xb4, 0x80, 0x4f, 0xc3, 0xb9, 0x71, 0xdf,
+	0x60, 0xfe, 0x8b, 0xf8, 0x24, 0x44, 0xae, 0xcb, 0xdd, 0x3a, 0xc7, 0x7a, 0x06, 0xcf, 0x43, 0xec,
+	0x5a, 0xbd, 0x45, 0x76, 0x37, 0x82, 0xf8, 0x37, 0x69, 0x68, 0x1f, 0xd9, 0x86, 0x9c, 0x2f, 0xfa,
+	0x18, 0x71, 0xb8, 0x09, 0x79, 0xbf, 0xab, 0x31, 0x28, 0xbc, 0x05, 0x67, 0x71, 0x06, 0x9e, 0x0c,
+	0x93, 0xde, 0x06, 0x14, 0xfc, 0x0b, 0x37, 0xf4, 0x84, 0x14, 0x5e, 0xf2, 0x97, 0x77, 0xd4, 0x1f,
+	0x50, 0xed, 0xdc, 0x78, 0xa3, 0x8e, 0x62, 0xe7, 0xfd, 0x1b, 0x79, 0x58, 0xa1, 0x48, 0x8b, 0x1b,
+	0xde, 0x6a, 0x5d, 0xc0, 0x39, 0xb8, 0x30, 0x12, 0xd6, 0xf4, 0x54, 0x75, 0x80, 0x8b, 0xfe, 0x63,
+	0x35, 0x04, 0x06, 0xba, 0x16, 0xfd, 0x57, 0x20, 0x78, 0x43, 0x5d, 0x71, 0xff, 0x85, 0xcf, 0xc3,
+	0xb3, 0x13, 0x9c, 0x23, 0x0a, 0x5f, 0xf2, 0xc5, 0x9b, 0x8c, 0x1d, 0xca, 0xfc, 0x6f, 0x7f, 0xb7,
+	0x27, 0x93, 0xd7, 0xd9, 0xeb, 0x0c, 0xcb, 0x0b, 0xe8, 0xb2, 0xdf, 0x6d, 0x08, 0xf4, 0xa4, 0x2e,
+	0x1d, 0xf1, 0xc5, 0xf1, 0x77, 0xb4, 0x8c, 0x2f, 0xc1, 0x0b, 0x1f, 0x23, 0xbd, 0xd7, 0xa8, 0xe2,
+	0x0f, 0x28, 0xc4, 0x86, 0xdf, 0xd5, 0xff, 0xf8, 0x17, 0x65, 0x32, 0xe5, 0x65, 0xfb, 0xaf, 0xbf,
+	0x77, 0x21, 0x2e, 0xf4, 0xce, 0x2e, 0x1f, 0xa1, 0xf0, 0xc8, 0x7b, 0x7b, 0xe5, 0xa8, 0x2e, 0x6a,
+	0x35, 0x89, 0x0e, 0x6f, 0x28, 0xfa, 0x9f, 0x7f, 0xed, 0xc2, 0x6c, 0xbd, 0x8e, 0xfe, 0xef, 0x2f,
+	0x97, 0xc0, 0x36, 0x6a, 0x12, 0xd7, 0xb8, 0xc1, 0x89, 0xac, 0x80, 0xbe, 0xc0, 0x69, 0x38, 0xe5,
+	0xda, 0x07, 0xd8, 0x97, 0xd9, 0xd8, 0xa3, 0x5f, 0x09, 0xc5, 0xfc, 0x02, 0x5e, 0xf6, 0x08, 0x78,
+	0xd5, 0x23, 0xe0, 0x75, 0x8f, 0x50, 0x6f, 0x7a, 0x84, 0x7a, 0xdb, 0x23, 0xd4, 0xbb, 0x1e, 0xa1,
+	0xde, 0xf7, 0x08, 0x78, 0x68, 0x13, 0xf0, 0xc8, 0x26, 0xd4, 0x53, 0x9b, 0x80, 0x67, 0x36, 0xa1,
+	0x9e, 0xdb, 0x84, 0x7a, 0x61, 0x13, 0xea, 0xa5, 0x4d, 0xc0, 0x2b, 0x9b, 0x80, 0xd7, 0x36, 0xa1,
+	0xde, 0xd8, 0x04, 0xbc, 0xb5, 0x09, 0xf5, 0xce, 0x26, 0xe0, 0xbd, 0x4d, 0xa8, 0x87, 0x7d, 0x42,
+	0x3d, 0xea, 0x13, 0xf0, 0xb8, 0x4f, 0xa8, 0x9f, 0xfa, 0x04, 0xfc, 0xdc, 0x27, 0xd4, 0xd3, 0x3e,
+	0xa1, 0x9e, 0xf5, 0x09, 0x78, 0xde, 0x27, 0xe0, 0x45, 0x9f, 0x80, 0xdb, 0x97, 0xdb, 0x7a, 0xd9,
+	0xda, 0x56, 0xac, 0x6d, 0x55, 0x6b, 0x9b, 0x65, 0x4d, 0xb1, 0xee, 0xeb, 0xc6, 0x4e, 0x25, 0xfc,
+	0x37, 0xbd, 0xbb, 0xd3, 0xae, 0x58, 0x96, 0xd6, 0xdd, 0xdc, 0x4c, 0x38, 0xff, 0x31, 0xaf, 0xfc,
+	0x3d, 0x00, 0x88, 0xf6, 0x0c, 0x93, 0x8b, 0x0c, 0x00, 0x00,
 }
 
 func (x Right) String() string {
@@ -751,6 +793,28 @@ func (this *APIKey) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if that1.LastUsedAt == nil {
+		if this.LastUsedAt != nil {
+			return false
+		}
+	} else if !this.LastUsedAt.Equal(*that1.LastUsedAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *APIKeys) Equal(that interface{}) bool {
@@ -960,6 +1024,41 @@ func (m *APIKey) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRights(dAtA, i, uint64(j3))
 		i += copy(dAtA[i:], dAtA4[:j3])
 	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRights(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n5, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.LastUsedAt != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRights(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt)))
+		n6, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUsedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -1011,27 +1110,27 @@ func (m *Collaborator) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRights(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n5, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n7, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n5
+	i += n7
 	if len(m.Rights) > 0 {
-		dAtA7 := make([]byte, len(m.Rights)*10)
-		var j6 int
+		dAtA9 := make([]byte, len(m.Rights)*10)
+		var j8 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j6++
+				j8++
 			}
-			dAtA7[j6] = uint8(num)
-			j6++
+			dAtA9[j8] = uint8(num)
+			j8++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRights(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintRights(dAtA, i, uint64(j8))
+		i += copy(dAtA[i:], dAtA9[:j8])
 	}
 	return i, nil
 }
@@ -1054,27 +1153,27 @@ func (m *GetCollaboratorResponse) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRights(dAtA, i, uint64(m.OrganizationOrUserIdentifiers.Size()))
-	n8, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
+	n10, err := m.OrganizationOrUserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n10
 	if len(m.Rights) > 0 {
-		dAtA10 := make([]byte, len(m.Rights)*10)
-		var j9 int
+		dAtA12 := make([]byte, len(m.Rights)*10)
+		var j11 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j9++
+				j11++
 			}
-			dAtA10[j9] = uint8(num)
-			j9++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRights(dAtA, i, uint64(j9))
-		i += copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintRights(dAtA, i, uint64(j11))
+		i += copy(dAtA[i:], dAtA12[:j11])
 	}
 	return i, nil
 }
@@ -1140,6 +1239,17 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 	for i := 0; i < v2; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.LastUsedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v3 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.AllowedCIDRs[i] = randStringRights(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1148,9 +1258,9 @@ func NewPopulatedAPIKey(r randyRights, easy bool) *APIKey {
 func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 	this := &APIKeys{}
 	if r.Intn(10) != 0 {
-		v3 := r.Intn(5)
-		this.APIKeys = make([]*APIKey, v3)
-		for i := 0; i < v3; i++ {
+		v4 := r.Intn(5)
+		this.APIKeys = make([]*APIKey, v4)
+		for i := 0; i < v4; i++ {
 			this.APIKeys[i] = NewPopulatedAPIKey(r, easy)
 		}
 	}
@@ -1161,11 +1271,11 @@ func NewPopulatedAPIKeys(r randyRights, easy bool) *APIKeys {
 
 func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 	this := &Collaborator{}
-	v4 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v4
-	v5 := r.Intn(10)
-	this.Rights = make([]Right, v5)
-	for i := 0; i < v5; i++ {
+	v5 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v5
+	v6 := r.Intn(10)
+	this.Rights = make([]Right, v6)
+	for i := 0; i < v6; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1175,11 +1285,11 @@ func NewPopulatedCollaborator(r randyRights, easy bool) *Collaborator {
 
 func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollaboratorResponse {
 	this := &GetCollaboratorResponse{}
-	v6 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v6
-	v7 := r.Intn(10)
-	this.Rights = make([]Right, v7)
-	for i := 0; i < v7; i++ {
+	v7 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v7
+	v8 := r.Intn(10)
+	this.Rights = make([]Right, v8)
+	for i := 0; i < v8; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1190,9 +1300,9 @@ func NewPopulatedGetCollaboratorResponse(r randyRights, easy bool) *GetCollabora
 func NewPopulatedCollaborators(r randyRights, easy bool) *Collaborators {
 	this := &Collaborators{}
 	if r.Intn(10) != 0 {
-		v8 := r.Intn(5)
-		this.Collaborators = make([]*Collaborator, v8)
-		for i := 0; i < v8; i++ {
+		v9 := r.Intn(5)
+		this.Collaborators = make([]*Collaborator, v9)
+		for i := 0; i < v9; i++ {
 			this.Collaborators[i] = NewPopulatedCollaborator(r, easy)
 		}
 	}
@@ -1220,9 +1330,9 @@ func randUTF8RuneRights(r randyRights) rune {
 	return rune(ru + 61)
 }
 func randStringRights(r randyRights) string {
-	v9 := r.Intn(100)
-	tmps := make([]rune, v9)
-	for i := 0; i < v9; i++ {
+	v10 := r.Intn(100)
+	tmps := make([]rune, v10)
+	for i := 0; i < v10; i++ {
 		tmps[i] = randUTF8RuneRights(r)
 	}
 	return string(tmps)
@@ -1244,11 +1354,11 @@ func randFieldRights(dAtA []byte, r randyRights, fieldNumber int, wire int) []by
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
-		v10 := r.Int63()
+		v11 := r.Int63()
 		if r.Intn(2) == 0 {
-			v10 *= -1
+			v11 *= -1
 		}
-		dAtA = encodeVarintPopulateRights(dAtA, uint64(v10))
+		dAtA = encodeVarintPopulateRights(dAtA, uint64(v11))
 	case 1:
 		dAtA = encodeVarintPopulateRights(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -1314,6 +1424,20 @@ func (m *APIKey) Size() (n int) {
 		}
 		n += 1 + sovRights(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if m.LastUsedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUsedAt)
+		n += 1 + l + sovRights(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovRights(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1415,6 +1539,9 @@ func (this *APIKey) String() string {
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastUsedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUsedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1785,6 +1912,110 @@ func (m *APIKey) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUsedAt == nil {
+				m.LastUsedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUsedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRights
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRights
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRights
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRights(dAtA[iNdEx:])
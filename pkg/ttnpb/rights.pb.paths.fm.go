@@ -10,15 +10,21 @@ var RightsFieldPathsTopLevel = []string{
 	"rights",
 }
 var APIKeyFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"id",
 	"key",
+	"last_used_at",
 	"name",
 	"rights",
 }
 
 var APIKeyFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"id",
 	"key",
+	"last_used_at",
 	"name",
 	"rights",
 }
@@ -66,6 +66,33 @@ func (dst *APIKey) SetFields(src *APIKey, paths ...string) error {
 			} else {
 				dst.Rights = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "last_used_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_used_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastUsedAt = src.LastUsedAt
+			} else {
+				dst.LastUsedAt = nil
+			}
+		case "allowed_cidrs":
+			if len(subs) > 0 {
+				return fmt.Errorf("'allowed_cidrs' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AllowedCIDRs = src.AllowedCIDRs
+			} else {
+				dst.AllowedCIDRs = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -170,6 +170,39 @@ func (m *APIKey) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_used_at":
+
+			if v, ok := interface{}(m.GetLastUsedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyValidationError{
+						field:  "last_used_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "allowed_cidrs":
+
+			if len(m.GetAllowedCIDRs()) > 32 {
+				return APIKeyValidationError{
+					field:  "allowed_cidrs",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
 		default:
 			return APIKeyValidationError{
 				field:  name,
@@ -39,6 +39,7 @@ type User struct {
 	UserIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	CreatedAt       time.Time         `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
 	UpdatedAt       time.Time         `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	DeletedAt       *time.Time        `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at,omitempty"`
 	Name            string            `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Description     string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes      map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -112,6 +113,13 @@ func (m *User) GetUpdatedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *User) GetDeletedAt() *time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return nil
+}
+
 func (m *User) GetName() string {
 	if m != nil {
 		return m.Name
@@ -718,10 +726,12 @@ func (m *GetUserAPIKeyRequest) GetKeyID() string {
 
 type CreateUserAPIKeyRequest struct {
 	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Rights               []Right  `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right    `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	ExpiresAt            *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	AllowedCIDRs         []string   `protobuf:"bytes,5,rep,name=allowed_cidrs,json=allowedCidrs,proto3" json:"allowed_cidrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *CreateUserAPIKeyRequest) Reset()      { *m = CreateUserAPIKeyRequest{} }
@@ -770,11 +780,28 @@ func (m *CreateUserAPIKeyRequest) GetRights() []Right {
 	return nil
 }
 
+func (m *CreateUserAPIKeyRequest) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *CreateUserAPIKeyRequest) GetAllowedCIDRs() []string {
+	if m != nil {
+		return m.AllowedCIDRs
+	}
+	return nil
+}
+
 type UpdateUserAPIKeyRequest struct {
-	UserIdentifiers      `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
-	APIKey               `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UserIdentifiers `protobuf:"bytes,1,opt,name=user_ids,json=userIds,proto3,embedded=user_ids" json:"user_ids"`
+	APIKey          `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,embedded=api_key" json:"api_key"`
+	// The names of the API key fields that should be updated.
+	// If this is not set, the name and rights are updated.
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *UpdateUserAPIKeyRequest) Reset()      { *m = UpdateUserAPIKeyRequest{} }
@@ -809,6 +836,13 @@ func (m *UpdateUserAPIKeyRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UpdateUserAPIKeyRequest proto.InternalMessageInfo
 
+func (m *UpdateUserAPIKeyRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
+}
+
 type Invitation struct {
 	Email                string           `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	Token                string           `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
@@ -1376,111 +1410,116 @@ func init() {
 }
 
 var fileDescriptor_5ce30de589ccb9af = []byte{
-	// 1659 bytes of a gzipped FileDescriptorProto
+	// 1730 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xde, 0x21, 0xb9, 0x22, 0xf9, 0x68, 0xfd, 0x4d, 0x64, 0x6b, 0x4b, 0xd5, 0x23, 0x62, 0x2b,
-	0x14, 0xb2, 0x60, 0x52, 0x85, 0x8c, 0xa6, 0xae, 0xe3, 0xd6, 0x21, 0x6d, 0xd7, 0x10, 0xec, 0x02,
-	0xc6, 0xda, 0xe9, 0xa1, 0x41, 0xba, 0x5d, 0x71, 0x47, 0xf4, 0x80, 0xcb, 0xdd, 0xcd, 0xee, 0x50,
-	0x0e, 0x5d, 0x14, 0x08, 0x7a, 0x68, 0x83, 0x9e, 0x8c, 0x02, 0x05, 0x0a, 0xf7, 0xd0, 0xa2, 0xa7,
-	0xa0, 0xa7, 0xf4, 0x96, 0xde, 0x82, 0x9e, 0x7c, 0xf4, 0x31, 0x40, 0x01, 0x37, 0x22, 0x2f, 0x46,
-	0x4f, 0x39, 0x06, 0x3a, 0x15, 0x33, 0xbb, 0xcb, 0x5d, 0x51, 0xb4, 0x23, 0x3b, 0x16, 0xd0, 0xdb,
-	0xce, 0xbc, 0xef, 0xfd, 0xcc, 0x9b, 0xf9, 0xde, 0x7b, 0x58, 0xf8, 0xb6, 0xe3, 0x05, 0xd6, 0x7d,
-	0xcb, 0xad, 0x87, 0xdc, 0x6a, 0x77, 0x37, 0x2d, 0x9f, 0x6d, 0xf6, 0x43, 0x1a, 0x34, 0xfc, 0xc0,
-	0xe3, 0x1e, 0x9e, 0xe3, 0xdc, 0x6d, 0xc4, 0x88, 0xc6, 0xde, 0x85, 0x6a, 0xb3, 0xc3, 0xf8, 0xbd,
-	0xfe, 0x4e, 0xa3, 0xed, 0xf5, 0x36, 0xa9, 0xbb, 0xe7, 0x0d, 0xfc, 0xc0, 0xfb, 0x60, 0xb0, 0x29,
-	0xc1, 0xed, 0x7a, 0x87, 0xba, 0xf5, 0x3d, 0xcb, 0x61, 0xb6, 0xc5, 0xe9, 0xe6, 0x91, 0x8f, 0xc8,
-	0x64, 0xb5, 0x9e, 0x31, 0xd1, 0xf1, 0x3a, 0x5e, 0xa4, 0xbc, 0xd3, 0xdf, 0x95, 0x2b, 0xb9, 0x90,
-	0x5f, 0x31, 0x7c, 0xa5, 0xe3, 0x79, 0x1d, 0x87, 0xa6, 0x28, 0xda, 0xf3, 0xf9, 0x20, 0x16, 0xd6,
-	0x26, 0x85, 0xbb, 0x8c, 0x3a, 0xb6, 0xd9, 0xb3, 0xc2, 0x6e, 0x8c, 0x58, 0x9d, 0x44, 0x70, 0xd6,
-	0xa3, 0x21, 0xb7, 0x7a, 0x7e, 0x0c, 0x20, 0x47, 0xcf, 0xdf, 0x76, 0x18, 0x75, 0x79, 0x2c, 0x5f,
-	0x9b, 0x22, 0xf7, 0x5c, 0x6e, 0xb5, 0xb9, 0xc9, 0xdc, 0xdd, 0x24, 0xca, 0xb3, 0x47, 0x51, 0xd4,
-	0xed, 0xf7, 0xc2, 0x58, 0xfc, 0x9d, 0xa3, 0x62, 0x66, 0x53, 0x97, 0xb3, 0x5d, 0x46, 0x83, 0xf0,
-	0xf9, 0x91, 0x04, 0xac, 0x73, 0x8f, 0xc7, 0x72, 0xfd, 0xbf, 0x65, 0x28, 0xbc, 0x13, 0xd2, 0x00,
-	0x5f, 0x85, 0x3c, 0xb3, 0x43, 0x0d, 0xd5, 0xd0, 0x7a, 0x65, 0x6b, 0xb5, 0x71, 0xf8, 0x8a, 0x1a,
-	0x02, 0xb2, 0x9d, 0x1a, 0x6f, 0x2d, 0x1c, 0xb4, 0xd4, 0xdf, 0xa3, 0xdc, 0x02, 0x7a, 0xfc, 0x74,
-	0x55, 0x79, 0xf2, 0x74, 0x15, 0x19, 0x42, 0x1b, 0x5f, 0x05, 0x68, 0x07, 0xd4, 0xe2, 0xd4, 0x36,
-	0x2d, 0xae, 0xe5, 0xa4, 0xad, 0x6a, 0x23, 0xca, 0x56, 0x23, 0xc9, 0x56, 0xe3, 0x6e, 0x92, 0xad,
-	0x56, 0x49, 0xa8, 0x3f, 0xfc, 0xcf, 0x2a, 0x32, 0xca, 0xb1, 0x5e, 0x93, 0x0b, 0x23, 0x7d, 0xdf,
-	0x4e, 0x8c, 0xe4, 0x5f, 0xc6, 0x48, 0xac, 0xd7, 0xe4, 0x78, 0x05, 0x0a, 0xae, 0xd5, 0xa3, 0x5a,
-	0xa1, 0x86, 0xd6, 0xcb, 0xad, 0xe2, 0x41, 0xab, 0x10, 0xe4, 0xb4, 0x2d, 0x43, 0x6e, 0xe2, 0x0d,
-	0xa8, 0xd8, 0x34, 0x6c, 0x07, 0xcc, 0xe7, 0xcc, 0x73, 0x35, 0x55, 0x62, 0x4a, 0x07, 0x2d, 0x35,
-	0xc8, 0x6b, 0x4f, 0xe6, 0x8d, 0xac, 0x10, 0x07, 0x00, 0x16, 0xe7, 0x01, 0xdb, 0xe9, 0x73, 0x1a,
-	0x6a, 0x33, 0xb5, 0xfc, 0x7a, 0x65, 0x6b, 0x6d, 0x5a, 0x7a, 0x1a, 0xcd, 0x31, 0xec, 0xba, 0xcb,
-	0x83, 0x41, 0xeb, 0xfc, 0x41, 0xeb, 0xdc, 0x23, 0xf4, 0x5d, 0x7d, 0x2d, 0xd0, 0xb5, 0xb5, 0x2d,
-	0xf2, 0x8b, 0x77, 0xad, 0xfa, 0x83, 0xef, 0xd5, 0x7f, 0xf8, 0xde, 0xfa, 0x95, 0x4b, 0xef, 0xd6,
-	0xdf, 0xbb, 0x92, 0x2c, 0xcf, 0xfd, 0x6a, 0xeb, 0xfc, 0xaf, 0xd7, 0x8c, 0x8c, 0x17, 0xfc, 0x63,
-	0x38, 0x95, 0x7d, 0x0e, 0x5a, 0x51, 0x7a, 0x5d, 0x99, 0xf4, 0x7a, 0x35, 0xc2, 0x6c, 0xbb, 0xbb,
-	0x9e, 0x51, 0x69, 0xa7, 0x0b, 0xfc, 0x16, 0x9c, 0xf6, 0x03, 0xd6, 0xb3, 0x82, 0x81, 0x49, 0x7b,
-	0x16, 0x73, 0x4c, 0xcb, 0xb6, 0x03, 0x1a, 0x86, 0x5a, 0x29, 0x93, 0x8d, 0x5f, 0x22, 0xe3, 0x8d,
-	0x18, 0x75, 0x5d, 0x80, 0x9a, 0x11, 0x06, 0x3b, 0xa0, 0x4f, 0x55, 0x36, 0x13, 0xca, 0xc9, 0x6b,
-	0x29, 0x7f, 0xed, 0xb5, 0x14, 0xe4, 0x95, 0x90, 0x29, 0x2e, 0x7e, 0x96, 0x18, 0x6a, 0x72, 0x5c,
-	0x85, 0x92, 0x6f, 0x85, 0xe1, 0x7d, 0x2f, 0xb0, 0x35, 0x10, 0xd1, 0x19, 0xe3, 0x35, 0xbe, 0x0d,
-	0x6f, 0x24, 0xdf, 0x66, 0xe6, 0x45, 0x54, 0x8e, 0xe9, 0x7a, 0x31, 0x51, 0x7e, 0x67, 0xfc, 0x2a,
-	0xde, 0x84, 0xe5, 0x80, 0xbe, 0xdf, 0x67, 0x01, 0x35, 0x27, 0x2c, 0x6b, 0xa7, 0x6a, 0x68, 0xbd,
-	0x64, 0x9c, 0x8e, 0xc5, 0xb7, 0x0f, 0xa9, 0xe2, 0xef, 0x83, 0x1a, 0x72, 0x81, 0x9a, 0xad, 0xa1,
-	0xf5, 0xb9, 0xad, 0xd3, 0x93, 0x37, 0x71, 0x47, 0x08, 0xe5, 0x0b, 0xfa, 0x8d, 0x20, 0x85, 0x11,
-	0xa1, 0xf1, 0x12, 0xa8, 0x96, 0xdd, 0x63, 0xae, 0x36, 0x27, 0x8d, 0x47, 0x0b, 0x5c, 0x07, 0xcc,
-	0x69, 0xcf, 0xf7, 0x02, 0x91, 0xe2, 0xf1, 0xe1, 0xe7, 0xe5, 0xe1, 0x17, 0xc7, 0x92, 0x24, 0x02,
-	0xdc, 0x86, 0xb3, 0x47, 0xe1, 0x66, 0x86, 0x66, 0x0b, 0xc7, 0xcc, 0x47, 0xf5, 0x88, 0xed, 0xab,
-	0x63, 0xce, 0x4d, 0x77, 0x42, 0x3f, 0xf0, 0x59, 0x40, 0x43, 0xe1, 0x64, 0xf1, 0x95, 0x9d, 0x5c,
-	0x8f, 0x8c, 0x34, 0x39, 0x7e, 0x1b, 0xe6, 0xfd, 0xc0, 0xdb, 0x65, 0x0e, 0x35, 0x7d, 0xd6, 0xe6,
-	0xfd, 0x80, 0x6a, 0x58, 0x9a, 0x5d, 0x9e, 0xcc, 0xe7, 0xed, 0x48, 0x6c, 0xcc, 0xc5, 0xf8, 0x78,
-	0x5d, 0xfd, 0x11, 0xcc, 0x4f, 0xb0, 0x0c, 0x2f, 0x40, 0xbe, 0x4b, 0x07, 0xb2, 0x6e, 0x95, 0x0d,
-	0xf1, 0x29, 0xb2, 0xbe, 0x67, 0x39, 0x7d, 0x2a, 0xeb, 0x4f, 0xd9, 0x88, 0x16, 0x97, 0x72, 0x17,
-	0x91, 0xfe, 0xbb, 0x1c, 0x14, 0x63, 0x53, 0xf8, 0x32, 0x94, 0x68, 0x6f, 0x87, 0xda, 0x36, 0xb5,
-	0xe3, 0xa2, 0x57, 0x7b, 0x4e, 0x14, 0x8d, 0xeb, 0x31, 0xce, 0x18, 0x6b, 0xe0, 0x1b, 0xa0, 0x86,
-	0xec, 0x01, 0x0d, 0xb5, 0x9c, 0xa4, 0xa6, 0xfe, 0x3c, 0xd5, 0x3b, 0x02, 0x14, 0x95, 0x83, 0xd9,
-	0x83, 0x16, 0x3c, 0x42, 0xc5, 0x0d, 0x35, 0xc8, 0x3f, 0x44, 0xe2, 0x89, 0x08, 0x51, 0xf5, 0x2d,
-	0x28, 0x25, 0xe6, 0xf1, 0x0a, 0x94, 0x7b, 0xac, 0x47, 0x4d, 0x3e, 0xf0, 0x69, 0x7c, 0xa0, 0x92,
-	0xd8, 0xb8, 0x3b, 0xf0, 0x29, 0xc6, 0x50, 0xb0, 0x2d, 0x6e, 0xc9, 0x43, 0x9d, 0x32, 0xe4, 0x77,
-	0xf5, 0x22, 0x40, 0xea, 0x20, 0x9b, 0x89, 0xd9, 0xaf, 0xcb, 0xc4, 0x05, 0x50, 0x45, 0xcd, 0x0a,
-	0xf1, 0x06, 0xa8, 0xa2, 0x33, 0x8b, 0xc2, 0x2f, 0x0e, 0xb2, 0x34, 0xad, 0xb2, 0x19, 0x11, 0x44,
-	0xff, 0x0b, 0x82, 0xb9, 0x1b, 0x94, 0xcb, 0x2d, 0xfa, 0x7e, 0x9f, 0x86, 0x1c, 0xdf, 0x82, 0x92,
-	0x90, 0x99, 0xdf, 0xa8, 0x75, 0x14, 0xfb, 0x12, 0x12, 0xe2, 0x2b, 0x00, 0x69, 0xaf, 0x7d, 0x6e,
-	0xfb, 0xf8, 0x89, 0x80, 0xfc, 0xd4, 0x0a, 0xbb, 0xad, 0x82, 0x30, 0x61, 0x94, 0x77, 0x93, 0x0d,
-	0xfd, 0x01, 0x2c, 0x46, 0x6f, 0x3a, 0x1b, 0xe3, 0x25, 0x28, 0x08, 0x07, 0x71, 0x7c, 0x53, 0x4f,
-	0x38, 0x25, 0x28, 0xa9, 0x83, 0xcf, 0xc1, 0x02, 0x73, 0xf7, 0x18, 0xb7, 0x44, 0x2f, 0x30, 0xb9,
-	0xd7, 0xa5, 0x6e, 0x9c, 0xcc, 0xf9, 0x74, 0xff, 0xae, 0xd8, 0xd6, 0x1f, 0x22, 0x58, 0x8c, 0xca,
-	0xc5, 0xeb, 0x72, 0xfe, 0x8d, 0xd3, 0xe1, 0x02, 0x89, 0xd2, 0x71, 0x77, 0x92, 0x94, 0x27, 0x72,
-	0x7f, 0xfa, 0x3f, 0x11, 0x7c, 0x2b, 0x4d, 0xc1, 0x89, 0xfa, 0x12, 0xaf, 0xdd, 0xa5, 0xf7, 0xe3,
-	0xcb, 0x10, 0x9f, 0x62, 0xc7, 0x73, 0x6c, 0x39, 0x30, 0x94, 0x0d, 0xf1, 0x89, 0x37, 0x60, 0x31,
-	0xa0, 0x7b, 0x5e, 0x97, 0x9a, 0x96, 0xe3, 0x98, 0x56, 0xbb, 0x2d, 0x7a, 0x60, 0x41, 0xd6, 0xe2,
-	0xf9, 0x48, 0xd0, 0x74, 0x9c, 0xa6, 0xdc, 0xd6, 0x1f, 0x21, 0x38, 0x73, 0x8b, 0x85, 0xf2, 0x75,
-	0x37, 0x6f, 0x6f, 0xdf, 0xa4, 0x83, 0xf0, 0x64, 0x02, 0x27, 0xa0, 0x3a, 0xac, 0xc7, 0xa2, 0xf1,
-	0x68, 0x56, 0x36, 0x8d, 0x8d, 0xbc, 0xf6, 0xac, 0x68, 0x44, 0xdb, 0x82, 0xe8, 0xbe, 0xd5, 0xa1,
-	0xf2, 0x1c, 0xb3, 0x86, 0xfc, 0xd6, 0x7f, 0x8b, 0x60, 0x29, 0x66, 0x5e, 0x14, 0xdb, 0xc9, 0x84,
-	0x56, 0x83, 0x99, 0x2e, 0x1d, 0x98, 0xcc, 0x8e, 0xd2, 0xda, 0x2a, 0x0f, 0x9f, 0xae, 0xaa, 0x37,
-	0xe9, 0x60, 0xfb, 0x9a, 0xa1, 0x76, 0xe9, 0x60, 0xdb, 0xd6, 0xff, 0x85, 0x60, 0x39, 0x65, 0xd8,
-	0x49, 0xc6, 0x92, 0x0c, 0x70, 0xb9, 0x69, 0x03, 0xdc, 0x65, 0x98, 0x89, 0xa6, 0x58, 0x2d, 0x5f,
-	0xcb, 0x4f, 0x6b, 0xc8, 0x86, 0x90, 0xca, 0x92, 0xfb, 0x07, 0x54, 0xd4, 0xe3, 0xae, 0x1c, 0xeb,
-	0xe8, 0x7f, 0x47, 0xb0, 0x9c, 0x3e, 0xd3, 0x93, 0x3c, 0x44, 0x13, 0x8a, 0x96, 0xcf, 0x4c, 0x51,
-	0x96, 0x23, 0xfa, 0x9e, 0x99, 0x34, 0x16, 0x79, 0x9f, 0x62, 0x63, 0xc6, 0xf2, 0xd9, 0x4d, 0x3a,
-	0xd0, 0xff, 0x98, 0x07, 0xd8, 0x1e, 0x97, 0x1a, 0x7c, 0x16, 0x54, 0x39, 0x95, 0x45, 0xfd, 0x21,
-	0x1d, 0xe5, 0xa2, 0x5d, 0x51, 0xf1, 0xb3, 0x45, 0x2a, 0x5a, 0x88, 0x89, 0x3a, 0xd3, 0xca, 0x5f,
-	0x6a, 0xa2, 0xa6, 0xe3, 0xee, 0x7d, 0x78, 0xb6, 0x2f, 0xbc, 0x8e, 0xd9, 0x5e, 0x7d, 0xb5, 0xd9,
-	0xbe, 0x09, 0x15, 0xc1, 0x65, 0x3f, 0xb6, 0x32, 0x73, 0xcc, 0xd1, 0x04, 0x12, 0x25, 0x39, 0x8a,
-	0xa4, 0x26, 0x76, 0x06, 0x5a, 0xf1, 0x58, 0x37, 0x9d, 0x5a, 0x68, 0x0d, 0xf4, 0x5b, 0x51, 0xb9,
-	0x48, 0xaf, 0x66, 0x5c, 0x2e, 0xc6, 0x04, 0x47, 0x2f, 0x26, 0x78, 0x2e, 0x43, 0xf0, 0x9b, 0x50,
-	0xc9, 0x58, 0xc2, 0x97, 0xa1, 0x92, 0xb6, 0x97, 0xa4, 0x37, 0x57, 0x27, 0xc3, 0x4b, 0x35, 0x8c,
-	0x2c, 0x5c, 0x7f, 0x13, 0x4e, 0xdf, 0xa1, 0xae, 0x9d, 0x11, 0xc7, 0x91, 0xbd, 0xf8, 0xf1, 0xe8,
-	0x17, 0x61, 0xf9, 0x1a, 0x75, 0x28, 0xa7, 0x2f, 0xad, 0xf9, 0x67, 0x04, 0x67, 0x44, 0xb2, 0xee,
-	0xd0, 0x30, 0x64, 0x9e, 0x9b, 0xc9, 0xd9, 0x6b, 0x26, 0xd4, 0x05, 0x80, 0x30, 0xf2, 0x91, 0x56,
-	0xa9, 0xa5, 0xa8, 0x36, 0xbc, 0x3d, 0x7c, 0xba, 0x5a, 0x4e, 0x02, 0xb8, 0x66, 0x94, 0xc3, 0x24,
-	0x16, 0xfd, 0xdf, 0x39, 0xa8, 0x64, 0xa2, 0xfb, 0x3f, 0x08, 0x69, 0x82, 0x4c, 0xf9, 0xd7, 0x41,
-	0xa6, 0xc2, 0xab, 0x91, 0xe9, 0xca, 0xa1, 0xda, 0xa0, 0x1e, 0x93, 0x4b, 0x69, 0x5d, 0xd0, 0x6f,
-	0xc0, 0xa9, 0x4c, 0x72, 0x43, 0xfc, 0x03, 0x28, 0xc5, 0xe7, 0x4c, 0x1e, 0xee, 0xca, 0xb4, 0xec,
-	0xc6, 0x78, 0x63, 0x0c, 0xd6, 0xff, 0x81, 0x60, 0x39, 0xe9, 0xc0, 0x89, 0xb5, 0x93, 0x29, 0xcb,
-	0x4b, 0xa0, 0x7a, 0x81, 0x4d, 0x83, 0xa4, 0x4a, 0xca, 0x45, 0xca, 0xdb, 0xfc, 0x8b, 0x79, 0x5b,
-	0x48, 0x79, 0xdb, 0xfa, 0x1b, 0x7a, 0xbc, 0x4f, 0xd0, 0x93, 0x7d, 0x82, 0x3e, 0xdf, 0x27, 0xca,
-	0x17, 0xfb, 0x44, 0x79, 0xb6, 0x4f, 0x94, 0x2f, 0xf7, 0x89, 0xf2, 0xd5, 0x3e, 0x41, 0x1f, 0x0e,
-	0x09, 0xfa, 0x68, 0x48, 0x94, 0x8f, 0x87, 0x04, 0x7d, 0x32, 0x24, 0xca, 0xa7, 0x43, 0xa2, 0x7c,
-	0x36, 0x24, 0xca, 0xe3, 0x21, 0x41, 0x4f, 0x86, 0x04, 0x7d, 0x3e, 0x24, 0xca, 0x17, 0x43, 0x82,
-	0x9e, 0x0d, 0x89, 0xf2, 0xe5, 0x90, 0xa0, 0xaf, 0x86, 0x44, 0xf9, 0x70, 0x44, 0x94, 0x8f, 0x46,
-	0x04, 0x3d, 0x1c, 0x11, 0xe5, 0x4f, 0x23, 0x82, 0xfe, 0x3a, 0x22, 0xca, 0xc7, 0x23, 0xa2, 0x7c,
-	0x32, 0x22, 0xe8, 0xd3, 0x11, 0x41, 0x9f, 0x8d, 0x08, 0xfa, 0xf9, 0xf9, 0x8e, 0xd7, 0xe0, 0xf7,
-	0x28, 0xbf, 0xc7, 0xdc, 0x4e, 0xd8, 0x70, 0x29, 0xbf, 0xef, 0x05, 0xdd, 0xcd, 0xc3, 0xbf, 0x7a,
-	0xfc, 0x6e, 0x67, 0x93, 0x73, 0xd7, 0xdf, 0xd9, 0x99, 0x91, 0xd7, 0x78, 0xe1, 0x7f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x52, 0x9b, 0x96, 0x63, 0x96, 0x13, 0x00, 0x00,
+	0x15, 0xe6, 0x90, 0x5c, 0x91, 0x7c, 0xd4, 0xef, 0x5a, 0xb6, 0xb6, 0x54, 0x3d, 0x22, 0xb6, 0x42,
+	0x21, 0x0b, 0x16, 0x55, 0xc8, 0x68, 0xea, 0x3a, 0x4e, 0x1d, 0x52, 0x76, 0x0d, 0xc1, 0x2e, 0x60,
+	0xac, 0x9d, 0x1e, 0x1a, 0xa4, 0x9b, 0x15, 0x77, 0x44, 0x0f, 0xb8, 0xdc, 0xdd, 0xec, 0x0c, 0xa5,
+	0xd0, 0x45, 0x81, 0xa0, 0x87, 0x36, 0xe8, 0xc9, 0x08, 0x50, 0xa0, 0x70, 0x0f, 0x2d, 0x7a, 0x0a,
+	0xd0, 0x4b, 0x7a, 0x4b, 0x6f, 0x41, 0x4f, 0x3e, 0xfa, 0x18, 0xa0, 0x80, 0x1a, 0x91, 0x17, 0x03,
+	0xbd, 0xe4, 0x18, 0xe8, 0x54, 0xcc, 0xec, 0x2e, 0x77, 0x4d, 0xd1, 0x8e, 0x64, 0x5b, 0x40, 0x6e,
+	0x33, 0xf3, 0xbe, 0xf7, 0x33, 0x6f, 0xe6, 0x7d, 0x6f, 0x76, 0xe1, 0xfb, 0x8e, 0x17, 0x58, 0x7b,
+	0x96, 0xbb, 0xc6, 0xb8, 0xd5, 0x6c, 0xaf, 0x5b, 0x3e, 0x5d, 0xef, 0x32, 0x12, 0xd4, 0xfc, 0xc0,
+	0xe3, 0x9e, 0x3a, 0xcd, 0xb9, 0x5b, 0x8b, 0x10, 0xb5, 0xdd, 0x4b, 0x95, 0x7a, 0x8b, 0xf2, 0xfb,
+	0xdd, 0xed, 0x5a, 0xd3, 0xeb, 0xac, 0x13, 0x77, 0xd7, 0xeb, 0xf9, 0x81, 0xf7, 0x61, 0x6f, 0x5d,
+	0x82, 0x9b, 0x6b, 0x2d, 0xe2, 0xae, 0xed, 0x5a, 0x0e, 0xb5, 0x2d, 0x4e, 0xd6, 0x8f, 0x0c, 0x42,
+	0x93, 0x95, 0xb5, 0x94, 0x89, 0x96, 0xd7, 0xf2, 0x42, 0xe5, 0xed, 0xee, 0x8e, 0x9c, 0xc9, 0x89,
+	0x1c, 0x45, 0xf0, 0xc5, 0x96, 0xe7, 0xb5, 0x1c, 0x92, 0xa0, 0x48, 0xc7, 0xe7, 0xbd, 0x48, 0x58,
+	0x1d, 0x15, 0xee, 0x50, 0xe2, 0xd8, 0x66, 0xc7, 0x62, 0xed, 0x08, 0xb1, 0x34, 0x8a, 0xe0, 0xb4,
+	0x43, 0x18, 0xb7, 0x3a, 0x7e, 0x04, 0xc0, 0x47, 0xf7, 0xdf, 0x74, 0x28, 0x71, 0x79, 0x24, 0x5f,
+	0x1e, 0x23, 0xf7, 0x5c, 0x6e, 0x35, 0xb9, 0x49, 0xdd, 0x9d, 0x38, 0xca, 0xf3, 0x47, 0x51, 0xc4,
+	0xed, 0x76, 0x58, 0x24, 0xfe, 0xc1, 0x51, 0x31, 0xb5, 0x89, 0xcb, 0xe9, 0x0e, 0x25, 0x01, 0x7b,
+	0x7e, 0x24, 0x01, 0x6d, 0xdd, 0xe7, 0x91, 0x5c, 0xff, 0x07, 0x40, 0xfe, 0x1d, 0x46, 0x02, 0x75,
+	0x13, 0x72, 0xd4, 0x66, 0x1a, 0xaa, 0xa2, 0x95, 0xf2, 0xc6, 0x52, 0xed, 0xd9, 0x23, 0xaa, 0x09,
+	0xc8, 0x56, 0x62, 0xbc, 0x31, 0x7b, 0xd8, 0x50, 0xfe, 0x88, 0xb2, 0xb3, 0xe8, 0xf1, 0xfe, 0x52,
+	0xe6, 0xc9, 0xfe, 0x12, 0x32, 0x84, 0xb6, 0xba, 0x09, 0xd0, 0x0c, 0x88, 0xc5, 0x89, 0x6d, 0x5a,
+	0x5c, 0xcb, 0x4a, 0x5b, 0x95, 0x5a, 0x98, 0xad, 0x5a, 0x9c, 0xad, 0xda, 0xbd, 0x38, 0x5b, 0x8d,
+	0xa2, 0x50, 0x7f, 0xf8, 0xdf, 0x25, 0x64, 0x94, 0x22, 0xbd, 0x3a, 0x17, 0x46, 0xba, 0xbe, 0x1d,
+	0x1b, 0xc9, 0x9d, 0xc4, 0x48, 0xa4, 0x57, 0xe7, 0xea, 0x35, 0x00, 0x9b, 0x38, 0x24, 0x32, 0x72,
+	0xe6, 0x5b, 0x8d, 0xe4, 0x43, 0x03, 0x91, 0x4e, 0x9d, 0xab, 0x8b, 0x90, 0x77, 0xad, 0x0e, 0xd1,
+	0xf2, 0x55, 0xb4, 0x52, 0x6a, 0x14, 0x0e, 0x1b, 0xf9, 0x20, 0xab, 0x6d, 0x18, 0x72, 0x51, 0x5d,
+	0x85, 0xb2, 0x4d, 0x58, 0x33, 0xa0, 0x3e, 0xa7, 0x9e, 0xab, 0x29, 0x12, 0x53, 0x3c, 0x6c, 0x28,
+	0x41, 0x4e, 0x7b, 0x32, 0x63, 0xa4, 0x85, 0x6a, 0x00, 0x60, 0x71, 0x1e, 0xd0, 0xed, 0x2e, 0x27,
+	0x4c, 0x9b, 0xa8, 0xe6, 0x56, 0xca, 0x1b, 0xcb, 0xe3, 0xf2, 0x5b, 0xab, 0x0f, 0x61, 0x37, 0x5c,
+	0x1e, 0xf4, 0x1a, 0x17, 0x0f, 0x1b, 0x17, 0x1e, 0xa1, 0x1f, 0xea, 0xcb, 0x81, 0xae, 0x2d, 0x6f,
+	0xe0, 0x5f, 0xbf, 0x6b, 0xad, 0x3d, 0xf8, 0xd1, 0xda, 0x4f, 0xdf, 0x5b, 0xb9, 0x76, 0xe5, 0xdd,
+	0xb5, 0xf7, 0xae, 0xc5, 0xd3, 0x0b, 0xbf, 0xd9, 0xb8, 0xf8, 0xdb, 0x65, 0x23, 0xe5, 0x45, 0xfd,
+	0x19, 0x4c, 0xa6, 0xef, 0x93, 0x56, 0x90, 0x5e, 0x17, 0x47, 0xbd, 0x6e, 0x86, 0x98, 0x2d, 0x77,
+	0xc7, 0x33, 0xca, 0xcd, 0x64, 0xa2, 0xbe, 0x09, 0x67, 0xfd, 0x80, 0x76, 0xac, 0xa0, 0x67, 0x92,
+	0x8e, 0x45, 0x1d, 0xd3, 0xb2, 0xed, 0x80, 0x30, 0xa6, 0x15, 0x53, 0xd9, 0x78, 0x1f, 0x19, 0x67,
+	0x22, 0xd4, 0x0d, 0x01, 0xaa, 0x87, 0x18, 0xd5, 0x01, 0x7d, 0xac, 0xb2, 0x19, 0xd7, 0xac, 0x3c,
+	0x92, 0xd2, 0x31, 0x8f, 0x04, 0x8f, 0x71, 0xf1, 0xcb, 0xd8, 0x50, 0x9d, 0xab, 0x15, 0x28, 0xfa,
+	0x16, 0x63, 0x7b, 0x5e, 0x60, 0x6b, 0x20, 0xa2, 0x33, 0x86, 0x73, 0xf5, 0x0e, 0x9c, 0x89, 0xc7,
+	0x66, 0xea, 0x4a, 0x95, 0x8f, 0xe9, 0x7a, 0x2e, 0x56, 0x7e, 0x67, 0x78, 0xad, 0xde, 0x80, 0x85,
+	0x80, 0x7c, 0xd0, 0xa5, 0x01, 0x31, 0x47, 0x2c, 0x6b, 0x93, 0x55, 0xb4, 0x52, 0x34, 0xce, 0x46,
+	0xe2, 0x3b, 0xcf, 0xa8, 0xaa, 0x3f, 0x06, 0x85, 0x71, 0x81, 0x9a, 0xaa, 0xa2, 0x95, 0xe9, 0x8d,
+	0xb3, 0xa3, 0x27, 0x71, 0x57, 0x08, 0xe5, 0x0d, 0xfa, 0x9d, 0xa8, 0x2a, 0x23, 0x44, 0xab, 0xf3,
+	0xa0, 0x58, 0x76, 0x87, 0xba, 0xda, 0xb4, 0x34, 0x1e, 0x4e, 0xd4, 0x35, 0x50, 0x39, 0xe9, 0xf8,
+	0x5e, 0x20, 0x52, 0x3c, 0xdc, 0xfc, 0x8c, 0xdc, 0xfc, 0xdc, 0x50, 0x12, 0x47, 0xa0, 0x36, 0xe1,
+	0xfc, 0x51, 0xb8, 0x99, 0xaa, 0xd3, 0xd9, 0x63, 0xe6, 0xa3, 0x72, 0xc4, 0xf6, 0xe6, 0xb0, 0x68,
+	0xc7, 0x3b, 0x21, 0x1f, 0xfa, 0x34, 0x20, 0x4c, 0x38, 0x99, 0x7b, 0x69, 0x27, 0x37, 0x42, 0x23,
+	0x75, 0xae, 0xbe, 0x0d, 0x33, 0x7e, 0xe0, 0xed, 0x50, 0x87, 0x98, 0x3e, 0x6d, 0xf2, 0x6e, 0x40,
+	0x34, 0x55, 0x9a, 0x5d, 0x18, 0xcd, 0xe7, 0x9d, 0x50, 0x6c, 0x4c, 0x47, 0xf8, 0x68, 0x5e, 0x79,
+	0x0b, 0x66, 0x46, 0xaa, 0x4c, 0x9d, 0x85, 0x5c, 0x9b, 0xf4, 0x24, 0xf1, 0x95, 0x0c, 0x31, 0x14,
+	0x59, 0xdf, 0xb5, 0x9c, 0x2e, 0x91, 0x04, 0x56, 0x32, 0xc2, 0xc9, 0x95, 0xec, 0x65, 0xa4, 0xff,
+	0x21, 0x0b, 0x85, 0xc8, 0x94, 0x7a, 0x15, 0x8a, 0xa4, 0xb3, 0x4d, 0x6c, 0x9b, 0xd8, 0x11, 0x6b,
+	0x56, 0x9f, 0x13, 0x45, 0xed, 0x46, 0x84, 0x33, 0x86, 0x1a, 0xea, 0x4d, 0x50, 0x18, 0x7d, 0x40,
+	0x98, 0x96, 0x95, 0xa5, 0xa9, 0x3f, 0x4f, 0xf5, 0x2e, 0x7d, 0x10, 0x05, 0xda, 0x98, 0x3a, 0x6c,
+	0xc0, 0x23, 0x54, 0x58, 0x55, 0x82, 0xdc, 0x43, 0x24, 0xae, 0x88, 0x10, 0x55, 0xde, 0x84, 0x62,
+	0x6c, 0x5e, 0x5d, 0x84, 0x52, 0x87, 0x76, 0x88, 0xc9, 0x7b, 0x3e, 0x89, 0x36, 0x54, 0x14, 0x0b,
+	0xf7, 0x7a, 0x3e, 0x51, 0x55, 0xc8, 0xdb, 0x16, 0xb7, 0xe4, 0xa6, 0x26, 0x0d, 0x39, 0xae, 0x5c,
+	0x06, 0x48, 0x1c, 0xa4, 0x33, 0x31, 0xf5, 0x6d, 0x99, 0xb8, 0x04, 0x8a, 0xe0, 0x2c, 0xa6, 0xae,
+	0x82, 0x22, 0x5a, 0xbb, 0xe8, 0x1c, 0x62, 0x23, 0xf3, 0xe3, 0x98, 0xcd, 0x08, 0x21, 0xfa, 0x5f,
+	0x11, 0x4c, 0xdf, 0x24, 0x5c, 0x2e, 0x91, 0x0f, 0xba, 0x84, 0x71, 0xf5, 0x36, 0x14, 0x85, 0xcc,
+	0x7c, 0xa5, 0xde, 0x53, 0xe8, 0x4a, 0x08, 0x13, 0xac, 0x9f, 0x34, 0xeb, 0xe7, 0xf6, 0x9f, 0x9f,
+	0x0b, 0xc8, 0x2f, 0x2c, 0xd6, 0x6e, 0xe4, 0x85, 0x09, 0xa3, 0xb4, 0x13, 0x2f, 0xe8, 0x0f, 0x60,
+	0x2e, 0xbc, 0xd3, 0xe9, 0x18, 0xaf, 0x40, 0x5e, 0x38, 0x88, 0xe2, 0x1b, 0xbb, 0xc3, 0x31, 0x41,
+	0x49, 0x1d, 0xf5, 0x02, 0xcc, 0x52, 0x77, 0x97, 0x72, 0x4b, 0xf4, 0x02, 0x93, 0x7b, 0x6d, 0xe2,
+	0x46, 0xc9, 0x9c, 0x49, 0xd6, 0xef, 0x89, 0x65, 0xfd, 0x21, 0x82, 0xb9, 0x90, 0x2e, 0x5e, 0x97,
+	0xf3, 0x57, 0x4e, 0x87, 0x0b, 0x38, 0x4c, 0xc7, 0xbd, 0xd1, 0xa2, 0x3c, 0x95, 0xf3, 0xd3, 0xff,
+	0x85, 0xe0, 0x7b, 0x49, 0x0a, 0x4e, 0xd5, 0x97, 0xb8, 0xed, 0x2e, 0xd9, 0x8b, 0x0e, 0x43, 0x0c,
+	0xc5, 0x8a, 0xe7, 0xd8, 0xf2, 0xc5, 0x51, 0x32, 0xc4, 0x50, 0x5d, 0x85, 0xb9, 0x80, 0xec, 0x7a,
+	0x6d, 0x62, 0x5a, 0x8e, 0x63, 0x5a, 0xcd, 0xa6, 0xe8, 0x81, 0x79, 0xc9, 0xc5, 0x33, 0xa1, 0xa0,
+	0xee, 0x38, 0x75, 0xb9, 0xac, 0x3f, 0x42, 0x70, 0xee, 0x36, 0x65, 0xf2, 0x76, 0xd7, 0xef, 0x6c,
+	0xdd, 0x22, 0x3d, 0x76, 0x3a, 0x81, 0x63, 0x50, 0x1c, 0xda, 0xa1, 0xe1, 0xfb, 0x6a, 0x4a, 0x36,
+	0x8d, 0xd5, 0x9c, 0xf6, 0xb4, 0x60, 0x84, 0xcb, 0xa2, 0xd0, 0x7d, 0xab, 0x45, 0xe4, 0x3e, 0xa6,
+	0x0c, 0x39, 0xd6, 0x7f, 0x8f, 0x60, 0xfe, 0x26, 0x49, 0xc5, 0x76, 0x3a, 0xa1, 0x55, 0x61, 0xa2,
+	0x4d, 0x7a, 0x26, 0xb5, 0xc3, 0xb4, 0x36, 0x4a, 0xfd, 0xfd, 0x25, 0xe5, 0x16, 0xe9, 0x6d, 0x5d,
+	0x37, 0x94, 0x36, 0xe9, 0x6d, 0xd9, 0xfa, 0xbf, 0xb3, 0xb0, 0x90, 0x54, 0xd8, 0x69, 0xc6, 0x12,
+	0x3f, 0xe0, 0xb2, 0xe3, 0x1e, 0x70, 0x57, 0x61, 0x22, 0x7c, 0x06, 0x6b, 0xb9, 0x6a, 0x6e, 0x5c,
+	0x43, 0x36, 0x84, 0x54, 0x52, 0xee, 0x27, 0xa8, 0xa0, 0x47, 0x5d, 0x39, 0xd2, 0x11, 0x75, 0x95,
+	0xea, 0x6c, 0xf9, 0xe3, 0x3e, 0x2e, 0xc9, 0xb0, 0x91, 0xbd, 0x05, 0x53, 0x96, 0xe3, 0x78, 0x7b,
+	0xc4, 0x36, 0x9b, 0xd4, 0x0e, 0x98, 0xa6, 0x54, 0x73, 0x2b, 0xa5, 0x86, 0x76, 0xd8, 0x50, 0x3e,
+	0x41, 0xd9, 0xd9, 0x6a, 0x7f, 0x7f, 0x69, 0xb2, 0x1e, 0x02, 0x36, 0xb7, 0xae, 0x1b, 0xcc, 0x98,
+	0x8c, 0xe0, 0x9b, 0x02, 0xad, 0xff, 0x0f, 0xc1, 0x42, 0x52, 0x26, 0xa7, 0x99, 0xc4, 0x3a, 0x14,
+	0x2c, 0x9f, 0x9a, 0xa2, 0x2d, 0x84, 0xf4, 0x71, 0x6e, 0xd4, 0x58, 0xe8, 0x7d, 0x8c, 0x8d, 0x09,
+	0xcb, 0xa7, 0xb7, 0x48, 0x6f, 0x84, 0x84, 0x72, 0x27, 0x27, 0xa1, 0x3f, 0xe5, 0x00, 0xb6, 0x86,
+	0x5c, 0xa9, 0x9e, 0x07, 0x45, 0x3e, 0x2b, 0x35, 0x94, 0x3a, 0xd8, 0xf7, 0x91, 0x11, 0xae, 0x8a,
+	0x96, 0x95, 0x66, 0xd9, 0x70, 0x22, 0xbe, 0x29, 0x52, 0x27, 0x76, 0xa2, 0x6f, 0x8a, 0xe4, 0xd4,
+	0x9e, 0xfd, 0xba, 0xc9, 0xbf, 0x8e, 0xaf, 0x1b, 0xe5, 0xe5, 0xbe, 0x6e, 0xea, 0x50, 0x16, 0x64,
+	0xe4, 0x47, 0x56, 0x26, 0x8e, 0x79, 0x03, 0x21, 0x56, 0x92, 0x6f, 0xa9, 0xc4, 0xc4, 0x76, 0x4f,
+	0x2b, 0x1c, 0xeb, 0xaa, 0x24, 0x16, 0x1a, 0x3d, 0xfd, 0x76, 0xc8, 0x77, 0xc9, 0xd1, 0x0c, 0xf9,
+	0x6e, 0xc8, 0x50, 0xe8, 0xc5, 0x0c, 0x95, 0x4d, 0x31, 0xd4, 0x2d, 0x28, 0xa7, 0x2c, 0xa9, 0x57,
+	0xa1, 0x9c, 0xf4, 0xc7, 0xf8, 0x71, 0x51, 0x19, 0x0d, 0x2f, 0xd1, 0x30, 0xd2, 0x70, 0xfd, 0x0d,
+	0x38, 0x7b, 0x97, 0xb8, 0x76, 0x4a, 0x1c, 0x45, 0xf6, 0xe2, 0xcb, 0xa3, 0x5f, 0x86, 0x85, 0xeb,
+	0xf2, 0x0b, 0xf0, 0xc4, 0x9a, 0x7f, 0x41, 0x70, 0x4e, 0x24, 0xeb, 0x2e, 0x61, 0x8c, 0x7a, 0x6e,
+	0x2a, 0x67, 0xaf, 0xb9, 0x22, 0x2f, 0x01, 0xb0, 0xd0, 0x47, 0x42, 0xb3, 0xf3, 0x21, 0xb9, 0xbd,
+	0xdd, 0xdf, 0x5f, 0x2a, 0xc5, 0x01, 0x5c, 0x37, 0x4a, 0x2c, 0x8e, 0x45, 0xff, 0x4f, 0x16, 0xca,
+	0xa9, 0xe8, 0xbe, 0x03, 0x21, 0x8d, 0x14, 0x53, 0xee, 0x75, 0x14, 0x53, 0xfe, 0xa5, 0x7f, 0x15,
+	0xa4, 0xb8, 0x41, 0x39, 0x31, 0x9b, 0xeb, 0x37, 0x61, 0x32, 0x95, 0x5c, 0xa6, 0xfe, 0x04, 0x8a,
+	0xd1, 0x3e, 0xe3, 0x8b, 0xbb, 0x38, 0x2e, 0xbb, 0x11, 0xde, 0x18, 0x82, 0xf5, 0x7f, 0x22, 0x58,
+	0x88, 0x9f, 0x10, 0xb1, 0xb5, 0xd3, 0xe1, 0xf5, 0x79, 0x50, 0xbc, 0xc0, 0x26, 0x41, 0xcc, 0x92,
+	0x72, 0x92, 0xd4, 0x6d, 0xee, 0xc5, 0x75, 0x9b, 0x4f, 0xea, 0xb6, 0xf1, 0x77, 0xf4, 0xf8, 0x00,
+	0xa3, 0x27, 0x07, 0x18, 0x7d, 0x79, 0x80, 0x33, 0x5f, 0x1d, 0xe0, 0xcc, 0xd3, 0x03, 0x9c, 0xf9,
+	0xfa, 0x00, 0x67, 0xbe, 0x39, 0xc0, 0xe8, 0xa3, 0x3e, 0x46, 0x1f, 0xf7, 0x71, 0xe6, 0xd3, 0x3e,
+	0x46, 0x9f, 0xf5, 0x71, 0xe6, 0xf3, 0x3e, 0xce, 0x7c, 0xd1, 0xc7, 0x99, 0xc7, 0x7d, 0x8c, 0x9e,
+	0xf4, 0x31, 0xfa, 0xb2, 0x8f, 0x33, 0x5f, 0xf5, 0x31, 0x7a, 0xda, 0xc7, 0x99, 0xaf, 0xfb, 0x18,
+	0x7d, 0xd3, 0xc7, 0x99, 0x8f, 0x06, 0x38, 0xf3, 0xf1, 0x00, 0xa3, 0x87, 0x03, 0x9c, 0xf9, 0xf3,
+	0x00, 0xa3, 0xbf, 0x0d, 0x70, 0xe6, 0xd3, 0x01, 0xce, 0x7c, 0x36, 0xc0, 0xe8, 0xf3, 0x01, 0x46,
+	0x5f, 0x0c, 0x30, 0xfa, 0xd5, 0xc5, 0x96, 0x57, 0xe3, 0xf7, 0x09, 0xbf, 0x4f, 0xdd, 0x16, 0xab,
+	0xb9, 0x84, 0xef, 0x79, 0x41, 0x7b, 0xfd, 0xd9, 0x9f, 0x5d, 0x7e, 0xbb, 0xb5, 0xce, 0xb9, 0xeb,
+	0x6f, 0x6f, 0x4f, 0xc8, 0x63, 0xbc, 0xf4, 0xff, 0x01, 0x00, 0x60, 0xdc, 0x44, 0x20, 0x98, 0x14,
+	0x00, 0x00,
 }
 
 func (this *User) Equal(that interface{}) bool {
@@ -1511,6 +1550,13 @@ func (this *User) Equal(that interface{}) bool {
 	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
 		return false
 	}
+	if that1.DeletedAt == nil {
+		if this.DeletedAt != nil {
+			return false
+		}
+	} else if !this.DeletedAt.Equal(*that1.DeletedAt) {
+		return false
+	}
 	if this.Name != that1.Name {
 		return false
 	}
@@ -1900,6 +1946,21 @@ func (this *CreateUserAPIKeyRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
+	if len(this.AllowedCIDRs) != len(that1.AllowedCIDRs) {
+		return false
+	}
+	for i := range this.AllowedCIDRs {
+		if this.AllowedCIDRs[i] != that1.AllowedCIDRs[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
@@ -1927,6 +1988,9 @@ func (this *UpdateUserAPIKeyRequest) Equal(that interface{}) bool {
 	if !this.APIKey.Equal(&that1.APIKey) {
 		return false
 	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
 	return true
 }
 func (this *Invitation) Equal(that interface{}) bool {
@@ -2387,6 +2451,18 @@ func (m *User) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n8
 	}
+	if m.DeletedAt != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)))
+		n9, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DeletedAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
 	return i, nil
 }
 
@@ -2409,11 +2485,11 @@ func (m *Picture) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintUser(dAtA, i, uint64(m.Embedded.Size()))
-		n9, err := m.Embedded.MarshalTo(dAtA[i:])
+		n10, err := m.Embedded.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n10
 	}
 	if len(m.Sizes) > 0 {
 		for k := range m.Sizes {
@@ -2512,19 +2588,19 @@ func (m *GetUserRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n10, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n11, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n11
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.FieldMask.Size()))
-	n11, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n12, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n11
+	i += n12
 	return i, nil
 }
 
@@ -2546,11 +2622,11 @@ func (m *CreateUserRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.User.Size()))
-	n12, err := m.User.MarshalTo(dAtA[i:])
+	n13, err := m.User.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
 	if len(m.InvitationToken) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -2578,19 +2654,19 @@ func (m *UpdateUserRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.User.Size()))
-	n13, err := m.User.MarshalTo(dAtA[i:])
+	n14, err := m.User.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n14
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.FieldMask.Size()))
-	n14, err := m.FieldMask.MarshalTo(dAtA[i:])
+	n15, err := m.FieldMask.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n15
 	return i, nil
 }
 
@@ -2612,11 +2688,11 @@ func (m *CreateTemporaryPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n15, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n16, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n15
+	i += n16
 	return i, nil
 }
 
@@ -2638,11 +2714,11 @@ func (m *UpdateUserPasswordRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n16, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n17, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n17
 	if len(m.New) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -2686,11 +2762,11 @@ func (m *ListUserAPIKeysRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n17, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n18, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n18
 	if m.Limit != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -2722,11 +2798,11 @@ func (m *GetUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n18, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n19, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n19
 	if len(m.KeyID) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -2754,11 +2830,11 @@ func (m *CreateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n19, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n20, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n20
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -2766,21 +2842,46 @@ func (m *CreateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 		i += copy(dAtA[i:], m.Name)
 	}
 	if len(m.Rights) > 0 {
-		dAtA21 := make([]byte, len(m.Rights)*10)
-		var j20 int
+		dAtA22 := make([]byte, len(m.Rights)*10)
+		var j21 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j20++
+				j21++
 			}
-			dAtA21[j20] = uint8(num)
-			j20++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintUser(dAtA, i, uint64(j20))
-		i += copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintUser(dAtA, i, uint64(j21))
+		i += copy(dAtA[i:], dAtA22[:j21])
+	}
+	if m.ExpiresAt != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
+		n23, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
 	return i, nil
 }
@@ -2803,19 +2904,27 @@ func (m *UpdateUserAPIKeyRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n22, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n24, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n24
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.APIKey.Size()))
-	n23, err := m.APIKey.MarshalTo(dAtA[i:])
+	n25, err := m.APIKey.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n25
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintUser(dAtA, i, uint64(m.FieldMask.Size()))
+	n26, err := m.FieldMask.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n26
 	return i, nil
 }
 
@@ -2849,46 +2958,46 @@ func (m *Invitation) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiresAt)))
-	n24, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i:])
+	n27, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n27
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n25, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	n28, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n28
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
-	n26, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	n29, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n29
 	if m.AcceptedAt != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.AcceptedAt)))
-		n27, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i:])
+		n30, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AcceptedAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n30
 	}
 	if m.AcceptedBy != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintUser(dAtA, i, uint64(m.AcceptedBy.Size()))
-		n28, err := m.AcceptedBy.MarshalTo(dAtA[i:])
+		n31, err := m.AcceptedBy.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n31
 	}
 	return i, nil
 }
@@ -3017,11 +3126,11 @@ func (m *UserSessionIdentifiers) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n29, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n32, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n32
 	if len(m.SessionID) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -3049,11 +3158,11 @@ func (m *UserSession) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n30, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n33, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n33
 	if len(m.SessionID) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -3063,28 +3172,28 @@ func (m *UserSession) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x1a
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)))
-	n31, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
+	n34, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n34
 	dAtA[i] = 0x22
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)))
-	n32, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
+	n35, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n35
 	if m.ExpiresAt != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintUser(dAtA, i, uint64(github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)))
-		n33, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
+		n36, err := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n36
 	}
 	return i, nil
 }
@@ -3137,11 +3246,11 @@ func (m *ListUserSessionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintUser(dAtA, i, uint64(m.UserIdentifiers.Size()))
-	n34, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
+	n37, err := m.UserIdentifiers.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n37
 	if len(m.Order) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -3215,6 +3324,9 @@ func NewPopulatedUser(r randyUser, easy bool) *User {
 	if r.Intn(10) != 0 {
 		this.ProfilePicture = NewPopulatedPicture(r, easy)
 	}
+	if r.Intn(10) != 0 {
+		this.DeletedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3348,6 +3460,14 @@ func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIK
 	for i := 0; i < v19; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(56)])
 	}
+	if r.Intn(10) != 0 {
+		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	v20 := r.Intn(10)
+	this.AllowedCIDRs = make([]string, v20)
+	for i := 0; i < v20; i++ {
+		this.AllowedCIDRs[i] = randStringUser(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3355,10 +3475,12 @@ func NewPopulatedCreateUserAPIKeyRequest(r randyUser, easy bool) *CreateUserAPIK
 
 func NewPopulatedUpdateUserAPIKeyRequest(r randyUser, easy bool) *UpdateUserAPIKeyRequest {
 	this := &UpdateUserAPIKeyRequest{}
-	v20 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v20
-	v21 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v21
+	v21 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v21
+	v22 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3368,12 +3490,12 @@ func NewPopulatedInvitation(r randyUser, easy bool) *Invitation {
 	this := &Invitation{}
 	this.Email = randStringUser(r)
 	this.Token = randStringUser(r)
-	v22 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ExpiresAt = *v22
-	v23 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v23
 	v24 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v24
+	this.ExpiresAt = *v24
+	v25 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v25
+	v26 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v26
 	if r.Intn(10) != 0 {
 		this.AcceptedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
@@ -3397,9 +3519,9 @@ func NewPopulatedListInvitationsRequest(r randyUser, easy bool) *ListInvitations
 func NewPopulatedInvitations(r randyUser, easy bool) *Invitations {
 	this := &Invitations{}
 	if r.Intn(10) != 0 {
-		v25 := r.Intn(5)
-		this.Invitations = make([]*Invitation, v25)
-		for i := 0; i < v25; i++ {
+		v27 := r.Intn(5)
+		this.Invitations = make([]*Invitation, v27)
+		for i := 0; i < v27; i++ {
 			this.Invitations[i] = NewPopulatedInvitation(r, easy)
 		}
 	}
@@ -3426,8 +3548,8 @@ func NewPopulatedDeleteInvitationRequest(r randyUser, easy bool) *DeleteInvitati
 
 func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIdentifiers {
 	this := &UserSessionIdentifiers{}
-	v26 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v26
+	v28 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v28
 	this.SessionID = randStringUser(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3436,13 +3558,13 @@ func NewPopulatedUserSessionIdentifiers(r randyUser, easy bool) *UserSessionIden
 
 func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
 	this := &UserSession{}
-	v27 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v27
+	v29 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v29
 	this.SessionID = randStringUser(r)
-	v28 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v28
-	v29 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v29
+	v30 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v30
+	v31 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v31
 	if r.Intn(10) != 0 {
 		this.ExpiresAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	}
@@ -3454,9 +3576,9 @@ func NewPopulatedUserSession(r randyUser, easy bool) *UserSession {
 func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
 	this := &UserSessions{}
 	if r.Intn(10) != 0 {
-		v30 := r.Intn(5)
-		this.Sessions = make([]*UserSession, v30)
-		for i := 0; i < v30; i++ {
+		v32 := r.Intn(5)
+		this.Sessions = make([]*UserSession, v32)
+		for i := 0; i < v32; i++ {
 			this.Sessions[i] = NewPopulatedUserSession(r, easy)
 		}
 	}
@@ -3467,8 +3589,8 @@ func NewPopulatedUserSessions(r randyUser, easy bool) *UserSessions {
 
 func NewPopulatedListUserSessionsRequest(r randyUser, easy bool) *ListUserSessionsRequest {
 	this := &ListUserSessionsRequest{}
-	v31 := NewPopulatedUserIdentifiers(r, easy)
-	this.UserIdentifiers = *v31
+	v33 := NewPopulatedUserIdentifiers(r, easy)
+	this.UserIdentifiers = *v33
 	this.Order = randStringUser(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -3496,9 +3618,9 @@ func randUTF8RuneUser(r randyUser) rune {
 	return rune(ru + 61)
 }
 func randStringUser(r randyUser) string {
-	v32 := r.Intn(100)
-	tmps := make([]rune, v32)
-	for i := 0; i < v32; i++ {
+	v34 := r.Intn(100)
+	tmps := make([]rune, v34)
+	for i := 0; i < v34; i++ {
 		tmps[i] = randUTF8RuneUser(r)
 	}
 	return string(tmps)
@@ -3520,11 +3642,11 @@ func randFieldUser(dAtA []byte, r randyUser, fieldNumber int, wire int) []byte {
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
-		v33 := r.Int63()
+		v35 := r.Int63()
 		if r.Intn(2) == 0 {
-			v33 *= -1
+			v35 *= -1
 		}
-		dAtA = encodeVarintPopulateUser(dAtA, uint64(v33))
+		dAtA = encodeVarintPopulateUser(dAtA, uint64(v35))
 	case 1:
 		dAtA = encodeVarintPopulateUser(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3624,6 +3746,10 @@ func (m *User) Size() (n int) {
 		l = m.ProfilePicture.Size()
 		n += 2 + l + sovUser(uint64(l))
 	}
+	if m.DeletedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DeletedAt)
+		n += 2 + l + sovUser(uint64(l))
+	}
 	return n
 }
 
@@ -3805,6 +3931,16 @@ func (m *CreateUserAPIKeyRequest) Size() (n int) {
 		}
 		n += 1 + sovUser(uint64(l)) + l
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovUser(uint64(l))
+	}
+	if len(m.AllowedCIDRs) > 0 {
+		for _, s := range m.AllowedCIDRs {
+			l = len(s)
+			n += 1 + l + sovUser(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3818,6 +3954,8 @@ func (m *UpdateUserAPIKeyRequest) Size() (n int) {
 	n += 1 + l + sovUser(uint64(l))
 	l = m.APIKey.Size()
 	n += 1 + l + sovUser(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovUser(uint64(l))
 	return n
 }
 
@@ -4028,6 +4166,7 @@ func (this *User) String() string {
 		`TemporaryPasswordCreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordCreatedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`TemporaryPasswordExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.TemporaryPasswordExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`ProfilePicture:` + strings.Replace(fmt.Sprintf("%v", this.ProfilePicture), "Picture", "Picture", 1) + `,`,
+		`DeletedAt:` + strings.Replace(fmt.Sprintf("%v", this.DeletedAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4161,6 +4300,8 @@ func (this *CreateUserAPIKeyRequest) String() string {
 		`UserIdentifiers:` + strings.Replace(strings.Replace(this.UserIdentifiers.String(), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`AllowedCIDRs:` + fmt.Sprintf("%v", this.AllowedCIDRs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4172,6 +4313,7 @@ func (this *UpdateUserAPIKeyRequest) String() string {
 	s := strings.Join([]string{`&UpdateUserAPIKeyRequest{`,
 		`UserIdentifiers:` + strings.Replace(strings.Replace(this.UserIdentifiers.String(), "UserIdentifiers", "UserIdentifiers", 1), `&`, ``, 1) + `,`,
 		`APIKey:` + strings.Replace(strings.Replace(this.APIKey.String(), "APIKey", "APIKey", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(this.FieldMask.String(), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4977,6 +5119,42 @@ func (m *User) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletedAt == nil {
+				m.DeletedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6426,6 +6604,74 @@ func (m *CreateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCIDRs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCIDRs = append(m.AllowedCIDRs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -6545,6 +6791,39 @@ func (m *UpdateUserAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUser
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUser
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUser(dAtA[iNdEx:])
@@ -7,6 +7,7 @@ var UserFieldPathsNested = []string{
 	"attributes",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"ids",
 	"ids.email",
@@ -34,6 +35,7 @@ var UserFieldPathsTopLevel = []string{
 	"attributes",
 	"contact_info",
 	"created_at",
+	"deleted_at",
 	"description",
 	"ids",
 	"name",
@@ -85,6 +87,7 @@ var CreateUserRequestFieldPathsNested = []string{
 	"user.attributes",
 	"user.contact_info",
 	"user.created_at",
+	"user.deleted_at",
 	"user.description",
 	"user.ids",
 	"user.ids.email",
@@ -118,6 +121,7 @@ var UpdateUserRequestFieldPathsNested = []string{
 	"user.attributes",
 	"user.contact_info",
 	"user.created_at",
+	"user.deleted_at",
 	"user.description",
 	"user.ids",
 	"user.ids.email",
@@ -193,6 +197,8 @@ var GetUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
 var CreateUserAPIKeyRequestFieldPathsNested = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"name",
 	"rights",
 	"user_ids",
@@ -201,16 +207,22 @@ var CreateUserAPIKeyRequestFieldPathsNested = []string{
 }
 
 var CreateUserAPIKeyRequestFieldPathsTopLevel = []string{
+	"allowed_cidrs",
+	"expires_at",
 	"name",
 	"rights",
 	"user_ids",
 }
 var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 	"api_key",
+	"api_key.allowed_cidrs",
+	"api_key.expires_at",
 	"api_key.id",
 	"api_key.key",
+	"api_key.last_used_at",
 	"api_key.name",
 	"api_key.rights",
+	"field_mask",
 	"user_ids",
 	"user_ids.email",
 	"user_ids.user_id",
@@ -218,6 +230,7 @@ var UpdateUserAPIKeyRequestFieldPathsNested = []string{
 
 var UpdateUserAPIKeyRequestFieldPathsTopLevel = []string{
 	"api_key",
+	"field_mask",
 	"user_ids",
 }
 var InvitationFieldPathsNested = []string{
@@ -36,62 +36,64 @@ func init() {
 }
 
 var fileDescriptor_82df9ba9356987c4 = []byte{
-	// 867 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0x3d, 0x6c, 0xdb, 0x46,
-	0x14, 0xc7, 0x79, 0xae, 0x2b, 0xa0, 0x17, 0xc3, 0x68, 0xae, 0x41, 0xdc, 0xd0, 0xc6, 0x0b, 0xc2,
-	0x3a, 0x31, 0xaa, 0x46, 0x47, 0x54, 0x0e, 0x50, 0x20, 0x5b, 0xfa, 0x01, 0x23, 0x68, 0x87, 0x20,
-	0x1f, 0x4b, 0x0b, 0x54, 0xa0, 0xa4, 0x33, 0x75, 0x90, 0x4d, 0xb2, 0xbc, 0x93, 0x0d, 0xd6, 0x48,
-	0x90, 0x66, 0x0a, 0xd0, 0xa5, 0x40, 0x0b, 0xb4, 0x63, 0xd1, 0x29, 0xdd, 0x32, 0x66, 0xf4, 0x98,
-	0x31, 0x40, 0x97, 0x8c, 0x11, 0xd9, 0x21, 0x63, 0xc6, 0x4c, 0x45, 0xc1, 0x3b, 0x92, 0xb2, 0x28,
-	0xd1, 0xe2, 0x46, 0xdd, 0xfb, 0xf3, 0xfd, 0xfe, 0xef, 0xdd, 0x7b, 0x84, 0xf0, 0xe5, 0x3d, 0x3f,
-	0x74, 0x0e, 0x1d, 0xaf, 0x25, 0xa4, 0xd3, 0x1b, 0xda, 0x4e, 0xc0, 0xed, 0x91, 0x60, 0x61, 0x47,
-	0xb0, 0xf0, 0x80, 0xf7, 0x98, 0xa0, 0x41, 0xe8, 0x4b, 0x9f, 0xac, 0x4a, 0xe9, 0xd1, 0x4c, 0x4a,
-	0x0f, 0xb6, 0xcd, 0x96, 0xcb, 0xe5, 0x60, 0xd4, 0xa5, 0x3d, 0x7f, 0xdf, 0x76, 0x7d, 0xd7, 0xb7,
-	0x95, 0xac, 0x3b, 0xda, 0x55, 0xbf, 0xd4, 0x0f, 0xf5, 0xa4, 0x5f, 0x37, 0x37, 0x5c, 0xdf, 0x77,
-	0xf7, 0x98, 0x4a, 0xef, 0x78, 0x9e, 0x2f, 0x1d, 0xc9, 0x7d, 0x2f, 0x4b, 0x6e, 0xae, 0x67, 0xd1,
-	0x22, 0x07, 0xdb, 0x0f, 0x64, 0x94, 0x05, 0x3f, 0x9a, 0x35, 0xc8, 0xfb, 0xcc, 0x93, 0x7c, 0x97,
-	0xb3, 0x30, 0xcf, 0x00, 0xb3, 0xa2, 0x90, 0xbb, 0x03, 0x99, 0xc7, 0x37, 0xe6, 0x57, 0xa9, 0xa3,
-	0xed, 0xbf, 0xdf, 0xc5, 0x2b, 0xf7, 0x04, 0x0b, 0x6f, 0x33, 0x97, 0x0b, 0x19, 0x46, 0xe4, 0x2e,
-	0x6e, 0x7c, 0x11, 0x32, 0x47, 0x32, 0x72, 0x89, 0x4e, 0x17, 0x4e, 0xf5, 0xb9, 0x56, 0xff, 0x30,
-	0x62, 0x42, 0x9a, 0xe7, 0xca, 0x92, 0x34, 0x68, 0x9d, 0x7d, 0xf4, 0xcf, 0xbf, 0xbf, 0x2e, 0x9d,
-	0xb1, 0x1a, 0x0a, 0x24, 0xae, 0xa3, 0x26, 0xf9, 0x1e, 0xbf, 0xb3, 0xc3, 0x24, 0x81, 0xb2, 0x7e,
-	0x87, 0xc9, 0xc5, 0xf9, 0x2e, 0xa9, 0x7c, 0xeb, 0xe4, 0x82, 0xce, 0x67, 0x1f, 0xa9, 0x5b, 0xe2,
-	0x7d, 0x41, 0xb3, 0x87, 0xfb, 0xc4, 0xc5, 0x8d, 0x7b, 0x41, 0x7f, 0xae, 0x6b, 0x7d, 0xbe, 0x98,
-	0xb2, 0xa9, 0x28, 0x60, 0x4e, 0x51, 0xe8, 0x49, 0x4a, 0x5a, 0xc8, 0xef, 0x08, 0xaf, 0xe9, 0x3e,
-	0xdc, 0x65, 0xfb, 0x81, 0x1f, 0x3a, 0x61, 0x74, 0xcb, 0x11, 0xe2, 0xd0, 0x0f, 0xfb, 0x84, 0xce,
-	0x6f, 0xd8, 0x8c, 0x30, 0xf7, 0x71, 0x9e, 0xea, 0xcb, 0xa7, 0xf9, 0xe5, 0xd3, 0xaf, 0xd2, 0xcb,
-	0xb7, 0xae, 0x29, 0x27, 0xd4, 0xba, 0x5a, 0x59, 0xaf, 0x2d, 0xf3, 0x9c, 0x9d, 0x20, 0xa7, 0x3f,
-	0x42, 0x78, 0x55, 0xd7, 0x5a, 0x18, 0xfa, 0xb8, 0xba, 0x17, 0x75, 0xbd, 0xb4, 0x94, 0x97, 0x2d,
-	0xd3, 0xaa, 0xf6, 0x92, 0x3b, 0x48, 0xdb, 0xf3, 0x1d, 0x6e, 0x7c, 0xc9, 0xf6, 0x98, 0x64, 0xe4,
-	0xe2, 0xbc, 0x26, 0xdf, 0x9c, 0x4c, 0x6f, 0x25, 0xf1, 0x43, 0x45, 0x24, 0xcd, 0xf7, 0x4b, 0xc4,
-	0xfb, 0xed, 0xff, 0x96, 0x31, 0x4e, 0xb3, 0xdc, 0xe8, 0xf5, 0x98, 0x10, 0x64, 0x17, 0xe3, 0x6f,
-	0xb8, 0x90, 0xb7, 0xd5, 0xb0, 0xd7, 0xe1, 0x95, 0x04, 0xfa, 0x45, 0xeb, 0xa2, 0xe2, 0x5d, 0x20,
-	0x6b, 0x65, 0x5e, 0xb6, 0x46, 0xe4, 0x01, 0x5e, 0xd1, 0x17, 0x79, 0xe3, 0xd6, 0xcd, 0xaf, 0x59,
-	0x44, 0xb6, 0xaa, 0xf7, 0x42, 0x2b, 0x26, 0x3d, 0x2d, 0x09, 0x75, 0x38, 0xef, 0xa9, 0x75, 0x4a,
-	0x4f, 0x9d, 0x80, 0xb7, 0x86, 0x2c, 0x52, 0xbb, 0xf3, 0x23, 0x3e, 0x93, 0xd6, 0xa9, 0x5f, 0x16,
-	0xe4, 0x4a, 0x39, 0x6b, 0x1a, 0x9c, 0xc0, 0x45, 0x4e, 0x5f, 0x9b, 0x4f, 0x17, 0x56, 0x53, 0xe1,
-	0x37, 0x49, 0x0d, 0x3c, 0x79, 0x80, 0xdf, 0xdb, 0x61, 0x19, 0x9a, 0x6c, 0x56, 0x6c, 0x6f, 0xbd,
-	0xaa, 0xb7, 0x15, 0xb6, 0x45, 0x3e, 0x59, 0x8c, 0xb5, 0x8f, 0x86, 0x2c, 0x52, 0x7b, 0xfd, 0x33,
-	0xc2, 0x2b, 0x7a, 0x68, 0xab, 0x9a, 0x3f, 0x19, 0xe9, 0x7a, 0x36, 0xae, 0x2b, 0x1b, 0xd7, 0x4c,
-	0xbb, 0x8e, 0x0d, 0x27, 0xe0, 0x9d, 0x21, 0x8b, 0xa8, 0x5e, 0xfe, 0xf6, 0xf1, 0x12, 0x3e, 0xaf,
-	0xc6, 0xca, 0x3b, 0xe0, 0xfa, 0x33, 0x5e, 0x7c, 0x36, 0xbb, 0x78, 0xf9, 0x0e, 0xf3, 0xfa, 0xe4,
-	0x72, 0x19, 0x9b, 0x9e, 0x9e, 0xd4, 0x6b, 0x77, 0x66, 0x59, 0x36, 0x91, 0x58, 0x6b, 0xca, 0xe1,
-	0x59, 0x6b, 0xc5, 0xe6, 0xc5, 0xa1, 0x1a, 0x04, 0x07, 0x2f, 0xa7, 0x77, 0x3d, 0x7f, 0x02, 0x26,
-	0x09, 0x8a, 0x09, 0x58, 0xaf, 0x86, 0x08, 0xeb, 0x9c, 0xa2, 0xac, 0x92, 0x29, 0x0a, 0xe9, 0x14,
-	0xfb, 0x3b, 0xd3, 0x68, 0x7d, 0x3e, 0x5b, 0x4a, 0xd5, 0x1e, 0x67, 0x80, 0xe6, 0x14, 0xa0, 0xfd,
-	0xdb, 0x12, 0xfe, 0x20, 0x6d, 0xe1, 0x1d, 0x26, 0xc4, 0xc9, 0xfe, 0x45, 0x59, 0x6d, 0x5b, 0x55,
-	0xd3, 0x9d, 0xbd, 0x50, 0x14, 0xb7, 0x31, 0x6f, 0xdf, 0x73, 0x51, 0x9d, 0x19, 0x17, 0x99, 0x96,
-	0xfc, 0x84, 0x8a, 0xa2, 0xaf, 0x9c, 0x92, 0xb4, 0xce, 0xb7, 0xeb, 0x33, 0x85, 0xfd, 0xb4, 0x69,
-	0x2f, 0xc6, 0xda, 0x47, 0xd9, 0x53, 0x7a, 0xfa, 0xf9, 0x5f, 0xe8, 0xf9, 0x18, 0xd0, 0x8b, 0x31,
-	0xa0, 0x97, 0x63, 0x30, 0x5e, 0x8d, 0xc1, 0x78, 0x3d, 0x06, 0xe3, 0xcd, 0x18, 0x8c, 0xb7, 0x63,
-	0x40, 0x0f, 0x63, 0x40, 0x8f, 0x63, 0x30, 0x9e, 0xc4, 0x80, 0x9e, 0xc6, 0x60, 0x3c, 0x8b, 0xc1,
-	0x38, 0x8e, 0xc1, 0x78, 0x1e, 0x03, 0x7a, 0x11, 0x03, 0x7a, 0x19, 0x83, 0xf1, 0x2a, 0x06, 0xf4,
-	0x3a, 0x06, 0xe3, 0x4d, 0x0c, 0xe8, 0x6d, 0x0c, 0xc6, 0xc3, 0x04, 0x8c, 0xc7, 0x09, 0xa0, 0x5f,
-	0x12, 0x30, 0xfe, 0x48, 0x00, 0xfd, 0x99, 0x80, 0xf1, 0x24, 0x01, 0xe3, 0x69, 0x02, 0xe8, 0x59,
-	0x02, 0xe8, 0x38, 0x01, 0xf4, 0xed, 0x55, 0xd7, 0xa7, 0x72, 0xc0, 0xe4, 0x80, 0x7b, 0xae, 0xa0,
-	0x1e, 0x93, 0x87, 0x7e, 0x38, 0xb4, 0xa7, 0xff, 0x30, 0x04, 0x43, 0xd7, 0x96, 0xd2, 0x0b, 0xba,
-	0xdd, 0x86, 0xaa, 0x76, 0xfb, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x7f, 0xf1, 0x68, 0x38,
-	0x09, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x3f, 0x6c, 0xdb, 0x46,
+	0x14, 0xc6, 0x79, 0xae, 0xab, 0xa2, 0x17, 0xc3, 0x68, 0xae, 0x81, 0xed, 0xd0, 0xc6, 0x4b, 0xc3,
+	0x3a, 0x31, 0xaa, 0x46, 0x47, 0xd4, 0x0e, 0x50, 0x20, 0x5b, 0xfa, 0x07, 0x46, 0xd0, 0x0e, 0x86,
+	0x93, 0x2c, 0x2d, 0x50, 0x95, 0x92, 0xce, 0xf4, 0x41, 0x36, 0xc9, 0xde, 0x9d, 0x6c, 0xa8, 0x46,
+	0x82, 0x34, 0x53, 0x80, 0x2e, 0x05, 0x5a, 0xa0, 0x1d, 0x8b, 0x4e, 0x19, 0x33, 0x66, 0xf4, 0x98,
+	0x31, 0x40, 0x97, 0x8c, 0x11, 0xd9, 0x21, 0x63, 0xba, 0x65, 0x2a, 0x0a, 0xde, 0x91, 0x92, 0x45,
+	0x89, 0x31, 0x81, 0x4c, 0x26, 0xef, 0x7d, 0xfc, 0x7e, 0xef, 0xbd, 0x7b, 0x77, 0x16, 0xbe, 0xb4,
+	0x17, 0x0a, 0xef, 0xd0, 0x0b, 0x1a, 0x52, 0x79, 0xed, 0xae, 0xeb, 0x45, 0xdc, 0xed, 0x49, 0x26,
+	0x9a, 0x92, 0x89, 0x03, 0xde, 0x66, 0x92, 0x46, 0x22, 0x54, 0x21, 0x99, 0x57, 0x2a, 0xa0, 0x99,
+	0x94, 0x1e, 0x6c, 0xd8, 0x0d, 0x9f, 0xab, 0xdd, 0x5e, 0x8b, 0xb6, 0xc3, 0x7d, 0xd7, 0x0f, 0xfd,
+	0xd0, 0xd5, 0xb2, 0x56, 0x6f, 0x47, 0xbf, 0xe9, 0x17, 0xfd, 0x64, 0x3e, 0xb7, 0x57, 0xfc, 0x30,
+	0xf4, 0xf7, 0x98, 0xb6, 0xf7, 0x82, 0x20, 0x54, 0x9e, 0xe2, 0x61, 0x90, 0x99, 0xdb, 0xcb, 0x59,
+	0x74, 0xe8, 0xc1, 0xf6, 0x23, 0xd5, 0xcf, 0x82, 0x1f, 0x4e, 0x26, 0xc8, 0x3b, 0x2c, 0x50, 0x7c,
+	0x87, 0x33, 0x91, 0x3b, 0xc0, 0xa4, 0x48, 0x70, 0x7f, 0x57, 0xe5, 0xf1, 0x95, 0xe9, 0x55, 0x9a,
+	0xe8, 0xfa, 0xbf, 0x35, 0x3c, 0x77, 0x5b, 0x32, 0xb1, 0xcd, 0x7c, 0x2e, 0x95, 0xe8, 0x93, 0x5b,
+	0xb8, 0xf6, 0xb9, 0x60, 0x9e, 0x62, 0xe4, 0x22, 0x1d, 0x2f, 0x9c, 0x9a, 0x75, 0xa3, 0xfe, 0xa1,
+	0xc7, 0xa4, 0xb2, 0xcf, 0x15, 0x25, 0x69, 0xd0, 0x39, 0x7b, 0xff, 0xef, 0x7f, 0x7e, 0x9d, 0x39,
+	0xe3, 0xd4, 0x34, 0x48, 0x5e, 0x43, 0x75, 0xf2, 0x1d, 0x7e, 0x6b, 0x93, 0x29, 0x02, 0x45, 0xfd,
+	0x26, 0x53, 0xa7, 0xfb, 0x5d, 0xd4, 0x7e, 0xcb, 0xe4, 0xbc, 0xf1, 0x73, 0x8f, 0xd2, 0x3f, 0x4d,
+	0xde, 0x91, 0x34, 0x7b, 0xb8, 0x43, 0x7c, 0x5c, 0xbb, 0x1d, 0x75, 0xa6, 0x66, 0x6d, 0xd6, 0x4f,
+	0xa7, 0xac, 0x6a, 0x0a, 0xd8, 0x63, 0x14, 0x7a, 0x92, 0x92, 0x16, 0xf2, 0x3b, 0xc2, 0x8b, 0xa6,
+	0x0f, 0xb7, 0xd8, 0x7e, 0x14, 0x0a, 0x4f, 0xf4, 0xb7, 0x3c, 0x29, 0x0f, 0x43, 0xd1, 0x21, 0x74,
+	0x7a, 0xc3, 0x26, 0x84, 0x79, 0x1e, 0x0b, 0xd4, 0x6c, 0x3e, 0xcd, 0x37, 0x9f, 0x7e, 0x99, 0x6e,
+	0xbe, 0x73, 0x55, 0x67, 0x42, 0x9d, 0x2b, 0xa5, 0xf5, 0xba, 0x2a, 0xf7, 0x6c, 0x46, 0x39, 0xfd,
+	0x3e, 0xc2, 0xf3, 0xa6, 0xd6, 0x61, 0x42, 0x1f, 0x95, 0xf7, 0xa2, 0x6a, 0x2e, 0x0d, 0x9d, 0xcb,
+	0x9a, 0xed, 0x94, 0xe7, 0x92, 0x67, 0x90, 0xb6, 0xe7, 0x5b, 0x5c, 0xfb, 0x82, 0xed, 0x31, 0xc5,
+	0xc8, 0x85, 0x69, 0x4d, 0xbe, 0x31, 0x9a, 0xde, 0x52, 0xe2, 0x92, 0x26, 0x92, 0xfa, 0x7b, 0x05,
+	0xe2, 0x1d, 0xd2, 0xc1, 0xef, 0x6c, 0x33, 0xa9, 0x42, 0xf1, 0x06, 0xee, 0x1f, 0x68, 0x77, 0xdb,
+	0x59, 0x2a, 0xba, 0xbb, 0x22, 0xb3, 0xfe, 0x1e, 0xbf, 0xbd, 0xd5, 0x13, 0xfe, 0x1b, 0x30, 0x40,
+	0x33, 0x96, 0xea, 0x0b, 0x13, 0x8c, 0x28, 0x35, 0x5e, 0xff, 0x6f, 0x16, 0xe3, 0xd4, 0xeb, 0x7a,
+	0xbb, 0xcd, 0xa4, 0x24, 0x3b, 0x18, 0x7f, 0xcd, 0xa5, 0xda, 0xd6, 0x87, 0xb6, 0x0a, 0xb5, 0x20,
+	0x30, 0x1f, 0x3a, 0x17, 0x34, 0xf5, 0x3c, 0x59, 0x9c, 0xac, 0xcc, 0x38, 0xdf, 0xc5, 0x73, 0x66,
+	0x20, 0xaf, 0x6f, 0xdd, 0xf8, 0x8a, 0xf5, 0xc9, 0x5a, 0xf9, 0xf9, 0x36, 0x8a, 0xd1, 0x6c, 0x14,
+	0x84, 0x26, 0x9c, 0xcf, 0x86, 0xf3, 0x9a, 0xd9, 0xf0, 0x22, 0xde, 0xe8, 0xb2, 0xbe, 0xbe, 0x03,
+	0x7e, 0xc4, 0x67, 0xd2, 0x3a, 0xcd, 0xc7, 0x92, 0x5c, 0x2e, 0xba, 0xa6, 0xc1, 0x11, 0x5c, 0xe6,
+	0xf4, 0xc5, 0xe9, 0x74, 0xe9, 0xd4, 0x35, 0x7e, 0x95, 0x54, 0xc0, 0x93, 0xbb, 0xf8, 0xdd, 0x4d,
+	0x96, 0xa1, 0xc9, 0x6a, 0xc9, 0x2d, 0x54, 0xad, 0xea, 0x0d, 0x8d, 0x6d, 0x90, 0x8f, 0x4f, 0xc7,
+	0xba, 0x47, 0x5d, 0xd6, 0xd7, 0xa3, 0xfb, 0x33, 0xc2, 0x73, 0xe6, 0xf0, 0x95, 0x35, 0x7f, 0x74,
+	0x34, 0xab, 0xa5, 0x71, 0x4d, 0xa7, 0x71, 0xd5, 0x76, 0xab, 0xa4, 0xe1, 0x45, 0xbc, 0xd9, 0x65,
+	0x7d, 0x6a, 0x2e, 0xb1, 0xf5, 0xe3, 0x19, 0xbc, 0xa0, 0xc7, 0x2a, 0x38, 0xe0, 0xe6, 0xdf, 0xd1,
+	0xf0, 0xfa, 0x6f, 0xe1, 0xd9, 0x9b, 0x2c, 0xe8, 0x90, 0x4b, 0x45, 0x6c, 0xba, 0x7a, 0x52, 0x6f,
+	0xb2, 0xb3, 0x8b, 0xb2, 0x91, 0xc4, 0x59, 0xd4, 0x19, 0x9e, 0x75, 0xe6, 0x5c, 0x3e, 0x5c, 0xd4,
+	0x83, 0xe0, 0xe1, 0xd9, 0x74, 0xaf, 0xa7, 0x4f, 0xc0, 0xc8, 0x60, 0x38, 0x01, 0xcb, 0xe5, 0x10,
+	0xe9, 0x9c, 0xd3, 0x94, 0x79, 0x32, 0x46, 0x21, 0xcd, 0xe1, 0x3d, 0x34, 0xd1, 0x68, 0xb3, 0x3e,
+	0x59, 0x4a, 0xd9, 0x69, 0xce, 0x00, 0xf5, 0x31, 0xc0, 0xfa, 0x6f, 0x33, 0xf8, 0xfd, 0xb4, 0x85,
+	0x37, 0x99, 0x94, 0x27, 0xfb, 0xd7, 0xcf, 0x6a, 0x5b, 0x2b, 0x9b, 0xee, 0xec, 0x83, 0x61, 0x71,
+	0x2b, 0xd3, 0xce, 0x7b, 0x2e, 0xaa, 0x32, 0xe3, 0x32, 0xd3, 0x92, 0x9f, 0xd0, 0xb0, 0xe8, 0xcb,
+	0xaf, 0x31, 0xad, 0x72, 0x83, 0x7d, 0xaa, 0xb1, 0x9f, 0xd4, 0xdd, 0xd3, 0xb1, 0xee, 0x51, 0xf6,
+	0x94, 0xae, 0x7e, 0xf6, 0x17, 0x7a, 0x32, 0x00, 0xf4, 0x74, 0x00, 0xe8, 0xd9, 0x00, 0xac, 0xe7,
+	0x03, 0xb0, 0x5e, 0x0c, 0xc0, 0x7a, 0x39, 0x00, 0xeb, 0xd5, 0x00, 0xd0, 0xbd, 0x18, 0xd0, 0x83,
+	0x18, 0xac, 0x87, 0x31, 0xa0, 0x47, 0x31, 0x58, 0x8f, 0x63, 0xb0, 0x8e, 0x63, 0xb0, 0x9e, 0xc4,
+	0x80, 0x9e, 0xc6, 0x80, 0x9e, 0xc5, 0x60, 0x3d, 0x8f, 0x01, 0xbd, 0x88, 0xc1, 0x7a, 0x19, 0x03,
+	0x7a, 0x15, 0x83, 0x75, 0x2f, 0x01, 0xeb, 0x41, 0x02, 0xe8, 0x97, 0x04, 0xac, 0x3f, 0x12, 0x40,
+	0x7f, 0x26, 0x60, 0x3d, 0x4c, 0xc0, 0x7a, 0x94, 0x00, 0x7a, 0x9c, 0x00, 0x3a, 0x4e, 0x00, 0x7d,
+	0x73, 0xc5, 0x0f, 0xa9, 0xda, 0x65, 0x6a, 0x97, 0x07, 0xbe, 0xa4, 0x01, 0x53, 0x87, 0xa1, 0xe8,
+	0xba, 0xe3, 0x3f, 0x7c, 0xa2, 0xae, 0xef, 0x2a, 0x15, 0x44, 0xad, 0x56, 0x4d, 0x57, 0xbb, 0xf1,
+	0xff, 0x00, 0xda, 0xf7, 0x05, 0xbf, 0x00, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -118,6 +120,11 @@ type UserRegistryClient interface {
 	CreateTemporaryPassword(ctx context.Context, in *CreateTemporaryPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Restore a recently deleted user.
+	Restore(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// Purge the user. This permanently deletes the user and the data
+	// related to it, after which its ID can be reused.
+	Purge(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
 }
 
 type userRegistryClient struct {
@@ -182,6 +189,24 @@ func (c *userRegistryClient) Delete(ctx context.Context, in *UserIdentifiers, op
 	return out, nil
 }
 
+func (c *userRegistryClient) Restore(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/Restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) Purge(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.UserRegistry/Purge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserRegistryServer is the server API for UserRegistry service.
 type UserRegistryServer interface {
 	// Register a new user. This method may be restricted by network settings.
@@ -196,6 +221,11 @@ type UserRegistryServer interface {
 	CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*types.Empty, error)
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*types.Empty, error)
 	Delete(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Restore a recently deleted user.
+	Restore(context.Context, *UserIdentifiers) (*types.Empty, error)
+	// Purge the user. This permanently deletes the user and the data
+	// related to it, after which its ID can be reused.
+	Purge(context.Context, *UserIdentifiers) (*types.Empty, error)
 }
 
 func RegisterUserRegistryServer(s *grpc.Server, srv UserRegistryServer) {
@@ -310,6 +340,42 @@ func _UserRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/Restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).Restore(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.UserRegistry/Purge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).Purge(ctx, req.(*UserIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.UserRegistry",
 	HandlerType: (*UserRegistryServer)(nil),
@@ -338,6 +404,14 @@ var _UserRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _UserRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _UserRegistry_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _UserRegistry_Purge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/user_services.proto",
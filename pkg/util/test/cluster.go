@@ -101,6 +101,7 @@ type MockCluster struct {
 	GetPeerFunc            func(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (cluster.Peer, error)
 	ClaimIDsFunc           func(ctx context.Context, ids ttnpb.Identifiers) error
 	UnclaimIDsFunc         func(ctx context.Context, ids ttnpb.Identifiers) error
+	ListPeersFunc          func(ctx context.Context) ([]*ttnpb.ClusterPeer, error)
 	TLSFunc                func() bool
 	AuthFunc               func() grpc.CallOption
 	WithVerifiedSourceFunc func(ctx context.Context) context.Context
@@ -163,6 +164,14 @@ func (m MockCluster) UnclaimIDs(ctx context.Context, ids ttnpb.Identifiers) erro
 	return m.UnclaimIDsFunc(ctx, ids)
 }
 
+// ListPeers calls ListPeersFunc if set and panics otherwise.
+func (m MockCluster) ListPeers(ctx context.Context) ([]*ttnpb.ClusterPeer, error) {
+	if m.ListPeersFunc == nil {
+		panic("ListPeers called, but not set")
+	}
+	return m.ListPeersFunc(ctx)
+}
+
 // TLS calls TLSFunc if set and panics otherwise.
 func (m MockCluster) TLS() bool {
 	if m.TLSFunc == nil {
@@ -81,14 +81,14 @@ func NewRedis(t testing.TB, namespace ...string) (*ttnredis.Client, func()) {
 		return strings.Join(ss, " ")
 	}
 
-	cl.Client.WrapProcess(func(p func(redis.Cmder) error) func(redis.Cmder) error {
+	cl.UniversalClient.WrapProcess(func(p func(redis.Cmder) error) func(redis.Cmder) error {
 		logger := GetLogger(t)
 		return func(cmd redis.Cmder) error {
 			logger.Debugf("Executing `%s`", formatCmd(cmd))
 			return p(cmd)
 		}
 	})
-	cl.Client.WrapProcessPipeline(func(p func([]redis.Cmder) error) func([]redis.Cmder) error {
+	cl.UniversalClient.WrapProcessPipeline(func(p func([]redis.Cmder) error) func([]redis.Cmder) error {
 		logger := GetLogger(t)
 		return func(cmds []redis.Cmder) error {
 			var s string
@@ -112,14 +112,14 @@ func NewRedis(t testing.TB, namespace ...string) (*ttnredis.Client, func()) {
 		defer cl.Close()
 
 		q := cl.Key("*")
-		keys, err := cl.Client.Keys(q).Result()
+		keys, err := cl.UniversalClient.Keys(q).Result()
 		if err != nil {
 			logger.WithField("query", q).Fatal("Failed to query Redis for keys")
 			return
 		}
 
 		if len(keys) > 0 {
-			n, err := cl.Client.Del(keys...).Result()
+			n, err := cl.UniversalClient.Del(keys...).Result()
 			if err != nil {
 				logger.WithError(err).Fatal("Failed to delete existing keys")
 				return
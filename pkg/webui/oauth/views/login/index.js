@@ -23,7 +23,7 @@ import * as Yup from 'yup'
 
 import api from '../../api'
 import sharedMessages from '../../../lib/shared-messages'
-import { selectApplicationRootPath } from '../../../lib/selectors/env'
+import { selectApplicationRootPath, selectApplicationConfig } from '../../../lib/selectors/env'
 import PropTypes from '../../../lib/prop-types'
 
 import Button from '../../../components/button'
@@ -40,6 +40,7 @@ const m = defineMessages({
   createAccount: 'Create an account',
   forgotPassword: 'Forgot password?',
   loginToContinue: 'Please login to continue',
+  loginWith: 'Login with {name}',
   stackAccount: 'The Things Stack Account',
 })
 
@@ -49,6 +50,7 @@ const validationSchema = Yup.object().shape({
 })
 
 const appRoot = selectApplicationRootPath()
+const { federation_providers: federationProviders = [] } = selectApplicationConfig()
 
 @withRouter
 @connect(
@@ -146,6 +148,16 @@ export default class OAuth extends React.PureComponent {
               <Form.Submit component={SubmitButton} message={sharedMessages.login} />
               <Button naked message={m.createAccount} onClick={this.navigateToRegister} />
               <Button naked message={m.forgotPassword} onClick={this.navigateToResetPassword} />
+              {federationProviders.map(provider => (
+                <Button.AnchorLink
+                  key={provider.id}
+                  secondary
+                  message={{ ...m.loginWith, values: { name: provider.name } }}
+                  href={`${appRoot}/login/${provider.id}?${Query.stringify({
+                    n: url(this.props.location),
+                  })}`}
+                />
+              ))}
             </Form>
           </div>
         </div>